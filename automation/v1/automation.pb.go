@@ -0,0 +1,3388 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: proto/automation/v1/automation.proto
+
+package automationv1
+
+import (
+	v1 "github.com/nexusflow/nexusflow/pkg/proto/common/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ConditionType int32
+
+const (
+	ConditionType_CONDITION_TYPE_UNSPECIFIED         ConditionType = 0
+	ConditionType_CONDITION_TYPE_FIELD               ConditionType = 1 // Compares a field of the event or issue
+	ConditionType_CONDITION_TYPE_SUB_TASKS_IN_STATUS ConditionType = 2 // Every sub-task is in one of status_ids
+)
+
+// Enum value maps for ConditionType.
+var (
+	ConditionType_name = map[int32]string{
+		0: "CONDITION_TYPE_UNSPECIFIED",
+		1: "CONDITION_TYPE_FIELD",
+		2: "CONDITION_TYPE_SUB_TASKS_IN_STATUS",
+	}
+	ConditionType_value = map[string]int32{
+		"CONDITION_TYPE_UNSPECIFIED":         0,
+		"CONDITION_TYPE_FIELD":               1,
+		"CONDITION_TYPE_SUB_TASKS_IN_STATUS": 2,
+	}
+)
+
+func (x ConditionType) Enum() *ConditionType {
+	p := new(ConditionType)
+	*p = x
+	return p
+}
+
+func (x ConditionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConditionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_automation_v1_automation_proto_enumTypes[0].Descriptor()
+}
+
+func (ConditionType) Type() protoreflect.EnumType {
+	return &file_proto_automation_v1_automation_proto_enumTypes[0]
+}
+
+func (x ConditionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConditionType.Descriptor instead.
+func (ConditionType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{0}
+}
+
+type ConditionOperator int32
+
+const (
+	ConditionOperator_CONDITION_OPERATOR_UNSPECIFIED  ConditionOperator = 0
+	ConditionOperator_CONDITION_OPERATOR_EQUALS       ConditionOperator = 1
+	ConditionOperator_CONDITION_OPERATOR_NOT_EQUALS   ConditionOperator = 2
+	ConditionOperator_CONDITION_OPERATOR_IN           ConditionOperator = 3
+	ConditionOperator_CONDITION_OPERATOR_NOT_IN       ConditionOperator = 4
+	ConditionOperator_CONDITION_OPERATOR_CONTAINS     ConditionOperator = 5 // Substring, or element of a list
+	ConditionOperator_CONDITION_OPERATOR_IS_EMPTY     ConditionOperator = 6
+	ConditionOperator_CONDITION_OPERATOR_IS_NOT_EMPTY ConditionOperator = 7
+)
+
+// Enum value maps for ConditionOperator.
+var (
+	ConditionOperator_name = map[int32]string{
+		0: "CONDITION_OPERATOR_UNSPECIFIED",
+		1: "CONDITION_OPERATOR_EQUALS",
+		2: "CONDITION_OPERATOR_NOT_EQUALS",
+		3: "CONDITION_OPERATOR_IN",
+		4: "CONDITION_OPERATOR_NOT_IN",
+		5: "CONDITION_OPERATOR_CONTAINS",
+		6: "CONDITION_OPERATOR_IS_EMPTY",
+		7: "CONDITION_OPERATOR_IS_NOT_EMPTY",
+	}
+	ConditionOperator_value = map[string]int32{
+		"CONDITION_OPERATOR_UNSPECIFIED":  0,
+		"CONDITION_OPERATOR_EQUALS":       1,
+		"CONDITION_OPERATOR_NOT_EQUALS":   2,
+		"CONDITION_OPERATOR_IN":           3,
+		"CONDITION_OPERATOR_NOT_IN":       4,
+		"CONDITION_OPERATOR_CONTAINS":     5,
+		"CONDITION_OPERATOR_IS_EMPTY":     6,
+		"CONDITION_OPERATOR_IS_NOT_EMPTY": 7,
+	}
+)
+
+func (x ConditionOperator) Enum() *ConditionOperator {
+	p := new(ConditionOperator)
+	*p = x
+	return p
+}
+
+func (x ConditionOperator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConditionOperator) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_automation_v1_automation_proto_enumTypes[1].Descriptor()
+}
+
+func (ConditionOperator) Type() protoreflect.EnumType {
+	return &file_proto_automation_v1_automation_proto_enumTypes[1]
+}
+
+func (x ConditionOperator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConditionOperator.Descriptor instead.
+func (ConditionOperator) EnumDescriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{1}
+}
+
+// Issue the rule looks at or acts on
+type Target int32
+
+const (
+	Target_TARGET_UNSPECIFIED Target = 0
+	Target_TARGET_ISSUE       Target = 1 // The issue of the event
+	Target_TARGET_PARENT      Target = 2 // Its parent issue
+)
+
+// Enum value maps for Target.
+var (
+	Target_name = map[int32]string{
+		0: "TARGET_UNSPECIFIED",
+		1: "TARGET_ISSUE",
+		2: "TARGET_PARENT",
+	}
+	Target_value = map[string]int32{
+		"TARGET_UNSPECIFIED": 0,
+		"TARGET_ISSUE":       1,
+		"TARGET_PARENT":      2,
+	}
+)
+
+func (x Target) Enum() *Target {
+	p := new(Target)
+	*p = x
+	return p
+}
+
+func (x Target) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Target) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_automation_v1_automation_proto_enumTypes[2].Descriptor()
+}
+
+func (Target) Type() protoreflect.EnumType {
+	return &file_proto_automation_v1_automation_proto_enumTypes[2]
+}
+
+func (x Target) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Target.Descriptor instead.
+func (Target) EnumDescriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{2}
+}
+
+type ActionType int32
+
+const (
+	ActionType_ACTION_TYPE_UNSPECIFIED      ActionType = 0
+	ActionType_ACTION_TYPE_TRANSITION_ISSUE ActionType = 1 // Sets status_id
+	ActionType_ACTION_TYPE_UPDATE_ISSUE     ActionType = 2 // Sets assignee_id and/or priority
+	ActionType_ACTION_TYPE_NOTIFY           ActionType = 3 // Notifies user_ids
+	ActionType_ACTION_TYPE_ADD_COMMENT      ActionType = 4 // Comments message on the issue
+)
+
+// Enum value maps for ActionType.
+var (
+	ActionType_name = map[int32]string{
+		0: "ACTION_TYPE_UNSPECIFIED",
+		1: "ACTION_TYPE_TRANSITION_ISSUE",
+		2: "ACTION_TYPE_UPDATE_ISSUE",
+		3: "ACTION_TYPE_NOTIFY",
+		4: "ACTION_TYPE_ADD_COMMENT",
+	}
+	ActionType_value = map[string]int32{
+		"ACTION_TYPE_UNSPECIFIED":      0,
+		"ACTION_TYPE_TRANSITION_ISSUE": 1,
+		"ACTION_TYPE_UPDATE_ISSUE":     2,
+		"ACTION_TYPE_NOTIFY":           3,
+		"ACTION_TYPE_ADD_COMMENT":      4,
+	}
+)
+
+func (x ActionType) Enum() *ActionType {
+	p := new(ActionType)
+	*p = x
+	return p
+}
+
+func (x ActionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_automation_v1_automation_proto_enumTypes[3].Descriptor()
+}
+
+func (ActionType) Type() protoreflect.EnumType {
+	return &file_proto_automation_v1_automation_proto_enumTypes[3]
+}
+
+func (x ActionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActionType.Descriptor instead.
+func (ActionType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{3}
+}
+
+type ExecutionStatus int32
+
+const (
+	ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED   ExecutionStatus = 0
+	ExecutionStatus_EXECUTION_STATUS_SUCCESS       ExecutionStatus = 1
+	ExecutionStatus_EXECUTION_STATUS_FAILED        ExecutionStatus = 2
+	ExecutionStatus_EXECUTION_STATUS_DRY_RUN       ExecutionStatus = 3
+	ExecutionStatus_EXECUTION_STATUS_LOOP_DETECTED ExecutionStatus = 4 // Skipped by the execution limit
+)
+
+// Enum value maps for ExecutionStatus.
+var (
+	ExecutionStatus_name = map[int32]string{
+		0: "EXECUTION_STATUS_UNSPECIFIED",
+		1: "EXECUTION_STATUS_SUCCESS",
+		2: "EXECUTION_STATUS_FAILED",
+		3: "EXECUTION_STATUS_DRY_RUN",
+		4: "EXECUTION_STATUS_LOOP_DETECTED",
+	}
+	ExecutionStatus_value = map[string]int32{
+		"EXECUTION_STATUS_UNSPECIFIED":   0,
+		"EXECUTION_STATUS_SUCCESS":       1,
+		"EXECUTION_STATUS_FAILED":        2,
+		"EXECUTION_STATUS_DRY_RUN":       3,
+		"EXECUTION_STATUS_LOOP_DETECTED": 4,
+	}
+)
+
+func (x ExecutionStatus) Enum() *ExecutionStatus {
+	p := new(ExecutionStatus)
+	*p = x
+	return p
+}
+
+func (x ExecutionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExecutionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_automation_v1_automation_proto_enumTypes[4].Descriptor()
+}
+
+func (ExecutionStatus) Type() protoreflect.EnumType {
+	return &file_proto_automation_v1_automation_proto_enumTypes[4]
+}
+
+func (x ExecutionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExecutionStatus.Descriptor instead.
+func (ExecutionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{4}
+}
+
+type SLATimerState int32
+
+const (
+	SLATimerState_SLA_TIMER_STATE_UNSPECIFIED SLATimerState = 0
+	SLATimerState_SLA_TIMER_STATE_RUNNING     SLATimerState = 1
+	SLATimerState_SLA_TIMER_STATE_PAUSED      SLATimerState = 2
+	SLATimerState_SLA_TIMER_STATE_STOPPED     SLATimerState = 3
+)
+
+// Enum value maps for SLATimerState.
+var (
+	SLATimerState_name = map[int32]string{
+		0: "SLA_TIMER_STATE_UNSPECIFIED",
+		1: "SLA_TIMER_STATE_RUNNING",
+		2: "SLA_TIMER_STATE_PAUSED",
+		3: "SLA_TIMER_STATE_STOPPED",
+	}
+	SLATimerState_value = map[string]int32{
+		"SLA_TIMER_STATE_UNSPECIFIED": 0,
+		"SLA_TIMER_STATE_RUNNING":     1,
+		"SLA_TIMER_STATE_PAUSED":      2,
+		"SLA_TIMER_STATE_STOPPED":     3,
+	}
+)
+
+func (x SLATimerState) Enum() *SLATimerState {
+	p := new(SLATimerState)
+	*p = x
+	return p
+}
+
+func (x SLATimerState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SLATimerState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_automation_v1_automation_proto_enumTypes[5].Descriptor()
+}
+
+func (SLATimerState) Type() protoreflect.EnumType {
+	return &file_proto_automation_v1_automation_proto_enumTypes[5]
+}
+
+func (x SLATimerState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SLATimerState.Descriptor instead.
+func (SLATimerState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{5}
+}
+
+// Rule runs its actions when an event of its trigger type matches all of its
+// conditions. Scheduled rules run on a timer against the issues their
+// schedule selects instead.
+type Rule struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId   string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Event type that triggers the rule, e.g. "issue.updated",
+	// "git.pull_request_merged" or "sla.breached", or "scheduled"
+	TriggerEvent string       `protobuf:"bytes,5,opt,name=trigger_event,json=triggerEvent,proto3" json:"trigger_event,omitempty"`
+	Conditions   []*Condition `protobuf:"bytes,6,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Actions      []*Action    `protobuf:"bytes,7,rep,name=actions,proto3" json:"actions,omitempty"`
+	Enabled      bool         `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Dry-run rules record the actions they would take without taking them
+	DryRun bool `protobuf:"varint,9,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Lets the rule run on changes made by other rules. Loops are cut off by
+	// an execution limit per issue.
+	AllowAutomationTriggers bool                   `protobuf:"varint,10,opt,name=allow_automation_triggers,json=allowAutomationTriggers,proto3" json:"allow_automation_triggers,omitempty"`
+	CreatedBy               string                 `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt               *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt               *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Schedule                *RuleSchedule          `protobuf:"bytes,14,opt,name=schedule,proto3" json:"schedule,omitempty"` // Scheduled rules only
+	NextRunAt               *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	LastRunAt               *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Rule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Rule) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *Rule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Rule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Rule) GetTriggerEvent() string {
+	if x != nil {
+		return x.TriggerEvent
+	}
+	return ""
+}
+
+func (x *Rule) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *Rule) GetActions() []*Action {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *Rule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Rule) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *Rule) GetAllowAutomationTriggers() bool {
+	if x != nil {
+		return x.AllowAutomationTriggers
+	}
+	return false
+}
+
+func (x *Rule) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Rule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Rule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Rule) GetSchedule() *RuleSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *Rule) GetNextRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return nil
+}
+
+func (x *Rule) GetLastRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return nil
+}
+
+// RuleSchedule is how often a scheduled rule runs and which issues of its
+// project it checks
+type RuleSchedule struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IntervalMinutes int32                  `protobuf:"varint,1,opt,name=interval_minutes,json=intervalMinutes,proto3" json:"interval_minutes,omitempty"` // At least 5
+	StaleMinutes    int32                  `protobuf:"varint,2,opt,name=stale_minutes,json=staleMinutes,proto3" json:"stale_minutes,omitempty"`          // Only issues not updated for this long
+	StatusIds       []string               `protobuf:"bytes,3,rep,name=status_ids,json=statusIds,proto3" json:"status_ids,omitempty"`
+	IssueTypes      []string               `protobuf:"bytes,4,rep,name=issue_types,json=issueTypes,proto3" json:"issue_types,omitempty"` // e.g. "bug"
+	Priorities      []string               `protobuf:"bytes,5,rep,name=priorities,proto3" json:"priorities,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RuleSchedule) Reset() {
+	*x = RuleSchedule{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleSchedule) ProtoMessage() {}
+
+func (x *RuleSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleSchedule.ProtoReflect.Descriptor instead.
+func (*RuleSchedule) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RuleSchedule) GetIntervalMinutes() int32 {
+	if x != nil {
+		return x.IntervalMinutes
+	}
+	return 0
+}
+
+func (x *RuleSchedule) GetStaleMinutes() int32 {
+	if x != nil {
+		return x.StaleMinutes
+	}
+	return 0
+}
+
+func (x *RuleSchedule) GetStatusIds() []string {
+	if x != nil {
+		return x.StatusIds
+	}
+	return nil
+}
+
+func (x *RuleSchedule) GetIssueTypes() []string {
+	if x != nil {
+		return x.IssueTypes
+	}
+	return nil
+}
+
+func (x *RuleSchedule) GetPriorities() []string {
+	if x != nil {
+		return x.Priorities
+	}
+	return nil
+}
+
+type Condition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  ConditionType          `protobuf:"varint,1,opt,name=type,proto3,enum=nexusflow.automation.v1.ConditionType" json:"type,omitempty"`
+	// Field path for field conditions: "event.type", "event.user_id",
+	// "payload.<key>[.<key>]", "issue.<field>" or "parent.<field>". For
+	// example "payload.changes.priority.to" is the new priority of an update.
+	Field         string            `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Operator      ConditionOperator `protobuf:"varint,3,opt,name=operator,proto3,enum=nexusflow.automation.v1.ConditionOperator" json:"operator,omitempty"`
+	Values        []string          `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty"`
+	Target        Target            `protobuf:"varint,5,opt,name=target,proto3,enum=nexusflow.automation.v1.Target" json:"target,omitempty"` // Sub-task conditions; defaults to the issue
+	StatusIds     []string          `protobuf:"bytes,6,rep,name=status_ids,json=statusIds,proto3" json:"status_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Condition) Reset() {
+	*x = Condition{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Condition) ProtoMessage() {}
+
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Condition) GetType() ConditionType {
+	if x != nil {
+		return x.Type
+	}
+	return ConditionType_CONDITION_TYPE_UNSPECIFIED
+}
+
+func (x *Condition) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Condition) GetOperator() ConditionOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return ConditionOperator_CONDITION_OPERATOR_UNSPECIFIED
+}
+
+func (x *Condition) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Condition) GetTarget() Target {
+	if x != nil {
+		return x.Target
+	}
+	return Target_TARGET_UNSPECIFIED
+}
+
+func (x *Condition) GetStatusIds() []string {
+	if x != nil {
+		return x.StatusIds
+	}
+	return nil
+}
+
+// Action of a rule. Text fields and user IDs may reference the same paths as
+// conditions, e.g. "{{issue.key}}" or "{{issue.assignee_id}}".
+type Action struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          ActionType             `protobuf:"varint,1,opt,name=type,proto3,enum=nexusflow.automation.v1.ActionType" json:"type,omitempty"`
+	Target        Target                 `protobuf:"varint,2,opt,name=target,proto3,enum=nexusflow.automation.v1.Target" json:"target,omitempty"` // Defaults to the issue
+	StatusId      string                 `protobuf:"bytes,3,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	AssigneeId    string                 `protobuf:"bytes,4,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	Priority      string                 `protobuf:"bytes,5,opt,name=priority,proto3" json:"priority,omitempty"` // lowest, low, medium, high or highest
+	UserIds       []string               `protobuf:"bytes,6,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Title         string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	Message       string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"` // Notification or comment text
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Action) Reset() {
+	*x = Action{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Action) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Action) ProtoMessage() {}
+
+func (x *Action) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Action.ProtoReflect.Descriptor instead.
+func (*Action) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Action) GetType() ActionType {
+	if x != nil {
+		return x.Type
+	}
+	return ActionType_ACTION_TYPE_UNSPECIFIED
+}
+
+func (x *Action) GetTarget() Target {
+	if x != nil {
+		return x.Target
+	}
+	return Target_TARGET_UNSPECIFIED
+}
+
+func (x *Action) GetStatusId() string {
+	if x != nil {
+		return x.StatusId
+	}
+	return ""
+}
+
+func (x *Action) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+func (x *Action) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *Action) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *Action) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Action) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ActionResult is the outcome of one action of an execution
+type ActionResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          ActionType             `protobuf:"varint,1,opt,name=type,proto3,enum=nexusflow.automation.v1.ActionType" json:"type,omitempty"`
+	IssueId       string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // done, planned, unchanged or failed
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActionResult) Reset() {
+	*x = ActionResult{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionResult) ProtoMessage() {}
+
+func (x *ActionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionResult.ProtoReflect.Descriptor instead.
+func (*ActionResult) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ActionResult) GetType() ActionType {
+	if x != nil {
+		return x.Type
+	}
+	return ActionType_ACTION_TYPE_UNSPECIFIED
+}
+
+func (x *ActionResult) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *ActionResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ActionResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// RuleExecution is an entry of a rule's audit log
+type RuleExecution struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,4,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType     string                 `protobuf:"bytes,5,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	IssueId       string                 `protobuf:"bytes,6,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Status        ExecutionStatus        `protobuf:"varint,7,opt,name=status,proto3,enum=nexusflow.automation.v1.ExecutionStatus" json:"status,omitempty"`
+	Actions       []*ActionResult        `protobuf:"bytes,8,rep,name=actions,proto3" json:"actions,omitempty"`
+	Error         string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleExecution) Reset() {
+	*x = RuleExecution{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleExecution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleExecution) ProtoMessage() {}
+
+func (x *RuleExecution) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleExecution.ProtoReflect.Descriptor instead.
+func (*RuleExecution) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RuleExecution) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RuleExecution) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *RuleExecution) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *RuleExecution) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *RuleExecution) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *RuleExecution) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *RuleExecution) GetStatus() ExecutionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED
+}
+
+func (x *RuleExecution) GetActions() []*ActionResult {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *RuleExecution) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RuleExecution) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// BusinessCalendar is the working time SLA targets are counted in
+type BusinessCalendar struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA name; defaults to UTC
+	WorkingHours  []*WorkingHours        `protobuf:"bytes,5,rep,name=working_hours,json=workingHours,proto3" json:"working_hours,omitempty"`
+	Holidays      []string               `protobuf:"bytes,6,rep,name=holidays,proto3" json:"holidays,omitempty"` // Dates, e.g. "2026-12-25"
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BusinessCalendar) Reset() {
+	*x = BusinessCalendar{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BusinessCalendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BusinessCalendar) ProtoMessage() {}
+
+func (x *BusinessCalendar) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BusinessCalendar.ProtoReflect.Descriptor instead.
+func (*BusinessCalendar) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BusinessCalendar) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BusinessCalendar) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *BusinessCalendar) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BusinessCalendar) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *BusinessCalendar) GetWorkingHours() []*WorkingHours {
+	if x != nil {
+		return x.WorkingHours
+	}
+	return nil
+}
+
+func (x *BusinessCalendar) GetHolidays() []string {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+func (x *BusinessCalendar) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *BusinessCalendar) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// WorkingHours is a period of working time on a weekday
+type WorkingHours struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weekday       int32                  `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday,omitempty"` // 0 is Sunday
+	Start         string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`      // "09:00"
+	End           string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`          // "17:30"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkingHours) Reset() {
+	*x = WorkingHours{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkingHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkingHours) ProtoMessage() {}
+
+func (x *WorkingHours) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkingHours.ProtoReflect.Descriptor instead.
+func (*WorkingHours) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WorkingHours) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *WorkingHours) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *WorkingHours) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+// SLADefinition is a time target for the issues of a project. Its timer
+// runs while an issue is in a start category, pauses in a pause category and
+// stops in a stop category. Categories are "todo", "in_progress" and "done".
+type SLADefinition struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId       string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	TargetMinutes   int32                  `protobuf:"varint,5,opt,name=target_minutes,json=targetMinutes,proto3" json:"target_minutes,omitempty"`
+	WarningMinutes  int32                  `protobuf:"varint,6,opt,name=warning_minutes,json=warningMinutes,proto3" json:"warning_minutes,omitempty"` // Warn this long before the target; 0 for none
+	CalendarId      string                 `protobuf:"bytes,7,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`              // Empty counts around the clock
+	IssueTypes      []string               `protobuf:"bytes,8,rep,name=issue_types,json=issueTypes,proto3" json:"issue_types,omitempty"`              // Empty applies to every issue
+	Priorities      []string               `protobuf:"bytes,9,rep,name=priorities,proto3" json:"priorities,omitempty"`
+	StartCategories []string               `protobuf:"bytes,10,rep,name=start_categories,json=startCategories,proto3" json:"start_categories,omitempty"` // Empty starts in any category that does not stop
+	PauseCategories []string               `protobuf:"bytes,11,rep,name=pause_categories,json=pauseCategories,proto3" json:"pause_categories,omitempty"`
+	StopCategories  []string               `protobuf:"bytes,12,rep,name=stop_categories,json=stopCategories,proto3" json:"stop_categories,omitempty"`
+	Enabled         bool                   `protobuf:"varint,13,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SLADefinition) Reset() {
+	*x = SLADefinition{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLADefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLADefinition) ProtoMessage() {}
+
+func (x *SLADefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLADefinition.ProtoReflect.Descriptor instead.
+func (*SLADefinition) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SLADefinition) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SLADefinition) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *SLADefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SLADefinition) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SLADefinition) GetTargetMinutes() int32 {
+	if x != nil {
+		return x.TargetMinutes
+	}
+	return 0
+}
+
+func (x *SLADefinition) GetWarningMinutes() int32 {
+	if x != nil {
+		return x.WarningMinutes
+	}
+	return 0
+}
+
+func (x *SLADefinition) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *SLADefinition) GetIssueTypes() []string {
+	if x != nil {
+		return x.IssueTypes
+	}
+	return nil
+}
+
+func (x *SLADefinition) GetPriorities() []string {
+	if x != nil {
+		return x.Priorities
+	}
+	return nil
+}
+
+func (x *SLADefinition) GetStartCategories() []string {
+	if x != nil {
+		return x.StartCategories
+	}
+	return nil
+}
+
+func (x *SLADefinition) GetPauseCategories() []string {
+	if x != nil {
+		return x.PauseCategories
+	}
+	return nil
+}
+
+func (x *SLADefinition) GetStopCategories() []string {
+	if x != nil {
+		return x.StopCategories
+	}
+	return nil
+}
+
+func (x *SLADefinition) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SLADefinition) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SLADefinition) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// IssueSLA is the state of an SLA on an issue. Times are business time.
+type IssueSLA struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SlaId            string                 `protobuf:"bytes,1,opt,name=sla_id,json=slaId,proto3" json:"sla_id,omitempty"`
+	SlaName          string                 `protobuf:"bytes,2,opt,name=sla_name,json=slaName,proto3" json:"sla_name,omitempty"`
+	IssueId          string                 `protobuf:"bytes,3,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	State            SLATimerState          `protobuf:"varint,4,opt,name=state,proto3,enum=nexusflow.automation.v1.SLATimerState" json:"state,omitempty"`
+	TargetSeconds    int64                  `protobuf:"varint,5,opt,name=target_seconds,json=targetSeconds,proto3" json:"target_seconds,omitempty"`
+	ElapsedSeconds   int64                  `protobuf:"varint,6,opt,name=elapsed_seconds,json=elapsedSeconds,proto3" json:"elapsed_seconds,omitempty"`
+	RemainingSeconds int64                  `protobuf:"varint,7,opt,name=remaining_seconds,json=remainingSeconds,proto3" json:"remaining_seconds,omitempty"` // Negative once breached
+	DueAt            *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`                                   // While running
+	Breached         bool                   `protobuf:"varint,9,opt,name=breached,proto3" json:"breached,omitempty"`
+	StartedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	StoppedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=stopped_at,json=stoppedAt,proto3" json:"stopped_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *IssueSLA) Reset() {
+	*x = IssueSLA{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueSLA) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueSLA) ProtoMessage() {}
+
+func (x *IssueSLA) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueSLA.ProtoReflect.Descriptor instead.
+func (*IssueSLA) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *IssueSLA) GetSlaId() string {
+	if x != nil {
+		return x.SlaId
+	}
+	return ""
+}
+
+func (x *IssueSLA) GetSlaName() string {
+	if x != nil {
+		return x.SlaName
+	}
+	return ""
+}
+
+func (x *IssueSLA) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *IssueSLA) GetState() SLATimerState {
+	if x != nil {
+		return x.State
+	}
+	return SLATimerState_SLA_TIMER_STATE_UNSPECIFIED
+}
+
+func (x *IssueSLA) GetTargetSeconds() int64 {
+	if x != nil {
+		return x.TargetSeconds
+	}
+	return 0
+}
+
+func (x *IssueSLA) GetElapsedSeconds() int64 {
+	if x != nil {
+		return x.ElapsedSeconds
+	}
+	return 0
+}
+
+func (x *IssueSLA) GetRemainingSeconds() int64 {
+	if x != nil {
+		return x.RemainingSeconds
+	}
+	return 0
+}
+
+func (x *IssueSLA) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *IssueSLA) GetBreached() bool {
+	if x != nil {
+		return x.Breached
+	}
+	return false
+}
+
+func (x *IssueSLA) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *IssueSLA) GetStoppedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StoppedAt
+	}
+	return nil
+}
+
+type CreateRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *Rule                  `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRuleRequest) Reset() {
+	*x = CreateRuleRequest{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRuleRequest) ProtoMessage() {}
+
+func (x *CreateRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateRuleRequest) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type CreateRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *Rule                  `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRuleResponse) Reset() {
+	*x = CreateRuleResponse{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRuleResponse) ProtoMessage() {}
+
+func (x *CreateRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateRuleResponse) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type GetRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRuleRequest) Reset() {
+	*x = GetRuleRequest{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleRequest) ProtoMessage() {}
+
+func (x *GetRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *Rule                  `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRuleResponse) Reset() {
+	*x = GetRuleResponse{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleResponse) ProtoMessage() {}
+
+func (x *GetRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleResponse.ProtoReflect.Descriptor instead.
+func (*GetRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetRuleResponse) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// UpdateRuleRequest replaces the rule; its project cannot change
+type UpdateRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rule          *Rule                  `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRuleRequest) Reset() {
+	*x = UpdateRuleRequest{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRuleRequest) ProtoMessage() {}
+
+func (x *UpdateRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateRuleRequest) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *Rule                  `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRuleResponse) Reset() {
+	*x = UpdateRuleResponse{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRuleResponse) ProtoMessage() {}
+
+func (x *UpdateRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateRuleResponse) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRuleRequest) Reset() {
+	*x = DeleteRuleRequest{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRuleRequest) ProtoMessage() {}
+
+func (x *DeleteRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRuleResponse) Reset() {
+	*x = DeleteRuleResponse{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRuleResponse) ProtoMessage() {}
+
+func (x *DeleteRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{17}
+}
+
+type ListRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRulesRequest) Reset() {
+	*x = ListRulesRequest{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRulesRequest) ProtoMessage() {}
+
+func (x *ListRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListRulesRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type ListRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*Rule                `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRulesResponse) Reset() {
+	*x = ListRulesResponse{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRulesResponse) ProtoMessage() {}
+
+func (x *ListRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListRulesResponse) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type ListRuleExecutionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Pagination    *v1.PaginationRequest  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"` // Page based
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRuleExecutionsRequest) Reset() {
+	*x = ListRuleExecutionsRequest{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRuleExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRuleExecutionsRequest) ProtoMessage() {}
+
+func (x *ListRuleExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRuleExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRuleExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListRuleExecutionsRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *ListRuleExecutionsRequest) GetPagination() *v1.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListRuleExecutionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Executions    []*RuleExecution       `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"` // Newest first
+	Pagination    *v1.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRuleExecutionsResponse) Reset() {
+	*x = ListRuleExecutionsResponse{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRuleExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRuleExecutionsResponse) ProtoMessage() {}
+
+func (x *ListRuleExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRuleExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRuleExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListRuleExecutionsResponse) GetExecutions() []*RuleExecution {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+func (x *ListRuleExecutionsResponse) GetPagination() *v1.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type CreateBusinessCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendar      *BusinessCalendar      `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBusinessCalendarRequest) Reset() {
+	*x = CreateBusinessCalendarRequest{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBusinessCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBusinessCalendarRequest) ProtoMessage() {}
+
+func (x *CreateBusinessCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBusinessCalendarRequest.ProtoReflect.Descriptor instead.
+func (*CreateBusinessCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateBusinessCalendarRequest) GetCalendar() *BusinessCalendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+type CreateBusinessCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendar      *BusinessCalendar      `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBusinessCalendarResponse) Reset() {
+	*x = CreateBusinessCalendarResponse{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBusinessCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBusinessCalendarResponse) ProtoMessage() {}
+
+func (x *CreateBusinessCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBusinessCalendarResponse.ProtoReflect.Descriptor instead.
+func (*CreateBusinessCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateBusinessCalendarResponse) GetCalendar() *BusinessCalendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+type GetBusinessCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBusinessCalendarRequest) Reset() {
+	*x = GetBusinessCalendarRequest{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBusinessCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBusinessCalendarRequest) ProtoMessage() {}
+
+func (x *GetBusinessCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBusinessCalendarRequest.ProtoReflect.Descriptor instead.
+func (*GetBusinessCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetBusinessCalendarRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetBusinessCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendar      *BusinessCalendar      `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBusinessCalendarResponse) Reset() {
+	*x = GetBusinessCalendarResponse{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBusinessCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBusinessCalendarResponse) ProtoMessage() {}
+
+func (x *GetBusinessCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBusinessCalendarResponse.ProtoReflect.Descriptor instead.
+func (*GetBusinessCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetBusinessCalendarResponse) GetCalendar() *BusinessCalendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+// UpdateBusinessCalendarRequest replaces the calendar; its project cannot
+// change
+type UpdateBusinessCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Calendar      *BusinessCalendar      `protobuf:"bytes,2,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBusinessCalendarRequest) Reset() {
+	*x = UpdateBusinessCalendarRequest{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBusinessCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBusinessCalendarRequest) ProtoMessage() {}
+
+func (x *UpdateBusinessCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBusinessCalendarRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBusinessCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateBusinessCalendarRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateBusinessCalendarRequest) GetCalendar() *BusinessCalendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+type UpdateBusinessCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendar      *BusinessCalendar      `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBusinessCalendarResponse) Reset() {
+	*x = UpdateBusinessCalendarResponse{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBusinessCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBusinessCalendarResponse) ProtoMessage() {}
+
+func (x *UpdateBusinessCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBusinessCalendarResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBusinessCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateBusinessCalendarResponse) GetCalendar() *BusinessCalendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+type DeleteBusinessCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBusinessCalendarRequest) Reset() {
+	*x = DeleteBusinessCalendarRequest{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBusinessCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBusinessCalendarRequest) ProtoMessage() {}
+
+func (x *DeleteBusinessCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBusinessCalendarRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBusinessCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteBusinessCalendarRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteBusinessCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBusinessCalendarResponse) Reset() {
+	*x = DeleteBusinessCalendarResponse{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBusinessCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBusinessCalendarResponse) ProtoMessage() {}
+
+func (x *DeleteBusinessCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBusinessCalendarResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBusinessCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{29}
+}
+
+type ListBusinessCalendarsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBusinessCalendarsRequest) Reset() {
+	*x = ListBusinessCalendarsRequest{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBusinessCalendarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBusinessCalendarsRequest) ProtoMessage() {}
+
+func (x *ListBusinessCalendarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBusinessCalendarsRequest.ProtoReflect.Descriptor instead.
+func (*ListBusinessCalendarsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListBusinessCalendarsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type ListBusinessCalendarsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendars     []*BusinessCalendar    `protobuf:"bytes,1,rep,name=calendars,proto3" json:"calendars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBusinessCalendarsResponse) Reset() {
+	*x = ListBusinessCalendarsResponse{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBusinessCalendarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBusinessCalendarsResponse) ProtoMessage() {}
+
+func (x *ListBusinessCalendarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBusinessCalendarsResponse.ProtoReflect.Descriptor instead.
+func (*ListBusinessCalendarsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListBusinessCalendarsResponse) GetCalendars() []*BusinessCalendar {
+	if x != nil {
+		return x.Calendars
+	}
+	return nil
+}
+
+type CreateSLADefinitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sla           *SLADefinition         `protobuf:"bytes,1,opt,name=sla,proto3" json:"sla,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSLADefinitionRequest) Reset() {
+	*x = CreateSLADefinitionRequest{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSLADefinitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSLADefinitionRequest) ProtoMessage() {}
+
+func (x *CreateSLADefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSLADefinitionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSLADefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateSLADefinitionRequest) GetSla() *SLADefinition {
+	if x != nil {
+		return x.Sla
+	}
+	return nil
+}
+
+type CreateSLADefinitionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sla           *SLADefinition         `protobuf:"bytes,1,opt,name=sla,proto3" json:"sla,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSLADefinitionResponse) Reset() {
+	*x = CreateSLADefinitionResponse{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSLADefinitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSLADefinitionResponse) ProtoMessage() {}
+
+func (x *CreateSLADefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSLADefinitionResponse.ProtoReflect.Descriptor instead.
+func (*CreateSLADefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateSLADefinitionResponse) GetSla() *SLADefinition {
+	if x != nil {
+		return x.Sla
+	}
+	return nil
+}
+
+type GetSLADefinitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSLADefinitionRequest) Reset() {
+	*x = GetSLADefinitionRequest{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSLADefinitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSLADefinitionRequest) ProtoMessage() {}
+
+func (x *GetSLADefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSLADefinitionRequest.ProtoReflect.Descriptor instead.
+func (*GetSLADefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetSLADefinitionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetSLADefinitionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sla           *SLADefinition         `protobuf:"bytes,1,opt,name=sla,proto3" json:"sla,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSLADefinitionResponse) Reset() {
+	*x = GetSLADefinitionResponse{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSLADefinitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSLADefinitionResponse) ProtoMessage() {}
+
+func (x *GetSLADefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSLADefinitionResponse.ProtoReflect.Descriptor instead.
+func (*GetSLADefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetSLADefinitionResponse) GetSla() *SLADefinition {
+	if x != nil {
+		return x.Sla
+	}
+	return nil
+}
+
+// UpdateSLADefinitionRequest replaces the SLA; its project cannot change
+type UpdateSLADefinitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sla           *SLADefinition         `protobuf:"bytes,2,opt,name=sla,proto3" json:"sla,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSLADefinitionRequest) Reset() {
+	*x = UpdateSLADefinitionRequest{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSLADefinitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSLADefinitionRequest) ProtoMessage() {}
+
+func (x *UpdateSLADefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSLADefinitionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSLADefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateSLADefinitionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSLADefinitionRequest) GetSla() *SLADefinition {
+	if x != nil {
+		return x.Sla
+	}
+	return nil
+}
+
+type UpdateSLADefinitionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sla           *SLADefinition         `protobuf:"bytes,1,opt,name=sla,proto3" json:"sla,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSLADefinitionResponse) Reset() {
+	*x = UpdateSLADefinitionResponse{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSLADefinitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSLADefinitionResponse) ProtoMessage() {}
+
+func (x *UpdateSLADefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSLADefinitionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSLADefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateSLADefinitionResponse) GetSla() *SLADefinition {
+	if x != nil {
+		return x.Sla
+	}
+	return nil
+}
+
+type DeleteSLADefinitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSLADefinitionRequest) Reset() {
+	*x = DeleteSLADefinitionRequest{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSLADefinitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSLADefinitionRequest) ProtoMessage() {}
+
+func (x *DeleteSLADefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSLADefinitionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSLADefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeleteSLADefinitionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteSLADefinitionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSLADefinitionResponse) Reset() {
+	*x = DeleteSLADefinitionResponse{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSLADefinitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSLADefinitionResponse) ProtoMessage() {}
+
+func (x *DeleteSLADefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSLADefinitionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSLADefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{39}
+}
+
+type ListSLADefinitionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSLADefinitionsRequest) Reset() {
+	*x = ListSLADefinitionsRequest{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSLADefinitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSLADefinitionsRequest) ProtoMessage() {}
+
+func (x *ListSLADefinitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSLADefinitionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSLADefinitionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListSLADefinitionsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type ListSLADefinitionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slas          []*SLADefinition       `protobuf:"bytes,1,rep,name=slas,proto3" json:"slas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSLADefinitionsResponse) Reset() {
+	*x = ListSLADefinitionsResponse{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSLADefinitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSLADefinitionsResponse) ProtoMessage() {}
+
+func (x *ListSLADefinitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSLADefinitionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSLADefinitionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListSLADefinitionsResponse) GetSlas() []*SLADefinition {
+	if x != nil {
+		return x.Slas
+	}
+	return nil
+}
+
+type ListIssueSLAsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssueSLAsRequest) Reset() {
+	*x = ListIssueSLAsRequest{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssueSLAsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssueSLAsRequest) ProtoMessage() {}
+
+func (x *ListIssueSLAsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIssueSLAsRequest.ProtoReflect.Descriptor instead.
+func (*ListIssueSLAsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListIssueSLAsRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+type ListIssueSLAsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slas          []*IssueSLA            `protobuf:"bytes,1,rep,name=slas,proto3" json:"slas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssueSLAsResponse) Reset() {
+	*x = ListIssueSLAsResponse{}
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssueSLAsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssueSLAsResponse) ProtoMessage() {}
+
+func (x *ListIssueSLAsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_automation_v1_automation_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIssueSLAsResponse.ProtoReflect.Descriptor instead.
+func (*ListIssueSLAsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_automation_v1_automation_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListIssueSLAsResponse) GetSlas() []*IssueSLA {
+	if x != nil {
+		return x.Slas
+	}
+	return nil
+}
+
+var File_proto_automation_v1_automation_proto protoreflect.FileDescriptor
+
+const file_proto_automation_v1_automation_proto_rawDesc = "" +
+	"\n" +
+	"$proto/automation/v1/automation.proto\x12\x17nexusflow.automation.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cproto/common/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\"\xce\x05\n" +
+	"\x04Rule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12#\n" +
+	"\rtrigger_event\x18\x05 \x01(\tR\ftriggerEvent\x12B\n" +
+	"\n" +
+	"conditions\x18\x06 \x03(\v2\".nexusflow.automation.v1.ConditionR\n" +
+	"conditions\x129\n" +
+	"\aactions\x18\a \x03(\v2\x1f.nexusflow.automation.v1.ActionR\aactions\x12\x18\n" +
+	"\aenabled\x18\b \x01(\bR\aenabled\x12\x17\n" +
+	"\adry_run\x18\t \x01(\bR\x06dryRun\x12:\n" +
+	"\x19allow_automation_triggers\x18\n" +
+	" \x01(\bR\x17allowAutomationTriggers\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\v \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12A\n" +
+	"\bschedule\x18\x0e \x01(\v2%.nexusflow.automation.v1.RuleScheduleR\bschedule\x12:\n" +
+	"\vnext_run_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tnextRunAt\x12:\n" +
+	"\vlast_run_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tlastRunAt\"\xbe\x01\n" +
+	"\fRuleSchedule\x12)\n" +
+	"\x10interval_minutes\x18\x01 \x01(\x05R\x0fintervalMinutes\x12#\n" +
+	"\rstale_minutes\x18\x02 \x01(\x05R\fstaleMinutes\x12\x1d\n" +
+	"\n" +
+	"status_ids\x18\x03 \x03(\tR\tstatusIds\x12\x1f\n" +
+	"\vissue_types\x18\x04 \x03(\tR\n" +
+	"issueTypes\x12\x1e\n" +
+	"\n" +
+	"priorities\x18\x05 \x03(\tR\n" +
+	"priorities\"\x95\x02\n" +
+	"\tCondition\x12:\n" +
+	"\x04type\x18\x01 \x01(\x0e2&.nexusflow.automation.v1.ConditionTypeR\x04type\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12F\n" +
+	"\boperator\x18\x03 \x01(\x0e2*.nexusflow.automation.v1.ConditionOperatorR\boperator\x12\x16\n" +
+	"\x06values\x18\x04 \x03(\tR\x06values\x127\n" +
+	"\x06target\x18\x05 \x01(\x0e2\x1f.nexusflow.automation.v1.TargetR\x06target\x12\x1d\n" +
+	"\n" +
+	"status_ids\x18\x06 \x03(\tR\tstatusIds\"\x9f\x02\n" +
+	"\x06Action\x127\n" +
+	"\x04type\x18\x01 \x01(\x0e2#.nexusflow.automation.v1.ActionTypeR\x04type\x127\n" +
+	"\x06target\x18\x02 \x01(\x0e2\x1f.nexusflow.automation.v1.TargetR\x06target\x12\x1b\n" +
+	"\tstatus_id\x18\x03 \x01(\tR\bstatusId\x12\x1f\n" +
+	"\vassignee_id\x18\x04 \x01(\tR\n" +
+	"assigneeId\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\tR\bpriority\x12\x19\n" +
+	"\buser_ids\x18\x06 \x03(\tR\auserIds\x12\x14\n" +
+	"\x05title\x18\a \x01(\tR\x05title\x12\x18\n" +
+	"\amessage\x18\b \x01(\tR\amessage\"\x90\x01\n" +
+	"\fActionResult\x127\n" +
+	"\x04type\x18\x01 \x01(\x0e2#.nexusflow.automation.v1.ActionTypeR\x04type\x12\x19\n" +
+	"\bissue_id\x18\x02 \x01(\tR\aissueId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\x80\x03\n" +
+	"\rRuleExecution\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\tR\x06ruleId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x03 \x01(\tR\tprojectId\x12\x19\n" +
+	"\bevent_id\x18\x04 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x05 \x01(\tR\teventType\x12\x19\n" +
+	"\bissue_id\x18\x06 \x01(\tR\aissueId\x12@\n" +
+	"\x06status\x18\a \x01(\x0e2(.nexusflow.automation.v1.ExecutionStatusR\x06status\x12?\n" +
+	"\aactions\x18\b \x03(\v2%.nexusflow.automation.v1.ActionResultR\aactions\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xcf\x02\n" +
+	"\x10BusinessCalendar\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12J\n" +
+	"\rworking_hours\x18\x05 \x03(\v2%.nexusflow.automation.v1.WorkingHoursR\fworkingHours\x12\x1a\n" +
+	"\bholidays\x18\x06 \x03(\tR\bholidays\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"P\n" +
+	"\fWorkingHours\x12\x18\n" +
+	"\aweekday\x18\x01 \x01(\x05R\aweekday\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\"\xb5\x04\n" +
+	"\rSLADefinition\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12%\n" +
+	"\x0etarget_minutes\x18\x05 \x01(\x05R\rtargetMinutes\x12'\n" +
+	"\x0fwarning_minutes\x18\x06 \x01(\x05R\x0ewarningMinutes\x12\x1f\n" +
+	"\vcalendar_id\x18\a \x01(\tR\n" +
+	"calendarId\x12\x1f\n" +
+	"\vissue_types\x18\b \x03(\tR\n" +
+	"issueTypes\x12\x1e\n" +
+	"\n" +
+	"priorities\x18\t \x03(\tR\n" +
+	"priorities\x12)\n" +
+	"\x10start_categories\x18\n" +
+	" \x03(\tR\x0fstartCategories\x12)\n" +
+	"\x10pause_categories\x18\v \x03(\tR\x0fpauseCategories\x12'\n" +
+	"\x0fstop_categories\x18\f \x03(\tR\x0estopCategories\x12\x18\n" +
+	"\aenabled\x18\r \x01(\bR\aenabled\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd7\x03\n" +
+	"\bIssueSLA\x12\x15\n" +
+	"\x06sla_id\x18\x01 \x01(\tR\x05slaId\x12\x19\n" +
+	"\bsla_name\x18\x02 \x01(\tR\aslaName\x12\x19\n" +
+	"\bissue_id\x18\x03 \x01(\tR\aissueId\x12<\n" +
+	"\x05state\x18\x04 \x01(\x0e2&.nexusflow.automation.v1.SLATimerStateR\x05state\x12%\n" +
+	"\x0etarget_seconds\x18\x05 \x01(\x03R\rtargetSeconds\x12'\n" +
+	"\x0felapsed_seconds\x18\x06 \x01(\x03R\x0eelapsedSeconds\x12+\n" +
+	"\x11remaining_seconds\x18\a \x01(\x03R\x10remainingSeconds\x121\n" +
+	"\x06due_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x1a\n" +
+	"\bbreached\x18\t \x01(\bR\bbreached\x129\n" +
+	"\n" +
+	"started_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x129\n" +
+	"\n" +
+	"stopped_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tstoppedAt\"F\n" +
+	"\x11CreateRuleRequest\x121\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1d.nexusflow.automation.v1.RuleR\x04rule\"G\n" +
+	"\x12CreateRuleResponse\x121\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1d.nexusflow.automation.v1.RuleR\x04rule\" \n" +
+	"\x0eGetRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"D\n" +
+	"\x0fGetRuleResponse\x121\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1d.nexusflow.automation.v1.RuleR\x04rule\"V\n" +
+	"\x11UpdateRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x121\n" +
+	"\x04rule\x18\x02 \x01(\v2\x1d.nexusflow.automation.v1.RuleR\x04rule\"G\n" +
+	"\x12UpdateRuleResponse\x121\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1d.nexusflow.automation.v1.RuleR\x04rule\"#\n" +
+	"\x11DeleteRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
+	"\x12DeleteRuleResponse\"1\n" +
+	"\x10ListRulesRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"H\n" +
+	"\x11ListRulesResponse\x123\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1d.nexusflow.automation.v1.RuleR\x05rules\"|\n" +
+	"\x19ListRuleExecutionsRequest\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12F\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2&.nexusflow.common.v1.PaginationRequestR\n" +
+	"pagination\"\xad\x01\n" +
+	"\x1aListRuleExecutionsResponse\x12F\n" +
+	"\n" +
+	"executions\x18\x01 \x03(\v2&.nexusflow.automation.v1.RuleExecutionR\n" +
+	"executions\x12G\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2'.nexusflow.common.v1.PaginationResponseR\n" +
+	"pagination\"f\n" +
+	"\x1dCreateBusinessCalendarRequest\x12E\n" +
+	"\bcalendar\x18\x01 \x01(\v2).nexusflow.automation.v1.BusinessCalendarR\bcalendar\"g\n" +
+	"\x1eCreateBusinessCalendarResponse\x12E\n" +
+	"\bcalendar\x18\x01 \x01(\v2).nexusflow.automation.v1.BusinessCalendarR\bcalendar\",\n" +
+	"\x1aGetBusinessCalendarRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"d\n" +
+	"\x1bGetBusinessCalendarResponse\x12E\n" +
+	"\bcalendar\x18\x01 \x01(\v2).nexusflow.automation.v1.BusinessCalendarR\bcalendar\"v\n" +
+	"\x1dUpdateBusinessCalendarRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12E\n" +
+	"\bcalendar\x18\x02 \x01(\v2).nexusflow.automation.v1.BusinessCalendarR\bcalendar\"g\n" +
+	"\x1eUpdateBusinessCalendarResponse\x12E\n" +
+	"\bcalendar\x18\x01 \x01(\v2).nexusflow.automation.v1.BusinessCalendarR\bcalendar\"/\n" +
+	"\x1dDeleteBusinessCalendarRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\" \n" +
+	"\x1eDeleteBusinessCalendarResponse\"=\n" +
+	"\x1cListBusinessCalendarsRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"h\n" +
+	"\x1dListBusinessCalendarsResponse\x12G\n" +
+	"\tcalendars\x18\x01 \x03(\v2).nexusflow.automation.v1.BusinessCalendarR\tcalendars\"V\n" +
+	"\x1aCreateSLADefinitionRequest\x128\n" +
+	"\x03sla\x18\x01 \x01(\v2&.nexusflow.automation.v1.SLADefinitionR\x03sla\"W\n" +
+	"\x1bCreateSLADefinitionResponse\x128\n" +
+	"\x03sla\x18\x01 \x01(\v2&.nexusflow.automation.v1.SLADefinitionR\x03sla\")\n" +
+	"\x17GetSLADefinitionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"T\n" +
+	"\x18GetSLADefinitionResponse\x128\n" +
+	"\x03sla\x18\x01 \x01(\v2&.nexusflow.automation.v1.SLADefinitionR\x03sla\"f\n" +
+	"\x1aUpdateSLADefinitionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x128\n" +
+	"\x03sla\x18\x02 \x01(\v2&.nexusflow.automation.v1.SLADefinitionR\x03sla\"W\n" +
+	"\x1bUpdateSLADefinitionResponse\x128\n" +
+	"\x03sla\x18\x01 \x01(\v2&.nexusflow.automation.v1.SLADefinitionR\x03sla\",\n" +
+	"\x1aDeleteSLADefinitionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1d\n" +
+	"\x1bDeleteSLADefinitionResponse\":\n" +
+	"\x19ListSLADefinitionsRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"X\n" +
+	"\x1aListSLADefinitionsResponse\x12:\n" +
+	"\x04slas\x18\x01 \x03(\v2&.nexusflow.automation.v1.SLADefinitionR\x04slas\"1\n" +
+	"\x14ListIssueSLAsRequest\x12\x19\n" +
+	"\bissue_id\x18\x01 \x01(\tR\aissueId\"N\n" +
+	"\x15ListIssueSLAsResponse\x125\n" +
+	"\x04slas\x18\x01 \x03(\v2!.nexusflow.automation.v1.IssueSLAR\x04slas*q\n" +
+	"\rConditionType\x12\x1e\n" +
+	"\x1aCONDITION_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14CONDITION_TYPE_FIELD\x10\x01\x12&\n" +
+	"\"CONDITION_TYPE_SUB_TASKS_IN_STATUS\x10\x02*\x9a\x02\n" +
+	"\x11ConditionOperator\x12\"\n" +
+	"\x1eCONDITION_OPERATOR_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19CONDITION_OPERATOR_EQUALS\x10\x01\x12!\n" +
+	"\x1dCONDITION_OPERATOR_NOT_EQUALS\x10\x02\x12\x19\n" +
+	"\x15CONDITION_OPERATOR_IN\x10\x03\x12\x1d\n" +
+	"\x19CONDITION_OPERATOR_NOT_IN\x10\x04\x12\x1f\n" +
+	"\x1bCONDITION_OPERATOR_CONTAINS\x10\x05\x12\x1f\n" +
+	"\x1bCONDITION_OPERATOR_IS_EMPTY\x10\x06\x12#\n" +
+	"\x1fCONDITION_OPERATOR_IS_NOT_EMPTY\x10\a*E\n" +
+	"\x06Target\x12\x16\n" +
+	"\x12TARGET_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fTARGET_ISSUE\x10\x01\x12\x11\n" +
+	"\rTARGET_PARENT\x10\x02*\x9e\x01\n" +
+	"\n" +
+	"ActionType\x12\x1b\n" +
+	"\x17ACTION_TYPE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cACTION_TYPE_TRANSITION_ISSUE\x10\x01\x12\x1c\n" +
+	"\x18ACTION_TYPE_UPDATE_ISSUE\x10\x02\x12\x16\n" +
+	"\x12ACTION_TYPE_NOTIFY\x10\x03\x12\x1b\n" +
+	"\x17ACTION_TYPE_ADD_COMMENT\x10\x04*\xb0\x01\n" +
+	"\x0fExecutionStatus\x12 \n" +
+	"\x1cEXECUTION_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18EXECUTION_STATUS_SUCCESS\x10\x01\x12\x1b\n" +
+	"\x17EXECUTION_STATUS_FAILED\x10\x02\x12\x1c\n" +
+	"\x18EXECUTION_STATUS_DRY_RUN\x10\x03\x12\"\n" +
+	"\x1eEXECUTION_STATUS_LOOP_DETECTED\x10\x04*\x86\x01\n" +
+	"\rSLATimerState\x12\x1f\n" +
+	"\x1bSLA_TIMER_STATE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17SLA_TIMER_STATE_RUNNING\x10\x01\x12\x1a\n" +
+	"\x16SLA_TIMER_STATE_PAUSED\x10\x02\x12\x1b\n" +
+	"\x17SLA_TIMER_STATE_STOPPED\x10\x032\xa6\x15\n" +
+	"\x11AutomationService\x12\x86\x01\n" +
+	"\n" +
+	"CreateRule\x12*.nexusflow.automation.v1.CreateRuleRequest\x1a+.nexusflow.automation.v1.CreateRuleResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/automation-rules\x12\x7f\n" +
+	"\aGetRule\x12'.nexusflow.automation.v1.GetRuleRequest\x1a(.nexusflow.automation.v1.GetRuleResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/automation-rules/{id}\x12\x8e\x01\n" +
+	"\n" +
+	"UpdateRule\x12*.nexusflow.automation.v1.UpdateRuleRequest\x1a+.nexusflow.automation.v1.UpdateRuleResponse\"'\x82\xd3\xe4\x93\x02!:\x04rule\x1a\x19/v1/automation-rules/{id}\x12\x88\x01\n" +
+	"\n" +
+	"DeleteRule\x12*.nexusflow.automation.v1.DeleteRuleRequest\x1a+.nexusflow.automation.v1.DeleteRuleResponse\"!\x82\xd3\xe4\x93\x02\x1b*\x19/v1/automation-rules/{id}\x12\x96\x01\n" +
+	"\tListRules\x12).nexusflow.automation.v1.ListRulesRequest\x1a*.nexusflow.automation.v1.ListRulesResponse\"2\x82\xd3\xe4\x93\x02,\x12*/v1/projects/{project_id}/automation-rules\x12\xb0\x01\n" +
+	"\x12ListRuleExecutions\x122.nexusflow.automation.v1.ListRuleExecutionsRequest\x1a3.nexusflow.automation.v1.ListRuleExecutionsResponse\"1\x82\xd3\xe4\x93\x02+\x12)/v1/automation-rules/{rule_id}/executions\x12\xac\x01\n" +
+	"\x16CreateBusinessCalendar\x126.nexusflow.automation.v1.CreateBusinessCalendarRequest\x1a7.nexusflow.automation.v1.CreateBusinessCalendarResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/business-calendars\x12\xa5\x01\n" +
+	"\x13GetBusinessCalendar\x123.nexusflow.automation.v1.GetBusinessCalendarRequest\x1a4.nexusflow.automation.v1.GetBusinessCalendarResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/business-calendars/{id}\x12\xb8\x01\n" +
+	"\x16UpdateBusinessCalendar\x126.nexusflow.automation.v1.UpdateBusinessCalendarRequest\x1a7.nexusflow.automation.v1.UpdateBusinessCalendarResponse\"-\x82\xd3\xe4\x93\x02':\bcalendar\x1a\x1b/v1/business-calendars/{id}\x12\xae\x01\n" +
+	"\x16DeleteBusinessCalendar\x126.nexusflow.automation.v1.DeleteBusinessCalendarRequest\x1a7.nexusflow.automation.v1.DeleteBusinessCalendarResponse\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/v1/business-calendars/{id}\x12\xbc\x01\n" +
+	"\x15ListBusinessCalendars\x125.nexusflow.automation.v1.ListBusinessCalendarsRequest\x1a6.nexusflow.automation.v1.ListBusinessCalendarsResponse\"4\x82\xd3\xe4\x93\x02.\x12,/v1/projects/{project_id}/business-calendars\x12\x95\x01\n" +
+	"\x13CreateSLADefinition\x123.nexusflow.automation.v1.CreateSLADefinitionRequest\x1a4.nexusflow.automation.v1.CreateSLADefinitionResponse\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\"\b/v1/slas\x12\x8e\x01\n" +
+	"\x10GetSLADefinition\x120.nexusflow.automation.v1.GetSLADefinitionRequest\x1a1.nexusflow.automation.v1.GetSLADefinitionResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/slas/{id}\x12\x9c\x01\n" +
+	"\x13UpdateSLADefinition\x123.nexusflow.automation.v1.UpdateSLADefinitionRequest\x1a4.nexusflow.automation.v1.UpdateSLADefinitionResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x03sla\x1a\r/v1/slas/{id}\x12\x97\x01\n" +
+	"\x13DeleteSLADefinition\x123.nexusflow.automation.v1.DeleteSLADefinitionRequest\x1a4.nexusflow.automation.v1.DeleteSLADefinitionResponse\"\x15\x82\xd3\xe4\x93\x02\x0f*\r/v1/slas/{id}\x12\xa5\x01\n" +
+	"\x12ListSLADefinitions\x122.nexusflow.automation.v1.ListSLADefinitionsRequest\x1a3.nexusflow.automation.v1.ListSLADefinitionsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/projects/{project_id}/slas\x12\x92\x01\n" +
+	"\rListIssueSLAs\x12-.nexusflow.automation.v1.ListIssueSLAsRequest\x1a..nexusflow.automation.v1.ListIssueSLAsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/issues/{issue_id}/slasBEZCgithub.com/nexusflow/nexusflow/pkg/proto/automation/v1;automationv1b\x06proto3"
+
+var (
+	file_proto_automation_v1_automation_proto_rawDescOnce sync.Once
+	file_proto_automation_v1_automation_proto_rawDescData []byte
+)
+
+func file_proto_automation_v1_automation_proto_rawDescGZIP() []byte {
+	file_proto_automation_v1_automation_proto_rawDescOnce.Do(func() {
+		file_proto_automation_v1_automation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_automation_v1_automation_proto_rawDesc), len(file_proto_automation_v1_automation_proto_rawDesc)))
+	})
+	return file_proto_automation_v1_automation_proto_rawDescData
+}
+
+var file_proto_automation_v1_automation_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_proto_automation_v1_automation_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_proto_automation_v1_automation_proto_goTypes = []any{
+	(ConditionType)(0),                     // 0: nexusflow.automation.v1.ConditionType
+	(ConditionOperator)(0),                 // 1: nexusflow.automation.v1.ConditionOperator
+	(Target)(0),                            // 2: nexusflow.automation.v1.Target
+	(ActionType)(0),                        // 3: nexusflow.automation.v1.ActionType
+	(ExecutionStatus)(0),                   // 4: nexusflow.automation.v1.ExecutionStatus
+	(SLATimerState)(0),                     // 5: nexusflow.automation.v1.SLATimerState
+	(*Rule)(nil),                           // 6: nexusflow.automation.v1.Rule
+	(*RuleSchedule)(nil),                   // 7: nexusflow.automation.v1.RuleSchedule
+	(*Condition)(nil),                      // 8: nexusflow.automation.v1.Condition
+	(*Action)(nil),                         // 9: nexusflow.automation.v1.Action
+	(*ActionResult)(nil),                   // 10: nexusflow.automation.v1.ActionResult
+	(*RuleExecution)(nil),                  // 11: nexusflow.automation.v1.RuleExecution
+	(*BusinessCalendar)(nil),               // 12: nexusflow.automation.v1.BusinessCalendar
+	(*WorkingHours)(nil),                   // 13: nexusflow.automation.v1.WorkingHours
+	(*SLADefinition)(nil),                  // 14: nexusflow.automation.v1.SLADefinition
+	(*IssueSLA)(nil),                       // 15: nexusflow.automation.v1.IssueSLA
+	(*CreateRuleRequest)(nil),              // 16: nexusflow.automation.v1.CreateRuleRequest
+	(*CreateRuleResponse)(nil),             // 17: nexusflow.automation.v1.CreateRuleResponse
+	(*GetRuleRequest)(nil),                 // 18: nexusflow.automation.v1.GetRuleRequest
+	(*GetRuleResponse)(nil),                // 19: nexusflow.automation.v1.GetRuleResponse
+	(*UpdateRuleRequest)(nil),              // 20: nexusflow.automation.v1.UpdateRuleRequest
+	(*UpdateRuleResponse)(nil),             // 21: nexusflow.automation.v1.UpdateRuleResponse
+	(*DeleteRuleRequest)(nil),              // 22: nexusflow.automation.v1.DeleteRuleRequest
+	(*DeleteRuleResponse)(nil),             // 23: nexusflow.automation.v1.DeleteRuleResponse
+	(*ListRulesRequest)(nil),               // 24: nexusflow.automation.v1.ListRulesRequest
+	(*ListRulesResponse)(nil),              // 25: nexusflow.automation.v1.ListRulesResponse
+	(*ListRuleExecutionsRequest)(nil),      // 26: nexusflow.automation.v1.ListRuleExecutionsRequest
+	(*ListRuleExecutionsResponse)(nil),     // 27: nexusflow.automation.v1.ListRuleExecutionsResponse
+	(*CreateBusinessCalendarRequest)(nil),  // 28: nexusflow.automation.v1.CreateBusinessCalendarRequest
+	(*CreateBusinessCalendarResponse)(nil), // 29: nexusflow.automation.v1.CreateBusinessCalendarResponse
+	(*GetBusinessCalendarRequest)(nil),     // 30: nexusflow.automation.v1.GetBusinessCalendarRequest
+	(*GetBusinessCalendarResponse)(nil),    // 31: nexusflow.automation.v1.GetBusinessCalendarResponse
+	(*UpdateBusinessCalendarRequest)(nil),  // 32: nexusflow.automation.v1.UpdateBusinessCalendarRequest
+	(*UpdateBusinessCalendarResponse)(nil), // 33: nexusflow.automation.v1.UpdateBusinessCalendarResponse
+	(*DeleteBusinessCalendarRequest)(nil),  // 34: nexusflow.automation.v1.DeleteBusinessCalendarRequest
+	(*DeleteBusinessCalendarResponse)(nil), // 35: nexusflow.automation.v1.DeleteBusinessCalendarResponse
+	(*ListBusinessCalendarsRequest)(nil),   // 36: nexusflow.automation.v1.ListBusinessCalendarsRequest
+	(*ListBusinessCalendarsResponse)(nil),  // 37: nexusflow.automation.v1.ListBusinessCalendarsResponse
+	(*CreateSLADefinitionRequest)(nil),     // 38: nexusflow.automation.v1.CreateSLADefinitionRequest
+	(*CreateSLADefinitionResponse)(nil),    // 39: nexusflow.automation.v1.CreateSLADefinitionResponse
+	(*GetSLADefinitionRequest)(nil),        // 40: nexusflow.automation.v1.GetSLADefinitionRequest
+	(*GetSLADefinitionResponse)(nil),       // 41: nexusflow.automation.v1.GetSLADefinitionResponse
+	(*UpdateSLADefinitionRequest)(nil),     // 42: nexusflow.automation.v1.UpdateSLADefinitionRequest
+	(*UpdateSLADefinitionResponse)(nil),    // 43: nexusflow.automation.v1.UpdateSLADefinitionResponse
+	(*DeleteSLADefinitionRequest)(nil),     // 44: nexusflow.automation.v1.DeleteSLADefinitionRequest
+	(*DeleteSLADefinitionResponse)(nil),    // 45: nexusflow.automation.v1.DeleteSLADefinitionResponse
+	(*ListSLADefinitionsRequest)(nil),      // 46: nexusflow.automation.v1.ListSLADefinitionsRequest
+	(*ListSLADefinitionsResponse)(nil),     // 47: nexusflow.automation.v1.ListSLADefinitionsResponse
+	(*ListIssueSLAsRequest)(nil),           // 48: nexusflow.automation.v1.ListIssueSLAsRequest
+	(*ListIssueSLAsResponse)(nil),          // 49: nexusflow.automation.v1.ListIssueSLAsResponse
+	(*timestamppb.Timestamp)(nil),          // 50: google.protobuf.Timestamp
+	(*v1.PaginationRequest)(nil),           // 51: nexusflow.common.v1.PaginationRequest
+	(*v1.PaginationResponse)(nil),          // 52: nexusflow.common.v1.PaginationResponse
+}
+var file_proto_automation_v1_automation_proto_depIdxs = []int32{
+	8,  // 0: nexusflow.automation.v1.Rule.conditions:type_name -> nexusflow.automation.v1.Condition
+	9,  // 1: nexusflow.automation.v1.Rule.actions:type_name -> nexusflow.automation.v1.Action
+	50, // 2: nexusflow.automation.v1.Rule.created_at:type_name -> google.protobuf.Timestamp
+	50, // 3: nexusflow.automation.v1.Rule.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 4: nexusflow.automation.v1.Rule.schedule:type_name -> nexusflow.automation.v1.RuleSchedule
+	50, // 5: nexusflow.automation.v1.Rule.next_run_at:type_name -> google.protobuf.Timestamp
+	50, // 6: nexusflow.automation.v1.Rule.last_run_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: nexusflow.automation.v1.Condition.type:type_name -> nexusflow.automation.v1.ConditionType
+	1,  // 8: nexusflow.automation.v1.Condition.operator:type_name -> nexusflow.automation.v1.ConditionOperator
+	2,  // 9: nexusflow.automation.v1.Condition.target:type_name -> nexusflow.automation.v1.Target
+	3,  // 10: nexusflow.automation.v1.Action.type:type_name -> nexusflow.automation.v1.ActionType
+	2,  // 11: nexusflow.automation.v1.Action.target:type_name -> nexusflow.automation.v1.Target
+	3,  // 12: nexusflow.automation.v1.ActionResult.type:type_name -> nexusflow.automation.v1.ActionType
+	4,  // 13: nexusflow.automation.v1.RuleExecution.status:type_name -> nexusflow.automation.v1.ExecutionStatus
+	10, // 14: nexusflow.automation.v1.RuleExecution.actions:type_name -> nexusflow.automation.v1.ActionResult
+	50, // 15: nexusflow.automation.v1.RuleExecution.created_at:type_name -> google.protobuf.Timestamp
+	13, // 16: nexusflow.automation.v1.BusinessCalendar.working_hours:type_name -> nexusflow.automation.v1.WorkingHours
+	50, // 17: nexusflow.automation.v1.BusinessCalendar.created_at:type_name -> google.protobuf.Timestamp
+	50, // 18: nexusflow.automation.v1.BusinessCalendar.updated_at:type_name -> google.protobuf.Timestamp
+	50, // 19: nexusflow.automation.v1.SLADefinition.created_at:type_name -> google.protobuf.Timestamp
+	50, // 20: nexusflow.automation.v1.SLADefinition.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 21: nexusflow.automation.v1.IssueSLA.state:type_name -> nexusflow.automation.v1.SLATimerState
+	50, // 22: nexusflow.automation.v1.IssueSLA.due_at:type_name -> google.protobuf.Timestamp
+	50, // 23: nexusflow.automation.v1.IssueSLA.started_at:type_name -> google.protobuf.Timestamp
+	50, // 24: nexusflow.automation.v1.IssueSLA.stopped_at:type_name -> google.protobuf.Timestamp
+	6,  // 25: nexusflow.automation.v1.CreateRuleRequest.rule:type_name -> nexusflow.automation.v1.Rule
+	6,  // 26: nexusflow.automation.v1.CreateRuleResponse.rule:type_name -> nexusflow.automation.v1.Rule
+	6,  // 27: nexusflow.automation.v1.GetRuleResponse.rule:type_name -> nexusflow.automation.v1.Rule
+	6,  // 28: nexusflow.automation.v1.UpdateRuleRequest.rule:type_name -> nexusflow.automation.v1.Rule
+	6,  // 29: nexusflow.automation.v1.UpdateRuleResponse.rule:type_name -> nexusflow.automation.v1.Rule
+	6,  // 30: nexusflow.automation.v1.ListRulesResponse.rules:type_name -> nexusflow.automation.v1.Rule
+	51, // 31: nexusflow.automation.v1.ListRuleExecutionsRequest.pagination:type_name -> nexusflow.common.v1.PaginationRequest
+	11, // 32: nexusflow.automation.v1.ListRuleExecutionsResponse.executions:type_name -> nexusflow.automation.v1.RuleExecution
+	52, // 33: nexusflow.automation.v1.ListRuleExecutionsResponse.pagination:type_name -> nexusflow.common.v1.PaginationResponse
+	12, // 34: nexusflow.automation.v1.CreateBusinessCalendarRequest.calendar:type_name -> nexusflow.automation.v1.BusinessCalendar
+	12, // 35: nexusflow.automation.v1.CreateBusinessCalendarResponse.calendar:type_name -> nexusflow.automation.v1.BusinessCalendar
+	12, // 36: nexusflow.automation.v1.GetBusinessCalendarResponse.calendar:type_name -> nexusflow.automation.v1.BusinessCalendar
+	12, // 37: nexusflow.automation.v1.UpdateBusinessCalendarRequest.calendar:type_name -> nexusflow.automation.v1.BusinessCalendar
+	12, // 38: nexusflow.automation.v1.UpdateBusinessCalendarResponse.calendar:type_name -> nexusflow.automation.v1.BusinessCalendar
+	12, // 39: nexusflow.automation.v1.ListBusinessCalendarsResponse.calendars:type_name -> nexusflow.automation.v1.BusinessCalendar
+	14, // 40: nexusflow.automation.v1.CreateSLADefinitionRequest.sla:type_name -> nexusflow.automation.v1.SLADefinition
+	14, // 41: nexusflow.automation.v1.CreateSLADefinitionResponse.sla:type_name -> nexusflow.automation.v1.SLADefinition
+	14, // 42: nexusflow.automation.v1.GetSLADefinitionResponse.sla:type_name -> nexusflow.automation.v1.SLADefinition
+	14, // 43: nexusflow.automation.v1.UpdateSLADefinitionRequest.sla:type_name -> nexusflow.automation.v1.SLADefinition
+	14, // 44: nexusflow.automation.v1.UpdateSLADefinitionResponse.sla:type_name -> nexusflow.automation.v1.SLADefinition
+	14, // 45: nexusflow.automation.v1.ListSLADefinitionsResponse.slas:type_name -> nexusflow.automation.v1.SLADefinition
+	15, // 46: nexusflow.automation.v1.ListIssueSLAsResponse.slas:type_name -> nexusflow.automation.v1.IssueSLA
+	16, // 47: nexusflow.automation.v1.AutomationService.CreateRule:input_type -> nexusflow.automation.v1.CreateRuleRequest
+	18, // 48: nexusflow.automation.v1.AutomationService.GetRule:input_type -> nexusflow.automation.v1.GetRuleRequest
+	20, // 49: nexusflow.automation.v1.AutomationService.UpdateRule:input_type -> nexusflow.automation.v1.UpdateRuleRequest
+	22, // 50: nexusflow.automation.v1.AutomationService.DeleteRule:input_type -> nexusflow.automation.v1.DeleteRuleRequest
+	24, // 51: nexusflow.automation.v1.AutomationService.ListRules:input_type -> nexusflow.automation.v1.ListRulesRequest
+	26, // 52: nexusflow.automation.v1.AutomationService.ListRuleExecutions:input_type -> nexusflow.automation.v1.ListRuleExecutionsRequest
+	28, // 53: nexusflow.automation.v1.AutomationService.CreateBusinessCalendar:input_type -> nexusflow.automation.v1.CreateBusinessCalendarRequest
+	30, // 54: nexusflow.automation.v1.AutomationService.GetBusinessCalendar:input_type -> nexusflow.automation.v1.GetBusinessCalendarRequest
+	32, // 55: nexusflow.automation.v1.AutomationService.UpdateBusinessCalendar:input_type -> nexusflow.automation.v1.UpdateBusinessCalendarRequest
+	34, // 56: nexusflow.automation.v1.AutomationService.DeleteBusinessCalendar:input_type -> nexusflow.automation.v1.DeleteBusinessCalendarRequest
+	36, // 57: nexusflow.automation.v1.AutomationService.ListBusinessCalendars:input_type -> nexusflow.automation.v1.ListBusinessCalendarsRequest
+	38, // 58: nexusflow.automation.v1.AutomationService.CreateSLADefinition:input_type -> nexusflow.automation.v1.CreateSLADefinitionRequest
+	40, // 59: nexusflow.automation.v1.AutomationService.GetSLADefinition:input_type -> nexusflow.automation.v1.GetSLADefinitionRequest
+	42, // 60: nexusflow.automation.v1.AutomationService.UpdateSLADefinition:input_type -> nexusflow.automation.v1.UpdateSLADefinitionRequest
+	44, // 61: nexusflow.automation.v1.AutomationService.DeleteSLADefinition:input_type -> nexusflow.automation.v1.DeleteSLADefinitionRequest
+	46, // 62: nexusflow.automation.v1.AutomationService.ListSLADefinitions:input_type -> nexusflow.automation.v1.ListSLADefinitionsRequest
+	48, // 63: nexusflow.automation.v1.AutomationService.ListIssueSLAs:input_type -> nexusflow.automation.v1.ListIssueSLAsRequest
+	17, // 64: nexusflow.automation.v1.AutomationService.CreateRule:output_type -> nexusflow.automation.v1.CreateRuleResponse
+	19, // 65: nexusflow.automation.v1.AutomationService.GetRule:output_type -> nexusflow.automation.v1.GetRuleResponse
+	21, // 66: nexusflow.automation.v1.AutomationService.UpdateRule:output_type -> nexusflow.automation.v1.UpdateRuleResponse
+	23, // 67: nexusflow.automation.v1.AutomationService.DeleteRule:output_type -> nexusflow.automation.v1.DeleteRuleResponse
+	25, // 68: nexusflow.automation.v1.AutomationService.ListRules:output_type -> nexusflow.automation.v1.ListRulesResponse
+	27, // 69: nexusflow.automation.v1.AutomationService.ListRuleExecutions:output_type -> nexusflow.automation.v1.ListRuleExecutionsResponse
+	29, // 70: nexusflow.automation.v1.AutomationService.CreateBusinessCalendar:output_type -> nexusflow.automation.v1.CreateBusinessCalendarResponse
+	31, // 71: nexusflow.automation.v1.AutomationService.GetBusinessCalendar:output_type -> nexusflow.automation.v1.GetBusinessCalendarResponse
+	33, // 72: nexusflow.automation.v1.AutomationService.UpdateBusinessCalendar:output_type -> nexusflow.automation.v1.UpdateBusinessCalendarResponse
+	35, // 73: nexusflow.automation.v1.AutomationService.DeleteBusinessCalendar:output_type -> nexusflow.automation.v1.DeleteBusinessCalendarResponse
+	37, // 74: nexusflow.automation.v1.AutomationService.ListBusinessCalendars:output_type -> nexusflow.automation.v1.ListBusinessCalendarsResponse
+	39, // 75: nexusflow.automation.v1.AutomationService.CreateSLADefinition:output_type -> nexusflow.automation.v1.CreateSLADefinitionResponse
+	41, // 76: nexusflow.automation.v1.AutomationService.GetSLADefinition:output_type -> nexusflow.automation.v1.GetSLADefinitionResponse
+	43, // 77: nexusflow.automation.v1.AutomationService.UpdateSLADefinition:output_type -> nexusflow.automation.v1.UpdateSLADefinitionResponse
+	45, // 78: nexusflow.automation.v1.AutomationService.DeleteSLADefinition:output_type -> nexusflow.automation.v1.DeleteSLADefinitionResponse
+	47, // 79: nexusflow.automation.v1.AutomationService.ListSLADefinitions:output_type -> nexusflow.automation.v1.ListSLADefinitionsResponse
+	49, // 80: nexusflow.automation.v1.AutomationService.ListIssueSLAs:output_type -> nexusflow.automation.v1.ListIssueSLAsResponse
+	64, // [64:81] is the sub-list for method output_type
+	47, // [47:64] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
+}
+
+func init() { file_proto_automation_v1_automation_proto_init() }
+func file_proto_automation_v1_automation_proto_init() {
+	if File_proto_automation_v1_automation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_automation_v1_automation_proto_rawDesc), len(file_proto_automation_v1_automation_proto_rawDesc)),
+			NumEnums:      6,
+			NumMessages:   44,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_automation_v1_automation_proto_goTypes,
+		DependencyIndexes: file_proto_automation_v1_automation_proto_depIdxs,
+		EnumInfos:         file_proto_automation_v1_automation_proto_enumTypes,
+		MessageInfos:      file_proto_automation_v1_automation_proto_msgTypes,
+	}.Build()
+	File_proto_automation_v1_automation_proto = out.File
+	file_proto_automation_v1_automation_proto_goTypes = nil
+	file_proto_automation_v1_automation_proto_depIdxs = nil
+}
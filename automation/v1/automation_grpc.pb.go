@@ -0,0 +1,733 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.1
+// source: proto/automation/v1/automation.proto
+
+package automationv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AutomationService_CreateRule_FullMethodName             = "/nexusflow.automation.v1.AutomationService/CreateRule"
+	AutomationService_GetRule_FullMethodName                = "/nexusflow.automation.v1.AutomationService/GetRule"
+	AutomationService_UpdateRule_FullMethodName             = "/nexusflow.automation.v1.AutomationService/UpdateRule"
+	AutomationService_DeleteRule_FullMethodName             = "/nexusflow.automation.v1.AutomationService/DeleteRule"
+	AutomationService_ListRules_FullMethodName              = "/nexusflow.automation.v1.AutomationService/ListRules"
+	AutomationService_ListRuleExecutions_FullMethodName     = "/nexusflow.automation.v1.AutomationService/ListRuleExecutions"
+	AutomationService_CreateBusinessCalendar_FullMethodName = "/nexusflow.automation.v1.AutomationService/CreateBusinessCalendar"
+	AutomationService_GetBusinessCalendar_FullMethodName    = "/nexusflow.automation.v1.AutomationService/GetBusinessCalendar"
+	AutomationService_UpdateBusinessCalendar_FullMethodName = "/nexusflow.automation.v1.AutomationService/UpdateBusinessCalendar"
+	AutomationService_DeleteBusinessCalendar_FullMethodName = "/nexusflow.automation.v1.AutomationService/DeleteBusinessCalendar"
+	AutomationService_ListBusinessCalendars_FullMethodName  = "/nexusflow.automation.v1.AutomationService/ListBusinessCalendars"
+	AutomationService_CreateSLADefinition_FullMethodName    = "/nexusflow.automation.v1.AutomationService/CreateSLADefinition"
+	AutomationService_GetSLADefinition_FullMethodName       = "/nexusflow.automation.v1.AutomationService/GetSLADefinition"
+	AutomationService_UpdateSLADefinition_FullMethodName    = "/nexusflow.automation.v1.AutomationService/UpdateSLADefinition"
+	AutomationService_DeleteSLADefinition_FullMethodName    = "/nexusflow.automation.v1.AutomationService/DeleteSLADefinition"
+	AutomationService_ListSLADefinitions_FullMethodName     = "/nexusflow.automation.v1.AutomationService/ListSLADefinitions"
+	AutomationService_ListIssueSLAs_FullMethodName          = "/nexusflow.automation.v1.AutomationService/ListIssueSLAs"
+)
+
+// AutomationServiceClient is the client API for AutomationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AutomationServiceClient interface {
+	CreateRule(ctx context.Context, in *CreateRuleRequest, opts ...grpc.CallOption) (*CreateRuleResponse, error)
+	GetRule(ctx context.Context, in *GetRuleRequest, opts ...grpc.CallOption) (*GetRuleResponse, error)
+	UpdateRule(ctx context.Context, in *UpdateRuleRequest, opts ...grpc.CallOption) (*UpdateRuleResponse, error)
+	DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*DeleteRuleResponse, error)
+	ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error)
+	ListRuleExecutions(ctx context.Context, in *ListRuleExecutionsRequest, opts ...grpc.CallOption) (*ListRuleExecutionsResponse, error)
+	// Business calendars
+	CreateBusinessCalendar(ctx context.Context, in *CreateBusinessCalendarRequest, opts ...grpc.CallOption) (*CreateBusinessCalendarResponse, error)
+	GetBusinessCalendar(ctx context.Context, in *GetBusinessCalendarRequest, opts ...grpc.CallOption) (*GetBusinessCalendarResponse, error)
+	UpdateBusinessCalendar(ctx context.Context, in *UpdateBusinessCalendarRequest, opts ...grpc.CallOption) (*UpdateBusinessCalendarResponse, error)
+	DeleteBusinessCalendar(ctx context.Context, in *DeleteBusinessCalendarRequest, opts ...grpc.CallOption) (*DeleteBusinessCalendarResponse, error)
+	ListBusinessCalendars(ctx context.Context, in *ListBusinessCalendarsRequest, opts ...grpc.CallOption) (*ListBusinessCalendarsResponse, error)
+	// SLAs
+	CreateSLADefinition(ctx context.Context, in *CreateSLADefinitionRequest, opts ...grpc.CallOption) (*CreateSLADefinitionResponse, error)
+	GetSLADefinition(ctx context.Context, in *GetSLADefinitionRequest, opts ...grpc.CallOption) (*GetSLADefinitionResponse, error)
+	UpdateSLADefinition(ctx context.Context, in *UpdateSLADefinitionRequest, opts ...grpc.CallOption) (*UpdateSLADefinitionResponse, error)
+	DeleteSLADefinition(ctx context.Context, in *DeleteSLADefinitionRequest, opts ...grpc.CallOption) (*DeleteSLADefinitionResponse, error)
+	ListSLADefinitions(ctx context.Context, in *ListSLADefinitionsRequest, opts ...grpc.CallOption) (*ListSLADefinitionsResponse, error)
+	ListIssueSLAs(ctx context.Context, in *ListIssueSLAsRequest, opts ...grpc.CallOption) (*ListIssueSLAsResponse, error)
+}
+
+type automationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAutomationServiceClient(cc grpc.ClientConnInterface) AutomationServiceClient {
+	return &automationServiceClient{cc}
+}
+
+func (c *automationServiceClient) CreateRule(ctx context.Context, in *CreateRuleRequest, opts ...grpc.CallOption) (*CreateRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRuleResponse)
+	err := c.cc.Invoke(ctx, AutomationService_CreateRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *automationServiceClient) GetRule(ctx context.Context, in *GetRuleRequest, opts ...grpc.CallOption) (*GetRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRuleResponse)
+	err := c.cc.Invoke(ctx, AutomationService_GetRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *automationServiceClient) UpdateRule(ctx context.Context, in *UpdateRuleRequest, opts ...grpc.CallOption) (*UpdateRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRuleResponse)
+	err := c.cc.Invoke(ctx, AutomationService_UpdateRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *automationServiceClient) DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*DeleteRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRuleResponse)
+	err := c.cc.Invoke(ctx, AutomationService_DeleteRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *automationServiceClient) ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRulesResponse)
+	err := c.cc.Invoke(ctx, AutomationService_ListRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *automationServiceClient) ListRuleExecutions(ctx context.Context, in *ListRuleExecutionsRequest, opts ...grpc.CallOption) (*ListRuleExecutionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRuleExecutionsResponse)
+	err := c.cc.Invoke(ctx, AutomationService_ListRuleExecutions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *automationServiceClient) CreateBusinessCalendar(ctx context.Context, in *CreateBusinessCalendarRequest, opts ...grpc.CallOption) (*CreateBusinessCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBusinessCalendarResponse)
+	err := c.cc.Invoke(ctx, AutomationService_CreateBusinessCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *automationServiceClient) GetBusinessCalendar(ctx context.Context, in *GetBusinessCalendarRequest, opts ...grpc.CallOption) (*GetBusinessCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBusinessCalendarResponse)
+	err := c.cc.Invoke(ctx, AutomationService_GetBusinessCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *automationServiceClient) UpdateBusinessCalendar(ctx context.Context, in *UpdateBusinessCalendarRequest, opts ...grpc.CallOption) (*UpdateBusinessCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBusinessCalendarResponse)
+	err := c.cc.Invoke(ctx, AutomationService_UpdateBusinessCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *automationServiceClient) DeleteBusinessCalendar(ctx context.Context, in *DeleteBusinessCalendarRequest, opts ...grpc.CallOption) (*DeleteBusinessCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBusinessCalendarResponse)
+	err := c.cc.Invoke(ctx, AutomationService_DeleteBusinessCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *automationServiceClient) ListBusinessCalendars(ctx context.Context, in *ListBusinessCalendarsRequest, opts ...grpc.CallOption) (*ListBusinessCalendarsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBusinessCalendarsResponse)
+	err := c.cc.Invoke(ctx, AutomationService_ListBusinessCalendars_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *automationServiceClient) CreateSLADefinition(ctx context.Context, in *CreateSLADefinitionRequest, opts ...grpc.CallOption) (*CreateSLADefinitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSLADefinitionResponse)
+	err := c.cc.Invoke(ctx, AutomationService_CreateSLADefinition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *automationServiceClient) GetSLADefinition(ctx context.Context, in *GetSLADefinitionRequest, opts ...grpc.CallOption) (*GetSLADefinitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSLADefinitionResponse)
+	err := c.cc.Invoke(ctx, AutomationService_GetSLADefinition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *automationServiceClient) UpdateSLADefinition(ctx context.Context, in *UpdateSLADefinitionRequest, opts ...grpc.CallOption) (*UpdateSLADefinitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSLADefinitionResponse)
+	err := c.cc.Invoke(ctx, AutomationService_UpdateSLADefinition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *automationServiceClient) DeleteSLADefinition(ctx context.Context, in *DeleteSLADefinitionRequest, opts ...grpc.CallOption) (*DeleteSLADefinitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSLADefinitionResponse)
+	err := c.cc.Invoke(ctx, AutomationService_DeleteSLADefinition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *automationServiceClient) ListSLADefinitions(ctx context.Context, in *ListSLADefinitionsRequest, opts ...grpc.CallOption) (*ListSLADefinitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSLADefinitionsResponse)
+	err := c.cc.Invoke(ctx, AutomationService_ListSLADefinitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *automationServiceClient) ListIssueSLAs(ctx context.Context, in *ListIssueSLAsRequest, opts ...grpc.CallOption) (*ListIssueSLAsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIssueSLAsResponse)
+	err := c.cc.Invoke(ctx, AutomationService_ListIssueSLAs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AutomationServiceServer is the server API for AutomationService service.
+// All implementations must embed UnimplementedAutomationServiceServer
+// for forward compatibility.
+type AutomationServiceServer interface {
+	CreateRule(context.Context, *CreateRuleRequest) (*CreateRuleResponse, error)
+	GetRule(context.Context, *GetRuleRequest) (*GetRuleResponse, error)
+	UpdateRule(context.Context, *UpdateRuleRequest) (*UpdateRuleResponse, error)
+	DeleteRule(context.Context, *DeleteRuleRequest) (*DeleteRuleResponse, error)
+	ListRules(context.Context, *ListRulesRequest) (*ListRulesResponse, error)
+	ListRuleExecutions(context.Context, *ListRuleExecutionsRequest) (*ListRuleExecutionsResponse, error)
+	// Business calendars
+	CreateBusinessCalendar(context.Context, *CreateBusinessCalendarRequest) (*CreateBusinessCalendarResponse, error)
+	GetBusinessCalendar(context.Context, *GetBusinessCalendarRequest) (*GetBusinessCalendarResponse, error)
+	UpdateBusinessCalendar(context.Context, *UpdateBusinessCalendarRequest) (*UpdateBusinessCalendarResponse, error)
+	DeleteBusinessCalendar(context.Context, *DeleteBusinessCalendarRequest) (*DeleteBusinessCalendarResponse, error)
+	ListBusinessCalendars(context.Context, *ListBusinessCalendarsRequest) (*ListBusinessCalendarsResponse, error)
+	// SLAs
+	CreateSLADefinition(context.Context, *CreateSLADefinitionRequest) (*CreateSLADefinitionResponse, error)
+	GetSLADefinition(context.Context, *GetSLADefinitionRequest) (*GetSLADefinitionResponse, error)
+	UpdateSLADefinition(context.Context, *UpdateSLADefinitionRequest) (*UpdateSLADefinitionResponse, error)
+	DeleteSLADefinition(context.Context, *DeleteSLADefinitionRequest) (*DeleteSLADefinitionResponse, error)
+	ListSLADefinitions(context.Context, *ListSLADefinitionsRequest) (*ListSLADefinitionsResponse, error)
+	ListIssueSLAs(context.Context, *ListIssueSLAsRequest) (*ListIssueSLAsResponse, error)
+	mustEmbedUnimplementedAutomationServiceServer()
+}
+
+// UnimplementedAutomationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAutomationServiceServer struct{}
+
+func (UnimplementedAutomationServiceServer) CreateRule(context.Context, *CreateRuleRequest) (*CreateRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRule not implemented")
+}
+func (UnimplementedAutomationServiceServer) GetRule(context.Context, *GetRuleRequest) (*GetRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRule not implemented")
+}
+func (UnimplementedAutomationServiceServer) UpdateRule(context.Context, *UpdateRuleRequest) (*UpdateRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRule not implemented")
+}
+func (UnimplementedAutomationServiceServer) DeleteRule(context.Context, *DeleteRuleRequest) (*DeleteRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRule not implemented")
+}
+func (UnimplementedAutomationServiceServer) ListRules(context.Context, *ListRulesRequest) (*ListRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRules not implemented")
+}
+func (UnimplementedAutomationServiceServer) ListRuleExecutions(context.Context, *ListRuleExecutionsRequest) (*ListRuleExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRuleExecutions not implemented")
+}
+func (UnimplementedAutomationServiceServer) CreateBusinessCalendar(context.Context, *CreateBusinessCalendarRequest) (*CreateBusinessCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBusinessCalendar not implemented")
+}
+func (UnimplementedAutomationServiceServer) GetBusinessCalendar(context.Context, *GetBusinessCalendarRequest) (*GetBusinessCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBusinessCalendar not implemented")
+}
+func (UnimplementedAutomationServiceServer) UpdateBusinessCalendar(context.Context, *UpdateBusinessCalendarRequest) (*UpdateBusinessCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBusinessCalendar not implemented")
+}
+func (UnimplementedAutomationServiceServer) DeleteBusinessCalendar(context.Context, *DeleteBusinessCalendarRequest) (*DeleteBusinessCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBusinessCalendar not implemented")
+}
+func (UnimplementedAutomationServiceServer) ListBusinessCalendars(context.Context, *ListBusinessCalendarsRequest) (*ListBusinessCalendarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBusinessCalendars not implemented")
+}
+func (UnimplementedAutomationServiceServer) CreateSLADefinition(context.Context, *CreateSLADefinitionRequest) (*CreateSLADefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSLADefinition not implemented")
+}
+func (UnimplementedAutomationServiceServer) GetSLADefinition(context.Context, *GetSLADefinitionRequest) (*GetSLADefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSLADefinition not implemented")
+}
+func (UnimplementedAutomationServiceServer) UpdateSLADefinition(context.Context, *UpdateSLADefinitionRequest) (*UpdateSLADefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSLADefinition not implemented")
+}
+func (UnimplementedAutomationServiceServer) DeleteSLADefinition(context.Context, *DeleteSLADefinitionRequest) (*DeleteSLADefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSLADefinition not implemented")
+}
+func (UnimplementedAutomationServiceServer) ListSLADefinitions(context.Context, *ListSLADefinitionsRequest) (*ListSLADefinitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSLADefinitions not implemented")
+}
+func (UnimplementedAutomationServiceServer) ListIssueSLAs(context.Context, *ListIssueSLAsRequest) (*ListIssueSLAsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIssueSLAs not implemented")
+}
+func (UnimplementedAutomationServiceServer) mustEmbedUnimplementedAutomationServiceServer() {}
+func (UnimplementedAutomationServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeAutomationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AutomationServiceServer will
+// result in compilation errors.
+type UnsafeAutomationServiceServer interface {
+	mustEmbedUnimplementedAutomationServiceServer()
+}
+
+func RegisterAutomationServiceServer(s grpc.ServiceRegistrar, srv AutomationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAutomationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AutomationService_ServiceDesc, srv)
+}
+
+func _AutomationService_CreateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutomationServiceServer).CreateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutomationService_CreateRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutomationServiceServer).CreateRule(ctx, req.(*CreateRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutomationService_GetRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutomationServiceServer).GetRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutomationService_GetRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutomationServiceServer).GetRule(ctx, req.(*GetRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutomationService_UpdateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutomationServiceServer).UpdateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutomationService_UpdateRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutomationServiceServer).UpdateRule(ctx, req.(*UpdateRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutomationService_DeleteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutomationServiceServer).DeleteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutomationService_DeleteRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutomationServiceServer).DeleteRule(ctx, req.(*DeleteRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutomationService_ListRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutomationServiceServer).ListRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutomationService_ListRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutomationServiceServer).ListRules(ctx, req.(*ListRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutomationService_ListRuleExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRuleExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutomationServiceServer).ListRuleExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutomationService_ListRuleExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutomationServiceServer).ListRuleExecutions(ctx, req.(*ListRuleExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutomationService_CreateBusinessCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBusinessCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutomationServiceServer).CreateBusinessCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutomationService_CreateBusinessCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutomationServiceServer).CreateBusinessCalendar(ctx, req.(*CreateBusinessCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutomationService_GetBusinessCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBusinessCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutomationServiceServer).GetBusinessCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutomationService_GetBusinessCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutomationServiceServer).GetBusinessCalendar(ctx, req.(*GetBusinessCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutomationService_UpdateBusinessCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBusinessCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutomationServiceServer).UpdateBusinessCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutomationService_UpdateBusinessCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutomationServiceServer).UpdateBusinessCalendar(ctx, req.(*UpdateBusinessCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutomationService_DeleteBusinessCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBusinessCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutomationServiceServer).DeleteBusinessCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutomationService_DeleteBusinessCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutomationServiceServer).DeleteBusinessCalendar(ctx, req.(*DeleteBusinessCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutomationService_ListBusinessCalendars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBusinessCalendarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutomationServiceServer).ListBusinessCalendars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutomationService_ListBusinessCalendars_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutomationServiceServer).ListBusinessCalendars(ctx, req.(*ListBusinessCalendarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutomationService_CreateSLADefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSLADefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutomationServiceServer).CreateSLADefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutomationService_CreateSLADefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutomationServiceServer).CreateSLADefinition(ctx, req.(*CreateSLADefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutomationService_GetSLADefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSLADefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutomationServiceServer).GetSLADefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutomationService_GetSLADefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutomationServiceServer).GetSLADefinition(ctx, req.(*GetSLADefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutomationService_UpdateSLADefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSLADefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutomationServiceServer).UpdateSLADefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutomationService_UpdateSLADefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutomationServiceServer).UpdateSLADefinition(ctx, req.(*UpdateSLADefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutomationService_DeleteSLADefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSLADefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutomationServiceServer).DeleteSLADefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutomationService_DeleteSLADefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutomationServiceServer).DeleteSLADefinition(ctx, req.(*DeleteSLADefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutomationService_ListSLADefinitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSLADefinitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutomationServiceServer).ListSLADefinitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutomationService_ListSLADefinitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutomationServiceServer).ListSLADefinitions(ctx, req.(*ListSLADefinitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutomationService_ListIssueSLAs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIssueSLAsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutomationServiceServer).ListIssueSLAs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutomationService_ListIssueSLAs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutomationServiceServer).ListIssueSLAs(ctx, req.(*ListIssueSLAsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AutomationService_ServiceDesc is the grpc.ServiceDesc for AutomationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AutomationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nexusflow.automation.v1.AutomationService",
+	HandlerType: (*AutomationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRule",
+			Handler:    _AutomationService_CreateRule_Handler,
+		},
+		{
+			MethodName: "GetRule",
+			Handler:    _AutomationService_GetRule_Handler,
+		},
+		{
+			MethodName: "UpdateRule",
+			Handler:    _AutomationService_UpdateRule_Handler,
+		},
+		{
+			MethodName: "DeleteRule",
+			Handler:    _AutomationService_DeleteRule_Handler,
+		},
+		{
+			MethodName: "ListRules",
+			Handler:    _AutomationService_ListRules_Handler,
+		},
+		{
+			MethodName: "ListRuleExecutions",
+			Handler:    _AutomationService_ListRuleExecutions_Handler,
+		},
+		{
+			MethodName: "CreateBusinessCalendar",
+			Handler:    _AutomationService_CreateBusinessCalendar_Handler,
+		},
+		{
+			MethodName: "GetBusinessCalendar",
+			Handler:    _AutomationService_GetBusinessCalendar_Handler,
+		},
+		{
+			MethodName: "UpdateBusinessCalendar",
+			Handler:    _AutomationService_UpdateBusinessCalendar_Handler,
+		},
+		{
+			MethodName: "DeleteBusinessCalendar",
+			Handler:    _AutomationService_DeleteBusinessCalendar_Handler,
+		},
+		{
+			MethodName: "ListBusinessCalendars",
+			Handler:    _AutomationService_ListBusinessCalendars_Handler,
+		},
+		{
+			MethodName: "CreateSLADefinition",
+			Handler:    _AutomationService_CreateSLADefinition_Handler,
+		},
+		{
+			MethodName: "GetSLADefinition",
+			Handler:    _AutomationService_GetSLADefinition_Handler,
+		},
+		{
+			MethodName: "UpdateSLADefinition",
+			Handler:    _AutomationService_UpdateSLADefinition_Handler,
+		},
+		{
+			MethodName: "DeleteSLADefinition",
+			Handler:    _AutomationService_DeleteSLADefinition_Handler,
+		},
+		{
+			MethodName: "ListSLADefinitions",
+			Handler:    _AutomationService_ListSLADefinitions_Handler,
+		},
+		{
+			MethodName: "ListIssueSLAs",
+			Handler:    _AutomationService_ListIssueSLAs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/automation/v1/automation.proto",
+}
@@ -21,17 +21,128 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SwimlaneBy int32
+
+const (
+	SwimlaneBy_SWIMLANE_BY_NONE         SwimlaneBy = 0
+	SwimlaneBy_SWIMLANE_BY_ASSIGNEE     SwimlaneBy = 1
+	SwimlaneBy_SWIMLANE_BY_EPIC         SwimlaneBy = 2
+	SwimlaneBy_SWIMLANE_BY_PRIORITY     SwimlaneBy = 3
+	SwimlaneBy_SWIMLANE_BY_CUSTOM_FIELD SwimlaneBy = 4
+	SwimlaneBy_SWIMLANE_BY_QUERY        SwimlaneBy = 5
+)
+
+// Enum value maps for SwimlaneBy.
+var (
+	SwimlaneBy_name = map[int32]string{
+		0: "SWIMLANE_BY_NONE",
+		1: "SWIMLANE_BY_ASSIGNEE",
+		2: "SWIMLANE_BY_EPIC",
+		3: "SWIMLANE_BY_PRIORITY",
+		4: "SWIMLANE_BY_CUSTOM_FIELD",
+		5: "SWIMLANE_BY_QUERY",
+	}
+	SwimlaneBy_value = map[string]int32{
+		"SWIMLANE_BY_NONE":         0,
+		"SWIMLANE_BY_ASSIGNEE":     1,
+		"SWIMLANE_BY_EPIC":         2,
+		"SWIMLANE_BY_PRIORITY":     3,
+		"SWIMLANE_BY_CUSTOM_FIELD": 4,
+		"SWIMLANE_BY_QUERY":        5,
+	}
+)
+
+func (x SwimlaneBy) Enum() *SwimlaneBy {
+	p := new(SwimlaneBy)
+	*p = x
+	return p
+}
+
+func (x SwimlaneBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SwimlaneBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_proto_board_v1_board_proto_enumTypes[0].Descriptor()
+}
+
+func (SwimlaneBy) Type() protoreflect.EnumType {
+	return &file_pkg_proto_board_v1_board_proto_enumTypes[0]
+}
+
+func (x SwimlaneBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SwimlaneBy.Descriptor instead.
+func (SwimlaneBy) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{0}
+}
+
+// WIP limit a column breaks
+type WipViolation int32
+
+const (
+	WipViolation_WIP_VIOLATION_NONE      WipViolation = 0
+	WipViolation_WIP_VIOLATION_UNDER_MIN WipViolation = 1
+	WipViolation_WIP_VIOLATION_OVER_MAX  WipViolation = 2
+)
+
+// Enum value maps for WipViolation.
+var (
+	WipViolation_name = map[int32]string{
+		0: "WIP_VIOLATION_NONE",
+		1: "WIP_VIOLATION_UNDER_MIN",
+		2: "WIP_VIOLATION_OVER_MAX",
+	}
+	WipViolation_value = map[string]int32{
+		"WIP_VIOLATION_NONE":      0,
+		"WIP_VIOLATION_UNDER_MIN": 1,
+		"WIP_VIOLATION_OVER_MAX":  2,
+	}
+)
+
+func (x WipViolation) Enum() *WipViolation {
+	p := new(WipViolation)
+	*p = x
+	return p
+}
+
+func (x WipViolation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WipViolation) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_proto_board_v1_board_proto_enumTypes[1].Descriptor()
+}
+
+func (WipViolation) Type() protoreflect.EnumType {
+	return &file_pkg_proto_board_v1_board_proto_enumTypes[1]
+}
+
+func (x WipViolation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WipViolation.Descriptor instead.
+func (WipViolation) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{1}
+}
+
 // Board entity
 type Board struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId            string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name                 string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt            string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Swimlanes            *SwimlaneConfig        `protobuf:"bytes,7,opt,name=swimlanes,proto3" json:"swimlanes,omitempty"`
+	Filter               *BoardFilter           `protobuf:"bytes,8,opt,name=filter,proto3" json:"filter,omitempty"`                                                              // Unset for boards whose cards are added by hand
+	ArchiveDoneAfterDays int32                  `protobuf:"varint,9,opt,name=archive_done_after_days,json=archiveDoneAfterDays,proto3" json:"archive_done_after_days,omitempty"` // 0 keeps done cards on the board
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *Board) Reset() {
@@ -106,33 +217,51 @@ func (x *Board) GetUpdatedAt() string {
 	return ""
 }
 
-// Card entity
-type Card struct {
+func (x *Board) GetSwimlanes() *SwimlaneConfig {
+	if x != nil {
+		return x.Swimlanes
+	}
+	return nil
+}
+
+func (x *Board) GetFilter() *BoardFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *Board) GetArchiveDoneAfterDays() int32 {
+	if x != nil {
+		return x.ArchiveDoneAfterDays
+	}
+	return 0
+}
+
+// Issues of the board's project a board shows; cards follow the matching issues
+type BoardFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	BoardId       string                 `protobuf:"bytes,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
-	IssueId       string                 `protobuf:"bytes,3,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
-	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	IssueTypes    []string               `protobuf:"bytes,1,rep,name=issue_types,json=issueTypes,proto3" json:"issue_types,omitempty"` // e.g. "bug"; any type when empty
+	LabelIds      []string               `protobuf:"bytes,2,rep,name=label_ids,json=labelIds,proto3" json:"label_ids,omitempty"`       // Issues with any of the labels; any when empty
+	Query         string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`                             // Quick filter query the issues must also match
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Card) Reset() {
-	*x = Card{}
+func (x *BoardFilter) Reset() {
+	*x = BoardFilter{}
 	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Card) String() string {
+func (x *BoardFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Card) ProtoMessage() {}
+func (*BoardFilter) ProtoMessage() {}
 
-func (x *Card) ProtoReflect() protoreflect.Message {
+func (x *BoardFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -144,77 +273,56 @@ func (x *Card) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Card.ProtoReflect.Descriptor instead.
-func (*Card) Descriptor() ([]byte, []int) {
+// Deprecated: Use BoardFilter.ProtoReflect.Descriptor instead.
+func (*BoardFilter) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Card) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Card) GetBoardId() string {
-	if x != nil {
-		return x.BoardId
-	}
-	return ""
-}
-
-func (x *Card) GetIssueId() string {
-	if x != nil {
-		return x.IssueId
-	}
-	return ""
-}
-
-func (x *Card) GetPosition() int32 {
+func (x *BoardFilter) GetIssueTypes() []string {
 	if x != nil {
-		return x.Position
+		return x.IssueTypes
 	}
-	return 0
+	return nil
 }
 
-func (x *Card) GetCreatedAt() string {
+func (x *BoardFilter) GetLabelIds() []string {
 	if x != nil {
-		return x.CreatedAt
+		return x.LabelIds
 	}
-	return ""
+	return nil
 }
 
-func (x *Card) GetUpdatedAt() string {
+func (x *BoardFilter) GetQuery() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Query
 	}
 	return ""
 }
 
-// Requests and responses
-type CreateBoardRequest struct {
+// How the cards of a board are split into horizontal lanes
+type SwimlaneConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	By            SwimlaneBy             `protobuf:"varint,1,opt,name=by,proto3,enum=board.v1.SwimlaneBy" json:"by,omitempty"`
+	CustomFieldId string                 `protobuf:"bytes,2,opt,name=custom_field_id,json=customFieldId,proto3" json:"custom_field_id,omitempty"` // For SWIMLANE_BY_CUSTOM_FIELD
+	Queries       []*SwimlaneQuery       `protobuf:"bytes,3,rep,name=queries,proto3" json:"queries,omitempty"`                                    // For SWIMLANE_BY_QUERY, top to bottom
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateBoardRequest) Reset() {
-	*x = CreateBoardRequest{}
+func (x *SwimlaneConfig) Reset() {
+	*x = SwimlaneConfig{}
 	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBoardRequest) String() string {
+func (x *SwimlaneConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBoardRequest) ProtoMessage() {}
+func (*SwimlaneConfig) ProtoMessage() {}
 
-func (x *CreateBoardRequest) ProtoReflect() protoreflect.Message {
+func (x *SwimlaneConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -226,53 +334,55 @@ func (x *CreateBoardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBoardRequest.ProtoReflect.Descriptor instead.
-func (*CreateBoardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SwimlaneConfig.ProtoReflect.Descriptor instead.
+func (*SwimlaneConfig) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateBoardRequest) GetProjectId() string {
+func (x *SwimlaneConfig) GetBy() SwimlaneBy {
 	if x != nil {
-		return x.ProjectId
+		return x.By
 	}
-	return ""
+	return SwimlaneBy_SWIMLANE_BY_NONE
 }
 
-func (x *CreateBoardRequest) GetName() string {
+func (x *SwimlaneConfig) GetCustomFieldId() string {
 	if x != nil {
-		return x.Name
+		return x.CustomFieldId
 	}
 	return ""
 }
 
-func (x *CreateBoardRequest) GetDescription() string {
+func (x *SwimlaneConfig) GetQueries() []*SwimlaneQuery {
 	if x != nil {
-		return x.Description
+		return x.Queries
 	}
-	return ""
+	return nil
 }
 
-type CreateBoardResponse struct {
+// Lane holding the cards matching a query; a card goes in the first lane it matches
+type SwimlaneQuery struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Board         *Board                 `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateBoardResponse) Reset() {
-	*x = CreateBoardResponse{}
+func (x *SwimlaneQuery) Reset() {
+	*x = SwimlaneQuery{}
 	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBoardResponse) String() string {
+func (x *SwimlaneQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBoardResponse) ProtoMessage() {}
+func (*SwimlaneQuery) ProtoMessage() {}
 
-func (x *CreateBoardResponse) ProtoReflect() protoreflect.Message {
+func (x *SwimlaneQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -284,39 +394,57 @@ func (x *CreateBoardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBoardResponse.ProtoReflect.Descriptor instead.
-func (*CreateBoardResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SwimlaneQuery.ProtoReflect.Descriptor instead.
+func (*SwimlaneQuery) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CreateBoardResponse) GetBoard() *Board {
+func (x *SwimlaneQuery) GetName() string {
 	if x != nil {
-		return x.Board
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type GetBoardRequest struct {
+func (x *SwimlaneQuery) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+// Saved filter a board view can be narrowed with
+//
+// Queries combine clauses such as `assignee = currentUser()`, `priority IN (high, highest)`,
+// `label = <id>`, `summary ~ "login"`, `epic IS EMPTY` or `cf[<field id>] = value`
+// with AND, OR, NOT and parentheses.
+type QuickFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BoardId       string                 `protobuf:"bytes,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Query         string                 `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	Position      int32                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBoardRequest) Reset() {
-	*x = GetBoardRequest{}
+func (x *QuickFilter) Reset() {
+	*x = QuickFilter{}
 	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBoardRequest) String() string {
+func (x *QuickFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBoardRequest) ProtoMessage() {}
+func (*QuickFilter) ProtoMessage() {}
 
-func (x *GetBoardRequest) ProtoReflect() protoreflect.Message {
+func (x *QuickFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -328,84 +456,92 @@ func (x *GetBoardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBoardRequest.ProtoReflect.Descriptor instead.
-func (*GetBoardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use QuickFilter.ProtoReflect.Descriptor instead.
+func (*QuickFilter) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetBoardRequest) GetId() string {
+func (x *QuickFilter) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetBoardResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Board         *Board                 `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *QuickFilter) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
 }
 
-func (x *GetBoardResponse) Reset() {
-	*x = GetBoardResponse{}
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *QuickFilter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *GetBoardResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QuickFilter) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
 }
 
-func (*GetBoardResponse) ProtoMessage() {}
-
-func (x *GetBoardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[5]
+func (x *QuickFilter) GetPosition() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Position
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetBoardResponse.ProtoReflect.Descriptor instead.
-func (*GetBoardResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{5}
+func (x *QuickFilter) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
 }
 
-func (x *GetBoardResponse) GetBoard() *Board {
+func (x *QuickFilter) GetUpdatedAt() string {
 	if x != nil {
-		return x.Board
+		return x.UpdatedAt
 	}
-	return nil
+	return ""
 }
 
-type ListBoardsRequest struct {
+// Card entity
+type Card struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BoardId       string                 `protobuf:"bytes,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	IssueId       string                 `protobuf:"bytes,3,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	StatusId      string                 `protobuf:"bytes,7,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"` // Workflow status of the issue
+	ColumnId      string                 `protobuf:"bytes,8,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"` // Empty while no column holds the status
+	DoneAt        string                 `protobuf:"bytes,9,opt,name=done_at,json=doneAt,proto3" json:"done_at,omitempty"`       // When the issue reached a done status
+	ArchivedAt    string                 `protobuf:"bytes,10,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBoardsRequest) Reset() {
-	*x = ListBoardsRequest{}
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[6]
+func (x *Card) Reset() {
+	*x = Card{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBoardsRequest) String() string {
+func (x *Card) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBoardsRequest) ProtoMessage() {}
+func (*Card) ProtoMessage() {}
 
-func (x *ListBoardsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[6]
+func (x *Card) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,39 +552,237 @@ func (x *ListBoardsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBoardsRequest.ProtoReflect.Descriptor instead.
-func (*ListBoardsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use Card.ProtoReflect.Descriptor instead.
+func (*Card) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ListBoardsRequest) GetProjectId() string {
+func (x *Card) GetId() string {
 	if x != nil {
-		return x.ProjectId
+		return x.Id
 	}
 	return ""
 }
 
-type ListBoardsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Boards        []*Board               `protobuf:"bytes,1,rep,name=boards,proto3" json:"boards,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Card) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
 }
 
-func (x *ListBoardsResponse) Reset() {
-	*x = ListBoardsResponse{}
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[7]
+func (x *Card) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *Card) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Card) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Card) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Card) GetStatusId() string {
+	if x != nil {
+		return x.StatusId
+	}
+	return ""
+}
+
+func (x *Card) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *Card) GetDoneAt() string {
+	if x != nil {
+		return x.DoneAt
+	}
+	return ""
+}
+
+func (x *Card) GetArchivedAt() string {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return ""
+}
+
+// Column of a board, holding the cards whose issues are in one of its statuses
+type BoardColumn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BoardId       string                 `protobuf:"bytes,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	StatusIds     []string               `protobuf:"bytes,5,rep,name=status_ids,json=statusIds,proto3" json:"status_ids,omitempty"`
+	MinWip        int32                  `protobuf:"varint,6,opt,name=min_wip,json=minWip,proto3" json:"min_wip,omitempty"` // 0 for no minimum
+	MaxWip        int32                  `protobuf:"varint,7,opt,name=max_wip,json=maxWip,proto3" json:"max_wip,omitempty"` // 0 for no limit
+	CardCount     int32                  `protobuf:"varint,8,opt,name=card_count,json=cardCount,proto3" json:"card_count,omitempty"`
+	WipViolation  WipViolation           `protobuf:"varint,9,opt,name=wip_violation,json=wipViolation,proto3,enum=board.v1.WipViolation" json:"wip_violation,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardColumn) Reset() {
+	*x = BoardColumn{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBoardsResponse) String() string {
+func (x *BoardColumn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBoardsResponse) ProtoMessage() {}
+func (*BoardColumn) ProtoMessage() {}
 
-func (x *ListBoardsResponse) ProtoReflect() protoreflect.Message {
+func (x *BoardColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardColumn.ProtoReflect.Descriptor instead.
+func (*BoardColumn) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BoardColumn) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BoardColumn) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+func (x *BoardColumn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BoardColumn) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *BoardColumn) GetStatusIds() []string {
+	if x != nil {
+		return x.StatusIds
+	}
+	return nil
+}
+
+func (x *BoardColumn) GetMinWip() int32 {
+	if x != nil {
+		return x.MinWip
+	}
+	return 0
+}
+
+func (x *BoardColumn) GetMaxWip() int32 {
+	if x != nil {
+		return x.MaxWip
+	}
+	return 0
+}
+
+func (x *BoardColumn) GetCardCount() int32 {
+	if x != nil {
+		return x.CardCount
+	}
+	return 0
+}
+
+func (x *BoardColumn) GetWipViolation() WipViolation {
+	if x != nil {
+		return x.WipViolation
+	}
+	return WipViolation_WIP_VIOLATION_NONE
+}
+
+func (x *BoardColumn) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *BoardColumn) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// Issue fields shown on a card
+type IssueSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Summary       string                 `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`         // e.g. "bug"
+	Priority      string                 `protobuf:"bytes,5,opt,name=priority,proto3" json:"priority,omitempty"` // e.g. "high"
+	StatusId      string                 `protobuf:"bytes,6,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	AssigneeId    string                 `protobuf:"bytes,7,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	ParentId      string                 `protobuf:"bytes,8,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	StoryPoints   int32                  `protobuf:"varint,9,opt,name=story_points,json=storyPoints,proto3" json:"story_points,omitempty"`
+	LabelIds      []string               `protobuf:"bytes,10,rep,name=label_ids,json=labelIds,proto3" json:"label_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueSummary) Reset() {
+	*x = IssueSummary{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueSummary) ProtoMessage() {}
+
+func (x *IssueSummary) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -460,42 +794,1718 @@ func (x *ListBoardsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBoardsResponse.ProtoReflect.Descriptor instead.
-func (*ListBoardsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use IssueSummary.ProtoReflect.Descriptor instead.
+func (*IssueSummary) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *IssueSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IssueSummary) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *IssueSummary) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *IssueSummary) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *IssueSummary) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *IssueSummary) GetStatusId() string {
+	if x != nil {
+		return x.StatusId
+	}
+	return ""
+}
+
+func (x *IssueSummary) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+func (x *IssueSummary) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *IssueSummary) GetStoryPoints() int32 {
+	if x != nil {
+		return x.StoryPoints
+	}
+	return 0
+}
+
+func (x *IssueSummary) GetLabelIds() []string {
+	if x != nil {
+		return x.LabelIds
+	}
+	return nil
+}
+
+type BoardViewCard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	Issue         *IssueSummary          `protobuf:"bytes,2,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardViewCard) Reset() {
+	*x = BoardViewCard{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardViewCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardViewCard) ProtoMessage() {}
+
+func (x *BoardViewCard) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardViewCard.ProtoReflect.Descriptor instead.
+func (*BoardViewCard) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BoardViewCard) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+func (x *BoardViewCard) GetIssue() *IssueSummary {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+// Cards of a lane in one column
+type BoardCell struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColumnId      string                 `protobuf:"bytes,1,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	Cards         []*BoardViewCard       `protobuf:"bytes,2,rep,name=cards,proto3" json:"cards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardCell) Reset() {
+	*x = BoardCell{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardCell) ProtoMessage() {}
+
+func (x *BoardCell) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardCell.ProtoReflect.Descriptor instead.
+func (*BoardCell) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BoardCell) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *BoardCell) GetCards() []*BoardViewCard {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+type BoardLane struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // Assignee, epic or custom field value the lane groups by
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Cells         []*BoardCell           `protobuf:"bytes,3,rep,name=cells,proto3" json:"cells,omitempty"` // One per column, left to right
+	CardCount     int32                  `protobuf:"varint,4,opt,name=card_count,json=cardCount,proto3" json:"card_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardLane) Reset() {
+	*x = BoardLane{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardLane) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardLane) ProtoMessage() {}
+
+func (x *BoardLane) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardLane.ProtoReflect.Descriptor instead.
+func (*BoardLane) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BoardLane) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BoardLane) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BoardLane) GetCells() []*BoardCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *BoardLane) GetCardCount() int32 {
+	if x != nil {
+		return x.CardCount
+	}
+	return 0
+}
+
+// Requests and responses
+type CreateBoardRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId            string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Filter               *BoardFilter           `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	ArchiveDoneAfterDays int32                  `protobuf:"varint,5,opt,name=archive_done_after_days,json=archiveDoneAfterDays,proto3" json:"archive_done_after_days,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateBoardRequest) Reset() {
+	*x = CreateBoardRequest{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBoardRequest) ProtoMessage() {}
+
+func (x *CreateBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBoardRequest.ProtoReflect.Descriptor instead.
+func (*CreateBoardRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateBoardRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateBoardRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBoardRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateBoardRequest) GetFilter() *BoardFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *CreateBoardRequest) GetArchiveDoneAfterDays() int32 {
+	if x != nil {
+		return x.ArchiveDoneAfterDays
+	}
+	return 0
+}
+
+type CreateBoardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Board         *Board                 `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBoardResponse) Reset() {
+	*x = CreateBoardResponse{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBoardResponse) ProtoMessage() {}
+
+func (x *CreateBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBoardResponse.ProtoReflect.Descriptor instead.
+func (*CreateBoardResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateBoardResponse) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+type GetBoardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBoardRequest) Reset() {
+	*x = GetBoardRequest{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBoardRequest) ProtoMessage() {}
+
+func (x *GetBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBoardRequest.ProtoReflect.Descriptor instead.
+func (*GetBoardRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetBoardRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetBoardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Board         *Board                 `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Columns       []*BoardColumn         `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBoardResponse) Reset() {
+	*x = GetBoardResponse{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBoardResponse) ProtoMessage() {}
+
+func (x *GetBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBoardResponse.ProtoReflect.Descriptor instead.
+func (*GetBoardResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetBoardResponse) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *GetBoardResponse) GetColumns() []*BoardColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+type GetBoardViewRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BoardId        string                 `protobuf:"bytes,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	QuickFilterIds []string               `protobuf:"bytes,2,rep,name=quick_filter_ids,json=quickFilterIds,proto3" json:"quick_filter_ids,omitempty"` // Cards must match all of them
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                           // Resolves currentUser() in queries
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetBoardViewRequest) Reset() {
+	*x = GetBoardViewRequest{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBoardViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBoardViewRequest) ProtoMessage() {}
+
+func (x *GetBoardViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBoardViewRequest.ProtoReflect.Descriptor instead.
+func (*GetBoardViewRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetBoardViewRequest) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+func (x *GetBoardViewRequest) GetQuickFilterIds() []string {
+	if x != nil {
+		return x.QuickFilterIds
+	}
+	return nil
+}
+
+func (x *GetBoardViewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetBoardViewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Board         *Board                 `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Columns       []*BoardColumn         `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"` // WIP counts cover the whole board
+	Lanes         []*BoardLane           `protobuf:"bytes,3,rep,name=lanes,proto3" json:"lanes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBoardViewResponse) Reset() {
+	*x = GetBoardViewResponse{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBoardViewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBoardViewResponse) ProtoMessage() {}
+
+func (x *GetBoardViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBoardViewResponse.ProtoReflect.Descriptor instead.
+func (*GetBoardViewResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetBoardViewResponse) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *GetBoardViewResponse) GetColumns() []*BoardColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *GetBoardViewResponse) GetLanes() []*BoardLane {
+	if x != nil {
+		return x.Lanes
+	}
+	return nil
+}
+
+type ListBoardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBoardsRequest) Reset() {
+	*x = ListBoardsRequest{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBoardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBoardsRequest) ProtoMessage() {}
+
+func (x *ListBoardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBoardsRequest.ProtoReflect.Descriptor instead.
+func (*ListBoardsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListBoardsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type ListBoardsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Boards        []*Board               `protobuf:"bytes,1,rep,name=boards,proto3" json:"boards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBoardsResponse) Reset() {
+	*x = ListBoardsResponse{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBoardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBoardsResponse) ProtoMessage() {}
+
+func (x *ListBoardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBoardsResponse.ProtoReflect.Descriptor instead.
+func (*ListBoardsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListBoardsResponse) GetBoards() []*Board {
+	if x != nil {
+		return x.Boards
+	}
+	return nil
+}
+
+type UpdateBoardRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Swimlanes            *SwimlaneConfig        `protobuf:"bytes,4,opt,name=swimlanes,proto3" json:"swimlanes,omitempty"`                         // Replaces the swimlanes when set
+	Filter               *BoardFilter           `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`                               // Replaces the filter when set
+	ClearFilter          bool                   `protobuf:"varint,6,opt,name=clear_filter,json=clearFilter,proto3" json:"clear_filter,omitempty"` // Goes back to adding cards by hand
+	ArchiveDoneAfterDays *int32                 `protobuf:"varint,7,opt,name=archive_done_after_days,json=archiveDoneAfterDays,proto3,oneof" json:"archive_done_after_days,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateBoardRequest) Reset() {
+	*x = UpdateBoardRequest{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBoardRequest) ProtoMessage() {}
+
+func (x *UpdateBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBoardRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBoardRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateBoardRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateBoardRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateBoardRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateBoardRequest) GetSwimlanes() *SwimlaneConfig {
+	if x != nil {
+		return x.Swimlanes
+	}
+	return nil
+}
+
+func (x *UpdateBoardRequest) GetFilter() *BoardFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *UpdateBoardRequest) GetClearFilter() bool {
+	if x != nil {
+		return x.ClearFilter
+	}
+	return false
+}
+
+func (x *UpdateBoardRequest) GetArchiveDoneAfterDays() int32 {
+	if x != nil && x.ArchiveDoneAfterDays != nil {
+		return *x.ArchiveDoneAfterDays
+	}
+	return 0
+}
+
+type UpdateBoardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Board         *Board                 `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBoardResponse) Reset() {
+	*x = UpdateBoardResponse{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBoardResponse) ProtoMessage() {}
+
+func (x *UpdateBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBoardResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBoardResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateBoardResponse) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+type DeleteBoardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBoardRequest) Reset() {
+	*x = DeleteBoardRequest{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBoardRequest) ProtoMessage() {}
+
+func (x *DeleteBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBoardRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBoardRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteBoardRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteBoardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBoardResponse) Reset() {
+	*x = DeleteBoardResponse{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBoardResponse) ProtoMessage() {}
+
+func (x *DeleteBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBoardResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBoardResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{22}
+}
+
+type AddCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BoardId       string                 `protobuf:"bytes,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	IssueId       string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCardRequest) Reset() {
+	*x = AddCardRequest{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCardRequest) ProtoMessage() {}
+
+func (x *AddCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCardRequest.ProtoReflect.Descriptor instead.
+func (*AddCardRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AddCardRequest) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+func (x *AddCardRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *AddCardRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type AddCardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCardResponse) Reset() {
+	*x = AddCardResponse{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCardResponse) ProtoMessage() {}
+
+func (x *AddCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCardResponse.ProtoReflect.Descriptor instead.
+func (*AddCardResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AddCardResponse) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+type MoveCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardId        string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	NewPosition   int32                  `protobuf:"varint,2,opt,name=new_position,json=newPosition,proto3" json:"new_position,omitempty"`
+	ColumnId      string                 `protobuf:"bytes,3,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"` // Runs the workflow transition into the column when set
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCardRequest) Reset() {
+	*x = MoveCardRequest{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCardRequest) ProtoMessage() {}
+
+func (x *MoveCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCardRequest.ProtoReflect.Descriptor instead.
+func (*MoveCardRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MoveCardRequest) GetCardId() string {
+	if x != nil {
+		return x.CardId
+	}
+	return ""
+}
+
+func (x *MoveCardRequest) GetNewPosition() int32 {
+	if x != nil {
+		return x.NewPosition
+	}
+	return 0
+}
+
+func (x *MoveCardRequest) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *MoveCardRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type MoveCardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCardResponse) Reset() {
+	*x = MoveCardResponse{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCardResponse) ProtoMessage() {}
+
+func (x *MoveCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCardResponse.ProtoReflect.Descriptor instead.
+func (*MoveCardResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MoveCardResponse) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+type DeleteCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardId        string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCardRequest) Reset() {
+	*x = DeleteCardRequest{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCardRequest) ProtoMessage() {}
+
+func (x *DeleteCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCardRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCardRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteCardRequest) GetCardId() string {
+	if x != nil {
+		return x.CardId
+	}
+	return ""
+}
+
+type DeleteCardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCardResponse) Reset() {
+	*x = DeleteCardResponse{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCardResponse) ProtoMessage() {}
+
+func (x *DeleteCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCardResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCardResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{28}
+}
+
+type ListCardsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BoardId         string                 `protobuf:"bytes,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,2,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListCardsRequest) Reset() {
+	*x = ListCardsRequest{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCardsRequest) ProtoMessage() {}
+
+func (x *ListCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCardsRequest.ProtoReflect.Descriptor instead.
+func (*ListCardsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListCardsRequest) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+func (x *ListCardsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type ListCardsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         []*Card                `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCardsResponse) Reset() {
+	*x = ListCardsResponse{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCardsResponse) ProtoMessage() {}
+
+func (x *ListCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCardsResponse.ProtoReflect.Descriptor instead.
+func (*ListCardsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListCardsResponse) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+type CreateBoardColumnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BoardId       string                 `protobuf:"bytes,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StatusIds     []string               `protobuf:"bytes,3,rep,name=status_ids,json=statusIds,proto3" json:"status_ids,omitempty"`
+	MinWip        int32                  `protobuf:"varint,4,opt,name=min_wip,json=minWip,proto3" json:"min_wip,omitempty"`
+	MaxWip        int32                  `protobuf:"varint,5,opt,name=max_wip,json=maxWip,proto3" json:"max_wip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBoardColumnRequest) Reset() {
+	*x = CreateBoardColumnRequest{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBoardColumnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBoardColumnRequest) ProtoMessage() {}
+
+func (x *CreateBoardColumnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBoardColumnRequest.ProtoReflect.Descriptor instead.
+func (*CreateBoardColumnRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateBoardColumnRequest) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+func (x *CreateBoardColumnRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBoardColumnRequest) GetStatusIds() []string {
+	if x != nil {
+		return x.StatusIds
+	}
+	return nil
+}
+
+func (x *CreateBoardColumnRequest) GetMinWip() int32 {
+	if x != nil {
+		return x.MinWip
+	}
+	return 0
+}
+
+func (x *CreateBoardColumnRequest) GetMaxWip() int32 {
+	if x != nil {
+		return x.MaxWip
+	}
+	return 0
+}
+
+type CreateBoardColumnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Column        *BoardColumn           `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBoardColumnResponse) Reset() {
+	*x = CreateBoardColumnResponse{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBoardColumnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBoardColumnResponse) ProtoMessage() {}
+
+func (x *CreateBoardColumnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBoardColumnResponse.ProtoReflect.Descriptor instead.
+func (*CreateBoardColumnResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateBoardColumnResponse) GetColumn() *BoardColumn {
+	if x != nil {
+		return x.Column
+	}
+	return nil
+}
+
+type UpdateBoardColumnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	StatusIds     []string               `protobuf:"bytes,3,rep,name=status_ids,json=statusIds,proto3" json:"status_ids,omitempty"` // Replaces the statuses when not empty
+	MinWip        *int32                 `protobuf:"varint,4,opt,name=min_wip,json=minWip,proto3,oneof" json:"min_wip,omitempty"`
+	MaxWip        *int32                 `protobuf:"varint,5,opt,name=max_wip,json=maxWip,proto3,oneof" json:"max_wip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBoardColumnRequest) Reset() {
+	*x = UpdateBoardColumnRequest{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBoardColumnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBoardColumnRequest) ProtoMessage() {}
+
+func (x *UpdateBoardColumnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBoardColumnRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBoardColumnRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateBoardColumnRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateBoardColumnRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateBoardColumnRequest) GetStatusIds() []string {
+	if x != nil {
+		return x.StatusIds
+	}
+	return nil
+}
+
+func (x *UpdateBoardColumnRequest) GetMinWip() int32 {
+	if x != nil && x.MinWip != nil {
+		return *x.MinWip
+	}
+	return 0
+}
+
+func (x *UpdateBoardColumnRequest) GetMaxWip() int32 {
+	if x != nil && x.MaxWip != nil {
+		return *x.MaxWip
+	}
+	return 0
+}
+
+type UpdateBoardColumnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Column        *BoardColumn           `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBoardColumnResponse) Reset() {
+	*x = UpdateBoardColumnResponse{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBoardColumnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBoardColumnResponse) ProtoMessage() {}
+
+func (x *UpdateBoardColumnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBoardColumnResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBoardColumnResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateBoardColumnResponse) GetColumn() *BoardColumn {
+	if x != nil {
+		return x.Column
+	}
+	return nil
+}
+
+type DeleteBoardColumnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBoardColumnRequest) Reset() {
+	*x = DeleteBoardColumnRequest{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBoardColumnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBoardColumnRequest) ProtoMessage() {}
+
+func (x *DeleteBoardColumnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBoardColumnRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBoardColumnRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteBoardColumnRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteBoardColumnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBoardColumnResponse) Reset() {
+	*x = DeleteBoardColumnResponse{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBoardColumnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBoardColumnResponse) ProtoMessage() {}
+
+func (x *DeleteBoardColumnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBoardColumnResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBoardColumnResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{36}
+}
+
+type ListBoardColumnsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BoardId       string                 `protobuf:"bytes,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBoardColumnsRequest) Reset() {
+	*x = ListBoardColumnsRequest{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBoardColumnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBoardColumnsRequest) ProtoMessage() {}
+
+func (x *ListBoardColumnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBoardColumnsRequest.ProtoReflect.Descriptor instead.
+func (*ListBoardColumnsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListBoardColumnsRequest) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+type ListBoardColumnsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Columns       []*BoardColumn         `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBoardColumnsResponse) Reset() {
+	*x = ListBoardColumnsResponse{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBoardColumnsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBoardColumnsResponse) ProtoMessage() {}
+
+func (x *ListBoardColumnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBoardColumnsResponse.ProtoReflect.Descriptor instead.
+func (*ListBoardColumnsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ListBoardsResponse) GetBoards() []*Board {
+func (x *ListBoardColumnsResponse) GetColumns() []*BoardColumn {
 	if x != nil {
-		return x.Boards
+		return x.Columns
 	}
 	return nil
 }
 
-type UpdateBoardRequest struct {
+type ReorderBoardColumnsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	BoardId       string                 `protobuf:"bytes,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	ColumnIds     []string               `protobuf:"bytes,2,rep,name=column_ids,json=columnIds,proto3" json:"column_ids,omitempty"` // Every column of the board, in the new order
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBoardRequest) Reset() {
-	*x = UpdateBoardRequest{}
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[8]
+func (x *ReorderBoardColumnsRequest) Reset() {
+	*x = ReorderBoardColumnsRequest{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBoardRequest) String() string {
+func (x *ReorderBoardColumnsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBoardRequest) ProtoMessage() {}
+func (*ReorderBoardColumnsRequest) ProtoMessage() {}
 
-func (x *UpdateBoardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[8]
+func (x *ReorderBoardColumnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -506,54 +2516,47 @@ func (x *UpdateBoardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBoardRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBoardRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *UpdateBoardRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use ReorderBoardColumnsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderBoardColumnsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *UpdateBoardRequest) GetName() string {
+func (x *ReorderBoardColumnsRequest) GetBoardId() string {
 	if x != nil {
-		return x.Name
+		return x.BoardId
 	}
 	return ""
 }
 
-func (x *UpdateBoardRequest) GetDescription() string {
+func (x *ReorderBoardColumnsRequest) GetColumnIds() []string {
 	if x != nil {
-		return x.Description
+		return x.ColumnIds
 	}
-	return ""
+	return nil
 }
 
-type UpdateBoardResponse struct {
+type ReorderBoardColumnsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Board         *Board                 `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Columns       []*BoardColumn         `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBoardResponse) Reset() {
-	*x = UpdateBoardResponse{}
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[9]
+func (x *ReorderBoardColumnsResponse) Reset() {
+	*x = ReorderBoardColumnsResponse{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBoardResponse) String() string {
+func (x *ReorderBoardColumnsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBoardResponse) ProtoMessage() {}
+func (*ReorderBoardColumnsResponse) ProtoMessage() {}
 
-func (x *UpdateBoardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[9]
+func (x *ReorderBoardColumnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -564,40 +2567,42 @@ func (x *UpdateBoardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBoardResponse.ProtoReflect.Descriptor instead.
-func (*UpdateBoardResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ReorderBoardColumnsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderBoardColumnsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *UpdateBoardResponse) GetBoard() *Board {
+func (x *ReorderBoardColumnsResponse) GetColumns() []*BoardColumn {
 	if x != nil {
-		return x.Board
+		return x.Columns
 	}
 	return nil
 }
 
-type DeleteBoardRequest struct {
+type CreateQuickFilterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BoardId       string                 `protobuf:"bytes,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Query         string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteBoardRequest) Reset() {
-	*x = DeleteBoardRequest{}
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[10]
+func (x *CreateQuickFilterRequest) Reset() {
+	*x = CreateQuickFilterRequest{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBoardRequest) String() string {
+func (x *CreateQuickFilterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBoardRequest) ProtoMessage() {}
+func (*CreateQuickFilterRequest) ProtoMessage() {}
 
-func (x *DeleteBoardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[10]
+func (x *CreateQuickFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -608,39 +2613,54 @@ func (x *DeleteBoardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBoardRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBoardRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use CreateQuickFilterRequest.ProtoReflect.Descriptor instead.
+func (*CreateQuickFilterRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *DeleteBoardRequest) GetId() string {
+func (x *CreateQuickFilterRequest) GetBoardId() string {
 	if x != nil {
-		return x.Id
+		return x.BoardId
 	}
 	return ""
 }
 
-type DeleteBoardResponse struct {
+func (x *CreateQuickFilterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateQuickFilterRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type CreateQuickFilterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuickFilter   *QuickFilter           `protobuf:"bytes,1,opt,name=quick_filter,json=quickFilter,proto3" json:"quick_filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteBoardResponse) Reset() {
-	*x = DeleteBoardResponse{}
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[11]
+func (x *CreateQuickFilterResponse) Reset() {
+	*x = CreateQuickFilterResponse{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBoardResponse) String() string {
+func (x *CreateQuickFilterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBoardResponse) ProtoMessage() {}
+func (*CreateQuickFilterResponse) ProtoMessage() {}
 
-func (x *DeleteBoardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[11]
+func (x *CreateQuickFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -651,35 +2671,42 @@ func (x *DeleteBoardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBoardResponse.ProtoReflect.Descriptor instead.
-func (*DeleteBoardResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use CreateQuickFilterResponse.ProtoReflect.Descriptor instead.
+func (*CreateQuickFilterResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{42}
 }
 
-type AddCardRequest struct {
+func (x *CreateQuickFilterResponse) GetQuickFilter() *QuickFilter {
+	if x != nil {
+		return x.QuickFilter
+	}
+	return nil
+}
+
+type UpdateQuickFilterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BoardId       string                 `protobuf:"bytes,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
-	IssueId       string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
-	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Query         *string                `protobuf:"bytes,3,opt,name=query,proto3,oneof" json:"query,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddCardRequest) Reset() {
-	*x = AddCardRequest{}
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[12]
+func (x *UpdateQuickFilterRequest) Reset() {
+	*x = UpdateQuickFilterRequest{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddCardRequest) String() string {
+func (x *UpdateQuickFilterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCardRequest) ProtoMessage() {}
+func (*UpdateQuickFilterRequest) ProtoMessage() {}
 
-func (x *AddCardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[12]
+func (x *UpdateQuickFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -690,54 +2717,54 @@ func (x *AddCardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCardRequest.ProtoReflect.Descriptor instead.
-func (*AddCardRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use UpdateQuickFilterRequest.ProtoReflect.Descriptor instead.
+func (*UpdateQuickFilterRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *AddCardRequest) GetBoardId() string {
+func (x *UpdateQuickFilterRequest) GetId() string {
 	if x != nil {
-		return x.BoardId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AddCardRequest) GetIssueId() string {
-	if x != nil {
-		return x.IssueId
+func (x *UpdateQuickFilterRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-func (x *AddCardRequest) GetPosition() int32 {
-	if x != nil {
-		return x.Position
+func (x *UpdateQuickFilterRequest) GetQuery() string {
+	if x != nil && x.Query != nil {
+		return *x.Query
 	}
-	return 0
+	return ""
 }
 
-type AddCardResponse struct {
+type UpdateQuickFilterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Card          *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	QuickFilter   *QuickFilter           `protobuf:"bytes,1,opt,name=quick_filter,json=quickFilter,proto3" json:"quick_filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddCardResponse) Reset() {
-	*x = AddCardResponse{}
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[13]
+func (x *UpdateQuickFilterResponse) Reset() {
+	*x = UpdateQuickFilterResponse{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddCardResponse) String() string {
+func (x *UpdateQuickFilterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCardResponse) ProtoMessage() {}
+func (*UpdateQuickFilterResponse) ProtoMessage() {}
 
-func (x *AddCardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[13]
+func (x *UpdateQuickFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -748,41 +2775,40 @@ func (x *AddCardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCardResponse.ProtoReflect.Descriptor instead.
-func (*AddCardResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use UpdateQuickFilterResponse.ProtoReflect.Descriptor instead.
+func (*UpdateQuickFilterResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *AddCardResponse) GetCard() *Card {
+func (x *UpdateQuickFilterResponse) GetQuickFilter() *QuickFilter {
 	if x != nil {
-		return x.Card
+		return x.QuickFilter
 	}
 	return nil
 }
 
-type MoveCardRequest struct {
+type DeleteQuickFilterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CardId        string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
-	NewPosition   int32                  `protobuf:"varint,2,opt,name=new_position,json=newPosition,proto3" json:"new_position,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MoveCardRequest) Reset() {
-	*x = MoveCardRequest{}
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[14]
+func (x *DeleteQuickFilterRequest) Reset() {
+	*x = DeleteQuickFilterRequest{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MoveCardRequest) String() string {
+func (x *DeleteQuickFilterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveCardRequest) ProtoMessage() {}
+func (*DeleteQuickFilterRequest) ProtoMessage() {}
 
-func (x *MoveCardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[14]
+func (x *DeleteQuickFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -793,47 +2819,39 @@ func (x *MoveCardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveCardRequest.ProtoReflect.Descriptor instead.
-func (*MoveCardRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use DeleteQuickFilterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteQuickFilterRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *MoveCardRequest) GetCardId() string {
+func (x *DeleteQuickFilterRequest) GetId() string {
 	if x != nil {
-		return x.CardId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *MoveCardRequest) GetNewPosition() int32 {
-	if x != nil {
-		return x.NewPosition
-	}
-	return 0
-}
-
-type MoveCardResponse struct {
+type DeleteQuickFilterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Card          *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MoveCardResponse) Reset() {
-	*x = MoveCardResponse{}
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[15]
+func (x *DeleteQuickFilterResponse) Reset() {
+	*x = DeleteQuickFilterResponse{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MoveCardResponse) String() string {
+func (x *DeleteQuickFilterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveCardResponse) ProtoMessage() {}
+func (*DeleteQuickFilterResponse) ProtoMessage() {}
 
-func (x *MoveCardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[15]
+func (x *DeleteQuickFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -844,40 +2862,33 @@ func (x *MoveCardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveCardResponse.ProtoReflect.Descriptor instead.
-func (*MoveCardResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *MoveCardResponse) GetCard() *Card {
-	if x != nil {
-		return x.Card
-	}
-	return nil
+// Deprecated: Use DeleteQuickFilterResponse.ProtoReflect.Descriptor instead.
+func (*DeleteQuickFilterResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{46}
 }
 
-type DeleteCardRequest struct {
+type ListQuickFiltersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CardId        string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	BoardId       string                 `protobuf:"bytes,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCardRequest) Reset() {
-	*x = DeleteCardRequest{}
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[16]
+func (x *ListQuickFiltersRequest) Reset() {
+	*x = ListQuickFiltersRequest{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCardRequest) String() string {
+func (x *ListQuickFiltersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCardRequest) ProtoMessage() {}
+func (*ListQuickFiltersRequest) ProtoMessage() {}
 
-func (x *DeleteCardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[16]
+func (x *ListQuickFiltersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -888,39 +2899,40 @@ func (x *DeleteCardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCardRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCardRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListQuickFiltersRequest.ProtoReflect.Descriptor instead.
+func (*ListQuickFiltersRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *DeleteCardRequest) GetCardId() string {
+func (x *ListQuickFiltersRequest) GetBoardId() string {
 	if x != nil {
-		return x.CardId
+		return x.BoardId
 	}
 	return ""
 }
 
-type DeleteCardResponse struct {
+type ListQuickFiltersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuickFilters  []*QuickFilter         `protobuf:"bytes,1,rep,name=quick_filters,json=quickFilters,proto3" json:"quick_filters,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCardResponse) Reset() {
-	*x = DeleteCardResponse{}
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[17]
+func (x *ListQuickFiltersResponse) Reset() {
+	*x = ListQuickFiltersResponse{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCardResponse) String() string {
+func (x *ListQuickFiltersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCardResponse) ProtoMessage() {}
+func (*ListQuickFiltersResponse) ProtoMessage() {}
 
-func (x *DeleteCardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[17]
+func (x *ListQuickFiltersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -931,33 +2943,40 @@ func (x *DeleteCardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCardResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCardResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListQuickFiltersResponse.ProtoReflect.Descriptor instead.
+func (*ListQuickFiltersResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{48}
 }
 
-type ListCardsRequest struct {
+func (x *ListQuickFiltersResponse) GetQuickFilters() []*QuickFilter {
+	if x != nil {
+		return x.QuickFilters
+	}
+	return nil
+}
+
+type SyncBoardCardsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BoardId       string                 `protobuf:"bytes,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCardsRequest) Reset() {
-	*x = ListCardsRequest{}
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[18]
+func (x *SyncBoardCardsRequest) Reset() {
+	*x = SyncBoardCardsRequest{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCardsRequest) String() string {
+func (x *SyncBoardCardsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCardsRequest) ProtoMessage() {}
+func (*SyncBoardCardsRequest) ProtoMessage() {}
 
-func (x *ListCardsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[18]
+func (x *SyncBoardCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -968,40 +2987,41 @@ func (x *ListCardsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCardsRequest.ProtoReflect.Descriptor instead.
-func (*ListCardsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SyncBoardCardsRequest.ProtoReflect.Descriptor instead.
+func (*SyncBoardCardsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ListCardsRequest) GetBoardId() string {
+func (x *SyncBoardCardsRequest) GetBoardId() string {
 	if x != nil {
 		return x.BoardId
 	}
 	return ""
 }
 
-type ListCardsResponse struct {
+type SyncBoardCardsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cards         []*Card                `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	Added         int32                  `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"`
+	Removed       int32                  `protobuf:"varint,2,opt,name=removed,proto3" json:"removed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCardsResponse) Reset() {
-	*x = ListCardsResponse{}
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[19]
+func (x *SyncBoardCardsResponse) Reset() {
+	*x = SyncBoardCardsResponse{}
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCardsResponse) String() string {
+func (x *SyncBoardCardsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCardsResponse) ProtoMessage() {}
+func (*SyncBoardCardsResponse) ProtoMessage() {}
 
-func (x *ListCardsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[19]
+func (x *SyncBoardCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_board_v1_board_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1012,23 +3032,30 @@ func (x *ListCardsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCardsResponse.ProtoReflect.Descriptor instead.
-func (*ListCardsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use SyncBoardCardsResponse.ProtoReflect.Descriptor instead.
+func (*SyncBoardCardsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_board_v1_board_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ListCardsResponse) GetCards() []*Card {
+func (x *SyncBoardCardsResponse) GetAdded() int32 {
 	if x != nil {
-		return x.Cards
+		return x.Added
 	}
-	return nil
+	return 0
+}
+
+func (x *SyncBoardCardsResponse) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
 }
 
 var File_pkg_proto_board_v1_board_proto protoreflect.FileDescriptor
 
 const file_pkg_proto_board_v1_board_proto_rawDesc = "" +
 	"\n" +
-	"\x1epkg/proto/board/v1/board.proto\x12\bboard.v1\"\xaa\x01\n" +
+	"\x1epkg/proto/board/v1/board.proto\x12\bboard.v1\"\xc8\x02\n" +
 	"\x05Board\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -1038,7 +3065,32 @@ const file_pkg_proto_board_v1_board_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"\xa6\x01\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\x126\n" +
+	"\tswimlanes\x18\a \x01(\v2\x18.board.v1.SwimlaneConfigR\tswimlanes\x12-\n" +
+	"\x06filter\x18\b \x01(\v2\x15.board.v1.BoardFilterR\x06filter\x125\n" +
+	"\x17archive_done_after_days\x18\t \x01(\x05R\x14archiveDoneAfterDays\"a\n" +
+	"\vBoardFilter\x12\x1f\n" +
+	"\vissue_types\x18\x01 \x03(\tR\n" +
+	"issueTypes\x12\x1b\n" +
+	"\tlabel_ids\x18\x02 \x03(\tR\blabelIds\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\"\x91\x01\n" +
+	"\x0eSwimlaneConfig\x12$\n" +
+	"\x02by\x18\x01 \x01(\x0e2\x14.board.v1.SwimlaneByR\x02by\x12&\n" +
+	"\x0fcustom_field_id\x18\x02 \x01(\tR\rcustomFieldId\x121\n" +
+	"\aqueries\x18\x03 \x03(\v2\x17.board.v1.SwimlaneQueryR\aqueries\"9\n" +
+	"\rSwimlaneQuery\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\"\xbc\x01\n" +
+	"\vQuickFilter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bboard_id\x18\x02 \x01(\tR\aboardId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05query\x18\x04 \x01(\tR\x05query\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x05R\bposition\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\"\x9a\x02\n" +
 	"\x04Card\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bboard_id\x18\x02 \x01(\tR\aboardId\x12\x19\n" +
@@ -1047,27 +3099,91 @@ const file_pkg_proto_board_v1_board_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"i\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\x12\x1b\n" +
+	"\tstatus_id\x18\a \x01(\tR\bstatusId\x12\x1b\n" +
+	"\tcolumn_id\x18\b \x01(\tR\bcolumnId\x12\x17\n" +
+	"\adone_at\x18\t \x01(\tR\x06doneAt\x12\x1f\n" +
+	"\varchived_at\x18\n" +
+	" \x01(\tR\n" +
+	"archivedAt\"\xd3\x02\n" +
+	"\vBoardColumn\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bboard_id\x18\x02 \x01(\tR\aboardId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x12\x1d\n" +
+	"\n" +
+	"status_ids\x18\x05 \x03(\tR\tstatusIds\x12\x17\n" +
+	"\amin_wip\x18\x06 \x01(\x05R\x06minWip\x12\x17\n" +
+	"\amax_wip\x18\a \x01(\x05R\x06maxWip\x12\x1d\n" +
+	"\n" +
+	"card_count\x18\b \x01(\x05R\tcardCount\x12;\n" +
+	"\rwip_violation\x18\t \x01(\x0e2\x16.board.v1.WipViolationR\fwipViolation\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAt\"\x95\x02\n" +
+	"\fIssueSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x18\n" +
+	"\asummary\x18\x03 \x01(\tR\asummary\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\tR\bpriority\x12\x1b\n" +
+	"\tstatus_id\x18\x06 \x01(\tR\bstatusId\x12\x1f\n" +
+	"\vassignee_id\x18\a \x01(\tR\n" +
+	"assigneeId\x12\x1b\n" +
+	"\tparent_id\x18\b \x01(\tR\bparentId\x12!\n" +
+	"\fstory_points\x18\t \x01(\x05R\vstoryPoints\x12\x1b\n" +
+	"\tlabel_ids\x18\n" +
+	" \x03(\tR\blabelIds\"a\n" +
+	"\rBoardViewCard\x12\"\n" +
+	"\x04card\x18\x01 \x01(\v2\x0e.board.v1.CardR\x04card\x12,\n" +
+	"\x05issue\x18\x02 \x01(\v2\x16.board.v1.IssueSummaryR\x05issue\"W\n" +
+	"\tBoardCell\x12\x1b\n" +
+	"\tcolumn_id\x18\x01 \x01(\tR\bcolumnId\x12-\n" +
+	"\x05cards\x18\x02 \x03(\v2\x17.board.v1.BoardViewCardR\x05cards\"{\n" +
+	"\tBoardLane\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12)\n" +
+	"\x05cells\x18\x03 \x03(\v2\x13.board.v1.BoardCellR\x05cells\x12\x1d\n" +
+	"\n" +
+	"card_count\x18\x04 \x01(\x05R\tcardCount\"\xcf\x01\n" +
 	"\x12CreateBoardRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"<\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12-\n" +
+	"\x06filter\x18\x04 \x01(\v2\x15.board.v1.BoardFilterR\x06filter\x125\n" +
+	"\x17archive_done_after_days\x18\x05 \x01(\x05R\x14archiveDoneAfterDays\"<\n" +
 	"\x13CreateBoardResponse\x12%\n" +
 	"\x05board\x18\x01 \x01(\v2\x0f.board.v1.BoardR\x05board\"!\n" +
 	"\x0fGetBoardRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"9\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"j\n" +
 	"\x10GetBoardResponse\x12%\n" +
-	"\x05board\x18\x01 \x01(\v2\x0f.board.v1.BoardR\x05board\"2\n" +
+	"\x05board\x18\x01 \x01(\v2\x0f.board.v1.BoardR\x05board\x12/\n" +
+	"\acolumns\x18\x02 \x03(\v2\x15.board.v1.BoardColumnR\acolumns\"s\n" +
+	"\x13GetBoardViewRequest\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\tR\aboardId\x12(\n" +
+	"\x10quick_filter_ids\x18\x02 \x03(\tR\x0equickFilterIds\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"\x99\x01\n" +
+	"\x14GetBoardViewResponse\x12%\n" +
+	"\x05board\x18\x01 \x01(\v2\x0f.board.v1.BoardR\x05board\x12/\n" +
+	"\acolumns\x18\x02 \x03(\v2\x15.board.v1.BoardColumnR\acolumns\x12)\n" +
+	"\x05lanes\x18\x03 \x03(\v2\x13.board.v1.BoardLaneR\x05lanes\"2\n" +
 	"\x11ListBoardsRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\"=\n" +
 	"\x12ListBoardsResponse\x12'\n" +
-	"\x06boards\x18\x01 \x03(\v2\x0f.board.v1.BoardR\x06boards\"Z\n" +
+	"\x06boards\x18\x01 \x03(\v2\x0f.board.v1.BoardR\x06boards\"\xbc\x02\n" +
 	"\x12UpdateBoardRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"<\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x126\n" +
+	"\tswimlanes\x18\x04 \x01(\v2\x18.board.v1.SwimlaneConfigR\tswimlanes\x12-\n" +
+	"\x06filter\x18\x05 \x01(\v2\x15.board.v1.BoardFilterR\x06filter\x12!\n" +
+	"\fclear_filter\x18\x06 \x01(\bR\vclearFilter\x12:\n" +
+	"\x17archive_done_after_days\x18\a \x01(\x05H\x00R\x14archiveDoneAfterDays\x88\x01\x01B\x1a\n" +
+	"\x18_archive_done_after_days\"<\n" +
 	"\x13UpdateBoardResponse\x12%\n" +
 	"\x05board\x18\x01 \x01(\v2\x0f.board.v1.BoardR\x05board\"$\n" +
 	"\x12DeleteBoardRequest\x12\x0e\n" +
@@ -1078,22 +3194,100 @@ const file_pkg_proto_board_v1_board_proto_rawDesc = "" +
 	"\bissue_id\x18\x02 \x01(\tR\aissueId\x12\x1a\n" +
 	"\bposition\x18\x03 \x01(\x05R\bposition\"5\n" +
 	"\x0fAddCardResponse\x12\"\n" +
-	"\x04card\x18\x01 \x01(\v2\x0e.board.v1.CardR\x04card\"M\n" +
+	"\x04card\x18\x01 \x01(\v2\x0e.board.v1.CardR\x04card\"\x83\x01\n" +
 	"\x0fMoveCardRequest\x12\x17\n" +
 	"\acard_id\x18\x01 \x01(\tR\x06cardId\x12!\n" +
-	"\fnew_position\x18\x02 \x01(\x05R\vnewPosition\"6\n" +
+	"\fnew_position\x18\x02 \x01(\x05R\vnewPosition\x12\x1b\n" +
+	"\tcolumn_id\x18\x03 \x01(\tR\bcolumnId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\"6\n" +
 	"\x10MoveCardResponse\x12\"\n" +
 	"\x04card\x18\x01 \x01(\v2\x0e.board.v1.CardR\x04card\",\n" +
 	"\x11DeleteCardRequest\x12\x17\n" +
 	"\acard_id\x18\x01 \x01(\tR\x06cardId\"\x14\n" +
-	"\x12DeleteCardResponse\"-\n" +
+	"\x12DeleteCardResponse\"X\n" +
 	"\x10ListCardsRequest\x12\x19\n" +
-	"\bboard_id\x18\x01 \x01(\tR\aboardId\"9\n" +
+	"\bboard_id\x18\x01 \x01(\tR\aboardId\x12)\n" +
+	"\x10include_archived\x18\x02 \x01(\bR\x0fincludeArchived\"9\n" +
 	"\x11ListCardsResponse\x12$\n" +
-	"\x05cards\x18\x01 \x03(\v2\x0e.board.v1.CardR\x05cards2\x90\x05\n" +
+	"\x05cards\x18\x01 \x03(\v2\x0e.board.v1.CardR\x05cards\"\x9a\x01\n" +
+	"\x18CreateBoardColumnRequest\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\tR\aboardId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"status_ids\x18\x03 \x03(\tR\tstatusIds\x12\x17\n" +
+	"\amin_wip\x18\x04 \x01(\x05R\x06minWip\x12\x17\n" +
+	"\amax_wip\x18\x05 \x01(\x05R\x06maxWip\"J\n" +
+	"\x19CreateBoardColumnResponse\x12-\n" +
+	"\x06column\x18\x01 \x01(\v2\x15.board.v1.BoardColumnR\x06column\"\xbf\x01\n" +
+	"\x18UpdateBoardColumnRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"status_ids\x18\x03 \x03(\tR\tstatusIds\x12\x1c\n" +
+	"\amin_wip\x18\x04 \x01(\x05H\x01R\x06minWip\x88\x01\x01\x12\x1c\n" +
+	"\amax_wip\x18\x05 \x01(\x05H\x02R\x06maxWip\x88\x01\x01B\a\n" +
+	"\x05_nameB\n" +
+	"\n" +
+	"\b_min_wipB\n" +
+	"\n" +
+	"\b_max_wip\"J\n" +
+	"\x19UpdateBoardColumnResponse\x12-\n" +
+	"\x06column\x18\x01 \x01(\v2\x15.board.v1.BoardColumnR\x06column\"*\n" +
+	"\x18DeleteBoardColumnRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1b\n" +
+	"\x19DeleteBoardColumnResponse\"4\n" +
+	"\x17ListBoardColumnsRequest\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\tR\aboardId\"K\n" +
+	"\x18ListBoardColumnsResponse\x12/\n" +
+	"\acolumns\x18\x01 \x03(\v2\x15.board.v1.BoardColumnR\acolumns\"V\n" +
+	"\x1aReorderBoardColumnsRequest\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\tR\aboardId\x12\x1d\n" +
+	"\n" +
+	"column_ids\x18\x02 \x03(\tR\tcolumnIds\"N\n" +
+	"\x1bReorderBoardColumnsResponse\x12/\n" +
+	"\acolumns\x18\x01 \x03(\v2\x15.board.v1.BoardColumnR\acolumns\"_\n" +
+	"\x18CreateQuickFilterRequest\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\tR\aboardId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\"U\n" +
+	"\x19CreateQuickFilterResponse\x128\n" +
+	"\fquick_filter\x18\x01 \x01(\v2\x15.board.v1.QuickFilterR\vquickFilter\"q\n" +
+	"\x18UpdateQuickFilterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x19\n" +
+	"\x05query\x18\x03 \x01(\tH\x01R\x05query\x88\x01\x01B\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_query\"U\n" +
+	"\x19UpdateQuickFilterResponse\x128\n" +
+	"\fquick_filter\x18\x01 \x01(\v2\x15.board.v1.QuickFilterR\vquickFilter\"*\n" +
+	"\x18DeleteQuickFilterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1b\n" +
+	"\x19DeleteQuickFilterResponse\"4\n" +
+	"\x17ListQuickFiltersRequest\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\tR\aboardId\"V\n" +
+	"\x18ListQuickFiltersResponse\x12:\n" +
+	"\rquick_filters\x18\x01 \x03(\v2\x15.board.v1.QuickFilterR\fquickFilters\"2\n" +
+	"\x15SyncBoardCardsRequest\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\tR\aboardId\"H\n" +
+	"\x16SyncBoardCardsResponse\x12\x14\n" +
+	"\x05added\x18\x01 \x01(\x05R\x05added\x12\x18\n" +
+	"\aremoved\x18\x02 \x01(\x05R\aremoved*\xa1\x01\n" +
+	"\n" +
+	"SwimlaneBy\x12\x14\n" +
+	"\x10SWIMLANE_BY_NONE\x10\x00\x12\x18\n" +
+	"\x14SWIMLANE_BY_ASSIGNEE\x10\x01\x12\x14\n" +
+	"\x10SWIMLANE_BY_EPIC\x10\x02\x12\x18\n" +
+	"\x14SWIMLANE_BY_PRIORITY\x10\x03\x12\x1c\n" +
+	"\x18SWIMLANE_BY_CUSTOM_FIELD\x10\x04\x12\x15\n" +
+	"\x11SWIMLANE_BY_QUERY\x10\x05*_\n" +
+	"\fWipViolation\x12\x16\n" +
+	"\x12WIP_VIOLATION_NONE\x10\x00\x12\x1b\n" +
+	"\x17WIP_VIOLATION_UNDER_MIN\x10\x01\x12\x1a\n" +
+	"\x16WIP_VIOLATION_OVER_MAX\x10\x022\x82\r\n" +
 	"\fBoardService\x12J\n" +
 	"\vCreateBoard\x12\x1c.board.v1.CreateBoardRequest\x1a\x1d.board.v1.CreateBoardResponse\x12A\n" +
-	"\bGetBoard\x12\x19.board.v1.GetBoardRequest\x1a\x1a.board.v1.GetBoardResponse\x12G\n" +
+	"\bGetBoard\x12\x19.board.v1.GetBoardRequest\x1a\x1a.board.v1.GetBoardResponse\x12M\n" +
+	"\fGetBoardView\x12\x1d.board.v1.GetBoardViewRequest\x1a\x1e.board.v1.GetBoardViewResponse\x12G\n" +
 	"\n" +
 	"ListBoards\x12\x1b.board.v1.ListBoardsRequest\x1a\x1c.board.v1.ListBoardsResponse\x12J\n" +
 	"\vUpdateBoard\x12\x1c.board.v1.UpdateBoardRequest\x1a\x1d.board.v1.UpdateBoardResponse\x12J\n" +
@@ -1102,7 +3296,17 @@ const file_pkg_proto_board_v1_board_proto_rawDesc = "" +
 	"\bMoveCard\x12\x19.board.v1.MoveCardRequest\x1a\x1a.board.v1.MoveCardResponse\x12G\n" +
 	"\n" +
 	"DeleteCard\x12\x1b.board.v1.DeleteCardRequest\x1a\x1c.board.v1.DeleteCardResponse\x12D\n" +
-	"\tListCards\x12\x1a.board.v1.ListCardsRequest\x1a\x1b.board.v1.ListCardsResponseB4Z2github.com/nexusflow/nexusflow/pkg/proto/board/v1;b\x06proto3"
+	"\tListCards\x12\x1a.board.v1.ListCardsRequest\x1a\x1b.board.v1.ListCardsResponse\x12S\n" +
+	"\x0eSyncBoardCards\x12\x1f.board.v1.SyncBoardCardsRequest\x1a .board.v1.SyncBoardCardsResponse\x12\\\n" +
+	"\x11CreateBoardColumn\x12\".board.v1.CreateBoardColumnRequest\x1a#.board.v1.CreateBoardColumnResponse\x12\\\n" +
+	"\x11UpdateBoardColumn\x12\".board.v1.UpdateBoardColumnRequest\x1a#.board.v1.UpdateBoardColumnResponse\x12\\\n" +
+	"\x11DeleteBoardColumn\x12\".board.v1.DeleteBoardColumnRequest\x1a#.board.v1.DeleteBoardColumnResponse\x12Y\n" +
+	"\x10ListBoardColumns\x12!.board.v1.ListBoardColumnsRequest\x1a\".board.v1.ListBoardColumnsResponse\x12b\n" +
+	"\x13ReorderBoardColumns\x12$.board.v1.ReorderBoardColumnsRequest\x1a%.board.v1.ReorderBoardColumnsResponse\x12\\\n" +
+	"\x11CreateQuickFilter\x12\".board.v1.CreateQuickFilterRequest\x1a#.board.v1.CreateQuickFilterResponse\x12\\\n" +
+	"\x11UpdateQuickFilter\x12\".board.v1.UpdateQuickFilterRequest\x1a#.board.v1.UpdateQuickFilterResponse\x12\\\n" +
+	"\x11DeleteQuickFilter\x12\".board.v1.DeleteQuickFilterRequest\x1a#.board.v1.DeleteQuickFilterResponse\x12Y\n" +
+	"\x10ListQuickFilters\x12!.board.v1.ListQuickFiltersRequest\x1a\".board.v1.ListQuickFiltersResponseB4Z2github.com/nexusflow/nexusflow/pkg/proto/board/v1;b\x06proto3"
 
 var (
 	file_pkg_proto_board_v1_board_proto_rawDescOnce sync.Once
@@ -1116,60 +3320,139 @@ func file_pkg_proto_board_v1_board_proto_rawDescGZIP() []byte {
 	return file_pkg_proto_board_v1_board_proto_rawDescData
 }
 
-var file_pkg_proto_board_v1_board_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_pkg_proto_board_v1_board_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_pkg_proto_board_v1_board_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
 var file_pkg_proto_board_v1_board_proto_goTypes = []any{
-	(*Board)(nil),               // 0: board.v1.Board
-	(*Card)(nil),                // 1: board.v1.Card
-	(*CreateBoardRequest)(nil),  // 2: board.v1.CreateBoardRequest
-	(*CreateBoardResponse)(nil), // 3: board.v1.CreateBoardResponse
-	(*GetBoardRequest)(nil),     // 4: board.v1.GetBoardRequest
-	(*GetBoardResponse)(nil),    // 5: board.v1.GetBoardResponse
-	(*ListBoardsRequest)(nil),   // 6: board.v1.ListBoardsRequest
-	(*ListBoardsResponse)(nil),  // 7: board.v1.ListBoardsResponse
-	(*UpdateBoardRequest)(nil),  // 8: board.v1.UpdateBoardRequest
-	(*UpdateBoardResponse)(nil), // 9: board.v1.UpdateBoardResponse
-	(*DeleteBoardRequest)(nil),  // 10: board.v1.DeleteBoardRequest
-	(*DeleteBoardResponse)(nil), // 11: board.v1.DeleteBoardResponse
-	(*AddCardRequest)(nil),      // 12: board.v1.AddCardRequest
-	(*AddCardResponse)(nil),     // 13: board.v1.AddCardResponse
-	(*MoveCardRequest)(nil),     // 14: board.v1.MoveCardRequest
-	(*MoveCardResponse)(nil),    // 15: board.v1.MoveCardResponse
-	(*DeleteCardRequest)(nil),   // 16: board.v1.DeleteCardRequest
-	(*DeleteCardResponse)(nil),  // 17: board.v1.DeleteCardResponse
-	(*ListCardsRequest)(nil),    // 18: board.v1.ListCardsRequest
-	(*ListCardsResponse)(nil),   // 19: board.v1.ListCardsResponse
+	(SwimlaneBy)(0),                     // 0: board.v1.SwimlaneBy
+	(WipViolation)(0),                   // 1: board.v1.WipViolation
+	(*Board)(nil),                       // 2: board.v1.Board
+	(*BoardFilter)(nil),                 // 3: board.v1.BoardFilter
+	(*SwimlaneConfig)(nil),              // 4: board.v1.SwimlaneConfig
+	(*SwimlaneQuery)(nil),               // 5: board.v1.SwimlaneQuery
+	(*QuickFilter)(nil),                 // 6: board.v1.QuickFilter
+	(*Card)(nil),                        // 7: board.v1.Card
+	(*BoardColumn)(nil),                 // 8: board.v1.BoardColumn
+	(*IssueSummary)(nil),                // 9: board.v1.IssueSummary
+	(*BoardViewCard)(nil),               // 10: board.v1.BoardViewCard
+	(*BoardCell)(nil),                   // 11: board.v1.BoardCell
+	(*BoardLane)(nil),                   // 12: board.v1.BoardLane
+	(*CreateBoardRequest)(nil),          // 13: board.v1.CreateBoardRequest
+	(*CreateBoardResponse)(nil),         // 14: board.v1.CreateBoardResponse
+	(*GetBoardRequest)(nil),             // 15: board.v1.GetBoardRequest
+	(*GetBoardResponse)(nil),            // 16: board.v1.GetBoardResponse
+	(*GetBoardViewRequest)(nil),         // 17: board.v1.GetBoardViewRequest
+	(*GetBoardViewResponse)(nil),        // 18: board.v1.GetBoardViewResponse
+	(*ListBoardsRequest)(nil),           // 19: board.v1.ListBoardsRequest
+	(*ListBoardsResponse)(nil),          // 20: board.v1.ListBoardsResponse
+	(*UpdateBoardRequest)(nil),          // 21: board.v1.UpdateBoardRequest
+	(*UpdateBoardResponse)(nil),         // 22: board.v1.UpdateBoardResponse
+	(*DeleteBoardRequest)(nil),          // 23: board.v1.DeleteBoardRequest
+	(*DeleteBoardResponse)(nil),         // 24: board.v1.DeleteBoardResponse
+	(*AddCardRequest)(nil),              // 25: board.v1.AddCardRequest
+	(*AddCardResponse)(nil),             // 26: board.v1.AddCardResponse
+	(*MoveCardRequest)(nil),             // 27: board.v1.MoveCardRequest
+	(*MoveCardResponse)(nil),            // 28: board.v1.MoveCardResponse
+	(*DeleteCardRequest)(nil),           // 29: board.v1.DeleteCardRequest
+	(*DeleteCardResponse)(nil),          // 30: board.v1.DeleteCardResponse
+	(*ListCardsRequest)(nil),            // 31: board.v1.ListCardsRequest
+	(*ListCardsResponse)(nil),           // 32: board.v1.ListCardsResponse
+	(*CreateBoardColumnRequest)(nil),    // 33: board.v1.CreateBoardColumnRequest
+	(*CreateBoardColumnResponse)(nil),   // 34: board.v1.CreateBoardColumnResponse
+	(*UpdateBoardColumnRequest)(nil),    // 35: board.v1.UpdateBoardColumnRequest
+	(*UpdateBoardColumnResponse)(nil),   // 36: board.v1.UpdateBoardColumnResponse
+	(*DeleteBoardColumnRequest)(nil),    // 37: board.v1.DeleteBoardColumnRequest
+	(*DeleteBoardColumnResponse)(nil),   // 38: board.v1.DeleteBoardColumnResponse
+	(*ListBoardColumnsRequest)(nil),     // 39: board.v1.ListBoardColumnsRequest
+	(*ListBoardColumnsResponse)(nil),    // 40: board.v1.ListBoardColumnsResponse
+	(*ReorderBoardColumnsRequest)(nil),  // 41: board.v1.ReorderBoardColumnsRequest
+	(*ReorderBoardColumnsResponse)(nil), // 42: board.v1.ReorderBoardColumnsResponse
+	(*CreateQuickFilterRequest)(nil),    // 43: board.v1.CreateQuickFilterRequest
+	(*CreateQuickFilterResponse)(nil),   // 44: board.v1.CreateQuickFilterResponse
+	(*UpdateQuickFilterRequest)(nil),    // 45: board.v1.UpdateQuickFilterRequest
+	(*UpdateQuickFilterResponse)(nil),   // 46: board.v1.UpdateQuickFilterResponse
+	(*DeleteQuickFilterRequest)(nil),    // 47: board.v1.DeleteQuickFilterRequest
+	(*DeleteQuickFilterResponse)(nil),   // 48: board.v1.DeleteQuickFilterResponse
+	(*ListQuickFiltersRequest)(nil),     // 49: board.v1.ListQuickFiltersRequest
+	(*ListQuickFiltersResponse)(nil),    // 50: board.v1.ListQuickFiltersResponse
+	(*SyncBoardCardsRequest)(nil),       // 51: board.v1.SyncBoardCardsRequest
+	(*SyncBoardCardsResponse)(nil),      // 52: board.v1.SyncBoardCardsResponse
 }
 var file_pkg_proto_board_v1_board_proto_depIdxs = []int32{
-	0,  // 0: board.v1.CreateBoardResponse.board:type_name -> board.v1.Board
-	0,  // 1: board.v1.GetBoardResponse.board:type_name -> board.v1.Board
-	0,  // 2: board.v1.ListBoardsResponse.boards:type_name -> board.v1.Board
-	0,  // 3: board.v1.UpdateBoardResponse.board:type_name -> board.v1.Board
-	1,  // 4: board.v1.AddCardResponse.card:type_name -> board.v1.Card
-	1,  // 5: board.v1.MoveCardResponse.card:type_name -> board.v1.Card
-	1,  // 6: board.v1.ListCardsResponse.cards:type_name -> board.v1.Card
-	2,  // 7: board.v1.BoardService.CreateBoard:input_type -> board.v1.CreateBoardRequest
-	4,  // 8: board.v1.BoardService.GetBoard:input_type -> board.v1.GetBoardRequest
-	6,  // 9: board.v1.BoardService.ListBoards:input_type -> board.v1.ListBoardsRequest
-	8,  // 10: board.v1.BoardService.UpdateBoard:input_type -> board.v1.UpdateBoardRequest
-	10, // 11: board.v1.BoardService.DeleteBoard:input_type -> board.v1.DeleteBoardRequest
-	12, // 12: board.v1.BoardService.AddCard:input_type -> board.v1.AddCardRequest
-	14, // 13: board.v1.BoardService.MoveCard:input_type -> board.v1.MoveCardRequest
-	16, // 14: board.v1.BoardService.DeleteCard:input_type -> board.v1.DeleteCardRequest
-	18, // 15: board.v1.BoardService.ListCards:input_type -> board.v1.ListCardsRequest
-	3,  // 16: board.v1.BoardService.CreateBoard:output_type -> board.v1.CreateBoardResponse
-	5,  // 17: board.v1.BoardService.GetBoard:output_type -> board.v1.GetBoardResponse
-	7,  // 18: board.v1.BoardService.ListBoards:output_type -> board.v1.ListBoardsResponse
-	9,  // 19: board.v1.BoardService.UpdateBoard:output_type -> board.v1.UpdateBoardResponse
-	11, // 20: board.v1.BoardService.DeleteBoard:output_type -> board.v1.DeleteBoardResponse
-	13, // 21: board.v1.BoardService.AddCard:output_type -> board.v1.AddCardResponse
-	15, // 22: board.v1.BoardService.MoveCard:output_type -> board.v1.MoveCardResponse
-	17, // 23: board.v1.BoardService.DeleteCard:output_type -> board.v1.DeleteCardResponse
-	19, // 24: board.v1.BoardService.ListCards:output_type -> board.v1.ListCardsResponse
-	16, // [16:25] is the sub-list for method output_type
-	7,  // [7:16] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	4,  // 0: board.v1.Board.swimlanes:type_name -> board.v1.SwimlaneConfig
+	3,  // 1: board.v1.Board.filter:type_name -> board.v1.BoardFilter
+	0,  // 2: board.v1.SwimlaneConfig.by:type_name -> board.v1.SwimlaneBy
+	5,  // 3: board.v1.SwimlaneConfig.queries:type_name -> board.v1.SwimlaneQuery
+	1,  // 4: board.v1.BoardColumn.wip_violation:type_name -> board.v1.WipViolation
+	7,  // 5: board.v1.BoardViewCard.card:type_name -> board.v1.Card
+	9,  // 6: board.v1.BoardViewCard.issue:type_name -> board.v1.IssueSummary
+	10, // 7: board.v1.BoardCell.cards:type_name -> board.v1.BoardViewCard
+	11, // 8: board.v1.BoardLane.cells:type_name -> board.v1.BoardCell
+	3,  // 9: board.v1.CreateBoardRequest.filter:type_name -> board.v1.BoardFilter
+	2,  // 10: board.v1.CreateBoardResponse.board:type_name -> board.v1.Board
+	2,  // 11: board.v1.GetBoardResponse.board:type_name -> board.v1.Board
+	8,  // 12: board.v1.GetBoardResponse.columns:type_name -> board.v1.BoardColumn
+	2,  // 13: board.v1.GetBoardViewResponse.board:type_name -> board.v1.Board
+	8,  // 14: board.v1.GetBoardViewResponse.columns:type_name -> board.v1.BoardColumn
+	12, // 15: board.v1.GetBoardViewResponse.lanes:type_name -> board.v1.BoardLane
+	2,  // 16: board.v1.ListBoardsResponse.boards:type_name -> board.v1.Board
+	4,  // 17: board.v1.UpdateBoardRequest.swimlanes:type_name -> board.v1.SwimlaneConfig
+	3,  // 18: board.v1.UpdateBoardRequest.filter:type_name -> board.v1.BoardFilter
+	2,  // 19: board.v1.UpdateBoardResponse.board:type_name -> board.v1.Board
+	7,  // 20: board.v1.AddCardResponse.card:type_name -> board.v1.Card
+	7,  // 21: board.v1.MoveCardResponse.card:type_name -> board.v1.Card
+	7,  // 22: board.v1.ListCardsResponse.cards:type_name -> board.v1.Card
+	8,  // 23: board.v1.CreateBoardColumnResponse.column:type_name -> board.v1.BoardColumn
+	8,  // 24: board.v1.UpdateBoardColumnResponse.column:type_name -> board.v1.BoardColumn
+	8,  // 25: board.v1.ListBoardColumnsResponse.columns:type_name -> board.v1.BoardColumn
+	8,  // 26: board.v1.ReorderBoardColumnsResponse.columns:type_name -> board.v1.BoardColumn
+	6,  // 27: board.v1.CreateQuickFilterResponse.quick_filter:type_name -> board.v1.QuickFilter
+	6,  // 28: board.v1.UpdateQuickFilterResponse.quick_filter:type_name -> board.v1.QuickFilter
+	6,  // 29: board.v1.ListQuickFiltersResponse.quick_filters:type_name -> board.v1.QuickFilter
+	13, // 30: board.v1.BoardService.CreateBoard:input_type -> board.v1.CreateBoardRequest
+	15, // 31: board.v1.BoardService.GetBoard:input_type -> board.v1.GetBoardRequest
+	17, // 32: board.v1.BoardService.GetBoardView:input_type -> board.v1.GetBoardViewRequest
+	19, // 33: board.v1.BoardService.ListBoards:input_type -> board.v1.ListBoardsRequest
+	21, // 34: board.v1.BoardService.UpdateBoard:input_type -> board.v1.UpdateBoardRequest
+	23, // 35: board.v1.BoardService.DeleteBoard:input_type -> board.v1.DeleteBoardRequest
+	25, // 36: board.v1.BoardService.AddCard:input_type -> board.v1.AddCardRequest
+	27, // 37: board.v1.BoardService.MoveCard:input_type -> board.v1.MoveCardRequest
+	29, // 38: board.v1.BoardService.DeleteCard:input_type -> board.v1.DeleteCardRequest
+	31, // 39: board.v1.BoardService.ListCards:input_type -> board.v1.ListCardsRequest
+	51, // 40: board.v1.BoardService.SyncBoardCards:input_type -> board.v1.SyncBoardCardsRequest
+	33, // 41: board.v1.BoardService.CreateBoardColumn:input_type -> board.v1.CreateBoardColumnRequest
+	35, // 42: board.v1.BoardService.UpdateBoardColumn:input_type -> board.v1.UpdateBoardColumnRequest
+	37, // 43: board.v1.BoardService.DeleteBoardColumn:input_type -> board.v1.DeleteBoardColumnRequest
+	39, // 44: board.v1.BoardService.ListBoardColumns:input_type -> board.v1.ListBoardColumnsRequest
+	41, // 45: board.v1.BoardService.ReorderBoardColumns:input_type -> board.v1.ReorderBoardColumnsRequest
+	43, // 46: board.v1.BoardService.CreateQuickFilter:input_type -> board.v1.CreateQuickFilterRequest
+	45, // 47: board.v1.BoardService.UpdateQuickFilter:input_type -> board.v1.UpdateQuickFilterRequest
+	47, // 48: board.v1.BoardService.DeleteQuickFilter:input_type -> board.v1.DeleteQuickFilterRequest
+	49, // 49: board.v1.BoardService.ListQuickFilters:input_type -> board.v1.ListQuickFiltersRequest
+	14, // 50: board.v1.BoardService.CreateBoard:output_type -> board.v1.CreateBoardResponse
+	16, // 51: board.v1.BoardService.GetBoard:output_type -> board.v1.GetBoardResponse
+	18, // 52: board.v1.BoardService.GetBoardView:output_type -> board.v1.GetBoardViewResponse
+	20, // 53: board.v1.BoardService.ListBoards:output_type -> board.v1.ListBoardsResponse
+	22, // 54: board.v1.BoardService.UpdateBoard:output_type -> board.v1.UpdateBoardResponse
+	24, // 55: board.v1.BoardService.DeleteBoard:output_type -> board.v1.DeleteBoardResponse
+	26, // 56: board.v1.BoardService.AddCard:output_type -> board.v1.AddCardResponse
+	28, // 57: board.v1.BoardService.MoveCard:output_type -> board.v1.MoveCardResponse
+	30, // 58: board.v1.BoardService.DeleteCard:output_type -> board.v1.DeleteCardResponse
+	32, // 59: board.v1.BoardService.ListCards:output_type -> board.v1.ListCardsResponse
+	52, // 60: board.v1.BoardService.SyncBoardCards:output_type -> board.v1.SyncBoardCardsResponse
+	34, // 61: board.v1.BoardService.CreateBoardColumn:output_type -> board.v1.CreateBoardColumnResponse
+	36, // 62: board.v1.BoardService.UpdateBoardColumn:output_type -> board.v1.UpdateBoardColumnResponse
+	38, // 63: board.v1.BoardService.DeleteBoardColumn:output_type -> board.v1.DeleteBoardColumnResponse
+	40, // 64: board.v1.BoardService.ListBoardColumns:output_type -> board.v1.ListBoardColumnsResponse
+	42, // 65: board.v1.BoardService.ReorderBoardColumns:output_type -> board.v1.ReorderBoardColumnsResponse
+	44, // 66: board.v1.BoardService.CreateQuickFilter:output_type -> board.v1.CreateQuickFilterResponse
+	46, // 67: board.v1.BoardService.UpdateQuickFilter:output_type -> board.v1.UpdateQuickFilterResponse
+	48, // 68: board.v1.BoardService.DeleteQuickFilter:output_type -> board.v1.DeleteQuickFilterResponse
+	50, // 69: board.v1.BoardService.ListQuickFilters:output_type -> board.v1.ListQuickFiltersResponse
+	50, // [50:70] is the sub-list for method output_type
+	30, // [30:50] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_board_v1_board_proto_init() }
@@ -1177,18 +3460,22 @@ func file_pkg_proto_board_v1_board_proto_init() {
 	if File_pkg_proto_board_v1_board_proto != nil {
 		return
 	}
+	file_pkg_proto_board_v1_board_proto_msgTypes[19].OneofWrappers = []any{}
+	file_pkg_proto_board_v1_board_proto_msgTypes[33].OneofWrappers = []any{}
+	file_pkg_proto_board_v1_board_proto_msgTypes[43].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_proto_board_v1_board_proto_rawDesc), len(file_pkg_proto_board_v1_board_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   20,
+			NumEnums:      2,
+			NumMessages:   51,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_pkg_proto_board_v1_board_proto_goTypes,
 		DependencyIndexes: file_pkg_proto_board_v1_board_proto_depIdxs,
+		EnumInfos:         file_pkg_proto_board_v1_board_proto_enumTypes,
 		MessageInfos:      file_pkg_proto_board_v1_board_proto_msgTypes,
 	}.Build()
 	File_pkg_proto_board_v1_board_proto = out.File
@@ -19,15 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BoardService_CreateBoard_FullMethodName = "/board.v1.BoardService/CreateBoard"
-	BoardService_GetBoard_FullMethodName    = "/board.v1.BoardService/GetBoard"
-	BoardService_ListBoards_FullMethodName  = "/board.v1.BoardService/ListBoards"
-	BoardService_UpdateBoard_FullMethodName = "/board.v1.BoardService/UpdateBoard"
-	BoardService_DeleteBoard_FullMethodName = "/board.v1.BoardService/DeleteBoard"
-	BoardService_AddCard_FullMethodName     = "/board.v1.BoardService/AddCard"
-	BoardService_MoveCard_FullMethodName    = "/board.v1.BoardService/MoveCard"
-	BoardService_DeleteCard_FullMethodName  = "/board.v1.BoardService/DeleteCard"
-	BoardService_ListCards_FullMethodName   = "/board.v1.BoardService/ListCards"
+	BoardService_CreateBoard_FullMethodName         = "/board.v1.BoardService/CreateBoard"
+	BoardService_GetBoard_FullMethodName            = "/board.v1.BoardService/GetBoard"
+	BoardService_GetBoardView_FullMethodName        = "/board.v1.BoardService/GetBoardView"
+	BoardService_ListBoards_FullMethodName          = "/board.v1.BoardService/ListBoards"
+	BoardService_UpdateBoard_FullMethodName         = "/board.v1.BoardService/UpdateBoard"
+	BoardService_DeleteBoard_FullMethodName         = "/board.v1.BoardService/DeleteBoard"
+	BoardService_AddCard_FullMethodName             = "/board.v1.BoardService/AddCard"
+	BoardService_MoveCard_FullMethodName            = "/board.v1.BoardService/MoveCard"
+	BoardService_DeleteCard_FullMethodName          = "/board.v1.BoardService/DeleteCard"
+	BoardService_ListCards_FullMethodName           = "/board.v1.BoardService/ListCards"
+	BoardService_SyncBoardCards_FullMethodName      = "/board.v1.BoardService/SyncBoardCards"
+	BoardService_CreateBoardColumn_FullMethodName   = "/board.v1.BoardService/CreateBoardColumn"
+	BoardService_UpdateBoardColumn_FullMethodName   = "/board.v1.BoardService/UpdateBoardColumn"
+	BoardService_DeleteBoardColumn_FullMethodName   = "/board.v1.BoardService/DeleteBoardColumn"
+	BoardService_ListBoardColumns_FullMethodName    = "/board.v1.BoardService/ListBoardColumns"
+	BoardService_ReorderBoardColumns_FullMethodName = "/board.v1.BoardService/ReorderBoardColumns"
+	BoardService_CreateQuickFilter_FullMethodName   = "/board.v1.BoardService/CreateQuickFilter"
+	BoardService_UpdateQuickFilter_FullMethodName   = "/board.v1.BoardService/UpdateQuickFilter"
+	BoardService_DeleteQuickFilter_FullMethodName   = "/board.v1.BoardService/DeleteQuickFilter"
+	BoardService_ListQuickFilters_FullMethodName    = "/board.v1.BoardService/ListQuickFilters"
 )
 
 // BoardServiceClient is the client API for BoardService service.
@@ -36,6 +47,7 @@ const (
 type BoardServiceClient interface {
 	CreateBoard(ctx context.Context, in *CreateBoardRequest, opts ...grpc.CallOption) (*CreateBoardResponse, error)
 	GetBoard(ctx context.Context, in *GetBoardRequest, opts ...grpc.CallOption) (*GetBoardResponse, error)
+	GetBoardView(ctx context.Context, in *GetBoardViewRequest, opts ...grpc.CallOption) (*GetBoardViewResponse, error)
 	ListBoards(ctx context.Context, in *ListBoardsRequest, opts ...grpc.CallOption) (*ListBoardsResponse, error)
 	UpdateBoard(ctx context.Context, in *UpdateBoardRequest, opts ...grpc.CallOption) (*UpdateBoardResponse, error)
 	DeleteBoard(ctx context.Context, in *DeleteBoardRequest, opts ...grpc.CallOption) (*DeleteBoardResponse, error)
@@ -43,6 +55,18 @@ type BoardServiceClient interface {
 	MoveCard(ctx context.Context, in *MoveCardRequest, opts ...grpc.CallOption) (*MoveCardResponse, error)
 	DeleteCard(ctx context.Context, in *DeleteCardRequest, opts ...grpc.CallOption) (*DeleteCardResponse, error)
 	ListCards(ctx context.Context, in *ListCardsRequest, opts ...grpc.CallOption) (*ListCardsResponse, error)
+	SyncBoardCards(ctx context.Context, in *SyncBoardCardsRequest, opts ...grpc.CallOption) (*SyncBoardCardsResponse, error)
+	// Columns
+	CreateBoardColumn(ctx context.Context, in *CreateBoardColumnRequest, opts ...grpc.CallOption) (*CreateBoardColumnResponse, error)
+	UpdateBoardColumn(ctx context.Context, in *UpdateBoardColumnRequest, opts ...grpc.CallOption) (*UpdateBoardColumnResponse, error)
+	DeleteBoardColumn(ctx context.Context, in *DeleteBoardColumnRequest, opts ...grpc.CallOption) (*DeleteBoardColumnResponse, error)
+	ListBoardColumns(ctx context.Context, in *ListBoardColumnsRequest, opts ...grpc.CallOption) (*ListBoardColumnsResponse, error)
+	ReorderBoardColumns(ctx context.Context, in *ReorderBoardColumnsRequest, opts ...grpc.CallOption) (*ReorderBoardColumnsResponse, error)
+	// Quick filters
+	CreateQuickFilter(ctx context.Context, in *CreateQuickFilterRequest, opts ...grpc.CallOption) (*CreateQuickFilterResponse, error)
+	UpdateQuickFilter(ctx context.Context, in *UpdateQuickFilterRequest, opts ...grpc.CallOption) (*UpdateQuickFilterResponse, error)
+	DeleteQuickFilter(ctx context.Context, in *DeleteQuickFilterRequest, opts ...grpc.CallOption) (*DeleteQuickFilterResponse, error)
+	ListQuickFilters(ctx context.Context, in *ListQuickFiltersRequest, opts ...grpc.CallOption) (*ListQuickFiltersResponse, error)
 }
 
 type boardServiceClient struct {
@@ -73,6 +97,16 @@ func (c *boardServiceClient) GetBoard(ctx context.Context, in *GetBoardRequest,
 	return out, nil
 }
 
+func (c *boardServiceClient) GetBoardView(ctx context.Context, in *GetBoardViewRequest, opts ...grpc.CallOption) (*GetBoardViewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBoardViewResponse)
+	err := c.cc.Invoke(ctx, BoardService_GetBoardView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boardServiceClient) ListBoards(ctx context.Context, in *ListBoardsRequest, opts ...grpc.CallOption) (*ListBoardsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListBoardsResponse)
@@ -143,12 +177,113 @@ func (c *boardServiceClient) ListCards(ctx context.Context, in *ListCardsRequest
 	return out, nil
 }
 
+func (c *boardServiceClient) SyncBoardCards(ctx context.Context, in *SyncBoardCardsRequest, opts ...grpc.CallOption) (*SyncBoardCardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncBoardCardsResponse)
+	err := c.cc.Invoke(ctx, BoardService_SyncBoardCards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) CreateBoardColumn(ctx context.Context, in *CreateBoardColumnRequest, opts ...grpc.CallOption) (*CreateBoardColumnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBoardColumnResponse)
+	err := c.cc.Invoke(ctx, BoardService_CreateBoardColumn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) UpdateBoardColumn(ctx context.Context, in *UpdateBoardColumnRequest, opts ...grpc.CallOption) (*UpdateBoardColumnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBoardColumnResponse)
+	err := c.cc.Invoke(ctx, BoardService_UpdateBoardColumn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) DeleteBoardColumn(ctx context.Context, in *DeleteBoardColumnRequest, opts ...grpc.CallOption) (*DeleteBoardColumnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBoardColumnResponse)
+	err := c.cc.Invoke(ctx, BoardService_DeleteBoardColumn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) ListBoardColumns(ctx context.Context, in *ListBoardColumnsRequest, opts ...grpc.CallOption) (*ListBoardColumnsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBoardColumnsResponse)
+	err := c.cc.Invoke(ctx, BoardService_ListBoardColumns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) ReorderBoardColumns(ctx context.Context, in *ReorderBoardColumnsRequest, opts ...grpc.CallOption) (*ReorderBoardColumnsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderBoardColumnsResponse)
+	err := c.cc.Invoke(ctx, BoardService_ReorderBoardColumns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) CreateQuickFilter(ctx context.Context, in *CreateQuickFilterRequest, opts ...grpc.CallOption) (*CreateQuickFilterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateQuickFilterResponse)
+	err := c.cc.Invoke(ctx, BoardService_CreateQuickFilter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) UpdateQuickFilter(ctx context.Context, in *UpdateQuickFilterRequest, opts ...grpc.CallOption) (*UpdateQuickFilterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateQuickFilterResponse)
+	err := c.cc.Invoke(ctx, BoardService_UpdateQuickFilter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) DeleteQuickFilter(ctx context.Context, in *DeleteQuickFilterRequest, opts ...grpc.CallOption) (*DeleteQuickFilterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteQuickFilterResponse)
+	err := c.cc.Invoke(ctx, BoardService_DeleteQuickFilter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) ListQuickFilters(ctx context.Context, in *ListQuickFiltersRequest, opts ...grpc.CallOption) (*ListQuickFiltersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuickFiltersResponse)
+	err := c.cc.Invoke(ctx, BoardService_ListQuickFilters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BoardServiceServer is the server API for BoardService service.
 // All implementations must embed UnimplementedBoardServiceServer
 // for forward compatibility.
 type BoardServiceServer interface {
 	CreateBoard(context.Context, *CreateBoardRequest) (*CreateBoardResponse, error)
 	GetBoard(context.Context, *GetBoardRequest) (*GetBoardResponse, error)
+	GetBoardView(context.Context, *GetBoardViewRequest) (*GetBoardViewResponse, error)
 	ListBoards(context.Context, *ListBoardsRequest) (*ListBoardsResponse, error)
 	UpdateBoard(context.Context, *UpdateBoardRequest) (*UpdateBoardResponse, error)
 	DeleteBoard(context.Context, *DeleteBoardRequest) (*DeleteBoardResponse, error)
@@ -156,6 +291,18 @@ type BoardServiceServer interface {
 	MoveCard(context.Context, *MoveCardRequest) (*MoveCardResponse, error)
 	DeleteCard(context.Context, *DeleteCardRequest) (*DeleteCardResponse, error)
 	ListCards(context.Context, *ListCardsRequest) (*ListCardsResponse, error)
+	SyncBoardCards(context.Context, *SyncBoardCardsRequest) (*SyncBoardCardsResponse, error)
+	// Columns
+	CreateBoardColumn(context.Context, *CreateBoardColumnRequest) (*CreateBoardColumnResponse, error)
+	UpdateBoardColumn(context.Context, *UpdateBoardColumnRequest) (*UpdateBoardColumnResponse, error)
+	DeleteBoardColumn(context.Context, *DeleteBoardColumnRequest) (*DeleteBoardColumnResponse, error)
+	ListBoardColumns(context.Context, *ListBoardColumnsRequest) (*ListBoardColumnsResponse, error)
+	ReorderBoardColumns(context.Context, *ReorderBoardColumnsRequest) (*ReorderBoardColumnsResponse, error)
+	// Quick filters
+	CreateQuickFilter(context.Context, *CreateQuickFilterRequest) (*CreateQuickFilterResponse, error)
+	UpdateQuickFilter(context.Context, *UpdateQuickFilterRequest) (*UpdateQuickFilterResponse, error)
+	DeleteQuickFilter(context.Context, *DeleteQuickFilterRequest) (*DeleteQuickFilterResponse, error)
+	ListQuickFilters(context.Context, *ListQuickFiltersRequest) (*ListQuickFiltersResponse, error)
 	mustEmbedUnimplementedBoardServiceServer()
 }
 
@@ -172,6 +319,9 @@ func (UnimplementedBoardServiceServer) CreateBoard(context.Context, *CreateBoard
 func (UnimplementedBoardServiceServer) GetBoard(context.Context, *GetBoardRequest) (*GetBoardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBoard not implemented")
 }
+func (UnimplementedBoardServiceServer) GetBoardView(context.Context, *GetBoardViewRequest) (*GetBoardViewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoardView not implemented")
+}
 func (UnimplementedBoardServiceServer) ListBoards(context.Context, *ListBoardsRequest) (*ListBoardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBoards not implemented")
 }
@@ -193,6 +343,36 @@ func (UnimplementedBoardServiceServer) DeleteCard(context.Context, *DeleteCardRe
 func (UnimplementedBoardServiceServer) ListCards(context.Context, *ListCardsRequest) (*ListCardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCards not implemented")
 }
+func (UnimplementedBoardServiceServer) SyncBoardCards(context.Context, *SyncBoardCardsRequest) (*SyncBoardCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncBoardCards not implemented")
+}
+func (UnimplementedBoardServiceServer) CreateBoardColumn(context.Context, *CreateBoardColumnRequest) (*CreateBoardColumnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBoardColumn not implemented")
+}
+func (UnimplementedBoardServiceServer) UpdateBoardColumn(context.Context, *UpdateBoardColumnRequest) (*UpdateBoardColumnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBoardColumn not implemented")
+}
+func (UnimplementedBoardServiceServer) DeleteBoardColumn(context.Context, *DeleteBoardColumnRequest) (*DeleteBoardColumnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBoardColumn not implemented")
+}
+func (UnimplementedBoardServiceServer) ListBoardColumns(context.Context, *ListBoardColumnsRequest) (*ListBoardColumnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBoardColumns not implemented")
+}
+func (UnimplementedBoardServiceServer) ReorderBoardColumns(context.Context, *ReorderBoardColumnsRequest) (*ReorderBoardColumnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderBoardColumns not implemented")
+}
+func (UnimplementedBoardServiceServer) CreateQuickFilter(context.Context, *CreateQuickFilterRequest) (*CreateQuickFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuickFilter not implemented")
+}
+func (UnimplementedBoardServiceServer) UpdateQuickFilter(context.Context, *UpdateQuickFilterRequest) (*UpdateQuickFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQuickFilter not implemented")
+}
+func (UnimplementedBoardServiceServer) DeleteQuickFilter(context.Context, *DeleteQuickFilterRequest) (*DeleteQuickFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuickFilter not implemented")
+}
+func (UnimplementedBoardServiceServer) ListQuickFilters(context.Context, *ListQuickFiltersRequest) (*ListQuickFiltersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuickFilters not implemented")
+}
 func (UnimplementedBoardServiceServer) mustEmbedUnimplementedBoardServiceServer() {}
 func (UnimplementedBoardServiceServer) testEmbeddedByValue()                      {}
 
@@ -250,6 +430,24 @@ func _BoardService_GetBoard_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BoardService_GetBoardView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBoardViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).GetBoardView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BoardService_GetBoardView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).GetBoardView(ctx, req.(*GetBoardViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BoardService_ListBoards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListBoardsRequest)
 	if err := dec(in); err != nil {
@@ -376,6 +574,186 @@ func _BoardService_ListCards_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BoardService_SyncBoardCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncBoardCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).SyncBoardCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BoardService_SyncBoardCards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).SyncBoardCards(ctx, req.(*SyncBoardCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_CreateBoardColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBoardColumnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).CreateBoardColumn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BoardService_CreateBoardColumn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).CreateBoardColumn(ctx, req.(*CreateBoardColumnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_UpdateBoardColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBoardColumnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).UpdateBoardColumn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BoardService_UpdateBoardColumn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).UpdateBoardColumn(ctx, req.(*UpdateBoardColumnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_DeleteBoardColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBoardColumnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).DeleteBoardColumn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BoardService_DeleteBoardColumn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).DeleteBoardColumn(ctx, req.(*DeleteBoardColumnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_ListBoardColumns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBoardColumnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).ListBoardColumns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BoardService_ListBoardColumns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).ListBoardColumns(ctx, req.(*ListBoardColumnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_ReorderBoardColumns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderBoardColumnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).ReorderBoardColumns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BoardService_ReorderBoardColumns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).ReorderBoardColumns(ctx, req.(*ReorderBoardColumnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_CreateQuickFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuickFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).CreateQuickFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BoardService_CreateQuickFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).CreateQuickFilter(ctx, req.(*CreateQuickFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_UpdateQuickFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateQuickFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).UpdateQuickFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BoardService_UpdateQuickFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).UpdateQuickFilter(ctx, req.(*UpdateQuickFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_DeleteQuickFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuickFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).DeleteQuickFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BoardService_DeleteQuickFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).DeleteQuickFilter(ctx, req.(*DeleteQuickFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_ListQuickFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuickFiltersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).ListQuickFilters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BoardService_ListQuickFilters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).ListQuickFilters(ctx, req.(*ListQuickFiltersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BoardService_ServiceDesc is the grpc.ServiceDesc for BoardService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -391,6 +769,10 @@ var BoardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBoard",
 			Handler:    _BoardService_GetBoard_Handler,
 		},
+		{
+			MethodName: "GetBoardView",
+			Handler:    _BoardService_GetBoardView_Handler,
+		},
 		{
 			MethodName: "ListBoards",
 			Handler:    _BoardService_ListBoards_Handler,
@@ -419,6 +801,46 @@ var BoardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListCards",
 			Handler:    _BoardService_ListCards_Handler,
 		},
+		{
+			MethodName: "SyncBoardCards",
+			Handler:    _BoardService_SyncBoardCards_Handler,
+		},
+		{
+			MethodName: "CreateBoardColumn",
+			Handler:    _BoardService_CreateBoardColumn_Handler,
+		},
+		{
+			MethodName: "UpdateBoardColumn",
+			Handler:    _BoardService_UpdateBoardColumn_Handler,
+		},
+		{
+			MethodName: "DeleteBoardColumn",
+			Handler:    _BoardService_DeleteBoardColumn_Handler,
+		},
+		{
+			MethodName: "ListBoardColumns",
+			Handler:    _BoardService_ListBoardColumns_Handler,
+		},
+		{
+			MethodName: "ReorderBoardColumns",
+			Handler:    _BoardService_ReorderBoardColumns_Handler,
+		},
+		{
+			MethodName: "CreateQuickFilter",
+			Handler:    _BoardService_CreateQuickFilter_Handler,
+		},
+		{
+			MethodName: "UpdateQuickFilter",
+			Handler:    _BoardService_UpdateQuickFilter_Handler,
+		},
+		{
+			MethodName: "DeleteQuickFilter",
+			Handler:    _BoardService_DeleteQuickFilter_Handler,
+		},
+		{
+			MethodName: "ListQuickFilters",
+			Handler:    _BoardService_ListQuickFilters_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/proto/board/v1/board.proto",
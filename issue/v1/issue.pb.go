@@ -8,6 +8,7 @@ package issuev1
 
 import (
 	v1 "github.com/nexusflow/nexusflow/pkg/proto/common/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
@@ -219,6 +220,118 @@ func (CustomFieldType) EnumDescriptor() ([]byte, []int) {
 	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{2}
 }
 
+// What a schedule does about occurrences missed while the scheduler was down
+type ScheduleCatchUp int32
+
+const (
+	ScheduleCatchUp_SCHEDULE_CATCH_UP_UNSPECIFIED ScheduleCatchUp = 0 // Same as LATEST
+	ScheduleCatchUp_SCHEDULE_CATCH_UP_NONE        ScheduleCatchUp = 1 // Skip missed occurrences
+	ScheduleCatchUp_SCHEDULE_CATCH_UP_LATEST      ScheduleCatchUp = 2 // Create an issue for the most recent one only
+	ScheduleCatchUp_SCHEDULE_CATCH_UP_ALL         ScheduleCatchUp = 3 // Create an issue for each, up to 50
+)
+
+// Enum value maps for ScheduleCatchUp.
+var (
+	ScheduleCatchUp_name = map[int32]string{
+		0: "SCHEDULE_CATCH_UP_UNSPECIFIED",
+		1: "SCHEDULE_CATCH_UP_NONE",
+		2: "SCHEDULE_CATCH_UP_LATEST",
+		3: "SCHEDULE_CATCH_UP_ALL",
+	}
+	ScheduleCatchUp_value = map[string]int32{
+		"SCHEDULE_CATCH_UP_UNSPECIFIED": 0,
+		"SCHEDULE_CATCH_UP_NONE":        1,
+		"SCHEDULE_CATCH_UP_LATEST":      2,
+		"SCHEDULE_CATCH_UP_ALL":         3,
+	}
+)
+
+func (x ScheduleCatchUp) Enum() *ScheduleCatchUp {
+	p := new(ScheduleCatchUp)
+	*p = x
+	return p
+}
+
+func (x ScheduleCatchUp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduleCatchUp) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_issue_v1_issue_proto_enumTypes[3].Descriptor()
+}
+
+func (ScheduleCatchUp) Type() protoreflect.EnumType {
+	return &file_proto_issue_v1_issue_proto_enumTypes[3]
+}
+
+func (x ScheduleCatchUp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduleCatchUp.Descriptor instead.
+func (ScheduleCatchUp) EnumDescriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{3}
+}
+
+// Job status
+type JobStatus int32
+
+const (
+	JobStatus_JOB_STATUS_UNSPECIFIED           JobStatus = 0
+	JobStatus_JOB_STATUS_PENDING               JobStatus = 1
+	JobStatus_JOB_STATUS_RUNNING               JobStatus = 2
+	JobStatus_JOB_STATUS_COMPLETED             JobStatus = 3
+	JobStatus_JOB_STATUS_COMPLETED_WITH_ERRORS JobStatus = 4
+	JobStatus_JOB_STATUS_FAILED                JobStatus = 5
+)
+
+// Enum value maps for JobStatus.
+var (
+	JobStatus_name = map[int32]string{
+		0: "JOB_STATUS_UNSPECIFIED",
+		1: "JOB_STATUS_PENDING",
+		2: "JOB_STATUS_RUNNING",
+		3: "JOB_STATUS_COMPLETED",
+		4: "JOB_STATUS_COMPLETED_WITH_ERRORS",
+		5: "JOB_STATUS_FAILED",
+	}
+	JobStatus_value = map[string]int32{
+		"JOB_STATUS_UNSPECIFIED":           0,
+		"JOB_STATUS_PENDING":               1,
+		"JOB_STATUS_RUNNING":               2,
+		"JOB_STATUS_COMPLETED":             3,
+		"JOB_STATUS_COMPLETED_WITH_ERRORS": 4,
+		"JOB_STATUS_FAILED":                5,
+	}
+)
+
+func (x JobStatus) Enum() *JobStatus {
+	p := new(JobStatus)
+	*p = x
+	return p
+}
+
+func (x JobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_issue_v1_issue_proto_enumTypes[4].Descriptor()
+}
+
+func (JobStatus) Type() protoreflect.EnumType {
+	return &file_proto_issue_v1_issue_proto_enumTypes[4]
+}
+
+func (x JobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobStatus.Descriptor instead.
+func (JobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{4}
+}
+
 // Issue link type
 type IssueLinkType int32
 
@@ -268,11 +381,11 @@ func (x IssueLinkType) String() string {
 }
 
 func (IssueLinkType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_issue_v1_issue_proto_enumTypes[3].Descriptor()
+	return file_proto_issue_v1_issue_proto_enumTypes[5].Descriptor()
 }
 
 func (IssueLinkType) Type() protoreflect.EnumType {
-	return &file_proto_issue_v1_issue_proto_enumTypes[3]
+	return &file_proto_issue_v1_issue_proto_enumTypes[5]
 }
 
 func (x IssueLinkType) Number() protoreflect.EnumNumber {
@@ -281,33 +394,43 @@ func (x IssueLinkType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use IssueLinkType.Descriptor instead.
 func (IssueLinkType) EnumDescriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{3}
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{5}
 }
 
 // Issue entity
 type Issue struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"` // e.g., "PROJ-123"
-	Summary       string                 `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"` // Rich text (markdown)
-	Type          IssueType              `protobuf:"varint,6,opt,name=type,proto3,enum=nexusflow.issue.v1.IssueType" json:"type,omitempty"`
-	Priority      IssuePriority          `protobuf:"varint,7,opt,name=priority,proto3,enum=nexusflow.issue.v1.IssuePriority" json:"priority,omitempty"`
-	StatusId      string                 `protobuf:"bytes,8,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
-	AssigneeId    string                 `protobuf:"bytes,9,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
-	ReporterId    string                 `protobuf:"bytes,10,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
-	LabelIds      []string               `protobuf:"bytes,11,rep,name=label_ids,json=labelIds,proto3" json:"label_ids,omitempty"`
-	WatcherIds    []string               `protobuf:"bytes,12,rep,name=watcher_ids,json=watcherIds,proto3" json:"watcher_ids,omitempty"`
-	ParentId      string                 `protobuf:"bytes,13,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // For sub-tasks and hierarchy
-	CustomFields  []*CustomFieldValue    `protobuf:"bytes,14,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	DueDate       *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
-	StoryPoints   int32                  `protobuf:"varint,18,opt,name=story_points,json=storyPoints,proto3" json:"story_points,omitempty"`
-	SprintId      string                 `protobuf:"bytes,19,opt,name=sprint_id,json=sprintId,proto3" json:"sprint_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Id                       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId                string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Key                      string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"` // e.g., "PROJ-123"
+	Summary                  string                 `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	Description              string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"` // Rich text (markdown)
+	Type                     IssueType              `protobuf:"varint,6,opt,name=type,proto3,enum=nexusflow.issue.v1.IssueType" json:"type,omitempty"`
+	Priority                 IssuePriority          `protobuf:"varint,7,opt,name=priority,proto3,enum=nexusflow.issue.v1.IssuePriority" json:"priority,omitempty"`
+	StatusId                 string                 `protobuf:"bytes,8,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	AssigneeId               string                 `protobuf:"bytes,9,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	ReporterId               string                 `protobuf:"bytes,10,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	LabelIds                 []string               `protobuf:"bytes,11,rep,name=label_ids,json=labelIds,proto3" json:"label_ids,omitempty"`
+	WatcherIds               []string               `protobuf:"bytes,12,rep,name=watcher_ids,json=watcherIds,proto3" json:"watcher_ids,omitempty"`
+	ParentId                 string                 `protobuf:"bytes,13,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // For sub-tasks and hierarchy
+	CustomFields             []*CustomFieldValue    `protobuf:"bytes,14,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	CreatedAt                *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt                *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DueDate                  *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	StoryPoints              int32                  `protobuf:"varint,18,opt,name=story_points,json=storyPoints,proto3" json:"story_points,omitempty"`
+	SprintId                 string                 `protobuf:"bytes,19,opt,name=sprint_id,json=sprintId,proto3" json:"sprint_id,omitempty"`
+	ComponentIds             []string               `protobuf:"bytes,20,rep,name=component_ids,json=componentIds,proto3" json:"component_ids,omitempty"`
+	Version                  int64                  `protobuf:"varint,21,opt,name=version,proto3" json:"version,omitempty"`                     // Incremented on every update, also sent as the ETag
+	DeletedAt                *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // Set for issues in the trash
+	DeletedBy                string                 `protobuf:"bytes,23,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	OriginalEstimateSeconds  int64                  `protobuf:"varint,24,opt,name=original_estimate_seconds,json=originalEstimateSeconds,proto3" json:"original_estimate_seconds,omitempty"`
+	RemainingEstimateSeconds int64                  `protobuf:"varint,25,opt,name=remaining_estimate_seconds,json=remainingEstimateSeconds,proto3" json:"remaining_estimate_seconds,omitempty"`
+	TimeSpentSeconds         int64                  `protobuf:"varint,26,opt,name=time_spent_seconds,json=timeSpentSeconds,proto3" json:"time_spent_seconds,omitempty"` // Sum of the issue's own worklogs
+	ScheduleId               string                 `protobuf:"bytes,27,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`                      // Set on issues created by a schedule
+	ScheduledFor             *timestamppb.Timestamp `protobuf:"bytes,28,opt,name=scheduled_for,json=scheduledFor,proto3" json:"scheduled_for,omitempty"`                // The occurrence the issue was created for
+	Rank                     string                 `protobuf:"bytes,29,opt,name=rank,proto3" json:"rank,omitempty"`                                                    // Backlog position in the project; issues sort by rank
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *Issue) Reset() {
@@ -473,20 +596,91 @@ func (x *Issue) GetSprintId() string {
 	return ""
 }
 
+func (x *Issue) GetComponentIds() []string {
+	if x != nil {
+		return x.ComponentIds
+	}
+	return nil
+}
+
+func (x *Issue) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Issue) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Issue) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *Issue) GetOriginalEstimateSeconds() int64 {
+	if x != nil {
+		return x.OriginalEstimateSeconds
+	}
+	return 0
+}
+
+func (x *Issue) GetRemainingEstimateSeconds() int64 {
+	if x != nil {
+		return x.RemainingEstimateSeconds
+	}
+	return 0
+}
+
+func (x *Issue) GetTimeSpentSeconds() int64 {
+	if x != nil {
+		return x.TimeSpentSeconds
+	}
+	return 0
+}
+
+func (x *Issue) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *Issue) GetScheduledFor() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledFor
+	}
+	return nil
+}
+
+func (x *Issue) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
 // Custom field definition
 type CustomField struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Type          CustomFieldType        `protobuf:"varint,5,opt,name=type,proto3,enum=nexusflow.issue.v1.CustomFieldType" json:"type,omitempty"`
-	Required      bool                   `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
-	DefaultValue  *anypb.Any             `protobuf:"bytes,7,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
-	Options       []string               `protobuf:"bytes,8,rep,name=options,proto3" json:"options,omitempty"`                                                                         // For select/multi-select
-	Config        map[string]string      `protobuf:"bytes,9,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Type-specific configuration
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId      string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Type           CustomFieldType        `protobuf:"varint,5,opt,name=type,proto3,enum=nexusflow.issue.v1.CustomFieldType" json:"type,omitempty"`
+	Required       bool                   `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
+	DefaultValue   *anypb.Any             `protobuf:"bytes,7,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Options        []string               `protobuf:"bytes,8,rep,name=options,proto3" json:"options,omitempty"`                                                                         // For select/multi-select
+	Config         map[string]string      `protobuf:"bytes,9,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Type-specific configuration
+	OrganizationId string                 `protobuf:"bytes,10,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`                                    // Set for fields shared across the organization
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CustomField) Reset() {
@@ -582,29 +776,43 @@ func (x *CustomField) GetConfig() map[string]string {
 	return nil
 }
 
-// Custom field value
-type CustomFieldValue struct {
+func (x *CustomField) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+// Context in which a shared custom field applies. An empty project_ids or
+// issue_types list matches every project or issue type; the most specific
+// matching context wins.
+type CustomFieldContext struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FieldId       string                 `protobuf:"bytes,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
-	Value         *anypb.Any             `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FieldId       string                 `protobuf:"bytes,2,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ProjectIds    []string               `protobuf:"bytes,4,rep,name=project_ids,json=projectIds,proto3" json:"project_ids,omitempty"`
+	IssueTypes    []IssueType            `protobuf:"varint,5,rep,packed,name=issue_types,json=issueTypes,proto3,enum=nexusflow.issue.v1.IssueType" json:"issue_types,omitempty"`
+	Options       []string               `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"` // Overrides the field options when set
+	DefaultValue  *anypb.Any             `protobuf:"bytes,7,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CustomFieldValue) Reset() {
-	*x = CustomFieldValue{}
+func (x *CustomFieldContext) Reset() {
+	*x = CustomFieldContext{}
 	mi := &file_proto_issue_v1_issue_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CustomFieldValue) String() string {
+func (x *CustomFieldContext) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CustomFieldValue) ProtoMessage() {}
+func (*CustomFieldContext) ProtoMessage() {}
 
-func (x *CustomFieldValue) ProtoReflect() protoreflect.Message {
+func (x *CustomFieldContext) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_issue_v1_issue_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -616,50 +824,87 @@ func (x *CustomFieldValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CustomFieldValue.ProtoReflect.Descriptor instead.
-func (*CustomFieldValue) Descriptor() ([]byte, []int) {
+// Deprecated: Use CustomFieldContext.ProtoReflect.Descriptor instead.
+func (*CustomFieldContext) Descriptor() ([]byte, []int) {
 	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CustomFieldValue) GetFieldId() string {
+func (x *CustomFieldContext) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CustomFieldContext) GetFieldId() string {
 	if x != nil {
 		return x.FieldId
 	}
 	return ""
 }
 
-func (x *CustomFieldValue) GetValue() *anypb.Any {
+func (x *CustomFieldContext) GetName() string {
 	if x != nil {
-		return x.Value
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomFieldContext) GetProjectIds() []string {
+	if x != nil {
+		return x.ProjectIds
 	}
 	return nil
 }
 
-// Issue link
-type IssueLink struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SourceIssueId string                 `protobuf:"bytes,2,opt,name=source_issue_id,json=sourceIssueId,proto3" json:"source_issue_id,omitempty"`
-	TargetIssueId string                 `protobuf:"bytes,3,opt,name=target_issue_id,json=targetIssueId,proto3" json:"target_issue_id,omitempty"`
-	Type          IssueLinkType          `protobuf:"varint,4,opt,name=type,proto3,enum=nexusflow.issue.v1.IssueLinkType" json:"type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CustomFieldContext) GetIssueTypes() []IssueType {
+	if x != nil {
+		return x.IssueTypes
+	}
+	return nil
 }
 
-func (x *IssueLink) Reset() {
-	*x = IssueLink{}
+func (x *CustomFieldContext) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CustomFieldContext) GetDefaultValue() *anypb.Any {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return nil
+}
+
+// Project component
+type Component struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId         string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	LeadId            string                 `protobuf:"bytes,5,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
+	DefaultAssigneeId string                 `protobuf:"bytes,6,opt,name=default_assignee_id,json=defaultAssigneeId,proto3" json:"default_assignee_id,omitempty"` // Assigned to new issues created without an assignee
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Component) Reset() {
+	*x = Component{}
 	mi := &file_proto_issue_v1_issue_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IssueLink) String() string {
+func (x *Component) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IssueLink) ProtoMessage() {}
+func (*Component) ProtoMessage() {}
 
-func (x *IssueLink) ProtoReflect() protoreflect.Message {
+func (x *Component) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_issue_v1_issue_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -671,68 +916,86 @@ func (x *IssueLink) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IssueLink.ProtoReflect.Descriptor instead.
-func (*IssueLink) Descriptor() ([]byte, []int) {
+// Deprecated: Use Component.ProtoReflect.Descriptor instead.
+func (*Component) Descriptor() ([]byte, []int) {
 	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *IssueLink) GetId() string {
+func (x *Component) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *IssueLink) GetSourceIssueId() string {
+func (x *Component) GetProjectId() string {
 	if x != nil {
-		return x.SourceIssueId
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *IssueLink) GetTargetIssueId() string {
+func (x *Component) GetName() string {
 	if x != nil {
-		return x.TargetIssueId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *IssueLink) GetType() IssueLinkType {
+func (x *Component) GetDescription() string {
 	if x != nil {
-		return x.Type
+		return x.Description
 	}
-	return IssueLinkType_ISSUE_LINK_TYPE_UNSPECIFIED
+	return ""
 }
 
-type CreateIssueRequest struct {
+func (x *Component) GetLeadId() string {
+	if x != nil {
+		return x.LeadId
+	}
+	return ""
+}
+
+func (x *Component) GetDefaultAssigneeId() string {
+	if x != nil {
+		return x.DefaultAssigneeId
+	}
+	return ""
+}
+
+// Custom field value
+//
+// The packed value depends on the field type:
+//
+//	text, textarea, url, email, select, user -> google.protobuf.StringValue
+//	number                                   -> google.protobuf.DoubleValue
+//	checkbox                                 -> google.protobuf.BoolValue
+//	date                                     -> google.protobuf.Timestamp
+//	multi_select                             -> google.protobuf.ListValue of strings
+//
+// An unset value clears the field on update.
+type CustomFieldValue struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Summary       string                 `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Type          IssueType              `protobuf:"varint,4,opt,name=type,proto3,enum=nexusflow.issue.v1.IssueType" json:"type,omitempty"`
-	Priority      IssuePriority          `protobuf:"varint,5,opt,name=priority,proto3,enum=nexusflow.issue.v1.IssuePriority" json:"priority,omitempty"`
-	AssigneeId    string                 `protobuf:"bytes,6,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
-	ParentId      string                 `protobuf:"bytes,7,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
-	LabelIds      []string               `protobuf:"bytes,8,rep,name=label_ids,json=labelIds,proto3" json:"label_ids,omitempty"`
-	CustomFields  []*CustomFieldValue    `protobuf:"bytes,9,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	FieldId       string                 `protobuf:"bytes,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	Value         *anypb.Any             `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateIssueRequest) Reset() {
-	*x = CreateIssueRequest{}
+func (x *CustomFieldValue) Reset() {
+	*x = CustomFieldValue{}
 	mi := &file_proto_issue_v1_issue_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateIssueRequest) String() string {
+func (x *CustomFieldValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateIssueRequest) ProtoMessage() {}
+func (*CustomFieldValue) ProtoMessage() {}
 
-func (x *CreateIssueRequest) ProtoReflect() protoreflect.Message {
+func (x *CustomFieldValue) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_issue_v1_issue_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -744,96 +1007,5180 @@ func (x *CreateIssueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateIssueRequest.ProtoReflect.Descriptor instead.
-func (*CreateIssueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CustomFieldValue.ProtoReflect.Descriptor instead.
+func (*CustomFieldValue) Descriptor() ([]byte, []int) {
 	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreateIssueRequest) GetProjectId() string {
+func (x *CustomFieldValue) GetFieldId() string {
 	if x != nil {
-		return x.ProjectId
+		return x.FieldId
 	}
 	return ""
 }
 
-func (x *CreateIssueRequest) GetSummary() string {
+func (x *CustomFieldValue) GetValue() *anypb.Any {
 	if x != nil {
-		return x.Summary
+		return x.Value
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateIssueRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+// Asynchronous bulk job
+type Job struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        JobStatus              `protobuf:"varint,2,opt,name=status,proto3,enum=nexusflow.issue.v1.JobStatus" json:"status,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Processed     int32                  `protobuf:"varint,4,opt,name=processed,proto3" json:"processed,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,5,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+	Errors        []*JobItemError        `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"` // One entry per issue that was not changed
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`   // Set when the whole job failed
+	CreatedBy     string                 `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateIssueRequest) GetType() IssueType {
-	if x != nil {
-		return x.Type
-	}
-	return IssueType_ISSUE_TYPE_UNSPECIFIED
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Job) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Job) GetStatus() JobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JobStatus_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *Job) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Job) GetProcessed() int32 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *Job) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *Job) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *Job) GetErrors() []*JobItemError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *Job) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Job) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Job) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Job) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Job) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+// Work logged against an issue
+type Worklog struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IssueId         string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	ProjectId       string                 `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserId          string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Comment         string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Worklog) Reset() {
+	*x = Worklog{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Worklog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Worklog) ProtoMessage() {}
+
+func (x *Worklog) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Worklog.ProtoReflect.Descriptor instead.
+func (*Worklog) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Worklog) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Worklog) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *Worklog) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *Worklog) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Worklog) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Worklog) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *Worklog) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Worklog) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Worklog) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Estimates and time spent, in seconds
+type TimeTracking struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	OriginalEstimateSeconds  int64                  `protobuf:"varint,1,opt,name=original_estimate_seconds,json=originalEstimateSeconds,proto3" json:"original_estimate_seconds,omitempty"`
+	RemainingEstimateSeconds int64                  `protobuf:"varint,2,opt,name=remaining_estimate_seconds,json=remainingEstimateSeconds,proto3" json:"remaining_estimate_seconds,omitempty"`
+	TimeSpentSeconds         int64                  `protobuf:"varint,3,opt,name=time_spent_seconds,json=timeSpentSeconds,proto3" json:"time_spent_seconds,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *TimeTracking) Reset() {
+	*x = TimeTracking{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeTracking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeTracking) ProtoMessage() {}
+
+func (x *TimeTracking) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeTracking.ProtoReflect.Descriptor instead.
+func (*TimeTracking) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TimeTracking) GetOriginalEstimateSeconds() int64 {
+	if x != nil {
+		return x.OriginalEstimateSeconds
+	}
+	return 0
+}
+
+func (x *TimeTracking) GetRemainingEstimateSeconds() int64 {
+	if x != nil {
+		return x.RemainingEstimateSeconds
+	}
+	return 0
+}
+
+func (x *TimeTracking) GetTimeSpentSeconds() int64 {
+	if x != nil {
+		return x.TimeSpentSeconds
+	}
+	return 0
+}
+
+// Creates issues from a blueprint on a recurrence rule
+type IssueSchedule struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Blueprint of the issues to create
+	Summary      string              `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	Description  string              `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Type         IssueType           `protobuf:"varint,6,opt,name=type,proto3,enum=nexusflow.issue.v1.IssueType" json:"type,omitempty"`
+	Priority     IssuePriority       `protobuf:"varint,7,opt,name=priority,proto3,enum=nexusflow.issue.v1.IssuePriority" json:"priority,omitempty"`
+	AssigneeId   string              `protobuf:"bytes,8,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	CustomFields []*CustomFieldValue `protobuf:"bytes,9,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	// RFC 5545 rule, e.g. "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR;BYHOUR=9" or
+	// "FREQ=MONTHLY;BYMONTHDAY=1". Supports FREQ DAILY, WEEKLY and MONTHLY with
+	// INTERVAL, BYDAY, BYMONTHDAY, BYHOUR, BYMINUTE, and UNTIL or COUNT; the
+	// time of day and default day come from starts_at.
+	Rrule         string                 `protobuf:"bytes,10,opt,name=rrule,proto3" json:"rrule,omitempty"`
+	Timezone      string                 `protobuf:"bytes,11,opt,name=timezone,proto3" json:"timezone,omitempty"`                 // IANA name, defaults to UTC
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"` // Defaults to now
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	CatchUp       ScheduleCatchUp        `protobuf:"varint,14,opt,name=catch_up,json=catchUp,proto3,enum=nexusflow.issue.v1.ScheduleCatchUp" json:"catch_up,omitempty"`
+	Enabled       bool                   `protobuf:"varint,15,opt,name=enabled,proto3" json:"enabled,omitempty"` // Only enabled schedules create issues
+	NextRunAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	LastRunAt     *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	LastError     string                 `protobuf:"bytes,18,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"` // Why the last run failed to create issues
+	CreatedBy     string                 `protobuf:"bytes,19,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // Also the reporter of created issues
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueSchedule) Reset() {
+	*x = IssueSchedule{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueSchedule) ProtoMessage() {}
+
+func (x *IssueSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueSchedule.ProtoReflect.Descriptor instead.
+func (*IssueSchedule) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *IssueSchedule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IssueSchedule) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *IssueSchedule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IssueSchedule) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *IssueSchedule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *IssueSchedule) GetType() IssueType {
+	if x != nil {
+		return x.Type
+	}
+	return IssueType_ISSUE_TYPE_UNSPECIFIED
+}
+
+func (x *IssueSchedule) GetPriority() IssuePriority {
+	if x != nil {
+		return x.Priority
+	}
+	return IssuePriority_ISSUE_PRIORITY_UNSPECIFIED
+}
+
+func (x *IssueSchedule) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+func (x *IssueSchedule) GetCustomFields() []*CustomFieldValue {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+func (x *IssueSchedule) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *IssueSchedule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *IssueSchedule) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *IssueSchedule) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *IssueSchedule) GetCatchUp() ScheduleCatchUp {
+	if x != nil {
+		return x.CatchUp
+	}
+	return ScheduleCatchUp_SCHEDULE_CATCH_UP_UNSPECIFIED
+}
+
+func (x *IssueSchedule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *IssueSchedule) GetNextRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return nil
+}
+
+func (x *IssueSchedule) GetLastRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return nil
+}
+
+func (x *IssueSchedule) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *IssueSchedule) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *IssueSchedule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *IssueSchedule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Pre-fills new issues of a type in a project
+type IssueTemplate struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	IssueType IssueType              `protobuf:"varint,4,opt,name=issue_type,json=issueType,proto3,enum=nexusflow.issue.v1.IssueType" json:"issue_type,omitempty"`
+	// The default template of a type is used when an issue is created
+	// without a template_id. Making a template the default unsets the
+	// previous one.
+	IsDefault     bool                   `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`                                  // Skeleton used when the issue has no description
+	Priority      IssuePriority          `protobuf:"varint,7,opt,name=priority,proto3,enum=nexusflow.issue.v1.IssuePriority" json:"priority,omitempty"` // Used when the issue has no priority
+	LabelIds      []string               `protobuf:"bytes,8,rep,name=label_ids,json=labelIds,proto3" json:"label_ids,omitempty"`                        // Added to the issue's labels
+	CustomFields  []*CustomFieldValue    `protobuf:"bytes,9,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`            // Used for fields the issue leaves out
+	SubTasks      []*TemplateSubTask     `protobuf:"bytes,10,rep,name=sub_tasks,json=subTasks,proto3" json:"sub_tasks,omitempty"`                       // Created under every new issue
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueTemplate) Reset() {
+	*x = IssueTemplate{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueTemplate) ProtoMessage() {}
+
+func (x *IssueTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueTemplate.ProtoReflect.Descriptor instead.
+func (*IssueTemplate) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *IssueTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IssueTemplate) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *IssueTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IssueTemplate) GetIssueType() IssueType {
+	if x != nil {
+		return x.IssueType
+	}
+	return IssueType_ISSUE_TYPE_UNSPECIFIED
+}
+
+func (x *IssueTemplate) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *IssueTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *IssueTemplate) GetPriority() IssuePriority {
+	if x != nil {
+		return x.Priority
+	}
+	return IssuePriority_ISSUE_PRIORITY_UNSPECIFIED
+}
+
+func (x *IssueTemplate) GetLabelIds() []string {
+	if x != nil {
+		return x.LabelIds
+	}
+	return nil
+}
+
+func (x *IssueTemplate) GetCustomFields() []*CustomFieldValue {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+func (x *IssueTemplate) GetSubTasks() []*TemplateSubTask {
+	if x != nil {
+		return x.SubTasks
+	}
+	return nil
+}
+
+func (x *IssueTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *IssueTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Checklist item of an issue template
+type TemplateSubTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       string                 `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	AssigneeId    string                 `protobuf:"bytes,3,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"` // Defaults to the parent issue's assignee
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateSubTask) Reset() {
+	*x = TemplateSubTask{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateSubTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateSubTask) ProtoMessage() {}
+
+func (x *TemplateSubTask) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateSubTask.ProtoReflect.Descriptor instead.
+func (*TemplateSubTask) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TemplateSubTask) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *TemplateSubTask) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TemplateSubTask) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+// Why a single issue in a job was not changed
+type JobItemError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobItemError) Reset() {
+	*x = JobItemError{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobItemError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobItemError) ProtoMessage() {}
+
+func (x *JobItemError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobItemError.ProtoReflect.Descriptor instead.
+func (*JobItemError) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *JobItemError) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *JobItemError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Issue link
+type IssueLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SourceIssueId string                 `protobuf:"bytes,2,opt,name=source_issue_id,json=sourceIssueId,proto3" json:"source_issue_id,omitempty"`
+	TargetIssueId string                 `protobuf:"bytes,3,opt,name=target_issue_id,json=targetIssueId,proto3" json:"target_issue_id,omitempty"`
+	Type          IssueLinkType          `protobuf:"varint,4,opt,name=type,proto3,enum=nexusflow.issue.v1.IssueLinkType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueLink) Reset() {
+	*x = IssueLink{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueLink) ProtoMessage() {}
+
+func (x *IssueLink) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueLink.ProtoReflect.Descriptor instead.
+func (*IssueLink) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *IssueLink) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IssueLink) GetSourceIssueId() string {
+	if x != nil {
+		return x.SourceIssueId
+	}
+	return ""
+}
+
+func (x *IssueLink) GetTargetIssueId() string {
+	if x != nil {
+		return x.TargetIssueId
+	}
+	return ""
+}
+
+func (x *IssueLink) GetType() IssueLinkType {
+	if x != nil {
+		return x.Type
+	}
+	return IssueLinkType_ISSUE_LINK_TYPE_UNSPECIFIED
+}
+
+type CreateIssueRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId               string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Summary                 string                 `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	Description             string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Type                    IssueType              `protobuf:"varint,4,opt,name=type,proto3,enum=nexusflow.issue.v1.IssueType" json:"type,omitempty"`
+	Priority                IssuePriority          `protobuf:"varint,5,opt,name=priority,proto3,enum=nexusflow.issue.v1.IssuePriority" json:"priority,omitempty"`
+	AssigneeId              string                 `protobuf:"bytes,6,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	ParentId                string                 `protobuf:"bytes,7,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	LabelIds                []string               `protobuf:"bytes,8,rep,name=label_ids,json=labelIds,proto3" json:"label_ids,omitempty"`
+	CustomFields            []*CustomFieldValue    `protobuf:"bytes,9,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	ComponentIds            []string               `protobuf:"bytes,10,rep,name=component_ids,json=componentIds,proto3" json:"component_ids,omitempty"`
+	OriginalEstimateSeconds int64                  `protobuf:"varint,11,opt,name=original_estimate_seconds,json=originalEstimateSeconds,proto3" json:"original_estimate_seconds,omitempty"` // Also the initial remaining estimate
+	// Template to fill blank fields from; defaults to the project default
+	// template for the issue type
+	TemplateId    string `protobuf:"bytes,12,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIssueRequest) Reset() {
+	*x = CreateIssueRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIssueRequest) ProtoMessage() {}
+
+func (x *CreateIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIssueRequest.ProtoReflect.Descriptor instead.
+func (*CreateIssueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateIssueRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateIssueRequest) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *CreateIssueRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateIssueRequest) GetType() IssueType {
+	if x != nil {
+		return x.Type
+	}
+	return IssueType_ISSUE_TYPE_UNSPECIFIED
 }
 
 func (x *CreateIssueRequest) GetPriority() IssuePriority {
 	if x != nil {
-		return x.Priority
+		return x.Priority
+	}
+	return IssuePriority_ISSUE_PRIORITY_UNSPECIFIED
+}
+
+func (x *CreateIssueRequest) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+func (x *CreateIssueRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateIssueRequest) GetLabelIds() []string {
+	if x != nil {
+		return x.LabelIds
+	}
+	return nil
+}
+
+func (x *CreateIssueRequest) GetCustomFields() []*CustomFieldValue {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+func (x *CreateIssueRequest) GetComponentIds() []string {
+	if x != nil {
+		return x.ComponentIds
+	}
+	return nil
+}
+
+func (x *CreateIssueRequest) GetOriginalEstimateSeconds() int64 {
+	if x != nil {
+		return x.OriginalEstimateSeconds
+	}
+	return 0
+}
+
+func (x *CreateIssueRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+type CreateIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIssueResponse) Reset() {
+	*x = CreateIssueResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIssueResponse) ProtoMessage() {}
+
+func (x *CreateIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIssueResponse.ProtoReflect.Descriptor instead.
+func (*CreateIssueResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateIssueResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type GetIssueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIssueRequest) Reset() {
+	*x = GetIssueRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssueRequest) ProtoMessage() {}
+
+func (x *GetIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssueRequest.ProtoReflect.Descriptor instead.
+func (*GetIssueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetIssueRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIssueResponse) Reset() {
+	*x = GetIssueResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssueResponse) ProtoMessage() {}
+
+func (x *GetIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssueResponse.ProtoReflect.Descriptor instead.
+func (*GetIssueResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetIssueResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type GetIssueByKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIssueByKeyRequest) Reset() {
+	*x = GetIssueByKeyRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssueByKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssueByKeyRequest) ProtoMessage() {}
+
+func (x *GetIssueByKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssueByKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetIssueByKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetIssueByKeyRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetIssueByKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIssueByKeyResponse) Reset() {
+	*x = GetIssueByKeyResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssueByKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssueByKeyResponse) ProtoMessage() {}
+
+func (x *GetIssueByKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssueByKeyResponse.ProtoReflect.Descriptor instead.
+func (*GetIssueByKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetIssueByKeyResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type BatchGetIssuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"` // At most 500
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetIssuesRequest) Reset() {
+	*x = BatchGetIssuesRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetIssuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetIssuesRequest) ProtoMessage() {}
+
+func (x *BatchGetIssuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetIssuesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetIssuesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BatchGetIssuesRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchGetIssuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issues        []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"` // Missing and trashed issues are left out
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetIssuesResponse) Reset() {
+	*x = BatchGetIssuesResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetIssuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetIssuesResponse) ProtoMessage() {}
+
+func (x *BatchGetIssuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetIssuesResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetIssuesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BatchGetIssuesResponse) GetIssues() []*Issue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type UpdateIssueRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Summary      *string                `protobuf:"bytes,2,opt,name=summary,proto3,oneof" json:"summary,omitempty"`
+	Description  *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Priority     *IssuePriority         `protobuf:"varint,4,opt,name=priority,proto3,enum=nexusflow.issue.v1.IssuePriority,oneof" json:"priority,omitempty"`
+	StatusId     *string                `protobuf:"bytes,5,opt,name=status_id,json=statusId,proto3,oneof" json:"status_id,omitempty"`
+	AssigneeId   *string                `protobuf:"bytes,6,opt,name=assignee_id,json=assigneeId,proto3,oneof" json:"assignee_id,omitempty"`
+	LabelIds     []string               `protobuf:"bytes,7,rep,name=label_ids,json=labelIds,proto3" json:"label_ids,omitempty"`
+	CustomFields []*CustomFieldValue    `protobuf:"bytes,8,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	StoryPoints  *int32                 `protobuf:"varint,9,opt,name=story_points,json=storyPoints,proto3,oneof" json:"story_points,omitempty"`
+	ComponentIds []string               `protobuf:"bytes,10,rep,name=component_ids,json=componentIds,proto3" json:"component_ids,omitempty"`
+	// Paths of list fields to replace even when empty, e.g. "label_ids" to
+	// remove every label. Non-empty lists are always applied.
+	UpdateMask []string `protobuf:"bytes,11,rep,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Rejects the update with ABORTED if the issue is no longer at this version.
+	// Falls back to the If-Match header when unset.
+	ExpectedVersion *int64 `protobuf:"varint,12,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	// Setting only the original estimate before any work is logged also
+	// resets the remaining estimate.
+	OriginalEstimateSeconds  *int64 `protobuf:"varint,13,opt,name=original_estimate_seconds,json=originalEstimateSeconds,proto3,oneof" json:"original_estimate_seconds,omitempty"`
+	RemainingEstimateSeconds *int64 `protobuf:"varint,14,opt,name=remaining_estimate_seconds,json=remainingEstimateSeconds,proto3,oneof" json:"remaining_estimate_seconds,omitempty"`
+	// User making the change, reported in the issue.updated event
+	UserId        string `protobuf:"bytes,15,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateIssueRequest) Reset() {
+	*x = UpdateIssueRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIssueRequest) ProtoMessage() {}
+
+func (x *UpdateIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIssueRequest.ProtoReflect.Descriptor instead.
+func (*UpdateIssueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateIssueRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateIssueRequest) GetSummary() string {
+	if x != nil && x.Summary != nil {
+		return *x.Summary
+	}
+	return ""
+}
+
+func (x *UpdateIssueRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateIssueRequest) GetPriority() IssuePriority {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return IssuePriority_ISSUE_PRIORITY_UNSPECIFIED
+}
+
+func (x *UpdateIssueRequest) GetStatusId() string {
+	if x != nil && x.StatusId != nil {
+		return *x.StatusId
+	}
+	return ""
+}
+
+func (x *UpdateIssueRequest) GetAssigneeId() string {
+	if x != nil && x.AssigneeId != nil {
+		return *x.AssigneeId
+	}
+	return ""
+}
+
+func (x *UpdateIssueRequest) GetLabelIds() []string {
+	if x != nil {
+		return x.LabelIds
+	}
+	return nil
+}
+
+func (x *UpdateIssueRequest) GetCustomFields() []*CustomFieldValue {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+func (x *UpdateIssueRequest) GetStoryPoints() int32 {
+	if x != nil && x.StoryPoints != nil {
+		return *x.StoryPoints
+	}
+	return 0
+}
+
+func (x *UpdateIssueRequest) GetComponentIds() []string {
+	if x != nil {
+		return x.ComponentIds
+	}
+	return nil
+}
+
+func (x *UpdateIssueRequest) GetUpdateMask() []string {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateIssueRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateIssueRequest) GetOriginalEstimateSeconds() int64 {
+	if x != nil && x.OriginalEstimateSeconds != nil {
+		return *x.OriginalEstimateSeconds
+	}
+	return 0
+}
+
+func (x *UpdateIssueRequest) GetRemainingEstimateSeconds() int64 {
+	if x != nil && x.RemainingEstimateSeconds != nil {
+		return *x.RemainingEstimateSeconds
+	}
+	return 0
+}
+
+func (x *UpdateIssueRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UpdateIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateIssueResponse) Reset() {
+	*x = UpdateIssueResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIssueResponse) ProtoMessage() {}
+
+func (x *UpdateIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIssueResponse.ProtoReflect.Descriptor instead.
+func (*UpdateIssueResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateIssueResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+// Moves the issue and its sub-tasks to the project trash. Trashed issues are
+// permanently deleted after the retention period.
+type DeleteIssueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIssueRequest) Reset() {
+	*x = DeleteIssueRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIssueRequest) ProtoMessage() {}
+
+func (x *DeleteIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIssueRequest.ProtoReflect.Descriptor instead.
+func (*DeleteIssueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteIssueRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteIssueRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *v1.SuccessResponse    `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIssueResponse) Reset() {
+	*x = DeleteIssueResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIssueResponse) ProtoMessage() {}
+
+func (x *DeleteIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIssueResponse.ProtoReflect.Descriptor instead.
+func (*DeleteIssueResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteIssueResponse) GetResponse() *v1.SuccessResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// Lists issues deleted directly, most recent first; sub-tasks deleted along
+// with their parent are restored with it and not listed
+type ListDeletedIssuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Pagination    *v1.PaginationRequest  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedIssuesRequest) Reset() {
+	*x = ListDeletedIssuesRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedIssuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedIssuesRequest) ProtoMessage() {}
+
+func (x *ListDeletedIssuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedIssuesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedIssuesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListDeletedIssuesRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ListDeletedIssuesRequest) GetPagination() *v1.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListDeletedIssuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issues        []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	Pagination    *v1.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedIssuesResponse) Reset() {
+	*x = ListDeletedIssuesResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedIssuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedIssuesResponse) ProtoMessage() {}
+
+func (x *ListDeletedIssuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedIssuesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedIssuesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListDeletedIssuesResponse) GetIssues() []*Issue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *ListDeletedIssuesResponse) GetPagination() *v1.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type RestoreIssueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreIssueRequest) Reset() {
+	*x = RestoreIssueRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreIssueRequest) ProtoMessage() {}
+
+func (x *RestoreIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreIssueRequest.ProtoReflect.Descriptor instead.
+func (*RestoreIssueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RestoreIssueRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreIssueRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RestoreIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreIssueResponse) Reset() {
+	*x = RestoreIssueResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreIssueResponse) ProtoMessage() {}
+
+func (x *RestoreIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreIssueResponse.ProtoReflect.Descriptor instead.
+func (*RestoreIssueResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RestoreIssueResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+// Lists issues a page at a time using pagination.cursor. Supported
+// pagination.sort_by values are created_at (default), updated_at, due_date,
+// priority, story_points and rank, the backlog order.
+type ListIssuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Pagination    *v1.PaginationRequest  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Type          IssueType              `protobuf:"varint,3,opt,name=type,proto3,enum=nexusflow.issue.v1.IssueType" json:"type,omitempty"`
+	AssigneeId    string                 `protobuf:"bytes,4,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	SprintId      string                 `protobuf:"bytes,5,opt,name=sprint_id,json=sprintId,proto3" json:"sprint_id,omitempty"`
+	StatusIds     []string               `protobuf:"bytes,6,rep,name=status_ids,json=statusIds,proto3" json:"status_ids,omitempty"`
+	LabelIds      []string               `protobuf:"bytes,7,rep,name=label_ids,json=labelIds,proto3" json:"label_ids,omitempty"`             // Issues with any of these labels
+	ComponentIds  []string               `protobuf:"bytes,8,rep,name=component_ids,json=componentIds,proto3" json:"component_ids,omitempty"` // Issues in any of these components
+	ReporterId    string                 `protobuf:"bytes,9,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	Priorities    []IssuePriority        `protobuf:"varint,10,rep,packed,name=priorities,proto3,enum=nexusflow.issue.v1.IssuePriority" json:"priorities,omitempty"`
+	ParentId      string                 `protobuf:"bytes,11,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	DueAfter      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=due_after,json=dueAfter,proto3" json:"due_after,omitempty"`    // Inclusive
+	DueBefore     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=due_before,json=dueBefore,proto3" json:"due_before,omitempty"` // Exclusive
+	UpdatedSince  *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_since,json=updatedSince,proto3" json:"updated_since,omitempty"`
+	UpdatedBefore *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_before,json=updatedBefore,proto3" json:"updated_before,omitempty"` // Exclusive
+	Backlog       bool                   `protobuf:"varint,16,opt,name=backlog,proto3" json:"backlog,omitempty"`                                 // Only issues in no sprint
+	CustomFields  []*CustomFieldFilter   `protobuf:"bytes,17,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`    // Issues matching all of these
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssuesRequest) Reset() {
+	*x = ListIssuesRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssuesRequest) ProtoMessage() {}
+
+func (x *ListIssuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIssuesRequest.ProtoReflect.Descriptor instead.
+func (*ListIssuesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListIssuesRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ListIssuesRequest) GetPagination() *v1.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListIssuesRequest) GetType() IssueType {
+	if x != nil {
+		return x.Type
+	}
+	return IssueType_ISSUE_TYPE_UNSPECIFIED
+}
+
+func (x *ListIssuesRequest) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+func (x *ListIssuesRequest) GetSprintId() string {
+	if x != nil {
+		return x.SprintId
+	}
+	return ""
+}
+
+func (x *ListIssuesRequest) GetStatusIds() []string {
+	if x != nil {
+		return x.StatusIds
+	}
+	return nil
+}
+
+func (x *ListIssuesRequest) GetLabelIds() []string {
+	if x != nil {
+		return x.LabelIds
+	}
+	return nil
+}
+
+func (x *ListIssuesRequest) GetComponentIds() []string {
+	if x != nil {
+		return x.ComponentIds
+	}
+	return nil
+}
+
+func (x *ListIssuesRequest) GetReporterId() string {
+	if x != nil {
+		return x.ReporterId
+	}
+	return ""
+}
+
+func (x *ListIssuesRequest) GetPriorities() []IssuePriority {
+	if x != nil {
+		return x.Priorities
+	}
+	return nil
+}
+
+func (x *ListIssuesRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *ListIssuesRequest) GetDueAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAfter
+	}
+	return nil
+}
+
+func (x *ListIssuesRequest) GetDueBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueBefore
+	}
+	return nil
+}
+
+func (x *ListIssuesRequest) GetUpdatedSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedSince
+	}
+	return nil
+}
+
+func (x *ListIssuesRequest) GetUpdatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return nil
+}
+
+func (x *ListIssuesRequest) GetBacklog() bool {
+	if x != nil {
+		return x.Backlog
+	}
+	return false
+}
+
+func (x *ListIssuesRequest) GetCustomFields() []*CustomFieldFilter {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+// Matches issues whose custom field holds the value, or for multi-value
+// fields, includes it. Values compare as text, e.g. "42" or "true".
+type CustomFieldFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldId       string                 `protobuf:"bytes,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomFieldFilter) Reset() {
+	*x = CustomFieldFilter{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomFieldFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomFieldFilter) ProtoMessage() {}
+
+func (x *CustomFieldFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomFieldFilter.ProtoReflect.Descriptor instead.
+func (*CustomFieldFilter) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CustomFieldFilter) GetFieldId() string {
+	if x != nil {
+		return x.FieldId
+	}
+	return ""
+}
+
+func (x *CustomFieldFilter) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ListIssuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issues        []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	Pagination    *v1.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssuesResponse) Reset() {
+	*x = ListIssuesResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssuesResponse) ProtoMessage() {}
+
+func (x *ListIssuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIssuesResponse.ProtoReflect.Descriptor instead.
+func (*ListIssuesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListIssuesResponse) GetIssues() []*Issue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *ListIssuesResponse) GetPagination() *v1.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchIssuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"` // JQL-like query
+	Pagination    *v1.PaginationRequest  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ProjectIds    []string               `protobuf:"bytes,3,rep,name=project_ids,json=projectIds,proto3" json:"project_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchIssuesRequest) Reset() {
+	*x = SearchIssuesRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchIssuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchIssuesRequest) ProtoMessage() {}
+
+func (x *SearchIssuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchIssuesRequest.ProtoReflect.Descriptor instead.
+func (*SearchIssuesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SearchIssuesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchIssuesRequest) GetPagination() *v1.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *SearchIssuesRequest) GetProjectIds() []string {
+	if x != nil {
+		return x.ProjectIds
+	}
+	return nil
+}
+
+type SearchIssuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issues        []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	Pagination    *v1.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchIssuesResponse) Reset() {
+	*x = SearchIssuesResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchIssuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchIssuesResponse) ProtoMessage() {}
+
+func (x *SearchIssuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchIssuesResponse.ProtoReflect.Descriptor instead.
+func (*SearchIssuesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SearchIssuesResponse) GetIssues() []*Issue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *SearchIssuesResponse) GetPagination() *v1.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetIssueChildrenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIssueChildrenRequest) Reset() {
+	*x = GetIssueChildrenRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssueChildrenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssueChildrenRequest) ProtoMessage() {}
+
+func (x *GetIssueChildrenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssueChildrenRequest.ProtoReflect.Descriptor instead.
+func (*GetIssueChildrenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetIssueChildrenRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetIssueChildrenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Children      []*Issue               `protobuf:"bytes,1,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIssueChildrenResponse) Reset() {
+	*x = GetIssueChildrenResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssueChildrenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssueChildrenResponse) ProtoMessage() {}
+
+func (x *GetIssueChildrenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssueChildrenResponse.ProtoReflect.Descriptor instead.
+func (*GetIssueChildrenResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetIssueChildrenResponse) GetChildren() []*Issue {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type MoveIssueRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetProjectId string                 `protobuf:"bytes,2,opt,name=target_project_id,json=targetProjectId,proto3" json:"target_project_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MoveIssueRequest) Reset() {
+	*x = MoveIssueRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveIssueRequest) ProtoMessage() {}
+
+func (x *MoveIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveIssueRequest.ProtoReflect.Descriptor instead.
+func (*MoveIssueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MoveIssueRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveIssueRequest) GetTargetProjectId() string {
+	if x != nil {
+		return x.TargetProjectId
+	}
+	return ""
+}
+
+type MoveIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveIssueResponse) Reset() {
+	*x = MoveIssueResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveIssueResponse) ProtoMessage() {}
+
+func (x *MoveIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveIssueResponse.ProtoReflect.Descriptor instead.
+func (*MoveIssueResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MoveIssueResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type CreateIssueLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceIssueId string                 `protobuf:"bytes,1,opt,name=source_issue_id,json=sourceIssueId,proto3" json:"source_issue_id,omitempty"`
+	TargetIssueId string                 `protobuf:"bytes,2,opt,name=target_issue_id,json=targetIssueId,proto3" json:"target_issue_id,omitempty"`
+	Type          IssueLinkType          `protobuf:"varint,3,opt,name=type,proto3,enum=nexusflow.issue.v1.IssueLinkType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIssueLinkRequest) Reset() {
+	*x = CreateIssueLinkRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIssueLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIssueLinkRequest) ProtoMessage() {}
+
+func (x *CreateIssueLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIssueLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateIssueLinkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateIssueLinkRequest) GetSourceIssueId() string {
+	if x != nil {
+		return x.SourceIssueId
+	}
+	return ""
+}
+
+func (x *CreateIssueLinkRequest) GetTargetIssueId() string {
+	if x != nil {
+		return x.TargetIssueId
+	}
+	return ""
+}
+
+func (x *CreateIssueLinkRequest) GetType() IssueLinkType {
+	if x != nil {
+		return x.Type
+	}
+	return IssueLinkType_ISSUE_LINK_TYPE_UNSPECIFIED
+}
+
+type CreateIssueLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Link          *IssueLink             `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIssueLinkResponse) Reset() {
+	*x = CreateIssueLinkResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIssueLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIssueLinkResponse) ProtoMessage() {}
+
+func (x *CreateIssueLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIssueLinkResponse.ProtoReflect.Descriptor instead.
+func (*CreateIssueLinkResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateIssueLinkResponse) GetLink() *IssueLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+type DeleteIssueLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIssueLinkRequest) Reset() {
+	*x = DeleteIssueLinkRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIssueLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIssueLinkRequest) ProtoMessage() {}
+
+func (x *DeleteIssueLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIssueLinkRequest.ProtoReflect.Descriptor instead.
+func (*DeleteIssueLinkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteIssueLinkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteIssueLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *v1.SuccessResponse    `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIssueLinkResponse) Reset() {
+	*x = DeleteIssueLinkResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIssueLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIssueLinkResponse) ProtoMessage() {}
+
+func (x *DeleteIssueLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIssueLinkResponse.ProtoReflect.Descriptor instead.
+func (*DeleteIssueLinkResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteIssueLinkResponse) GetResponse() *v1.SuccessResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type GetIssueLinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIssueLinksRequest) Reset() {
+	*x = GetIssueLinksRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssueLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssueLinksRequest) ProtoMessage() {}
+
+func (x *GetIssueLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssueLinksRequest.ProtoReflect.Descriptor instead.
+func (*GetIssueLinksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetIssueLinksRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+type GetIssueLinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         []*IssueLink           `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIssueLinksResponse) Reset() {
+	*x = GetIssueLinksResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssueLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssueLinksResponse) ProtoMessage() {}
+
+func (x *GetIssueLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssueLinksResponse.ProtoReflect.Descriptor instead.
+func (*GetIssueLinksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetIssueLinksResponse) GetLinks() []*IssueLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type AddWatcherRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWatcherRequest) Reset() {
+	*x = AddWatcherRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWatcherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWatcherRequest) ProtoMessage() {}
+
+func (x *AddWatcherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWatcherRequest.ProtoReflect.Descriptor instead.
+func (*AddWatcherRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AddWatcherRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *AddWatcherRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AddWatcherResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWatcherResponse) Reset() {
+	*x = AddWatcherResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWatcherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWatcherResponse) ProtoMessage() {}
+
+func (x *AddWatcherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWatcherResponse.ProtoReflect.Descriptor instead.
+func (*AddWatcherResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AddWatcherResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type RemoveWatcherRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWatcherRequest) Reset() {
+	*x = RemoveWatcherRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWatcherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWatcherRequest) ProtoMessage() {}
+
+func (x *RemoveWatcherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWatcherRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWatcherRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RemoveWatcherRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *RemoveWatcherRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveWatcherResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWatcherResponse) Reset() {
+	*x = RemoveWatcherResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWatcherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWatcherResponse) ProtoMessage() {}
+
+func (x *RemoveWatcherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWatcherResponse.ProtoReflect.Descriptor instead.
+func (*RemoveWatcherResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RemoveWatcherResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type CreateCustomFieldRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId      string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Type           CustomFieldType        `protobuf:"varint,4,opt,name=type,proto3,enum=nexusflow.issue.v1.CustomFieldType" json:"type,omitempty"`
+	Required       bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	Options        []string               `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
+	DefaultValue   *anypb.Any             `protobuf:"bytes,7,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Config         map[string]string      `protobuf:"bytes,8,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Type-specific validation, e.g. min/max, max_length
+	OrganizationId string                 `protobuf:"bytes,9,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`                                     // Creates a shared field instead of a project field
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateCustomFieldRequest) Reset() {
+	*x = CreateCustomFieldRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCustomFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCustomFieldRequest) ProtoMessage() {}
+
+func (x *CreateCustomFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCustomFieldRequest.ProtoReflect.Descriptor instead.
+func (*CreateCustomFieldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateCustomFieldRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateCustomFieldRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCustomFieldRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCustomFieldRequest) GetType() CustomFieldType {
+	if x != nil {
+		return x.Type
+	}
+	return CustomFieldType_CUSTOM_FIELD_TYPE_UNSPECIFIED
+}
+
+func (x *CreateCustomFieldRequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *CreateCustomFieldRequest) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateCustomFieldRequest) GetDefaultValue() *anypb.Any {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return nil
+}
+
+func (x *CreateCustomFieldRequest) GetConfig() map[string]string {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *CreateCustomFieldRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type CreateCustomFieldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         *CustomField           `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCustomFieldResponse) Reset() {
+	*x = CreateCustomFieldResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCustomFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCustomFieldResponse) ProtoMessage() {}
+
+func (x *CreateCustomFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCustomFieldResponse.ProtoReflect.Descriptor instead.
+func (*CreateCustomFieldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateCustomFieldResponse) GetField() *CustomField {
+	if x != nil {
+		return x.Field
+	}
+	return nil
+}
+
+type UpdateCustomFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Required      *bool                  `protobuf:"varint,4,opt,name=required,proto3,oneof" json:"required,omitempty"`
+	Options       []string               `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCustomFieldRequest) Reset() {
+	*x = UpdateCustomFieldRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomFieldRequest) ProtoMessage() {}
+
+func (x *UpdateCustomFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomFieldRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCustomFieldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateCustomFieldRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCustomFieldRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCustomFieldRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateCustomFieldRequest) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *UpdateCustomFieldRequest) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type UpdateCustomFieldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         *CustomField           `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCustomFieldResponse) Reset() {
+	*x = UpdateCustomFieldResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomFieldResponse) ProtoMessage() {}
+
+func (x *UpdateCustomFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomFieldResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCustomFieldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateCustomFieldResponse) GetField() *CustomField {
+	if x != nil {
+		return x.Field
+	}
+	return nil
+}
+
+type DeleteCustomFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomFieldRequest) Reset() {
+	*x = DeleteCustomFieldRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomFieldRequest) ProtoMessage() {}
+
+func (x *DeleteCustomFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomFieldRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCustomFieldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteCustomFieldRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCustomFieldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *v1.SuccessResponse    `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomFieldResponse) Reset() {
+	*x = DeleteCustomFieldResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomFieldResponse) ProtoMessage() {}
+
+func (x *DeleteCustomFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomFieldResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCustomFieldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteCustomFieldResponse) GetResponse() *v1.SuccessResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type ListCustomFieldsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId      string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	IssueType      IssueType              `protobuf:"varint,2,opt,name=issue_type,json=issueType,proto3,enum=nexusflow.issue.v1.IssueType" json:"issue_type,omitempty"` // Resolves shared fields for this issue type
+	OrganizationId string                 `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`                     // Lists shared field definitions when project_id is empty
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListCustomFieldsRequest) Reset() {
+	*x = ListCustomFieldsRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomFieldsRequest) ProtoMessage() {}
+
+func (x *ListCustomFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomFieldsRequest.ProtoReflect.Descriptor instead.
+func (*ListCustomFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListCustomFieldsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ListCustomFieldsRequest) GetIssueType() IssueType {
+	if x != nil {
+		return x.IssueType
+	}
+	return IssueType_ISSUE_TYPE_UNSPECIFIED
+}
+
+func (x *ListCustomFieldsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type ListCustomFieldsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fields        []*CustomField         `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomFieldsResponse) Reset() {
+	*x = ListCustomFieldsResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomFieldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomFieldsResponse) ProtoMessage() {}
+
+func (x *ListCustomFieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomFieldsResponse.ProtoReflect.Descriptor instead.
+func (*ListCustomFieldsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListCustomFieldsResponse) GetFields() []*CustomField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type CreateCustomFieldContextRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldId       string                 `protobuf:"bytes,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ProjectIds    []string               `protobuf:"bytes,3,rep,name=project_ids,json=projectIds,proto3" json:"project_ids,omitempty"`
+	IssueTypes    []IssueType            `protobuf:"varint,4,rep,packed,name=issue_types,json=issueTypes,proto3,enum=nexusflow.issue.v1.IssueType" json:"issue_types,omitempty"`
+	Options       []string               `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	DefaultValue  *anypb.Any             `protobuf:"bytes,6,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCustomFieldContextRequest) Reset() {
+	*x = CreateCustomFieldContextRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCustomFieldContextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCustomFieldContextRequest) ProtoMessage() {}
+
+func (x *CreateCustomFieldContextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCustomFieldContextRequest.ProtoReflect.Descriptor instead.
+func (*CreateCustomFieldContextRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateCustomFieldContextRequest) GetFieldId() string {
+	if x != nil {
+		return x.FieldId
+	}
+	return ""
+}
+
+func (x *CreateCustomFieldContextRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCustomFieldContextRequest) GetProjectIds() []string {
+	if x != nil {
+		return x.ProjectIds
+	}
+	return nil
+}
+
+func (x *CreateCustomFieldContextRequest) GetIssueTypes() []IssueType {
+	if x != nil {
+		return x.IssueTypes
+	}
+	return nil
+}
+
+func (x *CreateCustomFieldContextRequest) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateCustomFieldContextRequest) GetDefaultValue() *anypb.Any {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return nil
+}
+
+type CreateCustomFieldContextResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *CustomFieldContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCustomFieldContextResponse) Reset() {
+	*x = CreateCustomFieldContextResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCustomFieldContextResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCustomFieldContextResponse) ProtoMessage() {}
+
+func (x *CreateCustomFieldContextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCustomFieldContextResponse.ProtoReflect.Descriptor instead.
+func (*CreateCustomFieldContextResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateCustomFieldContextResponse) GetContext() *CustomFieldContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type UpdateCustomFieldContextRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	ProjectIds   []string               `protobuf:"bytes,3,rep,name=project_ids,json=projectIds,proto3" json:"project_ids,omitempty"`
+	IssueTypes   []IssueType            `protobuf:"varint,4,rep,packed,name=issue_types,json=issueTypes,proto3,enum=nexusflow.issue.v1.IssueType" json:"issue_types,omitempty"`
+	Options      []string               `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	DefaultValue *anypb.Any             `protobuf:"bytes,6,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	// Paths of the list and default fields to replace, e.g. "project_ids"
+	UpdateMask    []string `protobuf:"bytes,7,rep,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCustomFieldContextRequest) Reset() {
+	*x = UpdateCustomFieldContextRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomFieldContextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomFieldContextRequest) ProtoMessage() {}
+
+func (x *UpdateCustomFieldContextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomFieldContextRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCustomFieldContextRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdateCustomFieldContextRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCustomFieldContextRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCustomFieldContextRequest) GetProjectIds() []string {
+	if x != nil {
+		return x.ProjectIds
+	}
+	return nil
+}
+
+func (x *UpdateCustomFieldContextRequest) GetIssueTypes() []IssueType {
+	if x != nil {
+		return x.IssueTypes
+	}
+	return nil
+}
+
+func (x *UpdateCustomFieldContextRequest) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *UpdateCustomFieldContextRequest) GetDefaultValue() *anypb.Any {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return nil
+}
+
+func (x *UpdateCustomFieldContextRequest) GetUpdateMask() []string {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateCustomFieldContextResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *CustomFieldContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCustomFieldContextResponse) Reset() {
+	*x = UpdateCustomFieldContextResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomFieldContextResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomFieldContextResponse) ProtoMessage() {}
+
+func (x *UpdateCustomFieldContextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomFieldContextResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCustomFieldContextResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateCustomFieldContextResponse) GetContext() *CustomFieldContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type DeleteCustomFieldContextRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomFieldContextRequest) Reset() {
+	*x = DeleteCustomFieldContextRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomFieldContextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomFieldContextRequest) ProtoMessage() {}
+
+func (x *DeleteCustomFieldContextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomFieldContextRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCustomFieldContextRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteCustomFieldContextRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCustomFieldContextResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *v1.SuccessResponse    `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomFieldContextResponse) Reset() {
+	*x = DeleteCustomFieldContextResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomFieldContextResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomFieldContextResponse) ProtoMessage() {}
+
+func (x *DeleteCustomFieldContextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomFieldContextResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCustomFieldContextResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteCustomFieldContextResponse) GetResponse() *v1.SuccessResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type ListCustomFieldContextsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldId       string                 `protobuf:"bytes,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomFieldContextsRequest) Reset() {
+	*x = ListCustomFieldContextsRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomFieldContextsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomFieldContextsRequest) ProtoMessage() {}
+
+func (x *ListCustomFieldContextsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomFieldContextsRequest.ProtoReflect.Descriptor instead.
+func (*ListCustomFieldContextsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListCustomFieldContextsRequest) GetFieldId() string {
+	if x != nil {
+		return x.FieldId
+	}
+	return ""
+}
+
+type ListCustomFieldContextsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contexts      []*CustomFieldContext  `protobuf:"bytes,1,rep,name=contexts,proto3" json:"contexts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomFieldContextsResponse) Reset() {
+	*x = ListCustomFieldContextsResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomFieldContextsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomFieldContextsResponse) ProtoMessage() {}
+
+func (x *ListCustomFieldContextsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomFieldContextsResponse.ProtoReflect.Descriptor instead.
+func (*ListCustomFieldContextsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListCustomFieldContextsResponse) GetContexts() []*CustomFieldContext {
+	if x != nil {
+		return x.Contexts
+	}
+	return nil
+}
+
+type CreateLabelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLabelRequest) Reset() {
+	*x = CreateLabelRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLabelRequest) ProtoMessage() {}
+
+func (x *CreateLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLabelRequest.ProtoReflect.Descriptor instead.
+func (*CreateLabelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CreateLabelRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateLabelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateLabelRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *CreateLabelRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CreateLabelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         *v1.Label              `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLabelResponse) Reset() {
+	*x = CreateLabelResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLabelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLabelResponse) ProtoMessage() {}
+
+func (x *CreateLabelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLabelResponse.ProtoReflect.Descriptor instead.
+func (*CreateLabelResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CreateLabelResponse) GetLabel() *v1.Label {
+	if x != nil {
+		return x.Label
+	}
+	return nil
+}
+
+type UpdateLabelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Color         *string                `protobuf:"bytes,3,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLabelRequest) Reset() {
+	*x = UpdateLabelRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLabelRequest) ProtoMessage() {}
+
+func (x *UpdateLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLabelRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLabelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UpdateLabelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateLabelRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateLabelRequest) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+func (x *UpdateLabelRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type UpdateLabelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         *v1.Label              `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLabelResponse) Reset() {
+	*x = UpdateLabelResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLabelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLabelResponse) ProtoMessage() {}
+
+func (x *UpdateLabelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLabelResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLabelResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UpdateLabelResponse) GetLabel() *v1.Label {
+	if x != nil {
+		return x.Label
+	}
+	return nil
+}
+
+type DeleteLabelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLabelRequest) Reset() {
+	*x = DeleteLabelRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLabelRequest) ProtoMessage() {}
+
+func (x *DeleteLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLabelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLabelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DeleteLabelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteLabelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *v1.SuccessResponse    `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLabelResponse) Reset() {
+	*x = DeleteLabelResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLabelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLabelResponse) ProtoMessage() {}
+
+func (x *DeleteLabelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLabelResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLabelResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DeleteLabelResponse) GetResponse() *v1.SuccessResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type ListLabelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLabelsRequest) Reset() {
+	*x = ListLabelsRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLabelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLabelsRequest) ProtoMessage() {}
+
+func (x *ListLabelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLabelsRequest.ProtoReflect.Descriptor instead.
+func (*ListLabelsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListLabelsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type ListLabelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Labels        []*v1.Label            `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLabelsResponse) Reset() {
+	*x = ListLabelsResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLabelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLabelsResponse) ProtoMessage() {}
+
+func (x *ListLabelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLabelsResponse.ProtoReflect.Descriptor instead.
+func (*ListLabelsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListLabelsResponse) GetLabels() []*v1.Label {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type BulkUpdateIssueLabelsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IssueIds       []string               `protobuf:"bytes,1,rep,name=issue_ids,json=issueIds,proto3" json:"issue_ids,omitempty"` // Must belong to the same project
+	AddLabelIds    []string               `protobuf:"bytes,2,rep,name=add_label_ids,json=addLabelIds,proto3" json:"add_label_ids,omitempty"`
+	RemoveLabelIds []string               `protobuf:"bytes,3,rep,name=remove_label_ids,json=removeLabelIds,proto3" json:"remove_label_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BulkUpdateIssueLabelsRequest) Reset() {
+	*x = BulkUpdateIssueLabelsRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateIssueLabelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateIssueLabelsRequest) ProtoMessage() {}
+
+func (x *BulkUpdateIssueLabelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateIssueLabelsRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateIssueLabelsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *BulkUpdateIssueLabelsRequest) GetIssueIds() []string {
+	if x != nil {
+		return x.IssueIds
+	}
+	return nil
+}
+
+func (x *BulkUpdateIssueLabelsRequest) GetAddLabelIds() []string {
+	if x != nil {
+		return x.AddLabelIds
+	}
+	return nil
+}
+
+func (x *BulkUpdateIssueLabelsRequest) GetRemoveLabelIds() []string {
+	if x != nil {
+		return x.RemoveLabelIds
+	}
+	return nil
+}
+
+type BulkUpdateIssueLabelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issues        []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateIssueLabelsResponse) Reset() {
+	*x = BulkUpdateIssueLabelsResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateIssueLabelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateIssueLabelsResponse) ProtoMessage() {}
+
+func (x *BulkUpdateIssueLabelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateIssueLabelsResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateIssueLabelsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *BulkUpdateIssueLabelsResponse) GetIssues() []*Issue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type CreateComponentRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId         string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	LeadId            string                 `protobuf:"bytes,4,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
+	DefaultAssigneeId string                 `protobuf:"bytes,5,opt,name=default_assignee_id,json=defaultAssigneeId,proto3" json:"default_assignee_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateComponentRequest) Reset() {
+	*x = CreateComponentRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateComponentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateComponentRequest) ProtoMessage() {}
+
+func (x *CreateComponentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateComponentRequest.ProtoReflect.Descriptor instead.
+func (*CreateComponentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CreateComponentRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateComponentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateComponentRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateComponentRequest) GetLeadId() string {
+	if x != nil {
+		return x.LeadId
+	}
+	return ""
+}
+
+func (x *CreateComponentRequest) GetDefaultAssigneeId() string {
+	if x != nil {
+		return x.DefaultAssigneeId
+	}
+	return ""
+}
+
+type CreateComponentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Component     *Component             `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateComponentResponse) Reset() {
+	*x = CreateComponentResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateComponentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateComponentResponse) ProtoMessage() {}
+
+func (x *CreateComponentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateComponentResponse.ProtoReflect.Descriptor instead.
+func (*CreateComponentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CreateComponentResponse) GetComponent() *Component {
+	if x != nil {
+		return x.Component
+	}
+	return nil
+}
+
+type UpdateComponentRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description       *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	LeadId            *string                `protobuf:"bytes,4,opt,name=lead_id,json=leadId,proto3,oneof" json:"lead_id,omitempty"`
+	DefaultAssigneeId *string                `protobuf:"bytes,5,opt,name=default_assignee_id,json=defaultAssigneeId,proto3,oneof" json:"default_assignee_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateComponentRequest) Reset() {
+	*x = UpdateComponentRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateComponentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateComponentRequest) ProtoMessage() {}
+
+func (x *UpdateComponentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateComponentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateComponentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UpdateComponentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateComponentRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateComponentRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateComponentRequest) GetLeadId() string {
+	if x != nil && x.LeadId != nil {
+		return *x.LeadId
+	}
+	return ""
+}
+
+func (x *UpdateComponentRequest) GetDefaultAssigneeId() string {
+	if x != nil && x.DefaultAssigneeId != nil {
+		return *x.DefaultAssigneeId
+	}
+	return ""
+}
+
+type UpdateComponentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Component     *Component             `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateComponentResponse) Reset() {
+	*x = UpdateComponentResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateComponentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateComponentResponse) ProtoMessage() {}
+
+func (x *UpdateComponentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateComponentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateComponentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *UpdateComponentResponse) GetComponent() *Component {
+	if x != nil {
+		return x.Component
+	}
+	return nil
+}
+
+type DeleteComponentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteComponentRequest) Reset() {
+	*x = DeleteComponentRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteComponentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteComponentRequest) ProtoMessage() {}
+
+func (x *DeleteComponentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteComponentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteComponentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DeleteComponentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteComponentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *v1.SuccessResponse    `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteComponentResponse) Reset() {
+	*x = DeleteComponentResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteComponentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteComponentResponse) ProtoMessage() {}
+
+func (x *DeleteComponentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteComponentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteComponentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeleteComponentResponse) GetResponse() *v1.SuccessResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type ListComponentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListComponentsRequest) Reset() {
+	*x = ListComponentsRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListComponentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListComponentsRequest) ProtoMessage() {}
+
+func (x *ListComponentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListComponentsRequest.ProtoReflect.Descriptor instead.
+func (*ListComponentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListComponentsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type ListComponentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Components    []*Component           `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListComponentsResponse) Reset() {
+	*x = ListComponentsResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListComponentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListComponentsResponse) ProtoMessage() {}
+
+func (x *ListComponentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListComponentsResponse.ProtoReflect.Descriptor instead.
+func (*ListComponentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListComponentsResponse) GetComponents() []*Component {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+type BulkUpdateIssueComponentsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	IssueIds           []string               `protobuf:"bytes,1,rep,name=issue_ids,json=issueIds,proto3" json:"issue_ids,omitempty"` // Must belong to the same project
+	AddComponentIds    []string               `protobuf:"bytes,2,rep,name=add_component_ids,json=addComponentIds,proto3" json:"add_component_ids,omitempty"`
+	RemoveComponentIds []string               `protobuf:"bytes,3,rep,name=remove_component_ids,json=removeComponentIds,proto3" json:"remove_component_ids,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *BulkUpdateIssueComponentsRequest) Reset() {
+	*x = BulkUpdateIssueComponentsRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateIssueComponentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateIssueComponentsRequest) ProtoMessage() {}
+
+func (x *BulkUpdateIssueComponentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateIssueComponentsRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateIssueComponentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *BulkUpdateIssueComponentsRequest) GetIssueIds() []string {
+	if x != nil {
+		return x.IssueIds
+	}
+	return nil
+}
+
+func (x *BulkUpdateIssueComponentsRequest) GetAddComponentIds() []string {
+	if x != nil {
+		return x.AddComponentIds
+	}
+	return nil
+}
+
+func (x *BulkUpdateIssueComponentsRequest) GetRemoveComponentIds() []string {
+	if x != nil {
+		return x.RemoveComponentIds
+	}
+	return nil
+}
+
+type BulkUpdateIssueComponentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issues        []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateIssueComponentsResponse) Reset() {
+	*x = BulkUpdateIssueComponentsResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateIssueComponentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateIssueComponentsResponse) ProtoMessage() {}
+
+func (x *BulkUpdateIssueComponentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateIssueComponentsResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateIssueComponentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *BulkUpdateIssueComponentsResponse) GetIssues() []*Issue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type SetIssuesSprintRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueIds      []string               `protobuf:"bytes,1,rep,name=issue_ids,json=issueIds,proto3" json:"issue_ids,omitempty"`
+	SprintId      string                 `protobuf:"bytes,2,opt,name=sprint_id,json=sprintId,proto3" json:"sprint_id,omitempty"` // Empty moves the issues to the backlog
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetIssuesSprintRequest) Reset() {
+	*x = SetIssuesSprintRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIssuesSprintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIssuesSprintRequest) ProtoMessage() {}
+
+func (x *SetIssuesSprintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIssuesSprintRequest.ProtoReflect.Descriptor instead.
+func (*SetIssuesSprintRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SetIssuesSprintRequest) GetIssueIds() []string {
+	if x != nil {
+		return x.IssueIds
+	}
+	return nil
+}
+
+func (x *SetIssuesSprintRequest) GetSprintId() string {
+	if x != nil {
+		return x.SprintId
+	}
+	return ""
+}
+
+type SetIssuesSprintResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueIds      []string               `protobuf:"bytes,1,rep,name=issue_ids,json=issueIds,proto3" json:"issue_ids,omitempty"` // Issues whose sprint changed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetIssuesSprintResponse) Reset() {
+	*x = SetIssuesSprintResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIssuesSprintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIssuesSprintResponse) ProtoMessage() {}
+
+func (x *SetIssuesSprintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIssuesSprintResponse.ProtoReflect.Descriptor instead.
+func (*SetIssuesSprintResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SetIssuesSprintResponse) GetIssueIds() []string {
+	if x != nil {
+		return x.IssueIds
+	}
+	return nil
+}
+
+type RankIssueBeforeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	BeforeIssueId string                 `protobuf:"bytes,2,opt,name=before_issue_id,json=beforeIssueId,proto3" json:"before_issue_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RankIssueBeforeRequest) Reset() {
+	*x = RankIssueBeforeRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RankIssueBeforeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankIssueBeforeRequest) ProtoMessage() {}
+
+func (x *RankIssueBeforeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankIssueBeforeRequest.ProtoReflect.Descriptor instead.
+func (*RankIssueBeforeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *RankIssueBeforeRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *RankIssueBeforeRequest) GetBeforeIssueId() string {
+	if x != nil {
+		return x.BeforeIssueId
+	}
+	return ""
+}
+
+func (x *RankIssueBeforeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RankIssueBeforeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RankIssueBeforeResponse) Reset() {
+	*x = RankIssueBeforeResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RankIssueBeforeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankIssueBeforeResponse) ProtoMessage() {}
+
+func (x *RankIssueBeforeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankIssueBeforeResponse.ProtoReflect.Descriptor instead.
+func (*RankIssueBeforeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *RankIssueBeforeResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type RankIssueAfterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	AfterIssueId  string                 `protobuf:"bytes,2,opt,name=after_issue_id,json=afterIssueId,proto3" json:"after_issue_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RankIssueAfterRequest) Reset() {
+	*x = RankIssueAfterRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RankIssueAfterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankIssueAfterRequest) ProtoMessage() {}
+
+func (x *RankIssueAfterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankIssueAfterRequest.ProtoReflect.Descriptor instead.
+func (*RankIssueAfterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *RankIssueAfterRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *RankIssueAfterRequest) GetAfterIssueId() string {
+	if x != nil {
+		return x.AfterIssueId
+	}
+	return ""
+}
+
+func (x *RankIssueAfterRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RankIssueAfterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RankIssueAfterResponse) Reset() {
+	*x = RankIssueAfterResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RankIssueAfterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankIssueAfterResponse) ProtoMessage() {}
+
+func (x *RankIssueAfterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankIssueAfterResponse.ProtoReflect.Descriptor instead.
+func (*RankIssueAfterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *RankIssueAfterResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+// Moves issues, in the given order, right before or right after an issue of
+// the same project. Exactly one of before_issue_id and after_issue_id is set.
+type RankIssuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueIds      []string               `protobuf:"bytes,1,rep,name=issue_ids,json=issueIds,proto3" json:"issue_ids,omitempty"`
+	BeforeIssueId string                 `protobuf:"bytes,2,opt,name=before_issue_id,json=beforeIssueId,proto3" json:"before_issue_id,omitempty"`
+	AfterIssueId  string                 `protobuf:"bytes,3,opt,name=after_issue_id,json=afterIssueId,proto3" json:"after_issue_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RankIssuesRequest) Reset() {
+	*x = RankIssuesRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RankIssuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankIssuesRequest) ProtoMessage() {}
+
+func (x *RankIssuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return IssuePriority_ISSUE_PRIORITY_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *CreateIssueRequest) GetAssigneeId() string {
+// Deprecated: Use RankIssuesRequest.ProtoReflect.Descriptor instead.
+func (*RankIssuesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *RankIssuesRequest) GetIssueIds() []string {
 	if x != nil {
-		return x.AssigneeId
+		return x.IssueIds
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateIssueRequest) GetParentId() string {
+func (x *RankIssuesRequest) GetBeforeIssueId() string {
 	if x != nil {
-		return x.ParentId
+		return x.BeforeIssueId
 	}
 	return ""
 }
 
-func (x *CreateIssueRequest) GetLabelIds() []string {
+func (x *RankIssuesRequest) GetAfterIssueId() string {
 	if x != nil {
-		return x.LabelIds
+		return x.AfterIssueId
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateIssueRequest) GetCustomFields() []*CustomFieldValue {
+func (x *RankIssuesRequest) GetUserId() string {
 	if x != nil {
-		return x.CustomFields
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type CreateIssueResponse struct {
+type RankIssuesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	Issues        []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateIssueResponse) Reset() {
-	*x = CreateIssueResponse{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[5]
+func (x *RankIssuesResponse) Reset() {
+	*x = RankIssuesResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateIssueResponse) String() string {
+func (x *RankIssuesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateIssueResponse) ProtoMessage() {}
+func (*RankIssuesResponse) ProtoMessage() {}
 
-func (x *CreateIssueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[5]
+func (x *RankIssuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -844,40 +6191,51 @@ func (x *CreateIssueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateIssueResponse.ProtoReflect.Descriptor instead.
-func (*CreateIssueResponse) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use RankIssuesResponse.ProtoReflect.Descriptor instead.
+func (*RankIssuesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *CreateIssueResponse) GetIssue() *Issue {
+func (x *RankIssuesResponse) GetIssues() []*Issue {
 	if x != nil {
-		return x.Issue
+		return x.Issues
 	}
 	return nil
 }
 
-type GetIssueRequest struct {
+// Applies the same changes to many issues in the background. Issues are
+// selected by issue_ids, or by filter when issue_ids is empty (the filter
+// pagination is ignored). Unset fields are left untouched; an empty
+// assignee_id or sprint_id clears it. delete cannot be combined with changes.
+type BulkUpdateIssuesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IssueIds      []string               `protobuf:"bytes,1,rep,name=issue_ids,json=issueIds,proto3" json:"issue_ids,omitempty"`
+	Filter        *ListIssuesRequest     `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	AssigneeId    *string                `protobuf:"bytes,3,opt,name=assignee_id,json=assigneeId,proto3,oneof" json:"assignee_id,omitempty"`
+	Priority      *IssuePriority         `protobuf:"varint,4,opt,name=priority,proto3,enum=nexusflow.issue.v1.IssuePriority,oneof" json:"priority,omitempty"`
+	StatusId      *string                `protobuf:"bytes,5,opt,name=status_id,json=statusId,proto3,oneof" json:"status_id,omitempty"` // Transition to this status
+	SprintId      *string                `protobuf:"bytes,6,opt,name=sprint_id,json=sprintId,proto3,oneof" json:"sprint_id,omitempty"` // Moved through the sprint service
+	Delete        bool                   `protobuf:"varint,7,opt,name=delete,proto3" json:"delete,omitempty"`
+	UserId        string                 `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetIssueRequest) Reset() {
-	*x = GetIssueRequest{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[6]
+func (x *BulkUpdateIssuesRequest) Reset() {
+	*x = BulkUpdateIssuesRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIssueRequest) String() string {
+func (x *BulkUpdateIssuesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIssueRequest) ProtoMessage() {}
+func (*BulkUpdateIssuesRequest) ProtoMessage() {}
 
-func (x *GetIssueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[6]
+func (x *BulkUpdateIssuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -888,40 +6246,89 @@ func (x *GetIssueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIssueRequest.ProtoReflect.Descriptor instead.
-func (*GetIssueRequest) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use BulkUpdateIssuesRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateIssuesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *GetIssueRequest) GetId() string {
+func (x *BulkUpdateIssuesRequest) GetIssueIds() []string {
 	if x != nil {
-		return x.Id
+		return x.IssueIds
+	}
+	return nil
+}
+
+func (x *BulkUpdateIssuesRequest) GetFilter() *ListIssuesRequest {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *BulkUpdateIssuesRequest) GetAssigneeId() string {
+	if x != nil && x.AssigneeId != nil {
+		return *x.AssigneeId
 	}
 	return ""
 }
 
-type GetIssueResponse struct {
+func (x *BulkUpdateIssuesRequest) GetPriority() IssuePriority {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return IssuePriority_ISSUE_PRIORITY_UNSPECIFIED
+}
+
+func (x *BulkUpdateIssuesRequest) GetStatusId() string {
+	if x != nil && x.StatusId != nil {
+		return *x.StatusId
+	}
+	return ""
+}
+
+func (x *BulkUpdateIssuesRequest) GetSprintId() string {
+	if x != nil && x.SprintId != nil {
+		return *x.SprintId
+	}
+	return ""
+}
+
+func (x *BulkUpdateIssuesRequest) GetDelete() bool {
+	if x != nil {
+		return x.Delete
+	}
+	return false
+}
+
+func (x *BulkUpdateIssuesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type BulkUpdateIssuesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"` // Poll GetJob for progress
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetIssueResponse) Reset() {
-	*x = GetIssueResponse{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[7]
+func (x *BulkUpdateIssuesResponse) Reset() {
+	*x = BulkUpdateIssuesResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIssueResponse) String() string {
+func (x *BulkUpdateIssuesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIssueResponse) ProtoMessage() {}
+func (*BulkUpdateIssuesResponse) ProtoMessage() {}
 
-func (x *GetIssueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[7]
+func (x *BulkUpdateIssuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -932,40 +6339,40 @@ func (x *GetIssueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIssueResponse.ProtoReflect.Descriptor instead.
-func (*GetIssueResponse) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use BulkUpdateIssuesResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateIssuesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *GetIssueResponse) GetIssue() *Issue {
+func (x *BulkUpdateIssuesResponse) GetJob() *Job {
 	if x != nil {
-		return x.Issue
+		return x.Job
 	}
 	return nil
 }
 
-type GetIssueByKeyRequest struct {
+type GetJobRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetIssueByKeyRequest) Reset() {
-	*x = GetIssueByKeyRequest{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[8]
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIssueByKeyRequest) String() string {
+func (x *GetJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIssueByKeyRequest) ProtoMessage() {}
+func (*GetJobRequest) ProtoMessage() {}
 
-func (x *GetIssueByKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[8]
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -976,40 +6383,40 @@ func (x *GetIssueByKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIssueByKeyRequest.ProtoReflect.Descriptor instead.
-func (*GetIssueByKeyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *GetIssueByKeyRequest) GetKey() string {
+func (x *GetJobRequest) GetId() string {
 	if x != nil {
-		return x.Key
+		return x.Id
 	}
 	return ""
 }
 
-type GetIssueByKeyResponse struct {
+type GetJobResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetIssueByKeyResponse) Reset() {
-	*x = GetIssueByKeyResponse{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[9]
+func (x *GetJobResponse) Reset() {
+	*x = GetJobResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIssueByKeyResponse) String() string {
+func (x *GetJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIssueByKeyResponse) ProtoMessage() {}
+func (*GetJobResponse) ProtoMessage() {}
 
-func (x *GetIssueByKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[9]
+func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1020,48 +6427,44 @@ func (x *GetIssueByKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIssueByKeyResponse.ProtoReflect.Descriptor instead.
-func (*GetIssueByKeyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
+func (*GetJobResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *GetIssueByKeyResponse) GetIssue() *Issue {
+func (x *GetJobResponse) GetJob() *Job {
 	if x != nil {
-		return x.Issue
+		return x.Job
 	}
 	return nil
 }
 
-type UpdateIssueRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Summary       *string                `protobuf:"bytes,2,opt,name=summary,proto3,oneof" json:"summary,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Priority      *IssuePriority         `protobuf:"varint,4,opt,name=priority,proto3,enum=nexusflow.issue.v1.IssuePriority,oneof" json:"priority,omitempty"`
-	StatusId      *string                `protobuf:"bytes,5,opt,name=status_id,json=statusId,proto3,oneof" json:"status_id,omitempty"`
-	AssigneeId    *string                `protobuf:"bytes,6,opt,name=assignee_id,json=assigneeId,proto3,oneof" json:"assignee_id,omitempty"`
-	LabelIds      []string               `protobuf:"bytes,7,rep,name=label_ids,json=labelIds,proto3" json:"label_ids,omitempty"`
-	CustomFields  []*CustomFieldValue    `protobuf:"bytes,8,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
-	StoryPoints   *int32                 `protobuf:"varint,9,opt,name=story_points,json=storyPoints,proto3,oneof" json:"story_points,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AddWorklogRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IssueId         string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"` // Defaults to now
+	DurationSeconds int64                  `protobuf:"varint,4,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Comment         string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *UpdateIssueRequest) Reset() {
-	*x = UpdateIssueRequest{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[10]
+func (x *AddWorklogRequest) Reset() {
+	*x = AddWorklogRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateIssueRequest) String() string {
+func (x *AddWorklogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateIssueRequest) ProtoMessage() {}
+func (*AddWorklogRequest) ProtoMessage() {}
 
-func (x *UpdateIssueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[10]
+func (x *AddWorklogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1072,96 +6475,189 @@ func (x *UpdateIssueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateIssueRequest.ProtoReflect.Descriptor instead.
-func (*UpdateIssueRequest) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use AddWorklogRequest.ProtoReflect.Descriptor instead.
+func (*AddWorklogRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *UpdateIssueRequest) GetId() string {
+func (x *AddWorklogRequest) GetIssueId() string {
 	if x != nil {
-		return x.Id
+		return x.IssueId
 	}
 	return ""
 }
 
-func (x *UpdateIssueRequest) GetSummary() string {
-	if x != nil && x.Summary != nil {
-		return *x.Summary
+func (x *AddWorklogRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdateIssueRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *AddWorklogRequest) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateIssueRequest) GetPriority() IssuePriority {
-	if x != nil && x.Priority != nil {
-		return *x.Priority
+func (x *AddWorklogRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
 	}
-	return IssuePriority_ISSUE_PRIORITY_UNSPECIFIED
+	return 0
 }
 
-func (x *UpdateIssueRequest) GetStatusId() string {
-	if x != nil && x.StatusId != nil {
-		return *x.StatusId
+func (x *AddWorklogRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
 	}
 	return ""
 }
 
-func (x *UpdateIssueRequest) GetAssigneeId() string {
-	if x != nil && x.AssigneeId != nil {
-		return *x.AssigneeId
+type AddWorklogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Worklog       *Worklog               `protobuf:"bytes,1,opt,name=worklog,proto3" json:"worklog,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWorklogResponse) Reset() {
+	*x = AddWorklogResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWorklogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWorklogResponse) ProtoMessage() {}
+
+func (x *AddWorklogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWorklogResponse.ProtoReflect.Descriptor instead.
+func (*AddWorklogResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *AddWorklogResponse) GetWorklog() *Worklog {
+	if x != nil {
+		return x.Worklog
+	}
+	return nil
+}
+
+// Only the author of a worklog can change it
+type UpdateWorklogRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	DurationSeconds *int64                 `protobuf:"varint,4,opt,name=duration_seconds,json=durationSeconds,proto3,oneof" json:"duration_seconds,omitempty"`
+	Comment         *string                `protobuf:"bytes,5,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateWorklogRequest) Reset() {
+	*x = UpdateWorklogRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorklogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorklogRequest) ProtoMessage() {}
+
+func (x *UpdateWorklogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorklogRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorklogRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *UpdateWorklogRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateIssueRequest) GetLabelIds() []string {
+func (x *UpdateWorklogRequest) GetUserId() string {
 	if x != nil {
-		return x.LabelIds
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateIssueRequest) GetCustomFields() []*CustomFieldValue {
+func (x *UpdateWorklogRequest) GetStartedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CustomFields
+		return x.StartedAt
 	}
 	return nil
 }
 
-func (x *UpdateIssueRequest) GetStoryPoints() int32 {
-	if x != nil && x.StoryPoints != nil {
-		return *x.StoryPoints
+func (x *UpdateWorklogRequest) GetDurationSeconds() int64 {
+	if x != nil && x.DurationSeconds != nil {
+		return *x.DurationSeconds
 	}
 	return 0
 }
 
-type UpdateIssueResponse struct {
+func (x *UpdateWorklogRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+type UpdateWorklogResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	Worklog       *Worklog               `protobuf:"bytes,1,opt,name=worklog,proto3" json:"worklog,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateIssueResponse) Reset() {
-	*x = UpdateIssueResponse{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[11]
+func (x *UpdateWorklogResponse) Reset() {
+	*x = UpdateWorklogResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateIssueResponse) String() string {
+func (x *UpdateWorklogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateIssueResponse) ProtoMessage() {}
+func (*UpdateWorklogResponse) ProtoMessage() {}
 
-func (x *UpdateIssueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[11]
+func (x *UpdateWorklogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1172,40 +6668,41 @@ func (x *UpdateIssueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateIssueResponse.ProtoReflect.Descriptor instead.
-func (*UpdateIssueResponse) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use UpdateWorklogResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWorklogResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *UpdateIssueResponse) GetIssue() *Issue {
+func (x *UpdateWorklogResponse) GetWorklog() *Worklog {
 	if x != nil {
-		return x.Issue
+		return x.Worklog
 	}
 	return nil
 }
 
-type DeleteIssueRequest struct {
+type DeleteWorklogRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteIssueRequest) Reset() {
-	*x = DeleteIssueRequest{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[12]
+func (x *DeleteWorklogRequest) Reset() {
+	*x = DeleteWorklogRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteIssueRequest) String() string {
+func (x *DeleteWorklogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteIssueRequest) ProtoMessage() {}
+func (*DeleteWorklogRequest) ProtoMessage() {}
 
-func (x *DeleteIssueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[12]
+func (x *DeleteWorklogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1216,40 +6713,46 @@ func (x *DeleteIssueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteIssueRequest.ProtoReflect.Descriptor instead.
-func (*DeleteIssueRequest) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use DeleteWorklogRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWorklogRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *DeleteIssueRequest) GetId() string {
+func (x *DeleteWorklogRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteIssueResponse struct {
+func (x *DeleteWorklogRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteWorklogResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Response      *v1.SuccessResponse    `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteIssueResponse) Reset() {
-	*x = DeleteIssueResponse{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[13]
+func (x *DeleteWorklogResponse) Reset() {
+	*x = DeleteWorklogResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteIssueResponse) String() string {
+func (x *DeleteWorklogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteIssueResponse) ProtoMessage() {}
+func (*DeleteWorklogResponse) ProtoMessage() {}
 
-func (x *DeleteIssueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[13]
+func (x *DeleteWorklogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1260,45 +6763,33 @@ func (x *DeleteIssueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteIssueResponse.ProtoReflect.Descriptor instead.
-func (*DeleteIssueResponse) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *DeleteIssueResponse) GetResponse() *v1.SuccessResponse {
-	if x != nil {
-		return x.Response
-	}
-	return nil
+// Deprecated: Use DeleteWorklogResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWorklogResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{101}
 }
 
-type ListIssuesRequest struct {
+type ListWorklogsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Pagination    *v1.PaginationRequest  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Type          IssueType              `protobuf:"varint,3,opt,name=type,proto3,enum=nexusflow.issue.v1.IssueType" json:"type,omitempty"`
-	AssigneeId    string                 `protobuf:"bytes,4,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
-	SprintId      string                 `protobuf:"bytes,5,opt,name=sprint_id,json=sprintId,proto3" json:"sprint_id,omitempty"`
-	StatusIds     []string               `protobuf:"bytes,6,rep,name=status_ids,json=statusIds,proto3" json:"status_ids,omitempty"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListIssuesRequest) Reset() {
-	*x = ListIssuesRequest{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[14]
+func (x *ListWorklogsRequest) Reset() {
+	*x = ListWorklogsRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIssuesRequest) String() string {
+func (x *ListWorklogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIssuesRequest) ProtoMessage() {}
+func (*ListWorklogsRequest) ProtoMessage() {}
 
-func (x *ListIssuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[14]
+func (x *ListWorklogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1309,76 +6800,40 @@ func (x *ListIssuesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIssuesRequest.ProtoReflect.Descriptor instead.
-func (*ListIssuesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *ListIssuesRequest) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
-}
-
-func (x *ListIssuesRequest) GetPagination() *v1.PaginationRequest {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
-
-func (x *ListIssuesRequest) GetType() IssueType {
-	if x != nil {
-		return x.Type
-	}
-	return IssueType_ISSUE_TYPE_UNSPECIFIED
-}
-
-func (x *ListIssuesRequest) GetAssigneeId() string {
-	if x != nil {
-		return x.AssigneeId
-	}
-	return ""
+// Deprecated: Use ListWorklogsRequest.ProtoReflect.Descriptor instead.
+func (*ListWorklogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *ListIssuesRequest) GetSprintId() string {
+func (x *ListWorklogsRequest) GetIssueId() string {
 	if x != nil {
-		return x.SprintId
+		return x.IssueId
 	}
 	return ""
 }
 
-func (x *ListIssuesRequest) GetStatusIds() []string {
-	if x != nil {
-		return x.StatusIds
-	}
-	return nil
-}
-
-type ListIssuesResponse struct {
+type ListWorklogsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Issues        []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
-	Pagination    *v1.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Worklogs      []*Worklog             `protobuf:"bytes,1,rep,name=worklogs,proto3" json:"worklogs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListIssuesResponse) Reset() {
-	*x = ListIssuesResponse{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[15]
+func (x *ListWorklogsResponse) Reset() {
+	*x = ListWorklogsResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIssuesResponse) String() string {
+func (x *ListWorklogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIssuesResponse) ProtoMessage() {}
+func (*ListWorklogsResponse) ProtoMessage() {}
 
-func (x *ListIssuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[15]
+func (x *ListWorklogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1389,49 +6844,40 @@ func (x *ListIssuesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIssuesResponse.ProtoReflect.Descriptor instead.
-func (*ListIssuesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *ListIssuesResponse) GetIssues() []*Issue {
-	if x != nil {
-		return x.Issues
-	}
-	return nil
+// Deprecated: Use ListWorklogsResponse.ProtoReflect.Descriptor instead.
+func (*ListWorklogsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *ListIssuesResponse) GetPagination() *v1.PaginationResponse {
+func (x *ListWorklogsResponse) GetWorklogs() []*Worklog {
 	if x != nil {
-		return x.Pagination
+		return x.Worklogs
 	}
 	return nil
 }
 
-type SearchIssuesRequest struct {
+type GetTimeTrackingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"` // JQL-like query
-	Pagination    *v1.PaginationRequest  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	ProjectIds    []string               `protobuf:"bytes,3,rep,name=project_ids,json=projectIds,proto3" json:"project_ids,omitempty"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchIssuesRequest) Reset() {
-	*x = SearchIssuesRequest{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[16]
+func (x *GetTimeTrackingRequest) Reset() {
+	*x = GetTimeTrackingRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchIssuesRequest) String() string {
+func (x *GetTimeTrackingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchIssuesRequest) ProtoMessage() {}
+func (*GetTimeTrackingRequest) ProtoMessage() {}
 
-func (x *SearchIssuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[16]
+func (x *GetTimeTrackingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1442,55 +6888,43 @@ func (x *SearchIssuesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchIssuesRequest.ProtoReflect.Descriptor instead.
-func (*SearchIssuesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetTimeTrackingRequest.ProtoReflect.Descriptor instead.
+func (*GetTimeTrackingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *SearchIssuesRequest) GetQuery() string {
+func (x *GetTimeTrackingRequest) GetIssueId() string {
 	if x != nil {
-		return x.Query
+		return x.IssueId
 	}
 	return ""
 }
 
-func (x *SearchIssuesRequest) GetPagination() *v1.PaginationRequest {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
-
-func (x *SearchIssuesRequest) GetProjectIds() []string {
-	if x != nil {
-		return x.ProjectIds
-	}
-	return nil
-}
-
-type SearchIssuesResponse struct {
+// issue holds the issue's own values; rollup adds those of its sub-tasks,
+// or of the stories and sub-tasks of an epic
+type GetTimeTrackingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Issues        []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
-	Pagination    *v1.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Issue         *TimeTracking          `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	Rollup        *TimeTracking          `protobuf:"bytes,2,opt,name=rollup,proto3" json:"rollup,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchIssuesResponse) Reset() {
-	*x = SearchIssuesResponse{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[17]
+func (x *GetTimeTrackingResponse) Reset() {
+	*x = GetTimeTrackingResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchIssuesResponse) String() string {
+func (x *GetTimeTrackingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchIssuesResponse) ProtoMessage() {}
+func (*GetTimeTrackingResponse) ProtoMessage() {}
 
-func (x *SearchIssuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[17]
+func (x *GetTimeTrackingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1501,47 +6935,52 @@ func (x *SearchIssuesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchIssuesResponse.ProtoReflect.Descriptor instead.
-func (*SearchIssuesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetTimeTrackingResponse.ProtoReflect.Descriptor instead.
+func (*GetTimeTrackingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *SearchIssuesResponse) GetIssues() []*Issue {
+func (x *GetTimeTrackingResponse) GetIssue() *TimeTracking {
 	if x != nil {
-		return x.Issues
+		return x.Issue
 	}
 	return nil
 }
 
-func (x *SearchIssuesResponse) GetPagination() *v1.PaginationResponse {
+func (x *GetTimeTrackingResponse) GetRollup() *TimeTracking {
 	if x != nil {
-		return x.Pagination
+		return x.Rollup
 	}
 	return nil
 }
 
-type GetIssueChildrenRequest struct {
+// Lists work started in [from, to) by a user, in a project, or both. The
+// range can span at most a year.
+type GetTimesheetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetIssueChildrenRequest) Reset() {
-	*x = GetIssueChildrenRequest{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[18]
+func (x *GetTimesheetRequest) Reset() {
+	*x = GetTimesheetRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIssueChildrenRequest) String() string {
+func (x *GetTimesheetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIssueChildrenRequest) ProtoMessage() {}
+func (*GetTimesheetRequest) ProtoMessage() {}
 
-func (x *GetIssueChildrenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[18]
+func (x *GetTimesheetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1552,85 +6991,62 @@ func (x *GetIssueChildrenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIssueChildrenRequest.ProtoReflect.Descriptor instead.
-func (*GetIssueChildrenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetTimesheetRequest.ProtoReflect.Descriptor instead.
+func (*GetTimesheetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *GetIssueChildrenRequest) GetId() string {
+func (x *GetTimesheetRequest) GetUserId() string {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return ""
 }
 
-type GetIssueChildrenResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Children      []*Issue               `protobuf:"bytes,1,rep,name=children,proto3" json:"children,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetIssueChildrenResponse) Reset() {
-	*x = GetIssueChildrenResponse{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetIssueChildrenResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetIssueChildrenResponse) ProtoMessage() {}
-
-func (x *GetIssueChildrenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[19]
+func (x *GetTimesheetRequest) GetProjectId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ProjectId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetIssueChildrenResponse.ProtoReflect.Descriptor instead.
-func (*GetIssueChildrenResponse) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{19}
+func (x *GetTimesheetRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
 }
 
-func (x *GetIssueChildrenResponse) GetChildren() []*Issue {
+func (x *GetTimesheetRequest) GetTo() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Children
+		return x.To
 	}
 	return nil
 }
-
-type MoveIssueRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TargetProjectId string                 `protobuf:"bytes,2,opt,name=target_project_id,json=targetProjectId,proto3" json:"target_project_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+
+type TimesheetTotal struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // User or issue ID
+	TimeSpentSeconds int64                  `protobuf:"varint,2,opt,name=time_spent_seconds,json=timeSpentSeconds,proto3" json:"time_spent_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *MoveIssueRequest) Reset() {
-	*x = MoveIssueRequest{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[20]
+func (x *TimesheetTotal) Reset() {
+	*x = TimesheetTotal{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MoveIssueRequest) String() string {
+func (x *TimesheetTotal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveIssueRequest) ProtoMessage() {}
+func (*TimesheetTotal) ProtoMessage() {}
 
-func (x *MoveIssueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[20]
+func (x *TimesheetTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1641,47 +7057,50 @@ func (x *MoveIssueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveIssueRequest.ProtoReflect.Descriptor instead.
-func (*MoveIssueRequest) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use TimesheetTotal.ProtoReflect.Descriptor instead.
+func (*TimesheetTotal) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *MoveIssueRequest) GetId() string {
+func (x *TimesheetTotal) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *MoveIssueRequest) GetTargetProjectId() string {
+func (x *TimesheetTotal) GetTimeSpentSeconds() int64 {
 	if x != nil {
-		return x.TargetProjectId
+		return x.TimeSpentSeconds
 	}
-	return ""
+	return 0
 }
 
-type MoveIssueResponse struct {
+type GetTimesheetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	Worklogs      []*Worklog             `protobuf:"bytes,1,rep,name=worklogs,proto3" json:"worklogs,omitempty"`
+	TotalSeconds  int64                  `protobuf:"varint,2,opt,name=total_seconds,json=totalSeconds,proto3" json:"total_seconds,omitempty"`
+	ByUser        []*TimesheetTotal      `protobuf:"bytes,3,rep,name=by_user,json=byUser,proto3" json:"by_user,omitempty"`
+	ByIssue       []*TimesheetTotal      `protobuf:"bytes,4,rep,name=by_issue,json=byIssue,proto3" json:"by_issue,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MoveIssueResponse) Reset() {
-	*x = MoveIssueResponse{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[21]
+func (x *GetTimesheetResponse) Reset() {
+	*x = GetTimesheetResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MoveIssueResponse) String() string {
+func (x *GetTimesheetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveIssueResponse) ProtoMessage() {}
+func (*GetTimesheetResponse) ProtoMessage() {}
 
-func (x *MoveIssueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[21]
+func (x *GetTimesheetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1692,42 +7111,62 @@ func (x *MoveIssueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveIssueResponse.ProtoReflect.Descriptor instead.
-func (*MoveIssueResponse) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetTimesheetResponse.ProtoReflect.Descriptor instead.
+func (*GetTimesheetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *MoveIssueResponse) GetIssue() *Issue {
+func (x *GetTimesheetResponse) GetWorklogs() []*Worklog {
 	if x != nil {
-		return x.Issue
+		return x.Worklogs
 	}
 	return nil
 }
 
-type CreateIssueLinkRequest struct {
+func (x *GetTimesheetResponse) GetTotalSeconds() int64 {
+	if x != nil {
+		return x.TotalSeconds
+	}
+	return 0
+}
+
+func (x *GetTimesheetResponse) GetByUser() []*TimesheetTotal {
+	if x != nil {
+		return x.ByUser
+	}
+	return nil
+}
+
+func (x *GetTimesheetResponse) GetByIssue() []*TimesheetTotal {
+	if x != nil {
+		return x.ByIssue
+	}
+	return nil
+}
+
+type CreateIssueScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SourceIssueId string                 `protobuf:"bytes,1,opt,name=source_issue_id,json=sourceIssueId,proto3" json:"source_issue_id,omitempty"`
-	TargetIssueId string                 `protobuf:"bytes,2,opt,name=target_issue_id,json=targetIssueId,proto3" json:"target_issue_id,omitempty"`
-	Type          IssueLinkType          `protobuf:"varint,3,opt,name=type,proto3,enum=nexusflow.issue.v1.IssueLinkType" json:"type,omitempty"`
+	Schedule      *IssueSchedule         `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"` // Output-only fields are ignored
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateIssueLinkRequest) Reset() {
-	*x = CreateIssueLinkRequest{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[22]
+func (x *CreateIssueScheduleRequest) Reset() {
+	*x = CreateIssueScheduleRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateIssueLinkRequest) String() string {
+func (x *CreateIssueScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateIssueLinkRequest) ProtoMessage() {}
+func (*CreateIssueScheduleRequest) ProtoMessage() {}
 
-func (x *CreateIssueLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[22]
+func (x *CreateIssueScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1738,54 +7177,47 @@ func (x *CreateIssueLinkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateIssueLinkRequest.ProtoReflect.Descriptor instead.
-func (*CreateIssueLinkRequest) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use CreateIssueScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CreateIssueScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *CreateIssueLinkRequest) GetSourceIssueId() string {
+func (x *CreateIssueScheduleRequest) GetSchedule() *IssueSchedule {
 	if x != nil {
-		return x.SourceIssueId
+		return x.Schedule
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateIssueLinkRequest) GetTargetIssueId() string {
+func (x *CreateIssueScheduleRequest) GetUserId() string {
 	if x != nil {
-		return x.TargetIssueId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *CreateIssueLinkRequest) GetType() IssueLinkType {
-	if x != nil {
-		return x.Type
-	}
-	return IssueLinkType_ISSUE_LINK_TYPE_UNSPECIFIED
-}
-
-type CreateIssueLinkResponse struct {
+type CreateIssueScheduleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Link          *IssueLink             `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	Schedule      *IssueSchedule         `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateIssueLinkResponse) Reset() {
-	*x = CreateIssueLinkResponse{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[23]
+func (x *CreateIssueScheduleResponse) Reset() {
+	*x = CreateIssueScheduleResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateIssueLinkResponse) String() string {
+func (x *CreateIssueScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateIssueLinkResponse) ProtoMessage() {}
+func (*CreateIssueScheduleResponse) ProtoMessage() {}
 
-func (x *CreateIssueLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[23]
+func (x *CreateIssueScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1796,40 +7228,40 @@ func (x *CreateIssueLinkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateIssueLinkResponse.ProtoReflect.Descriptor instead.
-func (*CreateIssueLinkResponse) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use CreateIssueScheduleResponse.ProtoReflect.Descriptor instead.
+func (*CreateIssueScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *CreateIssueLinkResponse) GetLink() *IssueLink {
+func (x *CreateIssueScheduleResponse) GetSchedule() *IssueSchedule {
 	if x != nil {
-		return x.Link
+		return x.Schedule
 	}
 	return nil
 }
 
-type DeleteIssueLinkRequest struct {
+type GetIssueScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteIssueLinkRequest) Reset() {
-	*x = DeleteIssueLinkRequest{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[24]
+func (x *GetIssueScheduleRequest) Reset() {
+	*x = GetIssueScheduleRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteIssueLinkRequest) String() string {
+func (x *GetIssueScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteIssueLinkRequest) ProtoMessage() {}
+func (*GetIssueScheduleRequest) ProtoMessage() {}
 
-func (x *DeleteIssueLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[24]
+func (x *GetIssueScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1840,40 +7272,40 @@ func (x *DeleteIssueLinkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteIssueLinkRequest.ProtoReflect.Descriptor instead.
-func (*DeleteIssueLinkRequest) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GetIssueScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetIssueScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *DeleteIssueLinkRequest) GetId() string {
+func (x *GetIssueScheduleRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteIssueLinkResponse struct {
+type GetIssueScheduleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Response      *v1.SuccessResponse    `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Schedule      *IssueSchedule         `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteIssueLinkResponse) Reset() {
-	*x = DeleteIssueLinkResponse{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[25]
+func (x *GetIssueScheduleResponse) Reset() {
+	*x = GetIssueScheduleResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteIssueLinkResponse) String() string {
+func (x *GetIssueScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteIssueLinkResponse) ProtoMessage() {}
+func (*GetIssueScheduleResponse) ProtoMessage() {}
 
-func (x *DeleteIssueLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[25]
+func (x *GetIssueScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1884,40 +7316,43 @@ func (x *DeleteIssueLinkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteIssueLinkResponse.ProtoReflect.Descriptor instead.
-func (*DeleteIssueLinkResponse) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GetIssueScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetIssueScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *DeleteIssueLinkResponse) GetResponse() *v1.SuccessResponse {
+func (x *GetIssueScheduleResponse) GetSchedule() *IssueSchedule {
 	if x != nil {
-		return x.Response
+		return x.Schedule
 	}
 	return nil
 }
 
-type GetIssueLinksRequest struct {
+// Replaces the blueprint and recurrence of a schedule. The next run is
+// recomputed from now; project_id cannot be changed.
+type UpdateIssueScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Schedule      *IssueSchedule         `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetIssueLinksRequest) Reset() {
-	*x = GetIssueLinksRequest{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[26]
+func (x *UpdateIssueScheduleRequest) Reset() {
+	*x = UpdateIssueScheduleRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIssueLinksRequest) String() string {
+func (x *UpdateIssueScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIssueLinksRequest) ProtoMessage() {}
+func (*UpdateIssueScheduleRequest) ProtoMessage() {}
 
-func (x *GetIssueLinksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[26]
+func (x *UpdateIssueScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1928,40 +7363,47 @@ func (x *GetIssueLinksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIssueLinksRequest.ProtoReflect.Descriptor instead.
-func (*GetIssueLinksRequest) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use UpdateIssueScheduleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateIssueScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *GetIssueLinksRequest) GetIssueId() string {
+func (x *UpdateIssueScheduleRequest) GetId() string {
 	if x != nil {
-		return x.IssueId
+		return x.Id
 	}
 	return ""
 }
 
-type GetIssueLinksResponse struct {
+func (x *UpdateIssueScheduleRequest) GetSchedule() *IssueSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type UpdateIssueScheduleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Links         []*IssueLink           `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	Schedule      *IssueSchedule         `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetIssueLinksResponse) Reset() {
-	*x = GetIssueLinksResponse{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[27]
+func (x *UpdateIssueScheduleResponse) Reset() {
+	*x = UpdateIssueScheduleResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIssueLinksResponse) String() string {
+func (x *UpdateIssueScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIssueLinksResponse) ProtoMessage() {}
+func (*UpdateIssueScheduleResponse) ProtoMessage() {}
 
-func (x *GetIssueLinksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[27]
+func (x *UpdateIssueScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1972,41 +7414,40 @@ func (x *GetIssueLinksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIssueLinksResponse.ProtoReflect.Descriptor instead.
-func (*GetIssueLinksResponse) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use UpdateIssueScheduleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateIssueScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *GetIssueLinksResponse) GetLinks() []*IssueLink {
+func (x *UpdateIssueScheduleResponse) GetSchedule() *IssueSchedule {
 	if x != nil {
-		return x.Links
+		return x.Schedule
 	}
 	return nil
 }
 
-type AddWatcherRequest struct {
+type DeleteIssueScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddWatcherRequest) Reset() {
-	*x = AddWatcherRequest{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[28]
+func (x *DeleteIssueScheduleRequest) Reset() {
+	*x = DeleteIssueScheduleRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddWatcherRequest) String() string {
+func (x *DeleteIssueScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddWatcherRequest) ProtoMessage() {}
+func (*DeleteIssueScheduleRequest) ProtoMessage() {}
 
-func (x *AddWatcherRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[28]
+func (x *DeleteIssueScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2017,47 +7458,76 @@ func (x *AddWatcherRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddWatcherRequest.ProtoReflect.Descriptor instead.
-func (*AddWatcherRequest) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use DeleteIssueScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteIssueScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *AddWatcherRequest) GetIssueId() string {
+func (x *DeleteIssueScheduleRequest) GetId() string {
 	if x != nil {
-		return x.IssueId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AddWatcherRequest) GetUserId() string {
+type DeleteIssueScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIssueScheduleResponse) Reset() {
+	*x = DeleteIssueScheduleResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIssueScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIssueScheduleResponse) ProtoMessage() {}
+
+func (x *DeleteIssueScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[116]
 	if x != nil {
-		return x.UserId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type AddWatcherResponse struct {
+// Deprecated: Use DeleteIssueScheduleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteIssueScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{116}
+}
+
+type ListIssueSchedulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddWatcherResponse) Reset() {
-	*x = AddWatcherResponse{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[29]
+func (x *ListIssueSchedulesRequest) Reset() {
+	*x = ListIssueSchedulesRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddWatcherResponse) String() string {
+func (x *ListIssueSchedulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddWatcherResponse) ProtoMessage() {}
+func (*ListIssueSchedulesRequest) ProtoMessage() {}
 
-func (x *AddWatcherResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[29]
+func (x *ListIssueSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2068,41 +7538,40 @@ func (x *AddWatcherResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddWatcherResponse.ProtoReflect.Descriptor instead.
-func (*AddWatcherResponse) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ListIssueSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListIssueSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *AddWatcherResponse) GetIssue() *Issue {
+func (x *ListIssueSchedulesRequest) GetProjectId() string {
 	if x != nil {
-		return x.Issue
+		return x.ProjectId
 	}
-	return nil
+	return ""
 }
 
-type RemoveWatcherRequest struct {
+type ListIssueSchedulesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Schedules     []*IssueSchedule       `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveWatcherRequest) Reset() {
-	*x = RemoveWatcherRequest{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[30]
+func (x *ListIssueSchedulesResponse) Reset() {
+	*x = ListIssueSchedulesResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveWatcherRequest) String() string {
+func (x *ListIssueSchedulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveWatcherRequest) ProtoMessage() {}
+func (*ListIssueSchedulesResponse) ProtoMessage() {}
 
-func (x *RemoveWatcherRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[30]
+func (x *ListIssueSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2113,47 +7582,40 @@ func (x *RemoveWatcherRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveWatcherRequest.ProtoReflect.Descriptor instead.
-func (*RemoveWatcherRequest) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *RemoveWatcherRequest) GetIssueId() string {
-	if x != nil {
-		return x.IssueId
-	}
-	return ""
+// Deprecated: Use ListIssueSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListIssueSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *RemoveWatcherRequest) GetUserId() string {
+func (x *ListIssueSchedulesResponse) GetSchedules() []*IssueSchedule {
 	if x != nil {
-		return x.UserId
+		return x.Schedules
 	}
-	return ""
+	return nil
 }
 
-type RemoveWatcherResponse struct {
+type CreateIssueTemplateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	Template      *IssueTemplate         `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveWatcherResponse) Reset() {
-	*x = RemoveWatcherResponse{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[31]
+func (x *CreateIssueTemplateRequest) Reset() {
+	*x = CreateIssueTemplateRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveWatcherResponse) String() string {
+func (x *CreateIssueTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveWatcherResponse) ProtoMessage() {}
+func (*CreateIssueTemplateRequest) ProtoMessage() {}
 
-func (x *RemoveWatcherResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[31]
+func (x *CreateIssueTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2164,45 +7626,40 @@ func (x *RemoveWatcherResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveWatcherResponse.ProtoReflect.Descriptor instead.
-func (*RemoveWatcherResponse) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use CreateIssueTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateIssueTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *RemoveWatcherResponse) GetIssue() *Issue {
+func (x *CreateIssueTemplateRequest) GetTemplate() *IssueTemplate {
 	if x != nil {
-		return x.Issue
+		return x.Template
 	}
 	return nil
 }
 
-type CreateCustomFieldRequest struct {
+type CreateIssueTemplateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Type          CustomFieldType        `protobuf:"varint,4,opt,name=type,proto3,enum=nexusflow.issue.v1.CustomFieldType" json:"type,omitempty"`
-	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
-	Options       []string               `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
+	Template      *IssueTemplate         `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCustomFieldRequest) Reset() {
-	*x = CreateCustomFieldRequest{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[32]
+func (x *CreateIssueTemplateResponse) Reset() {
+	*x = CreateIssueTemplateResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCustomFieldRequest) String() string {
+func (x *CreateIssueTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCustomFieldRequest) ProtoMessage() {}
+func (*CreateIssueTemplateResponse) ProtoMessage() {}
 
-func (x *CreateCustomFieldRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[32]
+func (x *CreateIssueTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2213,75 +7670,84 @@ func (x *CreateCustomFieldRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCustomFieldRequest.ProtoReflect.Descriptor instead.
-func (*CreateCustomFieldRequest) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use CreateIssueTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateIssueTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *CreateCustomFieldRequest) GetProjectId() string {
+func (x *CreateIssueTemplateResponse) GetTemplate() *IssueTemplate {
 	if x != nil {
-		return x.ProjectId
+		return x.Template
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateCustomFieldRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type GetIssueTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCustomFieldRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *GetIssueTemplateRequest) Reset() {
+	*x = GetIssueTemplateRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCustomFieldRequest) GetType() CustomFieldType {
-	if x != nil {
-		return x.Type
-	}
-	return CustomFieldType_CUSTOM_FIELD_TYPE_UNSPECIFIED
+func (x *GetIssueTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateCustomFieldRequest) GetRequired() bool {
+func (*GetIssueTemplateRequest) ProtoMessage() {}
+
+func (x *GetIssueTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[121]
 	if x != nil {
-		return x.Required
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *CreateCustomFieldRequest) GetOptions() []string {
+// Deprecated: Use GetIssueTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetIssueTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetIssueTemplateRequest) GetId() string {
 	if x != nil {
-		return x.Options
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type CreateCustomFieldResponse struct {
+type GetIssueTemplateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Field         *CustomField           `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Template      *IssueTemplate         `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCustomFieldResponse) Reset() {
-	*x = CreateCustomFieldResponse{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[33]
+func (x *GetIssueTemplateResponse) Reset() {
+	*x = GetIssueTemplateResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCustomFieldResponse) String() string {
+func (x *GetIssueTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCustomFieldResponse) ProtoMessage() {}
+func (*GetIssueTemplateResponse) ProtoMessage() {}
 
-func (x *CreateCustomFieldResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[33]
+func (x *GetIssueTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2292,44 +7758,42 @@ func (x *CreateCustomFieldResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCustomFieldResponse.ProtoReflect.Descriptor instead.
-func (*CreateCustomFieldResponse) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use GetIssueTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetIssueTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *CreateCustomFieldResponse) GetField() *CustomField {
+func (x *GetIssueTemplateResponse) GetTemplate() *IssueTemplate {
 	if x != nil {
-		return x.Field
+		return x.Template
 	}
 	return nil
 }
 
-type UpdateCustomFieldRequest struct {
+// Replaces the contents of a template; project_id cannot be changed
+type UpdateIssueTemplateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Required      *bool                  `protobuf:"varint,4,opt,name=required,proto3,oneof" json:"required,omitempty"`
-	Options       []string               `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	Template      *IssueTemplate         `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCustomFieldRequest) Reset() {
-	*x = UpdateCustomFieldRequest{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[34]
+func (x *UpdateIssueTemplateRequest) Reset() {
+	*x = UpdateIssueTemplateRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCustomFieldRequest) String() string {
+func (x *UpdateIssueTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCustomFieldRequest) ProtoMessage() {}
+func (*UpdateIssueTemplateRequest) ProtoMessage() {}
 
-func (x *UpdateCustomFieldRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[34]
+func (x *UpdateIssueTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2340,68 +7804,47 @@ func (x *UpdateCustomFieldRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCustomFieldRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCustomFieldRequest) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use UpdateIssueTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateIssueTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *UpdateCustomFieldRequest) GetId() string {
+func (x *UpdateIssueTemplateRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateCustomFieldRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
-}
-
-func (x *UpdateCustomFieldRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
-}
-
-func (x *UpdateCustomFieldRequest) GetRequired() bool {
-	if x != nil && x.Required != nil {
-		return *x.Required
-	}
-	return false
-}
-
-func (x *UpdateCustomFieldRequest) GetOptions() []string {
+func (x *UpdateIssueTemplateRequest) GetTemplate() *IssueTemplate {
 	if x != nil {
-		return x.Options
+		return x.Template
 	}
 	return nil
 }
 
-type UpdateCustomFieldResponse struct {
+type UpdateIssueTemplateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Field         *CustomField           `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Template      *IssueTemplate         `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCustomFieldResponse) Reset() {
-	*x = UpdateCustomFieldResponse{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[35]
+func (x *UpdateIssueTemplateResponse) Reset() {
+	*x = UpdateIssueTemplateResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCustomFieldResponse) String() string {
+func (x *UpdateIssueTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCustomFieldResponse) ProtoMessage() {}
+func (*UpdateIssueTemplateResponse) ProtoMessage() {}
 
-func (x *UpdateCustomFieldResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[35]
+func (x *UpdateIssueTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2412,40 +7855,40 @@ func (x *UpdateCustomFieldResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCustomFieldResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCustomFieldResponse) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use UpdateIssueTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateIssueTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *UpdateCustomFieldResponse) GetField() *CustomField {
+func (x *UpdateIssueTemplateResponse) GetTemplate() *IssueTemplate {
 	if x != nil {
-		return x.Field
+		return x.Template
 	}
 	return nil
 }
 
-type DeleteCustomFieldRequest struct {
+type DeleteIssueTemplateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCustomFieldRequest) Reset() {
-	*x = DeleteCustomFieldRequest{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[36]
+func (x *DeleteIssueTemplateRequest) Reset() {
+	*x = DeleteIssueTemplateRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCustomFieldRequest) String() string {
+func (x *DeleteIssueTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCustomFieldRequest) ProtoMessage() {}
+func (*DeleteIssueTemplateRequest) ProtoMessage() {}
 
-func (x *DeleteCustomFieldRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[36]
+func (x *DeleteIssueTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2456,40 +7899,39 @@ func (x *DeleteCustomFieldRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCustomFieldRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCustomFieldRequest) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use DeleteIssueTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteIssueTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *DeleteCustomFieldRequest) GetId() string {
+func (x *DeleteIssueTemplateRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteCustomFieldResponse struct {
+type DeleteIssueTemplateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Response      *v1.SuccessResponse    `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCustomFieldResponse) Reset() {
-	*x = DeleteCustomFieldResponse{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[37]
+func (x *DeleteIssueTemplateResponse) Reset() {
+	*x = DeleteIssueTemplateResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCustomFieldResponse) String() string {
+func (x *DeleteIssueTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCustomFieldResponse) ProtoMessage() {}
+func (*DeleteIssueTemplateResponse) ProtoMessage() {}
 
-func (x *DeleteCustomFieldResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[37]
+func (x *DeleteIssueTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2500,40 +7942,34 @@ func (x *DeleteCustomFieldResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCustomFieldResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCustomFieldResponse) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *DeleteCustomFieldResponse) GetResponse() *v1.SuccessResponse {
-	if x != nil {
-		return x.Response
-	}
-	return nil
+// Deprecated: Use DeleteIssueTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteIssueTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{126}
 }
 
-type ListCustomFieldsRequest struct {
+type ListIssueTemplatesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	IssueType     IssueType              `protobuf:"varint,2,opt,name=issue_type,json=issueType,proto3,enum=nexusflow.issue.v1.IssueType" json:"issue_type,omitempty"` // Optional filter
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCustomFieldsRequest) Reset() {
-	*x = ListCustomFieldsRequest{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[38]
+func (x *ListIssueTemplatesRequest) Reset() {
+	*x = ListIssueTemplatesRequest{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCustomFieldsRequest) String() string {
+func (x *ListIssueTemplatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCustomFieldsRequest) ProtoMessage() {}
+func (*ListIssueTemplatesRequest) ProtoMessage() {}
 
-func (x *ListCustomFieldsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[38]
+func (x *ListIssueTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2544,40 +7980,47 @@ func (x *ListCustomFieldsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCustomFieldsRequest.ProtoReflect.Descriptor instead.
-func (*ListCustomFieldsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use ListIssueTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListIssueTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *ListCustomFieldsRequest) GetProjectId() string {
+func (x *ListIssueTemplatesRequest) GetProjectId() string {
 	if x != nil {
 		return x.ProjectId
 	}
 	return ""
 }
 
-type ListCustomFieldsResponse struct {
+func (x *ListIssueTemplatesRequest) GetIssueType() IssueType {
+	if x != nil {
+		return x.IssueType
+	}
+	return IssueType_ISSUE_TYPE_UNSPECIFIED
+}
+
+type ListIssueTemplatesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Fields        []*CustomField         `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	Templates     []*IssueTemplate       `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCustomFieldsResponse) Reset() {
-	*x = ListCustomFieldsResponse{}
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[39]
+func (x *ListIssueTemplatesResponse) Reset() {
+	*x = ListIssueTemplatesResponse{}
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCustomFieldsResponse) String() string {
+func (x *ListIssueTemplatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCustomFieldsResponse) ProtoMessage() {}
+func (*ListIssueTemplatesResponse) ProtoMessage() {}
 
-func (x *ListCustomFieldsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_issue_v1_issue_proto_msgTypes[39]
+func (x *ListIssueTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_issue_v1_issue_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2588,14 +8031,14 @@ func (x *ListCustomFieldsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCustomFieldsResponse.ProtoReflect.Descriptor instead.
-func (*ListCustomFieldsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use ListIssueTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListIssueTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_issue_v1_issue_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *ListCustomFieldsResponse) GetFields() []*CustomField {
+func (x *ListIssueTemplatesResponse) GetTemplates() []*IssueTemplate {
 	if x != nil {
-		return x.Fields
+		return x.Templates
 	}
 	return nil
 }
@@ -2604,7 +8047,7 @@ var File_proto_issue_v1_issue_proto protoreflect.FileDescriptor
 
 const file_proto_issue_v1_issue_proto_rawDesc = "" +
 	"\n" +
-	"\x1aproto/issue/v1/issue.proto\x12\x12nexusflow.issue.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19google/protobuf/any.proto\x1a\x1cproto/common/v1/common.proto\"\xe8\x05\n" +
+	"\x1aproto/issue/v1/issue.proto\x12\x12nexusflow.issue.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19google/protobuf/any.proto\x1a\x1cproto/common/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\"\x9f\t\n" +
 	"\x05Issue\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -2631,7 +8074,20 @@ const file_proto_issue_v1_issue_proto_rawDesc = "" +
 	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x125\n" +
 	"\bdue_date\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12!\n" +
 	"\fstory_points\x18\x12 \x01(\x05R\vstoryPoints\x12\x1b\n" +
-	"\tsprint_id\x18\x13 \x01(\tR\bsprintId\"\x9c\x03\n" +
+	"\tsprint_id\x18\x13 \x01(\tR\bsprintId\x12#\n" +
+	"\rcomponent_ids\x18\x14 \x03(\tR\fcomponentIds\x12\x18\n" +
+	"\aversion\x18\x15 \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x17 \x01(\tR\tdeletedBy\x12:\n" +
+	"\x19original_estimate_seconds\x18\x18 \x01(\x03R\x17originalEstimateSeconds\x12<\n" +
+	"\x1aremaining_estimate_seconds\x18\x19 \x01(\x03R\x18remainingEstimateSeconds\x12,\n" +
+	"\x12time_spent_seconds\x18\x1a \x01(\x03R\x10timeSpentSeconds\x12\x1f\n" +
+	"\vschedule_id\x18\x1b \x01(\tR\n" +
+	"scheduleId\x12?\n" +
+	"\rscheduled_for\x18\x1c \x01(\v2\x1a.google.protobuf.TimestampR\fscheduledFor\x12\x12\n" +
+	"\x04rank\x18\x1d \x01(\tR\x04rank\"\xc5\x03\n" +
 	"\vCustomField\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -2642,18 +8098,130 @@ const file_proto_issue_v1_issue_proto_rawDesc = "" +
 	"\brequired\x18\x06 \x01(\bR\brequired\x129\n" +
 	"\rdefault_value\x18\a \x01(\v2\x14.google.protobuf.AnyR\fdefaultValue\x12\x18\n" +
 	"\aoptions\x18\b \x03(\tR\aoptions\x12C\n" +
-	"\x06config\x18\t \x03(\v2+.nexusflow.issue.v1.CustomField.ConfigEntryR\x06config\x1a9\n" +
+	"\x06config\x18\t \x03(\v2+.nexusflow.issue.v1.CustomField.ConfigEntryR\x06config\x12'\n" +
+	"\x0forganization_id\x18\n" +
+	" \x01(\tR\x0eorganizationId\x1a9\n" +
 	"\vConfigEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"Y\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x89\x02\n" +
+	"\x12CustomFieldContext\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bfield_id\x18\x02 \x01(\tR\afieldId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1f\n" +
+	"\vproject_ids\x18\x04 \x03(\tR\n" +
+	"projectIds\x12>\n" +
+	"\vissue_types\x18\x05 \x03(\x0e2\x1d.nexusflow.issue.v1.IssueTypeR\n" +
+	"issueTypes\x12\x18\n" +
+	"\aoptions\x18\x06 \x03(\tR\aoptions\x129\n" +
+	"\rdefault_value\x18\a \x01(\v2\x14.google.protobuf.AnyR\fdefaultValue\"\xb9\x01\n" +
+	"\tComponent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x17\n" +
+	"\alead_id\x18\x05 \x01(\tR\x06leadId\x12.\n" +
+	"\x13default_assignee_id\x18\x06 \x01(\tR\x11defaultAssigneeId\"Y\n" +
 	"\x10CustomFieldValue\x12\x19\n" +
 	"\bfield_id\x18\x01 \x01(\tR\afieldId\x12*\n" +
-	"\x05value\x18\x02 \x01(\v2\x14.google.protobuf.AnyR\x05value\"\xa2\x01\n" +
+	"\x05value\x18\x02 \x01(\v2\x14.google.protobuf.AnyR\x05value\"\xd8\x03\n" +
+	"\x03Job\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x125\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1d.nexusflow.issue.v1.JobStatusR\x06status\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x1c\n" +
+	"\tprocessed\x18\x04 \x01(\x05R\tprocessed\x12\x1c\n" +
+	"\tsucceeded\x18\x05 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x06 \x01(\x05R\x06failed\x128\n" +
+	"\x06errors\x18\a \x03(\v2 .nexusflow.issue.v1.JobItemErrorR\x06errors\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\t \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"started_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"\xe2\x02\n" +
+	"\aWorklog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bissue_id\x18\x02 \x01(\tR\aissueId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x03 \x01(\tR\tprojectId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12)\n" +
+	"\x10duration_seconds\x18\x06 \x01(\x03R\x0fdurationSeconds\x12\x18\n" +
+	"\acomment\x18\a \x01(\tR\acomment\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb6\x01\n" +
+	"\fTimeTracking\x12:\n" +
+	"\x19original_estimate_seconds\x18\x01 \x01(\x03R\x17originalEstimateSeconds\x12<\n" +
+	"\x1aremaining_estimate_seconds\x18\x02 \x01(\x03R\x18remainingEstimateSeconds\x12,\n" +
+	"\x12time_spent_seconds\x18\x03 \x01(\x03R\x10timeSpentSeconds\"\x92\a\n" +
+	"\rIssueSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\asummary\x18\x04 \x01(\tR\asummary\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x121\n" +
+	"\x04type\x18\x06 \x01(\x0e2\x1d.nexusflow.issue.v1.IssueTypeR\x04type\x12=\n" +
+	"\bpriority\x18\a \x01(\x0e2!.nexusflow.issue.v1.IssuePriorityR\bpriority\x12\x1f\n" +
+	"\vassignee_id\x18\b \x01(\tR\n" +
+	"assigneeId\x12I\n" +
+	"\rcustom_fields\x18\t \x03(\v2$.nexusflow.issue.v1.CustomFieldValueR\fcustomFields\x12\x14\n" +
+	"\x05rrule\x18\n" +
+	" \x01(\tR\x05rrule\x12\x1a\n" +
+	"\btimezone\x18\v \x01(\tR\btimezone\x127\n" +
+	"\tstarts_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12>\n" +
+	"\bcatch_up\x18\x0e \x01(\x0e2#.nexusflow.issue.v1.ScheduleCatchUpR\acatchUp\x12\x18\n" +
+	"\aenabled\x18\x0f \x01(\bR\aenabled\x12:\n" +
+	"\vnext_run_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tnextRunAt\x12:\n" +
+	"\vlast_run_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tlastRunAt\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x12 \x01(\tR\tlastError\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x13 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb0\x04\n" +
+	"\rIssueTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12<\n" +
+	"\n" +
+	"issue_type\x18\x04 \x01(\x0e2\x1d.nexusflow.issue.v1.IssueTypeR\tissueType\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x05 \x01(\bR\tisDefault\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12=\n" +
+	"\bpriority\x18\a \x01(\x0e2!.nexusflow.issue.v1.IssuePriorityR\bpriority\x12\x1b\n" +
+	"\tlabel_ids\x18\b \x03(\tR\blabelIds\x12I\n" +
+	"\rcustom_fields\x18\t \x03(\v2$.nexusflow.issue.v1.CustomFieldValueR\fcustomFields\x12@\n" +
+	"\tsub_tasks\x18\n" +
+	" \x03(\v2#.nexusflow.issue.v1.TemplateSubTaskR\bsubTasks\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"n\n" +
+	"\x0fTemplateSubTask\x12\x18\n" +
+	"\asummary\x18\x01 \x01(\tR\asummary\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vassignee_id\x18\x03 \x01(\tR\n" +
+	"assigneeId\"C\n" +
+	"\fJobItemError\x12\x19\n" +
+	"\bissue_id\x18\x01 \x01(\tR\aissueId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xa2\x01\n" +
 	"\tIssueLink\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
 	"\x0fsource_issue_id\x18\x02 \x01(\tR\rsourceIssueId\x12&\n" +
 	"\x0ftarget_issue_id\x18\x03 \x01(\tR\rtargetIssueId\x125\n" +
-	"\x04type\x18\x04 \x01(\x0e2!.nexusflow.issue.v1.IssueLinkTypeR\x04type\"\x87\x03\n" +
+	"\x04type\x18\x04 \x01(\x0e2!.nexusflow.issue.v1.IssueLinkTypeR\x04type\"\x89\x04\n" +
 	"\x12CreateIssueRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x18\n" +
@@ -2665,7 +8233,12 @@ const file_proto_issue_v1_issue_proto_rawDesc = "" +
 	"assigneeId\x12\x1b\n" +
 	"\tparent_id\x18\a \x01(\tR\bparentId\x12\x1b\n" +
 	"\tlabel_ids\x18\b \x03(\tR\blabelIds\x12I\n" +
-	"\rcustom_fields\x18\t \x03(\v2$.nexusflow.issue.v1.CustomFieldValueR\fcustomFields\"F\n" +
+	"\rcustom_fields\x18\t \x03(\v2$.nexusflow.issue.v1.CustomFieldValueR\fcustomFields\x12#\n" +
+	"\rcomponent_ids\x18\n" +
+	" \x03(\tR\fcomponentIds\x12:\n" +
+	"\x19original_estimate_seconds\x18\v \x01(\x03R\x17originalEstimateSeconds\x12\x1f\n" +
+	"\vtemplate_id\x18\f \x01(\tR\n" +
+	"templateId\"F\n" +
 	"\x13CreateIssueResponse\x12/\n" +
 	"\x05issue\x18\x01 \x01(\v2\x19.nexusflow.issue.v1.IssueR\x05issue\"!\n" +
 	"\x0fGetIssueRequest\x12\x0e\n" +
@@ -2675,7 +8248,11 @@ const file_proto_issue_v1_issue_proto_rawDesc = "" +
 	"\x14GetIssueByKeyRequest\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\"H\n" +
 	"\x15GetIssueByKeyResponse\x12/\n" +
-	"\x05issue\x18\x01 \x01(\v2\x19.nexusflow.issue.v1.IssueR\x05issue\"\xde\x03\n" +
+	"\x05issue\x18\x01 \x01(\v2\x19.nexusflow.issue.v1.IssueR\x05issue\")\n" +
+	"\x15BatchGetIssuesRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"K\n" +
+	"\x16BatchGetIssuesResponse\x121\n" +
+	"\x06issues\x18\x01 \x03(\v2\x19.nexusflow.issue.v1.IssueR\x06issues\"\xc3\x06\n" +
 	"\x12UpdateIssueRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\asummary\x18\x02 \x01(\tH\x00R\asummary\x88\x01\x01\x12%\n" +
@@ -2686,7 +8263,15 @@ const file_proto_issue_v1_issue_proto_rawDesc = "" +
 	"assigneeId\x88\x01\x01\x12\x1b\n" +
 	"\tlabel_ids\x18\a \x03(\tR\blabelIds\x12I\n" +
 	"\rcustom_fields\x18\b \x03(\v2$.nexusflow.issue.v1.CustomFieldValueR\fcustomFields\x12&\n" +
-	"\fstory_points\x18\t \x01(\x05H\x05R\vstoryPoints\x88\x01\x01B\n" +
+	"\fstory_points\x18\t \x01(\x05H\x05R\vstoryPoints\x88\x01\x01\x12#\n" +
+	"\rcomponent_ids\x18\n" +
+	" \x03(\tR\fcomponentIds\x12\x1f\n" +
+	"\vupdate_mask\x18\v \x03(\tR\n" +
+	"updateMask\x12.\n" +
+	"\x10expected_version\x18\f \x01(\x03H\x06R\x0fexpectedVersion\x88\x01\x01\x12?\n" +
+	"\x19original_estimate_seconds\x18\r \x01(\x03H\aR\x17originalEstimateSeconds\x88\x01\x01\x12A\n" +
+	"\x1aremaining_estimate_seconds\x18\x0e \x01(\x03H\bR\x18remainingEstimateSeconds\x88\x01\x01\x12\x17\n" +
+	"\auser_id\x18\x0f \x01(\tR\x06userIdB\n" +
 	"\n" +
 	"\b_summaryB\x0e\n" +
 	"\f_descriptionB\v\n" +
@@ -2694,13 +8279,33 @@ const file_proto_issue_v1_issue_proto_rawDesc = "" +
 	"\n" +
 	"_status_idB\x0e\n" +
 	"\f_assignee_idB\x0f\n" +
-	"\r_story_points\"F\n" +
+	"\r_story_pointsB\x13\n" +
+	"\x11_expected_versionB\x1c\n" +
+	"\x1a_original_estimate_secondsB\x1d\n" +
+	"\x1b_remaining_estimate_seconds\"F\n" +
 	"\x13UpdateIssueResponse\x12/\n" +
-	"\x05issue\x18\x01 \x01(\v2\x19.nexusflow.issue.v1.IssueR\x05issue\"$\n" +
+	"\x05issue\x18\x01 \x01(\v2\x19.nexusflow.issue.v1.IssueR\x05issue\"=\n" +
 	"\x12DeleteIssueRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"W\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"W\n" +
 	"\x13DeleteIssueResponse\x12@\n" +
-	"\bresponse\x18\x01 \x01(\v2$.nexusflow.common.v1.SuccessResponseR\bresponse\"\x8a\x02\n" +
+	"\bresponse\x18\x01 \x01(\v2$.nexusflow.common.v1.SuccessResponseR\bresponse\"\x81\x01\n" +
+	"\x18ListDeletedIssuesRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12F\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2&.nexusflow.common.v1.PaginationRequestR\n" +
+	"pagination\"\x97\x01\n" +
+	"\x19ListDeletedIssuesResponse\x121\n" +
+	"\x06issues\x18\x01 \x03(\v2\x19.nexusflow.issue.v1.IssueR\x06issues\x12G\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2'.nexusflow.common.v1.PaginationResponseR\n" +
+	"pagination\">\n" +
+	"\x13RestoreIssueRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"G\n" +
+	"\x14RestoreIssueResponse\x12/\n" +
+	"\x05issue\x18\x01 \x01(\v2\x19.nexusflow.issue.v1.IssueR\x05issue\"\xab\x06\n" +
 	"\x11ListIssuesRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12F\n" +
@@ -2712,7 +8317,26 @@ const file_proto_issue_v1_issue_proto_rawDesc = "" +
 	"assigneeId\x12\x1b\n" +
 	"\tsprint_id\x18\x05 \x01(\tR\bsprintId\x12\x1d\n" +
 	"\n" +
-	"status_ids\x18\x06 \x03(\tR\tstatusIds\"\x90\x01\n" +
+	"status_ids\x18\x06 \x03(\tR\tstatusIds\x12\x1b\n" +
+	"\tlabel_ids\x18\a \x03(\tR\blabelIds\x12#\n" +
+	"\rcomponent_ids\x18\b \x03(\tR\fcomponentIds\x12\x1f\n" +
+	"\vreporter_id\x18\t \x01(\tR\n" +
+	"reporterId\x12A\n" +
+	"\n" +
+	"priorities\x18\n" +
+	" \x03(\x0e2!.nexusflow.issue.v1.IssuePriorityR\n" +
+	"priorities\x12\x1b\n" +
+	"\tparent_id\x18\v \x01(\tR\bparentId\x127\n" +
+	"\tdue_after\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\bdueAfter\x129\n" +
+	"\n" +
+	"due_before\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tdueBefore\x12?\n" +
+	"\rupdated_since\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\fupdatedSince\x12A\n" +
+	"\x0eupdated_before\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\rupdatedBefore\x12\x18\n" +
+	"\abacklog\x18\x10 \x01(\bR\abacklog\x12J\n" +
+	"\rcustom_fields\x18\x11 \x03(\v2%.nexusflow.issue.v1.CustomFieldFilterR\fcustomFields\"D\n" +
+	"\x11CustomFieldFilter\x12\x19\n" +
+	"\bfield_id\x18\x01 \x01(\tR\afieldId\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\x90\x01\n" +
 	"\x12ListIssuesResponse\x121\n" +
 	"\x06issues\x18\x01 \x03(\v2\x19.nexusflow.issue.v1.IssueR\x06issues\x12G\n" +
 	"\n" +
@@ -2762,7 +8386,7 @@ const file_proto_issue_v1_issue_proto_rawDesc = "" +
 	"\bissue_id\x18\x01 \x01(\tR\aissueId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"H\n" +
 	"\x15RemoveWatcherResponse\x12/\n" +
-	"\x05issue\x18\x01 \x01(\v2\x19.nexusflow.issue.v1.IssueR\x05issue\"\xde\x01\n" +
+	"\x05issue\x18\x01 \x01(\v2\x19.nexusflow.issue.v1.IssueR\x05issue\"\xcf\x03\n" +
 	"\x18CreateCustomFieldRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x12\n" +
@@ -2770,7 +8394,13 @@ const file_proto_issue_v1_issue_proto_rawDesc = "" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x127\n" +
 	"\x04type\x18\x04 \x01(\x0e2#.nexusflow.issue.v1.CustomFieldTypeR\x04type\x12\x1a\n" +
 	"\brequired\x18\x05 \x01(\bR\brequired\x12\x18\n" +
-	"\aoptions\x18\x06 \x03(\tR\aoptions\"R\n" +
+	"\aoptions\x18\x06 \x03(\tR\aoptions\x129\n" +
+	"\rdefault_value\x18\a \x01(\v2\x14.google.protobuf.AnyR\fdefaultValue\x12P\n" +
+	"\x06config\x18\b \x03(\v28.nexusflow.issue.v1.CreateCustomFieldRequest.ConfigEntryR\x06config\x12'\n" +
+	"\x0forganization_id\x18\t \x01(\tR\x0eorganizationId\x1a9\n" +
+	"\vConfigEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"R\n" +
 	"\x19CreateCustomFieldResponse\x125\n" +
 	"\x05field\x18\x01 \x01(\v2\x1f.nexusflow.issue.v1.CustomFieldR\x05field\"\xcb\x01\n" +
 	"\x18UpdateCustomFieldRequest\x12\x0e\n" +
@@ -2787,12 +8417,259 @@ const file_proto_issue_v1_issue_proto_rawDesc = "" +
 	"\x18DeleteCustomFieldRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"]\n" +
 	"\x19DeleteCustomFieldResponse\x12@\n" +
-	"\bresponse\x18\x01 \x01(\v2$.nexusflow.common.v1.SuccessResponseR\bresponse\"8\n" +
+	"\bresponse\x18\x01 \x01(\v2$.nexusflow.common.v1.SuccessResponseR\bresponse\"\x9f\x01\n" +
 	"\x17ListCustomFieldsRequest\x12\x1d\n" +
 	"\n" +
-	"project_id\x18\x01 \x01(\tR\tprojectId\"S\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12<\n" +
+	"\n" +
+	"issue_type\x18\x02 \x01(\x0e2\x1d.nexusflow.issue.v1.IssueTypeR\tissueType\x12'\n" +
+	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\"S\n" +
 	"\x18ListCustomFieldsResponse\x127\n" +
-	"\x06fields\x18\x01 \x03(\v2\x1f.nexusflow.issue.v1.CustomFieldR\x06fields*\xb0\x01\n" +
+	"\x06fields\x18\x01 \x03(\v2\x1f.nexusflow.issue.v1.CustomFieldR\x06fields\"\x86\x02\n" +
+	"\x1fCreateCustomFieldContextRequest\x12\x19\n" +
+	"\bfield_id\x18\x01 \x01(\tR\afieldId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vproject_ids\x18\x03 \x03(\tR\n" +
+	"projectIds\x12>\n" +
+	"\vissue_types\x18\x04 \x03(\x0e2\x1d.nexusflow.issue.v1.IssueTypeR\n" +
+	"issueTypes\x12\x18\n" +
+	"\aoptions\x18\x05 \x03(\tR\aoptions\x129\n" +
+	"\rdefault_value\x18\x06 \x01(\v2\x14.google.protobuf.AnyR\fdefaultValue\"d\n" +
+	" CreateCustomFieldContextResponse\x12@\n" +
+	"\acontext\x18\x01 \x01(\v2&.nexusflow.issue.v1.CustomFieldContextR\acontext\"\xaa\x02\n" +
+	"\x1fUpdateCustomFieldContextRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1f\n" +
+	"\vproject_ids\x18\x03 \x03(\tR\n" +
+	"projectIds\x12>\n" +
+	"\vissue_types\x18\x04 \x03(\x0e2\x1d.nexusflow.issue.v1.IssueTypeR\n" +
+	"issueTypes\x12\x18\n" +
+	"\aoptions\x18\x05 \x03(\tR\aoptions\x129\n" +
+	"\rdefault_value\x18\x06 \x01(\v2\x14.google.protobuf.AnyR\fdefaultValue\x12\x1f\n" +
+	"\vupdate_mask\x18\a \x03(\tR\n" +
+	"updateMaskB\a\n" +
+	"\x05_name\"d\n" +
+	" UpdateCustomFieldContextResponse\x12@\n" +
+	"\acontext\x18\x01 \x01(\v2&.nexusflow.issue.v1.CustomFieldContextR\acontext\"1\n" +
+	"\x1fDeleteCustomFieldContextRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"d\n" +
+	" DeleteCustomFieldContextResponse\x12@\n" +
+	"\bresponse\x18\x01 \x01(\v2$.nexusflow.common.v1.SuccessResponseR\bresponse\";\n" +
+	"\x1eListCustomFieldContextsRequest\x12\x19\n" +
+	"\bfield_id\x18\x01 \x01(\tR\afieldId\"e\n" +
+	"\x1fListCustomFieldContextsResponse\x12B\n" +
+	"\bcontexts\x18\x01 \x03(\v2&.nexusflow.issue.v1.CustomFieldContextR\bcontexts\"\x7f\n" +
+	"\x12CreateLabelRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"G\n" +
+	"\x13CreateLabelResponse\x120\n" +
+	"\x05label\x18\x01 \x01(\v2\x1a.nexusflow.common.v1.LabelR\x05label\"\xa2\x01\n" +
+	"\x12UpdateLabelRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x19\n" +
+	"\x05color\x18\x03 \x01(\tH\x01R\x05color\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x02R\vdescription\x88\x01\x01B\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_colorB\x0e\n" +
+	"\f_description\"G\n" +
+	"\x13UpdateLabelResponse\x120\n" +
+	"\x05label\x18\x01 \x01(\v2\x1a.nexusflow.common.v1.LabelR\x05label\"$\n" +
+	"\x12DeleteLabelRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"W\n" +
+	"\x13DeleteLabelResponse\x12@\n" +
+	"\bresponse\x18\x01 \x01(\v2$.nexusflow.common.v1.SuccessResponseR\bresponse\"2\n" +
+	"\x11ListLabelsRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"H\n" +
+	"\x12ListLabelsResponse\x122\n" +
+	"\x06labels\x18\x01 \x03(\v2\x1a.nexusflow.common.v1.LabelR\x06labels\"\x89\x01\n" +
+	"\x1cBulkUpdateIssueLabelsRequest\x12\x1b\n" +
+	"\tissue_ids\x18\x01 \x03(\tR\bissueIds\x12\"\n" +
+	"\radd_label_ids\x18\x02 \x03(\tR\vaddLabelIds\x12(\n" +
+	"\x10remove_label_ids\x18\x03 \x03(\tR\x0eremoveLabelIds\"R\n" +
+	"\x1dBulkUpdateIssueLabelsResponse\x121\n" +
+	"\x06issues\x18\x01 \x03(\v2\x19.nexusflow.issue.v1.IssueR\x06issues\"\xb6\x01\n" +
+	"\x16CreateComponentRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x17\n" +
+	"\alead_id\x18\x04 \x01(\tR\x06leadId\x12.\n" +
+	"\x13default_assignee_id\x18\x05 \x01(\tR\x11defaultAssigneeId\"V\n" +
+	"\x17CreateComponentResponse\x12;\n" +
+	"\tcomponent\x18\x01 \x01(\v2\x1d.nexusflow.issue.v1.ComponentR\tcomponent\"\xf8\x01\n" +
+	"\x16UpdateComponentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1c\n" +
+	"\alead_id\x18\x04 \x01(\tH\x02R\x06leadId\x88\x01\x01\x123\n" +
+	"\x13default_assignee_id\x18\x05 \x01(\tH\x03R\x11defaultAssigneeId\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\n" +
+	"\n" +
+	"\b_lead_idB\x16\n" +
+	"\x14_default_assignee_id\"V\n" +
+	"\x17UpdateComponentResponse\x12;\n" +
+	"\tcomponent\x18\x01 \x01(\v2\x1d.nexusflow.issue.v1.ComponentR\tcomponent\"(\n" +
+	"\x16DeleteComponentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"[\n" +
+	"\x17DeleteComponentResponse\x12@\n" +
+	"\bresponse\x18\x01 \x01(\v2$.nexusflow.common.v1.SuccessResponseR\bresponse\"6\n" +
+	"\x15ListComponentsRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"W\n" +
+	"\x16ListComponentsResponse\x12=\n" +
+	"\n" +
+	"components\x18\x01 \x03(\v2\x1d.nexusflow.issue.v1.ComponentR\n" +
+	"components\"\x9d\x01\n" +
+	" BulkUpdateIssueComponentsRequest\x12\x1b\n" +
+	"\tissue_ids\x18\x01 \x03(\tR\bissueIds\x12*\n" +
+	"\x11add_component_ids\x18\x02 \x03(\tR\x0faddComponentIds\x120\n" +
+	"\x14remove_component_ids\x18\x03 \x03(\tR\x12removeComponentIds\"V\n" +
+	"!BulkUpdateIssueComponentsResponse\x121\n" +
+	"\x06issues\x18\x01 \x03(\v2\x19.nexusflow.issue.v1.IssueR\x06issues\"R\n" +
+	"\x16SetIssuesSprintRequest\x12\x1b\n" +
+	"\tissue_ids\x18\x01 \x03(\tR\bissueIds\x12\x1b\n" +
+	"\tsprint_id\x18\x02 \x01(\tR\bsprintId\"6\n" +
+	"\x17SetIssuesSprintResponse\x12\x1b\n" +
+	"\tissue_ids\x18\x01 \x03(\tR\bissueIds\"t\n" +
+	"\x16RankIssueBeforeRequest\x12\x19\n" +
+	"\bissue_id\x18\x01 \x01(\tR\aissueId\x12&\n" +
+	"\x0fbefore_issue_id\x18\x02 \x01(\tR\rbeforeIssueId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"J\n" +
+	"\x17RankIssueBeforeResponse\x12/\n" +
+	"\x05issue\x18\x01 \x01(\v2\x19.nexusflow.issue.v1.IssueR\x05issue\"q\n" +
+	"\x15RankIssueAfterRequest\x12\x19\n" +
+	"\bissue_id\x18\x01 \x01(\tR\aissueId\x12$\n" +
+	"\x0eafter_issue_id\x18\x02 \x01(\tR\fafterIssueId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"I\n" +
+	"\x16RankIssueAfterResponse\x12/\n" +
+	"\x05issue\x18\x01 \x01(\v2\x19.nexusflow.issue.v1.IssueR\x05issue\"\x97\x01\n" +
+	"\x11RankIssuesRequest\x12\x1b\n" +
+	"\tissue_ids\x18\x01 \x03(\tR\bissueIds\x12&\n" +
+	"\x0fbefore_issue_id\x18\x02 \x01(\tR\rbeforeIssueId\x12$\n" +
+	"\x0eafter_issue_id\x18\x03 \x01(\tR\fafterIssueId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\"G\n" +
+	"\x12RankIssuesResponse\x121\n" +
+	"\x06issues\x18\x01 \x03(\v2\x19.nexusflow.issue.v1.IssueR\x06issues\"\x8d\x03\n" +
+	"\x17BulkUpdateIssuesRequest\x12\x1b\n" +
+	"\tissue_ids\x18\x01 \x03(\tR\bissueIds\x12=\n" +
+	"\x06filter\x18\x02 \x01(\v2%.nexusflow.issue.v1.ListIssuesRequestR\x06filter\x12$\n" +
+	"\vassignee_id\x18\x03 \x01(\tH\x00R\n" +
+	"assigneeId\x88\x01\x01\x12B\n" +
+	"\bpriority\x18\x04 \x01(\x0e2!.nexusflow.issue.v1.IssuePriorityH\x01R\bpriority\x88\x01\x01\x12 \n" +
+	"\tstatus_id\x18\x05 \x01(\tH\x02R\bstatusId\x88\x01\x01\x12 \n" +
+	"\tsprint_id\x18\x06 \x01(\tH\x03R\bsprintId\x88\x01\x01\x12\x16\n" +
+	"\x06delete\x18\a \x01(\bR\x06delete\x12\x17\n" +
+	"\auser_id\x18\b \x01(\tR\x06userIdB\x0e\n" +
+	"\f_assignee_idB\v\n" +
+	"\t_priorityB\f\n" +
+	"\n" +
+	"_status_idB\f\n" +
+	"\n" +
+	"_sprint_id\"E\n" +
+	"\x18BulkUpdateIssuesResponse\x12)\n" +
+	"\x03job\x18\x01 \x01(\v2\x17.nexusflow.issue.v1.JobR\x03job\"\x1f\n" +
+	"\rGetJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\";\n" +
+	"\x0eGetJobResponse\x12)\n" +
+	"\x03job\x18\x01 \x01(\v2\x17.nexusflow.issue.v1.JobR\x03job\"\xc7\x01\n" +
+	"\x11AddWorklogRequest\x12\x19\n" +
+	"\bissue_id\x18\x01 \x01(\tR\aissueId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"started_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12)\n" +
+	"\x10duration_seconds\x18\x04 \x01(\x03R\x0fdurationSeconds\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\"K\n" +
+	"\x12AddWorklogResponse\x125\n" +
+	"\aworklog\x18\x01 \x01(\v2\x1b.nexusflow.issue.v1.WorklogR\aworklog\"\xea\x01\n" +
+	"\x14UpdateWorklogRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"started_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12.\n" +
+	"\x10duration_seconds\x18\x04 \x01(\x03H\x00R\x0fdurationSeconds\x88\x01\x01\x12\x1d\n" +
+	"\acomment\x18\x05 \x01(\tH\x01R\acomment\x88\x01\x01B\x13\n" +
+	"\x11_duration_secondsB\n" +
+	"\n" +
+	"\b_comment\"N\n" +
+	"\x15UpdateWorklogResponse\x125\n" +
+	"\aworklog\x18\x01 \x01(\v2\x1b.nexusflow.issue.v1.WorklogR\aworklog\"?\n" +
+	"\x14DeleteWorklogRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x17\n" +
+	"\x15DeleteWorklogResponse\"0\n" +
+	"\x13ListWorklogsRequest\x12\x19\n" +
+	"\bissue_id\x18\x01 \x01(\tR\aissueId\"O\n" +
+	"\x14ListWorklogsResponse\x127\n" +
+	"\bworklogs\x18\x01 \x03(\v2\x1b.nexusflow.issue.v1.WorklogR\bworklogs\"3\n" +
+	"\x16GetTimeTrackingRequest\x12\x19\n" +
+	"\bissue_id\x18\x01 \x01(\tR\aissueId\"\x8b\x01\n" +
+	"\x17GetTimeTrackingResponse\x126\n" +
+	"\x05issue\x18\x01 \x01(\v2 .nexusflow.issue.v1.TimeTrackingR\x05issue\x128\n" +
+	"\x06rollup\x18\x02 \x01(\v2 .nexusflow.issue.v1.TimeTrackingR\x06rollup\"\xa9\x01\n" +
+	"\x13GetTimesheetRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"N\n" +
+	"\x0eTimesheetTotal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
+	"\x12time_spent_seconds\x18\x02 \x01(\x03R\x10timeSpentSeconds\"\xf0\x01\n" +
+	"\x14GetTimesheetResponse\x127\n" +
+	"\bworklogs\x18\x01 \x03(\v2\x1b.nexusflow.issue.v1.WorklogR\bworklogs\x12#\n" +
+	"\rtotal_seconds\x18\x02 \x01(\x03R\ftotalSeconds\x12;\n" +
+	"\aby_user\x18\x03 \x03(\v2\".nexusflow.issue.v1.TimesheetTotalR\x06byUser\x12=\n" +
+	"\bby_issue\x18\x04 \x03(\v2\".nexusflow.issue.v1.TimesheetTotalR\abyIssue\"t\n" +
+	"\x1aCreateIssueScheduleRequest\x12=\n" +
+	"\bschedule\x18\x01 \x01(\v2!.nexusflow.issue.v1.IssueScheduleR\bschedule\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\\\n" +
+	"\x1bCreateIssueScheduleResponse\x12=\n" +
+	"\bschedule\x18\x01 \x01(\v2!.nexusflow.issue.v1.IssueScheduleR\bschedule\")\n" +
+	"\x17GetIssueScheduleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Y\n" +
+	"\x18GetIssueScheduleResponse\x12=\n" +
+	"\bschedule\x18\x01 \x01(\v2!.nexusflow.issue.v1.IssueScheduleR\bschedule\"k\n" +
+	"\x1aUpdateIssueScheduleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12=\n" +
+	"\bschedule\x18\x02 \x01(\v2!.nexusflow.issue.v1.IssueScheduleR\bschedule\"\\\n" +
+	"\x1bUpdateIssueScheduleResponse\x12=\n" +
+	"\bschedule\x18\x01 \x01(\v2!.nexusflow.issue.v1.IssueScheduleR\bschedule\",\n" +
+	"\x1aDeleteIssueScheduleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1d\n" +
+	"\x1bDeleteIssueScheduleResponse\":\n" +
+	"\x19ListIssueSchedulesRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"]\n" +
+	"\x1aListIssueSchedulesResponse\x12?\n" +
+	"\tschedules\x18\x01 \x03(\v2!.nexusflow.issue.v1.IssueScheduleR\tschedules\"[\n" +
+	"\x1aCreateIssueTemplateRequest\x12=\n" +
+	"\btemplate\x18\x01 \x01(\v2!.nexusflow.issue.v1.IssueTemplateR\btemplate\"\\\n" +
+	"\x1bCreateIssueTemplateResponse\x12=\n" +
+	"\btemplate\x18\x01 \x01(\v2!.nexusflow.issue.v1.IssueTemplateR\btemplate\")\n" +
+	"\x17GetIssueTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Y\n" +
+	"\x18GetIssueTemplateResponse\x12=\n" +
+	"\btemplate\x18\x01 \x01(\v2!.nexusflow.issue.v1.IssueTemplateR\btemplate\"k\n" +
+	"\x1aUpdateIssueTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12=\n" +
+	"\btemplate\x18\x02 \x01(\v2!.nexusflow.issue.v1.IssueTemplateR\btemplate\"\\\n" +
+	"\x1bUpdateIssueTemplateResponse\x12=\n" +
+	"\btemplate\x18\x01 \x01(\v2!.nexusflow.issue.v1.IssueTemplateR\btemplate\",\n" +
+	"\x1aDeleteIssueTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1d\n" +
+	"\x1bDeleteIssueTemplateResponse\"x\n" +
+	"\x19ListIssueTemplatesRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12<\n" +
+	"\n" +
+	"issue_type\x18\x02 \x01(\x0e2\x1d.nexusflow.issue.v1.IssueTypeR\tissueType\"]\n" +
+	"\x1aListIssueTemplatesResponse\x12?\n" +
+	"\ttemplates\x18\x01 \x03(\v2!.nexusflow.issue.v1.IssueTemplateR\ttemplates*\xb0\x01\n" +
 	"\tIssueType\x12\x1a\n" +
 	"\x16ISSUE_TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fISSUE_TYPE_EPIC\x10\x01\x12\x14\n" +
@@ -2820,7 +8697,19 @@ const file_proto_issue_v1_issue_proto_rawDesc = "" +
 	"\x15CUSTOM_FIELD_TYPE_URL\x10\b\x12\x1b\n" +
 	"\x17CUSTOM_FIELD_TYPE_EMAIL\x10\t\x12\x1e\n" +
 	"\x1aCUSTOM_FIELD_TYPE_TEXTAREA\x10\n" +
-	"*\x8a\x02\n" +
+	"*\x89\x01\n" +
+	"\x0fScheduleCatchUp\x12!\n" +
+	"\x1dSCHEDULE_CATCH_UP_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16SCHEDULE_CATCH_UP_NONE\x10\x01\x12\x1c\n" +
+	"\x18SCHEDULE_CATCH_UP_LATEST\x10\x02\x12\x19\n" +
+	"\x15SCHEDULE_CATCH_UP_ALL\x10\x03*\xae\x01\n" +
+	"\tJobStatus\x12\x1a\n" +
+	"\x16JOB_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12JOB_STATUS_PENDING\x10\x01\x12\x16\n" +
+	"\x12JOB_STATUS_RUNNING\x10\x02\x12\x18\n" +
+	"\x14JOB_STATUS_COMPLETED\x10\x03\x12$\n" +
+	" JOB_STATUS_COMPLETED_WITH_ERRORS\x10\x04\x12\x15\n" +
+	"\x11JOB_STATUS_FAILED\x10\x05*\x8a\x02\n" +
 	"\rIssueLinkType\x12\x1f\n" +
 	"\x1bISSUE_LINK_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16ISSUE_LINK_TYPE_BLOCKS\x10\x01\x12\x1e\n" +
@@ -2829,15 +8718,18 @@ const file_proto_issue_v1_issue_proto_rawDesc = "" +
 	"\x1aISSUE_LINK_TYPE_DUPLICATES\x10\x04\x12!\n" +
 	"\x1dISSUE_LINK_TYPE_DUPLICATED_BY\x10\x05\x12\x1a\n" +
 	"\x16ISSUE_LINK_TYPE_CAUSES\x10\x06\x12\x1d\n" +
-	"\x19ISSUE_LINK_TYPE_CAUSED_BY\x10\a2\xba\x0e\n" +
-	"\fIssueService\x12^\n" +
-	"\vCreateIssue\x12&.nexusflow.issue.v1.CreateIssueRequest\x1a'.nexusflow.issue.v1.CreateIssueResponse\x12U\n" +
-	"\bGetIssue\x12#.nexusflow.issue.v1.GetIssueRequest\x1a$.nexusflow.issue.v1.GetIssueResponse\x12d\n" +
-	"\rGetIssueByKey\x12(.nexusflow.issue.v1.GetIssueByKeyRequest\x1a).nexusflow.issue.v1.GetIssueByKeyResponse\x12^\n" +
-	"\vUpdateIssue\x12&.nexusflow.issue.v1.UpdateIssueRequest\x1a'.nexusflow.issue.v1.UpdateIssueResponse\x12^\n" +
-	"\vDeleteIssue\x12&.nexusflow.issue.v1.DeleteIssueRequest\x1a'.nexusflow.issue.v1.DeleteIssueResponse\x12[\n" +
+	"\x19ISSUE_LINK_TYPE_CAUSED_BY\x10\a2\xa58\n" +
+	"\fIssueService\x12\x8b\x01\n" +
+	"\vCreateIssue\x12&.nexusflow.issue.v1.CreateIssueRequest\x1a'.nexusflow.issue.v1.CreateIssueResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/projects/{project_id}/issues\x12n\n" +
+	"\bGetIssue\x12#.nexusflow.issue.v1.GetIssueRequest\x1a$.nexusflow.issue.v1.GetIssueResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/issues/{id}\x12d\n" +
+	"\rGetIssueByKey\x12(.nexusflow.issue.v1.GetIssueByKeyRequest\x1a).nexusflow.issue.v1.GetIssueByKeyResponse\x12g\n" +
+	"\x0eBatchGetIssues\x12).nexusflow.issue.v1.BatchGetIssuesRequest\x1a*.nexusflow.issue.v1.BatchGetIssuesResponse\x12z\n" +
+	"\vUpdateIssue\x12&.nexusflow.issue.v1.UpdateIssueRequest\x1a'.nexusflow.issue.v1.UpdateIssueResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*2\x0f/v1/issues/{id}\x12w\n" +
+	"\vDeleteIssue\x12&.nexusflow.issue.v1.DeleteIssueRequest\x1a'.nexusflow.issue.v1.DeleteIssueResponse\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/v1/issues/{id}\x12\x85\x01\n" +
 	"\n" +
-	"ListIssues\x12%.nexusflow.issue.v1.ListIssuesRequest\x1a&.nexusflow.issue.v1.ListIssuesResponse\x12a\n" +
+	"ListIssues\x12%.nexusflow.issue.v1.ListIssuesRequest\x1a&.nexusflow.issue.v1.ListIssuesResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/projects/{project_id}/issues\x12\x99\x01\n" +
+	"\x11ListDeletedIssues\x12,.nexusflow.issue.v1.ListDeletedIssuesRequest\x1a-.nexusflow.issue.v1.ListDeletedIssuesResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/projects/{project_id}/trash\x12\x85\x01\n" +
+	"\fRestoreIssue\x12'.nexusflow.issue.v1.RestoreIssueRequest\x1a(.nexusflow.issue.v1.RestoreIssueResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/issues/{id}:restore\x12a\n" +
 	"\fSearchIssues\x12'.nexusflow.issue.v1.SearchIssuesRequest\x1a(.nexusflow.issue.v1.SearchIssuesResponse\x12m\n" +
 	"\x10GetIssueChildren\x12+.nexusflow.issue.v1.GetIssueChildrenRequest\x1a,.nexusflow.issue.v1.GetIssueChildrenResponse\x12X\n" +
 	"\tMoveIssue\x12$.nexusflow.issue.v1.MoveIssueRequest\x1a%.nexusflow.issue.v1.MoveIssueResponse\x12j\n" +
@@ -2850,7 +8742,46 @@ const file_proto_issue_v1_issue_proto_rawDesc = "" +
 	"\x11CreateCustomField\x12,.nexusflow.issue.v1.CreateCustomFieldRequest\x1a-.nexusflow.issue.v1.CreateCustomFieldResponse\x12p\n" +
 	"\x11UpdateCustomField\x12,.nexusflow.issue.v1.UpdateCustomFieldRequest\x1a-.nexusflow.issue.v1.UpdateCustomFieldResponse\x12p\n" +
 	"\x11DeleteCustomField\x12,.nexusflow.issue.v1.DeleteCustomFieldRequest\x1a-.nexusflow.issue.v1.DeleteCustomFieldResponse\x12m\n" +
-	"\x10ListCustomFields\x12+.nexusflow.issue.v1.ListCustomFieldsRequest\x1a,.nexusflow.issue.v1.ListCustomFieldsResponseB;Z9github.com/nexusflow/nexusflow/pkg/proto/issue/v1;issuev1b\x06proto3"
+	"\x10ListCustomFields\x12+.nexusflow.issue.v1.ListCustomFieldsRequest\x1a,.nexusflow.issue.v1.ListCustomFieldsResponse\x12\x85\x01\n" +
+	"\x18CreateCustomFieldContext\x123.nexusflow.issue.v1.CreateCustomFieldContextRequest\x1a4.nexusflow.issue.v1.CreateCustomFieldContextResponse\x12\x85\x01\n" +
+	"\x18UpdateCustomFieldContext\x123.nexusflow.issue.v1.UpdateCustomFieldContextRequest\x1a4.nexusflow.issue.v1.UpdateCustomFieldContextResponse\x12\x85\x01\n" +
+	"\x18DeleteCustomFieldContext\x123.nexusflow.issue.v1.DeleteCustomFieldContextRequest\x1a4.nexusflow.issue.v1.DeleteCustomFieldContextResponse\x12\x82\x01\n" +
+	"\x17ListCustomFieldContexts\x122.nexusflow.issue.v1.ListCustomFieldContextsRequest\x1a3.nexusflow.issue.v1.ListCustomFieldContextsResponse\x12^\n" +
+	"\vCreateLabel\x12&.nexusflow.issue.v1.CreateLabelRequest\x1a'.nexusflow.issue.v1.CreateLabelResponse\x12^\n" +
+	"\vUpdateLabel\x12&.nexusflow.issue.v1.UpdateLabelRequest\x1a'.nexusflow.issue.v1.UpdateLabelResponse\x12^\n" +
+	"\vDeleteLabel\x12&.nexusflow.issue.v1.DeleteLabelRequest\x1a'.nexusflow.issue.v1.DeleteLabelResponse\x12[\n" +
+	"\n" +
+	"ListLabels\x12%.nexusflow.issue.v1.ListLabelsRequest\x1a&.nexusflow.issue.v1.ListLabelsResponse\x12|\n" +
+	"\x15BulkUpdateIssueLabels\x120.nexusflow.issue.v1.BulkUpdateIssueLabelsRequest\x1a1.nexusflow.issue.v1.BulkUpdateIssueLabelsResponse\x12j\n" +
+	"\x0fCreateComponent\x12*.nexusflow.issue.v1.CreateComponentRequest\x1a+.nexusflow.issue.v1.CreateComponentResponse\x12j\n" +
+	"\x0fUpdateComponent\x12*.nexusflow.issue.v1.UpdateComponentRequest\x1a+.nexusflow.issue.v1.UpdateComponentResponse\x12j\n" +
+	"\x0fDeleteComponent\x12*.nexusflow.issue.v1.DeleteComponentRequest\x1a+.nexusflow.issue.v1.DeleteComponentResponse\x12g\n" +
+	"\x0eListComponents\x12).nexusflow.issue.v1.ListComponentsRequest\x1a*.nexusflow.issue.v1.ListComponentsResponse\x12\x88\x01\n" +
+	"\x19BulkUpdateIssueComponents\x124.nexusflow.issue.v1.BulkUpdateIssueComponentsRequest\x1a5.nexusflow.issue.v1.BulkUpdateIssueComponentsResponse\x12j\n" +
+	"\x0fSetIssuesSprint\x12*.nexusflow.issue.v1.SetIssuesSprintRequest\x1a+.nexusflow.issue.v1.SetIssuesSprintResponse\x12\x97\x01\n" +
+	"\x0fRankIssueBefore\x12*.nexusflow.issue.v1.RankIssueBeforeRequest\x1a+.nexusflow.issue.v1.RankIssueBeforeResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/issues/{issue_id}:rankBefore\x12\x93\x01\n" +
+	"\x0eRankIssueAfter\x12).nexusflow.issue.v1.RankIssueAfterRequest\x1a*.nexusflow.issue.v1.RankIssueAfterResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/issues/{issue_id}:rankAfter\x12w\n" +
+	"\n" +
+	"RankIssues\x12%.nexusflow.issue.v1.RankIssuesRequest\x1a&.nexusflow.issue.v1.RankIssuesResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/issues:rank\x12\x8f\x01\n" +
+	"\x10BulkUpdateIssues\x12+.nexusflow.issue.v1.BulkUpdateIssuesRequest\x1a,.nexusflow.issue.v1.BulkUpdateIssuesResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/issues:bulkUpdate\x12f\n" +
+	"\x06GetJob\x12!.nexusflow.issue.v1.GetJobRequest\x1a\".nexusflow.issue.v1.GetJobResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/jobs/{id}\x12\x86\x01\n" +
+	"\n" +
+	"AddWorklog\x12%.nexusflow.issue.v1.AddWorklogRequest\x1a&.nexusflow.issue.v1.AddWorklogResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/issues/{issue_id}/worklogs\x12\x82\x01\n" +
+	"\rUpdateWorklog\x12(.nexusflow.issue.v1.UpdateWorklogRequest\x1a).nexusflow.issue.v1.UpdateWorklogResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*2\x11/v1/worklogs/{id}\x12\x7f\n" +
+	"\rDeleteWorklog\x12(.nexusflow.issue.v1.DeleteWorklogRequest\x1a).nexusflow.issue.v1.DeleteWorklogResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/worklogs/{id}\x12\x89\x01\n" +
+	"\fListWorklogs\x12'.nexusflow.issue.v1.ListWorklogsRequest\x1a(.nexusflow.issue.v1.ListWorklogsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/issues/{issue_id}/worklogs\x12\x97\x01\n" +
+	"\x0fGetTimeTracking\x12*.nexusflow.issue.v1.GetTimeTrackingRequest\x1a+.nexusflow.issue.v1.GetTimeTrackingResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/issues/{issue_id}/time-tracking\x12y\n" +
+	"\fGetTimesheet\x12'.nexusflow.issue.v1.GetTimesheetRequest\x1a(.nexusflow.issue.v1.GetTimesheetResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/timesheets\x12\x96\x01\n" +
+	"\x13CreateIssueSchedule\x12..nexusflow.issue.v1.CreateIssueScheduleRequest\x1a/.nexusflow.issue.v1.CreateIssueScheduleResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/issue-schedules\x12\x8f\x01\n" +
+	"\x10GetIssueSchedule\x12+.nexusflow.issue.v1.GetIssueScheduleRequest\x1a,.nexusflow.issue.v1.GetIssueScheduleResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/issue-schedules/{id}\x12\xa2\x01\n" +
+	"\x13UpdateIssueSchedule\x12..nexusflow.issue.v1.UpdateIssueScheduleRequest\x1a/.nexusflow.issue.v1.UpdateIssueScheduleResponse\"*\x82\xd3\xe4\x93\x02$:\bschedule\x1a\x18/v1/issue-schedules/{id}\x12\x98\x01\n" +
+	"\x13DeleteIssueSchedule\x12..nexusflow.issue.v1.DeleteIssueScheduleRequest\x1a/.nexusflow.issue.v1.DeleteIssueScheduleResponse\" \x82\xd3\xe4\x93\x02\x1a*\x18/v1/issue-schedules/{id}\x12\xa6\x01\n" +
+	"\x12ListIssueSchedules\x12-.nexusflow.issue.v1.ListIssueSchedulesRequest\x1a..nexusflow.issue.v1.ListIssueSchedulesResponse\"1\x82\xd3\xe4\x93\x02+\x12)/v1/projects/{project_id}/issue-schedules\x12\x96\x01\n" +
+	"\x13CreateIssueTemplate\x12..nexusflow.issue.v1.CreateIssueTemplateRequest\x1a/.nexusflow.issue.v1.CreateIssueTemplateResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/issue-templates\x12\x8f\x01\n" +
+	"\x10GetIssueTemplate\x12+.nexusflow.issue.v1.GetIssueTemplateRequest\x1a,.nexusflow.issue.v1.GetIssueTemplateResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/issue-templates/{id}\x12\xa2\x01\n" +
+	"\x13UpdateIssueTemplate\x12..nexusflow.issue.v1.UpdateIssueTemplateRequest\x1a/.nexusflow.issue.v1.UpdateIssueTemplateResponse\"*\x82\xd3\xe4\x93\x02$:\btemplate\x1a\x18/v1/issue-templates/{id}\x12\x98\x01\n" +
+	"\x13DeleteIssueTemplate\x12..nexusflow.issue.v1.DeleteIssueTemplateRequest\x1a/.nexusflow.issue.v1.DeleteIssueTemplateResponse\" \x82\xd3\xe4\x93\x02\x1a*\x18/v1/issue-templates/{id}\x12\xa6\x01\n" +
+	"\x12ListIssueTemplates\x12-.nexusflow.issue.v1.ListIssueTemplatesRequest\x1a..nexusflow.issue.v1.ListIssueTemplatesResponse\"1\x82\xd3\xe4\x93\x02+\x12)/v1/projects/{project_id}/issue-templatesB;Z9github.com/nexusflow/nexusflow/pkg/proto/issue/v1;issuev1b\x06proto3"
 
 var (
 	file_proto_issue_v1_issue_proto_rawDescOnce sync.Once
@@ -2864,143 +8795,406 @@ func file_proto_issue_v1_issue_proto_rawDescGZIP() []byte {
 	return file_proto_issue_v1_issue_proto_rawDescData
 }
 
-var file_proto_issue_v1_issue_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_proto_issue_v1_issue_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_proto_issue_v1_issue_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_proto_issue_v1_issue_proto_msgTypes = make([]protoimpl.MessageInfo, 131)
 var file_proto_issue_v1_issue_proto_goTypes = []any{
-	(IssueType)(0),                    // 0: nexusflow.issue.v1.IssueType
-	(IssuePriority)(0),                // 1: nexusflow.issue.v1.IssuePriority
-	(CustomFieldType)(0),              // 2: nexusflow.issue.v1.CustomFieldType
-	(IssueLinkType)(0),                // 3: nexusflow.issue.v1.IssueLinkType
-	(*Issue)(nil),                     // 4: nexusflow.issue.v1.Issue
-	(*CustomField)(nil),               // 5: nexusflow.issue.v1.CustomField
-	(*CustomFieldValue)(nil),          // 6: nexusflow.issue.v1.CustomFieldValue
-	(*IssueLink)(nil),                 // 7: nexusflow.issue.v1.IssueLink
-	(*CreateIssueRequest)(nil),        // 8: nexusflow.issue.v1.CreateIssueRequest
-	(*CreateIssueResponse)(nil),       // 9: nexusflow.issue.v1.CreateIssueResponse
-	(*GetIssueRequest)(nil),           // 10: nexusflow.issue.v1.GetIssueRequest
-	(*GetIssueResponse)(nil),          // 11: nexusflow.issue.v1.GetIssueResponse
-	(*GetIssueByKeyRequest)(nil),      // 12: nexusflow.issue.v1.GetIssueByKeyRequest
-	(*GetIssueByKeyResponse)(nil),     // 13: nexusflow.issue.v1.GetIssueByKeyResponse
-	(*UpdateIssueRequest)(nil),        // 14: nexusflow.issue.v1.UpdateIssueRequest
-	(*UpdateIssueResponse)(nil),       // 15: nexusflow.issue.v1.UpdateIssueResponse
-	(*DeleteIssueRequest)(nil),        // 16: nexusflow.issue.v1.DeleteIssueRequest
-	(*DeleteIssueResponse)(nil),       // 17: nexusflow.issue.v1.DeleteIssueResponse
-	(*ListIssuesRequest)(nil),         // 18: nexusflow.issue.v1.ListIssuesRequest
-	(*ListIssuesResponse)(nil),        // 19: nexusflow.issue.v1.ListIssuesResponse
-	(*SearchIssuesRequest)(nil),       // 20: nexusflow.issue.v1.SearchIssuesRequest
-	(*SearchIssuesResponse)(nil),      // 21: nexusflow.issue.v1.SearchIssuesResponse
-	(*GetIssueChildrenRequest)(nil),   // 22: nexusflow.issue.v1.GetIssueChildrenRequest
-	(*GetIssueChildrenResponse)(nil),  // 23: nexusflow.issue.v1.GetIssueChildrenResponse
-	(*MoveIssueRequest)(nil),          // 24: nexusflow.issue.v1.MoveIssueRequest
-	(*MoveIssueResponse)(nil),         // 25: nexusflow.issue.v1.MoveIssueResponse
-	(*CreateIssueLinkRequest)(nil),    // 26: nexusflow.issue.v1.CreateIssueLinkRequest
-	(*CreateIssueLinkResponse)(nil),   // 27: nexusflow.issue.v1.CreateIssueLinkResponse
-	(*DeleteIssueLinkRequest)(nil),    // 28: nexusflow.issue.v1.DeleteIssueLinkRequest
-	(*DeleteIssueLinkResponse)(nil),   // 29: nexusflow.issue.v1.DeleteIssueLinkResponse
-	(*GetIssueLinksRequest)(nil),      // 30: nexusflow.issue.v1.GetIssueLinksRequest
-	(*GetIssueLinksResponse)(nil),     // 31: nexusflow.issue.v1.GetIssueLinksResponse
-	(*AddWatcherRequest)(nil),         // 32: nexusflow.issue.v1.AddWatcherRequest
-	(*AddWatcherResponse)(nil),        // 33: nexusflow.issue.v1.AddWatcherResponse
-	(*RemoveWatcherRequest)(nil),      // 34: nexusflow.issue.v1.RemoveWatcherRequest
-	(*RemoveWatcherResponse)(nil),     // 35: nexusflow.issue.v1.RemoveWatcherResponse
-	(*CreateCustomFieldRequest)(nil),  // 36: nexusflow.issue.v1.CreateCustomFieldRequest
-	(*CreateCustomFieldResponse)(nil), // 37: nexusflow.issue.v1.CreateCustomFieldResponse
-	(*UpdateCustomFieldRequest)(nil),  // 38: nexusflow.issue.v1.UpdateCustomFieldRequest
-	(*UpdateCustomFieldResponse)(nil), // 39: nexusflow.issue.v1.UpdateCustomFieldResponse
-	(*DeleteCustomFieldRequest)(nil),  // 40: nexusflow.issue.v1.DeleteCustomFieldRequest
-	(*DeleteCustomFieldResponse)(nil), // 41: nexusflow.issue.v1.DeleteCustomFieldResponse
-	(*ListCustomFieldsRequest)(nil),   // 42: nexusflow.issue.v1.ListCustomFieldsRequest
-	(*ListCustomFieldsResponse)(nil),  // 43: nexusflow.issue.v1.ListCustomFieldsResponse
-	nil,                               // 44: nexusflow.issue.v1.CustomField.ConfigEntry
-	(*timestamppb.Timestamp)(nil),     // 45: google.protobuf.Timestamp
-	(*anypb.Any)(nil),                 // 46: google.protobuf.Any
-	(*v1.SuccessResponse)(nil),        // 47: nexusflow.common.v1.SuccessResponse
-	(*v1.PaginationRequest)(nil),      // 48: nexusflow.common.v1.PaginationRequest
-	(*v1.PaginationResponse)(nil),     // 49: nexusflow.common.v1.PaginationResponse
+	(IssueType)(0),                            // 0: nexusflow.issue.v1.IssueType
+	(IssuePriority)(0),                        // 1: nexusflow.issue.v1.IssuePriority
+	(CustomFieldType)(0),                      // 2: nexusflow.issue.v1.CustomFieldType
+	(ScheduleCatchUp)(0),                      // 3: nexusflow.issue.v1.ScheduleCatchUp
+	(JobStatus)(0),                            // 4: nexusflow.issue.v1.JobStatus
+	(IssueLinkType)(0),                        // 5: nexusflow.issue.v1.IssueLinkType
+	(*Issue)(nil),                             // 6: nexusflow.issue.v1.Issue
+	(*CustomField)(nil),                       // 7: nexusflow.issue.v1.CustomField
+	(*CustomFieldContext)(nil),                // 8: nexusflow.issue.v1.CustomFieldContext
+	(*Component)(nil),                         // 9: nexusflow.issue.v1.Component
+	(*CustomFieldValue)(nil),                  // 10: nexusflow.issue.v1.CustomFieldValue
+	(*Job)(nil),                               // 11: nexusflow.issue.v1.Job
+	(*Worklog)(nil),                           // 12: nexusflow.issue.v1.Worklog
+	(*TimeTracking)(nil),                      // 13: nexusflow.issue.v1.TimeTracking
+	(*IssueSchedule)(nil),                     // 14: nexusflow.issue.v1.IssueSchedule
+	(*IssueTemplate)(nil),                     // 15: nexusflow.issue.v1.IssueTemplate
+	(*TemplateSubTask)(nil),                   // 16: nexusflow.issue.v1.TemplateSubTask
+	(*JobItemError)(nil),                      // 17: nexusflow.issue.v1.JobItemError
+	(*IssueLink)(nil),                         // 18: nexusflow.issue.v1.IssueLink
+	(*CreateIssueRequest)(nil),                // 19: nexusflow.issue.v1.CreateIssueRequest
+	(*CreateIssueResponse)(nil),               // 20: nexusflow.issue.v1.CreateIssueResponse
+	(*GetIssueRequest)(nil),                   // 21: nexusflow.issue.v1.GetIssueRequest
+	(*GetIssueResponse)(nil),                  // 22: nexusflow.issue.v1.GetIssueResponse
+	(*GetIssueByKeyRequest)(nil),              // 23: nexusflow.issue.v1.GetIssueByKeyRequest
+	(*GetIssueByKeyResponse)(nil),             // 24: nexusflow.issue.v1.GetIssueByKeyResponse
+	(*BatchGetIssuesRequest)(nil),             // 25: nexusflow.issue.v1.BatchGetIssuesRequest
+	(*BatchGetIssuesResponse)(nil),            // 26: nexusflow.issue.v1.BatchGetIssuesResponse
+	(*UpdateIssueRequest)(nil),                // 27: nexusflow.issue.v1.UpdateIssueRequest
+	(*UpdateIssueResponse)(nil),               // 28: nexusflow.issue.v1.UpdateIssueResponse
+	(*DeleteIssueRequest)(nil),                // 29: nexusflow.issue.v1.DeleteIssueRequest
+	(*DeleteIssueResponse)(nil),               // 30: nexusflow.issue.v1.DeleteIssueResponse
+	(*ListDeletedIssuesRequest)(nil),          // 31: nexusflow.issue.v1.ListDeletedIssuesRequest
+	(*ListDeletedIssuesResponse)(nil),         // 32: nexusflow.issue.v1.ListDeletedIssuesResponse
+	(*RestoreIssueRequest)(nil),               // 33: nexusflow.issue.v1.RestoreIssueRequest
+	(*RestoreIssueResponse)(nil),              // 34: nexusflow.issue.v1.RestoreIssueResponse
+	(*ListIssuesRequest)(nil),                 // 35: nexusflow.issue.v1.ListIssuesRequest
+	(*CustomFieldFilter)(nil),                 // 36: nexusflow.issue.v1.CustomFieldFilter
+	(*ListIssuesResponse)(nil),                // 37: nexusflow.issue.v1.ListIssuesResponse
+	(*SearchIssuesRequest)(nil),               // 38: nexusflow.issue.v1.SearchIssuesRequest
+	(*SearchIssuesResponse)(nil),              // 39: nexusflow.issue.v1.SearchIssuesResponse
+	(*GetIssueChildrenRequest)(nil),           // 40: nexusflow.issue.v1.GetIssueChildrenRequest
+	(*GetIssueChildrenResponse)(nil),          // 41: nexusflow.issue.v1.GetIssueChildrenResponse
+	(*MoveIssueRequest)(nil),                  // 42: nexusflow.issue.v1.MoveIssueRequest
+	(*MoveIssueResponse)(nil),                 // 43: nexusflow.issue.v1.MoveIssueResponse
+	(*CreateIssueLinkRequest)(nil),            // 44: nexusflow.issue.v1.CreateIssueLinkRequest
+	(*CreateIssueLinkResponse)(nil),           // 45: nexusflow.issue.v1.CreateIssueLinkResponse
+	(*DeleteIssueLinkRequest)(nil),            // 46: nexusflow.issue.v1.DeleteIssueLinkRequest
+	(*DeleteIssueLinkResponse)(nil),           // 47: nexusflow.issue.v1.DeleteIssueLinkResponse
+	(*GetIssueLinksRequest)(nil),              // 48: nexusflow.issue.v1.GetIssueLinksRequest
+	(*GetIssueLinksResponse)(nil),             // 49: nexusflow.issue.v1.GetIssueLinksResponse
+	(*AddWatcherRequest)(nil),                 // 50: nexusflow.issue.v1.AddWatcherRequest
+	(*AddWatcherResponse)(nil),                // 51: nexusflow.issue.v1.AddWatcherResponse
+	(*RemoveWatcherRequest)(nil),              // 52: nexusflow.issue.v1.RemoveWatcherRequest
+	(*RemoveWatcherResponse)(nil),             // 53: nexusflow.issue.v1.RemoveWatcherResponse
+	(*CreateCustomFieldRequest)(nil),          // 54: nexusflow.issue.v1.CreateCustomFieldRequest
+	(*CreateCustomFieldResponse)(nil),         // 55: nexusflow.issue.v1.CreateCustomFieldResponse
+	(*UpdateCustomFieldRequest)(nil),          // 56: nexusflow.issue.v1.UpdateCustomFieldRequest
+	(*UpdateCustomFieldResponse)(nil),         // 57: nexusflow.issue.v1.UpdateCustomFieldResponse
+	(*DeleteCustomFieldRequest)(nil),          // 58: nexusflow.issue.v1.DeleteCustomFieldRequest
+	(*DeleteCustomFieldResponse)(nil),         // 59: nexusflow.issue.v1.DeleteCustomFieldResponse
+	(*ListCustomFieldsRequest)(nil),           // 60: nexusflow.issue.v1.ListCustomFieldsRequest
+	(*ListCustomFieldsResponse)(nil),          // 61: nexusflow.issue.v1.ListCustomFieldsResponse
+	(*CreateCustomFieldContextRequest)(nil),   // 62: nexusflow.issue.v1.CreateCustomFieldContextRequest
+	(*CreateCustomFieldContextResponse)(nil),  // 63: nexusflow.issue.v1.CreateCustomFieldContextResponse
+	(*UpdateCustomFieldContextRequest)(nil),   // 64: nexusflow.issue.v1.UpdateCustomFieldContextRequest
+	(*UpdateCustomFieldContextResponse)(nil),  // 65: nexusflow.issue.v1.UpdateCustomFieldContextResponse
+	(*DeleteCustomFieldContextRequest)(nil),   // 66: nexusflow.issue.v1.DeleteCustomFieldContextRequest
+	(*DeleteCustomFieldContextResponse)(nil),  // 67: nexusflow.issue.v1.DeleteCustomFieldContextResponse
+	(*ListCustomFieldContextsRequest)(nil),    // 68: nexusflow.issue.v1.ListCustomFieldContextsRequest
+	(*ListCustomFieldContextsResponse)(nil),   // 69: nexusflow.issue.v1.ListCustomFieldContextsResponse
+	(*CreateLabelRequest)(nil),                // 70: nexusflow.issue.v1.CreateLabelRequest
+	(*CreateLabelResponse)(nil),               // 71: nexusflow.issue.v1.CreateLabelResponse
+	(*UpdateLabelRequest)(nil),                // 72: nexusflow.issue.v1.UpdateLabelRequest
+	(*UpdateLabelResponse)(nil),               // 73: nexusflow.issue.v1.UpdateLabelResponse
+	(*DeleteLabelRequest)(nil),                // 74: nexusflow.issue.v1.DeleteLabelRequest
+	(*DeleteLabelResponse)(nil),               // 75: nexusflow.issue.v1.DeleteLabelResponse
+	(*ListLabelsRequest)(nil),                 // 76: nexusflow.issue.v1.ListLabelsRequest
+	(*ListLabelsResponse)(nil),                // 77: nexusflow.issue.v1.ListLabelsResponse
+	(*BulkUpdateIssueLabelsRequest)(nil),      // 78: nexusflow.issue.v1.BulkUpdateIssueLabelsRequest
+	(*BulkUpdateIssueLabelsResponse)(nil),     // 79: nexusflow.issue.v1.BulkUpdateIssueLabelsResponse
+	(*CreateComponentRequest)(nil),            // 80: nexusflow.issue.v1.CreateComponentRequest
+	(*CreateComponentResponse)(nil),           // 81: nexusflow.issue.v1.CreateComponentResponse
+	(*UpdateComponentRequest)(nil),            // 82: nexusflow.issue.v1.UpdateComponentRequest
+	(*UpdateComponentResponse)(nil),           // 83: nexusflow.issue.v1.UpdateComponentResponse
+	(*DeleteComponentRequest)(nil),            // 84: nexusflow.issue.v1.DeleteComponentRequest
+	(*DeleteComponentResponse)(nil),           // 85: nexusflow.issue.v1.DeleteComponentResponse
+	(*ListComponentsRequest)(nil),             // 86: nexusflow.issue.v1.ListComponentsRequest
+	(*ListComponentsResponse)(nil),            // 87: nexusflow.issue.v1.ListComponentsResponse
+	(*BulkUpdateIssueComponentsRequest)(nil),  // 88: nexusflow.issue.v1.BulkUpdateIssueComponentsRequest
+	(*BulkUpdateIssueComponentsResponse)(nil), // 89: nexusflow.issue.v1.BulkUpdateIssueComponentsResponse
+	(*SetIssuesSprintRequest)(nil),            // 90: nexusflow.issue.v1.SetIssuesSprintRequest
+	(*SetIssuesSprintResponse)(nil),           // 91: nexusflow.issue.v1.SetIssuesSprintResponse
+	(*RankIssueBeforeRequest)(nil),            // 92: nexusflow.issue.v1.RankIssueBeforeRequest
+	(*RankIssueBeforeResponse)(nil),           // 93: nexusflow.issue.v1.RankIssueBeforeResponse
+	(*RankIssueAfterRequest)(nil),             // 94: nexusflow.issue.v1.RankIssueAfterRequest
+	(*RankIssueAfterResponse)(nil),            // 95: nexusflow.issue.v1.RankIssueAfterResponse
+	(*RankIssuesRequest)(nil),                 // 96: nexusflow.issue.v1.RankIssuesRequest
+	(*RankIssuesResponse)(nil),                // 97: nexusflow.issue.v1.RankIssuesResponse
+	(*BulkUpdateIssuesRequest)(nil),           // 98: nexusflow.issue.v1.BulkUpdateIssuesRequest
+	(*BulkUpdateIssuesResponse)(nil),          // 99: nexusflow.issue.v1.BulkUpdateIssuesResponse
+	(*GetJobRequest)(nil),                     // 100: nexusflow.issue.v1.GetJobRequest
+	(*GetJobResponse)(nil),                    // 101: nexusflow.issue.v1.GetJobResponse
+	(*AddWorklogRequest)(nil),                 // 102: nexusflow.issue.v1.AddWorklogRequest
+	(*AddWorklogResponse)(nil),                // 103: nexusflow.issue.v1.AddWorklogResponse
+	(*UpdateWorklogRequest)(nil),              // 104: nexusflow.issue.v1.UpdateWorklogRequest
+	(*UpdateWorklogResponse)(nil),             // 105: nexusflow.issue.v1.UpdateWorklogResponse
+	(*DeleteWorklogRequest)(nil),              // 106: nexusflow.issue.v1.DeleteWorklogRequest
+	(*DeleteWorklogResponse)(nil),             // 107: nexusflow.issue.v1.DeleteWorklogResponse
+	(*ListWorklogsRequest)(nil),               // 108: nexusflow.issue.v1.ListWorklogsRequest
+	(*ListWorklogsResponse)(nil),              // 109: nexusflow.issue.v1.ListWorklogsResponse
+	(*GetTimeTrackingRequest)(nil),            // 110: nexusflow.issue.v1.GetTimeTrackingRequest
+	(*GetTimeTrackingResponse)(nil),           // 111: nexusflow.issue.v1.GetTimeTrackingResponse
+	(*GetTimesheetRequest)(nil),               // 112: nexusflow.issue.v1.GetTimesheetRequest
+	(*TimesheetTotal)(nil),                    // 113: nexusflow.issue.v1.TimesheetTotal
+	(*GetTimesheetResponse)(nil),              // 114: nexusflow.issue.v1.GetTimesheetResponse
+	(*CreateIssueScheduleRequest)(nil),        // 115: nexusflow.issue.v1.CreateIssueScheduleRequest
+	(*CreateIssueScheduleResponse)(nil),       // 116: nexusflow.issue.v1.CreateIssueScheduleResponse
+	(*GetIssueScheduleRequest)(nil),           // 117: nexusflow.issue.v1.GetIssueScheduleRequest
+	(*GetIssueScheduleResponse)(nil),          // 118: nexusflow.issue.v1.GetIssueScheduleResponse
+	(*UpdateIssueScheduleRequest)(nil),        // 119: nexusflow.issue.v1.UpdateIssueScheduleRequest
+	(*UpdateIssueScheduleResponse)(nil),       // 120: nexusflow.issue.v1.UpdateIssueScheduleResponse
+	(*DeleteIssueScheduleRequest)(nil),        // 121: nexusflow.issue.v1.DeleteIssueScheduleRequest
+	(*DeleteIssueScheduleResponse)(nil),       // 122: nexusflow.issue.v1.DeleteIssueScheduleResponse
+	(*ListIssueSchedulesRequest)(nil),         // 123: nexusflow.issue.v1.ListIssueSchedulesRequest
+	(*ListIssueSchedulesResponse)(nil),        // 124: nexusflow.issue.v1.ListIssueSchedulesResponse
+	(*CreateIssueTemplateRequest)(nil),        // 125: nexusflow.issue.v1.CreateIssueTemplateRequest
+	(*CreateIssueTemplateResponse)(nil),       // 126: nexusflow.issue.v1.CreateIssueTemplateResponse
+	(*GetIssueTemplateRequest)(nil),           // 127: nexusflow.issue.v1.GetIssueTemplateRequest
+	(*GetIssueTemplateResponse)(nil),          // 128: nexusflow.issue.v1.GetIssueTemplateResponse
+	(*UpdateIssueTemplateRequest)(nil),        // 129: nexusflow.issue.v1.UpdateIssueTemplateRequest
+	(*UpdateIssueTemplateResponse)(nil),       // 130: nexusflow.issue.v1.UpdateIssueTemplateResponse
+	(*DeleteIssueTemplateRequest)(nil),        // 131: nexusflow.issue.v1.DeleteIssueTemplateRequest
+	(*DeleteIssueTemplateResponse)(nil),       // 132: nexusflow.issue.v1.DeleteIssueTemplateResponse
+	(*ListIssueTemplatesRequest)(nil),         // 133: nexusflow.issue.v1.ListIssueTemplatesRequest
+	(*ListIssueTemplatesResponse)(nil),        // 134: nexusflow.issue.v1.ListIssueTemplatesResponse
+	nil,                                       // 135: nexusflow.issue.v1.CustomField.ConfigEntry
+	nil,                                       // 136: nexusflow.issue.v1.CreateCustomFieldRequest.ConfigEntry
+	(*timestamppb.Timestamp)(nil),             // 137: google.protobuf.Timestamp
+	(*anypb.Any)(nil),                         // 138: google.protobuf.Any
+	(*v1.SuccessResponse)(nil),                // 139: nexusflow.common.v1.SuccessResponse
+	(*v1.PaginationRequest)(nil),              // 140: nexusflow.common.v1.PaginationRequest
+	(*v1.PaginationResponse)(nil),             // 141: nexusflow.common.v1.PaginationResponse
+	(*v1.Label)(nil),                          // 142: nexusflow.common.v1.Label
 }
 var file_proto_issue_v1_issue_proto_depIdxs = []int32{
-	0,  // 0: nexusflow.issue.v1.Issue.type:type_name -> nexusflow.issue.v1.IssueType
-	1,  // 1: nexusflow.issue.v1.Issue.priority:type_name -> nexusflow.issue.v1.IssuePriority
-	6,  // 2: nexusflow.issue.v1.Issue.custom_fields:type_name -> nexusflow.issue.v1.CustomFieldValue
-	45, // 3: nexusflow.issue.v1.Issue.created_at:type_name -> google.protobuf.Timestamp
-	45, // 4: nexusflow.issue.v1.Issue.updated_at:type_name -> google.protobuf.Timestamp
-	45, // 5: nexusflow.issue.v1.Issue.due_date:type_name -> google.protobuf.Timestamp
-	2,  // 6: nexusflow.issue.v1.CustomField.type:type_name -> nexusflow.issue.v1.CustomFieldType
-	46, // 7: nexusflow.issue.v1.CustomField.default_value:type_name -> google.protobuf.Any
-	44, // 8: nexusflow.issue.v1.CustomField.config:type_name -> nexusflow.issue.v1.CustomField.ConfigEntry
-	46, // 9: nexusflow.issue.v1.CustomFieldValue.value:type_name -> google.protobuf.Any
-	3,  // 10: nexusflow.issue.v1.IssueLink.type:type_name -> nexusflow.issue.v1.IssueLinkType
-	0,  // 11: nexusflow.issue.v1.CreateIssueRequest.type:type_name -> nexusflow.issue.v1.IssueType
-	1,  // 12: nexusflow.issue.v1.CreateIssueRequest.priority:type_name -> nexusflow.issue.v1.IssuePriority
-	6,  // 13: nexusflow.issue.v1.CreateIssueRequest.custom_fields:type_name -> nexusflow.issue.v1.CustomFieldValue
-	4,  // 14: nexusflow.issue.v1.CreateIssueResponse.issue:type_name -> nexusflow.issue.v1.Issue
-	4,  // 15: nexusflow.issue.v1.GetIssueResponse.issue:type_name -> nexusflow.issue.v1.Issue
-	4,  // 16: nexusflow.issue.v1.GetIssueByKeyResponse.issue:type_name -> nexusflow.issue.v1.Issue
-	1,  // 17: nexusflow.issue.v1.UpdateIssueRequest.priority:type_name -> nexusflow.issue.v1.IssuePriority
-	6,  // 18: nexusflow.issue.v1.UpdateIssueRequest.custom_fields:type_name -> nexusflow.issue.v1.CustomFieldValue
-	4,  // 19: nexusflow.issue.v1.UpdateIssueResponse.issue:type_name -> nexusflow.issue.v1.Issue
-	47, // 20: nexusflow.issue.v1.DeleteIssueResponse.response:type_name -> nexusflow.common.v1.SuccessResponse
-	48, // 21: nexusflow.issue.v1.ListIssuesRequest.pagination:type_name -> nexusflow.common.v1.PaginationRequest
-	0,  // 22: nexusflow.issue.v1.ListIssuesRequest.type:type_name -> nexusflow.issue.v1.IssueType
-	4,  // 23: nexusflow.issue.v1.ListIssuesResponse.issues:type_name -> nexusflow.issue.v1.Issue
-	49, // 24: nexusflow.issue.v1.ListIssuesResponse.pagination:type_name -> nexusflow.common.v1.PaginationResponse
-	48, // 25: nexusflow.issue.v1.SearchIssuesRequest.pagination:type_name -> nexusflow.common.v1.PaginationRequest
-	4,  // 26: nexusflow.issue.v1.SearchIssuesResponse.issues:type_name -> nexusflow.issue.v1.Issue
-	49, // 27: nexusflow.issue.v1.SearchIssuesResponse.pagination:type_name -> nexusflow.common.v1.PaginationResponse
-	4,  // 28: nexusflow.issue.v1.GetIssueChildrenResponse.children:type_name -> nexusflow.issue.v1.Issue
-	4,  // 29: nexusflow.issue.v1.MoveIssueResponse.issue:type_name -> nexusflow.issue.v1.Issue
-	3,  // 30: nexusflow.issue.v1.CreateIssueLinkRequest.type:type_name -> nexusflow.issue.v1.IssueLinkType
-	7,  // 31: nexusflow.issue.v1.CreateIssueLinkResponse.link:type_name -> nexusflow.issue.v1.IssueLink
-	47, // 32: nexusflow.issue.v1.DeleteIssueLinkResponse.response:type_name -> nexusflow.common.v1.SuccessResponse
-	7,  // 33: nexusflow.issue.v1.GetIssueLinksResponse.links:type_name -> nexusflow.issue.v1.IssueLink
-	4,  // 34: nexusflow.issue.v1.AddWatcherResponse.issue:type_name -> nexusflow.issue.v1.Issue
-	4,  // 35: nexusflow.issue.v1.RemoveWatcherResponse.issue:type_name -> nexusflow.issue.v1.Issue
-	2,  // 36: nexusflow.issue.v1.CreateCustomFieldRequest.type:type_name -> nexusflow.issue.v1.CustomFieldType
-	5,  // 37: nexusflow.issue.v1.CreateCustomFieldResponse.field:type_name -> nexusflow.issue.v1.CustomField
-	5,  // 38: nexusflow.issue.v1.UpdateCustomFieldResponse.field:type_name -> nexusflow.issue.v1.CustomField
-	47, // 39: nexusflow.issue.v1.DeleteCustomFieldResponse.response:type_name -> nexusflow.common.v1.SuccessResponse
-	5,  // 40: nexusflow.issue.v1.ListCustomFieldsResponse.fields:type_name -> nexusflow.issue.v1.CustomField
-	8,  // 41: nexusflow.issue.v1.IssueService.CreateIssue:input_type -> nexusflow.issue.v1.CreateIssueRequest
-	10, // 42: nexusflow.issue.v1.IssueService.GetIssue:input_type -> nexusflow.issue.v1.GetIssueRequest
-	12, // 43: nexusflow.issue.v1.IssueService.GetIssueByKey:input_type -> nexusflow.issue.v1.GetIssueByKeyRequest
-	14, // 44: nexusflow.issue.v1.IssueService.UpdateIssue:input_type -> nexusflow.issue.v1.UpdateIssueRequest
-	16, // 45: nexusflow.issue.v1.IssueService.DeleteIssue:input_type -> nexusflow.issue.v1.DeleteIssueRequest
-	18, // 46: nexusflow.issue.v1.IssueService.ListIssues:input_type -> nexusflow.issue.v1.ListIssuesRequest
-	20, // 47: nexusflow.issue.v1.IssueService.SearchIssues:input_type -> nexusflow.issue.v1.SearchIssuesRequest
-	22, // 48: nexusflow.issue.v1.IssueService.GetIssueChildren:input_type -> nexusflow.issue.v1.GetIssueChildrenRequest
-	24, // 49: nexusflow.issue.v1.IssueService.MoveIssue:input_type -> nexusflow.issue.v1.MoveIssueRequest
-	26, // 50: nexusflow.issue.v1.IssueService.CreateIssueLink:input_type -> nexusflow.issue.v1.CreateIssueLinkRequest
-	28, // 51: nexusflow.issue.v1.IssueService.DeleteIssueLink:input_type -> nexusflow.issue.v1.DeleteIssueLinkRequest
-	30, // 52: nexusflow.issue.v1.IssueService.GetIssueLinks:input_type -> nexusflow.issue.v1.GetIssueLinksRequest
-	32, // 53: nexusflow.issue.v1.IssueService.AddWatcher:input_type -> nexusflow.issue.v1.AddWatcherRequest
-	34, // 54: nexusflow.issue.v1.IssueService.RemoveWatcher:input_type -> nexusflow.issue.v1.RemoveWatcherRequest
-	36, // 55: nexusflow.issue.v1.IssueService.CreateCustomField:input_type -> nexusflow.issue.v1.CreateCustomFieldRequest
-	38, // 56: nexusflow.issue.v1.IssueService.UpdateCustomField:input_type -> nexusflow.issue.v1.UpdateCustomFieldRequest
-	40, // 57: nexusflow.issue.v1.IssueService.DeleteCustomField:input_type -> nexusflow.issue.v1.DeleteCustomFieldRequest
-	42, // 58: nexusflow.issue.v1.IssueService.ListCustomFields:input_type -> nexusflow.issue.v1.ListCustomFieldsRequest
-	9,  // 59: nexusflow.issue.v1.IssueService.CreateIssue:output_type -> nexusflow.issue.v1.CreateIssueResponse
-	11, // 60: nexusflow.issue.v1.IssueService.GetIssue:output_type -> nexusflow.issue.v1.GetIssueResponse
-	13, // 61: nexusflow.issue.v1.IssueService.GetIssueByKey:output_type -> nexusflow.issue.v1.GetIssueByKeyResponse
-	15, // 62: nexusflow.issue.v1.IssueService.UpdateIssue:output_type -> nexusflow.issue.v1.UpdateIssueResponse
-	17, // 63: nexusflow.issue.v1.IssueService.DeleteIssue:output_type -> nexusflow.issue.v1.DeleteIssueResponse
-	19, // 64: nexusflow.issue.v1.IssueService.ListIssues:output_type -> nexusflow.issue.v1.ListIssuesResponse
-	21, // 65: nexusflow.issue.v1.IssueService.SearchIssues:output_type -> nexusflow.issue.v1.SearchIssuesResponse
-	23, // 66: nexusflow.issue.v1.IssueService.GetIssueChildren:output_type -> nexusflow.issue.v1.GetIssueChildrenResponse
-	25, // 67: nexusflow.issue.v1.IssueService.MoveIssue:output_type -> nexusflow.issue.v1.MoveIssueResponse
-	27, // 68: nexusflow.issue.v1.IssueService.CreateIssueLink:output_type -> nexusflow.issue.v1.CreateIssueLinkResponse
-	29, // 69: nexusflow.issue.v1.IssueService.DeleteIssueLink:output_type -> nexusflow.issue.v1.DeleteIssueLinkResponse
-	31, // 70: nexusflow.issue.v1.IssueService.GetIssueLinks:output_type -> nexusflow.issue.v1.GetIssueLinksResponse
-	33, // 71: nexusflow.issue.v1.IssueService.AddWatcher:output_type -> nexusflow.issue.v1.AddWatcherResponse
-	35, // 72: nexusflow.issue.v1.IssueService.RemoveWatcher:output_type -> nexusflow.issue.v1.RemoveWatcherResponse
-	37, // 73: nexusflow.issue.v1.IssueService.CreateCustomField:output_type -> nexusflow.issue.v1.CreateCustomFieldResponse
-	39, // 74: nexusflow.issue.v1.IssueService.UpdateCustomField:output_type -> nexusflow.issue.v1.UpdateCustomFieldResponse
-	41, // 75: nexusflow.issue.v1.IssueService.DeleteCustomField:output_type -> nexusflow.issue.v1.DeleteCustomFieldResponse
-	43, // 76: nexusflow.issue.v1.IssueService.ListCustomFields:output_type -> nexusflow.issue.v1.ListCustomFieldsResponse
-	59, // [59:77] is the sub-list for method output_type
-	41, // [41:59] is the sub-list for method input_type
-	41, // [41:41] is the sub-list for extension type_name
-	41, // [41:41] is the sub-list for extension extendee
-	0,  // [0:41] is the sub-list for field type_name
+	0,   // 0: nexusflow.issue.v1.Issue.type:type_name -> nexusflow.issue.v1.IssueType
+	1,   // 1: nexusflow.issue.v1.Issue.priority:type_name -> nexusflow.issue.v1.IssuePriority
+	10,  // 2: nexusflow.issue.v1.Issue.custom_fields:type_name -> nexusflow.issue.v1.CustomFieldValue
+	137, // 3: nexusflow.issue.v1.Issue.created_at:type_name -> google.protobuf.Timestamp
+	137, // 4: nexusflow.issue.v1.Issue.updated_at:type_name -> google.protobuf.Timestamp
+	137, // 5: nexusflow.issue.v1.Issue.due_date:type_name -> google.protobuf.Timestamp
+	137, // 6: nexusflow.issue.v1.Issue.deleted_at:type_name -> google.protobuf.Timestamp
+	137, // 7: nexusflow.issue.v1.Issue.scheduled_for:type_name -> google.protobuf.Timestamp
+	2,   // 8: nexusflow.issue.v1.CustomField.type:type_name -> nexusflow.issue.v1.CustomFieldType
+	138, // 9: nexusflow.issue.v1.CustomField.default_value:type_name -> google.protobuf.Any
+	135, // 10: nexusflow.issue.v1.CustomField.config:type_name -> nexusflow.issue.v1.CustomField.ConfigEntry
+	0,   // 11: nexusflow.issue.v1.CustomFieldContext.issue_types:type_name -> nexusflow.issue.v1.IssueType
+	138, // 12: nexusflow.issue.v1.CustomFieldContext.default_value:type_name -> google.protobuf.Any
+	138, // 13: nexusflow.issue.v1.CustomFieldValue.value:type_name -> google.protobuf.Any
+	4,   // 14: nexusflow.issue.v1.Job.status:type_name -> nexusflow.issue.v1.JobStatus
+	17,  // 15: nexusflow.issue.v1.Job.errors:type_name -> nexusflow.issue.v1.JobItemError
+	137, // 16: nexusflow.issue.v1.Job.created_at:type_name -> google.protobuf.Timestamp
+	137, // 17: nexusflow.issue.v1.Job.started_at:type_name -> google.protobuf.Timestamp
+	137, // 18: nexusflow.issue.v1.Job.finished_at:type_name -> google.protobuf.Timestamp
+	137, // 19: nexusflow.issue.v1.Worklog.started_at:type_name -> google.protobuf.Timestamp
+	137, // 20: nexusflow.issue.v1.Worklog.created_at:type_name -> google.protobuf.Timestamp
+	137, // 21: nexusflow.issue.v1.Worklog.updated_at:type_name -> google.protobuf.Timestamp
+	0,   // 22: nexusflow.issue.v1.IssueSchedule.type:type_name -> nexusflow.issue.v1.IssueType
+	1,   // 23: nexusflow.issue.v1.IssueSchedule.priority:type_name -> nexusflow.issue.v1.IssuePriority
+	10,  // 24: nexusflow.issue.v1.IssueSchedule.custom_fields:type_name -> nexusflow.issue.v1.CustomFieldValue
+	137, // 25: nexusflow.issue.v1.IssueSchedule.starts_at:type_name -> google.protobuf.Timestamp
+	137, // 26: nexusflow.issue.v1.IssueSchedule.ends_at:type_name -> google.protobuf.Timestamp
+	3,   // 27: nexusflow.issue.v1.IssueSchedule.catch_up:type_name -> nexusflow.issue.v1.ScheduleCatchUp
+	137, // 28: nexusflow.issue.v1.IssueSchedule.next_run_at:type_name -> google.protobuf.Timestamp
+	137, // 29: nexusflow.issue.v1.IssueSchedule.last_run_at:type_name -> google.protobuf.Timestamp
+	137, // 30: nexusflow.issue.v1.IssueSchedule.created_at:type_name -> google.protobuf.Timestamp
+	137, // 31: nexusflow.issue.v1.IssueSchedule.updated_at:type_name -> google.protobuf.Timestamp
+	0,   // 32: nexusflow.issue.v1.IssueTemplate.issue_type:type_name -> nexusflow.issue.v1.IssueType
+	1,   // 33: nexusflow.issue.v1.IssueTemplate.priority:type_name -> nexusflow.issue.v1.IssuePriority
+	10,  // 34: nexusflow.issue.v1.IssueTemplate.custom_fields:type_name -> nexusflow.issue.v1.CustomFieldValue
+	16,  // 35: nexusflow.issue.v1.IssueTemplate.sub_tasks:type_name -> nexusflow.issue.v1.TemplateSubTask
+	137, // 36: nexusflow.issue.v1.IssueTemplate.created_at:type_name -> google.protobuf.Timestamp
+	137, // 37: nexusflow.issue.v1.IssueTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	5,   // 38: nexusflow.issue.v1.IssueLink.type:type_name -> nexusflow.issue.v1.IssueLinkType
+	0,   // 39: nexusflow.issue.v1.CreateIssueRequest.type:type_name -> nexusflow.issue.v1.IssueType
+	1,   // 40: nexusflow.issue.v1.CreateIssueRequest.priority:type_name -> nexusflow.issue.v1.IssuePriority
+	10,  // 41: nexusflow.issue.v1.CreateIssueRequest.custom_fields:type_name -> nexusflow.issue.v1.CustomFieldValue
+	6,   // 42: nexusflow.issue.v1.CreateIssueResponse.issue:type_name -> nexusflow.issue.v1.Issue
+	6,   // 43: nexusflow.issue.v1.GetIssueResponse.issue:type_name -> nexusflow.issue.v1.Issue
+	6,   // 44: nexusflow.issue.v1.GetIssueByKeyResponse.issue:type_name -> nexusflow.issue.v1.Issue
+	6,   // 45: nexusflow.issue.v1.BatchGetIssuesResponse.issues:type_name -> nexusflow.issue.v1.Issue
+	1,   // 46: nexusflow.issue.v1.UpdateIssueRequest.priority:type_name -> nexusflow.issue.v1.IssuePriority
+	10,  // 47: nexusflow.issue.v1.UpdateIssueRequest.custom_fields:type_name -> nexusflow.issue.v1.CustomFieldValue
+	6,   // 48: nexusflow.issue.v1.UpdateIssueResponse.issue:type_name -> nexusflow.issue.v1.Issue
+	139, // 49: nexusflow.issue.v1.DeleteIssueResponse.response:type_name -> nexusflow.common.v1.SuccessResponse
+	140, // 50: nexusflow.issue.v1.ListDeletedIssuesRequest.pagination:type_name -> nexusflow.common.v1.PaginationRequest
+	6,   // 51: nexusflow.issue.v1.ListDeletedIssuesResponse.issues:type_name -> nexusflow.issue.v1.Issue
+	141, // 52: nexusflow.issue.v1.ListDeletedIssuesResponse.pagination:type_name -> nexusflow.common.v1.PaginationResponse
+	6,   // 53: nexusflow.issue.v1.RestoreIssueResponse.issue:type_name -> nexusflow.issue.v1.Issue
+	140, // 54: nexusflow.issue.v1.ListIssuesRequest.pagination:type_name -> nexusflow.common.v1.PaginationRequest
+	0,   // 55: nexusflow.issue.v1.ListIssuesRequest.type:type_name -> nexusflow.issue.v1.IssueType
+	1,   // 56: nexusflow.issue.v1.ListIssuesRequest.priorities:type_name -> nexusflow.issue.v1.IssuePriority
+	137, // 57: nexusflow.issue.v1.ListIssuesRequest.due_after:type_name -> google.protobuf.Timestamp
+	137, // 58: nexusflow.issue.v1.ListIssuesRequest.due_before:type_name -> google.protobuf.Timestamp
+	137, // 59: nexusflow.issue.v1.ListIssuesRequest.updated_since:type_name -> google.protobuf.Timestamp
+	137, // 60: nexusflow.issue.v1.ListIssuesRequest.updated_before:type_name -> google.protobuf.Timestamp
+	36,  // 61: nexusflow.issue.v1.ListIssuesRequest.custom_fields:type_name -> nexusflow.issue.v1.CustomFieldFilter
+	6,   // 62: nexusflow.issue.v1.ListIssuesResponse.issues:type_name -> nexusflow.issue.v1.Issue
+	141, // 63: nexusflow.issue.v1.ListIssuesResponse.pagination:type_name -> nexusflow.common.v1.PaginationResponse
+	140, // 64: nexusflow.issue.v1.SearchIssuesRequest.pagination:type_name -> nexusflow.common.v1.PaginationRequest
+	6,   // 65: nexusflow.issue.v1.SearchIssuesResponse.issues:type_name -> nexusflow.issue.v1.Issue
+	141, // 66: nexusflow.issue.v1.SearchIssuesResponse.pagination:type_name -> nexusflow.common.v1.PaginationResponse
+	6,   // 67: nexusflow.issue.v1.GetIssueChildrenResponse.children:type_name -> nexusflow.issue.v1.Issue
+	6,   // 68: nexusflow.issue.v1.MoveIssueResponse.issue:type_name -> nexusflow.issue.v1.Issue
+	5,   // 69: nexusflow.issue.v1.CreateIssueLinkRequest.type:type_name -> nexusflow.issue.v1.IssueLinkType
+	18,  // 70: nexusflow.issue.v1.CreateIssueLinkResponse.link:type_name -> nexusflow.issue.v1.IssueLink
+	139, // 71: nexusflow.issue.v1.DeleteIssueLinkResponse.response:type_name -> nexusflow.common.v1.SuccessResponse
+	18,  // 72: nexusflow.issue.v1.GetIssueLinksResponse.links:type_name -> nexusflow.issue.v1.IssueLink
+	6,   // 73: nexusflow.issue.v1.AddWatcherResponse.issue:type_name -> nexusflow.issue.v1.Issue
+	6,   // 74: nexusflow.issue.v1.RemoveWatcherResponse.issue:type_name -> nexusflow.issue.v1.Issue
+	2,   // 75: nexusflow.issue.v1.CreateCustomFieldRequest.type:type_name -> nexusflow.issue.v1.CustomFieldType
+	138, // 76: nexusflow.issue.v1.CreateCustomFieldRequest.default_value:type_name -> google.protobuf.Any
+	136, // 77: nexusflow.issue.v1.CreateCustomFieldRequest.config:type_name -> nexusflow.issue.v1.CreateCustomFieldRequest.ConfigEntry
+	7,   // 78: nexusflow.issue.v1.CreateCustomFieldResponse.field:type_name -> nexusflow.issue.v1.CustomField
+	7,   // 79: nexusflow.issue.v1.UpdateCustomFieldResponse.field:type_name -> nexusflow.issue.v1.CustomField
+	139, // 80: nexusflow.issue.v1.DeleteCustomFieldResponse.response:type_name -> nexusflow.common.v1.SuccessResponse
+	0,   // 81: nexusflow.issue.v1.ListCustomFieldsRequest.issue_type:type_name -> nexusflow.issue.v1.IssueType
+	7,   // 82: nexusflow.issue.v1.ListCustomFieldsResponse.fields:type_name -> nexusflow.issue.v1.CustomField
+	0,   // 83: nexusflow.issue.v1.CreateCustomFieldContextRequest.issue_types:type_name -> nexusflow.issue.v1.IssueType
+	138, // 84: nexusflow.issue.v1.CreateCustomFieldContextRequest.default_value:type_name -> google.protobuf.Any
+	8,   // 85: nexusflow.issue.v1.CreateCustomFieldContextResponse.context:type_name -> nexusflow.issue.v1.CustomFieldContext
+	0,   // 86: nexusflow.issue.v1.UpdateCustomFieldContextRequest.issue_types:type_name -> nexusflow.issue.v1.IssueType
+	138, // 87: nexusflow.issue.v1.UpdateCustomFieldContextRequest.default_value:type_name -> google.protobuf.Any
+	8,   // 88: nexusflow.issue.v1.UpdateCustomFieldContextResponse.context:type_name -> nexusflow.issue.v1.CustomFieldContext
+	139, // 89: nexusflow.issue.v1.DeleteCustomFieldContextResponse.response:type_name -> nexusflow.common.v1.SuccessResponse
+	8,   // 90: nexusflow.issue.v1.ListCustomFieldContextsResponse.contexts:type_name -> nexusflow.issue.v1.CustomFieldContext
+	142, // 91: nexusflow.issue.v1.CreateLabelResponse.label:type_name -> nexusflow.common.v1.Label
+	142, // 92: nexusflow.issue.v1.UpdateLabelResponse.label:type_name -> nexusflow.common.v1.Label
+	139, // 93: nexusflow.issue.v1.DeleteLabelResponse.response:type_name -> nexusflow.common.v1.SuccessResponse
+	142, // 94: nexusflow.issue.v1.ListLabelsResponse.labels:type_name -> nexusflow.common.v1.Label
+	6,   // 95: nexusflow.issue.v1.BulkUpdateIssueLabelsResponse.issues:type_name -> nexusflow.issue.v1.Issue
+	9,   // 96: nexusflow.issue.v1.CreateComponentResponse.component:type_name -> nexusflow.issue.v1.Component
+	9,   // 97: nexusflow.issue.v1.UpdateComponentResponse.component:type_name -> nexusflow.issue.v1.Component
+	139, // 98: nexusflow.issue.v1.DeleteComponentResponse.response:type_name -> nexusflow.common.v1.SuccessResponse
+	9,   // 99: nexusflow.issue.v1.ListComponentsResponse.components:type_name -> nexusflow.issue.v1.Component
+	6,   // 100: nexusflow.issue.v1.BulkUpdateIssueComponentsResponse.issues:type_name -> nexusflow.issue.v1.Issue
+	6,   // 101: nexusflow.issue.v1.RankIssueBeforeResponse.issue:type_name -> nexusflow.issue.v1.Issue
+	6,   // 102: nexusflow.issue.v1.RankIssueAfterResponse.issue:type_name -> nexusflow.issue.v1.Issue
+	6,   // 103: nexusflow.issue.v1.RankIssuesResponse.issues:type_name -> nexusflow.issue.v1.Issue
+	35,  // 104: nexusflow.issue.v1.BulkUpdateIssuesRequest.filter:type_name -> nexusflow.issue.v1.ListIssuesRequest
+	1,   // 105: nexusflow.issue.v1.BulkUpdateIssuesRequest.priority:type_name -> nexusflow.issue.v1.IssuePriority
+	11,  // 106: nexusflow.issue.v1.BulkUpdateIssuesResponse.job:type_name -> nexusflow.issue.v1.Job
+	11,  // 107: nexusflow.issue.v1.GetJobResponse.job:type_name -> nexusflow.issue.v1.Job
+	137, // 108: nexusflow.issue.v1.AddWorklogRequest.started_at:type_name -> google.protobuf.Timestamp
+	12,  // 109: nexusflow.issue.v1.AddWorklogResponse.worklog:type_name -> nexusflow.issue.v1.Worklog
+	137, // 110: nexusflow.issue.v1.UpdateWorklogRequest.started_at:type_name -> google.protobuf.Timestamp
+	12,  // 111: nexusflow.issue.v1.UpdateWorklogResponse.worklog:type_name -> nexusflow.issue.v1.Worklog
+	12,  // 112: nexusflow.issue.v1.ListWorklogsResponse.worklogs:type_name -> nexusflow.issue.v1.Worklog
+	13,  // 113: nexusflow.issue.v1.GetTimeTrackingResponse.issue:type_name -> nexusflow.issue.v1.TimeTracking
+	13,  // 114: nexusflow.issue.v1.GetTimeTrackingResponse.rollup:type_name -> nexusflow.issue.v1.TimeTracking
+	137, // 115: nexusflow.issue.v1.GetTimesheetRequest.from:type_name -> google.protobuf.Timestamp
+	137, // 116: nexusflow.issue.v1.GetTimesheetRequest.to:type_name -> google.protobuf.Timestamp
+	12,  // 117: nexusflow.issue.v1.GetTimesheetResponse.worklogs:type_name -> nexusflow.issue.v1.Worklog
+	113, // 118: nexusflow.issue.v1.GetTimesheetResponse.by_user:type_name -> nexusflow.issue.v1.TimesheetTotal
+	113, // 119: nexusflow.issue.v1.GetTimesheetResponse.by_issue:type_name -> nexusflow.issue.v1.TimesheetTotal
+	14,  // 120: nexusflow.issue.v1.CreateIssueScheduleRequest.schedule:type_name -> nexusflow.issue.v1.IssueSchedule
+	14,  // 121: nexusflow.issue.v1.CreateIssueScheduleResponse.schedule:type_name -> nexusflow.issue.v1.IssueSchedule
+	14,  // 122: nexusflow.issue.v1.GetIssueScheduleResponse.schedule:type_name -> nexusflow.issue.v1.IssueSchedule
+	14,  // 123: nexusflow.issue.v1.UpdateIssueScheduleRequest.schedule:type_name -> nexusflow.issue.v1.IssueSchedule
+	14,  // 124: nexusflow.issue.v1.UpdateIssueScheduleResponse.schedule:type_name -> nexusflow.issue.v1.IssueSchedule
+	14,  // 125: nexusflow.issue.v1.ListIssueSchedulesResponse.schedules:type_name -> nexusflow.issue.v1.IssueSchedule
+	15,  // 126: nexusflow.issue.v1.CreateIssueTemplateRequest.template:type_name -> nexusflow.issue.v1.IssueTemplate
+	15,  // 127: nexusflow.issue.v1.CreateIssueTemplateResponse.template:type_name -> nexusflow.issue.v1.IssueTemplate
+	15,  // 128: nexusflow.issue.v1.GetIssueTemplateResponse.template:type_name -> nexusflow.issue.v1.IssueTemplate
+	15,  // 129: nexusflow.issue.v1.UpdateIssueTemplateRequest.template:type_name -> nexusflow.issue.v1.IssueTemplate
+	15,  // 130: nexusflow.issue.v1.UpdateIssueTemplateResponse.template:type_name -> nexusflow.issue.v1.IssueTemplate
+	0,   // 131: nexusflow.issue.v1.ListIssueTemplatesRequest.issue_type:type_name -> nexusflow.issue.v1.IssueType
+	15,  // 132: nexusflow.issue.v1.ListIssueTemplatesResponse.templates:type_name -> nexusflow.issue.v1.IssueTemplate
+	19,  // 133: nexusflow.issue.v1.IssueService.CreateIssue:input_type -> nexusflow.issue.v1.CreateIssueRequest
+	21,  // 134: nexusflow.issue.v1.IssueService.GetIssue:input_type -> nexusflow.issue.v1.GetIssueRequest
+	23,  // 135: nexusflow.issue.v1.IssueService.GetIssueByKey:input_type -> nexusflow.issue.v1.GetIssueByKeyRequest
+	25,  // 136: nexusflow.issue.v1.IssueService.BatchGetIssues:input_type -> nexusflow.issue.v1.BatchGetIssuesRequest
+	27,  // 137: nexusflow.issue.v1.IssueService.UpdateIssue:input_type -> nexusflow.issue.v1.UpdateIssueRequest
+	29,  // 138: nexusflow.issue.v1.IssueService.DeleteIssue:input_type -> nexusflow.issue.v1.DeleteIssueRequest
+	35,  // 139: nexusflow.issue.v1.IssueService.ListIssues:input_type -> nexusflow.issue.v1.ListIssuesRequest
+	31,  // 140: nexusflow.issue.v1.IssueService.ListDeletedIssues:input_type -> nexusflow.issue.v1.ListDeletedIssuesRequest
+	33,  // 141: nexusflow.issue.v1.IssueService.RestoreIssue:input_type -> nexusflow.issue.v1.RestoreIssueRequest
+	38,  // 142: nexusflow.issue.v1.IssueService.SearchIssues:input_type -> nexusflow.issue.v1.SearchIssuesRequest
+	40,  // 143: nexusflow.issue.v1.IssueService.GetIssueChildren:input_type -> nexusflow.issue.v1.GetIssueChildrenRequest
+	42,  // 144: nexusflow.issue.v1.IssueService.MoveIssue:input_type -> nexusflow.issue.v1.MoveIssueRequest
+	44,  // 145: nexusflow.issue.v1.IssueService.CreateIssueLink:input_type -> nexusflow.issue.v1.CreateIssueLinkRequest
+	46,  // 146: nexusflow.issue.v1.IssueService.DeleteIssueLink:input_type -> nexusflow.issue.v1.DeleteIssueLinkRequest
+	48,  // 147: nexusflow.issue.v1.IssueService.GetIssueLinks:input_type -> nexusflow.issue.v1.GetIssueLinksRequest
+	50,  // 148: nexusflow.issue.v1.IssueService.AddWatcher:input_type -> nexusflow.issue.v1.AddWatcherRequest
+	52,  // 149: nexusflow.issue.v1.IssueService.RemoveWatcher:input_type -> nexusflow.issue.v1.RemoveWatcherRequest
+	54,  // 150: nexusflow.issue.v1.IssueService.CreateCustomField:input_type -> nexusflow.issue.v1.CreateCustomFieldRequest
+	56,  // 151: nexusflow.issue.v1.IssueService.UpdateCustomField:input_type -> nexusflow.issue.v1.UpdateCustomFieldRequest
+	58,  // 152: nexusflow.issue.v1.IssueService.DeleteCustomField:input_type -> nexusflow.issue.v1.DeleteCustomFieldRequest
+	60,  // 153: nexusflow.issue.v1.IssueService.ListCustomFields:input_type -> nexusflow.issue.v1.ListCustomFieldsRequest
+	62,  // 154: nexusflow.issue.v1.IssueService.CreateCustomFieldContext:input_type -> nexusflow.issue.v1.CreateCustomFieldContextRequest
+	64,  // 155: nexusflow.issue.v1.IssueService.UpdateCustomFieldContext:input_type -> nexusflow.issue.v1.UpdateCustomFieldContextRequest
+	66,  // 156: nexusflow.issue.v1.IssueService.DeleteCustomFieldContext:input_type -> nexusflow.issue.v1.DeleteCustomFieldContextRequest
+	68,  // 157: nexusflow.issue.v1.IssueService.ListCustomFieldContexts:input_type -> nexusflow.issue.v1.ListCustomFieldContextsRequest
+	70,  // 158: nexusflow.issue.v1.IssueService.CreateLabel:input_type -> nexusflow.issue.v1.CreateLabelRequest
+	72,  // 159: nexusflow.issue.v1.IssueService.UpdateLabel:input_type -> nexusflow.issue.v1.UpdateLabelRequest
+	74,  // 160: nexusflow.issue.v1.IssueService.DeleteLabel:input_type -> nexusflow.issue.v1.DeleteLabelRequest
+	76,  // 161: nexusflow.issue.v1.IssueService.ListLabels:input_type -> nexusflow.issue.v1.ListLabelsRequest
+	78,  // 162: nexusflow.issue.v1.IssueService.BulkUpdateIssueLabels:input_type -> nexusflow.issue.v1.BulkUpdateIssueLabelsRequest
+	80,  // 163: nexusflow.issue.v1.IssueService.CreateComponent:input_type -> nexusflow.issue.v1.CreateComponentRequest
+	82,  // 164: nexusflow.issue.v1.IssueService.UpdateComponent:input_type -> nexusflow.issue.v1.UpdateComponentRequest
+	84,  // 165: nexusflow.issue.v1.IssueService.DeleteComponent:input_type -> nexusflow.issue.v1.DeleteComponentRequest
+	86,  // 166: nexusflow.issue.v1.IssueService.ListComponents:input_type -> nexusflow.issue.v1.ListComponentsRequest
+	88,  // 167: nexusflow.issue.v1.IssueService.BulkUpdateIssueComponents:input_type -> nexusflow.issue.v1.BulkUpdateIssueComponentsRequest
+	90,  // 168: nexusflow.issue.v1.IssueService.SetIssuesSprint:input_type -> nexusflow.issue.v1.SetIssuesSprintRequest
+	92,  // 169: nexusflow.issue.v1.IssueService.RankIssueBefore:input_type -> nexusflow.issue.v1.RankIssueBeforeRequest
+	94,  // 170: nexusflow.issue.v1.IssueService.RankIssueAfter:input_type -> nexusflow.issue.v1.RankIssueAfterRequest
+	96,  // 171: nexusflow.issue.v1.IssueService.RankIssues:input_type -> nexusflow.issue.v1.RankIssuesRequest
+	98,  // 172: nexusflow.issue.v1.IssueService.BulkUpdateIssues:input_type -> nexusflow.issue.v1.BulkUpdateIssuesRequest
+	100, // 173: nexusflow.issue.v1.IssueService.GetJob:input_type -> nexusflow.issue.v1.GetJobRequest
+	102, // 174: nexusflow.issue.v1.IssueService.AddWorklog:input_type -> nexusflow.issue.v1.AddWorklogRequest
+	104, // 175: nexusflow.issue.v1.IssueService.UpdateWorklog:input_type -> nexusflow.issue.v1.UpdateWorklogRequest
+	106, // 176: nexusflow.issue.v1.IssueService.DeleteWorklog:input_type -> nexusflow.issue.v1.DeleteWorklogRequest
+	108, // 177: nexusflow.issue.v1.IssueService.ListWorklogs:input_type -> nexusflow.issue.v1.ListWorklogsRequest
+	110, // 178: nexusflow.issue.v1.IssueService.GetTimeTracking:input_type -> nexusflow.issue.v1.GetTimeTrackingRequest
+	112, // 179: nexusflow.issue.v1.IssueService.GetTimesheet:input_type -> nexusflow.issue.v1.GetTimesheetRequest
+	115, // 180: nexusflow.issue.v1.IssueService.CreateIssueSchedule:input_type -> nexusflow.issue.v1.CreateIssueScheduleRequest
+	117, // 181: nexusflow.issue.v1.IssueService.GetIssueSchedule:input_type -> nexusflow.issue.v1.GetIssueScheduleRequest
+	119, // 182: nexusflow.issue.v1.IssueService.UpdateIssueSchedule:input_type -> nexusflow.issue.v1.UpdateIssueScheduleRequest
+	121, // 183: nexusflow.issue.v1.IssueService.DeleteIssueSchedule:input_type -> nexusflow.issue.v1.DeleteIssueScheduleRequest
+	123, // 184: nexusflow.issue.v1.IssueService.ListIssueSchedules:input_type -> nexusflow.issue.v1.ListIssueSchedulesRequest
+	125, // 185: nexusflow.issue.v1.IssueService.CreateIssueTemplate:input_type -> nexusflow.issue.v1.CreateIssueTemplateRequest
+	127, // 186: nexusflow.issue.v1.IssueService.GetIssueTemplate:input_type -> nexusflow.issue.v1.GetIssueTemplateRequest
+	129, // 187: nexusflow.issue.v1.IssueService.UpdateIssueTemplate:input_type -> nexusflow.issue.v1.UpdateIssueTemplateRequest
+	131, // 188: nexusflow.issue.v1.IssueService.DeleteIssueTemplate:input_type -> nexusflow.issue.v1.DeleteIssueTemplateRequest
+	133, // 189: nexusflow.issue.v1.IssueService.ListIssueTemplates:input_type -> nexusflow.issue.v1.ListIssueTemplatesRequest
+	20,  // 190: nexusflow.issue.v1.IssueService.CreateIssue:output_type -> nexusflow.issue.v1.CreateIssueResponse
+	22,  // 191: nexusflow.issue.v1.IssueService.GetIssue:output_type -> nexusflow.issue.v1.GetIssueResponse
+	24,  // 192: nexusflow.issue.v1.IssueService.GetIssueByKey:output_type -> nexusflow.issue.v1.GetIssueByKeyResponse
+	26,  // 193: nexusflow.issue.v1.IssueService.BatchGetIssues:output_type -> nexusflow.issue.v1.BatchGetIssuesResponse
+	28,  // 194: nexusflow.issue.v1.IssueService.UpdateIssue:output_type -> nexusflow.issue.v1.UpdateIssueResponse
+	30,  // 195: nexusflow.issue.v1.IssueService.DeleteIssue:output_type -> nexusflow.issue.v1.DeleteIssueResponse
+	37,  // 196: nexusflow.issue.v1.IssueService.ListIssues:output_type -> nexusflow.issue.v1.ListIssuesResponse
+	32,  // 197: nexusflow.issue.v1.IssueService.ListDeletedIssues:output_type -> nexusflow.issue.v1.ListDeletedIssuesResponse
+	34,  // 198: nexusflow.issue.v1.IssueService.RestoreIssue:output_type -> nexusflow.issue.v1.RestoreIssueResponse
+	39,  // 199: nexusflow.issue.v1.IssueService.SearchIssues:output_type -> nexusflow.issue.v1.SearchIssuesResponse
+	41,  // 200: nexusflow.issue.v1.IssueService.GetIssueChildren:output_type -> nexusflow.issue.v1.GetIssueChildrenResponse
+	43,  // 201: nexusflow.issue.v1.IssueService.MoveIssue:output_type -> nexusflow.issue.v1.MoveIssueResponse
+	45,  // 202: nexusflow.issue.v1.IssueService.CreateIssueLink:output_type -> nexusflow.issue.v1.CreateIssueLinkResponse
+	47,  // 203: nexusflow.issue.v1.IssueService.DeleteIssueLink:output_type -> nexusflow.issue.v1.DeleteIssueLinkResponse
+	49,  // 204: nexusflow.issue.v1.IssueService.GetIssueLinks:output_type -> nexusflow.issue.v1.GetIssueLinksResponse
+	51,  // 205: nexusflow.issue.v1.IssueService.AddWatcher:output_type -> nexusflow.issue.v1.AddWatcherResponse
+	53,  // 206: nexusflow.issue.v1.IssueService.RemoveWatcher:output_type -> nexusflow.issue.v1.RemoveWatcherResponse
+	55,  // 207: nexusflow.issue.v1.IssueService.CreateCustomField:output_type -> nexusflow.issue.v1.CreateCustomFieldResponse
+	57,  // 208: nexusflow.issue.v1.IssueService.UpdateCustomField:output_type -> nexusflow.issue.v1.UpdateCustomFieldResponse
+	59,  // 209: nexusflow.issue.v1.IssueService.DeleteCustomField:output_type -> nexusflow.issue.v1.DeleteCustomFieldResponse
+	61,  // 210: nexusflow.issue.v1.IssueService.ListCustomFields:output_type -> nexusflow.issue.v1.ListCustomFieldsResponse
+	63,  // 211: nexusflow.issue.v1.IssueService.CreateCustomFieldContext:output_type -> nexusflow.issue.v1.CreateCustomFieldContextResponse
+	65,  // 212: nexusflow.issue.v1.IssueService.UpdateCustomFieldContext:output_type -> nexusflow.issue.v1.UpdateCustomFieldContextResponse
+	67,  // 213: nexusflow.issue.v1.IssueService.DeleteCustomFieldContext:output_type -> nexusflow.issue.v1.DeleteCustomFieldContextResponse
+	69,  // 214: nexusflow.issue.v1.IssueService.ListCustomFieldContexts:output_type -> nexusflow.issue.v1.ListCustomFieldContextsResponse
+	71,  // 215: nexusflow.issue.v1.IssueService.CreateLabel:output_type -> nexusflow.issue.v1.CreateLabelResponse
+	73,  // 216: nexusflow.issue.v1.IssueService.UpdateLabel:output_type -> nexusflow.issue.v1.UpdateLabelResponse
+	75,  // 217: nexusflow.issue.v1.IssueService.DeleteLabel:output_type -> nexusflow.issue.v1.DeleteLabelResponse
+	77,  // 218: nexusflow.issue.v1.IssueService.ListLabels:output_type -> nexusflow.issue.v1.ListLabelsResponse
+	79,  // 219: nexusflow.issue.v1.IssueService.BulkUpdateIssueLabels:output_type -> nexusflow.issue.v1.BulkUpdateIssueLabelsResponse
+	81,  // 220: nexusflow.issue.v1.IssueService.CreateComponent:output_type -> nexusflow.issue.v1.CreateComponentResponse
+	83,  // 221: nexusflow.issue.v1.IssueService.UpdateComponent:output_type -> nexusflow.issue.v1.UpdateComponentResponse
+	85,  // 222: nexusflow.issue.v1.IssueService.DeleteComponent:output_type -> nexusflow.issue.v1.DeleteComponentResponse
+	87,  // 223: nexusflow.issue.v1.IssueService.ListComponents:output_type -> nexusflow.issue.v1.ListComponentsResponse
+	89,  // 224: nexusflow.issue.v1.IssueService.BulkUpdateIssueComponents:output_type -> nexusflow.issue.v1.BulkUpdateIssueComponentsResponse
+	91,  // 225: nexusflow.issue.v1.IssueService.SetIssuesSprint:output_type -> nexusflow.issue.v1.SetIssuesSprintResponse
+	93,  // 226: nexusflow.issue.v1.IssueService.RankIssueBefore:output_type -> nexusflow.issue.v1.RankIssueBeforeResponse
+	95,  // 227: nexusflow.issue.v1.IssueService.RankIssueAfter:output_type -> nexusflow.issue.v1.RankIssueAfterResponse
+	97,  // 228: nexusflow.issue.v1.IssueService.RankIssues:output_type -> nexusflow.issue.v1.RankIssuesResponse
+	99,  // 229: nexusflow.issue.v1.IssueService.BulkUpdateIssues:output_type -> nexusflow.issue.v1.BulkUpdateIssuesResponse
+	101, // 230: nexusflow.issue.v1.IssueService.GetJob:output_type -> nexusflow.issue.v1.GetJobResponse
+	103, // 231: nexusflow.issue.v1.IssueService.AddWorklog:output_type -> nexusflow.issue.v1.AddWorklogResponse
+	105, // 232: nexusflow.issue.v1.IssueService.UpdateWorklog:output_type -> nexusflow.issue.v1.UpdateWorklogResponse
+	107, // 233: nexusflow.issue.v1.IssueService.DeleteWorklog:output_type -> nexusflow.issue.v1.DeleteWorklogResponse
+	109, // 234: nexusflow.issue.v1.IssueService.ListWorklogs:output_type -> nexusflow.issue.v1.ListWorklogsResponse
+	111, // 235: nexusflow.issue.v1.IssueService.GetTimeTracking:output_type -> nexusflow.issue.v1.GetTimeTrackingResponse
+	114, // 236: nexusflow.issue.v1.IssueService.GetTimesheet:output_type -> nexusflow.issue.v1.GetTimesheetResponse
+	116, // 237: nexusflow.issue.v1.IssueService.CreateIssueSchedule:output_type -> nexusflow.issue.v1.CreateIssueScheduleResponse
+	118, // 238: nexusflow.issue.v1.IssueService.GetIssueSchedule:output_type -> nexusflow.issue.v1.GetIssueScheduleResponse
+	120, // 239: nexusflow.issue.v1.IssueService.UpdateIssueSchedule:output_type -> nexusflow.issue.v1.UpdateIssueScheduleResponse
+	122, // 240: nexusflow.issue.v1.IssueService.DeleteIssueSchedule:output_type -> nexusflow.issue.v1.DeleteIssueScheduleResponse
+	124, // 241: nexusflow.issue.v1.IssueService.ListIssueSchedules:output_type -> nexusflow.issue.v1.ListIssueSchedulesResponse
+	126, // 242: nexusflow.issue.v1.IssueService.CreateIssueTemplate:output_type -> nexusflow.issue.v1.CreateIssueTemplateResponse
+	128, // 243: nexusflow.issue.v1.IssueService.GetIssueTemplate:output_type -> nexusflow.issue.v1.GetIssueTemplateResponse
+	130, // 244: nexusflow.issue.v1.IssueService.UpdateIssueTemplate:output_type -> nexusflow.issue.v1.UpdateIssueTemplateResponse
+	132, // 245: nexusflow.issue.v1.IssueService.DeleteIssueTemplate:output_type -> nexusflow.issue.v1.DeleteIssueTemplateResponse
+	134, // 246: nexusflow.issue.v1.IssueService.ListIssueTemplates:output_type -> nexusflow.issue.v1.ListIssueTemplatesResponse
+	190, // [190:247] is the sub-list for method output_type
+	133, // [133:190] is the sub-list for method input_type
+	133, // [133:133] is the sub-list for extension type_name
+	133, // [133:133] is the sub-list for extension extendee
+	0,   // [0:133] is the sub-list for field type_name
 }
 
 func init() { file_proto_issue_v1_issue_proto_init() }
@@ -3008,15 +9202,20 @@ func file_proto_issue_v1_issue_proto_init() {
 	if File_proto_issue_v1_issue_proto != nil {
 		return
 	}
-	file_proto_issue_v1_issue_proto_msgTypes[10].OneofWrappers = []any{}
-	file_proto_issue_v1_issue_proto_msgTypes[34].OneofWrappers = []any{}
+	file_proto_issue_v1_issue_proto_msgTypes[21].OneofWrappers = []any{}
+	file_proto_issue_v1_issue_proto_msgTypes[50].OneofWrappers = []any{}
+	file_proto_issue_v1_issue_proto_msgTypes[58].OneofWrappers = []any{}
+	file_proto_issue_v1_issue_proto_msgTypes[66].OneofWrappers = []any{}
+	file_proto_issue_v1_issue_proto_msgTypes[76].OneofWrappers = []any{}
+	file_proto_issue_v1_issue_proto_msgTypes[92].OneofWrappers = []any{}
+	file_proto_issue_v1_issue_proto_msgTypes[98].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_issue_v1_issue_proto_rawDesc), len(file_proto_issue_v1_issue_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   41,
+			NumEnums:      6,
+			NumMessages:   131,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
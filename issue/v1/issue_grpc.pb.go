@@ -19,24 +19,63 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	IssueService_CreateIssue_FullMethodName       = "/nexusflow.issue.v1.IssueService/CreateIssue"
-	IssueService_GetIssue_FullMethodName          = "/nexusflow.issue.v1.IssueService/GetIssue"
-	IssueService_GetIssueByKey_FullMethodName     = "/nexusflow.issue.v1.IssueService/GetIssueByKey"
-	IssueService_UpdateIssue_FullMethodName       = "/nexusflow.issue.v1.IssueService/UpdateIssue"
-	IssueService_DeleteIssue_FullMethodName       = "/nexusflow.issue.v1.IssueService/DeleteIssue"
-	IssueService_ListIssues_FullMethodName        = "/nexusflow.issue.v1.IssueService/ListIssues"
-	IssueService_SearchIssues_FullMethodName      = "/nexusflow.issue.v1.IssueService/SearchIssues"
-	IssueService_GetIssueChildren_FullMethodName  = "/nexusflow.issue.v1.IssueService/GetIssueChildren"
-	IssueService_MoveIssue_FullMethodName         = "/nexusflow.issue.v1.IssueService/MoveIssue"
-	IssueService_CreateIssueLink_FullMethodName   = "/nexusflow.issue.v1.IssueService/CreateIssueLink"
-	IssueService_DeleteIssueLink_FullMethodName   = "/nexusflow.issue.v1.IssueService/DeleteIssueLink"
-	IssueService_GetIssueLinks_FullMethodName     = "/nexusflow.issue.v1.IssueService/GetIssueLinks"
-	IssueService_AddWatcher_FullMethodName        = "/nexusflow.issue.v1.IssueService/AddWatcher"
-	IssueService_RemoveWatcher_FullMethodName     = "/nexusflow.issue.v1.IssueService/RemoveWatcher"
-	IssueService_CreateCustomField_FullMethodName = "/nexusflow.issue.v1.IssueService/CreateCustomField"
-	IssueService_UpdateCustomField_FullMethodName = "/nexusflow.issue.v1.IssueService/UpdateCustomField"
-	IssueService_DeleteCustomField_FullMethodName = "/nexusflow.issue.v1.IssueService/DeleteCustomField"
-	IssueService_ListCustomFields_FullMethodName  = "/nexusflow.issue.v1.IssueService/ListCustomFields"
+	IssueService_CreateIssue_FullMethodName               = "/nexusflow.issue.v1.IssueService/CreateIssue"
+	IssueService_GetIssue_FullMethodName                  = "/nexusflow.issue.v1.IssueService/GetIssue"
+	IssueService_GetIssueByKey_FullMethodName             = "/nexusflow.issue.v1.IssueService/GetIssueByKey"
+	IssueService_BatchGetIssues_FullMethodName            = "/nexusflow.issue.v1.IssueService/BatchGetIssues"
+	IssueService_UpdateIssue_FullMethodName               = "/nexusflow.issue.v1.IssueService/UpdateIssue"
+	IssueService_DeleteIssue_FullMethodName               = "/nexusflow.issue.v1.IssueService/DeleteIssue"
+	IssueService_ListIssues_FullMethodName                = "/nexusflow.issue.v1.IssueService/ListIssues"
+	IssueService_ListDeletedIssues_FullMethodName         = "/nexusflow.issue.v1.IssueService/ListDeletedIssues"
+	IssueService_RestoreIssue_FullMethodName              = "/nexusflow.issue.v1.IssueService/RestoreIssue"
+	IssueService_SearchIssues_FullMethodName              = "/nexusflow.issue.v1.IssueService/SearchIssues"
+	IssueService_GetIssueChildren_FullMethodName          = "/nexusflow.issue.v1.IssueService/GetIssueChildren"
+	IssueService_MoveIssue_FullMethodName                 = "/nexusflow.issue.v1.IssueService/MoveIssue"
+	IssueService_CreateIssueLink_FullMethodName           = "/nexusflow.issue.v1.IssueService/CreateIssueLink"
+	IssueService_DeleteIssueLink_FullMethodName           = "/nexusflow.issue.v1.IssueService/DeleteIssueLink"
+	IssueService_GetIssueLinks_FullMethodName             = "/nexusflow.issue.v1.IssueService/GetIssueLinks"
+	IssueService_AddWatcher_FullMethodName                = "/nexusflow.issue.v1.IssueService/AddWatcher"
+	IssueService_RemoveWatcher_FullMethodName             = "/nexusflow.issue.v1.IssueService/RemoveWatcher"
+	IssueService_CreateCustomField_FullMethodName         = "/nexusflow.issue.v1.IssueService/CreateCustomField"
+	IssueService_UpdateCustomField_FullMethodName         = "/nexusflow.issue.v1.IssueService/UpdateCustomField"
+	IssueService_DeleteCustomField_FullMethodName         = "/nexusflow.issue.v1.IssueService/DeleteCustomField"
+	IssueService_ListCustomFields_FullMethodName          = "/nexusflow.issue.v1.IssueService/ListCustomFields"
+	IssueService_CreateCustomFieldContext_FullMethodName  = "/nexusflow.issue.v1.IssueService/CreateCustomFieldContext"
+	IssueService_UpdateCustomFieldContext_FullMethodName  = "/nexusflow.issue.v1.IssueService/UpdateCustomFieldContext"
+	IssueService_DeleteCustomFieldContext_FullMethodName  = "/nexusflow.issue.v1.IssueService/DeleteCustomFieldContext"
+	IssueService_ListCustomFieldContexts_FullMethodName   = "/nexusflow.issue.v1.IssueService/ListCustomFieldContexts"
+	IssueService_CreateLabel_FullMethodName               = "/nexusflow.issue.v1.IssueService/CreateLabel"
+	IssueService_UpdateLabel_FullMethodName               = "/nexusflow.issue.v1.IssueService/UpdateLabel"
+	IssueService_DeleteLabel_FullMethodName               = "/nexusflow.issue.v1.IssueService/DeleteLabel"
+	IssueService_ListLabels_FullMethodName                = "/nexusflow.issue.v1.IssueService/ListLabels"
+	IssueService_BulkUpdateIssueLabels_FullMethodName     = "/nexusflow.issue.v1.IssueService/BulkUpdateIssueLabels"
+	IssueService_CreateComponent_FullMethodName           = "/nexusflow.issue.v1.IssueService/CreateComponent"
+	IssueService_UpdateComponent_FullMethodName           = "/nexusflow.issue.v1.IssueService/UpdateComponent"
+	IssueService_DeleteComponent_FullMethodName           = "/nexusflow.issue.v1.IssueService/DeleteComponent"
+	IssueService_ListComponents_FullMethodName            = "/nexusflow.issue.v1.IssueService/ListComponents"
+	IssueService_BulkUpdateIssueComponents_FullMethodName = "/nexusflow.issue.v1.IssueService/BulkUpdateIssueComponents"
+	IssueService_SetIssuesSprint_FullMethodName           = "/nexusflow.issue.v1.IssueService/SetIssuesSprint"
+	IssueService_RankIssueBefore_FullMethodName           = "/nexusflow.issue.v1.IssueService/RankIssueBefore"
+	IssueService_RankIssueAfter_FullMethodName            = "/nexusflow.issue.v1.IssueService/RankIssueAfter"
+	IssueService_RankIssues_FullMethodName                = "/nexusflow.issue.v1.IssueService/RankIssues"
+	IssueService_BulkUpdateIssues_FullMethodName          = "/nexusflow.issue.v1.IssueService/BulkUpdateIssues"
+	IssueService_GetJob_FullMethodName                    = "/nexusflow.issue.v1.IssueService/GetJob"
+	IssueService_AddWorklog_FullMethodName                = "/nexusflow.issue.v1.IssueService/AddWorklog"
+	IssueService_UpdateWorklog_FullMethodName             = "/nexusflow.issue.v1.IssueService/UpdateWorklog"
+	IssueService_DeleteWorklog_FullMethodName             = "/nexusflow.issue.v1.IssueService/DeleteWorklog"
+	IssueService_ListWorklogs_FullMethodName              = "/nexusflow.issue.v1.IssueService/ListWorklogs"
+	IssueService_GetTimeTracking_FullMethodName           = "/nexusflow.issue.v1.IssueService/GetTimeTracking"
+	IssueService_GetTimesheet_FullMethodName              = "/nexusflow.issue.v1.IssueService/GetTimesheet"
+	IssueService_CreateIssueSchedule_FullMethodName       = "/nexusflow.issue.v1.IssueService/CreateIssueSchedule"
+	IssueService_GetIssueSchedule_FullMethodName          = "/nexusflow.issue.v1.IssueService/GetIssueSchedule"
+	IssueService_UpdateIssueSchedule_FullMethodName       = "/nexusflow.issue.v1.IssueService/UpdateIssueSchedule"
+	IssueService_DeleteIssueSchedule_FullMethodName       = "/nexusflow.issue.v1.IssueService/DeleteIssueSchedule"
+	IssueService_ListIssueSchedules_FullMethodName        = "/nexusflow.issue.v1.IssueService/ListIssueSchedules"
+	IssueService_CreateIssueTemplate_FullMethodName       = "/nexusflow.issue.v1.IssueService/CreateIssueTemplate"
+	IssueService_GetIssueTemplate_FullMethodName          = "/nexusflow.issue.v1.IssueService/GetIssueTemplate"
+	IssueService_UpdateIssueTemplate_FullMethodName       = "/nexusflow.issue.v1.IssueService/UpdateIssueTemplate"
+	IssueService_DeleteIssueTemplate_FullMethodName       = "/nexusflow.issue.v1.IssueService/DeleteIssueTemplate"
+	IssueService_ListIssueTemplates_FullMethodName        = "/nexusflow.issue.v1.IssueService/ListIssueTemplates"
 )
 
 // IssueServiceClient is the client API for IssueService service.
@@ -49,9 +88,12 @@ type IssueServiceClient interface {
 	CreateIssue(ctx context.Context, in *CreateIssueRequest, opts ...grpc.CallOption) (*CreateIssueResponse, error)
 	GetIssue(ctx context.Context, in *GetIssueRequest, opts ...grpc.CallOption) (*GetIssueResponse, error)
 	GetIssueByKey(ctx context.Context, in *GetIssueByKeyRequest, opts ...grpc.CallOption) (*GetIssueByKeyResponse, error)
+	BatchGetIssues(ctx context.Context, in *BatchGetIssuesRequest, opts ...grpc.CallOption) (*BatchGetIssuesResponse, error)
 	UpdateIssue(ctx context.Context, in *UpdateIssueRequest, opts ...grpc.CallOption) (*UpdateIssueResponse, error)
 	DeleteIssue(ctx context.Context, in *DeleteIssueRequest, opts ...grpc.CallOption) (*DeleteIssueResponse, error)
 	ListIssues(ctx context.Context, in *ListIssuesRequest, opts ...grpc.CallOption) (*ListIssuesResponse, error)
+	ListDeletedIssues(ctx context.Context, in *ListDeletedIssuesRequest, opts ...grpc.CallOption) (*ListDeletedIssuesResponse, error)
+	RestoreIssue(ctx context.Context, in *RestoreIssueRequest, opts ...grpc.CallOption) (*RestoreIssueResponse, error)
 	SearchIssues(ctx context.Context, in *SearchIssuesRequest, opts ...grpc.CallOption) (*SearchIssuesResponse, error)
 	// Issue hierarchy
 	GetIssueChildren(ctx context.Context, in *GetIssueChildrenRequest, opts ...grpc.CallOption) (*GetIssueChildrenResponse, error)
@@ -68,6 +110,51 @@ type IssueServiceClient interface {
 	UpdateCustomField(ctx context.Context, in *UpdateCustomFieldRequest, opts ...grpc.CallOption) (*UpdateCustomFieldResponse, error)
 	DeleteCustomField(ctx context.Context, in *DeleteCustomFieldRequest, opts ...grpc.CallOption) (*DeleteCustomFieldResponse, error)
 	ListCustomFields(ctx context.Context, in *ListCustomFieldsRequest, opts ...grpc.CallOption) (*ListCustomFieldsResponse, error)
+	// Custom field contexts
+	CreateCustomFieldContext(ctx context.Context, in *CreateCustomFieldContextRequest, opts ...grpc.CallOption) (*CreateCustomFieldContextResponse, error)
+	UpdateCustomFieldContext(ctx context.Context, in *UpdateCustomFieldContextRequest, opts ...grpc.CallOption) (*UpdateCustomFieldContextResponse, error)
+	DeleteCustomFieldContext(ctx context.Context, in *DeleteCustomFieldContextRequest, opts ...grpc.CallOption) (*DeleteCustomFieldContextResponse, error)
+	ListCustomFieldContexts(ctx context.Context, in *ListCustomFieldContextsRequest, opts ...grpc.CallOption) (*ListCustomFieldContextsResponse, error)
+	// Labels
+	CreateLabel(ctx context.Context, in *CreateLabelRequest, opts ...grpc.CallOption) (*CreateLabelResponse, error)
+	UpdateLabel(ctx context.Context, in *UpdateLabelRequest, opts ...grpc.CallOption) (*UpdateLabelResponse, error)
+	DeleteLabel(ctx context.Context, in *DeleteLabelRequest, opts ...grpc.CallOption) (*DeleteLabelResponse, error)
+	ListLabels(ctx context.Context, in *ListLabelsRequest, opts ...grpc.CallOption) (*ListLabelsResponse, error)
+	BulkUpdateIssueLabels(ctx context.Context, in *BulkUpdateIssueLabelsRequest, opts ...grpc.CallOption) (*BulkUpdateIssueLabelsResponse, error)
+	// Components
+	CreateComponent(ctx context.Context, in *CreateComponentRequest, opts ...grpc.CallOption) (*CreateComponentResponse, error)
+	UpdateComponent(ctx context.Context, in *UpdateComponentRequest, opts ...grpc.CallOption) (*UpdateComponentResponse, error)
+	DeleteComponent(ctx context.Context, in *DeleteComponentRequest, opts ...grpc.CallOption) (*DeleteComponentResponse, error)
+	ListComponents(ctx context.Context, in *ListComponentsRequest, opts ...grpc.CallOption) (*ListComponentsResponse, error)
+	BulkUpdateIssueComponents(ctx context.Context, in *BulkUpdateIssueComponentsRequest, opts ...grpc.CallOption) (*BulkUpdateIssueComponentsResponse, error)
+	// Sprints, kept in step by the sprint service
+	SetIssuesSprint(ctx context.Context, in *SetIssuesSprintRequest, opts ...grpc.CallOption) (*SetIssuesSprintResponse, error)
+	// Backlog ranking
+	RankIssueBefore(ctx context.Context, in *RankIssueBeforeRequest, opts ...grpc.CallOption) (*RankIssueBeforeResponse, error)
+	RankIssueAfter(ctx context.Context, in *RankIssueAfterRequest, opts ...grpc.CallOption) (*RankIssueAfterResponse, error)
+	RankIssues(ctx context.Context, in *RankIssuesRequest, opts ...grpc.CallOption) (*RankIssuesResponse, error)
+	// Bulk operations
+	BulkUpdateIssues(ctx context.Context, in *BulkUpdateIssuesRequest, opts ...grpc.CallOption) (*BulkUpdateIssuesResponse, error)
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error)
+	// Time tracking
+	AddWorklog(ctx context.Context, in *AddWorklogRequest, opts ...grpc.CallOption) (*AddWorklogResponse, error)
+	UpdateWorklog(ctx context.Context, in *UpdateWorklogRequest, opts ...grpc.CallOption) (*UpdateWorklogResponse, error)
+	DeleteWorklog(ctx context.Context, in *DeleteWorklogRequest, opts ...grpc.CallOption) (*DeleteWorklogResponse, error)
+	ListWorklogs(ctx context.Context, in *ListWorklogsRequest, opts ...grpc.CallOption) (*ListWorklogsResponse, error)
+	GetTimeTracking(ctx context.Context, in *GetTimeTrackingRequest, opts ...grpc.CallOption) (*GetTimeTrackingResponse, error)
+	GetTimesheet(ctx context.Context, in *GetTimesheetRequest, opts ...grpc.CallOption) (*GetTimesheetResponse, error)
+	// Recurring issues
+	CreateIssueSchedule(ctx context.Context, in *CreateIssueScheduleRequest, opts ...grpc.CallOption) (*CreateIssueScheduleResponse, error)
+	GetIssueSchedule(ctx context.Context, in *GetIssueScheduleRequest, opts ...grpc.CallOption) (*GetIssueScheduleResponse, error)
+	UpdateIssueSchedule(ctx context.Context, in *UpdateIssueScheduleRequest, opts ...grpc.CallOption) (*UpdateIssueScheduleResponse, error)
+	DeleteIssueSchedule(ctx context.Context, in *DeleteIssueScheduleRequest, opts ...grpc.CallOption) (*DeleteIssueScheduleResponse, error)
+	ListIssueSchedules(ctx context.Context, in *ListIssueSchedulesRequest, opts ...grpc.CallOption) (*ListIssueSchedulesResponse, error)
+	// Issue templates
+	CreateIssueTemplate(ctx context.Context, in *CreateIssueTemplateRequest, opts ...grpc.CallOption) (*CreateIssueTemplateResponse, error)
+	GetIssueTemplate(ctx context.Context, in *GetIssueTemplateRequest, opts ...grpc.CallOption) (*GetIssueTemplateResponse, error)
+	UpdateIssueTemplate(ctx context.Context, in *UpdateIssueTemplateRequest, opts ...grpc.CallOption) (*UpdateIssueTemplateResponse, error)
+	DeleteIssueTemplate(ctx context.Context, in *DeleteIssueTemplateRequest, opts ...grpc.CallOption) (*DeleteIssueTemplateResponse, error)
+	ListIssueTemplates(ctx context.Context, in *ListIssueTemplatesRequest, opts ...grpc.CallOption) (*ListIssueTemplatesResponse, error)
 }
 
 type issueServiceClient struct {
@@ -108,6 +195,16 @@ func (c *issueServiceClient) GetIssueByKey(ctx context.Context, in *GetIssueByKe
 	return out, nil
 }
 
+func (c *issueServiceClient) BatchGetIssues(ctx context.Context, in *BatchGetIssuesRequest, opts ...grpc.CallOption) (*BatchGetIssuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetIssuesResponse)
+	err := c.cc.Invoke(ctx, IssueService_BatchGetIssues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *issueServiceClient) UpdateIssue(ctx context.Context, in *UpdateIssueRequest, opts ...grpc.CallOption) (*UpdateIssueResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateIssueResponse)
@@ -138,6 +235,26 @@ func (c *issueServiceClient) ListIssues(ctx context.Context, in *ListIssuesReque
 	return out, nil
 }
 
+func (c *issueServiceClient) ListDeletedIssues(ctx context.Context, in *ListDeletedIssuesRequest, opts ...grpc.CallOption) (*ListDeletedIssuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedIssuesResponse)
+	err := c.cc.Invoke(ctx, IssueService_ListDeletedIssues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) RestoreIssue(ctx context.Context, in *RestoreIssueRequest, opts ...grpc.CallOption) (*RestoreIssueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreIssueResponse)
+	err := c.cc.Invoke(ctx, IssueService_RestoreIssue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *issueServiceClient) SearchIssues(ctx context.Context, in *SearchIssuesRequest, opts ...grpc.CallOption) (*SearchIssuesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SearchIssuesResponse)
@@ -258,440 +375,1667 @@ func (c *issueServiceClient) ListCustomFields(ctx context.Context, in *ListCusto
 	return out, nil
 }
 
-// IssueServiceServer is the server API for IssueService service.
-// All implementations must embed UnimplementedIssueServiceServer
-// for forward compatibility.
-//
-// Issue service
-type IssueServiceServer interface {
-	// Issue management
-	CreateIssue(context.Context, *CreateIssueRequest) (*CreateIssueResponse, error)
-	GetIssue(context.Context, *GetIssueRequest) (*GetIssueResponse, error)
-	GetIssueByKey(context.Context, *GetIssueByKeyRequest) (*GetIssueByKeyResponse, error)
-	UpdateIssue(context.Context, *UpdateIssueRequest) (*UpdateIssueResponse, error)
-	DeleteIssue(context.Context, *DeleteIssueRequest) (*DeleteIssueResponse, error)
-	ListIssues(context.Context, *ListIssuesRequest) (*ListIssuesResponse, error)
-	SearchIssues(context.Context, *SearchIssuesRequest) (*SearchIssuesResponse, error)
-	// Issue hierarchy
-	GetIssueChildren(context.Context, *GetIssueChildrenRequest) (*GetIssueChildrenResponse, error)
-	MoveIssue(context.Context, *MoveIssueRequest) (*MoveIssueResponse, error)
-	// Issue links
-	CreateIssueLink(context.Context, *CreateIssueLinkRequest) (*CreateIssueLinkResponse, error)
-	DeleteIssueLink(context.Context, *DeleteIssueLinkRequest) (*DeleteIssueLinkResponse, error)
-	GetIssueLinks(context.Context, *GetIssueLinksRequest) (*GetIssueLinksResponse, error)
-	// Watchers
-	AddWatcher(context.Context, *AddWatcherRequest) (*AddWatcherResponse, error)
-	RemoveWatcher(context.Context, *RemoveWatcherRequest) (*RemoveWatcherResponse, error)
-	// Custom fields
-	CreateCustomField(context.Context, *CreateCustomFieldRequest) (*CreateCustomFieldResponse, error)
-	UpdateCustomField(context.Context, *UpdateCustomFieldRequest) (*UpdateCustomFieldResponse, error)
-	DeleteCustomField(context.Context, *DeleteCustomFieldRequest) (*DeleteCustomFieldResponse, error)
-	ListCustomFields(context.Context, *ListCustomFieldsRequest) (*ListCustomFieldsResponse, error)
-	mustEmbedUnimplementedIssueServiceServer()
+func (c *issueServiceClient) CreateCustomFieldContext(ctx context.Context, in *CreateCustomFieldContextRequest, opts ...grpc.CallOption) (*CreateCustomFieldContextResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCustomFieldContextResponse)
+	err := c.cc.Invoke(ctx, IssueService_CreateCustomFieldContext_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedIssueServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedIssueServiceServer struct{}
+func (c *issueServiceClient) UpdateCustomFieldContext(ctx context.Context, in *UpdateCustomFieldContextRequest, opts ...grpc.CallOption) (*UpdateCustomFieldContextResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCustomFieldContextResponse)
+	err := c.cc.Invoke(ctx, IssueService_UpdateCustomFieldContext_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedIssueServiceServer) CreateIssue(context.Context, *CreateIssueRequest) (*CreateIssueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateIssue not implemented")
+func (c *issueServiceClient) DeleteCustomFieldContext(ctx context.Context, in *DeleteCustomFieldContextRequest, opts ...grpc.CallOption) (*DeleteCustomFieldContextResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCustomFieldContextResponse)
+	err := c.cc.Invoke(ctx, IssueService_DeleteCustomFieldContext_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIssueServiceServer) GetIssue(context.Context, *GetIssueRequest) (*GetIssueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetIssue not implemented")
+
+func (c *issueServiceClient) ListCustomFieldContexts(ctx context.Context, in *ListCustomFieldContextsRequest, opts ...grpc.CallOption) (*ListCustomFieldContextsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCustomFieldContextsResponse)
+	err := c.cc.Invoke(ctx, IssueService_ListCustomFieldContexts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIssueServiceServer) GetIssueByKey(context.Context, *GetIssueByKeyRequest) (*GetIssueByKeyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetIssueByKey not implemented")
+
+func (c *issueServiceClient) CreateLabel(ctx context.Context, in *CreateLabelRequest, opts ...grpc.CallOption) (*CreateLabelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLabelResponse)
+	err := c.cc.Invoke(ctx, IssueService_CreateLabel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIssueServiceServer) UpdateIssue(context.Context, *UpdateIssueRequest) (*UpdateIssueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateIssue not implemented")
+
+func (c *issueServiceClient) UpdateLabel(ctx context.Context, in *UpdateLabelRequest, opts ...grpc.CallOption) (*UpdateLabelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateLabelResponse)
+	err := c.cc.Invoke(ctx, IssueService_UpdateLabel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIssueServiceServer) DeleteIssue(context.Context, *DeleteIssueRequest) (*DeleteIssueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteIssue not implemented")
+
+func (c *issueServiceClient) DeleteLabel(ctx context.Context, in *DeleteLabelRequest, opts ...grpc.CallOption) (*DeleteLabelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLabelResponse)
+	err := c.cc.Invoke(ctx, IssueService_DeleteLabel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIssueServiceServer) ListIssues(context.Context, *ListIssuesRequest) (*ListIssuesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListIssues not implemented")
+
+func (c *issueServiceClient) ListLabels(ctx context.Context, in *ListLabelsRequest, opts ...grpc.CallOption) (*ListLabelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLabelsResponse)
+	err := c.cc.Invoke(ctx, IssueService_ListLabels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIssueServiceServer) SearchIssues(context.Context, *SearchIssuesRequest) (*SearchIssuesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchIssues not implemented")
+
+func (c *issueServiceClient) BulkUpdateIssueLabels(ctx context.Context, in *BulkUpdateIssueLabelsRequest, opts ...grpc.CallOption) (*BulkUpdateIssueLabelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpdateIssueLabelsResponse)
+	err := c.cc.Invoke(ctx, IssueService_BulkUpdateIssueLabels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIssueServiceServer) GetIssueChildren(context.Context, *GetIssueChildrenRequest) (*GetIssueChildrenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetIssueChildren not implemented")
+
+func (c *issueServiceClient) CreateComponent(ctx context.Context, in *CreateComponentRequest, opts ...grpc.CallOption) (*CreateComponentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateComponentResponse)
+	err := c.cc.Invoke(ctx, IssueService_CreateComponent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIssueServiceServer) MoveIssue(context.Context, *MoveIssueRequest) (*MoveIssueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MoveIssue not implemented")
+
+func (c *issueServiceClient) UpdateComponent(ctx context.Context, in *UpdateComponentRequest, opts ...grpc.CallOption) (*UpdateComponentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateComponentResponse)
+	err := c.cc.Invoke(ctx, IssueService_UpdateComponent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIssueServiceServer) CreateIssueLink(context.Context, *CreateIssueLinkRequest) (*CreateIssueLinkResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateIssueLink not implemented")
+
+func (c *issueServiceClient) DeleteComponent(ctx context.Context, in *DeleteComponentRequest, opts ...grpc.CallOption) (*DeleteComponentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteComponentResponse)
+	err := c.cc.Invoke(ctx, IssueService_DeleteComponent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIssueServiceServer) DeleteIssueLink(context.Context, *DeleteIssueLinkRequest) (*DeleteIssueLinkResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteIssueLink not implemented")
+
+func (c *issueServiceClient) ListComponents(ctx context.Context, in *ListComponentsRequest, opts ...grpc.CallOption) (*ListComponentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListComponentsResponse)
+	err := c.cc.Invoke(ctx, IssueService_ListComponents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIssueServiceServer) GetIssueLinks(context.Context, *GetIssueLinksRequest) (*GetIssueLinksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetIssueLinks not implemented")
+
+func (c *issueServiceClient) BulkUpdateIssueComponents(ctx context.Context, in *BulkUpdateIssueComponentsRequest, opts ...grpc.CallOption) (*BulkUpdateIssueComponentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpdateIssueComponentsResponse)
+	err := c.cc.Invoke(ctx, IssueService_BulkUpdateIssueComponents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIssueServiceServer) AddWatcher(context.Context, *AddWatcherRequest) (*AddWatcherResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddWatcher not implemented")
+
+func (c *issueServiceClient) SetIssuesSprint(ctx context.Context, in *SetIssuesSprintRequest, opts ...grpc.CallOption) (*SetIssuesSprintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetIssuesSprintResponse)
+	err := c.cc.Invoke(ctx, IssueService_SetIssuesSprint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIssueServiceServer) RemoveWatcher(context.Context, *RemoveWatcherRequest) (*RemoveWatcherResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveWatcher not implemented")
+
+func (c *issueServiceClient) RankIssueBefore(ctx context.Context, in *RankIssueBeforeRequest, opts ...grpc.CallOption) (*RankIssueBeforeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RankIssueBeforeResponse)
+	err := c.cc.Invoke(ctx, IssueService_RankIssueBefore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIssueServiceServer) CreateCustomField(context.Context, *CreateCustomFieldRequest) (*CreateCustomFieldResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCustomField not implemented")
+
+func (c *issueServiceClient) RankIssueAfter(ctx context.Context, in *RankIssueAfterRequest, opts ...grpc.CallOption) (*RankIssueAfterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RankIssueAfterResponse)
+	err := c.cc.Invoke(ctx, IssueService_RankIssueAfter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) RankIssues(ctx context.Context, in *RankIssuesRequest, opts ...grpc.CallOption) (*RankIssuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RankIssuesResponse)
+	err := c.cc.Invoke(ctx, IssueService_RankIssues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) BulkUpdateIssues(ctx context.Context, in *BulkUpdateIssuesRequest, opts ...grpc.CallOption) (*BulkUpdateIssuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpdateIssuesResponse)
+	err := c.cc.Invoke(ctx, IssueService_BulkUpdateIssues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJobResponse)
+	err := c.cc.Invoke(ctx, IssueService_GetJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) AddWorklog(ctx context.Context, in *AddWorklogRequest, opts ...grpc.CallOption) (*AddWorklogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddWorklogResponse)
+	err := c.cc.Invoke(ctx, IssueService_AddWorklog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) UpdateWorklog(ctx context.Context, in *UpdateWorklogRequest, opts ...grpc.CallOption) (*UpdateWorklogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateWorklogResponse)
+	err := c.cc.Invoke(ctx, IssueService_UpdateWorklog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) DeleteWorklog(ctx context.Context, in *DeleteWorklogRequest, opts ...grpc.CallOption) (*DeleteWorklogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWorklogResponse)
+	err := c.cc.Invoke(ctx, IssueService_DeleteWorklog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) ListWorklogs(ctx context.Context, in *ListWorklogsRequest, opts ...grpc.CallOption) (*ListWorklogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWorklogsResponse)
+	err := c.cc.Invoke(ctx, IssueService_ListWorklogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) GetTimeTracking(ctx context.Context, in *GetTimeTrackingRequest, opts ...grpc.CallOption) (*GetTimeTrackingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTimeTrackingResponse)
+	err := c.cc.Invoke(ctx, IssueService_GetTimeTracking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) GetTimesheet(ctx context.Context, in *GetTimesheetRequest, opts ...grpc.CallOption) (*GetTimesheetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTimesheetResponse)
+	err := c.cc.Invoke(ctx, IssueService_GetTimesheet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) CreateIssueSchedule(ctx context.Context, in *CreateIssueScheduleRequest, opts ...grpc.CallOption) (*CreateIssueScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateIssueScheduleResponse)
+	err := c.cc.Invoke(ctx, IssueService_CreateIssueSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) GetIssueSchedule(ctx context.Context, in *GetIssueScheduleRequest, opts ...grpc.CallOption) (*GetIssueScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIssueScheduleResponse)
+	err := c.cc.Invoke(ctx, IssueService_GetIssueSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) UpdateIssueSchedule(ctx context.Context, in *UpdateIssueScheduleRequest, opts ...grpc.CallOption) (*UpdateIssueScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateIssueScheduleResponse)
+	err := c.cc.Invoke(ctx, IssueService_UpdateIssueSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) DeleteIssueSchedule(ctx context.Context, in *DeleteIssueScheduleRequest, opts ...grpc.CallOption) (*DeleteIssueScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteIssueScheduleResponse)
+	err := c.cc.Invoke(ctx, IssueService_DeleteIssueSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) ListIssueSchedules(ctx context.Context, in *ListIssueSchedulesRequest, opts ...grpc.CallOption) (*ListIssueSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIssueSchedulesResponse)
+	err := c.cc.Invoke(ctx, IssueService_ListIssueSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) CreateIssueTemplate(ctx context.Context, in *CreateIssueTemplateRequest, opts ...grpc.CallOption) (*CreateIssueTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateIssueTemplateResponse)
+	err := c.cc.Invoke(ctx, IssueService_CreateIssueTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) GetIssueTemplate(ctx context.Context, in *GetIssueTemplateRequest, opts ...grpc.CallOption) (*GetIssueTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIssueTemplateResponse)
+	err := c.cc.Invoke(ctx, IssueService_GetIssueTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) UpdateIssueTemplate(ctx context.Context, in *UpdateIssueTemplateRequest, opts ...grpc.CallOption) (*UpdateIssueTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateIssueTemplateResponse)
+	err := c.cc.Invoke(ctx, IssueService_UpdateIssueTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) DeleteIssueTemplate(ctx context.Context, in *DeleteIssueTemplateRequest, opts ...grpc.CallOption) (*DeleteIssueTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteIssueTemplateResponse)
+	err := c.cc.Invoke(ctx, IssueService_DeleteIssueTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) ListIssueTemplates(ctx context.Context, in *ListIssueTemplatesRequest, opts ...grpc.CallOption) (*ListIssueTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIssueTemplatesResponse)
+	err := c.cc.Invoke(ctx, IssueService_ListIssueTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IssueServiceServer is the server API for IssueService service.
+// All implementations must embed UnimplementedIssueServiceServer
+// for forward compatibility.
+//
+// Issue service
+type IssueServiceServer interface {
+	// Issue management
+	CreateIssue(context.Context, *CreateIssueRequest) (*CreateIssueResponse, error)
+	GetIssue(context.Context, *GetIssueRequest) (*GetIssueResponse, error)
+	GetIssueByKey(context.Context, *GetIssueByKeyRequest) (*GetIssueByKeyResponse, error)
+	BatchGetIssues(context.Context, *BatchGetIssuesRequest) (*BatchGetIssuesResponse, error)
+	UpdateIssue(context.Context, *UpdateIssueRequest) (*UpdateIssueResponse, error)
+	DeleteIssue(context.Context, *DeleteIssueRequest) (*DeleteIssueResponse, error)
+	ListIssues(context.Context, *ListIssuesRequest) (*ListIssuesResponse, error)
+	ListDeletedIssues(context.Context, *ListDeletedIssuesRequest) (*ListDeletedIssuesResponse, error)
+	RestoreIssue(context.Context, *RestoreIssueRequest) (*RestoreIssueResponse, error)
+	SearchIssues(context.Context, *SearchIssuesRequest) (*SearchIssuesResponse, error)
+	// Issue hierarchy
+	GetIssueChildren(context.Context, *GetIssueChildrenRequest) (*GetIssueChildrenResponse, error)
+	MoveIssue(context.Context, *MoveIssueRequest) (*MoveIssueResponse, error)
+	// Issue links
+	CreateIssueLink(context.Context, *CreateIssueLinkRequest) (*CreateIssueLinkResponse, error)
+	DeleteIssueLink(context.Context, *DeleteIssueLinkRequest) (*DeleteIssueLinkResponse, error)
+	GetIssueLinks(context.Context, *GetIssueLinksRequest) (*GetIssueLinksResponse, error)
+	// Watchers
+	AddWatcher(context.Context, *AddWatcherRequest) (*AddWatcherResponse, error)
+	RemoveWatcher(context.Context, *RemoveWatcherRequest) (*RemoveWatcherResponse, error)
+	// Custom fields
+	CreateCustomField(context.Context, *CreateCustomFieldRequest) (*CreateCustomFieldResponse, error)
+	UpdateCustomField(context.Context, *UpdateCustomFieldRequest) (*UpdateCustomFieldResponse, error)
+	DeleteCustomField(context.Context, *DeleteCustomFieldRequest) (*DeleteCustomFieldResponse, error)
+	ListCustomFields(context.Context, *ListCustomFieldsRequest) (*ListCustomFieldsResponse, error)
+	// Custom field contexts
+	CreateCustomFieldContext(context.Context, *CreateCustomFieldContextRequest) (*CreateCustomFieldContextResponse, error)
+	UpdateCustomFieldContext(context.Context, *UpdateCustomFieldContextRequest) (*UpdateCustomFieldContextResponse, error)
+	DeleteCustomFieldContext(context.Context, *DeleteCustomFieldContextRequest) (*DeleteCustomFieldContextResponse, error)
+	ListCustomFieldContexts(context.Context, *ListCustomFieldContextsRequest) (*ListCustomFieldContextsResponse, error)
+	// Labels
+	CreateLabel(context.Context, *CreateLabelRequest) (*CreateLabelResponse, error)
+	UpdateLabel(context.Context, *UpdateLabelRequest) (*UpdateLabelResponse, error)
+	DeleteLabel(context.Context, *DeleteLabelRequest) (*DeleteLabelResponse, error)
+	ListLabels(context.Context, *ListLabelsRequest) (*ListLabelsResponse, error)
+	BulkUpdateIssueLabels(context.Context, *BulkUpdateIssueLabelsRequest) (*BulkUpdateIssueLabelsResponse, error)
+	// Components
+	CreateComponent(context.Context, *CreateComponentRequest) (*CreateComponentResponse, error)
+	UpdateComponent(context.Context, *UpdateComponentRequest) (*UpdateComponentResponse, error)
+	DeleteComponent(context.Context, *DeleteComponentRequest) (*DeleteComponentResponse, error)
+	ListComponents(context.Context, *ListComponentsRequest) (*ListComponentsResponse, error)
+	BulkUpdateIssueComponents(context.Context, *BulkUpdateIssueComponentsRequest) (*BulkUpdateIssueComponentsResponse, error)
+	// Sprints, kept in step by the sprint service
+	SetIssuesSprint(context.Context, *SetIssuesSprintRequest) (*SetIssuesSprintResponse, error)
+	// Backlog ranking
+	RankIssueBefore(context.Context, *RankIssueBeforeRequest) (*RankIssueBeforeResponse, error)
+	RankIssueAfter(context.Context, *RankIssueAfterRequest) (*RankIssueAfterResponse, error)
+	RankIssues(context.Context, *RankIssuesRequest) (*RankIssuesResponse, error)
+	// Bulk operations
+	BulkUpdateIssues(context.Context, *BulkUpdateIssuesRequest) (*BulkUpdateIssuesResponse, error)
+	GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error)
+	// Time tracking
+	AddWorklog(context.Context, *AddWorklogRequest) (*AddWorklogResponse, error)
+	UpdateWorklog(context.Context, *UpdateWorklogRequest) (*UpdateWorklogResponse, error)
+	DeleteWorklog(context.Context, *DeleteWorklogRequest) (*DeleteWorklogResponse, error)
+	ListWorklogs(context.Context, *ListWorklogsRequest) (*ListWorklogsResponse, error)
+	GetTimeTracking(context.Context, *GetTimeTrackingRequest) (*GetTimeTrackingResponse, error)
+	GetTimesheet(context.Context, *GetTimesheetRequest) (*GetTimesheetResponse, error)
+	// Recurring issues
+	CreateIssueSchedule(context.Context, *CreateIssueScheduleRequest) (*CreateIssueScheduleResponse, error)
+	GetIssueSchedule(context.Context, *GetIssueScheduleRequest) (*GetIssueScheduleResponse, error)
+	UpdateIssueSchedule(context.Context, *UpdateIssueScheduleRequest) (*UpdateIssueScheduleResponse, error)
+	DeleteIssueSchedule(context.Context, *DeleteIssueScheduleRequest) (*DeleteIssueScheduleResponse, error)
+	ListIssueSchedules(context.Context, *ListIssueSchedulesRequest) (*ListIssueSchedulesResponse, error)
+	// Issue templates
+	CreateIssueTemplate(context.Context, *CreateIssueTemplateRequest) (*CreateIssueTemplateResponse, error)
+	GetIssueTemplate(context.Context, *GetIssueTemplateRequest) (*GetIssueTemplateResponse, error)
+	UpdateIssueTemplate(context.Context, *UpdateIssueTemplateRequest) (*UpdateIssueTemplateResponse, error)
+	DeleteIssueTemplate(context.Context, *DeleteIssueTemplateRequest) (*DeleteIssueTemplateResponse, error)
+	ListIssueTemplates(context.Context, *ListIssueTemplatesRequest) (*ListIssueTemplatesResponse, error)
+	mustEmbedUnimplementedIssueServiceServer()
+}
+
+// UnimplementedIssueServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedIssueServiceServer struct{}
+
+func (UnimplementedIssueServiceServer) CreateIssue(context.Context, *CreateIssueRequest) (*CreateIssueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIssue not implemented")
+}
+func (UnimplementedIssueServiceServer) GetIssue(context.Context, *GetIssueRequest) (*GetIssueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssue not implemented")
+}
+func (UnimplementedIssueServiceServer) GetIssueByKey(context.Context, *GetIssueByKeyRequest) (*GetIssueByKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssueByKey not implemented")
+}
+func (UnimplementedIssueServiceServer) BatchGetIssues(context.Context, *BatchGetIssuesRequest) (*BatchGetIssuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetIssues not implemented")
+}
+func (UnimplementedIssueServiceServer) UpdateIssue(context.Context, *UpdateIssueRequest) (*UpdateIssueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIssue not implemented")
+}
+func (UnimplementedIssueServiceServer) DeleteIssue(context.Context, *DeleteIssueRequest) (*DeleteIssueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIssue not implemented")
+}
+func (UnimplementedIssueServiceServer) ListIssues(context.Context, *ListIssuesRequest) (*ListIssuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIssues not implemented")
+}
+func (UnimplementedIssueServiceServer) ListDeletedIssues(context.Context, *ListDeletedIssuesRequest) (*ListDeletedIssuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedIssues not implemented")
+}
+func (UnimplementedIssueServiceServer) RestoreIssue(context.Context, *RestoreIssueRequest) (*RestoreIssueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreIssue not implemented")
+}
+func (UnimplementedIssueServiceServer) SearchIssues(context.Context, *SearchIssuesRequest) (*SearchIssuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchIssues not implemented")
+}
+func (UnimplementedIssueServiceServer) GetIssueChildren(context.Context, *GetIssueChildrenRequest) (*GetIssueChildrenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssueChildren not implemented")
+}
+func (UnimplementedIssueServiceServer) MoveIssue(context.Context, *MoveIssueRequest) (*MoveIssueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveIssue not implemented")
+}
+func (UnimplementedIssueServiceServer) CreateIssueLink(context.Context, *CreateIssueLinkRequest) (*CreateIssueLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIssueLink not implemented")
+}
+func (UnimplementedIssueServiceServer) DeleteIssueLink(context.Context, *DeleteIssueLinkRequest) (*DeleteIssueLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIssueLink not implemented")
+}
+func (UnimplementedIssueServiceServer) GetIssueLinks(context.Context, *GetIssueLinksRequest) (*GetIssueLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssueLinks not implemented")
+}
+func (UnimplementedIssueServiceServer) AddWatcher(context.Context, *AddWatcherRequest) (*AddWatcherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWatcher not implemented")
+}
+func (UnimplementedIssueServiceServer) RemoveWatcher(context.Context, *RemoveWatcherRequest) (*RemoveWatcherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWatcher not implemented")
+}
+func (UnimplementedIssueServiceServer) CreateCustomField(context.Context, *CreateCustomFieldRequest) (*CreateCustomFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCustomField not implemented")
+}
+func (UnimplementedIssueServiceServer) UpdateCustomField(context.Context, *UpdateCustomFieldRequest) (*UpdateCustomFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomField not implemented")
+}
+func (UnimplementedIssueServiceServer) DeleteCustomField(context.Context, *DeleteCustomFieldRequest) (*DeleteCustomFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomField not implemented")
+}
+func (UnimplementedIssueServiceServer) ListCustomFields(context.Context, *ListCustomFieldsRequest) (*ListCustomFieldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCustomFields not implemented")
+}
+func (UnimplementedIssueServiceServer) CreateCustomFieldContext(context.Context, *CreateCustomFieldContextRequest) (*CreateCustomFieldContextResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCustomFieldContext not implemented")
+}
+func (UnimplementedIssueServiceServer) UpdateCustomFieldContext(context.Context, *UpdateCustomFieldContextRequest) (*UpdateCustomFieldContextResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomFieldContext not implemented")
+}
+func (UnimplementedIssueServiceServer) DeleteCustomFieldContext(context.Context, *DeleteCustomFieldContextRequest) (*DeleteCustomFieldContextResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomFieldContext not implemented")
+}
+func (UnimplementedIssueServiceServer) ListCustomFieldContexts(context.Context, *ListCustomFieldContextsRequest) (*ListCustomFieldContextsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCustomFieldContexts not implemented")
+}
+func (UnimplementedIssueServiceServer) CreateLabel(context.Context, *CreateLabelRequest) (*CreateLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLabel not implemented")
+}
+func (UnimplementedIssueServiceServer) UpdateLabel(context.Context, *UpdateLabelRequest) (*UpdateLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLabel not implemented")
+}
+func (UnimplementedIssueServiceServer) DeleteLabel(context.Context, *DeleteLabelRequest) (*DeleteLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLabel not implemented")
+}
+func (UnimplementedIssueServiceServer) ListLabels(context.Context, *ListLabelsRequest) (*ListLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLabels not implemented")
+}
+func (UnimplementedIssueServiceServer) BulkUpdateIssueLabels(context.Context, *BulkUpdateIssueLabelsRequest) (*BulkUpdateIssueLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdateIssueLabels not implemented")
+}
+func (UnimplementedIssueServiceServer) CreateComponent(context.Context, *CreateComponentRequest) (*CreateComponentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComponent not implemented")
+}
+func (UnimplementedIssueServiceServer) UpdateComponent(context.Context, *UpdateComponentRequest) (*UpdateComponentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComponent not implemented")
+}
+func (UnimplementedIssueServiceServer) DeleteComponent(context.Context, *DeleteComponentRequest) (*DeleteComponentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComponent not implemented")
+}
+func (UnimplementedIssueServiceServer) ListComponents(context.Context, *ListComponentsRequest) (*ListComponentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComponents not implemented")
+}
+func (UnimplementedIssueServiceServer) BulkUpdateIssueComponents(context.Context, *BulkUpdateIssueComponentsRequest) (*BulkUpdateIssueComponentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdateIssueComponents not implemented")
+}
+func (UnimplementedIssueServiceServer) SetIssuesSprint(context.Context, *SetIssuesSprintRequest) (*SetIssuesSprintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIssuesSprint not implemented")
+}
+func (UnimplementedIssueServiceServer) RankIssueBefore(context.Context, *RankIssueBeforeRequest) (*RankIssueBeforeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RankIssueBefore not implemented")
+}
+func (UnimplementedIssueServiceServer) RankIssueAfter(context.Context, *RankIssueAfterRequest) (*RankIssueAfterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RankIssueAfter not implemented")
+}
+func (UnimplementedIssueServiceServer) RankIssues(context.Context, *RankIssuesRequest) (*RankIssuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RankIssues not implemented")
+}
+func (UnimplementedIssueServiceServer) BulkUpdateIssues(context.Context, *BulkUpdateIssuesRequest) (*BulkUpdateIssuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdateIssues not implemented")
+}
+func (UnimplementedIssueServiceServer) GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedIssueServiceServer) AddWorklog(context.Context, *AddWorklogRequest) (*AddWorklogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWorklog not implemented")
+}
+func (UnimplementedIssueServiceServer) UpdateWorklog(context.Context, *UpdateWorklogRequest) (*UpdateWorklogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorklog not implemented")
+}
+func (UnimplementedIssueServiceServer) DeleteWorklog(context.Context, *DeleteWorklogRequest) (*DeleteWorklogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorklog not implemented")
+}
+func (UnimplementedIssueServiceServer) ListWorklogs(context.Context, *ListWorklogsRequest) (*ListWorklogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorklogs not implemented")
+}
+func (UnimplementedIssueServiceServer) GetTimeTracking(context.Context, *GetTimeTrackingRequest) (*GetTimeTrackingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeTracking not implemented")
+}
+func (UnimplementedIssueServiceServer) GetTimesheet(context.Context, *GetTimesheetRequest) (*GetTimesheetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimesheet not implemented")
+}
+func (UnimplementedIssueServiceServer) CreateIssueSchedule(context.Context, *CreateIssueScheduleRequest) (*CreateIssueScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIssueSchedule not implemented")
+}
+func (UnimplementedIssueServiceServer) GetIssueSchedule(context.Context, *GetIssueScheduleRequest) (*GetIssueScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssueSchedule not implemented")
+}
+func (UnimplementedIssueServiceServer) UpdateIssueSchedule(context.Context, *UpdateIssueScheduleRequest) (*UpdateIssueScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIssueSchedule not implemented")
+}
+func (UnimplementedIssueServiceServer) DeleteIssueSchedule(context.Context, *DeleteIssueScheduleRequest) (*DeleteIssueScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIssueSchedule not implemented")
+}
+func (UnimplementedIssueServiceServer) ListIssueSchedules(context.Context, *ListIssueSchedulesRequest) (*ListIssueSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIssueSchedules not implemented")
+}
+func (UnimplementedIssueServiceServer) CreateIssueTemplate(context.Context, *CreateIssueTemplateRequest) (*CreateIssueTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIssueTemplate not implemented")
+}
+func (UnimplementedIssueServiceServer) GetIssueTemplate(context.Context, *GetIssueTemplateRequest) (*GetIssueTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssueTemplate not implemented")
+}
+func (UnimplementedIssueServiceServer) UpdateIssueTemplate(context.Context, *UpdateIssueTemplateRequest) (*UpdateIssueTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIssueTemplate not implemented")
+}
+func (UnimplementedIssueServiceServer) DeleteIssueTemplate(context.Context, *DeleteIssueTemplateRequest) (*DeleteIssueTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIssueTemplate not implemented")
+}
+func (UnimplementedIssueServiceServer) ListIssueTemplates(context.Context, *ListIssueTemplatesRequest) (*ListIssueTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIssueTemplates not implemented")
+}
+func (UnimplementedIssueServiceServer) mustEmbedUnimplementedIssueServiceServer() {}
+func (UnimplementedIssueServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeIssueServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IssueServiceServer will
+// result in compilation errors.
+type UnsafeIssueServiceServer interface {
+	mustEmbedUnimplementedIssueServiceServer()
+}
+
+func RegisterIssueServiceServer(s grpc.ServiceRegistrar, srv IssueServiceServer) {
+	// If the following call pancis, it indicates UnimplementedIssueServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&IssueService_ServiceDesc, srv)
+}
+
+func _IssueService_CreateIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).CreateIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_CreateIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).CreateIssue(ctx, req.(*CreateIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_GetIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).GetIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_GetIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).GetIssue(ctx, req.(*GetIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_GetIssueByKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssueByKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).GetIssueByKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_GetIssueByKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).GetIssueByKey(ctx, req.(*GetIssueByKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_BatchGetIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetIssuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).BatchGetIssues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_BatchGetIssues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).BatchGetIssues(ctx, req.(*BatchGetIssuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_UpdateIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).UpdateIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_UpdateIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).UpdateIssue(ctx, req.(*UpdateIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_DeleteIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).DeleteIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_DeleteIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).DeleteIssue(ctx, req.(*DeleteIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_ListIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIssuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).ListIssues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_ListIssues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).ListIssues(ctx, req.(*ListIssuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_ListDeletedIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedIssuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).ListDeletedIssues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_ListDeletedIssues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).ListDeletedIssues(ctx, req.(*ListDeletedIssuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_RestoreIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).RestoreIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_RestoreIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).RestoreIssue(ctx, req.(*RestoreIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_SearchIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchIssuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).SearchIssues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_SearchIssues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).SearchIssues(ctx, req.(*SearchIssuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_GetIssueChildren_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssueChildrenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).GetIssueChildren(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_GetIssueChildren_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).GetIssueChildren(ctx, req.(*GetIssueChildrenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_MoveIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).MoveIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_MoveIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).MoveIssue(ctx, req.(*MoveIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_CreateIssueLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIssueLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).CreateIssueLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_CreateIssueLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).CreateIssueLink(ctx, req.(*CreateIssueLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_DeleteIssueLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIssueLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).DeleteIssueLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_DeleteIssueLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).DeleteIssueLink(ctx, req.(*DeleteIssueLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_GetIssueLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssueLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).GetIssueLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_GetIssueLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).GetIssueLinks(ctx, req.(*GetIssueLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_AddWatcher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWatcherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).AddWatcher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_AddWatcher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).AddWatcher(ctx, req.(*AddWatcherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_RemoveWatcher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWatcherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).RemoveWatcher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_RemoveWatcher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).RemoveWatcher(ctx, req.(*RemoveWatcherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_CreateCustomField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCustomFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).CreateCustomField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_CreateCustomField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).CreateCustomField(ctx, req.(*CreateCustomFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_UpdateCustomField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCustomFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).UpdateCustomField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_UpdateCustomField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).UpdateCustomField(ctx, req.(*UpdateCustomFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_DeleteCustomField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCustomFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).DeleteCustomField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_DeleteCustomField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).DeleteCustomField(ctx, req.(*DeleteCustomFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_ListCustomFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCustomFieldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).ListCustomFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_ListCustomFields_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).ListCustomFields(ctx, req.(*ListCustomFieldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_CreateCustomFieldContext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCustomFieldContextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).CreateCustomFieldContext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_CreateCustomFieldContext_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).CreateCustomFieldContext(ctx, req.(*CreateCustomFieldContextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_UpdateCustomFieldContext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCustomFieldContextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).UpdateCustomFieldContext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_UpdateCustomFieldContext_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).UpdateCustomFieldContext(ctx, req.(*UpdateCustomFieldContextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_DeleteCustomFieldContext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCustomFieldContextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).DeleteCustomFieldContext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_DeleteCustomFieldContext_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).DeleteCustomFieldContext(ctx, req.(*DeleteCustomFieldContextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_ListCustomFieldContexts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCustomFieldContextsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).ListCustomFieldContexts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_ListCustomFieldContexts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).ListCustomFieldContexts(ctx, req.(*ListCustomFieldContextsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_CreateLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).CreateLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_CreateLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).CreateLabel(ctx, req.(*CreateLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_UpdateLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).UpdateLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_UpdateLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).UpdateLabel(ctx, req.(*UpdateLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_DeleteLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).DeleteLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_DeleteLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).DeleteLabel(ctx, req.(*DeleteLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_ListLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).ListLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_ListLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).ListLabels(ctx, req.(*ListLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_BulkUpdateIssueLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdateIssueLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).BulkUpdateIssueLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_BulkUpdateIssueLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).BulkUpdateIssueLabels(ctx, req.(*BulkUpdateIssueLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_CreateComponent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateComponentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).CreateComponent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_CreateComponent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).CreateComponent(ctx, req.(*CreateComponentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_UpdateComponent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateComponentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).UpdateComponent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_UpdateComponent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).UpdateComponent(ctx, req.(*UpdateComponentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_DeleteComponent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteComponentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).DeleteComponent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_DeleteComponent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).DeleteComponent(ctx, req.(*DeleteComponentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_ListComponents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListComponentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).ListComponents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_ListComponents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).ListComponents(ctx, req.(*ListComponentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedIssueServiceServer) UpdateCustomField(context.Context, *UpdateCustomFieldRequest) (*UpdateCustomFieldResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomField not implemented")
+
+func _IssueService_BulkUpdateIssueComponents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdateIssueComponentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).BulkUpdateIssueComponents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_BulkUpdateIssueComponents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).BulkUpdateIssueComponents(ctx, req.(*BulkUpdateIssueComponentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedIssueServiceServer) DeleteCustomField(context.Context, *DeleteCustomFieldRequest) (*DeleteCustomFieldResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomField not implemented")
+
+func _IssueService_SetIssuesSprint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIssuesSprintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).SetIssuesSprint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_SetIssuesSprint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).SetIssuesSprint(ctx, req.(*SetIssuesSprintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedIssueServiceServer) ListCustomFields(context.Context, *ListCustomFieldsRequest) (*ListCustomFieldsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCustomFields not implemented")
+
+func _IssueService_RankIssueBefore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RankIssueBeforeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).RankIssueBefore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_RankIssueBefore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).RankIssueBefore(ctx, req.(*RankIssueBeforeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedIssueServiceServer) mustEmbedUnimplementedIssueServiceServer() {}
-func (UnimplementedIssueServiceServer) testEmbeddedByValue()                      {}
 
-// UnsafeIssueServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to IssueServiceServer will
-// result in compilation errors.
-type UnsafeIssueServiceServer interface {
-	mustEmbedUnimplementedIssueServiceServer()
+func _IssueService_RankIssueAfter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RankIssueAfterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).RankIssueAfter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_RankIssueAfter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).RankIssueAfter(ctx, req.(*RankIssueAfterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterIssueServiceServer(s grpc.ServiceRegistrar, srv IssueServiceServer) {
-	// If the following call pancis, it indicates UnimplementedIssueServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _IssueService_RankIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RankIssuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&IssueService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(IssueServiceServer).RankIssues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_RankIssues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).RankIssues(ctx, req.(*RankIssuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_CreateIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateIssueRequest)
+func _IssueService_BulkUpdateIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdateIssuesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).CreateIssue(ctx, in)
+		return srv.(IssueServiceServer).BulkUpdateIssues(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_CreateIssue_FullMethodName,
+		FullMethod: IssueService_BulkUpdateIssues_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).CreateIssue(ctx, req.(*CreateIssueRequest))
+		return srv.(IssueServiceServer).BulkUpdateIssues(ctx, req.(*BulkUpdateIssuesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_GetIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetIssueRequest)
+func _IssueService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).GetIssue(ctx, in)
+		return srv.(IssueServiceServer).GetJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_GetIssue_FullMethodName,
+		FullMethod: IssueService_GetJob_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).GetIssue(ctx, req.(*GetIssueRequest))
+		return srv.(IssueServiceServer).GetJob(ctx, req.(*GetJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_GetIssueByKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetIssueByKeyRequest)
+func _IssueService_AddWorklog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWorklogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).GetIssueByKey(ctx, in)
+		return srv.(IssueServiceServer).AddWorklog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_GetIssueByKey_FullMethodName,
+		FullMethod: IssueService_AddWorklog_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).GetIssueByKey(ctx, req.(*GetIssueByKeyRequest))
+		return srv.(IssueServiceServer).AddWorklog(ctx, req.(*AddWorklogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_UpdateIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateIssueRequest)
+func _IssueService_UpdateWorklog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorklogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).UpdateIssue(ctx, in)
+		return srv.(IssueServiceServer).UpdateWorklog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_UpdateIssue_FullMethodName,
+		FullMethod: IssueService_UpdateWorklog_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).UpdateIssue(ctx, req.(*UpdateIssueRequest))
+		return srv.(IssueServiceServer).UpdateWorklog(ctx, req.(*UpdateWorklogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_DeleteIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteIssueRequest)
+func _IssueService_DeleteWorklog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorklogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).DeleteIssue(ctx, in)
+		return srv.(IssueServiceServer).DeleteWorklog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_DeleteIssue_FullMethodName,
+		FullMethod: IssueService_DeleteWorklog_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).DeleteIssue(ctx, req.(*DeleteIssueRequest))
+		return srv.(IssueServiceServer).DeleteWorklog(ctx, req.(*DeleteWorklogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_ListIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListIssuesRequest)
+func _IssueService_ListWorklogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorklogsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).ListIssues(ctx, in)
+		return srv.(IssueServiceServer).ListWorklogs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_ListIssues_FullMethodName,
+		FullMethod: IssueService_ListWorklogs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).ListIssues(ctx, req.(*ListIssuesRequest))
+		return srv.(IssueServiceServer).ListWorklogs(ctx, req.(*ListWorklogsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_SearchIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchIssuesRequest)
+func _IssueService_GetTimeTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTimeTrackingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).SearchIssues(ctx, in)
+		return srv.(IssueServiceServer).GetTimeTracking(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_SearchIssues_FullMethodName,
+		FullMethod: IssueService_GetTimeTracking_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).SearchIssues(ctx, req.(*SearchIssuesRequest))
+		return srv.(IssueServiceServer).GetTimeTracking(ctx, req.(*GetTimeTrackingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_GetIssueChildren_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetIssueChildrenRequest)
+func _IssueService_GetTimesheet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTimesheetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).GetIssueChildren(ctx, in)
+		return srv.(IssueServiceServer).GetTimesheet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_GetIssueChildren_FullMethodName,
+		FullMethod: IssueService_GetTimesheet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).GetIssueChildren(ctx, req.(*GetIssueChildrenRequest))
+		return srv.(IssueServiceServer).GetTimesheet(ctx, req.(*GetTimesheetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_MoveIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MoveIssueRequest)
+func _IssueService_CreateIssueSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIssueScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).MoveIssue(ctx, in)
+		return srv.(IssueServiceServer).CreateIssueSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_MoveIssue_FullMethodName,
+		FullMethod: IssueService_CreateIssueSchedule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).MoveIssue(ctx, req.(*MoveIssueRequest))
+		return srv.(IssueServiceServer).CreateIssueSchedule(ctx, req.(*CreateIssueScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_CreateIssueLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateIssueLinkRequest)
+func _IssueService_GetIssueSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssueScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).CreateIssueLink(ctx, in)
+		return srv.(IssueServiceServer).GetIssueSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_CreateIssueLink_FullMethodName,
+		FullMethod: IssueService_GetIssueSchedule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).CreateIssueLink(ctx, req.(*CreateIssueLinkRequest))
+		return srv.(IssueServiceServer).GetIssueSchedule(ctx, req.(*GetIssueScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_DeleteIssueLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteIssueLinkRequest)
+func _IssueService_UpdateIssueSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIssueScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).DeleteIssueLink(ctx, in)
+		return srv.(IssueServiceServer).UpdateIssueSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_DeleteIssueLink_FullMethodName,
+		FullMethod: IssueService_UpdateIssueSchedule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).DeleteIssueLink(ctx, req.(*DeleteIssueLinkRequest))
+		return srv.(IssueServiceServer).UpdateIssueSchedule(ctx, req.(*UpdateIssueScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_GetIssueLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetIssueLinksRequest)
+func _IssueService_DeleteIssueSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIssueScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).GetIssueLinks(ctx, in)
+		return srv.(IssueServiceServer).DeleteIssueSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_GetIssueLinks_FullMethodName,
+		FullMethod: IssueService_DeleteIssueSchedule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).GetIssueLinks(ctx, req.(*GetIssueLinksRequest))
+		return srv.(IssueServiceServer).DeleteIssueSchedule(ctx, req.(*DeleteIssueScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_AddWatcher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddWatcherRequest)
+func _IssueService_ListIssueSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIssueSchedulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).AddWatcher(ctx, in)
+		return srv.(IssueServiceServer).ListIssueSchedules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_AddWatcher_FullMethodName,
+		FullMethod: IssueService_ListIssueSchedules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).AddWatcher(ctx, req.(*AddWatcherRequest))
+		return srv.(IssueServiceServer).ListIssueSchedules(ctx, req.(*ListIssueSchedulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_RemoveWatcher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveWatcherRequest)
+func _IssueService_CreateIssueTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIssueTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).RemoveWatcher(ctx, in)
+		return srv.(IssueServiceServer).CreateIssueTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_RemoveWatcher_FullMethodName,
+		FullMethod: IssueService_CreateIssueTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).RemoveWatcher(ctx, req.(*RemoveWatcherRequest))
+		return srv.(IssueServiceServer).CreateIssueTemplate(ctx, req.(*CreateIssueTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_CreateCustomField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCustomFieldRequest)
+func _IssueService_GetIssueTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssueTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).CreateCustomField(ctx, in)
+		return srv.(IssueServiceServer).GetIssueTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_CreateCustomField_FullMethodName,
+		FullMethod: IssueService_GetIssueTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).CreateCustomField(ctx, req.(*CreateCustomFieldRequest))
+		return srv.(IssueServiceServer).GetIssueTemplate(ctx, req.(*GetIssueTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_UpdateCustomField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCustomFieldRequest)
+func _IssueService_UpdateIssueTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIssueTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).UpdateCustomField(ctx, in)
+		return srv.(IssueServiceServer).UpdateIssueTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_UpdateCustomField_FullMethodName,
+		FullMethod: IssueService_UpdateIssueTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).UpdateCustomField(ctx, req.(*UpdateCustomFieldRequest))
+		return srv.(IssueServiceServer).UpdateIssueTemplate(ctx, req.(*UpdateIssueTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_DeleteCustomField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCustomFieldRequest)
+func _IssueService_DeleteIssueTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIssueTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).DeleteCustomField(ctx, in)
+		return srv.(IssueServiceServer).DeleteIssueTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_DeleteCustomField_FullMethodName,
+		FullMethod: IssueService_DeleteIssueTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).DeleteCustomField(ctx, req.(*DeleteCustomFieldRequest))
+		return srv.(IssueServiceServer).DeleteIssueTemplate(ctx, req.(*DeleteIssueTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_ListCustomFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCustomFieldsRequest)
+func _IssueService_ListIssueTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIssueTemplatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).ListCustomFields(ctx, in)
+		return srv.(IssueServiceServer).ListIssueTemplates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_ListCustomFields_FullMethodName,
+		FullMethod: IssueService_ListIssueTemplates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).ListCustomFields(ctx, req.(*ListCustomFieldsRequest))
+		return srv.(IssueServiceServer).ListIssueTemplates(ctx, req.(*ListIssueTemplatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -715,6 +2059,10 @@ var IssueService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetIssueByKey",
 			Handler:    _IssueService_GetIssueByKey_Handler,
 		},
+		{
+			MethodName: "BatchGetIssues",
+			Handler:    _IssueService_BatchGetIssues_Handler,
+		},
 		{
 			MethodName: "UpdateIssue",
 			Handler:    _IssueService_UpdateIssue_Handler,
@@ -727,6 +2075,14 @@ var IssueService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListIssues",
 			Handler:    _IssueService_ListIssues_Handler,
 		},
+		{
+			MethodName: "ListDeletedIssues",
+			Handler:    _IssueService_ListDeletedIssues_Handler,
+		},
+		{
+			MethodName: "RestoreIssue",
+			Handler:    _IssueService_RestoreIssue_Handler,
+		},
 		{
 			MethodName: "SearchIssues",
 			Handler:    _IssueService_SearchIssues_Handler,
@@ -775,6 +2131,150 @@ var IssueService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListCustomFields",
 			Handler:    _IssueService_ListCustomFields_Handler,
 		},
+		{
+			MethodName: "CreateCustomFieldContext",
+			Handler:    _IssueService_CreateCustomFieldContext_Handler,
+		},
+		{
+			MethodName: "UpdateCustomFieldContext",
+			Handler:    _IssueService_UpdateCustomFieldContext_Handler,
+		},
+		{
+			MethodName: "DeleteCustomFieldContext",
+			Handler:    _IssueService_DeleteCustomFieldContext_Handler,
+		},
+		{
+			MethodName: "ListCustomFieldContexts",
+			Handler:    _IssueService_ListCustomFieldContexts_Handler,
+		},
+		{
+			MethodName: "CreateLabel",
+			Handler:    _IssueService_CreateLabel_Handler,
+		},
+		{
+			MethodName: "UpdateLabel",
+			Handler:    _IssueService_UpdateLabel_Handler,
+		},
+		{
+			MethodName: "DeleteLabel",
+			Handler:    _IssueService_DeleteLabel_Handler,
+		},
+		{
+			MethodName: "ListLabels",
+			Handler:    _IssueService_ListLabels_Handler,
+		},
+		{
+			MethodName: "BulkUpdateIssueLabels",
+			Handler:    _IssueService_BulkUpdateIssueLabels_Handler,
+		},
+		{
+			MethodName: "CreateComponent",
+			Handler:    _IssueService_CreateComponent_Handler,
+		},
+		{
+			MethodName: "UpdateComponent",
+			Handler:    _IssueService_UpdateComponent_Handler,
+		},
+		{
+			MethodName: "DeleteComponent",
+			Handler:    _IssueService_DeleteComponent_Handler,
+		},
+		{
+			MethodName: "ListComponents",
+			Handler:    _IssueService_ListComponents_Handler,
+		},
+		{
+			MethodName: "BulkUpdateIssueComponents",
+			Handler:    _IssueService_BulkUpdateIssueComponents_Handler,
+		},
+		{
+			MethodName: "SetIssuesSprint",
+			Handler:    _IssueService_SetIssuesSprint_Handler,
+		},
+		{
+			MethodName: "RankIssueBefore",
+			Handler:    _IssueService_RankIssueBefore_Handler,
+		},
+		{
+			MethodName: "RankIssueAfter",
+			Handler:    _IssueService_RankIssueAfter_Handler,
+		},
+		{
+			MethodName: "RankIssues",
+			Handler:    _IssueService_RankIssues_Handler,
+		},
+		{
+			MethodName: "BulkUpdateIssues",
+			Handler:    _IssueService_BulkUpdateIssues_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _IssueService_GetJob_Handler,
+		},
+		{
+			MethodName: "AddWorklog",
+			Handler:    _IssueService_AddWorklog_Handler,
+		},
+		{
+			MethodName: "UpdateWorklog",
+			Handler:    _IssueService_UpdateWorklog_Handler,
+		},
+		{
+			MethodName: "DeleteWorklog",
+			Handler:    _IssueService_DeleteWorklog_Handler,
+		},
+		{
+			MethodName: "ListWorklogs",
+			Handler:    _IssueService_ListWorklogs_Handler,
+		},
+		{
+			MethodName: "GetTimeTracking",
+			Handler:    _IssueService_GetTimeTracking_Handler,
+		},
+		{
+			MethodName: "GetTimesheet",
+			Handler:    _IssueService_GetTimesheet_Handler,
+		},
+		{
+			MethodName: "CreateIssueSchedule",
+			Handler:    _IssueService_CreateIssueSchedule_Handler,
+		},
+		{
+			MethodName: "GetIssueSchedule",
+			Handler:    _IssueService_GetIssueSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateIssueSchedule",
+			Handler:    _IssueService_UpdateIssueSchedule_Handler,
+		},
+		{
+			MethodName: "DeleteIssueSchedule",
+			Handler:    _IssueService_DeleteIssueSchedule_Handler,
+		},
+		{
+			MethodName: "ListIssueSchedules",
+			Handler:    _IssueService_ListIssueSchedules_Handler,
+		},
+		{
+			MethodName: "CreateIssueTemplate",
+			Handler:    _IssueService_CreateIssueTemplate_Handler,
+		},
+		{
+			MethodName: "GetIssueTemplate",
+			Handler:    _IssueService_GetIssueTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateIssueTemplate",
+			Handler:    _IssueService_UpdateIssueTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteIssueTemplate",
+			Handler:    _IssueService_DeleteIssueTemplate_Handler,
+		},
+		{
+			MethodName: "ListIssueTemplates",
+			Handler:    _IssueService_ListIssueTemplates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/issue/v1/issue.proto",
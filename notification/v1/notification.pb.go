@@ -21,6 +21,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type NotificationView int32
+
+const (
+	NotificationView_NOTIFICATION_VIEW_UNSPECIFIED NotificationView = 0 // Same as inbox
+	NotificationView_NOTIFICATION_VIEW_INBOX       NotificationView = 1
+	NotificationView_NOTIFICATION_VIEW_SNOOZED     NotificationView = 2
+	NotificationView_NOTIFICATION_VIEW_ARCHIVED    NotificationView = 3
+)
+
+// Enum value maps for NotificationView.
+var (
+	NotificationView_name = map[int32]string{
+		0: "NOTIFICATION_VIEW_UNSPECIFIED",
+		1: "NOTIFICATION_VIEW_INBOX",
+		2: "NOTIFICATION_VIEW_SNOOZED",
+		3: "NOTIFICATION_VIEW_ARCHIVED",
+	}
+	NotificationView_value = map[string]int32{
+		"NOTIFICATION_VIEW_UNSPECIFIED": 0,
+		"NOTIFICATION_VIEW_INBOX":       1,
+		"NOTIFICATION_VIEW_SNOOZED":     2,
+		"NOTIFICATION_VIEW_ARCHIVED":    3,
+	}
+)
+
+func (x NotificationView) Enum() *NotificationView {
+	p := new(NotificationView)
+	*p = x
+	return p
+}
+
+func (x NotificationView) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationView) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_proto_notification_v1_notification_proto_enumTypes[0].Descriptor()
+}
+
+func (NotificationView) Type() protoreflect.EnumType {
+	return &file_pkg_proto_notification_v1_notification_proto_enumTypes[0]
+}
+
+func (x NotificationView) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationView.Descriptor instead.
+func (NotificationView) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{0}
+}
+
 type Notification struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -32,6 +84,12 @@ type Notification struct {
 	Metadata      string                 `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	Read          bool                   `protobuf:"varint,8,opt,name=read,proto3" json:"read,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	GroupKey      string                 `protobuf:"bytes,10,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
+	Subject       string                 `protobuf:"bytes,11,opt,name=subject,proto3" json:"subject,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,12,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,13,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	SnoozedUntil  string                 `protobuf:"bytes,14,opt,name=snoozed_until,json=snoozedUntil,proto3" json:"snoozed_until,omitempty"`
+	ArchivedAt    string                 `protobuf:"bytes,15,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -129,6 +187,117 @@ func (x *Notification) GetCreatedAt() string {
 	return ""
 }
 
+func (x *Notification) GetGroupKey() string {
+	if x != nil {
+		return x.GroupKey
+	}
+	return ""
+}
+
+func (x *Notification) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Notification) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *Notification) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *Notification) GetSnoozedUntil() string {
+	if x != nil {
+		return x.SnoozedUntil
+	}
+	return ""
+}
+
+func (x *Notification) GetArchivedAt() string {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return ""
+}
+
+// NotificationThread collapses related notifications into their latest one
+type NotificationThread struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latest        *Notification          `protobuf:"bytes,1,opt,name=latest,proto3" json:"latest,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	UnreadCount   int32                  `protobuf:"varint,3,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	Summary       string                 `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"` // e.g. "5 updates on WEB-12"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationThread) Reset() {
+	*x = NotificationThread{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationThread) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationThread) ProtoMessage() {}
+
+func (x *NotificationThread) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationThread.ProtoReflect.Descriptor instead.
+func (*NotificationThread) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NotificationThread) GetLatest() *Notification {
+	if x != nil {
+		return x.Latest
+	}
+	return nil
+}
+
+func (x *NotificationThread) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *NotificationThread) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *NotificationThread) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
 type NotificationPreference struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -142,7 +311,7 @@ type NotificationPreference struct {
 
 func (x *NotificationPreference) Reset() {
 	*x = NotificationPreference{}
-	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[1]
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -154,7 +323,7 @@ func (x *NotificationPreference) String() string {
 func (*NotificationPreference) ProtoMessage() {}
 
 func (x *NotificationPreference) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[1]
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -167,7 +336,7 @@ func (x *NotificationPreference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationPreference.ProtoReflect.Descriptor instead.
 func (*NotificationPreference) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{1}
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *NotificationPreference) GetId() string {
@@ -210,13 +379,18 @@ type ListNotificationsRequest struct {
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Types         []string               `protobuf:"bytes,4,rep,name=types,proto3" json:"types,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,5,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UnreadOnly    bool                   `protobuf:"varint,6,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	View          NotificationView       `protobuf:"varint,7,opt,name=view,proto3,enum=notification.v1.NotificationView" json:"view,omitempty"`
+	Grouped       bool                   `protobuf:"varint,8,opt,name=grouped,proto3" json:"grouped,omitempty"` // List threads instead of notifications
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListNotificationsRequest) Reset() {
 	*x = ListNotificationsRequest{}
-	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[2]
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -228,7 +402,7 @@ func (x *ListNotificationsRequest) String() string {
 func (*ListNotificationsRequest) ProtoMessage() {}
 
 func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[2]
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -241,7 +415,7 @@ func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
 func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{2}
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ListNotificationsRequest) GetUserId() string {
@@ -265,17 +439,53 @@ func (x *ListNotificationsRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *ListNotificationsRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ListNotificationsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ListNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+func (x *ListNotificationsRequest) GetView() NotificationView {
+	if x != nil {
+		return x.View
+	}
+	return NotificationView_NOTIFICATION_VIEW_UNSPECIFIED
+}
+
+func (x *ListNotificationsRequest) GetGrouped() bool {
+	if x != nil {
+		return x.Grouped
+	}
+	return false
+}
+
 type ListNotificationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
 	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Threads       []*NotificationThread  `protobuf:"bytes,3,rep,name=threads,proto3" json:"threads,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListNotificationsResponse) Reset() {
 	*x = ListNotificationsResponse{}
-	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[3]
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -287,7 +497,7 @@ func (x *ListNotificationsResponse) String() string {
 func (*ListNotificationsResponse) ProtoMessage() {}
 
 func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[3]
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -300,7 +510,7 @@ func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
 func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{3}
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListNotificationsResponse) GetNotifications() []*Notification {
@@ -317,28 +527,37 @@ func (x *ListNotificationsResponse) GetTotal() int32 {
 	return 0
 }
 
-type MarkAsReadRequest struct {
+func (x *ListNotificationsResponse) GetThreads() []*NotificationThread {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+type SnoozeNotificationRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	NotificationId string                 `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SnoozeUntil    string                 `protobuf:"bytes,3,opt,name=snooze_until,json=snoozeUntil,proto3" json:"snooze_until,omitempty"` // RFC3339; empty ends the snooze
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *MarkAsReadRequest) Reset() {
-	*x = MarkAsReadRequest{}
-	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[4]
+func (x *SnoozeNotificationRequest) Reset() {
+	*x = SnoozeNotificationRequest{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarkAsReadRequest) String() string {
+func (x *SnoozeNotificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkAsReadRequest) ProtoMessage() {}
+func (*SnoozeNotificationRequest) ProtoMessage() {}
 
-func (x *MarkAsReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[4]
+func (x *SnoozeNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -349,75 +568,53 @@ func (x *MarkAsReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkAsReadRequest.ProtoReflect.Descriptor instead.
-func (*MarkAsReadRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SnoozeNotificationRequest.ProtoReflect.Descriptor instead.
+func (*SnoozeNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *MarkAsReadRequest) GetNotificationId() string {
+func (x *SnoozeNotificationRequest) GetNotificationId() string {
 	if x != nil {
 		return x.NotificationId
 	}
 	return ""
 }
 
-type MarkAsReadResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *MarkAsReadResponse) Reset() {
-	*x = MarkAsReadResponse{}
-	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *MarkAsReadResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MarkAsReadResponse) ProtoMessage() {}
-
-func (x *MarkAsReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[5]
+func (x *SnoozeNotificationRequest) GetUserId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.UserId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use MarkAsReadResponse.ProtoReflect.Descriptor instead.
-func (*MarkAsReadResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{5}
+func (x *SnoozeNotificationRequest) GetSnoozeUntil() string {
+	if x != nil {
+		return x.SnoozeUntil
+	}
+	return ""
 }
 
-type MarkAllAsReadRequest struct {
+type SnoozeNotificationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MarkAllAsReadRequest) Reset() {
-	*x = MarkAllAsReadRequest{}
+func (x *SnoozeNotificationResponse) Reset() {
+	*x = SnoozeNotificationResponse{}
 	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarkAllAsReadRequest) String() string {
+func (x *SnoozeNotificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkAllAsReadRequest) ProtoMessage() {}
+func (*SnoozeNotificationResponse) ProtoMessage() {}
 
-func (x *MarkAllAsReadRequest) ProtoReflect() protoreflect.Message {
+func (x *SnoozeNotificationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -429,38 +626,40 @@ func (x *MarkAllAsReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkAllAsReadRequest.ProtoReflect.Descriptor instead.
-func (*MarkAllAsReadRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SnoozeNotificationResponse.ProtoReflect.Descriptor instead.
+func (*SnoozeNotificationResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *MarkAllAsReadRequest) GetUserId() string {
+func (x *SnoozeNotificationResponse) GetCount() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type MarkAllAsReadResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ArchiveNotificationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NotificationId string                 `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *MarkAllAsReadResponse) Reset() {
-	*x = MarkAllAsReadResponse{}
+func (x *ArchiveNotificationRequest) Reset() {
+	*x = ArchiveNotificationRequest{}
 	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarkAllAsReadResponse) String() string {
+func (x *ArchiveNotificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkAllAsReadResponse) ProtoMessage() {}
+func (*ArchiveNotificationRequest) ProtoMessage() {}
 
-func (x *MarkAllAsReadResponse) ProtoReflect() protoreflect.Message {
+func (x *ArchiveNotificationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -472,32 +671,46 @@ func (x *MarkAllAsReadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkAllAsReadResponse.ProtoReflect.Descriptor instead.
-func (*MarkAllAsReadResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ArchiveNotificationRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveNotificationRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{7}
 }
 
-type GetUnreadCountRequest struct {
+func (x *ArchiveNotificationRequest) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+func (x *ArchiveNotificationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ArchiveNotificationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUnreadCountRequest) Reset() {
-	*x = GetUnreadCountRequest{}
+func (x *ArchiveNotificationResponse) Reset() {
+	*x = ArchiveNotificationResponse{}
 	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUnreadCountRequest) String() string {
+func (x *ArchiveNotificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUnreadCountRequest) ProtoMessage() {}
+func (*ArchiveNotificationResponse) ProtoMessage() {}
 
-func (x *GetUnreadCountRequest) ProtoReflect() protoreflect.Message {
+func (x *ArchiveNotificationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -509,40 +722,1316 @@ func (x *GetUnreadCountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUnreadCountRequest.ProtoReflect.Descriptor instead.
-func (*GetUnreadCountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ArchiveNotificationResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveNotificationResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetUnreadCountRequest) GetUserId() string {
+func (x *ArchiveNotificationResponse) GetCount() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type GetUnreadCountResponse struct {
+type UnarchiveNotificationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NotificationId string                 `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnarchiveNotificationRequest) Reset() {
+	*x = UnarchiveNotificationRequest{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveNotificationRequest) ProtoMessage() {}
+
+func (x *UnarchiveNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveNotificationRequest.ProtoReflect.Descriptor instead.
+func (*UnarchiveNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UnarchiveNotificationRequest) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+func (x *UnarchiveNotificationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnarchiveNotificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveNotificationResponse) Reset() {
+	*x = UnarchiveNotificationResponse{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveNotificationResponse) ProtoMessage() {}
+
+func (x *UnarchiveNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveNotificationResponse.ProtoReflect.Descriptor instead.
+func (*UnarchiveNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UnarchiveNotificationResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type MarkAsReadRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NotificationId string                 `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MarkAsReadRequest) Reset() {
+	*x = MarkAsReadRequest{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAsReadRequest) ProtoMessage() {}
+
+func (x *MarkAsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAsReadRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MarkAsReadRequest) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+type MarkAsReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAsReadResponse) Reset() {
+	*x = MarkAsReadResponse{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAsReadResponse) ProtoMessage() {}
+
+func (x *MarkAsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkAsReadResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{12}
+}
+
+type MarkAllAsReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllAsReadRequest) Reset() {
+	*x = MarkAllAsReadRequest{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllAsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllAsReadRequest) ProtoMessage() {}
+
+func (x *MarkAllAsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllAsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAllAsReadRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MarkAllAsReadRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type MarkAllAsReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllAsReadResponse) Reset() {
+	*x = MarkAllAsReadResponse{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllAsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllAsReadResponse) ProtoMessage() {}
+
+func (x *MarkAllAsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllAsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkAllAsReadResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{14}
+}
+
+type GetUnreadCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadCountRequest) Reset() {
+	*x = GetUnreadCountRequest{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountRequest) ProtoMessage() {}
+
+func (x *GetUnreadCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountRequest.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetUnreadCountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUnreadCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadCountResponse) Reset() {
+	*x = GetUnreadCountResponse{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountResponse) ProtoMessage() {}
+
+func (x *GetUnreadCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountResponse.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetUnreadCountResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPreferencesRequest) Reset() {
+	*x = GetPreferencesRequest{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreferencesRequest) ProtoMessage() {}
+
+func (x *GetPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetPreferencesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetPreferencesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Preferences   []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPreferencesResponse) Reset() {
+	*x = GetPreferencesResponse{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreferencesResponse) ProtoMessage() {}
+
+func (x *GetPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetPreferencesResponse) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdatePreferenceRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NotificationType string                 `protobuf:"bytes,2,opt,name=notification_type,json=notificationType,proto3" json:"notification_type,omitempty"`
+	InAppEnabled     bool                   `protobuf:"varint,3,opt,name=in_app_enabled,json=inAppEnabled,proto3" json:"in_app_enabled,omitempty"`
+	EmailEnabled     bool                   `protobuf:"varint,4,opt,name=email_enabled,json=emailEnabled,proto3" json:"email_enabled,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdatePreferenceRequest) Reset() {
+	*x = UpdatePreferenceRequest{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePreferenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePreferenceRequest) ProtoMessage() {}
+
+func (x *UpdatePreferenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePreferenceRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePreferenceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdatePreferenceRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdatePreferenceRequest) GetNotificationType() string {
+	if x != nil {
+		return x.NotificationType
+	}
+	return ""
+}
+
+func (x *UpdatePreferenceRequest) GetInAppEnabled() bool {
+	if x != nil {
+		return x.InAppEnabled
+	}
+	return false
+}
+
+func (x *UpdatePreferenceRequest) GetEmailEnabled() bool {
+	if x != nil {
+		return x.EmailEnabled
+	}
+	return false
+}
+
+type UpdatePreferenceResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Preference    *NotificationPreference `protobuf:"bytes,1,opt,name=preference,proto3" json:"preference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePreferenceResponse) Reset() {
+	*x = UpdatePreferenceResponse{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePreferenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePreferenceResponse) ProtoMessage() {}
+
+func (x *UpdatePreferenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePreferenceResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePreferenceResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdatePreferenceResponse) GetPreference() *NotificationPreference {
+	if x != nil {
+		return x.Preference
+	}
+	return nil
+}
+
+// ChatChannel is a Slack or Microsoft Teams destination of a project. Its
+// webhook URL and bot token are write-only.
+type ChatChannel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`                                     // slack_webhook, slack_bot or teams_webhook
+	SlackChannel  string                 `protobuf:"bytes,5,opt,name=slack_channel,json=slackChannel,proto3" json:"slack_channel,omitempty"` // Channel a Slack bot posts to
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatChannel) Reset() {
+	*x = ChatChannel{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatChannel) ProtoMessage() {}
+
+func (x *ChatChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatChannel.ProtoReflect.Descriptor instead.
+func (*ChatChannel) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ChatChannel) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChatChannel) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ChatChannel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChatChannel) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ChatChannel) GetSlackChannel() string {
+	if x != nil {
+		return x.SlackChannel
+	}
+	return ""
+}
+
+func (x *ChatChannel) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// ChannelRoute sends a project's events to a channel
+type ChannelRoute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,4,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"` // e.g. "issue.created" or "issue.*"; empty routes every event
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelRoute) Reset() {
+	*x = ChannelRoute{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelRoute) ProtoMessage() {}
+
+func (x *ChannelRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelRoute.ProtoReflect.Descriptor instead.
+func (*ChannelRoute) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ChannelRoute) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelRoute) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ChannelRoute) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ChannelRoute) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *ChannelRoute) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	WebhookUrl    string                 `protobuf:"bytes,4,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`       // For slack_webhook and teams_webhook; must be https
+	BotToken      string                 `protobuf:"bytes,5,opt,name=bot_token,json=botToken,proto3" json:"bot_token,omitempty"`             // For slack_bot
+	SlackChannel  string                 `protobuf:"bytes,6,opt,name=slack_channel,json=slackChannel,proto3" json:"slack_channel,omitempty"` // For slack_bot
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChannelRequest) Reset() {
+	*x = CreateChannelRequest{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChannelRequest) ProtoMessage() {}
+
+func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChannelRequest.ProtoReflect.Descriptor instead.
+func (*CreateChannelRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateChannelRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateChannelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateChannelRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreateChannelRequest) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *CreateChannelRequest) GetBotToken() string {
+	if x != nil {
+		return x.BotToken
+	}
+	return ""
+}
+
+func (x *CreateChannelRequest) GetSlackChannel() string {
+	if x != nil {
+		return x.SlackChannel
+	}
+	return ""
+}
+
+type CreateChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *ChatChannel           `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChannelResponse) Reset() {
+	*x = CreateChannelResponse{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChannelResponse) ProtoMessage() {}
+
+func (x *CreateChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChannelResponse.ProtoReflect.Descriptor instead.
+func (*CreateChannelResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateChannelResponse) GetChannel() *ChatChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type ListChannelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChannelsRequest) Reset() {
+	*x = ListChannelsRequest{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelsRequest) ProtoMessage() {}
+
+func (x *ListChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ListChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListChannelsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type ListChannelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channels      []*ChatChannel         `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChannelsResponse) Reset() {
+	*x = ListChannelsResponse{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelsResponse) ProtoMessage() {}
+
+func (x *ListChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ListChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListChannelsResponse) GetChannels() []*ChatChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type DeleteChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChannelRequest) Reset() {
+	*x = DeleteChannelRequest{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChannelRequest) ProtoMessage() {}
+
+func (x *DeleteChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChannelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChannelRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteChannelRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type DeleteChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChannelResponse) Reset() {
+	*x = DeleteChannelResponse{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChannelResponse) ProtoMessage() {}
+
+func (x *DeleteChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChannelResponse.ProtoReflect.Descriptor instead.
+func (*DeleteChannelResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{28}
+}
+
+// TestChannel sends a sample message so the channel's settings can be checked
+type TestChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestChannelRequest) Reset() {
+	*x = TestChannelRequest{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestChannelRequest) ProtoMessage() {}
+
+func (x *TestChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestChannelRequest.ProtoReflect.Descriptor instead.
+func (*TestChannelRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TestChannelRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type TestChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestChannelResponse) Reset() {
+	*x = TestChannelResponse{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestChannelResponse) ProtoMessage() {}
+
+func (x *TestChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestChannelResponse.ProtoReflect.Descriptor instead.
+func (*TestChannelResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{30}
+}
+
+type CreateChannelRouteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChannelRouteRequest) Reset() {
+	*x = CreateChannelRouteRequest{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChannelRouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChannelRouteRequest) ProtoMessage() {}
+
+func (x *CreateChannelRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChannelRouteRequest.ProtoReflect.Descriptor instead.
+func (*CreateChannelRouteRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateChannelRouteRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateChannelRouteRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *CreateChannelRouteRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+type CreateChannelRouteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Route         *ChannelRoute          `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChannelRouteResponse) Reset() {
+	*x = CreateChannelRouteResponse{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChannelRouteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChannelRouteResponse) ProtoMessage() {}
+
+func (x *CreateChannelRouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChannelRouteResponse.ProtoReflect.Descriptor instead.
+func (*CreateChannelRouteResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateChannelRouteResponse) GetRoute() *ChannelRoute {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+type ListChannelRoutesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChannelRoutesRequest) Reset() {
+	*x = ListChannelRoutesRequest{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChannelRoutesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelRoutesRequest) ProtoMessage() {}
+
+func (x *ListChannelRoutesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelRoutesRequest.ProtoReflect.Descriptor instead.
+func (*ListChannelRoutesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListChannelRoutesRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type ListChannelRoutesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Routes        []*ChannelRoute        `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChannelRoutesResponse) Reset() {
+	*x = ListChannelRoutesResponse{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChannelRoutesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelRoutesResponse) ProtoMessage() {}
+
+func (x *ListChannelRoutesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelRoutesResponse.ProtoReflect.Descriptor instead.
+func (*ListChannelRoutesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListChannelRoutesResponse) GetRoutes() []*ChannelRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+type DeleteChannelRouteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	RouteId       string                 `protobuf:"bytes,1,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUnreadCountResponse) Reset() {
-	*x = GetUnreadCountResponse{}
-	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[9]
+func (x *DeleteChannelRouteRequest) Reset() {
+	*x = DeleteChannelRouteRequest{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUnreadCountResponse) String() string {
+func (x *DeleteChannelRouteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUnreadCountResponse) ProtoMessage() {}
+func (*DeleteChannelRouteRequest) ProtoMessage() {}
 
-func (x *GetUnreadCountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[9]
+func (x *DeleteChannelRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -553,40 +2042,39 @@ func (x *GetUnreadCountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUnreadCountResponse.ProtoReflect.Descriptor instead.
-func (*GetUnreadCountResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DeleteChannelRouteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChannelRouteRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetUnreadCountResponse) GetCount() int32 {
+func (x *DeleteChannelRouteRequest) GetRouteId() string {
 	if x != nil {
-		return x.Count
+		return x.RouteId
 	}
-	return 0
+	return ""
 }
 
-type GetPreferencesRequest struct {
+type DeleteChannelRouteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPreferencesRequest) Reset() {
-	*x = GetPreferencesRequest{}
-	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[10]
+func (x *DeleteChannelRouteResponse) Reset() {
+	*x = DeleteChannelRouteResponse{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPreferencesRequest) String() string {
+func (x *DeleteChannelRouteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPreferencesRequest) ProtoMessage() {}
+func (*DeleteChannelRouteResponse) ProtoMessage() {}
 
-func (x *GetPreferencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[10]
+func (x *DeleteChannelRouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -597,40 +2085,94 @@ func (x *GetPreferencesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPreferencesRequest.ProtoReflect.Descriptor instead.
-func (*GetPreferencesRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use DeleteChannelRouteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteChannelRouteResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetPreferencesRequest) GetUserId() string {
+// SchemeEntry says who a project notifies of one type of event
+type SchemeEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventType     string                 `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // issue.created, issue.assigned, issue.updated, comment.created, sprint.started or sprint.completed
+	Recipient     string                 `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`                  // assignee, reporter, watchers, project_role, team or user
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`                          // The role (admin, member, viewer, lead), team ID or user ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemeEntry) Reset() {
+	*x = SchemeEntry{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemeEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemeEntry) ProtoMessage() {}
+
+func (x *SchemeEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[37]
 	if x != nil {
-		return x.UserId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemeEntry.ProtoReflect.Descriptor instead.
+func (*SchemeEntry) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SchemeEntry) GetEventType() string {
+	if x != nil {
+		return x.EventType
 	}
 	return ""
 }
 
-type GetPreferencesResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Preferences   []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+func (x *SchemeEntry) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *SchemeEntry) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type GetNotificationSchemeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPreferencesResponse) Reset() {
-	*x = GetPreferencesResponse{}
-	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[11]
+func (x *GetNotificationSchemeRequest) Reset() {
+	*x = GetNotificationSchemeRequest{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPreferencesResponse) String() string {
+func (x *GetNotificationSchemeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPreferencesResponse) ProtoMessage() {}
+func (*GetNotificationSchemeRequest) ProtoMessage() {}
 
-func (x *GetPreferencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[11]
+func (x *GetNotificationSchemeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -641,43 +2183,95 @@ func (x *GetPreferencesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPreferencesResponse.ProtoReflect.Descriptor instead.
-func (*GetPreferencesResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetNotificationSchemeRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationSchemeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetPreferencesResponse) GetPreferences() []*NotificationPreference {
+func (x *GetNotificationSchemeRequest) GetProjectId() string {
 	if x != nil {
-		return x.Preferences
+		return x.ProjectId
+	}
+	return ""
+}
+
+type GetNotificationSchemeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*SchemeEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,2,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"` // The project has no scheme of its own
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationSchemeResponse) Reset() {
+	*x = GetNotificationSchemeResponse{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationSchemeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationSchemeResponse) ProtoMessage() {}
+
+func (x *GetNotificationSchemeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationSchemeResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationSchemeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetNotificationSchemeResponse) GetEntries() []*SchemeEntry {
+	if x != nil {
+		return x.Entries
 	}
 	return nil
 }
 
-type UpdatePreferenceRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	NotificationType string                 `protobuf:"bytes,2,opt,name=notification_type,json=notificationType,proto3" json:"notification_type,omitempty"`
-	InAppEnabled     bool                   `protobuf:"varint,3,opt,name=in_app_enabled,json=inAppEnabled,proto3" json:"in_app_enabled,omitempty"`
-	EmailEnabled     bool                   `protobuf:"varint,4,opt,name=email_enabled,json=emailEnabled,proto3" json:"email_enabled,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *GetNotificationSchemeResponse) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
 }
 
-func (x *UpdatePreferenceRequest) Reset() {
-	*x = UpdatePreferenceRequest{}
-	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[12]
+// UpdateNotificationSchemeRequest replaces a project's scheme; event types
+// without entries notify nobody
+type UpdateNotificationSchemeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Entries       []*SchemeEntry         `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationSchemeRequest) Reset() {
+	*x = UpdateNotificationSchemeRequest{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePreferenceRequest) String() string {
+func (x *UpdateNotificationSchemeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePreferenceRequest) ProtoMessage() {}
+func (*UpdateNotificationSchemeRequest) ProtoMessage() {}
 
-func (x *UpdatePreferenceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[12]
+func (x *UpdateNotificationSchemeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -688,61 +2282,91 @@ func (x *UpdatePreferenceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePreferenceRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePreferenceRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use UpdateNotificationSchemeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationSchemeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *UpdatePreferenceRequest) GetUserId() string {
+func (x *UpdateNotificationSchemeRequest) GetProjectId() string {
 	if x != nil {
-		return x.UserId
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *UpdatePreferenceRequest) GetNotificationType() string {
+func (x *UpdateNotificationSchemeRequest) GetEntries() []*SchemeEntry {
 	if x != nil {
-		return x.NotificationType
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdatePreferenceRequest) GetInAppEnabled() bool {
+type UpdateNotificationSchemeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*SchemeEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationSchemeResponse) Reset() {
+	*x = UpdateNotificationSchemeResponse{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationSchemeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationSchemeResponse) ProtoMessage() {}
+
+func (x *UpdateNotificationSchemeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[41]
 	if x != nil {
-		return x.InAppEnabled
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *UpdatePreferenceRequest) GetEmailEnabled() bool {
+// Deprecated: Use UpdateNotificationSchemeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationSchemeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateNotificationSchemeResponse) GetEntries() []*SchemeEntry {
 	if x != nil {
-		return x.EmailEnabled
+		return x.Entries
 	}
-	return false
+	return nil
 }
 
-type UpdatePreferenceResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Preference    *NotificationPreference `protobuf:"bytes,1,opt,name=preference,proto3" json:"preference,omitempty"`
+type ResetNotificationSchemeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePreferenceResponse) Reset() {
-	*x = UpdatePreferenceResponse{}
-	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[13]
+func (x *ResetNotificationSchemeRequest) Reset() {
+	*x = ResetNotificationSchemeRequest{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePreferenceResponse) String() string {
+func (x *ResetNotificationSchemeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePreferenceResponse) ProtoMessage() {}
+func (*ResetNotificationSchemeRequest) ProtoMessage() {}
 
-func (x *UpdatePreferenceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[13]
+func (x *ResetNotificationSchemeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -753,14 +2377,58 @@ func (x *UpdatePreferenceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePreferenceResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePreferenceResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ResetNotificationSchemeRequest.ProtoReflect.Descriptor instead.
+func (*ResetNotificationSchemeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *UpdatePreferenceResponse) GetPreference() *NotificationPreference {
+func (x *ResetNotificationSchemeRequest) GetProjectId() string {
 	if x != nil {
-		return x.Preference
+		return x.ProjectId
+	}
+	return ""
+}
+
+type ResetNotificationSchemeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*SchemeEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetNotificationSchemeResponse) Reset() {
+	*x = ResetNotificationSchemeResponse{}
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetNotificationSchemeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetNotificationSchemeResponse) ProtoMessage() {}
+
+func (x *ResetNotificationSchemeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_notification_v1_notification_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetNotificationSchemeResponse.ProtoReflect.Descriptor instead.
+func (*ResetNotificationSchemeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_notification_v1_notification_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ResetNotificationSchemeResponse) GetEntries() []*SchemeEntry {
+	if x != nil {
+		return x.Entries
 	}
 	return nil
 }
@@ -769,7 +2437,7 @@ var File_pkg_proto_notification_v1_notification_proto protoreflect.FileDescripto
 
 const file_pkg_proto_notification_v1_notification_proto_rawDesc = "" +
 	"\n" +
-	",pkg/proto/notification/v1/notification.proto\x12\x0fnotification.v1\"\xde\x01\n" +
+	",pkg/proto/notification/v1/notification.proto\x12\x0fnotification.v1\"\x95\x03\n" +
 	"\fNotification\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
@@ -780,20 +2448,58 @@ const file_pkg_proto_notification_v1_notification_proto_rawDesc = "" +
 	"\bmetadata\x18\a \x01(\tR\bmetadata\x12\x12\n" +
 	"\x04read\x18\b \x01(\bR\x04read\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\t \x01(\tR\tcreatedAt\"\xb9\x01\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1b\n" +
+	"\tgroup_key\x18\n" +
+	" \x01(\tR\bgroupKey\x12\x18\n" +
+	"\asubject\x18\v \x01(\tR\asubject\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\f \x01(\tR\tprojectId\x12\x19\n" +
+	"\bactor_id\x18\r \x01(\tR\aactorId\x12#\n" +
+	"\rsnoozed_until\x18\x0e \x01(\tR\fsnoozedUntil\x12\x1f\n" +
+	"\varchived_at\x18\x0f \x01(\tR\n" +
+	"archivedAt\"\x9e\x01\n" +
+	"\x12NotificationThread\x125\n" +
+	"\x06latest\x18\x01 \x01(\v2\x1d.notification.v1.NotificationR\x06latest\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12!\n" +
+	"\funread_count\x18\x03 \x01(\x05R\vunreadCount\x12\x18\n" +
+	"\asummary\x18\x04 \x01(\tR\asummary\"\xb9\x01\n" +
 	"\x16NotificationPreference\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12+\n" +
 	"\x11notification_type\x18\x03 \x01(\tR\x10notificationType\x12$\n" +
 	"\x0ein_app_enabled\x18\x04 \x01(\bR\finAppEnabled\x12#\n" +
-	"\remail_enabled\x18\x05 \x01(\bR\femailEnabled\"a\n" +
+	"\remail_enabled\x18\x05 \x01(\bR\femailEnabled\"\x88\x02\n" +
 	"\x18ListNotificationsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"v\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05types\x18\x04 \x03(\tR\x05types\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x05 \x01(\tR\tprojectId\x12\x1f\n" +
+	"\vunread_only\x18\x06 \x01(\bR\n" +
+	"unreadOnly\x125\n" +
+	"\x04view\x18\a \x01(\x0e2!.notification.v1.NotificationViewR\x04view\x12\x18\n" +
+	"\agrouped\x18\b \x01(\bR\agrouped\"\xb5\x01\n" +
 	"\x19ListNotificationsResponse\x12C\n" +
 	"\rnotifications\x18\x01 \x03(\v2\x1d.notification.v1.NotificationR\rnotifications\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"<\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12=\n" +
+	"\athreads\x18\x03 \x03(\v2#.notification.v1.NotificationThreadR\athreads\"\x80\x01\n" +
+	"\x19SnoozeNotificationRequest\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
+	"\fsnooze_until\x18\x03 \x01(\tR\vsnoozeUntil\"2\n" +
+	"\x1aSnoozeNotificationResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"^\n" +
+	"\x1aArchiveNotificationRequest\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"3\n" +
+	"\x1bArchiveNotificationResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"`\n" +
+	"\x1cUnarchiveNotificationRequest\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"5\n" +
+	"\x1dUnarchiveNotificationResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"<\n" +
 	"\x11MarkAsReadRequest\x12'\n" +
 	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\"\x14\n" +
 	"\x12MarkAsReadResponse\"/\n" +
@@ -816,7 +2522,95 @@ const file_pkg_proto_notification_v1_notification_proto_rawDesc = "" +
 	"\x18UpdatePreferenceResponse\x12G\n" +
 	"\n" +
 	"preference\x18\x01 \x01(\v2'.notification.v1.NotificationPreferenceR\n" +
-	"preference2\xe7\x04\n" +
+	"preference\"\xa8\x01\n" +
+	"\vChatChannel\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12#\n" +
+	"\rslack_channel\x18\x05 \x01(\tR\fslackChannel\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"\x9c\x01\n" +
+	"\fChannelRoute\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\x12\x1f\n" +
+	"\vevent_types\x18\x04 \x03(\tR\n" +
+	"eventTypes\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\xc0\x01\n" +
+	"\x14CreateChannelRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x1f\n" +
+	"\vwebhook_url\x18\x04 \x01(\tR\n" +
+	"webhookUrl\x12\x1b\n" +
+	"\tbot_token\x18\x05 \x01(\tR\bbotToken\x12#\n" +
+	"\rslack_channel\x18\x06 \x01(\tR\fslackChannel\"O\n" +
+	"\x15CreateChannelResponse\x126\n" +
+	"\achannel\x18\x01 \x01(\v2\x1c.notification.v1.ChatChannelR\achannel\"4\n" +
+	"\x13ListChannelsRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"P\n" +
+	"\x14ListChannelsResponse\x128\n" +
+	"\bchannels\x18\x01 \x03(\v2\x1c.notification.v1.ChatChannelR\bchannels\"5\n" +
+	"\x14DeleteChannelRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\"\x17\n" +
+	"\x15DeleteChannelResponse\"3\n" +
+	"\x12TestChannelRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\"\x15\n" +
+	"\x13TestChannelResponse\"z\n" +
+	"\x19CreateChannelRouteRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\"Q\n" +
+	"\x1aCreateChannelRouteResponse\x123\n" +
+	"\x05route\x18\x01 \x01(\v2\x1d.notification.v1.ChannelRouteR\x05route\"9\n" +
+	"\x18ListChannelRoutesRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"R\n" +
+	"\x19ListChannelRoutesResponse\x125\n" +
+	"\x06routes\x18\x01 \x03(\v2\x1d.notification.v1.ChannelRouteR\x06routes\"6\n" +
+	"\x19DeleteChannelRouteRequest\x12\x19\n" +
+	"\broute_id\x18\x01 \x01(\tR\arouteId\"\x1c\n" +
+	"\x1aDeleteChannelRouteResponse\"`\n" +
+	"\vSchemeEntry\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\tR\teventType\x12\x1c\n" +
+	"\trecipient\x18\x02 \x01(\tR\trecipient\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"=\n" +
+	"\x1cGetNotificationSchemeRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"v\n" +
+	"\x1dGetNotificationSchemeResponse\x126\n" +
+	"\aentries\x18\x01 \x03(\v2\x1c.notification.v1.SchemeEntryR\aentries\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x02 \x01(\bR\tisDefault\"x\n" +
+	"\x1fUpdateNotificationSchemeRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x126\n" +
+	"\aentries\x18\x02 \x03(\v2\x1c.notification.v1.SchemeEntryR\aentries\"Z\n" +
+	" UpdateNotificationSchemeResponse\x126\n" +
+	"\aentries\x18\x01 \x03(\v2\x1c.notification.v1.SchemeEntryR\aentries\"?\n" +
+	"\x1eResetNotificationSchemeRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"Y\n" +
+	"\x1fResetNotificationSchemeResponse\x126\n" +
+	"\aentries\x18\x01 \x03(\v2\x1c.notification.v1.SchemeEntryR\aentries*\x91\x01\n" +
+	"\x10NotificationView\x12!\n" +
+	"\x1dNOTIFICATION_VIEW_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17NOTIFICATION_VIEW_INBOX\x10\x01\x12\x1d\n" +
+	"\x19NOTIFICATION_VIEW_SNOOZED\x10\x02\x12\x1e\n" +
+	"\x1aNOTIFICATION_VIEW_ARCHIVED\x10\x032\xf8\x0f\n" +
 	"\x13NotificationService\x12j\n" +
 	"\x11ListNotifications\x12).notification.v1.ListNotificationsRequest\x1a*.notification.v1.ListNotificationsResponse\x12U\n" +
 	"\n" +
@@ -824,7 +2618,20 @@ const file_pkg_proto_notification_v1_notification_proto_rawDesc = "" +
 	"\rMarkAllAsRead\x12%.notification.v1.MarkAllAsReadRequest\x1a&.notification.v1.MarkAllAsReadResponse\x12a\n" +
 	"\x0eGetUnreadCount\x12&.notification.v1.GetUnreadCountRequest\x1a'.notification.v1.GetUnreadCountResponse\x12a\n" +
 	"\x0eGetPreferences\x12&.notification.v1.GetPreferencesRequest\x1a'.notification.v1.GetPreferencesResponse\x12g\n" +
-	"\x10UpdatePreference\x12(.notification.v1.UpdatePreferenceRequest\x1a).notification.v1.UpdatePreferenceResponseB;Z9github.com/nexusflow/nexusflow/pkg/proto/notification/v1;b\x06proto3"
+	"\x10UpdatePreference\x12(.notification.v1.UpdatePreferenceRequest\x1a).notification.v1.UpdatePreferenceResponse\x12m\n" +
+	"\x12SnoozeNotification\x12*.notification.v1.SnoozeNotificationRequest\x1a+.notification.v1.SnoozeNotificationResponse\x12p\n" +
+	"\x13ArchiveNotification\x12+.notification.v1.ArchiveNotificationRequest\x1a,.notification.v1.ArchiveNotificationResponse\x12v\n" +
+	"\x15UnarchiveNotification\x12-.notification.v1.UnarchiveNotificationRequest\x1a..notification.v1.UnarchiveNotificationResponse\x12^\n" +
+	"\rCreateChannel\x12%.notification.v1.CreateChannelRequest\x1a&.notification.v1.CreateChannelResponse\x12[\n" +
+	"\fListChannels\x12$.notification.v1.ListChannelsRequest\x1a%.notification.v1.ListChannelsResponse\x12^\n" +
+	"\rDeleteChannel\x12%.notification.v1.DeleteChannelRequest\x1a&.notification.v1.DeleteChannelResponse\x12X\n" +
+	"\vTestChannel\x12#.notification.v1.TestChannelRequest\x1a$.notification.v1.TestChannelResponse\x12m\n" +
+	"\x12CreateChannelRoute\x12*.notification.v1.CreateChannelRouteRequest\x1a+.notification.v1.CreateChannelRouteResponse\x12j\n" +
+	"\x11ListChannelRoutes\x12).notification.v1.ListChannelRoutesRequest\x1a*.notification.v1.ListChannelRoutesResponse\x12m\n" +
+	"\x12DeleteChannelRoute\x12*.notification.v1.DeleteChannelRouteRequest\x1a+.notification.v1.DeleteChannelRouteResponse\x12v\n" +
+	"\x15GetNotificationScheme\x12-.notification.v1.GetNotificationSchemeRequest\x1a..notification.v1.GetNotificationSchemeResponse\x12\x7f\n" +
+	"\x18UpdateNotificationScheme\x120.notification.v1.UpdateNotificationSchemeRequest\x1a1.notification.v1.UpdateNotificationSchemeResponse\x12|\n" +
+	"\x17ResetNotificationScheme\x12/.notification.v1.ResetNotificationSchemeRequest\x1a0.notification.v1.ResetNotificationSchemeResponseB;Z9github.com/nexusflow/nexusflow/pkg/proto/notification/v1;b\x06proto3"
 
 var (
 	file_pkg_proto_notification_v1_notification_proto_rawDescOnce sync.Once
@@ -838,44 +2645,113 @@ func file_pkg_proto_notification_v1_notification_proto_rawDescGZIP() []byte {
 	return file_pkg_proto_notification_v1_notification_proto_rawDescData
 }
 
-var file_pkg_proto_notification_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_pkg_proto_notification_v1_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pkg_proto_notification_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
 var file_pkg_proto_notification_v1_notification_proto_goTypes = []any{
-	(*Notification)(nil),              // 0: notification.v1.Notification
-	(*NotificationPreference)(nil),    // 1: notification.v1.NotificationPreference
-	(*ListNotificationsRequest)(nil),  // 2: notification.v1.ListNotificationsRequest
-	(*ListNotificationsResponse)(nil), // 3: notification.v1.ListNotificationsResponse
-	(*MarkAsReadRequest)(nil),         // 4: notification.v1.MarkAsReadRequest
-	(*MarkAsReadResponse)(nil),        // 5: notification.v1.MarkAsReadResponse
-	(*MarkAllAsReadRequest)(nil),      // 6: notification.v1.MarkAllAsReadRequest
-	(*MarkAllAsReadResponse)(nil),     // 7: notification.v1.MarkAllAsReadResponse
-	(*GetUnreadCountRequest)(nil),     // 8: notification.v1.GetUnreadCountRequest
-	(*GetUnreadCountResponse)(nil),    // 9: notification.v1.GetUnreadCountResponse
-	(*GetPreferencesRequest)(nil),     // 10: notification.v1.GetPreferencesRequest
-	(*GetPreferencesResponse)(nil),    // 11: notification.v1.GetPreferencesResponse
-	(*UpdatePreferenceRequest)(nil),   // 12: notification.v1.UpdatePreferenceRequest
-	(*UpdatePreferenceResponse)(nil),  // 13: notification.v1.UpdatePreferenceResponse
+	(NotificationView)(0),                    // 0: notification.v1.NotificationView
+	(*Notification)(nil),                     // 1: notification.v1.Notification
+	(*NotificationThread)(nil),               // 2: notification.v1.NotificationThread
+	(*NotificationPreference)(nil),           // 3: notification.v1.NotificationPreference
+	(*ListNotificationsRequest)(nil),         // 4: notification.v1.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),        // 5: notification.v1.ListNotificationsResponse
+	(*SnoozeNotificationRequest)(nil),        // 6: notification.v1.SnoozeNotificationRequest
+	(*SnoozeNotificationResponse)(nil),       // 7: notification.v1.SnoozeNotificationResponse
+	(*ArchiveNotificationRequest)(nil),       // 8: notification.v1.ArchiveNotificationRequest
+	(*ArchiveNotificationResponse)(nil),      // 9: notification.v1.ArchiveNotificationResponse
+	(*UnarchiveNotificationRequest)(nil),     // 10: notification.v1.UnarchiveNotificationRequest
+	(*UnarchiveNotificationResponse)(nil),    // 11: notification.v1.UnarchiveNotificationResponse
+	(*MarkAsReadRequest)(nil),                // 12: notification.v1.MarkAsReadRequest
+	(*MarkAsReadResponse)(nil),               // 13: notification.v1.MarkAsReadResponse
+	(*MarkAllAsReadRequest)(nil),             // 14: notification.v1.MarkAllAsReadRequest
+	(*MarkAllAsReadResponse)(nil),            // 15: notification.v1.MarkAllAsReadResponse
+	(*GetUnreadCountRequest)(nil),            // 16: notification.v1.GetUnreadCountRequest
+	(*GetUnreadCountResponse)(nil),           // 17: notification.v1.GetUnreadCountResponse
+	(*GetPreferencesRequest)(nil),            // 18: notification.v1.GetPreferencesRequest
+	(*GetPreferencesResponse)(nil),           // 19: notification.v1.GetPreferencesResponse
+	(*UpdatePreferenceRequest)(nil),          // 20: notification.v1.UpdatePreferenceRequest
+	(*UpdatePreferenceResponse)(nil),         // 21: notification.v1.UpdatePreferenceResponse
+	(*ChatChannel)(nil),                      // 22: notification.v1.ChatChannel
+	(*ChannelRoute)(nil),                     // 23: notification.v1.ChannelRoute
+	(*CreateChannelRequest)(nil),             // 24: notification.v1.CreateChannelRequest
+	(*CreateChannelResponse)(nil),            // 25: notification.v1.CreateChannelResponse
+	(*ListChannelsRequest)(nil),              // 26: notification.v1.ListChannelsRequest
+	(*ListChannelsResponse)(nil),             // 27: notification.v1.ListChannelsResponse
+	(*DeleteChannelRequest)(nil),             // 28: notification.v1.DeleteChannelRequest
+	(*DeleteChannelResponse)(nil),            // 29: notification.v1.DeleteChannelResponse
+	(*TestChannelRequest)(nil),               // 30: notification.v1.TestChannelRequest
+	(*TestChannelResponse)(nil),              // 31: notification.v1.TestChannelResponse
+	(*CreateChannelRouteRequest)(nil),        // 32: notification.v1.CreateChannelRouteRequest
+	(*CreateChannelRouteResponse)(nil),       // 33: notification.v1.CreateChannelRouteResponse
+	(*ListChannelRoutesRequest)(nil),         // 34: notification.v1.ListChannelRoutesRequest
+	(*ListChannelRoutesResponse)(nil),        // 35: notification.v1.ListChannelRoutesResponse
+	(*DeleteChannelRouteRequest)(nil),        // 36: notification.v1.DeleteChannelRouteRequest
+	(*DeleteChannelRouteResponse)(nil),       // 37: notification.v1.DeleteChannelRouteResponse
+	(*SchemeEntry)(nil),                      // 38: notification.v1.SchemeEntry
+	(*GetNotificationSchemeRequest)(nil),     // 39: notification.v1.GetNotificationSchemeRequest
+	(*GetNotificationSchemeResponse)(nil),    // 40: notification.v1.GetNotificationSchemeResponse
+	(*UpdateNotificationSchemeRequest)(nil),  // 41: notification.v1.UpdateNotificationSchemeRequest
+	(*UpdateNotificationSchemeResponse)(nil), // 42: notification.v1.UpdateNotificationSchemeResponse
+	(*ResetNotificationSchemeRequest)(nil),   // 43: notification.v1.ResetNotificationSchemeRequest
+	(*ResetNotificationSchemeResponse)(nil),  // 44: notification.v1.ResetNotificationSchemeResponse
 }
 var file_pkg_proto_notification_v1_notification_proto_depIdxs = []int32{
-	0,  // 0: notification.v1.ListNotificationsResponse.notifications:type_name -> notification.v1.Notification
-	1,  // 1: notification.v1.GetPreferencesResponse.preferences:type_name -> notification.v1.NotificationPreference
-	1,  // 2: notification.v1.UpdatePreferenceResponse.preference:type_name -> notification.v1.NotificationPreference
-	2,  // 3: notification.v1.NotificationService.ListNotifications:input_type -> notification.v1.ListNotificationsRequest
-	4,  // 4: notification.v1.NotificationService.MarkAsRead:input_type -> notification.v1.MarkAsReadRequest
-	6,  // 5: notification.v1.NotificationService.MarkAllAsRead:input_type -> notification.v1.MarkAllAsReadRequest
-	8,  // 6: notification.v1.NotificationService.GetUnreadCount:input_type -> notification.v1.GetUnreadCountRequest
-	10, // 7: notification.v1.NotificationService.GetPreferences:input_type -> notification.v1.GetPreferencesRequest
-	12, // 8: notification.v1.NotificationService.UpdatePreference:input_type -> notification.v1.UpdatePreferenceRequest
-	3,  // 9: notification.v1.NotificationService.ListNotifications:output_type -> notification.v1.ListNotificationsResponse
-	5,  // 10: notification.v1.NotificationService.MarkAsRead:output_type -> notification.v1.MarkAsReadResponse
-	7,  // 11: notification.v1.NotificationService.MarkAllAsRead:output_type -> notification.v1.MarkAllAsReadResponse
-	9,  // 12: notification.v1.NotificationService.GetUnreadCount:output_type -> notification.v1.GetUnreadCountResponse
-	11, // 13: notification.v1.NotificationService.GetPreferences:output_type -> notification.v1.GetPreferencesResponse
-	13, // 14: notification.v1.NotificationService.UpdatePreference:output_type -> notification.v1.UpdatePreferenceResponse
-	9,  // [9:15] is the sub-list for method output_type
-	3,  // [3:9] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	1,  // 0: notification.v1.NotificationThread.latest:type_name -> notification.v1.Notification
+	0,  // 1: notification.v1.ListNotificationsRequest.view:type_name -> notification.v1.NotificationView
+	1,  // 2: notification.v1.ListNotificationsResponse.notifications:type_name -> notification.v1.Notification
+	2,  // 3: notification.v1.ListNotificationsResponse.threads:type_name -> notification.v1.NotificationThread
+	3,  // 4: notification.v1.GetPreferencesResponse.preferences:type_name -> notification.v1.NotificationPreference
+	3,  // 5: notification.v1.UpdatePreferenceResponse.preference:type_name -> notification.v1.NotificationPreference
+	22, // 6: notification.v1.CreateChannelResponse.channel:type_name -> notification.v1.ChatChannel
+	22, // 7: notification.v1.ListChannelsResponse.channels:type_name -> notification.v1.ChatChannel
+	23, // 8: notification.v1.CreateChannelRouteResponse.route:type_name -> notification.v1.ChannelRoute
+	23, // 9: notification.v1.ListChannelRoutesResponse.routes:type_name -> notification.v1.ChannelRoute
+	38, // 10: notification.v1.GetNotificationSchemeResponse.entries:type_name -> notification.v1.SchemeEntry
+	38, // 11: notification.v1.UpdateNotificationSchemeRequest.entries:type_name -> notification.v1.SchemeEntry
+	38, // 12: notification.v1.UpdateNotificationSchemeResponse.entries:type_name -> notification.v1.SchemeEntry
+	38, // 13: notification.v1.ResetNotificationSchemeResponse.entries:type_name -> notification.v1.SchemeEntry
+	4,  // 14: notification.v1.NotificationService.ListNotifications:input_type -> notification.v1.ListNotificationsRequest
+	12, // 15: notification.v1.NotificationService.MarkAsRead:input_type -> notification.v1.MarkAsReadRequest
+	14, // 16: notification.v1.NotificationService.MarkAllAsRead:input_type -> notification.v1.MarkAllAsReadRequest
+	16, // 17: notification.v1.NotificationService.GetUnreadCount:input_type -> notification.v1.GetUnreadCountRequest
+	18, // 18: notification.v1.NotificationService.GetPreferences:input_type -> notification.v1.GetPreferencesRequest
+	20, // 19: notification.v1.NotificationService.UpdatePreference:input_type -> notification.v1.UpdatePreferenceRequest
+	6,  // 20: notification.v1.NotificationService.SnoozeNotification:input_type -> notification.v1.SnoozeNotificationRequest
+	8,  // 21: notification.v1.NotificationService.ArchiveNotification:input_type -> notification.v1.ArchiveNotificationRequest
+	10, // 22: notification.v1.NotificationService.UnarchiveNotification:input_type -> notification.v1.UnarchiveNotificationRequest
+	24, // 23: notification.v1.NotificationService.CreateChannel:input_type -> notification.v1.CreateChannelRequest
+	26, // 24: notification.v1.NotificationService.ListChannels:input_type -> notification.v1.ListChannelsRequest
+	28, // 25: notification.v1.NotificationService.DeleteChannel:input_type -> notification.v1.DeleteChannelRequest
+	30, // 26: notification.v1.NotificationService.TestChannel:input_type -> notification.v1.TestChannelRequest
+	32, // 27: notification.v1.NotificationService.CreateChannelRoute:input_type -> notification.v1.CreateChannelRouteRequest
+	34, // 28: notification.v1.NotificationService.ListChannelRoutes:input_type -> notification.v1.ListChannelRoutesRequest
+	36, // 29: notification.v1.NotificationService.DeleteChannelRoute:input_type -> notification.v1.DeleteChannelRouteRequest
+	39, // 30: notification.v1.NotificationService.GetNotificationScheme:input_type -> notification.v1.GetNotificationSchemeRequest
+	41, // 31: notification.v1.NotificationService.UpdateNotificationScheme:input_type -> notification.v1.UpdateNotificationSchemeRequest
+	43, // 32: notification.v1.NotificationService.ResetNotificationScheme:input_type -> notification.v1.ResetNotificationSchemeRequest
+	5,  // 33: notification.v1.NotificationService.ListNotifications:output_type -> notification.v1.ListNotificationsResponse
+	13, // 34: notification.v1.NotificationService.MarkAsRead:output_type -> notification.v1.MarkAsReadResponse
+	15, // 35: notification.v1.NotificationService.MarkAllAsRead:output_type -> notification.v1.MarkAllAsReadResponse
+	17, // 36: notification.v1.NotificationService.GetUnreadCount:output_type -> notification.v1.GetUnreadCountResponse
+	19, // 37: notification.v1.NotificationService.GetPreferences:output_type -> notification.v1.GetPreferencesResponse
+	21, // 38: notification.v1.NotificationService.UpdatePreference:output_type -> notification.v1.UpdatePreferenceResponse
+	7,  // 39: notification.v1.NotificationService.SnoozeNotification:output_type -> notification.v1.SnoozeNotificationResponse
+	9,  // 40: notification.v1.NotificationService.ArchiveNotification:output_type -> notification.v1.ArchiveNotificationResponse
+	11, // 41: notification.v1.NotificationService.UnarchiveNotification:output_type -> notification.v1.UnarchiveNotificationResponse
+	25, // 42: notification.v1.NotificationService.CreateChannel:output_type -> notification.v1.CreateChannelResponse
+	27, // 43: notification.v1.NotificationService.ListChannels:output_type -> notification.v1.ListChannelsResponse
+	29, // 44: notification.v1.NotificationService.DeleteChannel:output_type -> notification.v1.DeleteChannelResponse
+	31, // 45: notification.v1.NotificationService.TestChannel:output_type -> notification.v1.TestChannelResponse
+	33, // 46: notification.v1.NotificationService.CreateChannelRoute:output_type -> notification.v1.CreateChannelRouteResponse
+	35, // 47: notification.v1.NotificationService.ListChannelRoutes:output_type -> notification.v1.ListChannelRoutesResponse
+	37, // 48: notification.v1.NotificationService.DeleteChannelRoute:output_type -> notification.v1.DeleteChannelRouteResponse
+	40, // 49: notification.v1.NotificationService.GetNotificationScheme:output_type -> notification.v1.GetNotificationSchemeResponse
+	42, // 50: notification.v1.NotificationService.UpdateNotificationScheme:output_type -> notification.v1.UpdateNotificationSchemeResponse
+	44, // 51: notification.v1.NotificationService.ResetNotificationScheme:output_type -> notification.v1.ResetNotificationSchemeResponse
+	33, // [33:52] is the sub-list for method output_type
+	14, // [14:33] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_notification_v1_notification_proto_init() }
@@ -888,13 +2764,14 @@ func file_pkg_proto_notification_v1_notification_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_proto_notification_v1_notification_proto_rawDesc), len(file_pkg_proto_notification_v1_notification_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   14,
+			NumEnums:      1,
+			NumMessages:   44,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_pkg_proto_notification_v1_notification_proto_goTypes,
 		DependencyIndexes: file_pkg_proto_notification_v1_notification_proto_depIdxs,
+		EnumInfos:         file_pkg_proto_notification_v1_notification_proto_enumTypes,
 		MessageInfos:      file_pkg_proto_notification_v1_notification_proto_msgTypes,
 	}.Build()
 	File_pkg_proto_notification_v1_notification_proto = out.File
@@ -19,12 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	NotificationService_ListNotifications_FullMethodName = "/notification.v1.NotificationService/ListNotifications"
-	NotificationService_MarkAsRead_FullMethodName        = "/notification.v1.NotificationService/MarkAsRead"
-	NotificationService_MarkAllAsRead_FullMethodName     = "/notification.v1.NotificationService/MarkAllAsRead"
-	NotificationService_GetUnreadCount_FullMethodName    = "/notification.v1.NotificationService/GetUnreadCount"
-	NotificationService_GetPreferences_FullMethodName    = "/notification.v1.NotificationService/GetPreferences"
-	NotificationService_UpdatePreference_FullMethodName  = "/notification.v1.NotificationService/UpdatePreference"
+	NotificationService_ListNotifications_FullMethodName        = "/notification.v1.NotificationService/ListNotifications"
+	NotificationService_MarkAsRead_FullMethodName               = "/notification.v1.NotificationService/MarkAsRead"
+	NotificationService_MarkAllAsRead_FullMethodName            = "/notification.v1.NotificationService/MarkAllAsRead"
+	NotificationService_GetUnreadCount_FullMethodName           = "/notification.v1.NotificationService/GetUnreadCount"
+	NotificationService_GetPreferences_FullMethodName           = "/notification.v1.NotificationService/GetPreferences"
+	NotificationService_UpdatePreference_FullMethodName         = "/notification.v1.NotificationService/UpdatePreference"
+	NotificationService_SnoozeNotification_FullMethodName       = "/notification.v1.NotificationService/SnoozeNotification"
+	NotificationService_ArchiveNotification_FullMethodName      = "/notification.v1.NotificationService/ArchiveNotification"
+	NotificationService_UnarchiveNotification_FullMethodName    = "/notification.v1.NotificationService/UnarchiveNotification"
+	NotificationService_CreateChannel_FullMethodName            = "/notification.v1.NotificationService/CreateChannel"
+	NotificationService_ListChannels_FullMethodName             = "/notification.v1.NotificationService/ListChannels"
+	NotificationService_DeleteChannel_FullMethodName            = "/notification.v1.NotificationService/DeleteChannel"
+	NotificationService_TestChannel_FullMethodName              = "/notification.v1.NotificationService/TestChannel"
+	NotificationService_CreateChannelRoute_FullMethodName       = "/notification.v1.NotificationService/CreateChannelRoute"
+	NotificationService_ListChannelRoutes_FullMethodName        = "/notification.v1.NotificationService/ListChannelRoutes"
+	NotificationService_DeleteChannelRoute_FullMethodName       = "/notification.v1.NotificationService/DeleteChannelRoute"
+	NotificationService_GetNotificationScheme_FullMethodName    = "/notification.v1.NotificationService/GetNotificationScheme"
+	NotificationService_UpdateNotificationScheme_FullMethodName = "/notification.v1.NotificationService/UpdateNotificationScheme"
+	NotificationService_ResetNotificationScheme_FullMethodName  = "/notification.v1.NotificationService/ResetNotificationScheme"
 )
 
 // NotificationServiceClient is the client API for NotificationService service.
@@ -37,6 +50,23 @@ type NotificationServiceClient interface {
 	GetUnreadCount(ctx context.Context, in *GetUnreadCountRequest, opts ...grpc.CallOption) (*GetUnreadCountResponse, error)
 	GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*GetPreferencesResponse, error)
 	UpdatePreference(ctx context.Context, in *UpdatePreferenceRequest, opts ...grpc.CallOption) (*UpdatePreferenceResponse, error)
+	// Snoozing, archiving and unarchiving apply to the notification's whole thread
+	SnoozeNotification(ctx context.Context, in *SnoozeNotificationRequest, opts ...grpc.CallOption) (*SnoozeNotificationResponse, error)
+	ArchiveNotification(ctx context.Context, in *ArchiveNotificationRequest, opts ...grpc.CallOption) (*ArchiveNotificationResponse, error)
+	UnarchiveNotification(ctx context.Context, in *UnarchiveNotificationRequest, opts ...grpc.CallOption) (*UnarchiveNotificationResponse, error)
+	// Chat channels
+	CreateChannel(ctx context.Context, in *CreateChannelRequest, opts ...grpc.CallOption) (*CreateChannelResponse, error)
+	ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
+	DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*DeleteChannelResponse, error)
+	TestChannel(ctx context.Context, in *TestChannelRequest, opts ...grpc.CallOption) (*TestChannelResponse, error)
+	CreateChannelRoute(ctx context.Context, in *CreateChannelRouteRequest, opts ...grpc.CallOption) (*CreateChannelRouteResponse, error)
+	ListChannelRoutes(ctx context.Context, in *ListChannelRoutesRequest, opts ...grpc.CallOption) (*ListChannelRoutesResponse, error)
+	DeleteChannelRoute(ctx context.Context, in *DeleteChannelRouteRequest, opts ...grpc.CallOption) (*DeleteChannelRouteResponse, error)
+	// Notification schemes decide who is notified of a project's events; user
+	// preferences then filter the recipients
+	GetNotificationScheme(ctx context.Context, in *GetNotificationSchemeRequest, opts ...grpc.CallOption) (*GetNotificationSchemeResponse, error)
+	UpdateNotificationScheme(ctx context.Context, in *UpdateNotificationSchemeRequest, opts ...grpc.CallOption) (*UpdateNotificationSchemeResponse, error)
+	ResetNotificationScheme(ctx context.Context, in *ResetNotificationSchemeRequest, opts ...grpc.CallOption) (*ResetNotificationSchemeResponse, error)
 }
 
 type notificationServiceClient struct {
@@ -107,6 +137,136 @@ func (c *notificationServiceClient) UpdatePreference(ctx context.Context, in *Up
 	return out, nil
 }
 
+func (c *notificationServiceClient) SnoozeNotification(ctx context.Context, in *SnoozeNotificationRequest, opts ...grpc.CallOption) (*SnoozeNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SnoozeNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_SnoozeNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ArchiveNotification(ctx context.Context, in *ArchiveNotificationRequest, opts ...grpc.CallOption) (*ArchiveNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ArchiveNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) UnarchiveNotification(ctx context.Context, in *UnarchiveNotificationRequest, opts ...grpc.CallOption) (*UnarchiveNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnarchiveNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_UnarchiveNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) CreateChannel(ctx context.Context, in *CreateChannelRequest, opts ...grpc.CallOption) (*CreateChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateChannelResponse)
+	err := c.cc.Invoke(ctx, NotificationService_CreateChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChannelsResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ListChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*DeleteChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteChannelResponse)
+	err := c.cc.Invoke(ctx, NotificationService_DeleteChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) TestChannel(ctx context.Context, in *TestChannelRequest, opts ...grpc.CallOption) (*TestChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestChannelResponse)
+	err := c.cc.Invoke(ctx, NotificationService_TestChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) CreateChannelRoute(ctx context.Context, in *CreateChannelRouteRequest, opts ...grpc.CallOption) (*CreateChannelRouteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateChannelRouteResponse)
+	err := c.cc.Invoke(ctx, NotificationService_CreateChannelRoute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ListChannelRoutes(ctx context.Context, in *ListChannelRoutesRequest, opts ...grpc.CallOption) (*ListChannelRoutesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChannelRoutesResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ListChannelRoutes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) DeleteChannelRoute(ctx context.Context, in *DeleteChannelRouteRequest, opts ...grpc.CallOption) (*DeleteChannelRouteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteChannelRouteResponse)
+	err := c.cc.Invoke(ctx, NotificationService_DeleteChannelRoute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) GetNotificationScheme(ctx context.Context, in *GetNotificationSchemeRequest, opts ...grpc.CallOption) (*GetNotificationSchemeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNotificationSchemeResponse)
+	err := c.cc.Invoke(ctx, NotificationService_GetNotificationScheme_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) UpdateNotificationScheme(ctx context.Context, in *UpdateNotificationSchemeRequest, opts ...grpc.CallOption) (*UpdateNotificationSchemeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNotificationSchemeResponse)
+	err := c.cc.Invoke(ctx, NotificationService_UpdateNotificationScheme_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ResetNotificationScheme(ctx context.Context, in *ResetNotificationSchemeRequest, opts ...grpc.CallOption) (*ResetNotificationSchemeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetNotificationSchemeResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ResetNotificationScheme_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationServiceServer is the server API for NotificationService service.
 // All implementations must embed UnimplementedNotificationServiceServer
 // for forward compatibility.
@@ -117,6 +277,23 @@ type NotificationServiceServer interface {
 	GetUnreadCount(context.Context, *GetUnreadCountRequest) (*GetUnreadCountResponse, error)
 	GetPreferences(context.Context, *GetPreferencesRequest) (*GetPreferencesResponse, error)
 	UpdatePreference(context.Context, *UpdatePreferenceRequest) (*UpdatePreferenceResponse, error)
+	// Snoozing, archiving and unarchiving apply to the notification's whole thread
+	SnoozeNotification(context.Context, *SnoozeNotificationRequest) (*SnoozeNotificationResponse, error)
+	ArchiveNotification(context.Context, *ArchiveNotificationRequest) (*ArchiveNotificationResponse, error)
+	UnarchiveNotification(context.Context, *UnarchiveNotificationRequest) (*UnarchiveNotificationResponse, error)
+	// Chat channels
+	CreateChannel(context.Context, *CreateChannelRequest) (*CreateChannelResponse, error)
+	ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error)
+	DeleteChannel(context.Context, *DeleteChannelRequest) (*DeleteChannelResponse, error)
+	TestChannel(context.Context, *TestChannelRequest) (*TestChannelResponse, error)
+	CreateChannelRoute(context.Context, *CreateChannelRouteRequest) (*CreateChannelRouteResponse, error)
+	ListChannelRoutes(context.Context, *ListChannelRoutesRequest) (*ListChannelRoutesResponse, error)
+	DeleteChannelRoute(context.Context, *DeleteChannelRouteRequest) (*DeleteChannelRouteResponse, error)
+	// Notification schemes decide who is notified of a project's events; user
+	// preferences then filter the recipients
+	GetNotificationScheme(context.Context, *GetNotificationSchemeRequest) (*GetNotificationSchemeResponse, error)
+	UpdateNotificationScheme(context.Context, *UpdateNotificationSchemeRequest) (*UpdateNotificationSchemeResponse, error)
+	ResetNotificationScheme(context.Context, *ResetNotificationSchemeRequest) (*ResetNotificationSchemeResponse, error)
 	mustEmbedUnimplementedNotificationServiceServer()
 }
 
@@ -145,6 +322,45 @@ func (UnimplementedNotificationServiceServer) GetPreferences(context.Context, *G
 func (UnimplementedNotificationServiceServer) UpdatePreference(context.Context, *UpdatePreferenceRequest) (*UpdatePreferenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePreference not implemented")
 }
+func (UnimplementedNotificationServiceServer) SnoozeNotification(context.Context, *SnoozeNotificationRequest) (*SnoozeNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnoozeNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) ArchiveNotification(context.Context, *ArchiveNotificationRequest) (*ArchiveNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) UnarchiveNotification(context.Context, *UnarchiveNotificationRequest) (*UnarchiveNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) CreateChannel(context.Context, *CreateChannelRequest) (*CreateChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChannel not implemented")
+}
+func (UnimplementedNotificationServiceServer) ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChannels not implemented")
+}
+func (UnimplementedNotificationServiceServer) DeleteChannel(context.Context, *DeleteChannelRequest) (*DeleteChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChannel not implemented")
+}
+func (UnimplementedNotificationServiceServer) TestChannel(context.Context, *TestChannelRequest) (*TestChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestChannel not implemented")
+}
+func (UnimplementedNotificationServiceServer) CreateChannelRoute(context.Context, *CreateChannelRouteRequest) (*CreateChannelRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChannelRoute not implemented")
+}
+func (UnimplementedNotificationServiceServer) ListChannelRoutes(context.Context, *ListChannelRoutesRequest) (*ListChannelRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChannelRoutes not implemented")
+}
+func (UnimplementedNotificationServiceServer) DeleteChannelRoute(context.Context, *DeleteChannelRouteRequest) (*DeleteChannelRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChannelRoute not implemented")
+}
+func (UnimplementedNotificationServiceServer) GetNotificationScheme(context.Context, *GetNotificationSchemeRequest) (*GetNotificationSchemeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationScheme not implemented")
+}
+func (UnimplementedNotificationServiceServer) UpdateNotificationScheme(context.Context, *UpdateNotificationSchemeRequest) (*UpdateNotificationSchemeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationScheme not implemented")
+}
+func (UnimplementedNotificationServiceServer) ResetNotificationScheme(context.Context, *ResetNotificationSchemeRequest) (*ResetNotificationSchemeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetNotificationScheme not implemented")
+}
 func (UnimplementedNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {}
 func (UnimplementedNotificationServiceServer) testEmbeddedByValue()                             {}
 
@@ -274,6 +490,240 @@ func _NotificationService_UpdatePreference_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_SnoozeNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnoozeNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SnoozeNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_SnoozeNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SnoozeNotification(ctx, req.(*SnoozeNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ArchiveNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ArchiveNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ArchiveNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ArchiveNotification(ctx, req.(*ArchiveNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_UnarchiveNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnarchiveNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).UnarchiveNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_UnarchiveNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).UnarchiveNotification(ctx, req.(*UnarchiveNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_CreateChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).CreateChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_CreateChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).CreateChannel(ctx, req.(*CreateChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ListChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ListChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListChannels(ctx, req.(*ListChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_DeleteChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).DeleteChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_DeleteChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).DeleteChannel(ctx, req.(*DeleteChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_TestChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).TestChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_TestChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).TestChannel(ctx, req.(*TestChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_CreateChannelRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChannelRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).CreateChannelRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_CreateChannelRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).CreateChannelRoute(ctx, req.(*CreateChannelRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ListChannelRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListChannelRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ListChannelRoutes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListChannelRoutes(ctx, req.(*ListChannelRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_DeleteChannelRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChannelRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).DeleteChannelRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_DeleteChannelRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).DeleteChannelRoute(ctx, req.(*DeleteChannelRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_GetNotificationScheme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationSchemeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetNotificationScheme(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_GetNotificationScheme_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetNotificationScheme(ctx, req.(*GetNotificationSchemeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_UpdateNotificationScheme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationSchemeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).UpdateNotificationScheme(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_UpdateNotificationScheme_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).UpdateNotificationScheme(ctx, req.(*UpdateNotificationSchemeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ResetNotificationScheme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetNotificationSchemeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ResetNotificationScheme(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ResetNotificationScheme_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ResetNotificationScheme(ctx, req.(*ResetNotificationSchemeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,6 +755,58 @@ var NotificationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePreference",
 			Handler:    _NotificationService_UpdatePreference_Handler,
 		},
+		{
+			MethodName: "SnoozeNotification",
+			Handler:    _NotificationService_SnoozeNotification_Handler,
+		},
+		{
+			MethodName: "ArchiveNotification",
+			Handler:    _NotificationService_ArchiveNotification_Handler,
+		},
+		{
+			MethodName: "UnarchiveNotification",
+			Handler:    _NotificationService_UnarchiveNotification_Handler,
+		},
+		{
+			MethodName: "CreateChannel",
+			Handler:    _NotificationService_CreateChannel_Handler,
+		},
+		{
+			MethodName: "ListChannels",
+			Handler:    _NotificationService_ListChannels_Handler,
+		},
+		{
+			MethodName: "DeleteChannel",
+			Handler:    _NotificationService_DeleteChannel_Handler,
+		},
+		{
+			MethodName: "TestChannel",
+			Handler:    _NotificationService_TestChannel_Handler,
+		},
+		{
+			MethodName: "CreateChannelRoute",
+			Handler:    _NotificationService_CreateChannelRoute_Handler,
+		},
+		{
+			MethodName: "ListChannelRoutes",
+			Handler:    _NotificationService_ListChannelRoutes_Handler,
+		},
+		{
+			MethodName: "DeleteChannelRoute",
+			Handler:    _NotificationService_DeleteChannelRoute_Handler,
+		},
+		{
+			MethodName: "GetNotificationScheme",
+			Handler:    _NotificationService_GetNotificationScheme_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationScheme",
+			Handler:    _NotificationService_UpdateNotificationScheme_Handler,
+		},
+		{
+			MethodName: "ResetNotificationScheme",
+			Handler:    _NotificationService_ResetNotificationScheme_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/proto/notification/v1/notification.proto",
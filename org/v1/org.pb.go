@@ -9,6 +9,7 @@ package orgv1
 import (
 	v11 "github.com/nexusflow/nexusflow/pkg/proto/common/v1"
 	v1 "github.com/nexusflow/nexusflow/pkg/proto/user/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -257,6 +258,7 @@ type Organization struct {
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	MemberCount   int32                  `protobuf:"varint,11,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
 	ProjectCount  int32                  `protobuf:"varint,12,opt,name=project_count,json=projectCount,proto3" json:"project_count,omitempty"`
+	Version       int64                  `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"` // Incremented on every update, also sent as the ETag
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -375,6 +377,13 @@ func (x *Organization) GetProjectCount() int32 {
 	return 0
 }
 
+func (x *Organization) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // Team entity
 type Team struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -863,14 +872,17 @@ func (x *GetOrganizationResponse) GetOrganization() *Organization {
 }
 
 type UpdateOrganizationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	LogoUrl       *string                `protobuf:"bytes,4,opt,name=logo_url,json=logoUrl,proto3,oneof" json:"logo_url,omitempty"`
-	Settings      map[string]string      `protobuf:"bytes,5,rep,name=settings,proto3" json:"settings,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	LogoUrl     *string                `protobuf:"bytes,4,opt,name=logo_url,json=logoUrl,proto3,oneof" json:"logo_url,omitempty"`
+	Settings    map[string]string      `protobuf:"bytes,5,rep,name=settings,proto3" json:"settings,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Rejects the update with ABORTED if the organization is no longer at this
+	// version. Falls back to the If-Match header when unset.
+	ExpectedVersion *int64 `protobuf:"varint,6,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateOrganizationRequest) Reset() {
@@ -938,6 +950,13 @@ func (x *UpdateOrganizationRequest) GetSettings() map[string]string {
 	return nil
 }
 
+func (x *UpdateOrganizationRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type UpdateOrganizationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
@@ -1582,6 +1601,110 @@ func (x *ListMembersResponse) GetPagination() *v11.PaginationResponse {
 	return nil
 }
 
+type GetMemberRoleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetMemberRoleRequest) Reset() {
+	*x = GetMemberRoleRequest{}
+	mi := &file_proto_org_v1_org_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemberRoleRequest) ProtoMessage() {}
+
+func (x *GetMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_org_v1_org_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*GetMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetMemberRoleRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *GetMemberRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetMemberRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          OrgRole                `protobuf:"varint,1,opt,name=role,proto3,enum=nexusflow.org.v1.OrgRole" json:"role,omitempty"`
+	IsMember      bool                   `protobuf:"varint,2,opt,name=is_member,json=isMember,proto3" json:"is_member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemberRoleResponse) Reset() {
+	*x = GetMemberRoleResponse{}
+	mi := &file_proto_org_v1_org_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemberRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemberRoleResponse) ProtoMessage() {}
+
+func (x *GetMemberRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_org_v1_org_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemberRoleResponse.ProtoReflect.Descriptor instead.
+func (*GetMemberRoleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetMemberRoleResponse) GetRole() OrgRole {
+	if x != nil {
+		return x.Role
+	}
+	return OrgRole_ORG_ROLE_UNSPECIFIED
+}
+
+func (x *GetMemberRoleResponse) GetIsMember() bool {
+	if x != nil {
+		return x.IsMember
+	}
+	return false
+}
+
 type CreateTeamRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -1593,7 +1716,7 @@ type CreateTeamRequest struct {
 
 func (x *CreateTeamRequest) Reset() {
 	*x = CreateTeamRequest{}
-	mi := &file_proto_org_v1_org_proto_msgTypes[22]
+	mi := &file_proto_org_v1_org_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1605,7 +1728,7 @@ func (x *CreateTeamRequest) String() string {
 func (*CreateTeamRequest) ProtoMessage() {}
 
 func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_org_v1_org_proto_msgTypes[22]
+	mi := &file_proto_org_v1_org_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1618,7 +1741,7 @@ func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
 func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
-	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{22}
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *CreateTeamRequest) GetOrganizationId() string {
@@ -1651,7 +1774,7 @@ type CreateTeamResponse struct {
 
 func (x *CreateTeamResponse) Reset() {
 	*x = CreateTeamResponse{}
-	mi := &file_proto_org_v1_org_proto_msgTypes[23]
+	mi := &file_proto_org_v1_org_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1663,7 +1786,7 @@ func (x *CreateTeamResponse) String() string {
 func (*CreateTeamResponse) ProtoMessage() {}
 
 func (x *CreateTeamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_org_v1_org_proto_msgTypes[23]
+	mi := &file_proto_org_v1_org_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1676,7 +1799,7 @@ func (x *CreateTeamResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTeamResponse.ProtoReflect.Descriptor instead.
 func (*CreateTeamResponse) Descriptor() ([]byte, []int) {
-	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{23}
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *CreateTeamResponse) GetTeam() *Team {
@@ -1695,7 +1818,7 @@ type GetTeamRequest struct {
 
 func (x *GetTeamRequest) Reset() {
 	*x = GetTeamRequest{}
-	mi := &file_proto_org_v1_org_proto_msgTypes[24]
+	mi := &file_proto_org_v1_org_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1707,7 +1830,7 @@ func (x *GetTeamRequest) String() string {
 func (*GetTeamRequest) ProtoMessage() {}
 
 func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_org_v1_org_proto_msgTypes[24]
+	mi := &file_proto_org_v1_org_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1720,7 +1843,7 @@ func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
 func (*GetTeamRequest) Descriptor() ([]byte, []int) {
-	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{24}
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetTeamRequest) GetId() string {
@@ -1739,7 +1862,7 @@ type GetTeamResponse struct {
 
 func (x *GetTeamResponse) Reset() {
 	*x = GetTeamResponse{}
-	mi := &file_proto_org_v1_org_proto_msgTypes[25]
+	mi := &file_proto_org_v1_org_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1751,7 +1874,7 @@ func (x *GetTeamResponse) String() string {
 func (*GetTeamResponse) ProtoMessage() {}
 
 func (x *GetTeamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_org_v1_org_proto_msgTypes[25]
+	mi := &file_proto_org_v1_org_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1764,7 +1887,7 @@ func (x *GetTeamResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTeamResponse.ProtoReflect.Descriptor instead.
 func (*GetTeamResponse) Descriptor() ([]byte, []int) {
-	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{25}
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetTeamResponse) GetTeam() *Team {
@@ -1785,7 +1908,7 @@ type UpdateTeamRequest struct {
 
 func (x *UpdateTeamRequest) Reset() {
 	*x = UpdateTeamRequest{}
-	mi := &file_proto_org_v1_org_proto_msgTypes[26]
+	mi := &file_proto_org_v1_org_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1797,7 +1920,7 @@ func (x *UpdateTeamRequest) String() string {
 func (*UpdateTeamRequest) ProtoMessage() {}
 
 func (x *UpdateTeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_org_v1_org_proto_msgTypes[26]
+	mi := &file_proto_org_v1_org_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1810,7 +1933,7 @@ func (x *UpdateTeamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTeamRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTeamRequest) Descriptor() ([]byte, []int) {
-	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{26}
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *UpdateTeamRequest) GetId() string {
@@ -1843,7 +1966,7 @@ type UpdateTeamResponse struct {
 
 func (x *UpdateTeamResponse) Reset() {
 	*x = UpdateTeamResponse{}
-	mi := &file_proto_org_v1_org_proto_msgTypes[27]
+	mi := &file_proto_org_v1_org_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1855,7 +1978,7 @@ func (x *UpdateTeamResponse) String() string {
 func (*UpdateTeamResponse) ProtoMessage() {}
 
 func (x *UpdateTeamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_org_v1_org_proto_msgTypes[27]
+	mi := &file_proto_org_v1_org_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1868,7 +1991,7 @@ func (x *UpdateTeamResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTeamResponse.ProtoReflect.Descriptor instead.
 func (*UpdateTeamResponse) Descriptor() ([]byte, []int) {
-	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{27}
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *UpdateTeamResponse) GetTeam() *Team {
@@ -1887,7 +2010,7 @@ type DeleteTeamRequest struct {
 
 func (x *DeleteTeamRequest) Reset() {
 	*x = DeleteTeamRequest{}
-	mi := &file_proto_org_v1_org_proto_msgTypes[28]
+	mi := &file_proto_org_v1_org_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1899,7 +2022,7 @@ func (x *DeleteTeamRequest) String() string {
 func (*DeleteTeamRequest) ProtoMessage() {}
 
 func (x *DeleteTeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_org_v1_org_proto_msgTypes[28]
+	mi := &file_proto_org_v1_org_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1912,7 +2035,7 @@ func (x *DeleteTeamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTeamRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTeamRequest) Descriptor() ([]byte, []int) {
-	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{28}
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *DeleteTeamRequest) GetId() string {
@@ -1931,7 +2054,7 @@ type DeleteTeamResponse struct {
 
 func (x *DeleteTeamResponse) Reset() {
 	*x = DeleteTeamResponse{}
-	mi := &file_proto_org_v1_org_proto_msgTypes[29]
+	mi := &file_proto_org_v1_org_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1943,7 +2066,7 @@ func (x *DeleteTeamResponse) String() string {
 func (*DeleteTeamResponse) ProtoMessage() {}
 
 func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_org_v1_org_proto_msgTypes[29]
+	mi := &file_proto_org_v1_org_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1956,7 +2079,7 @@ func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTeamResponse.ProtoReflect.Descriptor instead.
 func (*DeleteTeamResponse) Descriptor() ([]byte, []int) {
-	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{29}
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *DeleteTeamResponse) GetResponse() *v11.SuccessResponse {
@@ -1976,7 +2099,7 @@ type ListTeamsRequest struct {
 
 func (x *ListTeamsRequest) Reset() {
 	*x = ListTeamsRequest{}
-	mi := &file_proto_org_v1_org_proto_msgTypes[30]
+	mi := &file_proto_org_v1_org_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1988,7 +2111,7 @@ func (x *ListTeamsRequest) String() string {
 func (*ListTeamsRequest) ProtoMessage() {}
 
 func (x *ListTeamsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_org_v1_org_proto_msgTypes[30]
+	mi := &file_proto_org_v1_org_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2001,7 +2124,7 @@ func (x *ListTeamsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTeamsRequest.ProtoReflect.Descriptor instead.
 func (*ListTeamsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{30}
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ListTeamsRequest) GetOrganizationId() string {
@@ -2028,7 +2151,7 @@ type ListTeamsResponse struct {
 
 func (x *ListTeamsResponse) Reset() {
 	*x = ListTeamsResponse{}
-	mi := &file_proto_org_v1_org_proto_msgTypes[31]
+	mi := &file_proto_org_v1_org_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2040,7 +2163,7 @@ func (x *ListTeamsResponse) String() string {
 func (*ListTeamsResponse) ProtoMessage() {}
 
 func (x *ListTeamsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_org_v1_org_proto_msgTypes[31]
+	mi := &file_proto_org_v1_org_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2053,7 +2176,7 @@ func (x *ListTeamsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTeamsResponse.ProtoReflect.Descriptor instead.
 func (*ListTeamsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{31}
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ListTeamsResponse) GetTeams() []*Team {
@@ -2080,7 +2203,7 @@ type AddTeamMemberRequest struct {
 
 func (x *AddTeamMemberRequest) Reset() {
 	*x = AddTeamMemberRequest{}
-	mi := &file_proto_org_v1_org_proto_msgTypes[32]
+	mi := &file_proto_org_v1_org_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2092,7 +2215,7 @@ func (x *AddTeamMemberRequest) String() string {
 func (*AddTeamMemberRequest) ProtoMessage() {}
 
 func (x *AddTeamMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_org_v1_org_proto_msgTypes[32]
+	mi := &file_proto_org_v1_org_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2105,7 +2228,7 @@ func (x *AddTeamMemberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddTeamMemberRequest.ProtoReflect.Descriptor instead.
 func (*AddTeamMemberRequest) Descriptor() ([]byte, []int) {
-	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{32}
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *AddTeamMemberRequest) GetTeamId() string {
@@ -2131,7 +2254,7 @@ type AddTeamMemberResponse struct {
 
 func (x *AddTeamMemberResponse) Reset() {
 	*x = AddTeamMemberResponse{}
-	mi := &file_proto_org_v1_org_proto_msgTypes[33]
+	mi := &file_proto_org_v1_org_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2143,7 +2266,7 @@ func (x *AddTeamMemberResponse) String() string {
 func (*AddTeamMemberResponse) ProtoMessage() {}
 
 func (x *AddTeamMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_org_v1_org_proto_msgTypes[33]
+	mi := &file_proto_org_v1_org_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2156,7 +2279,7 @@ func (x *AddTeamMemberResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddTeamMemberResponse.ProtoReflect.Descriptor instead.
 func (*AddTeamMemberResponse) Descriptor() ([]byte, []int) {
-	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{33}
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *AddTeamMemberResponse) GetTeam() *Team {
@@ -2176,7 +2299,7 @@ type RemoveTeamMemberRequest struct {
 
 func (x *RemoveTeamMemberRequest) Reset() {
 	*x = RemoveTeamMemberRequest{}
-	mi := &file_proto_org_v1_org_proto_msgTypes[34]
+	mi := &file_proto_org_v1_org_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2188,7 +2311,7 @@ func (x *RemoveTeamMemberRequest) String() string {
 func (*RemoveTeamMemberRequest) ProtoMessage() {}
 
 func (x *RemoveTeamMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_org_v1_org_proto_msgTypes[34]
+	mi := &file_proto_org_v1_org_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2201,7 +2324,7 @@ func (x *RemoveTeamMemberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveTeamMemberRequest.ProtoReflect.Descriptor instead.
 func (*RemoveTeamMemberRequest) Descriptor() ([]byte, []int) {
-	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{34}
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *RemoveTeamMemberRequest) GetTeamId() string {
@@ -2227,7 +2350,7 @@ type RemoveTeamMemberResponse struct {
 
 func (x *RemoveTeamMemberResponse) Reset() {
 	*x = RemoveTeamMemberResponse{}
-	mi := &file_proto_org_v1_org_proto_msgTypes[35]
+	mi := &file_proto_org_v1_org_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2239,7 +2362,7 @@ func (x *RemoveTeamMemberResponse) String() string {
 func (*RemoveTeamMemberResponse) ProtoMessage() {}
 
 func (x *RemoveTeamMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_org_v1_org_proto_msgTypes[35]
+	mi := &file_proto_org_v1_org_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2252,7 +2375,7 @@ func (x *RemoveTeamMemberResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveTeamMemberResponse.ProtoReflect.Descriptor instead.
 func (*RemoveTeamMemberResponse) Descriptor() ([]byte, []int) {
-	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{35}
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *RemoveTeamMemberResponse) GetTeam() *Team {
@@ -2273,7 +2396,7 @@ type CreateInviteRequest struct {
 
 func (x *CreateInviteRequest) Reset() {
 	*x = CreateInviteRequest{}
-	mi := &file_proto_org_v1_org_proto_msgTypes[36]
+	mi := &file_proto_org_v1_org_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2285,7 +2408,7 @@ func (x *CreateInviteRequest) String() string {
 func (*CreateInviteRequest) ProtoMessage() {}
 
 func (x *CreateInviteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_org_v1_org_proto_msgTypes[36]
+	mi := &file_proto_org_v1_org_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2298,7 +2421,7 @@ func (x *CreateInviteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateInviteRequest.ProtoReflect.Descriptor instead.
 func (*CreateInviteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{36}
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *CreateInviteRequest) GetOrganizationId() string {
@@ -2331,7 +2454,7 @@ type CreateInviteResponse struct {
 
 func (x *CreateInviteResponse) Reset() {
 	*x = CreateInviteResponse{}
-	mi := &file_proto_org_v1_org_proto_msgTypes[37]
+	mi := &file_proto_org_v1_org_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2343,7 +2466,7 @@ func (x *CreateInviteResponse) String() string {
 func (*CreateInviteResponse) ProtoMessage() {}
 
 func (x *CreateInviteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_org_v1_org_proto_msgTypes[37]
+	mi := &file_proto_org_v1_org_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2356,7 +2479,7 @@ func (x *CreateInviteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateInviteResponse.ProtoReflect.Descriptor instead.
 func (*CreateInviteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{37}
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *CreateInviteResponse) GetInvite() *Invite {
@@ -2376,7 +2499,7 @@ type AcceptInviteRequest struct {
 
 func (x *AcceptInviteRequest) Reset() {
 	*x = AcceptInviteRequest{}
-	mi := &file_proto_org_v1_org_proto_msgTypes[38]
+	mi := &file_proto_org_v1_org_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2388,7 +2511,7 @@ func (x *AcceptInviteRequest) String() string {
 func (*AcceptInviteRequest) ProtoMessage() {}
 
 func (x *AcceptInviteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_org_v1_org_proto_msgTypes[38]
+	mi := &file_proto_org_v1_org_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2401,7 +2524,7 @@ func (x *AcceptInviteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptInviteRequest.ProtoReflect.Descriptor instead.
 func (*AcceptInviteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{38}
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *AcceptInviteRequest) GetToken() string {
@@ -2427,7 +2550,7 @@ type AcceptInviteResponse struct {
 
 func (x *AcceptInviteResponse) Reset() {
 	*x = AcceptInviteResponse{}
-	mi := &file_proto_org_v1_org_proto_msgTypes[39]
+	mi := &file_proto_org_v1_org_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2439,7 +2562,7 @@ func (x *AcceptInviteResponse) String() string {
 func (*AcceptInviteResponse) ProtoMessage() {}
 
 func (x *AcceptInviteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_org_v1_org_proto_msgTypes[39]
+	mi := &file_proto_org_v1_org_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2452,7 +2575,7 @@ func (x *AcceptInviteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptInviteResponse.ProtoReflect.Descriptor instead.
 func (*AcceptInviteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{39}
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *AcceptInviteResponse) GetMember() *OrgMember {
@@ -2471,7 +2594,7 @@ type RevokeInviteRequest struct {
 
 func (x *RevokeInviteRequest) Reset() {
 	*x = RevokeInviteRequest{}
-	mi := &file_proto_org_v1_org_proto_msgTypes[40]
+	mi := &file_proto_org_v1_org_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2483,7 +2606,7 @@ func (x *RevokeInviteRequest) String() string {
 func (*RevokeInviteRequest) ProtoMessage() {}
 
 func (x *RevokeInviteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_org_v1_org_proto_msgTypes[40]
+	mi := &file_proto_org_v1_org_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2496,7 +2619,7 @@ func (x *RevokeInviteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeInviteRequest.ProtoReflect.Descriptor instead.
 func (*RevokeInviteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{40}
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *RevokeInviteRequest) GetId() string {
@@ -2515,7 +2638,7 @@ type RevokeInviteResponse struct {
 
 func (x *RevokeInviteResponse) Reset() {
 	*x = RevokeInviteResponse{}
-	mi := &file_proto_org_v1_org_proto_msgTypes[41]
+	mi := &file_proto_org_v1_org_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2527,7 +2650,7 @@ func (x *RevokeInviteResponse) String() string {
 func (*RevokeInviteResponse) ProtoMessage() {}
 
 func (x *RevokeInviteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_org_v1_org_proto_msgTypes[41]
+	mi := &file_proto_org_v1_org_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2540,7 +2663,7 @@ func (x *RevokeInviteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeInviteResponse.ProtoReflect.Descriptor instead.
 func (*RevokeInviteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{41}
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *RevokeInviteResponse) GetResponse() *v11.SuccessResponse {
@@ -2560,7 +2683,7 @@ type ListInvitesRequest struct {
 
 func (x *ListInvitesRequest) Reset() {
 	*x = ListInvitesRequest{}
-	mi := &file_proto_org_v1_org_proto_msgTypes[42]
+	mi := &file_proto_org_v1_org_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2572,7 +2695,7 @@ func (x *ListInvitesRequest) String() string {
 func (*ListInvitesRequest) ProtoMessage() {}
 
 func (x *ListInvitesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_org_v1_org_proto_msgTypes[42]
+	mi := &file_proto_org_v1_org_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2585,7 +2708,7 @@ func (x *ListInvitesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListInvitesRequest.ProtoReflect.Descriptor instead.
 func (*ListInvitesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{42}
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ListInvitesRequest) GetOrganizationId() string {
@@ -2612,7 +2735,7 @@ type ListInvitesResponse struct {
 
 func (x *ListInvitesResponse) Reset() {
 	*x = ListInvitesResponse{}
-	mi := &file_proto_org_v1_org_proto_msgTypes[43]
+	mi := &file_proto_org_v1_org_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2624,7 +2747,7 @@ func (x *ListInvitesResponse) String() string {
 func (*ListInvitesResponse) ProtoMessage() {}
 
 func (x *ListInvitesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_org_v1_org_proto_msgTypes[43]
+	mi := &file_proto_org_v1_org_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2637,7 +2760,7 @@ func (x *ListInvitesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListInvitesResponse.ProtoReflect.Descriptor instead.
 func (*ListInvitesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{43}
+	return file_proto_org_v1_org_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *ListInvitesResponse) GetInvites() []*Invite {
@@ -2658,7 +2781,7 @@ var File_proto_org_v1_org_proto protoreflect.FileDescriptor
 
 const file_proto_org_v1_org_proto_rawDesc = "" +
 	"\n" +
-	"\x16proto/org/v1/org.proto\x12\x10nexusflow.org.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cproto/common/v1/common.proto\x1a\x18proto/user/v1/user.proto\"\xac\x04\n" +
+	"\x16proto/org/v1/org.proto\x12\x10nexusflow.org.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cproto/common/v1/common.proto\x1a\x18proto/user/v1/user.proto\x1a\x1cgoogle/api/annotations.proto\"\xc6\x04\n" +
 	"\fOrganization\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -2674,7 +2797,8 @@ const file_proto_org_v1_org_proto_rawDesc = "" +
 	"updated_at\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12!\n" +
 	"\fmember_count\x18\v \x01(\x05R\vmemberCount\x12#\n" +
-	"\rproject_count\x18\f \x01(\x05R\fprojectCount\x1a;\n" +
+	"\rproject_count\x18\f \x01(\x05R\fprojectCount\x12\x18\n" +
+	"\aversion\x18\r \x01(\x03R\aversion\x1a;\n" +
 	"\rSettingsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8a\x02\n" +
@@ -2719,19 +2843,21 @@ const file_proto_org_v1_org_proto_rawDesc = "" +
 	"\x16GetOrganizationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"]\n" +
 	"\x17GetOrganizationResponse\x12B\n" +
-	"\forganization\x18\x01 \x01(\v2\x1e.nexusflow.org.v1.OrganizationR\forganization\"\xc5\x02\n" +
+	"\forganization\x18\x01 \x01(\v2\x1e.nexusflow.org.v1.OrganizationR\forganization\"\x8a\x03\n" +
 	"\x19UpdateOrganizationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1e\n" +
 	"\blogo_url\x18\x04 \x01(\tH\x02R\alogoUrl\x88\x01\x01\x12U\n" +
-	"\bsettings\x18\x05 \x03(\v29.nexusflow.org.v1.UpdateOrganizationRequest.SettingsEntryR\bsettings\x1a;\n" +
+	"\bsettings\x18\x05 \x03(\v29.nexusflow.org.v1.UpdateOrganizationRequest.SettingsEntryR\bsettings\x12.\n" +
+	"\x10expected_version\x18\x06 \x01(\x03H\x03R\x0fexpectedVersion\x88\x01\x01\x1a;\n" +
 	"\rSettingsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\v\n" +
-	"\t_logo_url\"`\n" +
+	"\t_logo_urlB\x13\n" +
+	"\x11_expected_version\"`\n" +
 	"\x1aUpdateOrganizationResponse\x12B\n" +
 	"\forganization\x18\x01 \x01(\v2\x1e.nexusflow.org.v1.OrganizationR\forganization\"+\n" +
 	"\x19DeleteOrganizationRequest\x12\x0e\n" +
@@ -2774,7 +2900,13 @@ const file_proto_org_v1_org_proto_rawDesc = "" +
 	"\amembers\x18\x01 \x03(\v2\x1b.nexusflow.org.v1.OrgMemberR\amembers\x12G\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2'.nexusflow.common.v1.PaginationResponseR\n" +
-	"pagination\"r\n" +
+	"pagination\"X\n" +
+	"\x14GetMemberRoleRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"c\n" +
+	"\x15GetMemberRoleResponse\x12-\n" +
+	"\x04role\x18\x01 \x01(\x0e2\x19.nexusflow.org.v1.OrgRoleR\x04role\x12\x1b\n" +
+	"\tis_member\x18\x02 \x01(\bR\bisMember\"r\n" +
 	"\x11CreateTeamRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -2863,18 +2995,19 @@ const file_proto_org_v1_org_proto_rawDesc = "" +
 	"\x15INVITE_STATUS_PENDING\x10\x01\x12\x1a\n" +
 	"\x16INVITE_STATUS_ACCEPTED\x10\x02\x12\x19\n" +
 	"\x15INVITE_STATUS_EXPIRED\x10\x03\x12\x19\n" +
-	"\x15INVITE_STATUS_REVOKED\x10\x042\xa8\x0f\n" +
+	"\x15INVITE_STATUS_REVOKED\x10\x042\x98\x15\n" +
 	"\n" +
-	"OrgService\x12o\n" +
-	"\x12CreateOrganization\x12+.nexusflow.org.v1.CreateOrganizationRequest\x1a,.nexusflow.org.v1.CreateOrganizationResponse\x12f\n" +
-	"\x0fGetOrganization\x12(.nexusflow.org.v1.GetOrganizationRequest\x1a).nexusflow.org.v1.GetOrganizationResponse\x12o\n" +
-	"\x12UpdateOrganization\x12+.nexusflow.org.v1.UpdateOrganizationRequest\x1a,.nexusflow.org.v1.UpdateOrganizationResponse\x12o\n" +
-	"\x12DeleteOrganization\x12+.nexusflow.org.v1.DeleteOrganizationRequest\x1a,.nexusflow.org.v1.DeleteOrganizationResponse\x12l\n" +
-	"\x11ListOrganizations\x12*.nexusflow.org.v1.ListOrganizationsRequest\x1a+.nexusflow.org.v1.ListOrganizationsResponse\x12T\n" +
-	"\tAddMember\x12\".nexusflow.org.v1.AddMemberRequest\x1a#.nexusflow.org.v1.AddMemberResponse\x12]\n" +
-	"\fRemoveMember\x12%.nexusflow.org.v1.RemoveMemberRequest\x1a&.nexusflow.org.v1.RemoveMemberResponse\x12i\n" +
-	"\x10UpdateMemberRole\x12).nexusflow.org.v1.UpdateMemberRoleRequest\x1a*.nexusflow.org.v1.UpdateMemberRoleResponse\x12Z\n" +
-	"\vListMembers\x12$.nexusflow.org.v1.ListMembersRequest\x1a%.nexusflow.org.v1.ListMembersResponse\x12W\n" +
+	"OrgService\x12\x8d\x01\n" +
+	"\x12CreateOrganization\x12+.nexusflow.org.v1.CreateOrganizationRequest\x1a,.nexusflow.org.v1.CreateOrganizationResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/organizations\x12\x86\x01\n" +
+	"\x0fGetOrganization\x12(.nexusflow.org.v1.GetOrganizationRequest\x1a).nexusflow.org.v1.GetOrganizationResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/organizations/{id}\x12\x92\x01\n" +
+	"\x12UpdateOrganization\x12+.nexusflow.org.v1.UpdateOrganizationRequest\x1a,.nexusflow.org.v1.UpdateOrganizationResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*2\x16/v1/organizations/{id}\x12\x8f\x01\n" +
+	"\x12DeleteOrganization\x12+.nexusflow.org.v1.DeleteOrganizationRequest\x1a,.nexusflow.org.v1.DeleteOrganizationResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/v1/organizations/{id}\x12\x87\x01\n" +
+	"\x11ListOrganizations\x12*.nexusflow.org.v1.ListOrganizationsRequest\x1a+.nexusflow.org.v1.ListOrganizationsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/organizations\x12\x8c\x01\n" +
+	"\tAddMember\x12\".nexusflow.org.v1.AddMemberRequest\x1a#.nexusflow.org.v1.AddMemberResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/v1/organizations/{organization_id}/members\x12\x9c\x01\n" +
+	"\fRemoveMember\x12%.nexusflow.org.v1.RemoveMemberRequest\x1a&.nexusflow.org.v1.RemoveMemberResponse\"=\x82\xd3\xe4\x93\x027*5/v1/organizations/{organization_id}/members/{user_id}\x12\xb0\x01\n" +
+	"\x10UpdateMemberRole\x12).nexusflow.org.v1.UpdateMemberRoleRequest\x1a*.nexusflow.org.v1.UpdateMemberRoleResponse\"E\x82\xd3\xe4\x93\x02?:\x01*2:/v1/organizations/{organization_id}/members/{user_id}/role\x12\x8f\x01\n" +
+	"\vListMembers\x12$.nexusflow.org.v1.ListMembersRequest\x1a%.nexusflow.org.v1.ListMembersResponse\"3\x82\xd3\xe4\x93\x02-\x12+/v1/organizations/{organization_id}/members\x12\xa4\x01\n" +
+	"\rGetMemberRole\x12&.nexusflow.org.v1.GetMemberRoleRequest\x1a'.nexusflow.org.v1.GetMemberRoleResponse\"B\x82\xd3\xe4\x93\x02<\x12:/v1/organizations/{organization_id}/members/{user_id}/role\x12W\n" +
 	"\n" +
 	"CreateTeam\x12#.nexusflow.org.v1.CreateTeamRequest\x1a$.nexusflow.org.v1.CreateTeamResponse\x12N\n" +
 	"\aGetTeam\x12 .nexusflow.org.v1.GetTeamRequest\x1a!.nexusflow.org.v1.GetTeamResponse\x12W\n" +
@@ -2884,11 +3017,11 @@ const file_proto_org_v1_org_proto_rawDesc = "" +
 	"DeleteTeam\x12#.nexusflow.org.v1.DeleteTeamRequest\x1a$.nexusflow.org.v1.DeleteTeamResponse\x12T\n" +
 	"\tListTeams\x12\".nexusflow.org.v1.ListTeamsRequest\x1a#.nexusflow.org.v1.ListTeamsResponse\x12`\n" +
 	"\rAddTeamMember\x12&.nexusflow.org.v1.AddTeamMemberRequest\x1a'.nexusflow.org.v1.AddTeamMemberResponse\x12i\n" +
-	"\x10RemoveTeamMember\x12).nexusflow.org.v1.RemoveTeamMemberRequest\x1a*.nexusflow.org.v1.RemoveTeamMemberResponse\x12]\n" +
-	"\fCreateInvite\x12%.nexusflow.org.v1.CreateInviteRequest\x1a&.nexusflow.org.v1.CreateInviteResponse\x12]\n" +
-	"\fAcceptInvite\x12%.nexusflow.org.v1.AcceptInviteRequest\x1a&.nexusflow.org.v1.AcceptInviteResponse\x12]\n" +
-	"\fRevokeInvite\x12%.nexusflow.org.v1.RevokeInviteRequest\x1a&.nexusflow.org.v1.RevokeInviteResponse\x12Z\n" +
-	"\vListInvites\x12$.nexusflow.org.v1.ListInvitesRequest\x1a%.nexusflow.org.v1.ListInvitesResponseB7Z5github.com/nexusflow/nexusflow/pkg/proto/org/v1;orgv1b\x06proto3"
+	"\x10RemoveTeamMember\x12).nexusflow.org.v1.RemoveTeamMemberRequest\x1a*.nexusflow.org.v1.RemoveTeamMemberResponse\x12\x95\x01\n" +
+	"\fCreateInvite\x12%.nexusflow.org.v1.CreateInviteRequest\x1a&.nexusflow.org.v1.CreateInviteResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/v1/organizations/{organization_id}/invites\x12\x84\x01\n" +
+	"\fAcceptInvite\x12%.nexusflow.org.v1.AcceptInviteRequest\x1a&.nexusflow.org.v1.AcceptInviteResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/invites/{token}/accept\x12w\n" +
+	"\fRevokeInvite\x12%.nexusflow.org.v1.RevokeInviteRequest\x1a&.nexusflow.org.v1.RevokeInviteResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/invites/{id}\x12\x8f\x01\n" +
+	"\vListInvites\x12$.nexusflow.org.v1.ListInvitesRequest\x1a%.nexusflow.org.v1.ListInvitesResponse\"3\x82\xd3\xe4\x93\x02-\x12+/v1/organizations/{organization_id}/invitesB7Z5github.com/nexusflow/nexusflow/pkg/proto/org/v1;orgv1b\x06proto3"
 
 var (
 	file_proto_org_v1_org_proto_rawDescOnce sync.Once
@@ -2903,7 +3036,7 @@ func file_proto_org_v1_org_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_org_v1_org_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_proto_org_v1_org_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
+var file_proto_org_v1_org_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
 var file_proto_org_v1_org_proto_goTypes = []any{
 	(OrgStatus)(0),                     // 0: nexusflow.org.v1.OrgStatus
 	(OrgPlan)(0),                       // 1: nexusflow.org.v1.OrgPlan
@@ -2931,128 +3064,133 @@ var file_proto_org_v1_org_proto_goTypes = []any{
 	(*UpdateMemberRoleResponse)(nil),   // 23: nexusflow.org.v1.UpdateMemberRoleResponse
 	(*ListMembersRequest)(nil),         // 24: nexusflow.org.v1.ListMembersRequest
 	(*ListMembersResponse)(nil),        // 25: nexusflow.org.v1.ListMembersResponse
-	(*CreateTeamRequest)(nil),          // 26: nexusflow.org.v1.CreateTeamRequest
-	(*CreateTeamResponse)(nil),         // 27: nexusflow.org.v1.CreateTeamResponse
-	(*GetTeamRequest)(nil),             // 28: nexusflow.org.v1.GetTeamRequest
-	(*GetTeamResponse)(nil),            // 29: nexusflow.org.v1.GetTeamResponse
-	(*UpdateTeamRequest)(nil),          // 30: nexusflow.org.v1.UpdateTeamRequest
-	(*UpdateTeamResponse)(nil),         // 31: nexusflow.org.v1.UpdateTeamResponse
-	(*DeleteTeamRequest)(nil),          // 32: nexusflow.org.v1.DeleteTeamRequest
-	(*DeleteTeamResponse)(nil),         // 33: nexusflow.org.v1.DeleteTeamResponse
-	(*ListTeamsRequest)(nil),           // 34: nexusflow.org.v1.ListTeamsRequest
-	(*ListTeamsResponse)(nil),          // 35: nexusflow.org.v1.ListTeamsResponse
-	(*AddTeamMemberRequest)(nil),       // 36: nexusflow.org.v1.AddTeamMemberRequest
-	(*AddTeamMemberResponse)(nil),      // 37: nexusflow.org.v1.AddTeamMemberResponse
-	(*RemoveTeamMemberRequest)(nil),    // 38: nexusflow.org.v1.RemoveTeamMemberRequest
-	(*RemoveTeamMemberResponse)(nil),   // 39: nexusflow.org.v1.RemoveTeamMemberResponse
-	(*CreateInviteRequest)(nil),        // 40: nexusflow.org.v1.CreateInviteRequest
-	(*CreateInviteResponse)(nil),       // 41: nexusflow.org.v1.CreateInviteResponse
-	(*AcceptInviteRequest)(nil),        // 42: nexusflow.org.v1.AcceptInviteRequest
-	(*AcceptInviteResponse)(nil),       // 43: nexusflow.org.v1.AcceptInviteResponse
-	(*RevokeInviteRequest)(nil),        // 44: nexusflow.org.v1.RevokeInviteRequest
-	(*RevokeInviteResponse)(nil),       // 45: nexusflow.org.v1.RevokeInviteResponse
-	(*ListInvitesRequest)(nil),         // 46: nexusflow.org.v1.ListInvitesRequest
-	(*ListInvitesResponse)(nil),        // 47: nexusflow.org.v1.ListInvitesResponse
-	nil,                                // 48: nexusflow.org.v1.Organization.SettingsEntry
-	nil,                                // 49: nexusflow.org.v1.UpdateOrganizationRequest.SettingsEntry
-	(*timestamppb.Timestamp)(nil),      // 50: google.protobuf.Timestamp
-	(*v1.UserProfile)(nil),             // 51: nexusflow.user.v1.UserProfile
-	(*v11.SuccessResponse)(nil),        // 52: nexusflow.common.v1.SuccessResponse
-	(*v11.PaginationRequest)(nil),      // 53: nexusflow.common.v1.PaginationRequest
-	(*v11.PaginationResponse)(nil),     // 54: nexusflow.common.v1.PaginationResponse
+	(*GetMemberRoleRequest)(nil),       // 26: nexusflow.org.v1.GetMemberRoleRequest
+	(*GetMemberRoleResponse)(nil),      // 27: nexusflow.org.v1.GetMemberRoleResponse
+	(*CreateTeamRequest)(nil),          // 28: nexusflow.org.v1.CreateTeamRequest
+	(*CreateTeamResponse)(nil),         // 29: nexusflow.org.v1.CreateTeamResponse
+	(*GetTeamRequest)(nil),             // 30: nexusflow.org.v1.GetTeamRequest
+	(*GetTeamResponse)(nil),            // 31: nexusflow.org.v1.GetTeamResponse
+	(*UpdateTeamRequest)(nil),          // 32: nexusflow.org.v1.UpdateTeamRequest
+	(*UpdateTeamResponse)(nil),         // 33: nexusflow.org.v1.UpdateTeamResponse
+	(*DeleteTeamRequest)(nil),          // 34: nexusflow.org.v1.DeleteTeamRequest
+	(*DeleteTeamResponse)(nil),         // 35: nexusflow.org.v1.DeleteTeamResponse
+	(*ListTeamsRequest)(nil),           // 36: nexusflow.org.v1.ListTeamsRequest
+	(*ListTeamsResponse)(nil),          // 37: nexusflow.org.v1.ListTeamsResponse
+	(*AddTeamMemberRequest)(nil),       // 38: nexusflow.org.v1.AddTeamMemberRequest
+	(*AddTeamMemberResponse)(nil),      // 39: nexusflow.org.v1.AddTeamMemberResponse
+	(*RemoveTeamMemberRequest)(nil),    // 40: nexusflow.org.v1.RemoveTeamMemberRequest
+	(*RemoveTeamMemberResponse)(nil),   // 41: nexusflow.org.v1.RemoveTeamMemberResponse
+	(*CreateInviteRequest)(nil),        // 42: nexusflow.org.v1.CreateInviteRequest
+	(*CreateInviteResponse)(nil),       // 43: nexusflow.org.v1.CreateInviteResponse
+	(*AcceptInviteRequest)(nil),        // 44: nexusflow.org.v1.AcceptInviteRequest
+	(*AcceptInviteResponse)(nil),       // 45: nexusflow.org.v1.AcceptInviteResponse
+	(*RevokeInviteRequest)(nil),        // 46: nexusflow.org.v1.RevokeInviteRequest
+	(*RevokeInviteResponse)(nil),       // 47: nexusflow.org.v1.RevokeInviteResponse
+	(*ListInvitesRequest)(nil),         // 48: nexusflow.org.v1.ListInvitesRequest
+	(*ListInvitesResponse)(nil),        // 49: nexusflow.org.v1.ListInvitesResponse
+	nil,                                // 50: nexusflow.org.v1.Organization.SettingsEntry
+	nil,                                // 51: nexusflow.org.v1.UpdateOrganizationRequest.SettingsEntry
+	(*timestamppb.Timestamp)(nil),      // 52: google.protobuf.Timestamp
+	(*v1.UserProfile)(nil),             // 53: nexusflow.user.v1.UserProfile
+	(*v11.SuccessResponse)(nil),        // 54: nexusflow.common.v1.SuccessResponse
+	(*v11.PaginationRequest)(nil),      // 55: nexusflow.common.v1.PaginationRequest
+	(*v11.PaginationResponse)(nil),     // 56: nexusflow.common.v1.PaginationResponse
 }
 var file_proto_org_v1_org_proto_depIdxs = []int32{
 	0,  // 0: nexusflow.org.v1.Organization.status:type_name -> nexusflow.org.v1.OrgStatus
 	1,  // 1: nexusflow.org.v1.Organization.plan:type_name -> nexusflow.org.v1.OrgPlan
-	48, // 2: nexusflow.org.v1.Organization.settings:type_name -> nexusflow.org.v1.Organization.SettingsEntry
-	50, // 3: nexusflow.org.v1.Organization.created_at:type_name -> google.protobuf.Timestamp
-	50, // 4: nexusflow.org.v1.Organization.updated_at:type_name -> google.protobuf.Timestamp
-	50, // 5: nexusflow.org.v1.Team.created_at:type_name -> google.protobuf.Timestamp
-	50, // 6: nexusflow.org.v1.Team.updated_at:type_name -> google.protobuf.Timestamp
-	51, // 7: nexusflow.org.v1.OrgMember.user:type_name -> nexusflow.user.v1.UserProfile
+	50, // 2: nexusflow.org.v1.Organization.settings:type_name -> nexusflow.org.v1.Organization.SettingsEntry
+	52, // 3: nexusflow.org.v1.Organization.created_at:type_name -> google.protobuf.Timestamp
+	52, // 4: nexusflow.org.v1.Organization.updated_at:type_name -> google.protobuf.Timestamp
+	52, // 5: nexusflow.org.v1.Team.created_at:type_name -> google.protobuf.Timestamp
+	52, // 6: nexusflow.org.v1.Team.updated_at:type_name -> google.protobuf.Timestamp
+	53, // 7: nexusflow.org.v1.OrgMember.user:type_name -> nexusflow.user.v1.UserProfile
 	2,  // 8: nexusflow.org.v1.OrgMember.role:type_name -> nexusflow.org.v1.OrgRole
-	50, // 9: nexusflow.org.v1.OrgMember.joined_at:type_name -> google.protobuf.Timestamp
+	52, // 9: nexusflow.org.v1.OrgMember.joined_at:type_name -> google.protobuf.Timestamp
 	2,  // 10: nexusflow.org.v1.Invite.role:type_name -> nexusflow.org.v1.OrgRole
 	3,  // 11: nexusflow.org.v1.Invite.status:type_name -> nexusflow.org.v1.InviteStatus
-	50, // 12: nexusflow.org.v1.Invite.created_at:type_name -> google.protobuf.Timestamp
-	50, // 13: nexusflow.org.v1.Invite.expires_at:type_name -> google.protobuf.Timestamp
+	52, // 12: nexusflow.org.v1.Invite.created_at:type_name -> google.protobuf.Timestamp
+	52, // 13: nexusflow.org.v1.Invite.expires_at:type_name -> google.protobuf.Timestamp
 	4,  // 14: nexusflow.org.v1.CreateOrganizationResponse.organization:type_name -> nexusflow.org.v1.Organization
 	4,  // 15: nexusflow.org.v1.GetOrganizationResponse.organization:type_name -> nexusflow.org.v1.Organization
-	49, // 16: nexusflow.org.v1.UpdateOrganizationRequest.settings:type_name -> nexusflow.org.v1.UpdateOrganizationRequest.SettingsEntry
+	51, // 16: nexusflow.org.v1.UpdateOrganizationRequest.settings:type_name -> nexusflow.org.v1.UpdateOrganizationRequest.SettingsEntry
 	4,  // 17: nexusflow.org.v1.UpdateOrganizationResponse.organization:type_name -> nexusflow.org.v1.Organization
-	52, // 18: nexusflow.org.v1.DeleteOrganizationResponse.response:type_name -> nexusflow.common.v1.SuccessResponse
-	53, // 19: nexusflow.org.v1.ListOrganizationsRequest.pagination:type_name -> nexusflow.common.v1.PaginationRequest
+	54, // 18: nexusflow.org.v1.DeleteOrganizationResponse.response:type_name -> nexusflow.common.v1.SuccessResponse
+	55, // 19: nexusflow.org.v1.ListOrganizationsRequest.pagination:type_name -> nexusflow.common.v1.PaginationRequest
 	4,  // 20: nexusflow.org.v1.ListOrganizationsResponse.organizations:type_name -> nexusflow.org.v1.Organization
-	54, // 21: nexusflow.org.v1.ListOrganizationsResponse.pagination:type_name -> nexusflow.common.v1.PaginationResponse
+	56, // 21: nexusflow.org.v1.ListOrganizationsResponse.pagination:type_name -> nexusflow.common.v1.PaginationResponse
 	2,  // 22: nexusflow.org.v1.AddMemberRequest.role:type_name -> nexusflow.org.v1.OrgRole
 	6,  // 23: nexusflow.org.v1.AddMemberResponse.member:type_name -> nexusflow.org.v1.OrgMember
-	52, // 24: nexusflow.org.v1.RemoveMemberResponse.response:type_name -> nexusflow.common.v1.SuccessResponse
+	54, // 24: nexusflow.org.v1.RemoveMemberResponse.response:type_name -> nexusflow.common.v1.SuccessResponse
 	2,  // 25: nexusflow.org.v1.UpdateMemberRoleRequest.role:type_name -> nexusflow.org.v1.OrgRole
 	6,  // 26: nexusflow.org.v1.UpdateMemberRoleResponse.member:type_name -> nexusflow.org.v1.OrgMember
-	53, // 27: nexusflow.org.v1.ListMembersRequest.pagination:type_name -> nexusflow.common.v1.PaginationRequest
+	55, // 27: nexusflow.org.v1.ListMembersRequest.pagination:type_name -> nexusflow.common.v1.PaginationRequest
 	6,  // 28: nexusflow.org.v1.ListMembersResponse.members:type_name -> nexusflow.org.v1.OrgMember
-	54, // 29: nexusflow.org.v1.ListMembersResponse.pagination:type_name -> nexusflow.common.v1.PaginationResponse
-	5,  // 30: nexusflow.org.v1.CreateTeamResponse.team:type_name -> nexusflow.org.v1.Team
-	5,  // 31: nexusflow.org.v1.GetTeamResponse.team:type_name -> nexusflow.org.v1.Team
-	5,  // 32: nexusflow.org.v1.UpdateTeamResponse.team:type_name -> nexusflow.org.v1.Team
-	52, // 33: nexusflow.org.v1.DeleteTeamResponse.response:type_name -> nexusflow.common.v1.SuccessResponse
-	53, // 34: nexusflow.org.v1.ListTeamsRequest.pagination:type_name -> nexusflow.common.v1.PaginationRequest
-	5,  // 35: nexusflow.org.v1.ListTeamsResponse.teams:type_name -> nexusflow.org.v1.Team
-	54, // 36: nexusflow.org.v1.ListTeamsResponse.pagination:type_name -> nexusflow.common.v1.PaginationResponse
-	5,  // 37: nexusflow.org.v1.AddTeamMemberResponse.team:type_name -> nexusflow.org.v1.Team
-	5,  // 38: nexusflow.org.v1.RemoveTeamMemberResponse.team:type_name -> nexusflow.org.v1.Team
-	2,  // 39: nexusflow.org.v1.CreateInviteRequest.role:type_name -> nexusflow.org.v1.OrgRole
-	7,  // 40: nexusflow.org.v1.CreateInviteResponse.invite:type_name -> nexusflow.org.v1.Invite
-	6,  // 41: nexusflow.org.v1.AcceptInviteResponse.member:type_name -> nexusflow.org.v1.OrgMember
-	52, // 42: nexusflow.org.v1.RevokeInviteResponse.response:type_name -> nexusflow.common.v1.SuccessResponse
-	53, // 43: nexusflow.org.v1.ListInvitesRequest.pagination:type_name -> nexusflow.common.v1.PaginationRequest
-	7,  // 44: nexusflow.org.v1.ListInvitesResponse.invites:type_name -> nexusflow.org.v1.Invite
-	54, // 45: nexusflow.org.v1.ListInvitesResponse.pagination:type_name -> nexusflow.common.v1.PaginationResponse
-	8,  // 46: nexusflow.org.v1.OrgService.CreateOrganization:input_type -> nexusflow.org.v1.CreateOrganizationRequest
-	10, // 47: nexusflow.org.v1.OrgService.GetOrganization:input_type -> nexusflow.org.v1.GetOrganizationRequest
-	12, // 48: nexusflow.org.v1.OrgService.UpdateOrganization:input_type -> nexusflow.org.v1.UpdateOrganizationRequest
-	14, // 49: nexusflow.org.v1.OrgService.DeleteOrganization:input_type -> nexusflow.org.v1.DeleteOrganizationRequest
-	16, // 50: nexusflow.org.v1.OrgService.ListOrganizations:input_type -> nexusflow.org.v1.ListOrganizationsRequest
-	18, // 51: nexusflow.org.v1.OrgService.AddMember:input_type -> nexusflow.org.v1.AddMemberRequest
-	20, // 52: nexusflow.org.v1.OrgService.RemoveMember:input_type -> nexusflow.org.v1.RemoveMemberRequest
-	22, // 53: nexusflow.org.v1.OrgService.UpdateMemberRole:input_type -> nexusflow.org.v1.UpdateMemberRoleRequest
-	24, // 54: nexusflow.org.v1.OrgService.ListMembers:input_type -> nexusflow.org.v1.ListMembersRequest
-	26, // 55: nexusflow.org.v1.OrgService.CreateTeam:input_type -> nexusflow.org.v1.CreateTeamRequest
-	28, // 56: nexusflow.org.v1.OrgService.GetTeam:input_type -> nexusflow.org.v1.GetTeamRequest
-	30, // 57: nexusflow.org.v1.OrgService.UpdateTeam:input_type -> nexusflow.org.v1.UpdateTeamRequest
-	32, // 58: nexusflow.org.v1.OrgService.DeleteTeam:input_type -> nexusflow.org.v1.DeleteTeamRequest
-	34, // 59: nexusflow.org.v1.OrgService.ListTeams:input_type -> nexusflow.org.v1.ListTeamsRequest
-	36, // 60: nexusflow.org.v1.OrgService.AddTeamMember:input_type -> nexusflow.org.v1.AddTeamMemberRequest
-	38, // 61: nexusflow.org.v1.OrgService.RemoveTeamMember:input_type -> nexusflow.org.v1.RemoveTeamMemberRequest
-	40, // 62: nexusflow.org.v1.OrgService.CreateInvite:input_type -> nexusflow.org.v1.CreateInviteRequest
-	42, // 63: nexusflow.org.v1.OrgService.AcceptInvite:input_type -> nexusflow.org.v1.AcceptInviteRequest
-	44, // 64: nexusflow.org.v1.OrgService.RevokeInvite:input_type -> nexusflow.org.v1.RevokeInviteRequest
-	46, // 65: nexusflow.org.v1.OrgService.ListInvites:input_type -> nexusflow.org.v1.ListInvitesRequest
-	9,  // 66: nexusflow.org.v1.OrgService.CreateOrganization:output_type -> nexusflow.org.v1.CreateOrganizationResponse
-	11, // 67: nexusflow.org.v1.OrgService.GetOrganization:output_type -> nexusflow.org.v1.GetOrganizationResponse
-	13, // 68: nexusflow.org.v1.OrgService.UpdateOrganization:output_type -> nexusflow.org.v1.UpdateOrganizationResponse
-	15, // 69: nexusflow.org.v1.OrgService.DeleteOrganization:output_type -> nexusflow.org.v1.DeleteOrganizationResponse
-	17, // 70: nexusflow.org.v1.OrgService.ListOrganizations:output_type -> nexusflow.org.v1.ListOrganizationsResponse
-	19, // 71: nexusflow.org.v1.OrgService.AddMember:output_type -> nexusflow.org.v1.AddMemberResponse
-	21, // 72: nexusflow.org.v1.OrgService.RemoveMember:output_type -> nexusflow.org.v1.RemoveMemberResponse
-	23, // 73: nexusflow.org.v1.OrgService.UpdateMemberRole:output_type -> nexusflow.org.v1.UpdateMemberRoleResponse
-	25, // 74: nexusflow.org.v1.OrgService.ListMembers:output_type -> nexusflow.org.v1.ListMembersResponse
-	27, // 75: nexusflow.org.v1.OrgService.CreateTeam:output_type -> nexusflow.org.v1.CreateTeamResponse
-	29, // 76: nexusflow.org.v1.OrgService.GetTeam:output_type -> nexusflow.org.v1.GetTeamResponse
-	31, // 77: nexusflow.org.v1.OrgService.UpdateTeam:output_type -> nexusflow.org.v1.UpdateTeamResponse
-	33, // 78: nexusflow.org.v1.OrgService.DeleteTeam:output_type -> nexusflow.org.v1.DeleteTeamResponse
-	35, // 79: nexusflow.org.v1.OrgService.ListTeams:output_type -> nexusflow.org.v1.ListTeamsResponse
-	37, // 80: nexusflow.org.v1.OrgService.AddTeamMember:output_type -> nexusflow.org.v1.AddTeamMemberResponse
-	39, // 81: nexusflow.org.v1.OrgService.RemoveTeamMember:output_type -> nexusflow.org.v1.RemoveTeamMemberResponse
-	41, // 82: nexusflow.org.v1.OrgService.CreateInvite:output_type -> nexusflow.org.v1.CreateInviteResponse
-	43, // 83: nexusflow.org.v1.OrgService.AcceptInvite:output_type -> nexusflow.org.v1.AcceptInviteResponse
-	45, // 84: nexusflow.org.v1.OrgService.RevokeInvite:output_type -> nexusflow.org.v1.RevokeInviteResponse
-	47, // 85: nexusflow.org.v1.OrgService.ListInvites:output_type -> nexusflow.org.v1.ListInvitesResponse
-	66, // [66:86] is the sub-list for method output_type
-	46, // [46:66] is the sub-list for method input_type
-	46, // [46:46] is the sub-list for extension type_name
-	46, // [46:46] is the sub-list for extension extendee
-	0,  // [0:46] is the sub-list for field type_name
+	56, // 29: nexusflow.org.v1.ListMembersResponse.pagination:type_name -> nexusflow.common.v1.PaginationResponse
+	2,  // 30: nexusflow.org.v1.GetMemberRoleResponse.role:type_name -> nexusflow.org.v1.OrgRole
+	5,  // 31: nexusflow.org.v1.CreateTeamResponse.team:type_name -> nexusflow.org.v1.Team
+	5,  // 32: nexusflow.org.v1.GetTeamResponse.team:type_name -> nexusflow.org.v1.Team
+	5,  // 33: nexusflow.org.v1.UpdateTeamResponse.team:type_name -> nexusflow.org.v1.Team
+	54, // 34: nexusflow.org.v1.DeleteTeamResponse.response:type_name -> nexusflow.common.v1.SuccessResponse
+	55, // 35: nexusflow.org.v1.ListTeamsRequest.pagination:type_name -> nexusflow.common.v1.PaginationRequest
+	5,  // 36: nexusflow.org.v1.ListTeamsResponse.teams:type_name -> nexusflow.org.v1.Team
+	56, // 37: nexusflow.org.v1.ListTeamsResponse.pagination:type_name -> nexusflow.common.v1.PaginationResponse
+	5,  // 38: nexusflow.org.v1.AddTeamMemberResponse.team:type_name -> nexusflow.org.v1.Team
+	5,  // 39: nexusflow.org.v1.RemoveTeamMemberResponse.team:type_name -> nexusflow.org.v1.Team
+	2,  // 40: nexusflow.org.v1.CreateInviteRequest.role:type_name -> nexusflow.org.v1.OrgRole
+	7,  // 41: nexusflow.org.v1.CreateInviteResponse.invite:type_name -> nexusflow.org.v1.Invite
+	6,  // 42: nexusflow.org.v1.AcceptInviteResponse.member:type_name -> nexusflow.org.v1.OrgMember
+	54, // 43: nexusflow.org.v1.RevokeInviteResponse.response:type_name -> nexusflow.common.v1.SuccessResponse
+	55, // 44: nexusflow.org.v1.ListInvitesRequest.pagination:type_name -> nexusflow.common.v1.PaginationRequest
+	7,  // 45: nexusflow.org.v1.ListInvitesResponse.invites:type_name -> nexusflow.org.v1.Invite
+	56, // 46: nexusflow.org.v1.ListInvitesResponse.pagination:type_name -> nexusflow.common.v1.PaginationResponse
+	8,  // 47: nexusflow.org.v1.OrgService.CreateOrganization:input_type -> nexusflow.org.v1.CreateOrganizationRequest
+	10, // 48: nexusflow.org.v1.OrgService.GetOrganization:input_type -> nexusflow.org.v1.GetOrganizationRequest
+	12, // 49: nexusflow.org.v1.OrgService.UpdateOrganization:input_type -> nexusflow.org.v1.UpdateOrganizationRequest
+	14, // 50: nexusflow.org.v1.OrgService.DeleteOrganization:input_type -> nexusflow.org.v1.DeleteOrganizationRequest
+	16, // 51: nexusflow.org.v1.OrgService.ListOrganizations:input_type -> nexusflow.org.v1.ListOrganizationsRequest
+	18, // 52: nexusflow.org.v1.OrgService.AddMember:input_type -> nexusflow.org.v1.AddMemberRequest
+	20, // 53: nexusflow.org.v1.OrgService.RemoveMember:input_type -> nexusflow.org.v1.RemoveMemberRequest
+	22, // 54: nexusflow.org.v1.OrgService.UpdateMemberRole:input_type -> nexusflow.org.v1.UpdateMemberRoleRequest
+	24, // 55: nexusflow.org.v1.OrgService.ListMembers:input_type -> nexusflow.org.v1.ListMembersRequest
+	26, // 56: nexusflow.org.v1.OrgService.GetMemberRole:input_type -> nexusflow.org.v1.GetMemberRoleRequest
+	28, // 57: nexusflow.org.v1.OrgService.CreateTeam:input_type -> nexusflow.org.v1.CreateTeamRequest
+	30, // 58: nexusflow.org.v1.OrgService.GetTeam:input_type -> nexusflow.org.v1.GetTeamRequest
+	32, // 59: nexusflow.org.v1.OrgService.UpdateTeam:input_type -> nexusflow.org.v1.UpdateTeamRequest
+	34, // 60: nexusflow.org.v1.OrgService.DeleteTeam:input_type -> nexusflow.org.v1.DeleteTeamRequest
+	36, // 61: nexusflow.org.v1.OrgService.ListTeams:input_type -> nexusflow.org.v1.ListTeamsRequest
+	38, // 62: nexusflow.org.v1.OrgService.AddTeamMember:input_type -> nexusflow.org.v1.AddTeamMemberRequest
+	40, // 63: nexusflow.org.v1.OrgService.RemoveTeamMember:input_type -> nexusflow.org.v1.RemoveTeamMemberRequest
+	42, // 64: nexusflow.org.v1.OrgService.CreateInvite:input_type -> nexusflow.org.v1.CreateInviteRequest
+	44, // 65: nexusflow.org.v1.OrgService.AcceptInvite:input_type -> nexusflow.org.v1.AcceptInviteRequest
+	46, // 66: nexusflow.org.v1.OrgService.RevokeInvite:input_type -> nexusflow.org.v1.RevokeInviteRequest
+	48, // 67: nexusflow.org.v1.OrgService.ListInvites:input_type -> nexusflow.org.v1.ListInvitesRequest
+	9,  // 68: nexusflow.org.v1.OrgService.CreateOrganization:output_type -> nexusflow.org.v1.CreateOrganizationResponse
+	11, // 69: nexusflow.org.v1.OrgService.GetOrganization:output_type -> nexusflow.org.v1.GetOrganizationResponse
+	13, // 70: nexusflow.org.v1.OrgService.UpdateOrganization:output_type -> nexusflow.org.v1.UpdateOrganizationResponse
+	15, // 71: nexusflow.org.v1.OrgService.DeleteOrganization:output_type -> nexusflow.org.v1.DeleteOrganizationResponse
+	17, // 72: nexusflow.org.v1.OrgService.ListOrganizations:output_type -> nexusflow.org.v1.ListOrganizationsResponse
+	19, // 73: nexusflow.org.v1.OrgService.AddMember:output_type -> nexusflow.org.v1.AddMemberResponse
+	21, // 74: nexusflow.org.v1.OrgService.RemoveMember:output_type -> nexusflow.org.v1.RemoveMemberResponse
+	23, // 75: nexusflow.org.v1.OrgService.UpdateMemberRole:output_type -> nexusflow.org.v1.UpdateMemberRoleResponse
+	25, // 76: nexusflow.org.v1.OrgService.ListMembers:output_type -> nexusflow.org.v1.ListMembersResponse
+	27, // 77: nexusflow.org.v1.OrgService.GetMemberRole:output_type -> nexusflow.org.v1.GetMemberRoleResponse
+	29, // 78: nexusflow.org.v1.OrgService.CreateTeam:output_type -> nexusflow.org.v1.CreateTeamResponse
+	31, // 79: nexusflow.org.v1.OrgService.GetTeam:output_type -> nexusflow.org.v1.GetTeamResponse
+	33, // 80: nexusflow.org.v1.OrgService.UpdateTeam:output_type -> nexusflow.org.v1.UpdateTeamResponse
+	35, // 81: nexusflow.org.v1.OrgService.DeleteTeam:output_type -> nexusflow.org.v1.DeleteTeamResponse
+	37, // 82: nexusflow.org.v1.OrgService.ListTeams:output_type -> nexusflow.org.v1.ListTeamsResponse
+	39, // 83: nexusflow.org.v1.OrgService.AddTeamMember:output_type -> nexusflow.org.v1.AddTeamMemberResponse
+	41, // 84: nexusflow.org.v1.OrgService.RemoveTeamMember:output_type -> nexusflow.org.v1.RemoveTeamMemberResponse
+	43, // 85: nexusflow.org.v1.OrgService.CreateInvite:output_type -> nexusflow.org.v1.CreateInviteResponse
+	45, // 86: nexusflow.org.v1.OrgService.AcceptInvite:output_type -> nexusflow.org.v1.AcceptInviteResponse
+	47, // 87: nexusflow.org.v1.OrgService.RevokeInvite:output_type -> nexusflow.org.v1.RevokeInviteResponse
+	49, // 88: nexusflow.org.v1.OrgService.ListInvites:output_type -> nexusflow.org.v1.ListInvitesResponse
+	68, // [68:89] is the sub-list for method output_type
+	47, // [47:68] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_proto_org_v1_org_proto_init() }
@@ -3061,14 +3199,14 @@ func file_proto_org_v1_org_proto_init() {
 		return
 	}
 	file_proto_org_v1_org_proto_msgTypes[8].OneofWrappers = []any{}
-	file_proto_org_v1_org_proto_msgTypes[26].OneofWrappers = []any{}
+	file_proto_org_v1_org_proto_msgTypes[28].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_org_v1_org_proto_rawDesc), len(file_proto_org_v1_org_proto_rawDesc)),
 			NumEnums:      4,
-			NumMessages:   46,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
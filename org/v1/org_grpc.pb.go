@@ -28,6 +28,7 @@ const (
 	OrgService_RemoveMember_FullMethodName       = "/nexusflow.org.v1.OrgService/RemoveMember"
 	OrgService_UpdateMemberRole_FullMethodName   = "/nexusflow.org.v1.OrgService/UpdateMemberRole"
 	OrgService_ListMembers_FullMethodName        = "/nexusflow.org.v1.OrgService/ListMembers"
+	OrgService_GetMemberRole_FullMethodName      = "/nexusflow.org.v1.OrgService/GetMemberRole"
 	OrgService_CreateTeam_FullMethodName         = "/nexusflow.org.v1.OrgService/CreateTeam"
 	OrgService_GetTeam_FullMethodName            = "/nexusflow.org.v1.OrgService/GetTeam"
 	OrgService_UpdateTeam_FullMethodName         = "/nexusflow.org.v1.OrgService/UpdateTeam"
@@ -58,6 +59,7 @@ type OrgServiceClient interface {
 	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error)
 	UpdateMemberRole(ctx context.Context, in *UpdateMemberRoleRequest, opts ...grpc.CallOption) (*UpdateMemberRoleResponse, error)
 	ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error)
+	GetMemberRole(ctx context.Context, in *GetMemberRoleRequest, opts ...grpc.CallOption) (*GetMemberRoleResponse, error)
 	// Team management
 	CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*CreateTeamResponse, error)
 	GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*GetTeamResponse, error)
@@ -171,6 +173,16 @@ func (c *orgServiceClient) ListMembers(ctx context.Context, in *ListMembersReque
 	return out, nil
 }
 
+func (c *orgServiceClient) GetMemberRole(ctx context.Context, in *GetMemberRoleRequest, opts ...grpc.CallOption) (*GetMemberRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMemberRoleResponse)
+	err := c.cc.Invoke(ctx, OrgService_GetMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orgServiceClient) CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*CreateTeamResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateTeamResponse)
@@ -298,6 +310,7 @@ type OrgServiceServer interface {
 	RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error)
 	UpdateMemberRole(context.Context, *UpdateMemberRoleRequest) (*UpdateMemberRoleResponse, error)
 	ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error)
+	GetMemberRole(context.Context, *GetMemberRoleRequest) (*GetMemberRoleResponse, error)
 	// Team management
 	CreateTeam(context.Context, *CreateTeamRequest) (*CreateTeamResponse, error)
 	GetTeam(context.Context, *GetTeamRequest) (*GetTeamResponse, error)
@@ -348,6 +361,9 @@ func (UnimplementedOrgServiceServer) UpdateMemberRole(context.Context, *UpdateMe
 func (UnimplementedOrgServiceServer) ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMembers not implemented")
 }
+func (UnimplementedOrgServiceServer) GetMemberRole(context.Context, *GetMemberRoleRequest) (*GetMemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemberRole not implemented")
+}
 func (UnimplementedOrgServiceServer) CreateTeam(context.Context, *CreateTeamRequest) (*CreateTeamResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTeam not implemented")
 }
@@ -564,6 +580,24 @@ func _OrgService_ListMembers_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrgService_GetMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgServiceServer).GetMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrgService_GetMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgServiceServer).GetMemberRole(ctx, req.(*GetMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrgService_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateTeamRequest)
 	if err := dec(in); err != nil {
@@ -805,6 +839,10 @@ var OrgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMembers",
 			Handler:    _OrgService_ListMembers_Handler,
 		},
+		{
+			MethodName: "GetMemberRole",
+			Handler:    _OrgService_GetMemberRole_Handler,
+		},
 		{
 			MethodName: "CreateTeam",
 			Handler:    _OrgService_CreateTeam_Handler,
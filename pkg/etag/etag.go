@@ -0,0 +1,77 @@
+package etag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// ifMatchMetadataKey is how the gateway forwards the HTTP If-Match header
+const ifMatchMetadataKey = "grpcgateway-if-match"
+
+// ErrConflict is returned when an update is based on a stale version
+var ErrConflict = errors.New("version conflict")
+
+// ConflictError is returned when an entity changed since the version the
+// caller expected. Current holds the latest state so clients can merge.
+type ConflictError[T any] struct {
+	// Entity names the kind of entity, such as "issue"
+	Entity  string
+	ID      string
+	Version int64
+	Current T
+}
+
+func (e *ConflictError[T]) Error() string {
+	return fmt.Sprintf("%s %s was modified, current version is %d", e.Entity, e.ID, e.Version)
+}
+
+func (e *ConflictError[T]) Unwrap() error {
+	return ErrConflict
+}
+
+// Set sends the entity version as an etag header, which the gateway returns
+// to HTTP clients as ETag
+func Set(ctx context.Context, version int64) {
+	_ = grpc.SetHeader(ctx, metadata.Pairs("etag", strconv.Quote(strconv.FormatInt(version, 10))))
+}
+
+// ExpectedVersion returns the version an update is conditioned on: the
+// request field if set, otherwise the If-Match header. A nil result means
+// unconditional.
+func ExpectedVersion(ctx context.Context, field *int64) (*int64, error) {
+	if field != nil {
+		return field, nil
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	values := md.Get(ifMatchMetadataKey)
+	if len(values) == 0 || strings.TrimSpace(values[0]) == "*" {
+		return nil, nil
+	}
+	tag := strings.TrimPrefix(strings.TrimSpace(values[0]), "W/")
+	version, err := strconv.ParseInt(strings.Trim(tag, `"`), 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid If-Match header %q", values[0])
+	}
+	return &version, nil
+}
+
+// ConflictStatus builds an Aborted status carrying the current entity
+func ConflictStatus(msg string, current protoadapt.MessageV1) error {
+	st := status.New(codes.Aborted, msg)
+	if detailed, err := st.WithDetails(current); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
@@ -31,6 +31,31 @@ type Event struct {
 	Payload        map[string]interface{} `json:"payload"`
 }
 
+// PayloadStrings reads a list of strings from a payload field, skipping
+// empty and non-string values. A consumed event's lists decode as
+// []interface{}; events built in process may hold a []string.
+func (e Event) PayloadStrings(key string) []string {
+	switch values := e.Payload[key].(type) {
+	case []string:
+		result := make([]string, 0, len(values))
+		for _, s := range values {
+			if s != "" {
+				result = append(result, s)
+			}
+		}
+		return result
+	case []interface{}:
+		result := make([]string, 0, len(values))
+		for _, v := range values {
+			if s, ok := v.(string); ok && s != "" {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
 // NewProducer creates a new Kafka producer
 func NewProducer(cfg ProducerConfig) (*Producer, error) {
 	config := sarama.NewConfig()
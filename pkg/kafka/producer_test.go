@@ -0,0 +1,31 @@
+package kafka
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEvent_PayloadStrings(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload map[string]interface{}
+		want    []string
+	}{
+		{"Decoded list", map[string]interface{}{"ids": []interface{}{"a", "b"}}, []string{"a", "b"}},
+		{"In-process list", map[string]interface{}{"ids": []string{"a", "", "b"}}, []string{"a", "b"}},
+		{"Other values skipped", map[string]interface{}{"ids": []interface{}{"a", 1.0, nil, ""}}, []string{"a"}},
+		{"Empty list", map[string]interface{}{"ids": []interface{}{}}, []string{}},
+		{"Missing", map[string]interface{}{}, nil},
+		{"Not a list", map[string]interface{}{"ids": "a"}, nil},
+		{"No payload", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := Event{Payload: tt.payload}
+			if got := event.PayloadStrings("ids"); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PayloadStrings() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+module github.com/nexusflow/nexusflow/pkg/workflow
+
+go 1.24.0
+
+require github.com/nexusflow/nexusflow/pkg/proto v0.0.0-00010101000000-000000000000
+
+require (
+	golang.org/x/net v0.47.0 // indirect
+	golang.org/x/sys v0.38.0 // indirect
+	golang.org/x/text v0.31.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20251111163417-95abcf5c77ba // indirect
+	google.golang.org/grpc v1.77.0 // indirect
+	google.golang.org/protobuf v1.36.10 // indirect
+)
+
+replace github.com/nexusflow/nexusflow/pkg/proto => ../proto
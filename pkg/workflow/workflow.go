@@ -0,0 +1,26 @@
+// Package workflow reads what services need to know about project workflows
+// from the workflow service
+package workflow
+
+import (
+	"context"
+	"fmt"
+
+	workflowpb "github.com/nexusflow/nexusflow/pkg/proto/workflow/v1"
+)
+
+// StatusCategories maps the statuses of a project's workflows to their
+// categories
+func StatusCategories(ctx context.Context, client workflowpb.WorkflowServiceClient, projectID string) (map[string]workflowpb.StatusCategory, error) {
+	resp, err := client.ListWorkflows(ctx, &workflowpb.ListWorkflowsRequest{ProjectId: projectID})
+	if err != nil {
+		return nil, fmt.Errorf("list workflows: %w", err)
+	}
+	categories := make(map[string]workflowpb.StatusCategory)
+	for _, w := range resp.Workflows {
+		for _, st := range w.Statuses {
+			categories[st.Id] = st.Category
+		}
+	}
+	return categories, nil
+}
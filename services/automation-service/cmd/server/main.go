@@ -0,0 +1,226 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/nexusflow/nexusflow/pkg/config"
+	"github.com/nexusflow/nexusflow/pkg/database"
+	"github.com/nexusflow/nexusflow/pkg/kafka"
+	"github.com/nexusflow/nexusflow/pkg/logger"
+	pb "github.com/nexusflow/nexusflow/pkg/proto/automation/v1"
+	"github.com/nexusflow/nexusflow/services/automation-service/internal/handler"
+	"github.com/nexusflow/nexusflow/services/automation-service/internal/repository"
+	"github.com/nexusflow/nexusflow/services/automation-service/internal/service"
+)
+
+const serviceName = "automation-service"
+
+func main() {
+	// Initialize logger
+	log, err := logger.NewDefault(serviceName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
+		os.Exit(1)
+	}
+	defer func() { _ = log.Sync() }()
+
+	log.Sugar().Infow("Starting automation-service")
+
+	// Load configuration
+	cfg, err := config.New(serviceName)
+	if err != nil {
+		log.Sugar().Fatal("Failed to load configuration")
+	}
+
+	// Initialize database
+	dbCfg := cfg.GetDatabase()
+	db, err := database.New(database.Config{
+		Host:            dbCfg.Host,
+		Port:            dbCfg.Port,
+		User:            dbCfg.User,
+		Password:        dbCfg.Password,
+		Database:        dbCfg.Database,
+		SSLMode:         dbCfg.SSLMode,
+		MaxOpenConns:    dbCfg.MaxOpenConns,
+		MaxIdleConns:    dbCfg.MaxIdleConns,
+		ConnMaxLifetime: time.Duration(dbCfg.ConnMaxLifetime) * time.Second,
+	})
+	if err != nil {
+		log.Sugar().Fatal("Failed to connect to database")
+	}
+	defer db.Close()
+
+	log.Sugar().Infow("Database connection established")
+
+	// Run database migrations
+	if err := runMigrations(db.GetSQLDB(), log); err != nil {
+		log.Sugar().Fatal("Failed to run migrations")
+	}
+
+	// Initialize Kafka producer
+	kafkaCfg := cfg.GetKafka()
+	var producer *kafka.Producer
+	producer, err = kafka.NewProducer(kafka.ProducerConfig{
+		Brokers: kafkaCfg.Brokers,
+	})
+	if err != nil {
+		log.Sugar().Warnw("Failed to create Kafka producer, continuing without events", "error", err)
+		producer = nil
+	} else {
+		defer producer.Close()
+		log.Sugar().Infow("Kafka producer initialized")
+	}
+
+	// Initialize layers
+	repo := repository.NewAutomationRepository(db, log)
+	issueServiceAddr := "127.0.0.1:50054"    // Default
+	workflowServiceAddr := "127.0.0.1:50055" // Default
+	commentServiceAddr := "127.0.0.1:50058"  // Default
+	// Comments store their author as a UUID, so the automation user is one
+	actorID := cfg.GetString("automation.actor_id")
+	if actorID == "" {
+		actorID = "00000000-0000-0000-0000-000000000001"
+	}
+	svc, err := service.NewAutomationService(repo, producer, log, issueServiceAddr, workflowServiceAddr, commentServiceAddr, actorID)
+	if err != nil {
+		log.Sugar().Fatalw("Failed to create automation service", "error", err)
+	}
+	h := handler.NewAutomationHandler(svc, log)
+
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+
+	// Run scheduled rules
+	scheduleInterval := cfg.GetInt("automation.schedule_poll_interval_seconds")
+	if scheduleInterval <= 0 {
+		scheduleInterval = 60
+	}
+	go svc.RunScheduler(backgroundCtx, time.Duration(scheduleInterval)*time.Second)
+
+	// Publish SLA warnings and breaches as they fall due
+	slaInterval := cfg.GetInt("sla.poll_interval_seconds")
+	if slaInterval <= 0 {
+		slaInterval = 30
+	}
+	go svc.RunSLATimers(backgroundCtx, time.Duration(slaInterval)*time.Second)
+
+	// Consume the events that trigger rules and move SLA timers
+	consumer, err := kafka.NewEventConsumer(kafka.ConsumerConfig{
+		Brokers:       kafkaCfg.Brokers,
+		ConsumerGroup: kafkaCfg.ConsumerGroup,
+		Topics:        []string{"issue-events", "comment-events", "sprint-events", "git-events", "sla-events"},
+	}, svc.HandleEvent)
+	if err != nil {
+		log.Sugar().Warnw("Failed to create Kafka consumer, continuing without rules", "error", err)
+	} else {
+		defer consumer.Close()
+		consumerCtx, stopConsumer := context.WithCancel(context.Background())
+		defer stopConsumer()
+		go func() {
+			if err := consumer.Start(consumerCtx); err != nil && !errors.Is(err, context.Canceled) {
+				log.Sugar().Errorw("Kafka consumer stopped", "error", err)
+			}
+		}()
+		log.Sugar().Infow("Kafka consumer initialized")
+	}
+
+	// Create gRPC server
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			// Add interceptors here
+		),
+	)
+
+	// Register services
+	pb.RegisterAutomationServiceServer(grpcServer, h)
+
+	// Register health check
+	healthServer := health.NewServer()
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
+	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+
+	// Register reflection service (for development)
+	reflection.Register(grpcServer)
+
+	// Start gRPC server
+	serverCfg := cfg.GetServer()
+	port := serverCfg.GRPCPort
+	if port == 0 || port == 9090 {
+		port = 50063
+	}
+
+	addr := fmt.Sprintf("%s:%d", serverCfg.Host, port)
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Sugar().Fatalw("Failed to listen", "error", err, "addr", addr)
+	}
+
+	// Start server in goroutine
+	go func() {
+		log.Sugar().Infow("gRPC server listening", "addr", addr)
+		if err := grpcServer.Serve(listener); err != nil {
+			log.Sugar().Fatal("Failed to serve")
+		}
+	}()
+
+	// Wait for interrupt signal
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	log.Sugar().Infow("Shutting down server...")
+
+	// Graceful shutdown
+	grpcServer.GracefulStop()
+
+	log.Sugar().Infow("Server stopped")
+}
+
+// runMigrations runs database migrations
+func runMigrations(db *sql.DB, log *logger.Logger) error {
+	driver, err := postgres.WithInstance(db, &postgres.Config{
+		MigrationsTable: "schema_migrations_automation",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create migration driver: %w", err)
+	}
+
+	m, err := migrate.NewWithDatabaseInstance(
+		"file://migrations",
+		"postgres",
+		driver,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create migration instance: %w", err)
+	}
+
+	log.Sugar().Infow("Running database migrations...")
+
+	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
+		return fmt.Errorf("failed to run migrations: %w", err)
+	}
+
+	version, dirty, err := m.Version()
+	if err != nil && err != migrate.ErrNilVersion {
+		return fmt.Errorf("failed to get migration version: %w", err)
+	}
+
+	log.Sugar().Infow("Database migrations complete", "version", version, "dirty", dirty)
+	return nil
+}
@@ -0,0 +1,389 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nexusflow/nexusflow/pkg/logger"
+	pb "github.com/nexusflow/nexusflow/pkg/proto/automation/v1"
+	commonpb "github.com/nexusflow/nexusflow/pkg/proto/common/v1"
+	"github.com/nexusflow/nexusflow/services/automation-service/internal/models"
+	"github.com/nexusflow/nexusflow/services/automation-service/internal/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AutomationHandler handles gRPC requests
+type AutomationHandler struct {
+	pb.UnimplementedAutomationServiceServer
+	service *service.AutomationService
+	log     *logger.Logger
+}
+
+// NewAutomationHandler creates a new automation handler
+func NewAutomationHandler(service *service.AutomationService, log *logger.Logger) *AutomationHandler {
+	return &AutomationHandler{
+		service: service,
+		log:     log,
+	}
+}
+
+// CreateRule creates a rule
+func (h *AutomationHandler) CreateRule(ctx context.Context, req *pb.CreateRuleRequest) (*pb.CreateRuleResponse, error) {
+	if req.Rule == nil {
+		return nil, status.Error(codes.InvalidArgument, "rule is required")
+	}
+	rule, err := h.service.CreateRule(ctx, protoToRule(req.Rule))
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to create rule", "error", err)
+		return nil, h.errorToStatus(err, "failed to create rule")
+	}
+	return &pb.CreateRuleResponse{Rule: ruleToProto(rule)}, nil
+}
+
+// GetRule gets a rule
+func (h *AutomationHandler) GetRule(ctx context.Context, req *pb.GetRuleRequest) (*pb.GetRuleResponse, error) {
+	rule, err := h.service.GetRule(ctx, req.Id)
+	if err != nil {
+		return nil, h.errorToStatus(err, "failed to get rule")
+	}
+	return &pb.GetRuleResponse{Rule: ruleToProto(rule)}, nil
+}
+
+// UpdateRule replaces a rule
+func (h *AutomationHandler) UpdateRule(ctx context.Context, req *pb.UpdateRuleRequest) (*pb.UpdateRuleResponse, error) {
+	if req.Rule == nil {
+		return nil, status.Error(codes.InvalidArgument, "rule is required")
+	}
+	update := protoToRule(req.Rule)
+	update.ID = req.Id
+	rule, err := h.service.UpdateRule(ctx, update)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to update rule", "error", err)
+		return nil, h.errorToStatus(err, "failed to update rule")
+	}
+	return &pb.UpdateRuleResponse{Rule: ruleToProto(rule)}, nil
+}
+
+// DeleteRule deletes a rule
+func (h *AutomationHandler) DeleteRule(ctx context.Context, req *pb.DeleteRuleRequest) (*pb.DeleteRuleResponse, error) {
+	if err := h.service.DeleteRule(ctx, req.Id); err != nil {
+		h.log.Sugar().Errorw("Failed to delete rule", "error", err)
+		return nil, h.errorToStatus(err, "failed to delete rule")
+	}
+	return &pb.DeleteRuleResponse{}, nil
+}
+
+// ListRules lists the rules of a project
+func (h *AutomationHandler) ListRules(ctx context.Context, req *pb.ListRulesRequest) (*pb.ListRulesResponse, error) {
+	rules, err := h.service.ListRules(ctx, req.ProjectId)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to list rules", "error", err)
+		return nil, h.errorToStatus(err, "failed to list rules")
+	}
+
+	pbRules := make([]*pb.Rule, len(rules))
+	for i, rule := range rules {
+		pbRules[i] = ruleToProto(rule)
+	}
+	return &pb.ListRulesResponse{Rules: pbRules}, nil
+}
+
+// ListRuleExecutions lists a page of a rule's audit log
+func (h *AutomationHandler) ListRuleExecutions(ctx context.Context, req *pb.ListRuleExecutionsRequest) (*pb.ListRuleExecutionsResponse, error) {
+	page, pageSize := 1, 0
+	if req.Pagination != nil {
+		page = int(req.Pagination.Page)
+		pageSize = int(req.Pagination.PageSize)
+	}
+
+	result, err := h.service.ListRuleExecutions(ctx, req.RuleId, page, pageSize)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to list rule executions", "error", err)
+		return nil, h.errorToStatus(err, "failed to list rule executions")
+	}
+
+	executions := make([]*pb.RuleExecution, len(result.Executions))
+	for i, e := range result.Executions {
+		executions[i] = executionToProto(e)
+	}
+
+	totalPages := (result.Total + result.PageSize - 1) / result.PageSize
+	return &pb.ListRuleExecutionsResponse{
+		Executions: executions,
+		Pagination: &commonpb.PaginationResponse{
+			Page:        int32(result.Page),
+			PageSize:    int32(result.PageSize),
+			TotalItems:  int64(result.Total),
+			TotalPages:  int32(totalPages),
+			HasNext:     result.Page < totalPages,
+			HasPrevious: result.Page > 1,
+		},
+	}, nil
+}
+
+// errorToStatus maps service errors to gRPC status codes
+func (h *AutomationHandler) errorToStatus(err error, msg string) error {
+	switch {
+	case errors.Is(err, service.ErrValidation):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
+
+func protoToRule(r *pb.Rule) *models.Rule {
+	rule := &models.Rule{
+		ProjectID:               r.ProjectId,
+		Name:                    r.Name,
+		Description:             r.Description,
+		TriggerEvent:            r.TriggerEvent,
+		Enabled:                 r.Enabled,
+		DryRun:                  r.DryRun,
+		AllowAutomationTriggers: r.AllowAutomationTriggers,
+		CreatedBy:               r.CreatedBy,
+	}
+	if r.Schedule != nil {
+		rule.Schedule = &models.RuleSchedule{
+			IntervalMinutes: int(r.Schedule.IntervalMinutes),
+			StaleMinutes:    int(r.Schedule.StaleMinutes),
+			StatusIDs:       r.Schedule.StatusIds,
+			IssueTypes:      r.Schedule.IssueTypes,
+			Priorities:      r.Schedule.Priorities,
+		}
+	}
+	for _, c := range r.Conditions {
+		rule.Conditions = append(rule.Conditions, models.Condition{
+			Type:      protoConditionTypeToModel(c.Type),
+			Field:     c.Field,
+			Operator:  protoOperatorToModel(c.Operator),
+			Values:    c.Values,
+			Target:    protoTargetToModel(c.Target),
+			StatusIDs: c.StatusIds,
+		})
+	}
+	for _, a := range r.Actions {
+		rule.Actions = append(rule.Actions, models.Action{
+			Type:       protoActionTypeToModel(a.Type),
+			Target:     protoTargetToModel(a.Target),
+			StatusID:   a.StatusId,
+			AssigneeID: a.AssigneeId,
+			Priority:   a.Priority,
+			UserIDs:    a.UserIds,
+			Title:      a.Title,
+			Message:    a.Message,
+		})
+	}
+	return rule
+}
+
+func ruleToProto(rule *models.Rule) *pb.Rule {
+	r := &pb.Rule{
+		Id:                      rule.ID,
+		ProjectId:               rule.ProjectID,
+		Name:                    rule.Name,
+		Description:             rule.Description,
+		TriggerEvent:            rule.TriggerEvent,
+		Enabled:                 rule.Enabled,
+		DryRun:                  rule.DryRun,
+		AllowAutomationTriggers: rule.AllowAutomationTriggers,
+		CreatedBy:               rule.CreatedBy,
+		CreatedAt:               timestamppb.New(rule.CreatedAt),
+		UpdatedAt:               timestamppb.New(rule.UpdatedAt),
+	}
+	if rule.Schedule != nil {
+		r.Schedule = &pb.RuleSchedule{
+			IntervalMinutes: int32(rule.Schedule.IntervalMinutes),
+			StaleMinutes:    int32(rule.Schedule.StaleMinutes),
+			StatusIds:       rule.Schedule.StatusIDs,
+			IssueTypes:      rule.Schedule.IssueTypes,
+			Priorities:      rule.Schedule.Priorities,
+		}
+	}
+	if !rule.NextRunAt.IsZero() {
+		r.NextRunAt = timestamppb.New(rule.NextRunAt)
+	}
+	if !rule.LastRunAt.IsZero() {
+		r.LastRunAt = timestamppb.New(rule.LastRunAt)
+	}
+	for _, c := range rule.Conditions {
+		r.Conditions = append(r.Conditions, &pb.Condition{
+			Type:      conditionTypeToProto(c.Type),
+			Field:     c.Field,
+			Operator:  operatorToProto(c.Operator),
+			Values:    c.Values,
+			Target:    targetToProto(c.Target),
+			StatusIds: c.StatusIDs,
+		})
+	}
+	for _, a := range rule.Actions {
+		r.Actions = append(r.Actions, &pb.Action{
+			Type:       actionTypeToProto(a.Type),
+			Target:     targetToProto(a.Target),
+			StatusId:   a.StatusID,
+			AssigneeId: a.AssigneeID,
+			Priority:   a.Priority,
+			UserIds:    a.UserIDs,
+			Title:      a.Title,
+			Message:    a.Message,
+		})
+	}
+	return r
+}
+
+func executionToProto(e *models.RuleExecution) *pb.RuleExecution {
+	execution := &pb.RuleExecution{
+		Id:        e.ID,
+		RuleId:    e.RuleID,
+		ProjectId: e.ProjectID,
+		EventId:   e.EventID,
+		EventType: e.EventType,
+		IssueId:   e.IssueID,
+		Status:    executionStatusToProto(e.Status),
+		Error:     e.Error,
+		CreatedAt: timestamppb.New(e.CreatedAt),
+	}
+	for _, a := range e.Actions {
+		execution.Actions = append(execution.Actions, &pb.ActionResult{
+			Type:    actionTypeToProto(a.Type),
+			IssueId: a.IssueID,
+			Status:  a.Status,
+			Error:   a.Error,
+		})
+	}
+	return execution
+}
+
+func protoConditionTypeToModel(t pb.ConditionType) models.ConditionType {
+	switch t {
+	case pb.ConditionType_CONDITION_TYPE_FIELD:
+		return models.ConditionTypeField
+	case pb.ConditionType_CONDITION_TYPE_SUB_TASKS_IN_STATUS:
+		return models.ConditionTypeSubTasksInStatus
+	default:
+		return ""
+	}
+}
+
+func conditionTypeToProto(t models.ConditionType) pb.ConditionType {
+	switch t {
+	case models.ConditionTypeField:
+		return pb.ConditionType_CONDITION_TYPE_FIELD
+	case models.ConditionTypeSubTasksInStatus:
+		return pb.ConditionType_CONDITION_TYPE_SUB_TASKS_IN_STATUS
+	default:
+		return pb.ConditionType_CONDITION_TYPE_UNSPECIFIED
+	}
+}
+
+func protoOperatorToModel(o pb.ConditionOperator) models.ConditionOperator {
+	switch o {
+	case pb.ConditionOperator_CONDITION_OPERATOR_EQUALS:
+		return models.OperatorEquals
+	case pb.ConditionOperator_CONDITION_OPERATOR_NOT_EQUALS:
+		return models.OperatorNotEquals
+	case pb.ConditionOperator_CONDITION_OPERATOR_IN:
+		return models.OperatorIn
+	case pb.ConditionOperator_CONDITION_OPERATOR_NOT_IN:
+		return models.OperatorNotIn
+	case pb.ConditionOperator_CONDITION_OPERATOR_CONTAINS:
+		return models.OperatorContains
+	case pb.ConditionOperator_CONDITION_OPERATOR_IS_EMPTY:
+		return models.OperatorIsEmpty
+	case pb.ConditionOperator_CONDITION_OPERATOR_IS_NOT_EMPTY:
+		return models.OperatorIsNotEmpty
+	default:
+		return ""
+	}
+}
+
+func operatorToProto(o models.ConditionOperator) pb.ConditionOperator {
+	switch o {
+	case models.OperatorEquals:
+		return pb.ConditionOperator_CONDITION_OPERATOR_EQUALS
+	case models.OperatorNotEquals:
+		return pb.ConditionOperator_CONDITION_OPERATOR_NOT_EQUALS
+	case models.OperatorIn:
+		return pb.ConditionOperator_CONDITION_OPERATOR_IN
+	case models.OperatorNotIn:
+		return pb.ConditionOperator_CONDITION_OPERATOR_NOT_IN
+	case models.OperatorContains:
+		return pb.ConditionOperator_CONDITION_OPERATOR_CONTAINS
+	case models.OperatorIsEmpty:
+		return pb.ConditionOperator_CONDITION_OPERATOR_IS_EMPTY
+	case models.OperatorIsNotEmpty:
+		return pb.ConditionOperator_CONDITION_OPERATOR_IS_NOT_EMPTY
+	default:
+		return pb.ConditionOperator_CONDITION_OPERATOR_UNSPECIFIED
+	}
+}
+
+func protoTargetToModel(t pb.Target) models.Target {
+	switch t {
+	case pb.Target_TARGET_ISSUE:
+		return models.TargetIssue
+	case pb.Target_TARGET_PARENT:
+		return models.TargetParent
+	default:
+		return ""
+	}
+}
+
+func targetToProto(t models.Target) pb.Target {
+	switch t {
+	case models.TargetIssue:
+		return pb.Target_TARGET_ISSUE
+	case models.TargetParent:
+		return pb.Target_TARGET_PARENT
+	default:
+		return pb.Target_TARGET_UNSPECIFIED
+	}
+}
+
+func protoActionTypeToModel(t pb.ActionType) models.ActionType {
+	switch t {
+	case pb.ActionType_ACTION_TYPE_TRANSITION_ISSUE:
+		return models.ActionTypeTransitionIssue
+	case pb.ActionType_ACTION_TYPE_UPDATE_ISSUE:
+		return models.ActionTypeUpdateIssue
+	case pb.ActionType_ACTION_TYPE_NOTIFY:
+		return models.ActionTypeNotify
+	case pb.ActionType_ACTION_TYPE_ADD_COMMENT:
+		return models.ActionTypeAddComment
+	default:
+		return ""
+	}
+}
+
+func actionTypeToProto(t models.ActionType) pb.ActionType {
+	switch t {
+	case models.ActionTypeTransitionIssue:
+		return pb.ActionType_ACTION_TYPE_TRANSITION_ISSUE
+	case models.ActionTypeUpdateIssue:
+		return pb.ActionType_ACTION_TYPE_UPDATE_ISSUE
+	case models.ActionTypeNotify:
+		return pb.ActionType_ACTION_TYPE_NOTIFY
+	case models.ActionTypeAddComment:
+		return pb.ActionType_ACTION_TYPE_ADD_COMMENT
+	default:
+		return pb.ActionType_ACTION_TYPE_UNSPECIFIED
+	}
+}
+
+func executionStatusToProto(s models.ExecutionStatus) pb.ExecutionStatus {
+	switch s {
+	case models.ExecutionStatusSuccess:
+		return pb.ExecutionStatus_EXECUTION_STATUS_SUCCESS
+	case models.ExecutionStatusFailed:
+		return pb.ExecutionStatus_EXECUTION_STATUS_FAILED
+	case models.ExecutionStatusDryRun:
+		return pb.ExecutionStatus_EXECUTION_STATUS_DRY_RUN
+	case models.ExecutionStatusLoopDetected:
+		return pb.ExecutionStatus_EXECUTION_STATUS_LOOP_DETECTED
+	default:
+		return pb.ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED
+	}
+}
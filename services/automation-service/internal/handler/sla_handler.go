@@ -0,0 +1,259 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/nexusflow/nexusflow/pkg/proto/automation/v1"
+	"github.com/nexusflow/nexusflow/services/automation-service/internal/models"
+	"github.com/nexusflow/nexusflow/services/automation-service/internal/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateBusinessCalendar creates a business calendar
+func (h *AutomationHandler) CreateBusinessCalendar(ctx context.Context, req *pb.CreateBusinessCalendarRequest) (*pb.CreateBusinessCalendarResponse, error) {
+	if req.Calendar == nil {
+		return nil, status.Error(codes.InvalidArgument, "calendar is required")
+	}
+	calendar, err := h.service.CreateCalendar(ctx, protoToCalendar(req.Calendar))
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to create calendar", "error", err)
+		return nil, h.errorToStatus(err, "failed to create calendar")
+	}
+	return &pb.CreateBusinessCalendarResponse{Calendar: calendarToProto(calendar)}, nil
+}
+
+// GetBusinessCalendar gets a business calendar
+func (h *AutomationHandler) GetBusinessCalendar(ctx context.Context, req *pb.GetBusinessCalendarRequest) (*pb.GetBusinessCalendarResponse, error) {
+	calendar, err := h.service.GetCalendar(ctx, req.Id)
+	if err != nil {
+		return nil, h.errorToStatus(err, "failed to get calendar")
+	}
+	return &pb.GetBusinessCalendarResponse{Calendar: calendarToProto(calendar)}, nil
+}
+
+// UpdateBusinessCalendar replaces a business calendar
+func (h *AutomationHandler) UpdateBusinessCalendar(ctx context.Context, req *pb.UpdateBusinessCalendarRequest) (*pb.UpdateBusinessCalendarResponse, error) {
+	if req.Calendar == nil {
+		return nil, status.Error(codes.InvalidArgument, "calendar is required")
+	}
+	update := protoToCalendar(req.Calendar)
+	update.ID = req.Id
+	calendar, err := h.service.UpdateCalendar(ctx, update)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to update calendar", "error", err)
+		return nil, h.errorToStatus(err, "failed to update calendar")
+	}
+	return &pb.UpdateBusinessCalendarResponse{Calendar: calendarToProto(calendar)}, nil
+}
+
+// DeleteBusinessCalendar deletes a business calendar
+func (h *AutomationHandler) DeleteBusinessCalendar(ctx context.Context, req *pb.DeleteBusinessCalendarRequest) (*pb.DeleteBusinessCalendarResponse, error) {
+	if err := h.service.DeleteCalendar(ctx, req.Id); err != nil {
+		h.log.Sugar().Errorw("Failed to delete calendar", "error", err)
+		return nil, h.errorToStatus(err, "failed to delete calendar")
+	}
+	return &pb.DeleteBusinessCalendarResponse{}, nil
+}
+
+// ListBusinessCalendars lists the business calendars of a project
+func (h *AutomationHandler) ListBusinessCalendars(ctx context.Context, req *pb.ListBusinessCalendarsRequest) (*pb.ListBusinessCalendarsResponse, error) {
+	calendars, err := h.service.ListCalendars(ctx, req.ProjectId)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to list calendars", "error", err)
+		return nil, h.errorToStatus(err, "failed to list calendars")
+	}
+
+	pbCalendars := make([]*pb.BusinessCalendar, len(calendars))
+	for i, calendar := range calendars {
+		pbCalendars[i] = calendarToProto(calendar)
+	}
+	return &pb.ListBusinessCalendarsResponse{Calendars: pbCalendars}, nil
+}
+
+// CreateSLADefinition creates an SLA definition
+func (h *AutomationHandler) CreateSLADefinition(ctx context.Context, req *pb.CreateSLADefinitionRequest) (*pb.CreateSLADefinitionResponse, error) {
+	if req.Sla == nil {
+		return nil, status.Error(codes.InvalidArgument, "sla is required")
+	}
+	sla, err := h.service.CreateSLADefinition(ctx, protoToSLADefinition(req.Sla))
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to create SLA definition", "error", err)
+		return nil, h.errorToStatus(err, "failed to create SLA definition")
+	}
+	return &pb.CreateSLADefinitionResponse{Sla: slaDefinitionToProto(sla)}, nil
+}
+
+// GetSLADefinition gets an SLA definition
+func (h *AutomationHandler) GetSLADefinition(ctx context.Context, req *pb.GetSLADefinitionRequest) (*pb.GetSLADefinitionResponse, error) {
+	sla, err := h.service.GetSLADefinition(ctx, req.Id)
+	if err != nil {
+		return nil, h.errorToStatus(err, "failed to get SLA definition")
+	}
+	return &pb.GetSLADefinitionResponse{Sla: slaDefinitionToProto(sla)}, nil
+}
+
+// UpdateSLADefinition replaces an SLA definition
+func (h *AutomationHandler) UpdateSLADefinition(ctx context.Context, req *pb.UpdateSLADefinitionRequest) (*pb.UpdateSLADefinitionResponse, error) {
+	if req.Sla == nil {
+		return nil, status.Error(codes.InvalidArgument, "sla is required")
+	}
+	update := protoToSLADefinition(req.Sla)
+	update.ID = req.Id
+	sla, err := h.service.UpdateSLADefinition(ctx, update)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to update SLA definition", "error", err)
+		return nil, h.errorToStatus(err, "failed to update SLA definition")
+	}
+	return &pb.UpdateSLADefinitionResponse{Sla: slaDefinitionToProto(sla)}, nil
+}
+
+// DeleteSLADefinition deletes an SLA definition
+func (h *AutomationHandler) DeleteSLADefinition(ctx context.Context, req *pb.DeleteSLADefinitionRequest) (*pb.DeleteSLADefinitionResponse, error) {
+	if err := h.service.DeleteSLADefinition(ctx, req.Id); err != nil {
+		h.log.Sugar().Errorw("Failed to delete SLA definition", "error", err)
+		return nil, h.errorToStatus(err, "failed to delete SLA definition")
+	}
+	return &pb.DeleteSLADefinitionResponse{}, nil
+}
+
+// ListSLADefinitions lists the SLA definitions of a project
+func (h *AutomationHandler) ListSLADefinitions(ctx context.Context, req *pb.ListSLADefinitionsRequest) (*pb.ListSLADefinitionsResponse, error) {
+	slas, err := h.service.ListSLADefinitions(ctx, req.ProjectId)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to list SLA definitions", "error", err)
+		return nil, h.errorToStatus(err, "failed to list SLA definitions")
+	}
+
+	pbSLAs := make([]*pb.SLADefinition, len(slas))
+	for i, sla := range slas {
+		pbSLAs[i] = slaDefinitionToProto(sla)
+	}
+	return &pb.ListSLADefinitionsResponse{Slas: pbSLAs}, nil
+}
+
+// ListIssueSLAs lists the SLAs of an issue with their remaining time
+func (h *AutomationHandler) ListIssueSLAs(ctx context.Context, req *pb.ListIssueSLAsRequest) (*pb.ListIssueSLAsResponse, error) {
+	slas, err := h.service.ListIssueSLAs(ctx, req.IssueId)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to list issue SLAs", "error", err)
+		return nil, h.errorToStatus(err, "failed to list issue SLAs")
+	}
+
+	pbSLAs := make([]*pb.IssueSLA, len(slas))
+	for i, sla := range slas {
+		pbSLAs[i] = issueSLAToProto(sla)
+	}
+	return &pb.ListIssueSLAsResponse{Slas: pbSLAs}, nil
+}
+
+func protoToCalendar(c *pb.BusinessCalendar) *models.BusinessCalendar {
+	calendar := &models.BusinessCalendar{
+		ProjectID: c.ProjectId,
+		Name:      c.Name,
+		Timezone:  c.Timezone,
+		Holidays:  c.Holidays,
+	}
+	for _, wh := range c.WorkingHours {
+		calendar.WorkingHours = append(calendar.WorkingHours, models.WorkingHours{
+			Weekday: time.Weekday(wh.Weekday),
+			Start:   wh.Start,
+			End:     wh.End,
+		})
+	}
+	return calendar
+}
+
+func calendarToProto(calendar *models.BusinessCalendar) *pb.BusinessCalendar {
+	c := &pb.BusinessCalendar{
+		Id:        calendar.ID,
+		ProjectId: calendar.ProjectID,
+		Name:      calendar.Name,
+		Timezone:  calendar.Timezone,
+		Holidays:  calendar.Holidays,
+		CreatedAt: timestamppb.New(calendar.CreatedAt),
+		UpdatedAt: timestamppb.New(calendar.UpdatedAt),
+	}
+	for _, wh := range calendar.WorkingHours {
+		c.WorkingHours = append(c.WorkingHours, &pb.WorkingHours{
+			Weekday: int32(wh.Weekday),
+			Start:   wh.Start,
+			End:     wh.End,
+		})
+	}
+	return c
+}
+
+func protoToSLADefinition(s *pb.SLADefinition) *models.SLADefinition {
+	return &models.SLADefinition{
+		ProjectID:       s.ProjectId,
+		Name:            s.Name,
+		Description:     s.Description,
+		TargetMinutes:   int(s.TargetMinutes),
+		WarningMinutes:  int(s.WarningMinutes),
+		CalendarID:      s.CalendarId,
+		IssueTypes:      s.IssueTypes,
+		Priorities:      s.Priorities,
+		StartCategories: s.StartCategories,
+		PauseCategories: s.PauseCategories,
+		StopCategories:  s.StopCategories,
+		Enabled:         s.Enabled,
+	}
+}
+
+func slaDefinitionToProto(sla *models.SLADefinition) *pb.SLADefinition {
+	return &pb.SLADefinition{
+		Id:              sla.ID,
+		ProjectId:       sla.ProjectID,
+		Name:            sla.Name,
+		Description:     sla.Description,
+		TargetMinutes:   int32(sla.TargetMinutes),
+		WarningMinutes:  int32(sla.WarningMinutes),
+		CalendarId:      sla.CalendarID,
+		IssueTypes:      sla.IssueTypes,
+		Priorities:      sla.Priorities,
+		StartCategories: sla.StartCategories,
+		PauseCategories: sla.PauseCategories,
+		StopCategories:  sla.StopCategories,
+		Enabled:         sla.Enabled,
+		CreatedAt:       timestamppb.New(sla.CreatedAt),
+		UpdatedAt:       timestamppb.New(sla.UpdatedAt),
+	}
+}
+
+func issueSLAToProto(sla *service.IssueSLA) *pb.IssueSLA {
+	timer := sla.Timer
+	s := &pb.IssueSLA{
+		SlaId:            timer.SLAID,
+		SlaName:          timer.SLA.Name,
+		IssueId:          timer.IssueID,
+		State:            slaTimerStateToProto(timer.State),
+		TargetSeconds:    int64(timer.SLA.TargetMinutes) * 60,
+		ElapsedSeconds:   sla.ElapsedSeconds,
+		RemainingSeconds: sla.RemainingSeconds,
+		Breached:         timer.Breached || sla.RemainingSeconds < 0,
+		StartedAt:        timestamppb.New(timer.StartedAt),
+	}
+	if !timer.DueAt.IsZero() {
+		s.DueAt = timestamppb.New(timer.DueAt)
+	}
+	if !timer.StoppedAt.IsZero() {
+		s.StoppedAt = timestamppb.New(timer.StoppedAt)
+	}
+	return s
+}
+
+func slaTimerStateToProto(s models.SLATimerState) pb.SLATimerState {
+	switch s {
+	case models.SLATimerStateRunning:
+		return pb.SLATimerState_SLA_TIMER_STATE_RUNNING
+	case models.SLATimerStatePaused:
+		return pb.SLATimerState_SLA_TIMER_STATE_PAUSED
+	case models.SLATimerStateStopped:
+		return pb.SLATimerState_SLA_TIMER_STATE_STOPPED
+	default:
+		return pb.SLATimerState_SLA_TIMER_STATE_UNSPECIFIED
+	}
+}
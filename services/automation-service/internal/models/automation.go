@@ -0,0 +1,163 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// ConditionType represents the kind of check a condition makes
+type ConditionType string
+
+const (
+	// ConditionTypeField compares a field of the event or an issue
+	ConditionTypeField ConditionType = "field"
+	// ConditionTypeSubTasksInStatus requires every sub-task of an issue to be
+	// in one of a set of statuses
+	ConditionTypeSubTasksInStatus ConditionType = "sub_tasks_in_status"
+)
+
+// ConditionOperator represents how a field condition compares values
+type ConditionOperator string
+
+const (
+	OperatorEquals     ConditionOperator = "equals"
+	OperatorNotEquals  ConditionOperator = "not_equals"
+	OperatorIn         ConditionOperator = "in"
+	OperatorNotIn      ConditionOperator = "not_in"
+	OperatorContains   ConditionOperator = "contains"
+	OperatorIsEmpty    ConditionOperator = "is_empty"
+	OperatorIsNotEmpty ConditionOperator = "is_not_empty"
+)
+
+// Target represents the issue a condition or action applies to
+type Target string
+
+const (
+	TargetIssue  Target = "issue"
+	TargetParent Target = "parent"
+)
+
+// ActionType represents what an action does
+type ActionType string
+
+const (
+	ActionTypeTransitionIssue ActionType = "transition_issue"
+	ActionTypeUpdateIssue     ActionType = "update_issue"
+	ActionTypeNotify          ActionType = "notify"
+	ActionTypeAddComment      ActionType = "add_comment"
+)
+
+// TriggerScheduled is the trigger of rules that run on a timer rather than
+// on an event
+const TriggerScheduled = "scheduled"
+
+// ExecutionStatus represents the outcome of a rule execution
+type ExecutionStatus string
+
+const (
+	ExecutionStatusSuccess      ExecutionStatus = "success"
+	ExecutionStatusFailed       ExecutionStatus = "failed"
+	ExecutionStatusDryRun       ExecutionStatus = "dry_run"
+	ExecutionStatusLoopDetected ExecutionStatus = "loop_detected"
+)
+
+// ActionResult statuses
+const (
+	ActionStatusDone      = "done"
+	ActionStatusPlanned   = "planned"
+	ActionStatusUnchanged = "unchanged"
+	ActionStatusFailed    = "failed"
+)
+
+// Rule runs its actions when an event of its trigger type matches all of its
+// conditions
+type Rule struct {
+	bun.BaseModel `bun:"table:automation_rules,alias:r"`
+
+	ID          string `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	ProjectID   string `bun:"project_id,notnull,type:uuid"`
+	Name        string `bun:"name,notnull"`
+	Description string `bun:"description"`
+
+	TriggerEvent string      `bun:"trigger_event,notnull"`
+	Conditions   []Condition `bun:"conditions,type:jsonb"`
+	Actions      []Action    `bun:"actions,type:jsonb"`
+
+	// Schedule selects the issues of scheduled rules
+	Schedule  *RuleSchedule `bun:"schedule,type:jsonb"`
+	NextRunAt time.Time     `bun:"next_run_at,nullzero"`
+	LastRunAt time.Time     `bun:"last_run_at,nullzero"`
+
+	Enabled bool `bun:"enabled,notnull,default:true"`
+	// DryRun rules record the actions they would take without taking them
+	DryRun bool `bun:"dry_run,notnull,default:false"`
+	// AllowAutomationTriggers lets the rule run on changes made by rules
+	AllowAutomationTriggers bool `bun:"allow_automation_triggers,notnull,default:false"`
+
+	CreatedBy string    `bun:"created_by,type:uuid,nullzero"`
+	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// RuleSchedule is how often a scheduled rule runs and which issues of its
+// project it runs on. Each matching issue is checked against the rule's
+// conditions as if it had an event of its own.
+type RuleSchedule struct {
+	IntervalMinutes int `json:"interval_minutes"`
+	// StaleMinutes only selects issues not updated for that long
+	StaleMinutes int      `json:"stale_minutes,omitempty"`
+	StatusIDs    []string `json:"status_ids,omitempty"`
+	IssueTypes   []string `json:"issue_types,omitempty"`
+	Priorities   []string `json:"priorities,omitempty"`
+}
+
+// Condition is a check a rule makes before running its actions
+type Condition struct {
+	Type ConditionType `json:"type"`
+	// Field is the path of the value a field condition compares, see
+	// service.eventContext.lookup
+	Field    string            `json:"field,omitempty"`
+	Operator ConditionOperator `json:"operator,omitempty"`
+	Values   []string          `json:"values,omitempty"`
+	// Target and StatusIDs are used by sub-task conditions
+	Target    Target   `json:"target,omitempty"`
+	StatusIDs []string `json:"status_ids,omitempty"`
+}
+
+// Action is something a rule does. Text fields and user IDs may contain
+// {{path}} placeholders. Message is the text of notifications and comments.
+type Action struct {
+	Type       ActionType `json:"type"`
+	Target     Target     `json:"target,omitempty"`
+	StatusID   string     `json:"status_id,omitempty"`
+	AssigneeID string     `json:"assignee_id,omitempty"`
+	Priority   string     `json:"priority,omitempty"`
+	UserIDs    []string   `json:"user_ids,omitempty"`
+	Title      string     `json:"title,omitempty"`
+	Message    string     `json:"message,omitempty"`
+}
+
+// RuleExecution is an entry of a rule's audit log
+type RuleExecution struct {
+	bun.BaseModel `bun:"table:automation_rule_executions,alias:re"`
+
+	ID        string          `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	RuleID    string          `bun:"rule_id,notnull,type:uuid"`
+	ProjectID string          `bun:"project_id,notnull,type:uuid"`
+	EventID   string          `bun:"event_id,notnull"`
+	EventType string          `bun:"event_type,notnull"`
+	IssueID   string          `bun:"issue_id,type:uuid,nullzero"`
+	Status    ExecutionStatus `bun:"status,notnull"`
+	Actions   []ActionResult  `bun:"actions,type:jsonb"`
+	Error     string          `bun:"error"`
+	CreatedAt time.Time       `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// ActionResult is the outcome of one action of an execution
+type ActionResult struct {
+	Type    ActionType `json:"type"`
+	IssueID string     `json:"issue_id,omitempty"`
+	Status  string     `json:"status"`
+	Error   string     `json:"error,omitempty"`
+}
@@ -0,0 +1,109 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// Status categories, as the workflow service names them
+const (
+	StatusCategoryTodo       = "todo"
+	StatusCategoryInProgress = "in_progress"
+	StatusCategoryDone       = "done"
+)
+
+// SLATimerState represents whether an SLA timer is counting
+type SLATimerState string
+
+const (
+	SLATimerStateRunning SLATimerState = "running"
+	SLATimerStatePaused  SLATimerState = "paused"
+	SLATimerStateStopped SLATimerState = "stopped"
+)
+
+// BusinessCalendar is the working time SLA targets are counted in
+type BusinessCalendar struct {
+	bun.BaseModel `bun:"table:business_calendars,alias:bc"`
+
+	ID        string `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	ProjectID string `bun:"project_id,notnull,type:uuid"`
+	Name      string `bun:"name,notnull"`
+	// Timezone is an IANA name such as "Europe/Berlin"
+	Timezone     string         `bun:"timezone,notnull"`
+	WorkingHours []WorkingHours `bun:"working_hours,type:jsonb"`
+	// Holidays are dates ("2006-01-02") without working time
+	Holidays []string `bun:"holidays,type:jsonb"`
+
+	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// WorkingHours is a period of working time on a weekday. A day may have
+// several, e.g. around a lunch break.
+type WorkingHours struct {
+	Weekday time.Weekday `json:"weekday"`
+	Start   string       `json:"start"` // "09:00"
+	End     string       `json:"end"`   // "17:30"
+}
+
+// SLADefinition is a time target for the issues of a project. Its timer runs
+// while an issue is in a start category, pauses in a pause category and stops
+// in a stop category.
+type SLADefinition struct {
+	bun.BaseModel `bun:"table:sla_definitions,alias:sla"`
+
+	ID          string `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	ProjectID   string `bun:"project_id,notnull,type:uuid"`
+	Name        string `bun:"name,notnull"`
+	Description string `bun:"description"`
+
+	TargetMinutes int `bun:"target_minutes,notnull"`
+	// WarningMinutes is how long before the target a warning is published;
+	// zero publishes none
+	WarningMinutes int `bun:"warning_minutes,notnull"`
+	// CalendarID is the business calendar time is counted in; empty counts
+	// around the clock
+	CalendarID string `bun:"calendar_id,type:uuid,nullzero"`
+
+	// IssueTypes and Priorities limit the issues the SLA applies to
+	IssueTypes []string `bun:"issue_types,array"`
+	Priorities []string `bun:"priorities,array"`
+
+	// StartCategories empty starts the timer in any category that does not
+	// stop it
+	StartCategories []string `bun:"start_categories,array"`
+	PauseCategories []string `bun:"pause_categories,array"`
+	StopCategories  []string `bun:"stop_categories,array"`
+
+	Enabled   bool      `bun:"enabled,notnull,default:true"`
+	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// IssueSLATimer tracks an SLA on one issue. Elapsed time is business time
+// counted up to ResumedAt; while running, the time since ResumedAt adds to it.
+type IssueSLATimer struct {
+	bun.BaseModel `bun:"table:issue_sla_timers,alias:st"`
+
+	ID        string        `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	SLAID     string        `bun:"sla_id,notnull,type:uuid"`
+	IssueID   string        `bun:"issue_id,notnull,type:uuid"`
+	ProjectID string        `bun:"project_id,notnull,type:uuid"`
+	State     SLATimerState `bun:"state,notnull"`
+
+	ElapsedSeconds int64     `bun:"elapsed_seconds,notnull"`
+	StartedAt      time.Time `bun:"started_at,notnull"`
+	ResumedAt      time.Time `bun:"resumed_at,nullzero"`
+	// WarnAt and DueAt are set while the timer runs
+	WarnAt    time.Time `bun:"warn_at,nullzero"`
+	DueAt     time.Time `bun:"due_at,nullzero"`
+	StoppedAt time.Time `bun:"stopped_at,nullzero"`
+	Warned    bool      `bun:"warned,notnull"`
+	Breached  bool      `bun:"breached,notnull"`
+
+	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+
+	SLA *SLADefinition `bun:"rel:belongs-to,join:sla_id=id"`
+}
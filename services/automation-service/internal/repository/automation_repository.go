@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nexusflow/nexusflow/pkg/database"
+	"github.com/nexusflow/nexusflow/pkg/logger"
+	"github.com/nexusflow/nexusflow/services/automation-service/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// AutomationRepository stores automation rules and their audit log
+type AutomationRepository struct {
+	db  *database.DB
+	log *logger.Logger
+}
+
+// NewAutomationRepository creates a new automation repository
+func NewAutomationRepository(db *database.DB, log *logger.Logger) *AutomationRepository {
+	return &AutomationRepository{db: db, log: log}
+}
+
+// CreateRule creates a rule
+func (r *AutomationRepository) CreateRule(ctx context.Context, rule *models.Rule) error {
+	if _, err := r.db.NewInsert().Model(rule).Exec(ctx); err != nil {
+		return fmt.Errorf("create rule: %w", err)
+	}
+	return nil
+}
+
+// GetRule gets a rule by ID
+func (r *AutomationRepository) GetRule(ctx context.Context, id string) (*models.Rule, error) {
+	rule := new(models.Rule)
+	err := r.db.NewSelect().Model(rule).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get rule: %w", err)
+	}
+	return rule, nil
+}
+
+// UpdateRule updates a rule
+func (r *AutomationRepository) UpdateRule(ctx context.Context, rule *models.Rule) error {
+	rule.UpdatedAt = time.Now()
+	if _, err := r.db.NewUpdate().Model(rule).WherePK().Exec(ctx); err != nil {
+		return fmt.Errorf("update rule: %w", err)
+	}
+	return nil
+}
+
+// DeleteRule deletes a rule and its audit log
+func (r *AutomationRepository) DeleteRule(ctx context.Context, id string) error {
+	_, err := r.db.NewDelete().Model((*models.Rule)(nil)).Where("id = ?", id).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete rule: %w", err)
+	}
+	return nil
+}
+
+// ListRules lists the rules of a project
+func (r *AutomationRepository) ListRules(ctx context.Context, projectID string) ([]*models.Rule, error) {
+	var rules []*models.Rule
+	err := r.db.NewSelect().
+		Model(&rules).
+		Where("project_id = ?", projectID).
+		Order("name ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list rules: %w", err)
+	}
+	return rules, nil
+}
+
+// ListTriggeredRules lists the enabled rules of a project triggered by an
+// event type
+func (r *AutomationRepository) ListTriggeredRules(ctx context.Context, projectID, eventType string) ([]*models.Rule, error) {
+	var rules []*models.Rule
+	err := r.db.NewSelect().
+		Model(&rules).
+		Where("project_id = ?", projectID).
+		Where("enabled").
+		Where("trigger_event = ?", eventType).
+		Order("created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list triggered rules: %w", err)
+	}
+	return rules, nil
+}
+
+// CreateExecution records a rule execution. It reports false if the rule
+// already ran for the event.
+func (r *AutomationRepository) CreateExecution(ctx context.Context, execution *models.RuleExecution) (bool, error) {
+	res, err := r.db.NewInsert().
+		Model(execution).
+		On("CONFLICT (rule_id, event_id) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("create rule execution: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("create rule execution: %w", err)
+	}
+	return n == 1, nil
+}
+
+// ListRulesRun returns which of the rules already ran for an event
+func (r *AutomationRepository) ListRulesRun(ctx context.Context, eventID string, ruleIDs []string) (map[string]bool, error) {
+	if len(ruleIDs) == 0 {
+		return map[string]bool{}, nil
+	}
+	var ids []string
+	err := r.db.NewSelect().
+		Model((*models.RuleExecution)(nil)).
+		Column("rule_id").
+		Where("event_id = ?", eventID).
+		Where("rule_id IN (?)", bun.In(ruleIDs)).
+		Scan(ctx, &ids)
+	if err != nil {
+		return nil, fmt.Errorf("list rules run: %w", err)
+	}
+	run := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		run[id] = true
+	}
+	return run, nil
+}
+
+// CountRecentExecutions counts the executions of a rule on an issue since a
+// time that took or planned actions
+func (r *AutomationRepository) CountRecentExecutions(ctx context.Context, ruleID, issueID string, since time.Time) (int, error) {
+	count, err := r.db.NewSelect().
+		Model((*models.RuleExecution)(nil)).
+		Where("rule_id = ?", ruleID).
+		Where("issue_id = ?", issueID).
+		Where("created_at >= ?", since).
+		Where("status IN (?)", bun.In([]models.ExecutionStatus{
+			models.ExecutionStatusSuccess,
+			models.ExecutionStatusFailed,
+			models.ExecutionStatusDryRun,
+		})).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("count rule executions: %w", err)
+	}
+	return count, nil
+}
+
+// ListExecutions lists a page of a rule's executions, newest first, with the
+// total count
+func (r *AutomationRepository) ListExecutions(ctx context.Context, ruleID string, limit, offset int) ([]*models.RuleExecution, int, error) {
+	var executions []*models.RuleExecution
+	count, err := r.db.NewSelect().
+		Model(&executions).
+		Where("rule_id = ?", ruleID).
+		OrderExpr("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list rule executions: %w", err)
+	}
+	return executions, count, nil
+}
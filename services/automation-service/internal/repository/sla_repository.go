@@ -0,0 +1,316 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nexusflow/nexusflow/services/automation-service/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// CreateCalendar creates a business calendar
+func (r *AutomationRepository) CreateCalendar(ctx context.Context, calendar *models.BusinessCalendar) error {
+	if _, err := r.db.NewInsert().Model(calendar).Exec(ctx); err != nil {
+		return fmt.Errorf("create calendar: %w", err)
+	}
+	return nil
+}
+
+// GetCalendar gets a business calendar by ID
+func (r *AutomationRepository) GetCalendar(ctx context.Context, id string) (*models.BusinessCalendar, error) {
+	calendar := new(models.BusinessCalendar)
+	err := r.db.NewSelect().Model(calendar).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get calendar: %w", err)
+	}
+	return calendar, nil
+}
+
+// UpdateCalendar updates a business calendar
+func (r *AutomationRepository) UpdateCalendar(ctx context.Context, calendar *models.BusinessCalendar) error {
+	calendar.UpdatedAt = time.Now()
+	if _, err := r.db.NewUpdate().Model(calendar).WherePK().Exec(ctx); err != nil {
+		return fmt.Errorf("update calendar: %w", err)
+	}
+	return nil
+}
+
+// DeleteCalendar deletes a business calendar
+func (r *AutomationRepository) DeleteCalendar(ctx context.Context, id string) error {
+	_, err := r.db.NewDelete().Model((*models.BusinessCalendar)(nil)).Where("id = ?", id).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete calendar: %w", err)
+	}
+	return nil
+}
+
+// ListCalendars lists the business calendars of a project
+func (r *AutomationRepository) ListCalendars(ctx context.Context, projectID string) ([]*models.BusinessCalendar, error) {
+	var calendars []*models.BusinessCalendar
+	err := r.db.NewSelect().
+		Model(&calendars).
+		Where("project_id = ?", projectID).
+		Order("name ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list calendars: %w", err)
+	}
+	return calendars, nil
+}
+
+// CreateSLADefinition creates an SLA definition
+func (r *AutomationRepository) CreateSLADefinition(ctx context.Context, sla *models.SLADefinition) error {
+	if _, err := r.db.NewInsert().Model(sla).Exec(ctx); err != nil {
+		return fmt.Errorf("create sla definition: %w", err)
+	}
+	return nil
+}
+
+// GetSLADefinition gets an SLA definition by ID
+func (r *AutomationRepository) GetSLADefinition(ctx context.Context, id string) (*models.SLADefinition, error) {
+	sla := new(models.SLADefinition)
+	err := r.db.NewSelect().Model(sla).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get sla definition: %w", err)
+	}
+	return sla, nil
+}
+
+// UpdateSLADefinition updates an SLA definition
+func (r *AutomationRepository) UpdateSLADefinition(ctx context.Context, sla *models.SLADefinition) error {
+	sla.UpdatedAt = time.Now()
+	if _, err := r.db.NewUpdate().Model(sla).WherePK().Exec(ctx); err != nil {
+		return fmt.Errorf("update sla definition: %w", err)
+	}
+	return nil
+}
+
+// DeleteSLADefinition deletes an SLA definition and its timers
+func (r *AutomationRepository) DeleteSLADefinition(ctx context.Context, id string) error {
+	_, err := r.db.NewDelete().Model((*models.SLADefinition)(nil)).Where("id = ?", id).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete sla definition: %w", err)
+	}
+	return nil
+}
+
+// ListSLADefinitions lists the SLA definitions of a project, optionally only
+// the enabled ones
+func (r *AutomationRepository) ListSLADefinitions(ctx context.Context, projectID string, enabledOnly bool) ([]*models.SLADefinition, error) {
+	var slas []*models.SLADefinition
+	q := r.db.NewSelect().
+		Model(&slas).
+		Where("project_id = ?", projectID).
+		Order("name ASC")
+	if enabledOnly {
+		q = q.Where("enabled")
+	}
+	if err := q.Scan(ctx); err != nil {
+		return nil, fmt.Errorf("list sla definitions: %w", err)
+	}
+	return slas, nil
+}
+
+// ListSLADefinitionsByCalendar lists the SLA definitions counted in a
+// business calendar
+func (r *AutomationRepository) ListSLADefinitionsByCalendar(ctx context.Context, calendarID string) ([]*models.SLADefinition, error) {
+	var slas []*models.SLADefinition
+	err := r.db.NewSelect().
+		Model(&slas).
+		Where("calendar_id = ?", calendarID).
+		Order("name ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list sla definitions by calendar: %w", err)
+	}
+	return slas, nil
+}
+
+// GetSLATimer gets the timer of an SLA on an issue
+func (r *AutomationRepository) GetSLATimer(ctx context.Context, slaID, issueID string) (*models.IssueSLATimer, error) {
+	timer := new(models.IssueSLATimer)
+	err := r.db.NewSelect().
+		Model(timer).
+		Where("sla_id = ?", slaID).
+		Where("issue_id = ?", issueID).
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get sla timer: %w", err)
+	}
+	return timer, nil
+}
+
+// SaveSLATimer creates or updates an SLA timer
+func (r *AutomationRepository) SaveSLATimer(ctx context.Context, timer *models.IssueSLATimer) error {
+	var err error
+	if timer.ID == "" {
+		_, err = r.db.NewInsert().Model(timer).Exec(ctx)
+	} else {
+		timer.UpdatedAt = time.Now()
+		_, err = r.db.NewUpdate().Model(timer).ExcludeColumn("created_at").WherePK().Exec(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("save sla timer: %w", err)
+	}
+	return nil
+}
+
+// ListIssueSLATimers lists the timers of an issue with their SLAs
+func (r *AutomationRepository) ListIssueSLATimers(ctx context.Context, issueID string) ([]*models.IssueSLATimer, error) {
+	var timers []*models.IssueSLATimer
+	err := r.db.NewSelect().
+		Model(&timers).
+		Relation("SLA").
+		Where("st.issue_id = ?", issueID).
+		Order("sla.name ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list issue sla timers: %w", err)
+	}
+	return timers, nil
+}
+
+// ListActiveSLATimers lists the running and paused timers of an SLA
+func (r *AutomationRepository) ListActiveSLATimers(ctx context.Context, slaID string) ([]*models.IssueSLATimer, error) {
+	var timers []*models.IssueSLATimer
+	err := r.db.NewSelect().
+		Model(&timers).
+		Where("sla_id = ?", slaID).
+		Where("state <> ?", models.SLATimerStateStopped).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list active sla timers: %w", err)
+	}
+	return timers, nil
+}
+
+// ListDueSLAWarnings lists running timers whose warning is due at now
+func (r *AutomationRepository) ListDueSLAWarnings(ctx context.Context, now time.Time, limit int) ([]*models.IssueSLATimer, error) {
+	return r.listDueSLATimers(ctx, "st.warn_at <= ? AND NOT st.warned", now, limit)
+}
+
+// ListDueSLABreaches lists running timers whose target is due at now
+func (r *AutomationRepository) ListDueSLABreaches(ctx context.Context, now time.Time, limit int) ([]*models.IssueSLATimer, error) {
+	return r.listDueSLATimers(ctx, "st.due_at <= ? AND NOT st.breached", now, limit)
+}
+
+func (r *AutomationRepository) listDueSLATimers(ctx context.Context, where string, now time.Time, limit int) ([]*models.IssueSLATimer, error) {
+	var timers []*models.IssueSLATimer
+	err := r.db.NewSelect().
+		Model(&timers).
+		Relation("SLA").
+		Where("st.state = ?", models.SLATimerStateRunning).
+		Where(where, now).
+		Order("st.due_at ASC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list due sla timers: %w", err)
+	}
+	return timers, nil
+}
+
+// ClaimSLAWarning marks a running timer as warned. It reports false if the
+// timer was warned, paused or stopped in the meantime.
+func (r *AutomationRepository) ClaimSLAWarning(ctx context.Context, id string) (bool, error) {
+	return r.claimSLATimer(ctx, id, "warned")
+}
+
+// ClaimSLABreach marks a running timer as breached. It reports false if the
+// timer was breached, paused or stopped in the meantime.
+func (r *AutomationRepository) ClaimSLABreach(ctx context.Context, id string) (bool, error) {
+	return r.claimSLATimer(ctx, id, "breached")
+}
+
+func (r *AutomationRepository) claimSLATimer(ctx context.Context, id, column string) (bool, error) {
+	res, err := r.db.NewUpdate().
+		Model((*models.IssueSLATimer)(nil)).
+		Set("? = TRUE", bun.Ident(column)).
+		Where("id = ?", id).
+		Where("state = ?", models.SLATimerStateRunning).
+		Where("NOT ?", bun.Ident(column)).
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("claim sla timer: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("claim sla timer: %w", err)
+	}
+	return n == 1, nil
+}
+
+// ListRunningSLATimers lists the running timers of issues with their SLAs
+func (r *AutomationRepository) ListRunningSLATimers(ctx context.Context, issueIDs []string) ([]*models.IssueSLATimer, error) {
+	var timers []*models.IssueSLATimer
+	err := r.db.NewSelect().
+		Model(&timers).
+		Relation("SLA").
+		Where("st.issue_id IN (?)", bun.In(issueIDs)).
+		Where("st.state = ?", models.SLATimerStateRunning).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list running sla timers: %w", err)
+	}
+	return timers, nil
+}
+
+// DeleteIssueSLATimers deletes the timers of issues, for issues that are
+// purged
+func (r *AutomationRepository) DeleteIssueSLATimers(ctx context.Context, issueIDs []string) error {
+	_, err := r.db.NewDelete().
+		Model((*models.IssueSLATimer)(nil)).
+		Where("issue_id IN (?)", bun.In(issueIDs)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete issue sla timers: %w", err)
+	}
+	return nil
+}
+
+// ListDueScheduledRules lists enabled scheduled rules due to run at now
+func (r *AutomationRepository) ListDueScheduledRules(ctx context.Context, now time.Time) ([]*models.Rule, error) {
+	var rules []*models.Rule
+	err := r.db.NewSelect().
+		Model(&rules).
+		Where("enabled").
+		Where("trigger_event = ?", models.TriggerScheduled).
+		Where("next_run_at <= ?", now).
+		Order("next_run_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list due scheduled rules: %w", err)
+	}
+	return rules, nil
+}
+
+// ClaimScheduledRuleRun moves a scheduled rule to its next run. It reports
+// false if another instance claimed the run first.
+func (r *AutomationRepository) ClaimScheduledRuleRun(ctx context.Context, rule *models.Rule, next, now time.Time) (bool, error) {
+	res, err := r.db.NewUpdate().
+		Model((*models.Rule)(nil)).
+		Set("next_run_at = ?", next).
+		Set("last_run_at = ?", now).
+		Where("id = ?", rule.ID).
+		Where("next_run_at = ?", rule.NextRunAt).
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("claim scheduled rule run: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("claim scheduled rule run: %w", err)
+	}
+	return n == 1, nil
+}
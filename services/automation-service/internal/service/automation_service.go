@@ -0,0 +1,361 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nexusflow/nexusflow/pkg/kafka"
+	"github.com/nexusflow/nexusflow/pkg/logger"
+	commentpb "github.com/nexusflow/nexusflow/pkg/proto/comment/v1"
+	issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+	workflowpb "github.com/nexusflow/nexusflow/pkg/proto/workflow/v1"
+	"github.com/nexusflow/nexusflow/services/automation-service/internal/models"
+	"github.com/nexusflow/nexusflow/services/automation-service/internal/repository"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	// maxRuleActions caps the actions of a rule
+	maxRuleActions = 10
+	// minScheduleIntervalMinutes keeps scheduled rules below the loop limit
+	minScheduleIntervalMinutes = 5
+)
+
+// AutomationService handles automation rules and runs them on events
+type AutomationService struct {
+	repo           *repository.AutomationRepository
+	producer       *kafka.Producer
+	log            *logger.Logger
+	issueClient    issuepb.IssueServiceClient
+	workflowClient workflowpb.WorkflowServiceClient
+	commentClient  commentpb.CommentServiceClient
+	// actorID is the user ID changes made by rules are attributed to
+	actorID string
+}
+
+// NewAutomationService creates a new automation service
+func NewAutomationService(
+	repo *repository.AutomationRepository,
+	producer *kafka.Producer,
+	log *logger.Logger,
+	issueServiceAddr string,
+	workflowServiceAddr string,
+	commentServiceAddr string,
+	actorID string,
+) (*AutomationService, error) {
+	// Connect to issue service
+	issueConn, err := grpc.Dial(issueServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to issue service: %w", err)
+	}
+	// Connect to workflow service, for the status categories of SLAs
+	workflowConn, err := grpc.Dial(workflowServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to workflow service: %w", err)
+	}
+	// Connect to comment service
+	commentConn, err := grpc.Dial(commentServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to comment service: %w", err)
+	}
+
+	return &AutomationService{
+		repo:           repo,
+		producer:       producer,
+		log:            log,
+		issueClient:    issuepb.NewIssueServiceClient(issueConn),
+		workflowClient: workflowpb.NewWorkflowServiceClient(workflowConn),
+		commentClient:  commentpb.NewCommentServiceClient(commentConn),
+		actorID:        actorID,
+	}, nil
+}
+
+// CreateRule creates a rule
+func (s *AutomationService) CreateRule(ctx context.Context, rule *models.Rule) (*models.Rule, error) {
+	if rule.ProjectID == "" {
+		return nil, fmt.Errorf("%w: project_id is required", ErrValidation)
+	}
+	if err := validateRule(rule); err != nil {
+		return nil, err
+	}
+	if rule.TriggerEvent == models.TriggerScheduled {
+		rule.NextRunAt = time.Now()
+	}
+
+	if err := s.repo.CreateRule(ctx, rule); err != nil {
+		return nil, fmt.Errorf("failed to create rule: %w", err)
+	}
+
+	s.publishEvent("automation_rule.created", rule.ProjectID, rule.CreatedBy, rulePayload(rule))
+	return rule, nil
+}
+
+// GetRule gets a rule
+func (s *AutomationService) GetRule(ctx context.Context, id string) (*models.Rule, error) {
+	rule, err := s.repo.GetRule(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rule: %w", err)
+	}
+	if rule == nil {
+		return nil, fmt.Errorf("%w: rule %s", ErrNotFound, id)
+	}
+	return rule, nil
+}
+
+// UpdateRule replaces the contents of a rule; its project cannot change
+func (s *AutomationService) UpdateRule(ctx context.Context, update *models.Rule) (*models.Rule, error) {
+	rule, err := s.GetRule(ctx, update.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.Name = update.Name
+	rule.Description = update.Description
+	rule.TriggerEvent = update.TriggerEvent
+	rule.Conditions = update.Conditions
+	rule.Actions = update.Actions
+	rule.Enabled = update.Enabled
+	rule.DryRun = update.DryRun
+	rule.AllowAutomationTriggers = update.AllowAutomationTriggers
+	rule.Schedule = update.Schedule
+	if err := validateRule(rule); err != nil {
+		return nil, err
+	}
+	// Rules that become scheduled run on the next poll
+	if rule.TriggerEvent != models.TriggerScheduled {
+		rule.NextRunAt = time.Time{}
+	} else if rule.NextRunAt.IsZero() {
+		rule.NextRunAt = time.Now()
+	}
+
+	if err := s.repo.UpdateRule(ctx, rule); err != nil {
+		return nil, fmt.Errorf("failed to update rule: %w", err)
+	}
+
+	s.publishEvent("automation_rule.updated", rule.ProjectID, "system", rulePayload(rule))
+	return rule, nil
+}
+
+// DeleteRule deletes a rule and its audit log
+func (s *AutomationService) DeleteRule(ctx context.Context, id string) error {
+	rule, err := s.GetRule(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.DeleteRule(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete rule: %w", err)
+	}
+
+	s.publishEvent("automation_rule.deleted", rule.ProjectID, "system", map[string]interface{}{
+		"rule_id": rule.ID,
+	})
+	return nil
+}
+
+// ListRules lists the rules of a project
+func (s *AutomationService) ListRules(ctx context.Context, projectID string) ([]*models.Rule, error) {
+	if projectID == "" {
+		return nil, fmt.Errorf("%w: project_id is required", ErrValidation)
+	}
+	return s.repo.ListRules(ctx, projectID)
+}
+
+// ExecutionPage is a page of a rule's audit log
+type ExecutionPage struct {
+	Executions []*models.RuleExecution
+	Page       int
+	PageSize   int
+	Total      int
+}
+
+// ListRuleExecutions lists a page of a rule's audit log, newest first
+func (s *AutomationService) ListRuleExecutions(ctx context.Context, ruleID string, page, pageSize int) (*ExecutionPage, error) {
+	if _, err := s.GetRule(ctx, ruleID); err != nil {
+		return nil, err
+	}
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	executions, total, err := s.repo.ListExecutions(ctx, ruleID, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rule executions: %w", err)
+	}
+	return &ExecutionPage{Executions: executions, Page: page, PageSize: pageSize, Total: total}, nil
+}
+
+// validateRule checks a rule's trigger, conditions and actions
+func validateRule(rule *models.Rule) error {
+	rule.Name = strings.TrimSpace(rule.Name)
+	if rule.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrValidation)
+	}
+	rule.TriggerEvent = strings.TrimSpace(rule.TriggerEvent)
+	if rule.TriggerEvent == "" {
+		return fmt.Errorf("%w: trigger_event is required", ErrValidation)
+	}
+	if rule.TriggerEvent == models.TriggerScheduled {
+		if err := validateSchedule(rule.Schedule); err != nil {
+			return fmt.Errorf("%w: schedule: %v", ErrValidation, err)
+		}
+	} else {
+		rule.Schedule = nil
+	}
+
+	for i := range rule.Conditions {
+		if err := validateCondition(&rule.Conditions[i]); err != nil {
+			return fmt.Errorf("%w: condition %d: %v", ErrValidation, i+1, err)
+		}
+	}
+
+	if len(rule.Actions) == 0 {
+		return fmt.Errorf("%w: a rule needs at least one action", ErrValidation)
+	}
+	if len(rule.Actions) > maxRuleActions {
+		return fmt.Errorf("%w: a rule can have at most %d actions", ErrValidation, maxRuleActions)
+	}
+	for i := range rule.Actions {
+		if err := validateAction(&rule.Actions[i]); err != nil {
+			return fmt.Errorf("%w: action %d: %v", ErrValidation, i+1, err)
+		}
+	}
+	return nil
+}
+
+func validateSchedule(schedule *models.RuleSchedule) error {
+	if schedule == nil {
+		return fmt.Errorf("scheduled rules need a schedule")
+	}
+	if schedule.IntervalMinutes < minScheduleIntervalMinutes {
+		return fmt.Errorf("interval_minutes must be at least %d", minScheduleIntervalMinutes)
+	}
+	if schedule.StaleMinutes < 0 {
+		return fmt.Errorf("stale_minutes cannot be negative")
+	}
+	for _, t := range schedule.IssueTypes {
+		if issueTypeToProto(t) == issuepb.IssueType_ISSUE_TYPE_UNSPECIFIED {
+			return fmt.Errorf("invalid issue type %q", t)
+		}
+	}
+	for _, p := range schedule.Priorities {
+		if priorityToProto(p) == issuepb.IssuePriority_ISSUE_PRIORITY_UNSPECIFIED {
+			return fmt.Errorf("invalid priority %q", p)
+		}
+	}
+	return nil
+}
+
+func validateCondition(c *models.Condition) error {
+	switch c.Type {
+	case models.ConditionTypeField:
+		if !validFieldPath(c.Field) {
+			return fmt.Errorf("invalid field %q", c.Field)
+		}
+		switch c.Operator {
+		case models.OperatorEquals, models.OperatorNotEquals, models.OperatorContains:
+			if len(c.Values) != 1 {
+				return fmt.Errorf("%s needs exactly one value", c.Operator)
+			}
+		case models.OperatorIn, models.OperatorNotIn:
+			if len(c.Values) == 0 {
+				return fmt.Errorf("%s needs at least one value", c.Operator)
+			}
+		case models.OperatorIsEmpty, models.OperatorIsNotEmpty:
+			c.Values = nil
+		default:
+			return fmt.Errorf("invalid operator %q", c.Operator)
+		}
+	case models.ConditionTypeSubTasksInStatus:
+		if c.Target == "" {
+			c.Target = models.TargetIssue
+		}
+		if c.Target != models.TargetIssue && c.Target != models.TargetParent {
+			return fmt.Errorf("invalid target %q", c.Target)
+		}
+		if len(c.StatusIDs) == 0 {
+			return fmt.Errorf("status_ids is required")
+		}
+	default:
+		return fmt.Errorf("invalid condition type %q", c.Type)
+	}
+	return nil
+}
+
+func validateAction(a *models.Action) error {
+	if a.Target == "" {
+		a.Target = models.TargetIssue
+	}
+	if a.Target != models.TargetIssue && a.Target != models.TargetParent {
+		return fmt.Errorf("invalid target %q", a.Target)
+	}
+
+	switch a.Type {
+	case models.ActionTypeTransitionIssue:
+		if a.StatusID == "" {
+			return fmt.Errorf("status_id is required")
+		}
+	case models.ActionTypeUpdateIssue:
+		if a.AssigneeID == "" && a.Priority == "" {
+			return fmt.Errorf("assignee_id or priority is required")
+		}
+		if a.Priority != "" && priorityToProto(a.Priority) == issuepb.IssuePriority_ISSUE_PRIORITY_UNSPECIFIED {
+			return fmt.Errorf("invalid priority %q", a.Priority)
+		}
+	case models.ActionTypeNotify:
+		if len(a.UserIDs) == 0 {
+			return fmt.Errorf("user_ids is required")
+		}
+		if strings.TrimSpace(a.Title) == "" {
+			return fmt.Errorf("title is required")
+		}
+	case models.ActionTypeAddComment:
+		if strings.TrimSpace(a.Message) == "" {
+			return fmt.Errorf("message is required")
+		}
+	default:
+		return fmt.Errorf("invalid action type %q", a.Type)
+	}
+	return nil
+}
+
+// publishEvent publishes a Kafka event
+func (s *AutomationService) publishEvent(eventType, projectID, userID string, payload map[string]interface{}) {
+	s.publish("automation-events", eventType, projectID, userID, payload)
+}
+
+func (s *AutomationService) publish(topic, eventType, projectID, userID string, payload map[string]interface{}) {
+	if s.producer == nil {
+		return
+	}
+
+	payload["project_id"] = projectID
+	event := kafka.Event{
+		Type:      eventType,
+		ProjectID: projectID,
+		UserID:    userID,
+		Timestamp: time.Now(),
+		Payload:   payload,
+	}
+	if err := s.producer.PublishEvent(topic, event); err != nil {
+		s.log.Sugar().Errorw("Failed to publish event", "error", err, "type", eventType)
+	}
+}
+
+func rulePayload(rule *models.Rule) map[string]interface{} {
+	return map[string]interface{}{
+		"rule_id":       rule.ID,
+		"name":          rule.Name,
+		"trigger_event": rule.TriggerEvent,
+		"enabled":       rule.Enabled,
+		"dry_run":       rule.DryRun,
+	}
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/nexusflow/nexusflow/services/automation-service/internal/models"
+)
+
+// maxCalendarDays bounds the days a calendar is searched for working time,
+// so calendars made up of holidays cannot loop forever
+const maxCalendarDays = 3 * 366
+
+// workCalendar counts business time in a business calendar. The zero value
+// counts around the clock.
+type workCalendar struct {
+	loc      *time.Location
+	days     map[time.Weekday][]workPeriod
+	holidays map[string]bool
+}
+
+// workPeriod is working time within a day, in minutes from midnight
+type workPeriod struct {
+	start, end int
+}
+
+// newWorkCalendar prepares a business calendar for counting; nil gives a
+// calendar that counts around the clock
+func newWorkCalendar(calendar *models.BusinessCalendar) (*workCalendar, error) {
+	if calendar == nil {
+		return &workCalendar{}, nil
+	}
+
+	loc, err := time.LoadLocation(calendar.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q", calendar.Timezone)
+	}
+	wc := &workCalendar{
+		loc:      loc,
+		days:     make(map[time.Weekday][]workPeriod),
+		holidays: make(map[string]bool),
+	}
+	for _, wh := range calendar.WorkingHours {
+		if wh.Weekday < time.Sunday || wh.Weekday > time.Saturday {
+			return nil, fmt.Errorf("invalid weekday %d", wh.Weekday)
+		}
+		start, err := parseClock(wh.Start)
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseClock(wh.End)
+		if err != nil {
+			return nil, err
+		}
+		if end <= start {
+			return nil, fmt.Errorf("working hours %s-%s end before they start", wh.Start, wh.End)
+		}
+		wc.days[wh.Weekday] = append(wc.days[wh.Weekday], workPeriod{start: start, end: end})
+	}
+	if len(wc.days) == 0 {
+		return nil, fmt.Errorf("a calendar needs working hours")
+	}
+	for weekday, periods := range wc.days {
+		sort.Slice(periods, func(i, j int) bool { return periods[i].start < periods[j].start })
+		for i := 1; i < len(periods); i++ {
+			if periods[i].start < periods[i-1].end {
+				return nil, fmt.Errorf("working hours overlap on %s", weekday)
+			}
+		}
+	}
+	for _, day := range calendar.Holidays {
+		if _, err := time.Parse("2006-01-02", day); err != nil {
+			return nil, fmt.Errorf("invalid holiday %q", day)
+		}
+		wc.holidays[day] = true
+	}
+	return wc, nil
+}
+
+// parseClock parses "15:04" into minutes from midnight; "24:00" ends a day
+func parseClock(s string) (int, error) {
+	if s == "24:00" {
+		return 24 * 60, nil
+	}
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// periods returns the working periods of the day starting at midnight
+func (wc *workCalendar) periods(midnight time.Time) [][2]time.Time {
+	if wc.holidays[midnight.Format("2006-01-02")] {
+		return nil
+	}
+	var periods [][2]time.Time
+	for _, p := range wc.days[midnight.Weekday()] {
+		periods = append(periods, [2]time.Time{
+			time.Date(midnight.Year(), midnight.Month(), midnight.Day(), 0, p.start, 0, 0, wc.loc),
+			time.Date(midnight.Year(), midnight.Month(), midnight.Day(), 0, p.end, 0, 0, wc.loc),
+		})
+	}
+	return periods
+}
+
+// between returns the business time from one time to another
+func (wc *workCalendar) between(from, to time.Time) time.Duration {
+	if !to.After(from) {
+		return 0
+	}
+	if wc.loc == nil {
+		return to.Sub(from)
+	}
+
+	var total time.Duration
+	from = from.In(wc.loc)
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, wc.loc)
+	for i := 0; i < maxCalendarDays && day.Before(to); i++ {
+		for _, p := range wc.periods(day) {
+			start, end := p[0], p[1]
+			if start.Before(from) {
+				start = from
+			}
+			if end.After(to) {
+				end = to
+			}
+			if end.After(start) {
+				total += end.Sub(start)
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return total
+}
+
+// add returns the time when a business duration starting at from has
+// passed, or the zero time if the calendar has no working time left
+func (wc *workCalendar) add(from time.Time, d time.Duration) time.Time {
+	if wc.loc == nil {
+		return from.Add(d)
+	}
+
+	from = from.In(wc.loc)
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, wc.loc)
+	for i := 0; i < maxCalendarDays; i++ {
+		for _, p := range wc.periods(day) {
+			start, end := p[0], p[1]
+			if start.Before(from) {
+				start = from
+			}
+			if !end.After(start) {
+				continue
+			}
+			available := end.Sub(start)
+			if d <= available {
+				return start.Add(d)
+			}
+			d -= available
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return time.Time{}
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nexusflow/nexusflow/services/automation-service/internal/models"
+)
+
+// officeCalendar works 09:00-12:00 and 13:00-17:00 on weekdays in Berlin,
+// with Christmas Day off
+func officeCalendar(t *testing.T) *workCalendar {
+	t.Helper()
+	calendar := &models.BusinessCalendar{
+		Name:     "Office",
+		Timezone: "Europe/Berlin",
+		Holidays: []string{"2026-12-25"},
+	}
+	for day := time.Monday; day <= time.Friday; day++ {
+		calendar.WorkingHours = append(calendar.WorkingHours,
+			models.WorkingHours{Weekday: day, Start: "13:00", End: "17:00"},
+			models.WorkingHours{Weekday: day, Start: "09:00", End: "12:00"},
+		)
+	}
+	wc, err := newWorkCalendar(calendar)
+	if err != nil {
+		t.Fatalf("newWorkCalendar() error = %v", err)
+	}
+	return wc
+}
+
+// allDayCalendar works around the clock every day in Berlin
+func allDayCalendar(t *testing.T) *workCalendar {
+	t.Helper()
+	calendar := &models.BusinessCalendar{Name: "24/7", Timezone: "Europe/Berlin"}
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		calendar.WorkingHours = append(calendar.WorkingHours, models.WorkingHours{Weekday: day, Start: "00:00", End: "24:00"})
+	}
+	wc, err := newWorkCalendar(calendar)
+	if err != nil {
+		t.Fatalf("newWorkCalendar() error = %v", err)
+	}
+	return wc
+}
+
+func berlin(t *testing.T, value string) time.Time {
+	t.Helper()
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+	at, err := time.ParseInLocation("2006-01-02 15:04", value, loc)
+	if err != nil {
+		t.Fatalf("ParseInLocation(%q) error = %v", value, err)
+	}
+	return at
+}
+
+func TestWorkCalendar_Between(t *testing.T) {
+	office, allDay := officeCalendar(t), allDayCalendar(t)
+	tests := []struct {
+		name     string
+		wc       *workCalendar
+		from, to string
+		want     time.Duration
+	}{
+		{"Within a morning", office, "2026-10-19 10:00", "2026-10-19 11:30", 90 * time.Minute},
+		{"Across lunch", office, "2026-10-19 11:00", "2026-10-19 14:00", 2 * time.Hour},
+		{"Whole day", office, "2026-10-19 07:00", "2026-10-19 19:00", 7 * time.Hour},
+		{"Outside hours", office, "2026-10-19 18:00", "2026-10-19 23:00", 0},
+		{"Over a weekend", office, "2026-10-23 16:00", "2026-10-26 10:00", 2 * time.Hour},
+		{"Over a holiday", office, "2026-12-24 16:00", "2026-12-28 10:00", 2 * time.Hour},
+		{"Whole week", office, "2026-10-19 00:00", "2026-10-26 00:00", 35 * time.Hour},
+		{"Backwards", office, "2026-10-19 11:00", "2026-10-19 10:00", 0},
+		{"Day clocks go forward", allDay, "2026-03-29 00:00", "2026-03-30 00:00", 23 * time.Hour},
+		{"Day clocks go back", allDay, "2026-10-25 00:00", "2026-10-26 00:00", 25 * time.Hour},
+		{"Over both weekends", allDay, "2026-03-28 00:00", "2026-10-26 00:00", 212 * 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.wc.between(berlin(t, tt.from), berlin(t, tt.to)); got != tt.want {
+				t.Errorf("between() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorkCalendar_BetweenOtherZone(t *testing.T) {
+	// 07:30-08:30 UTC is 09:30-10:30 in Berlin summer time
+	from := time.Date(2026, 10, 19, 7, 30, 0, 0, time.UTC)
+	if got := officeCalendar(t).between(from, from.Add(time.Hour)); got != time.Hour {
+		t.Errorf("between() = %v, want %v", got, time.Hour)
+	}
+}
+
+func TestWorkCalendar_Add(t *testing.T) {
+	office, allDay := officeCalendar(t), allDayCalendar(t)
+	tests := []struct {
+		name string
+		wc   *workCalendar
+		from string
+		d    time.Duration
+		want string
+	}{
+		{"Within a morning", office, "2026-10-19 10:00", time.Hour, "2026-10-19 11:00"},
+		{"Across lunch", office, "2026-10-19 11:30", time.Hour, "2026-10-19 13:30"},
+		{"Before hours", office, "2026-10-19 07:00", 30 * time.Minute, "2026-10-19 09:30"},
+		{"Ends with the day", office, "2026-10-19 16:00", time.Hour, "2026-10-19 17:00"},
+		{"Over a weekend", office, "2026-10-23 16:00", 2 * time.Hour, "2026-10-26 10:00"},
+		{"Over a holiday", office, "2026-12-24 16:00", 2 * time.Hour, "2026-12-28 10:00"},
+		{"Nothing from a weekend", office, "2026-10-24 12:00", 0, "2026-10-26 09:00"},
+		{"Day clocks go forward", allDay, "2026-03-28 12:00", 24 * time.Hour, "2026-03-29 13:00"},
+		{"Day clocks go back", allDay, "2026-10-24 12:00", 24 * time.Hour, "2026-10-25 11:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := berlin(t, tt.want)
+			if got := tt.wc.add(berlin(t, tt.from), tt.d); !got.Equal(want) {
+				t.Errorf("add() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestWorkCalendar_AroundTheClock(t *testing.T) {
+	wc, err := newWorkCalendar(nil)
+	if err != nil {
+		t.Fatalf("newWorkCalendar() error = %v", err)
+	}
+	from := time.Date(2026, 10, 24, 12, 0, 0, 0, time.UTC)
+	if got := wc.between(from, from.Add(30*time.Hour)); got != 30*time.Hour {
+		t.Errorf("between() = %v, want %v", got, 30*time.Hour)
+	}
+	if got := wc.add(from, 30*time.Hour); !got.Equal(from.Add(30 * time.Hour)) {
+		t.Errorf("add() = %v, want %v", got, from.Add(30*time.Hour))
+	}
+}
+
+func TestWorkCalendar_NoWorkingTimeLeft(t *testing.T) {
+	// Mondays only, with every Monday for years a holiday
+	calendar := &models.BusinessCalendar{
+		Timezone:     "UTC",
+		WorkingHours: []models.WorkingHours{{Weekday: time.Monday, Start: "09:00", End: "17:00"}},
+	}
+	for day := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC); day.Year() < 2031; day = day.AddDate(0, 0, 7) {
+		calendar.Holidays = append(calendar.Holidays, day.Format("2006-01-02"))
+	}
+	wc, err := newWorkCalendar(calendar)
+	if err != nil {
+		t.Fatalf("newWorkCalendar() error = %v", err)
+	}
+	if got := wc.add(time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC), time.Hour); !got.IsZero() {
+		t.Errorf("add() = %v, want the zero time", got)
+	}
+}
+
+func TestNewWorkCalendar_Invalid(t *testing.T) {
+	monday := func(start, end string) []models.WorkingHours {
+		return []models.WorkingHours{{Weekday: time.Monday, Start: start, End: end}}
+	}
+	tests := []struct {
+		name     string
+		calendar *models.BusinessCalendar
+	}{
+		{"Unknown timezone", &models.BusinessCalendar{Timezone: "Mars/Olympus", WorkingHours: monday("09:00", "17:00")}},
+		{"No working hours", &models.BusinessCalendar{Timezone: "UTC"}},
+		{"Invalid weekday", &models.BusinessCalendar{Timezone: "UTC", WorkingHours: []models.WorkingHours{{Weekday: 7, Start: "09:00", End: "17:00"}}}},
+		{"Invalid time", &models.BusinessCalendar{Timezone: "UTC", WorkingHours: monday("9am", "17:00")}},
+		{"Ends before it starts", &models.BusinessCalendar{Timezone: "UTC", WorkingHours: monday("17:00", "09:00")}},
+		{"Overlapping hours", &models.BusinessCalendar{Timezone: "UTC", WorkingHours: append(monday("09:00", "13:00"), monday("12:00", "17:00")...)}},
+		{"Invalid holiday", &models.BusinessCalendar{Timezone: "UTC", WorkingHours: monday("09:00", "17:00"), Holidays: []string{"25/12/2026"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := newWorkCalendar(tt.calendar); err == nil {
+				t.Error("newWorkCalendar() error = nil, want an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,461 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nexusflow/nexusflow/pkg/kafka"
+	commentpb "github.com/nexusflow/nexusflow/pkg/proto/comment/v1"
+	issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+	workflowpb "github.com/nexusflow/nexusflow/pkg/proto/workflow/v1"
+	"github.com/nexusflow/nexusflow/services/automation-service/internal/models"
+)
+
+const (
+	// maxRuleExecutionsPerIssue and ruleLoopWindow cut off rules that keep
+	// triggering each other on the same issue
+	maxRuleExecutionsPerIssue = 10
+	ruleLoopWindow            = 5 * time.Minute
+)
+
+// HandleEvent tracks the SLAs of issues and runs the rules triggered by an
+// event consumed from Kafka. Changes made by rules only trigger rules that
+// allow it, so rules do not loop by default.
+func (s *AutomationService) HandleEvent(ctx context.Context, event kafka.Event) error {
+	if event.Type == "" {
+		return nil
+	}
+	ec := newEventContext(event, s.issueClient)
+	if err := s.trackSLAs(ctx, ec); err != nil {
+		s.log.Sugar().Errorw("Failed to track SLAs", "error", err, "event_id", event.ID)
+	}
+
+	projectID, err := ec.projectID(ctx)
+	if err != nil {
+		s.log.Sugar().Errorw("Failed to find the project of an event", "error", err, "event_id", event.ID)
+		return err
+	}
+	if projectID == "" {
+		return nil
+	}
+	rules, err := s.repo.ListTriggeredRules(ctx, projectID, event.Type)
+	if err != nil {
+		s.log.Sugar().Errorw("Failed to list triggered rules", "error", err, "type", event.Type)
+		return err
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+	ruleIDs := make([]string, len(rules))
+	for i, rule := range rules {
+		ruleIDs[i] = rule.ID
+	}
+	// Redelivered events do not run rules again
+	done, err := s.repo.ListRulesRun(ctx, event.ID, ruleIDs)
+	if err != nil {
+		s.log.Sugar().Errorw("Failed to list rules run", "error", err, "event_id", event.ID)
+		return err
+	}
+
+	// Comment events carry their author in the payload only
+	actorID := event.UserID
+	if actorID == "" {
+		actorID, _ = event.Payload["author_id"].(string)
+	}
+	fromAutomation := actorID == s.actorID
+	for _, rule := range rules {
+		if done[rule.ID] || (fromAutomation && !rule.AllowAutomationTriggers) {
+			continue
+		}
+		if err := s.runRule(ctx, rule, ec); err != nil {
+			s.log.Sugar().Errorw("Failed to run rule", "error", err, "rule_id", rule.ID, "event_id", event.ID)
+		}
+	}
+	return nil
+}
+
+// runRule evaluates a rule on an event of its project that it has not run
+// for, and takes its actions, or plans them for dry-run rules. Every run
+// whose conditions match is recorded in the rule's audit log, as are runs
+// that fail or are cut off as a loop.
+func (s *AutomationService) runRule(ctx context.Context, rule *models.Rule, ec *eventContext) error {
+	execution := &models.RuleExecution{
+		RuleID:    rule.ID,
+		ProjectID: rule.ProjectID,
+		EventID:   ec.event.ID,
+		EventType: ec.event.Type,
+	}
+
+	issue, err := ec.loadIssue(ctx)
+	if err != nil {
+		return s.recordFailure(ctx, execution, err)
+	}
+	if issue != nil {
+		execution.IssueID = issue.Id
+	}
+
+	matched, err := s.evaluateConditions(ctx, rule, ec)
+	if err != nil {
+		return s.recordFailure(ctx, execution, err)
+	}
+	if !matched {
+		return nil
+	}
+
+	if issue != nil {
+		count, err := s.repo.CountRecentExecutions(ctx, rule.ID, issue.Id, time.Now().Add(-ruleLoopWindow))
+		if err != nil {
+			return err
+		}
+		if count >= maxRuleExecutionsPerIssue {
+			execution.Status = models.ExecutionStatusLoopDetected
+			execution.Error = fmt.Sprintf("rule ran %d times on the issue within %s", count, ruleLoopWindow)
+			return s.recordExecution(ctx, execution)
+		}
+	}
+
+	execution.Status = models.ExecutionStatusSuccess
+	if rule.DryRun {
+		execution.Status = models.ExecutionStatusDryRun
+	}
+	for _, action := range rule.Actions {
+		result := s.runAction(ctx, rule, action, ec)
+		if result.Status == models.ActionStatusFailed {
+			execution.Status = models.ExecutionStatusFailed
+		}
+		execution.Actions = append(execution.Actions, result)
+	}
+	return s.recordExecution(ctx, execution)
+}
+
+func (s *AutomationService) recordFailure(ctx context.Context, execution *models.RuleExecution, err error) error {
+	execution.Status = models.ExecutionStatusFailed
+	execution.Error = err.Error()
+	return s.recordExecution(ctx, execution)
+}
+
+func (s *AutomationService) recordExecution(ctx context.Context, execution *models.RuleExecution) error {
+	created, err := s.repo.CreateExecution(ctx, execution)
+	if err != nil || !created {
+		return err
+	}
+
+	s.publishEvent("automation_rule.executed", execution.ProjectID, s.actorID, map[string]interface{}{
+		"rule_id":      execution.RuleID,
+		"execution_id": execution.ID,
+		"event_id":     execution.EventID,
+		"issue_id":     execution.IssueID,
+		"status":       string(execution.Status),
+	})
+	return nil
+}
+
+// evaluateConditions reports whether an event matches all of a rule's conditions
+func (s *AutomationService) evaluateConditions(ctx context.Context, rule *models.Rule, ec *eventContext) (bool, error) {
+	for _, c := range rule.Conditions {
+		var matched bool
+		var err error
+		switch c.Type {
+		case models.ConditionTypeField:
+			matched, err = evaluateFieldCondition(ctx, c, ec)
+		case models.ConditionTypeSubTasksInStatus:
+			matched, err = s.evaluateSubTasksCondition(ctx, c, ec)
+		}
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func evaluateFieldCondition(ctx context.Context, c models.Condition, ec *eventContext) (bool, error) {
+	value, err := ec.lookup(ctx, c.Field)
+	if err != nil {
+		return false, err
+	}
+	values := valueStrings(value)
+
+	switch c.Operator {
+	case models.OperatorEquals:
+		return len(values) == 1 && values[0] == c.Values[0], nil
+	case models.OperatorNotEquals:
+		return !(len(values) == 1 && values[0] == c.Values[0]), nil
+	case models.OperatorIn:
+		return len(values) == 1 && contains(c.Values, values[0]), nil
+	case models.OperatorNotIn:
+		return !(len(values) == 1 && contains(c.Values, values[0])), nil
+	case models.OperatorContains:
+		if isList(value) {
+			return contains(values, c.Values[0]), nil
+		}
+		return len(values) == 1 && strings.Contains(values[0], c.Values[0]), nil
+	case models.OperatorIsEmpty:
+		return len(values) == 0, nil
+	case models.OperatorIsNotEmpty:
+		return len(values) > 0, nil
+	}
+	return false, nil
+}
+
+// evaluateSubTasksCondition reports whether the target issue has sub-tasks
+// and every one of them is in one of the condition's statuses
+func (s *AutomationService) evaluateSubTasksCondition(ctx context.Context, c models.Condition, ec *eventContext) (bool, error) {
+	issue, err := ec.target(ctx, c.Target == models.TargetParent)
+	if err != nil || issue == nil {
+		return false, err
+	}
+
+	resp, err := s.issueClient.GetIssueChildren(ctx, &issuepb.GetIssueChildrenRequest{Id: issue.Id})
+	if err != nil {
+		return false, fmt.Errorf("failed to get sub-tasks: %w", err)
+	}
+	if len(resp.Children) == 0 {
+		return false, nil
+	}
+	for _, child := range resp.Children {
+		if !contains(c.StatusIDs, child.StatusId) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// runAction takes one action of a rule, or only works out its outcome for
+// dry-run rules
+func (s *AutomationService) runAction(ctx context.Context, rule *models.Rule, action models.Action, ec *eventContext) models.ActionResult {
+	result := models.ActionResult{Type: action.Type}
+	fail := func(err error) models.ActionResult {
+		result.Status = models.ActionStatusFailed
+		result.Error = err.Error()
+		return result
+	}
+
+	if action.Type == models.ActionTypeNotify {
+		return s.notify(ctx, rule, action, ec)
+	}
+	if action.Type == models.ActionTypeAddComment {
+		return s.addComment(ctx, rule, action, ec)
+	}
+
+	issue, err := ec.target(ctx, action.Target == models.TargetParent)
+	if err != nil {
+		return fail(err)
+	}
+	if issue == nil {
+		return fail(fmt.Errorf("event has no %s issue", action.Target))
+	}
+	result.IssueID = issue.Id
+
+	if action.Type == models.ActionTypeTransitionIssue {
+		return s.transitionIssue(ctx, rule, action, ec, issue, result)
+	}
+
+	req := &issuepb.UpdateIssueRequest{Id: issue.Id, UserId: s.actorID}
+	changed := false
+	switch action.Type {
+	case models.ActionTypeUpdateIssue:
+		if action.AssigneeID != "" {
+			assigneeID, err := ec.expand(ctx, action.AssigneeID)
+			if err != nil {
+				return fail(err)
+			}
+			if assigneeID != "" && assigneeID != issue.AssigneeId {
+				req.AssigneeId = &assigneeID
+				changed = true
+			}
+		}
+		if action.Priority != "" {
+			priority := priorityToProto(action.Priority)
+			if priority != issue.Priority {
+				req.Priority = &priority
+				changed = true
+			}
+		}
+	}
+
+	switch {
+	case !changed:
+		result.Status = models.ActionStatusUnchanged
+	case rule.DryRun:
+		result.Status = models.ActionStatusPlanned
+	default:
+		resp, err := s.issueClient.UpdateIssue(ctx, req)
+		if err != nil {
+			return fail(fmt.Errorf("failed to update issue: %w", err))
+		}
+		// Later actions and rules see the updated issue
+		if action.Target == models.TargetParent {
+			ec.parent = resp.Issue
+		} else {
+			ec.issue = resp.Issue
+		}
+		result.Status = models.ActionStatusDone
+	}
+	return result
+}
+
+// transitionIssue moves the issue into the action's status through a
+// workflow transition, so the transition's conditions and validators apply
+// to the rule as they would to a user
+func (s *AutomationService) transitionIssue(ctx context.Context, rule *models.Rule, action models.Action, ec *eventContext, issue *issuepb.Issue, result models.ActionResult) models.ActionResult {
+	fail := func(err error) models.ActionResult {
+		result.Status = models.ActionStatusFailed
+		result.Error = err.Error()
+		return result
+	}
+	if issue.StatusId == action.StatusID {
+		result.Status = models.ActionStatusUnchanged
+		return result
+	}
+
+	transResp, err := s.workflowClient.GetAvailableTransitions(ctx, &workflowpb.GetAvailableTransitionsRequest{
+		IssueId: issue.Id,
+		UserId:  s.actorID,
+	})
+	if err != nil {
+		return fail(fmt.Errorf("failed to get available transitions: %w", err))
+	}
+	var transition *workflowpb.Transition
+	for _, t := range transResp.Transitions {
+		if t.ToStatusId == action.StatusID {
+			transition = t
+			break
+		}
+	}
+	if transition == nil {
+		return fail(fmt.Errorf("workflow has no transition from status %s to %s", issue.StatusId, action.StatusID))
+	}
+	if rule.DryRun {
+		result.Status = models.ActionStatusPlanned
+		return result
+	}
+
+	_, err = s.workflowClient.ExecuteTransition(ctx, &workflowpb.ExecuteTransitionRequest{
+		IssueId:      issue.Id,
+		TransitionId: transition.Id,
+		UserId:       s.actorID,
+	})
+	if err != nil {
+		return fail(fmt.Errorf("failed to execute transition: %w", err))
+	}
+	result.Status = models.ActionStatusDone
+
+	// Later actions and rules see the transitioned issue
+	resp, err := s.issueClient.GetIssue(ctx, &issuepb.GetIssueRequest{Id: issue.Id})
+	if err != nil {
+		s.log.Sugar().Warnw("Failed to reload transitioned issue", "error", err, "issue_id", issue.Id)
+		return result
+	}
+	if action.Target == models.TargetParent {
+		ec.parent = resp.Issue
+	} else {
+		ec.issue = resp.Issue
+	}
+	return result
+}
+
+// notify asks the notification service to notify the action's users
+func (s *AutomationService) notify(ctx context.Context, rule *models.Rule, action models.Action, ec *eventContext) models.ActionResult {
+	result := models.ActionResult{Type: action.Type}
+
+	var userIDs []string
+	seen := make(map[string]bool)
+	for _, v := range action.UserIDs {
+		userID, err := ec.expand(ctx, v)
+		if err != nil {
+			result.Status = models.ActionStatusFailed
+			result.Error = err.Error()
+			return result
+		}
+		if userID != "" && !seen[userID] {
+			seen[userID] = true
+			userIDs = append(userIDs, userID)
+		}
+	}
+	title, err := ec.expand(ctx, action.Title)
+	if err == nil {
+		action.Message, err = ec.expand(ctx, action.Message)
+	}
+	if err != nil {
+		result.Status = models.ActionStatusFailed
+		result.Error = err.Error()
+		return result
+	}
+
+	issue, _ := ec.loadIssue(ctx)
+	if issue != nil {
+		result.IssueID = issue.Id
+	}
+	if len(userIDs) == 0 {
+		result.Status = models.ActionStatusUnchanged
+		return result
+	}
+	if rule.DryRun {
+		result.Status = models.ActionStatusPlanned
+		return result
+	}
+
+	s.publishEvent("automation.notify", rule.ProjectID, s.actorID, map[string]interface{}{
+		"rule_id":  rule.ID,
+		"issue_id": result.IssueID,
+		"user_ids": userIDs,
+		"title":    title,
+		"message":  action.Message,
+	})
+	result.Status = models.ActionStatusDone
+	return result
+}
+
+// addComment comments on the target issue as the automation user
+func (s *AutomationService) addComment(ctx context.Context, rule *models.Rule, action models.Action, ec *eventContext) models.ActionResult {
+	result := models.ActionResult{Type: action.Type}
+	fail := func(err error) models.ActionResult {
+		result.Status = models.ActionStatusFailed
+		result.Error = err.Error()
+		return result
+	}
+
+	issue, err := ec.target(ctx, action.Target == models.TargetParent)
+	if err != nil {
+		return fail(err)
+	}
+	if issue == nil {
+		return fail(fmt.Errorf("event has no %s issue", action.Target))
+	}
+	result.IssueID = issue.Id
+
+	content, err := ec.expand(ctx, action.Message)
+	if err != nil {
+		return fail(err)
+	}
+	if strings.TrimSpace(content) == "" {
+		result.Status = models.ActionStatusUnchanged
+		return result
+	}
+	if rule.DryRun {
+		result.Status = models.ActionStatusPlanned
+		return result
+	}
+
+	_, err = s.commentClient.CreateComment(ctx, &commentpb.CreateCommentRequest{
+		IssueId:  issue.Id,
+		AuthorId: s.actorID,
+		Content:  content,
+	})
+	if err != nil {
+		return fail(fmt.Errorf("failed to add comment: %w", err))
+	}
+	result.Status = models.ActionStatusDone
+	return result
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/nexusflow/nexusflow/pkg/kafka"
+	issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// placeholderPattern matches {{path}} placeholders in action text
+var placeholderPattern = regexp.MustCompile(`\{\{\s*([a-z_]+(?:\.[a-z_]+)*)\s*\}\}`)
+
+// eventContext holds an event while its rules run. The issue of the event and
+// its parent are loaded from the issue service on first use and shared by
+// every rule.
+type eventContext struct {
+	event  kafka.Event
+	client issuepb.IssueServiceClient
+
+	issue        *issuepb.Issue
+	issueLoaded  bool
+	parent       *issuepb.Issue
+	parentLoaded bool
+}
+
+func newEventContext(event kafka.Event, client issuepb.IssueServiceClient) *eventContext {
+	return &eventContext{event: event, client: client}
+}
+
+// loadIssue returns the issue of the event, found by the issue_id or
+// issue_key of its payload, or nil if it has none
+func (ec *eventContext) loadIssue(ctx context.Context) (*issuepb.Issue, error) {
+	if ec.issueLoaded {
+		return ec.issue, nil
+	}
+
+	var err error
+	if id, _ := ec.event.Payload["issue_id"].(string); id != "" {
+		var resp *issuepb.GetIssueResponse
+		if resp, err = ec.client.GetIssue(ctx, &issuepb.GetIssueRequest{Id: id}); err == nil {
+			ec.issue = resp.Issue
+		}
+	} else if key, _ := ec.event.Payload["issue_key"].(string); key != "" {
+		var resp *issuepb.GetIssueByKeyResponse
+		if resp, err = ec.client.GetIssueByKey(ctx, &issuepb.GetIssueByKeyRequest{Key: key}); err == nil {
+			ec.issue = resp.Issue
+		}
+	}
+	if err != nil && status.Code(err) != codes.NotFound {
+		return nil, fmt.Errorf("failed to load issue: %w", err)
+	}
+
+	ec.issueLoaded = true
+	return ec.issue, nil
+}
+
+// loadParent returns the parent of the event's issue, or nil if it has none
+func (ec *eventContext) loadParent(ctx context.Context) (*issuepb.Issue, error) {
+	if ec.parentLoaded {
+		return ec.parent, nil
+	}
+
+	issue, err := ec.loadIssue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if issue != nil && issue.ParentId != "" {
+		resp, err := ec.client.GetIssue(ctx, &issuepb.GetIssueRequest{Id: issue.ParentId})
+		if err != nil && status.Code(err) != codes.NotFound {
+			return nil, fmt.Errorf("failed to load parent issue: %w", err)
+		}
+		if err == nil {
+			ec.parent = resp.Issue
+		}
+	}
+
+	ec.parentLoaded = true
+	return ec.parent, nil
+}
+
+// target returns the issue a condition or action applies to
+func (ec *eventContext) target(ctx context.Context, parent bool) (*issuepb.Issue, error) {
+	if parent {
+		return ec.loadParent(ctx)
+	}
+	return ec.loadIssue(ctx)
+}
+
+// projectID returns the project of the event. Events that do not carry one,
+// such as comment events, take the project of their issue.
+func (ec *eventContext) projectID(ctx context.Context) (string, error) {
+	if projectID, _ := ec.event.Payload["project_id"].(string); projectID != "" {
+		return projectID, nil
+	}
+	if ec.event.ProjectID != "" {
+		return ec.event.ProjectID, nil
+	}
+	issue, err := ec.loadIssue(ctx)
+	if err != nil || issue == nil {
+		return "", err
+	}
+	return issue.ProjectId, nil
+}
+
+// validFieldPath reports whether a condition field can be looked up
+func validFieldPath(path string) bool {
+	scope, name, ok := strings.Cut(path, ".")
+	if !ok || name == "" {
+		return false
+	}
+	switch scope {
+	case "event":
+		switch name {
+		case "id", "type", "user_id", "project_id":
+			return true
+		}
+		return false
+	case "payload", "issue", "parent":
+		return true
+	}
+	return false
+}
+
+// lookup returns the value at a field path: "event.<field>",
+// "payload.<key>[.<key>]", "issue.<field>" or "parent.<field>". A missing
+// value is nil.
+func (ec *eventContext) lookup(ctx context.Context, path string) (interface{}, error) {
+	scope, name, _ := strings.Cut(path, ".")
+	switch scope {
+	case "event":
+		switch name {
+		case "id":
+			return ec.event.ID, nil
+		case "type":
+			return ec.event.Type, nil
+		case "user_id":
+			return ec.event.UserID, nil
+		case "project_id":
+			return ec.projectID(ctx)
+		}
+	case "payload":
+		var value interface{} = ec.event.Payload
+		for _, key := range strings.Split(name, ".") {
+			m, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, nil
+			}
+			value = m[key]
+		}
+		return value, nil
+	case "issue", "parent":
+		issue, err := ec.target(ctx, scope == "parent")
+		if err != nil || issue == nil {
+			return nil, err
+		}
+		return issueFields(issue)[name], nil
+	}
+	return nil, nil
+}
+
+// expand replaces the {{path}} placeholders of a text with their values
+func (ec *eventContext) expand(ctx context.Context, text string) (string, error) {
+	var lookupErr error
+	expanded := placeholderPattern.ReplaceAllStringFunc(text, func(match string) string {
+		path := placeholderPattern.FindStringSubmatch(match)[1]
+		value, err := ec.lookup(ctx, path)
+		if err != nil {
+			lookupErr = err
+			return ""
+		}
+		return strings.Join(valueStrings(value), ", ")
+	})
+	return expanded, lookupErr
+}
+
+// issueFields exposes the fields of an issue to conditions and placeholders,
+// with enums in the form the issue service uses in its events
+func issueFields(issue *issuepb.Issue) map[string]interface{} {
+	return map[string]interface{}{
+		"id":            issue.Id,
+		"key":           issue.Key,
+		"project_id":    issue.ProjectId,
+		"summary":       issue.Summary,
+		"type":          issueTypeName(issue.Type),
+		"priority":      priorityName(issue.Priority),
+		"status_id":     issue.StatusId,
+		"assignee_id":   issue.AssigneeId,
+		"reporter_id":   issue.ReporterId,
+		"parent_id":     issue.ParentId,
+		"sprint_id":     issue.SprintId,
+		"label_ids":     issue.LabelIds,
+		"component_ids": issue.ComponentIds,
+		"story_points":  issue.StoryPoints,
+	}
+}
+
+func issueTypeName(t issuepb.IssueType) string {
+	if t == issuepb.IssueType_ISSUE_TYPE_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(t.String(), "ISSUE_TYPE_"))
+}
+
+func priorityName(p issuepb.IssuePriority) string {
+	if p == issuepb.IssuePriority_ISSUE_PRIORITY_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(p.String(), "ISSUE_PRIORITY_"))
+}
+
+func issueTypeToProto(name string) issuepb.IssueType {
+	return issuepb.IssueType(issuepb.IssueType_value["ISSUE_TYPE_"+strings.ToUpper(name)])
+}
+
+func priorityToProto(name string) issuepb.IssuePriority {
+	return issuepb.IssuePriority(issuepb.IssuePriority_value["ISSUE_PRIORITY_"+strings.ToUpper(name)])
+}
+
+// valueStrings flattens a looked up value to strings for comparison. Lists
+// give one string per element; nil and empty strings give none.
+func valueStrings(value interface{}) []string {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		if v == "" {
+			return nil
+		}
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		var values []string
+		for _, e := range v {
+			values = append(values, valueStrings(e)...)
+		}
+		return values
+	case float64:
+		return []string{strconv.FormatFloat(v, 'f', -1, 64)}
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
+
+func isList(value interface{}) bool {
+	switch value.(type) {
+	case []string, []interface{}:
+		return true
+	}
+	return false
+}
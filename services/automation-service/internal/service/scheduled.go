@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nexusflow/nexusflow/pkg/kafka"
+	commonpb "github.com/nexusflow/nexusflow/pkg/proto/common/v1"
+	issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+	"github.com/nexusflow/nexusflow/services/automation-service/internal/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxScheduledRuleIssues caps the issues one run of a scheduled rule checks
+const maxScheduledRuleIssues = 1000
+
+// RunScheduler runs due scheduled rules every interval until ctx is done
+func (s *AutomationService) RunScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := s.RunDueScheduledRules(ctx, time.Now())
+		if err != nil {
+			s.log.Sugar().Errorw("Failed to run scheduled rules", "error", err)
+		} else if n > 0 {
+			s.log.Sugar().Infow("Ran scheduled rules", "count", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunDueScheduledRules runs every scheduled rule that is due at now and
+// returns the number of rules run
+func (s *AutomationService) RunDueScheduledRules(ctx context.Context, now time.Time) (int, error) {
+	rules, err := s.repo.ListDueScheduledRules(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list due scheduled rules: %w", err)
+	}
+
+	ran := 0
+	for _, rule := range rules {
+		// The run is claimed first, so several instances never run it twice
+		next := now.Add(time.Duration(rule.Schedule.IntervalMinutes) * time.Minute)
+		claimed, err := s.repo.ClaimScheduledRuleRun(ctx, rule, next, now)
+		if err != nil {
+			return ran, err
+		}
+		if !claimed {
+			continue
+		}
+		if err := s.runScheduledRule(ctx, rule, now); err != nil {
+			s.log.Sugar().Errorw("Failed to run scheduled rule", "error", err, "rule_id", rule.ID)
+		}
+		ran++
+	}
+	return ran, nil
+}
+
+// runScheduledRule runs a rule on each issue its schedule selects, as if
+// every issue had an event of its own
+func (s *AutomationService) runScheduledRule(ctx context.Context, rule *models.Rule, now time.Time) error {
+	issues, err := s.scheduledIssues(ctx, rule, now)
+	if err != nil {
+		return err
+	}
+
+	for _, issue := range issues {
+		event := kafka.Event{
+			ID:        fmt.Sprintf("scheduled:%s:%d:%s", rule.ID, now.Unix(), issue.Id),
+			Type:      models.TriggerScheduled,
+			ProjectID: rule.ProjectID,
+			Timestamp: now,
+			Payload: map[string]interface{}{
+				"issue_id":   issue.Id,
+				"project_id": rule.ProjectID,
+				"rule_id":    rule.ID,
+			},
+		}
+		ec := newEventContext(event, s.issueClient)
+		ec.issue, ec.issueLoaded = issue, true
+		if err := s.runRule(ctx, rule, ec); err != nil {
+			s.log.Sugar().Errorw("Failed to run rule", "error", err, "rule_id", rule.ID, "issue_id", issue.Id)
+		}
+	}
+	return nil
+}
+
+// scheduledIssues lists the issues of a rule's project that its schedule
+// selects
+func (s *AutomationService) scheduledIssues(ctx context.Context, rule *models.Rule, now time.Time) ([]*issuepb.Issue, error) {
+	schedule := rule.Schedule
+	req := &issuepb.ListIssuesRequest{
+		ProjectId:  rule.ProjectID,
+		StatusIds:  schedule.StatusIDs,
+		Pagination: &commonpb.PaginationRequest{PageSize: 100},
+	}
+	for _, p := range schedule.Priorities {
+		req.Priorities = append(req.Priorities, priorityToProto(p))
+	}
+	if schedule.StaleMinutes > 0 {
+		req.UpdatedBefore = timestamppb.New(now.Add(-time.Duration(schedule.StaleMinutes) * time.Minute))
+	}
+
+	var issues []*issuepb.Issue
+	for len(issues) < maxScheduledRuleIssues {
+		resp, err := s.issueClient.ListIssues(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list issues: %w", err)
+		}
+		for _, issue := range resp.Issues {
+			if len(schedule.IssueTypes) == 0 || contains(schedule.IssueTypes, issueTypeName(issue.Type)) {
+				issues = append(issues, issue)
+			}
+		}
+		if resp.Pagination == nil || !resp.Pagination.HasNext {
+			break
+		}
+		req.Pagination.Cursor = resp.Pagination.NextCursor
+	}
+	if len(issues) > maxScheduledRuleIssues {
+		issues = issues[:maxScheduledRuleIssues]
+	}
+	return issues, nil
+}
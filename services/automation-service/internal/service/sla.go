@@ -0,0 +1,654 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+	workflowpb "github.com/nexusflow/nexusflow/pkg/proto/workflow/v1"
+	"github.com/nexusflow/nexusflow/pkg/workflow"
+	"github.com/nexusflow/nexusflow/services/automation-service/internal/models"
+)
+
+const (
+	// slaEventsTopic is where SLA events are published; rules consume it too
+	slaEventsTopic = "sla-events"
+	// slaTimerBatchSize is how many due timers are read at a time
+	slaTimerBatchSize = 100
+	// batchGetIssuesLimit is the most issues BatchGetIssues returns at once
+	batchGetIssuesLimit = 500
+)
+
+// CreateCalendar creates a business calendar
+func (s *AutomationService) CreateCalendar(ctx context.Context, calendar *models.BusinessCalendar) (*models.BusinessCalendar, error) {
+	if calendar.ProjectID == "" {
+		return nil, fmt.Errorf("%w: project_id is required", ErrValidation)
+	}
+	if err := validateCalendar(calendar); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreateCalendar(ctx, calendar); err != nil {
+		return nil, fmt.Errorf("failed to create calendar: %w", err)
+	}
+	return calendar, nil
+}
+
+// GetCalendar gets a business calendar
+func (s *AutomationService) GetCalendar(ctx context.Context, id string) (*models.BusinessCalendar, error) {
+	calendar, err := s.repo.GetCalendar(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calendar: %w", err)
+	}
+	if calendar == nil {
+		return nil, fmt.Errorf("%w: calendar %s", ErrNotFound, id)
+	}
+	return calendar, nil
+}
+
+// UpdateCalendar replaces the contents of a calendar; its project cannot
+// change. The deadlines of running timers are moved to the new hours.
+func (s *AutomationService) UpdateCalendar(ctx context.Context, update *models.BusinessCalendar) (*models.BusinessCalendar, error) {
+	calendar, err := s.GetCalendar(ctx, update.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar.Name = update.Name
+	calendar.Timezone = update.Timezone
+	calendar.WorkingHours = update.WorkingHours
+	calendar.Holidays = update.Holidays
+	if err := validateCalendar(calendar); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateCalendar(ctx, calendar); err != nil {
+		return nil, fmt.Errorf("failed to update calendar: %w", err)
+	}
+
+	slas, err := s.repo.ListSLADefinitionsByCalendar(ctx, calendar.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list calendar SLAs: %w", err)
+	}
+	for _, sla := range slas {
+		s.rescheduleSLATimers(ctx, sla)
+	}
+	return calendar, nil
+}
+
+// DeleteCalendar deletes a business calendar that no SLA uses
+func (s *AutomationService) DeleteCalendar(ctx context.Context, id string) error {
+	if _, err := s.GetCalendar(ctx, id); err != nil {
+		return err
+	}
+	slas, err := s.repo.ListSLADefinitionsByCalendar(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to list calendar SLAs: %w", err)
+	}
+	if len(slas) > 0 {
+		return fmt.Errorf("%w: calendar is used by SLA %q", ErrValidation, slas[0].Name)
+	}
+	if err := s.repo.DeleteCalendar(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete calendar: %w", err)
+	}
+	return nil
+}
+
+// ListCalendars lists the business calendars of a project
+func (s *AutomationService) ListCalendars(ctx context.Context, projectID string) ([]*models.BusinessCalendar, error) {
+	if projectID == "" {
+		return nil, fmt.Errorf("%w: project_id is required", ErrValidation)
+	}
+	return s.repo.ListCalendars(ctx, projectID)
+}
+
+func validateCalendar(calendar *models.BusinessCalendar) error {
+	calendar.Name = strings.TrimSpace(calendar.Name)
+	if calendar.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrValidation)
+	}
+	if calendar.Timezone == "" {
+		calendar.Timezone = "UTC"
+	}
+	if _, err := newWorkCalendar(calendar); err != nil {
+		return fmt.Errorf("%w: %v", ErrValidation, err)
+	}
+	return nil
+}
+
+// CreateSLADefinition creates an SLA definition. Its timers start with the
+// next change of each issue.
+func (s *AutomationService) CreateSLADefinition(ctx context.Context, sla *models.SLADefinition) (*models.SLADefinition, error) {
+	if sla.ProjectID == "" {
+		return nil, fmt.Errorf("%w: project_id is required", ErrValidation)
+	}
+	if err := s.validateSLADefinition(ctx, sla); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreateSLADefinition(ctx, sla); err != nil {
+		return nil, fmt.Errorf("failed to create SLA definition: %w", err)
+	}
+	return sla, nil
+}
+
+// GetSLADefinition gets an SLA definition
+func (s *AutomationService) GetSLADefinition(ctx context.Context, id string) (*models.SLADefinition, error) {
+	sla, err := s.repo.GetSLADefinition(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SLA definition: %w", err)
+	}
+	if sla == nil {
+		return nil, fmt.Errorf("%w: SLA definition %s", ErrNotFound, id)
+	}
+	return sla, nil
+}
+
+// UpdateSLADefinition replaces the contents of an SLA definition; its
+// project cannot change. The deadlines of running timers follow the new
+// target and calendar.
+func (s *AutomationService) UpdateSLADefinition(ctx context.Context, update *models.SLADefinition) (*models.SLADefinition, error) {
+	sla, err := s.GetSLADefinition(ctx, update.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	sla.Name = update.Name
+	sla.Description = update.Description
+	sla.TargetMinutes = update.TargetMinutes
+	sla.WarningMinutes = update.WarningMinutes
+	sla.CalendarID = update.CalendarID
+	sla.IssueTypes = update.IssueTypes
+	sla.Priorities = update.Priorities
+	sla.StartCategories = update.StartCategories
+	sla.PauseCategories = update.PauseCategories
+	sla.StopCategories = update.StopCategories
+	sla.Enabled = update.Enabled
+	if err := s.validateSLADefinition(ctx, sla); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateSLADefinition(ctx, sla); err != nil {
+		return nil, fmt.Errorf("failed to update SLA definition: %w", err)
+	}
+	s.rescheduleSLATimers(ctx, sla)
+	return sla, nil
+}
+
+// DeleteSLADefinition deletes an SLA definition and its timers
+func (s *AutomationService) DeleteSLADefinition(ctx context.Context, id string) error {
+	if _, err := s.GetSLADefinition(ctx, id); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteSLADefinition(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete SLA definition: %w", err)
+	}
+	return nil
+}
+
+// ListSLADefinitions lists the SLA definitions of a project
+func (s *AutomationService) ListSLADefinitions(ctx context.Context, projectID string) ([]*models.SLADefinition, error) {
+	if projectID == "" {
+		return nil, fmt.Errorf("%w: project_id is required", ErrValidation)
+	}
+	return s.repo.ListSLADefinitions(ctx, projectID, false)
+}
+
+func (s *AutomationService) validateSLADefinition(ctx context.Context, sla *models.SLADefinition) error {
+	sla.Name = strings.TrimSpace(sla.Name)
+	if sla.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrValidation)
+	}
+	if sla.TargetMinutes <= 0 {
+		return fmt.Errorf("%w: target_minutes must be positive", ErrValidation)
+	}
+	if sla.WarningMinutes < 0 || sla.WarningMinutes >= sla.TargetMinutes {
+		return fmt.Errorf("%w: warning_minutes must be less than target_minutes", ErrValidation)
+	}
+
+	if sla.CalendarID != "" {
+		calendar, err := s.GetCalendar(ctx, sla.CalendarID)
+		if err != nil {
+			return err
+		}
+		if calendar.ProjectID != sla.ProjectID {
+			return fmt.Errorf("%w: calendar %s belongs to another project", ErrValidation, calendar.ID)
+		}
+	}
+
+	for _, t := range sla.IssueTypes {
+		if issueTypeToProto(t) == issuepb.IssueType_ISSUE_TYPE_UNSPECIFIED {
+			return fmt.Errorf("%w: invalid issue type %q", ErrValidation, t)
+		}
+	}
+	for _, p := range sla.Priorities {
+		if priorityToProto(p) == issuepb.IssuePriority_ISSUE_PRIORITY_UNSPECIFIED {
+			return fmt.Errorf("%w: invalid priority %q", ErrValidation, p)
+		}
+	}
+
+	if len(sla.StopCategories) == 0 {
+		return fmt.Errorf("%w: stop_categories is required", ErrValidation)
+	}
+	for _, categories := range [][]string{sla.StartCategories, sla.PauseCategories, sla.StopCategories} {
+		for _, c := range categories {
+			if c != models.StatusCategoryTodo && c != models.StatusCategoryInProgress && c != models.StatusCategoryDone {
+				return fmt.Errorf("%w: invalid status category %q", ErrValidation, c)
+			}
+		}
+	}
+	for _, c := range sla.StopCategories {
+		if contains(sla.StartCategories, c) || contains(sla.PauseCategories, c) {
+			return fmt.Errorf("%w: status category %q both stops the SLA and starts or pauses it", ErrValidation, c)
+		}
+	}
+	return nil
+}
+
+// IssueSLA is the state of an SLA on an issue
+type IssueSLA struct {
+	Timer *models.IssueSLATimer
+	// ElapsedSeconds and RemainingSeconds are business time as of now;
+	// remaining time is negative once the SLA is breached
+	ElapsedSeconds   int64
+	RemainingSeconds int64
+}
+
+// ListIssueSLAs lists the SLAs tracked on an issue with their remaining time
+func (s *AutomationService) ListIssueSLAs(ctx context.Context, issueID string) ([]*IssueSLA, error) {
+	if issueID == "" {
+		return nil, fmt.Errorf("%w: issue_id is required", ErrValidation)
+	}
+	timers, err := s.repo.ListIssueSLATimers(ctx, issueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list issue SLAs: %w", err)
+	}
+
+	now := time.Now()
+	calendars := make(map[string]*workCalendar)
+	slas := make([]*IssueSLA, 0, len(timers))
+	for _, timer := range timers {
+		wc, ok := calendars[timer.SLA.CalendarID]
+		if !ok {
+			if wc, err = s.slaCalendar(ctx, timer.SLA); err != nil {
+				return nil, err
+			}
+			calendars[timer.SLA.CalendarID] = wc
+		}
+		elapsed := timerElapsed(timer, wc, now)
+		slas = append(slas, &IssueSLA{
+			Timer:            timer,
+			ElapsedSeconds:   elapsed,
+			RemainingSeconds: int64(timer.SLA.TargetMinutes)*60 - elapsed,
+		})
+	}
+	return slas, nil
+}
+
+// trackSLAs moves the SLA timers of the issues of an event: issues start,
+// pause and stop their timers as they are created and change status, and
+// their timers are suspended while they are in the trash. Timers move at the
+// time of the event, so events that arrive late count the right time.
+func (s *AutomationService) trackSLAs(ctx context.Context, ec *eventContext) error {
+	at := ec.event.Timestamp
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	switch ec.event.Type {
+	case "issue.created":
+	case "issue.updated":
+		changes, _ := ec.event.Payload["changes"].(map[string]interface{})
+		if _, ok := changes["status_id"]; !ok {
+			return nil
+		}
+	case "issue.deleted":
+		issueIDs := valueStrings(ec.event.Payload["issue_ids"])
+		if len(issueIDs) == 0 {
+			return nil
+		}
+		return s.suspendSLATimers(ctx, issueIDs, at)
+	case "issue.restored":
+		return s.resumeSLATimers(ctx, valueStrings(ec.event.Payload["issue_ids"]), at)
+	case "issue.purged":
+		issueIDs := valueStrings(ec.event.Payload["issue_ids"])
+		if len(issueIDs) == 0 {
+			return nil
+		}
+		return s.repo.DeleteIssueSLATimers(ctx, issueIDs)
+	default:
+		return nil
+	}
+
+	issue, err := ec.loadIssue(ctx)
+	if err != nil || issue == nil {
+		return err
+	}
+	return s.trackIssueSLAs(ctx, []*issuepb.Issue{issue}, at)
+}
+
+// trackIssueSLAs brings the SLA timers of issues of one project in line with
+// their statuses at a time
+func (s *AutomationService) trackIssueSLAs(ctx context.Context, issues []*issuepb.Issue, at time.Time) error {
+	if len(issues) == 0 {
+		return nil
+	}
+	projectID := issues[0].ProjectId
+	slas, err := s.repo.ListSLADefinitions(ctx, projectID, true)
+	if err != nil || len(slas) == 0 {
+		return err
+	}
+	categories, err := workflow.StatusCategories(ctx, s.workflowClient, projectID)
+	if err != nil {
+		return err
+	}
+
+	for _, issue := range issues {
+		for _, sla := range slas {
+			if err := s.updateSLATimer(ctx, sla, issue, slaCategory(categories[issue.StatusId]), at); err != nil {
+				s.log.Sugar().Errorw("Failed to update SLA timer", "error", err, "sla_id", sla.ID, "issue_id", issue.Id)
+			}
+		}
+	}
+	return nil
+}
+
+// suspendSLATimers pauses the running timers of issues moved to the trash,
+// keeping the time they counted so far, without publishing anything
+func (s *AutomationService) suspendSLATimers(ctx context.Context, issueIDs []string, at time.Time) error {
+	timers, err := s.repo.ListRunningSLATimers(ctx, issueIDs)
+	if err != nil {
+		return err
+	}
+	calendars := make(map[string]*workCalendar)
+	for _, timer := range timers {
+		wc, ok := calendars[timer.SLA.CalendarID]
+		if !ok {
+			if wc, err = s.slaCalendar(ctx, timer.SLA); err != nil {
+				return err
+			}
+			calendars[timer.SLA.CalendarID] = wc
+		}
+		timer.ElapsedSeconds = timerElapsed(timer, wc, at)
+		timer.State = models.SLATimerStatePaused
+		timer.ResumedAt = time.Time{}
+		scheduleSLATimer(timer, timer.SLA, wc)
+		if err := s.repo.SaveSLATimer(ctx, timer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resumeSLATimers picks the timers of restored issues back up where their
+// statuses leave them: suspended timers resume unless the issue is in a
+// status that pauses them
+func (s *AutomationService) resumeSLATimers(ctx context.Context, issueIDs []string, at time.Time) error {
+	for start := 0; start < len(issueIDs); start += batchGetIssuesLimit {
+		end := start + batchGetIssuesLimit
+		if end > len(issueIDs) {
+			end = len(issueIDs)
+		}
+		resp, err := s.issueClient.BatchGetIssues(ctx, &issuepb.BatchGetIssuesRequest{Ids: issueIDs[start:end]})
+		if err != nil {
+			return fmt.Errorf("failed to get restored issues: %w", err)
+		}
+		// A subtree stays in one project, but group them in case
+		byProject := make(map[string][]*issuepb.Issue)
+		for _, issue := range resp.Issues {
+			byProject[issue.ProjectId] = append(byProject[issue.ProjectId], issue)
+		}
+		for _, issues := range byProject {
+			if err := s.trackIssueSLAs(ctx, issues, at); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// updateSLATimer starts, pauses, resumes or stops the timer of an SLA on an
+// issue that is now in a status category
+func (s *AutomationService) updateSLATimer(ctx context.Context, sla *models.SLADefinition, issue *issuepb.Issue, category string, now time.Time) error {
+	timer, err := s.repo.GetSLATimer(ctx, sla.ID, issue.Id)
+	if err != nil {
+		return err
+	}
+	wc, err := s.slaCalendar(ctx, sla)
+	if err != nil {
+		return err
+	}
+
+	stop := contains(sla.StopCategories, category)
+	pause := contains(sla.PauseCategories, category)
+	start := len(sla.StartCategories) == 0 || contains(sla.StartCategories, category)
+
+	var eventType string
+	switch {
+	case timer == nil || timer.State == models.SLATimerStateStopped:
+		if stop || !start || !slaApplies(sla, issue) {
+			return nil
+		}
+		// A new cycle, e.g. for a reopened issue
+		if timer == nil {
+			timer = &models.IssueSLATimer{SLAID: sla.ID, IssueID: issue.Id}
+		}
+		timer.ProjectID = issue.ProjectId
+		timer.ElapsedSeconds = 0
+		timer.StartedAt = now
+		timer.StoppedAt = time.Time{}
+		timer.Warned = false
+		timer.Breached = false
+		timer.State = models.SLATimerStateRunning
+		timer.ResumedAt = now
+		if pause {
+			timer.State = models.SLATimerStatePaused
+			timer.ResumedAt = time.Time{}
+		}
+		eventType = "sla.started"
+	case stop:
+		timer.ElapsedSeconds = timerElapsed(timer, wc, now)
+		timer.State = models.SLATimerStateStopped
+		timer.StoppedAt = now
+		timer.ResumedAt = time.Time{}
+		if timer.ElapsedSeconds > int64(sla.TargetMinutes)*60 {
+			timer.Breached = true
+		}
+		eventType = "sla.stopped"
+	case timer.State == models.SLATimerStateRunning && pause:
+		timer.ElapsedSeconds = timerElapsed(timer, wc, now)
+		timer.State = models.SLATimerStatePaused
+		timer.ResumedAt = time.Time{}
+		eventType = "sla.paused"
+	case timer.State == models.SLATimerStatePaused && !pause:
+		timer.State = models.SLATimerStateRunning
+		timer.ResumedAt = now
+		eventType = "sla.resumed"
+	default:
+		return nil
+	}
+
+	scheduleSLATimer(timer, sla, wc)
+	if err := s.repo.SaveSLATimer(ctx, timer); err != nil {
+		return err
+	}
+	s.publishSLAEvent(eventType, sla, timer, wc, now)
+	return nil
+}
+
+// rescheduleSLATimers moves the deadlines of the running timers of an SLA
+// after its target or calendar changed. Failures are logged, as the SLA is
+// already saved.
+func (s *AutomationService) rescheduleSLATimers(ctx context.Context, sla *models.SLADefinition) {
+	timers, err := s.repo.ListActiveSLATimers(ctx, sla.ID)
+	if err == nil && len(timers) > 0 {
+		var wc *workCalendar
+		if wc, err = s.slaCalendar(ctx, sla); err == nil {
+			for _, timer := range timers {
+				scheduleSLATimer(timer, sla, wc)
+				if err = s.repo.SaveSLATimer(ctx, timer); err != nil {
+					break
+				}
+			}
+		}
+	}
+	if err != nil {
+		s.log.Sugar().Errorw("Failed to reschedule SLA timers", "error", err, "sla_id", sla.ID)
+	}
+}
+
+// RunSLATimers publishes SLA warnings and breaches as they fall due, every
+// interval until ctx is done
+func (s *AutomationService) RunSLATimers(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := s.CheckSLATimers(ctx, time.Now())
+		if err != nil {
+			s.log.Sugar().Errorw("Failed to check SLA timers", "error", err)
+		} else if n > 0 {
+			s.log.Sugar().Infow("Published SLA warnings and breaches", "count", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckSLATimers publishes the warnings and breaches due at now and returns
+// how many were published
+func (s *AutomationService) CheckSLATimers(ctx context.Context, now time.Time) (int, error) {
+	published := 0
+	checks := []struct {
+		eventType string
+		list      func(context.Context, time.Time, int) ([]*models.IssueSLATimer, error)
+		claim     func(context.Context, string) (bool, error)
+	}{
+		{"sla.warning", s.repo.ListDueSLAWarnings, s.repo.ClaimSLAWarning},
+		{"sla.breached", s.repo.ListDueSLABreaches, s.repo.ClaimSLABreach},
+	}
+	for _, check := range checks {
+		for {
+			timers, err := check.list(ctx, now, slaTimerBatchSize)
+			if err != nil {
+				return published, fmt.Errorf("failed to list due SLA timers: %w", err)
+			}
+			for _, timer := range timers {
+				// Claimed first, so several instances publish each once
+				claimed, err := check.claim(ctx, timer.ID)
+				if err != nil {
+					return published, err
+				}
+				if !claimed {
+					continue
+				}
+				wc, err := s.slaCalendar(ctx, timer.SLA)
+				if err != nil {
+					return published, err
+				}
+				s.publishSLAEvent(check.eventType, timer.SLA, timer, wc, now)
+				published++
+			}
+			if len(timers) < slaTimerBatchSize {
+				break
+			}
+		}
+	}
+	return published, nil
+}
+
+// slaCategory names a workflow status category the way SLA conditions do
+func slaCategory(category workflowpb.StatusCategory) string {
+	switch category {
+	case workflowpb.StatusCategory_STATUS_CATEGORY_TODO:
+		return models.StatusCategoryTodo
+	case workflowpb.StatusCategory_STATUS_CATEGORY_IN_PROGRESS:
+		return models.StatusCategoryInProgress
+	case workflowpb.StatusCategory_STATUS_CATEGORY_DONE:
+		return models.StatusCategoryDone
+	}
+	return ""
+}
+
+// slaCalendar returns the calendar an SLA counts time in
+func (s *AutomationService) slaCalendar(ctx context.Context, sla *models.SLADefinition) (*workCalendar, error) {
+	if sla.CalendarID == "" {
+		return newWorkCalendar(nil)
+	}
+	calendar, err := s.repo.GetCalendar(ctx, sla.CalendarID)
+	if err != nil {
+		return nil, err
+	}
+	return newWorkCalendar(calendar)
+}
+
+// slaApplies reports whether an SLA applies to an issue
+func slaApplies(sla *models.SLADefinition, issue *issuepb.Issue) bool {
+	if len(sla.IssueTypes) > 0 && !contains(sla.IssueTypes, issueTypeName(issue.Type)) {
+		return false
+	}
+	if len(sla.Priorities) > 0 && !contains(sla.Priorities, priorityName(issue.Priority)) {
+		return false
+	}
+	return true
+}
+
+// timerElapsed returns the business seconds a timer has counted as of now
+func timerElapsed(timer *models.IssueSLATimer, wc *workCalendar, now time.Time) int64 {
+	elapsed := timer.ElapsedSeconds
+	if timer.State == models.SLATimerStateRunning {
+		elapsed += int64(wc.between(timer.ResumedAt, now) / time.Second)
+	}
+	return elapsed
+}
+
+// scheduleSLATimer sets the warning and due times of a running timer, and
+// clears them for others
+func scheduleSLATimer(timer *models.IssueSLATimer, sla *models.SLADefinition, wc *workCalendar) {
+	timer.WarnAt, timer.DueAt = time.Time{}, time.Time{}
+	if timer.State != models.SLATimerStateRunning {
+		return
+	}
+
+	remaining := time.Duration(int64(sla.TargetMinutes)*60-timer.ElapsedSeconds) * time.Second
+	if remaining < 0 {
+		remaining = 0
+	}
+	timer.DueAt = wc.add(timer.ResumedAt, remaining)
+	if sla.WarningMinutes > 0 {
+		warning := remaining - time.Duration(sla.WarningMinutes)*time.Minute
+		if warning < 0 {
+			warning = 0
+		}
+		timer.WarnAt = wc.add(timer.ResumedAt, warning)
+	}
+}
+
+// publishSLAEvent publishes an SLA event to the sla-events topic. SLA events
+// are not changes made by rules, so any rule can trigger on them.
+func (s *AutomationService) publishSLAEvent(eventType string, sla *models.SLADefinition, timer *models.IssueSLATimer, wc *workCalendar, now time.Time) {
+	elapsed := timerElapsed(timer, wc, now)
+	payload := map[string]interface{}{
+		"sla_id":            sla.ID,
+		"sla_name":          sla.Name,
+		"timer_id":          timer.ID,
+		"issue_id":          timer.IssueID,
+		"state":             string(timer.State),
+		"elapsed_seconds":   elapsed,
+		"remaining_seconds": int64(sla.TargetMinutes)*60 - elapsed,
+		"breached":          timer.Breached || eventType == "sla.breached",
+	}
+	if !timer.DueAt.IsZero() {
+		payload["due_at"] = timer.DueAt.UTC().Format(time.RFC3339)
+	}
+	if eventType == "sla.stopped" {
+		payload["met"] = !timer.Breached
+	}
+	s.publish(slaEventsTopic, eventType, sla.ProjectID, "system", payload)
+}
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS automation_rule_executions;
+DROP TABLE IF EXISTS automation_rules;
@@ -0,0 +1,57 @@
+-- Enable UUID extension
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+-- Shared trigger function to keep updated_at current
+CREATE OR REPLACE FUNCTION update_updated_at_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+-- Automation rules: trigger event -> conditions -> actions
+CREATE TABLE IF NOT EXISTS automation_rules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    trigger_event VARCHAR(100) NOT NULL,
+    conditions JSONB,
+    actions JSONB,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    dry_run BOOLEAN NOT NULL DEFAULT FALSE,
+    allow_automation_triggers BOOLEAN NOT NULL DEFAULT FALSE,
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(project_id, name)
+);
+
+CREATE INDEX idx_automation_rules_project_id ON automation_rules(project_id);
+CREATE INDEX idx_automation_rules_trigger ON automation_rules(trigger_event) WHERE enabled;
+
+CREATE TRIGGER update_automation_rules_updated_at
+    BEFORE UPDATE ON automation_rules
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Audit log of rule executions
+CREATE TABLE IF NOT EXISTS automation_rule_executions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    rule_id UUID NOT NULL REFERENCES automation_rules(id) ON DELETE CASCADE,
+    project_id UUID NOT NULL,
+    event_id VARCHAR(100) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    issue_id UUID,
+    status VARCHAR(50) NOT NULL,
+    actions JSONB,
+    error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    -- Redelivered events run a rule once
+    UNIQUE(rule_id, event_id)
+);
+
+CREATE INDEX idx_automation_rule_executions_rule ON automation_rule_executions(rule_id, created_at DESC);
+-- Recent executions per issue, for loop protection
+CREATE INDEX idx_automation_rule_executions_issue ON automation_rule_executions(rule_id, issue_id, created_at DESC);
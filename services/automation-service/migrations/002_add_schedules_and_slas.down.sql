@@ -0,0 +1,12 @@
+DROP TRIGGER IF EXISTS update_issue_sla_timers_updated_at ON issue_sla_timers;
+DROP TRIGGER IF EXISTS update_sla_definitions_updated_at ON sla_definitions;
+DROP TRIGGER IF EXISTS update_business_calendars_updated_at ON business_calendars;
+
+DROP TABLE IF EXISTS issue_sla_timers;
+DROP TABLE IF EXISTS sla_definitions;
+DROP TABLE IF EXISTS business_calendars;
+
+DROP INDEX IF EXISTS idx_automation_rules_next_run;
+ALTER TABLE automation_rules DROP COLUMN IF EXISTS last_run_at;
+ALTER TABLE automation_rules DROP COLUMN IF EXISTS next_run_at;
+ALTER TABLE automation_rules DROP COLUMN IF EXISTS schedule;
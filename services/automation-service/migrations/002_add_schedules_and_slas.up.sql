@@ -0,0 +1,81 @@
+-- Scheduled rules run on a timer against the issues matching their schedule
+ALTER TABLE automation_rules ADD COLUMN schedule JSONB;
+ALTER TABLE automation_rules ADD COLUMN next_run_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE automation_rules ADD COLUMN last_run_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX idx_automation_rules_next_run ON automation_rules(next_run_at) WHERE enabled AND trigger_event = 'scheduled';
+
+-- Business-hours calendars that SLA time is counted in
+CREATE TABLE IF NOT EXISTS business_calendars (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    timezone VARCHAR(100) NOT NULL DEFAULT 'UTC',
+    working_hours JSONB NOT NULL DEFAULT '[]',
+    holidays JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(project_id, name)
+);
+
+CREATE TRIGGER update_business_calendars_updated_at
+    BEFORE UPDATE ON business_calendars
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- SLA definitions: a time target counted while issues are in certain status categories
+CREATE TABLE IF NOT EXISTS sla_definitions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    target_minutes INTEGER NOT NULL,
+    warning_minutes INTEGER NOT NULL DEFAULT 0,
+    calendar_id UUID REFERENCES business_calendars(id), -- NULL counts around the clock
+    issue_types TEXT[] NOT NULL DEFAULT '{}',
+    priorities TEXT[] NOT NULL DEFAULT '{}',
+    start_categories TEXT[] NOT NULL DEFAULT '{}',
+    pause_categories TEXT[] NOT NULL DEFAULT '{}',
+    stop_categories TEXT[] NOT NULL DEFAULT '{}',
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(project_id, name)
+);
+
+CREATE INDEX idx_sla_definitions_calendar_id ON sla_definitions(calendar_id);
+
+CREATE TRIGGER update_sla_definitions_updated_at
+    BEFORE UPDATE ON sla_definitions
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- SLA timers: one per SLA and issue, restarted for each new cycle
+CREATE TABLE IF NOT EXISTS issue_sla_timers (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    sla_id UUID NOT NULL REFERENCES sla_definitions(id) ON DELETE CASCADE,
+    issue_id UUID NOT NULL,
+    project_id UUID NOT NULL,
+    state VARCHAR(50) NOT NULL,
+    elapsed_seconds BIGINT NOT NULL DEFAULT 0, -- business time counted before resumed_at
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    resumed_at TIMESTAMP WITH TIME ZONE,
+    warn_at TIMESTAMP WITH TIME ZONE,
+    due_at TIMESTAMP WITH TIME ZONE,
+    stopped_at TIMESTAMP WITH TIME ZONE,
+    warned BOOLEAN NOT NULL DEFAULT FALSE,
+    breached BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(sla_id, issue_id)
+);
+
+CREATE INDEX idx_issue_sla_timers_issue_id ON issue_sla_timers(issue_id);
+-- Running timers by deadline, for warnings and breaches
+CREATE INDEX idx_issue_sla_timers_due ON issue_sla_timers(due_at) WHERE state = 'running' AND NOT breached;
+CREATE INDEX idx_issue_sla_timers_warn ON issue_sla_timers(warn_at) WHERE state = 'running' AND NOT warned;
+
+CREATE TRIGGER update_issue_sla_timers_updated_at
+    BEFORE UPDATE ON issue_sla_timers
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_automation_rules_project_trigger;
+CREATE INDEX idx_automation_rules_trigger ON automation_rules(trigger_event) WHERE enabled;
@@ -0,0 +1,3 @@
+-- Triggered rules are looked up by the project and type of each event
+DROP INDEX IF EXISTS idx_automation_rules_trigger;
+CREATE INDEX idx_automation_rules_project_trigger ON automation_rules(project_id, trigger_event) WHERE enabled;
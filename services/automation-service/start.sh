@@ -0,0 +1,18 @@
+#!/bin/bash
+
+# Exit on error
+set -e
+
+# Load environment variables
+if [ -f .env ]; then
+  export $(cat .env | xargs)
+fi
+
+# Build the service
+echo "Building automation-service..."
+cd "$(dirname "$0")"
+go build -o ../../bin/automation-service ./cmd/server
+
+# Run the service
+echo "Starting automation-service..."
+../../bin/automation-service
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"net"
 	"os"
@@ -87,9 +89,44 @@ func main() {
 
 	// Initialize layers
 	repo := repository.NewBoardRepository(db, log)
-	svc := service.NewBoardService(repo, producer, log)
+	issueServiceAddr := "127.0.0.1:50054"    // Default
+	workflowServiceAddr := "127.0.0.1:50055" // Default
+	svc, err := service.NewBoardService(repo, producer, log, issueServiceAddr, workflowServiceAddr)
+	if err != nil {
+		log.Sugar().Fatalw("Failed to create board service", "error", err)
+	}
+
+	// Archive cards that have been done for their board's archive period
+	archiveInterval := cfg.GetInt("cards.archive_interval_minutes")
+	if archiveInterval <= 0 {
+		archiveInterval = 60
+	}
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+	go svc.RunCardArchiver(backgroundCtx, time.Duration(archiveInterval)*time.Minute)
+
 	h := handler.NewBoardHandler(svc, log)
 
+	// Consume issue events to keep cards in step with issues and board filters
+	consumer, err := kafka.NewEventConsumer(kafka.ConsumerConfig{
+		Brokers:       kafkaCfg.Brokers,
+		ConsumerGroup: kafkaCfg.ConsumerGroup,
+		Topics:        []string{"issue-events"},
+	}, svc.HandleIssueEvent)
+	if err != nil {
+		log.Sugar().Warnw("Failed to create Kafka consumer, continuing without issue events", "error", err)
+	} else {
+		defer consumer.Close()
+		consumerCtx, stopConsumer := context.WithCancel(context.Background())
+		defer stopConsumer()
+		go func() {
+			if err := consumer.Start(consumerCtx); err != nil && !errors.Is(err, context.Canceled) {
+				log.Sugar().Errorw("Kafka consumer stopped", "error", err)
+			}
+		}()
+		log.Sugar().Infow("Kafka consumer initialized")
+	}
+
 	// Create gRPC server
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
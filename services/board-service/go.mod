@@ -8,9 +8,11 @@ require (
     github.com/nexusflow/nexusflow/pkg/kafka v0.0.0
     github.com/nexusflow/nexusflow/pkg/logger v0.0.0
     github.com/nexusflow/nexusflow/pkg/proto v0.0.0
+    github.com/nexusflow/nexusflow/pkg/workflow v0.0.0
     github.com/nexusflow/nexusflow/pkg/database v0.0.0
     github.com/nexusflow/nexusflow/pkg/config v0.0.0
     google.golang.org/grpc v1.77.0
+    google.golang.org/protobuf v1.36.10
 )
 
 replace (
@@ -19,4 +21,5 @@ replace (
     github.com/nexusflow/nexusflow/pkg/kafka => ../../pkg/kafka
     github.com/nexusflow/nexusflow/pkg/logger => ../../pkg/logger
     github.com/nexusflow/nexusflow/pkg/proto => ../../pkg/proto
+    github.com/nexusflow/nexusflow/pkg/workflow => ../../pkg/workflow
 )
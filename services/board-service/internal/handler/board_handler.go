@@ -2,6 +2,7 @@ package handler
 
 import (
     "context"
+    "errors"
     "time"
     
     "github.com/nexusflow/nexusflow/pkg/logger"
@@ -29,26 +30,48 @@ func boardToProto(b *models.Board) *pb.Board {
         return nil
     }
     return &pb.Board{
-        Id:          b.ID,
-        ProjectId:   b.ProjectID,
-        Name:        b.Name,
-        Description: b.Description,
-        CreatedAt:   b.CreatedAt.Format(time.RFC3339),
-        UpdatedAt:   b.UpdatedAt.Format(time.RFC3339),
+        Id:                   b.ID,
+        ProjectId:            b.ProjectID,
+        Name:                 b.Name,
+        Description:          b.Description,
+        CreatedAt:            b.CreatedAt.Format(time.RFC3339),
+        UpdatedAt:            b.UpdatedAt.Format(time.RFC3339),
+        Swimlanes:            swimlaneConfigToProto(b.Swimlanes),
+        Filter:               boardFilterToProto(b.Filter),
+        ArchiveDoneAfterDays: int32(b.ArchiveDoneAfterDays),
     }
 }
 
+func boardFilterToProto(f *models.BoardFilter) *pb.BoardFilter {
+    if f == nil {
+        return nil
+    }
+    return &pb.BoardFilter{IssueTypes: f.IssueTypes, LabelIds: f.LabelIDs, Query: f.Query}
+}
+
+// formatOptionalTime formats a timestamp, leaving unset ones empty
+func formatOptionalTime(t time.Time) string {
+    if t.IsZero() {
+        return ""
+    }
+    return t.Format(time.RFC3339)
+}
+
 func cardToProto(c *models.Card) *pb.Card {
     if c == nil {
         return nil
     }
     return &pb.Card{
-        Id:        c.ID,
-        BoardId:   c.BoardID,
-        IssueId:   c.IssueID,
-        Position:  int32(c.Position),
-        CreatedAt: c.CreatedAt.Format(time.RFC3339),
-        UpdatedAt: c.UpdatedAt.Format(time.RFC3339),
+        Id:         c.ID,
+        BoardId:    c.BoardID,
+        IssueId:    c.IssueID,
+        Position:   int32(c.Position),
+        CreatedAt:  c.CreatedAt.Format(time.RFC3339),
+        UpdatedAt:  c.UpdatedAt.Format(time.RFC3339),
+        StatusId:   c.StatusID,
+        ColumnId:   c.ColumnID,
+        DoneAt:     formatOptionalTime(c.DoneAt),
+        ArchivedAt: formatOptionalTime(c.ArchivedAt),
     }
 }
 
@@ -71,7 +94,12 @@ func (h *BoardHandler) GetBoard(ctx context.Context, req *pb.GetBoardRequest) (*
     if board == nil {
         return nil, status.Error(codes.NotFound, "board not found")
     }
-    return &pb.GetBoardResponse{Board: boardToProto(board)}, nil
+    columns, err := h.svc.ListColumns(ctx, board.ID)
+    if err != nil {
+        h.log.Sugar().Errorw("Failed to list board columns", "error", err)
+        return nil, status.Errorf(codes.Internal, "failed to list board columns: %v", err)
+    }
+    return &pb.GetBoardResponse{Board: boardToProto(board), Columns: columnLoadsToProto(columns)}, nil
 }
 
 func (h *BoardHandler) ListBoards(ctx context.Context, req *pb.ListBoardsRequest) (*pb.ListBoardsResponse, error) {
@@ -118,7 +146,7 @@ func (h *BoardHandler) MoveCard(ctx context.Context, req *pb.MoveCardRequest) (*
     card, err := h.svc.MoveCard(ctx, req)
     if err != nil {
         h.log.Sugar().Errorw("Failed to move card", "error", err)
-        return nil, status.Errorf(codes.Internal, "failed to move card: %v", err)
+        return nil, h.errorToStatus(err, "failed to move card")
     }
     return &pb.MoveCardResponse{Card: cardToProto(card)}, nil
 }
@@ -132,7 +160,7 @@ func (h *BoardHandler) DeleteCard(ctx context.Context, req *pb.DeleteCardRequest
 }
 
 func (h *BoardHandler) ListCards(ctx context.Context, req *pb.ListCardsRequest) (*pb.ListCardsResponse, error) {
-    cards, err := h.svc.ListCards(ctx, req.BoardId)
+    cards, err := h.svc.ListCards(ctx, req.BoardId, req.IncludeArchived)
     if err != nil {
         h.log.Sugar().Errorw("Failed to list cards", "error", err)
         return nil, status.Errorf(codes.Internal, "failed to list cards: %v", err)
@@ -143,3 +171,25 @@ func (h *BoardHandler) ListCards(ctx context.Context, req *pb.ListCardsRequest)
     }
     return &pb.ListCardsResponse{Cards: pbCards}, nil
 }
+
+// SyncBoardCards brings the cards of a filtered board in line with its filter
+func (h *BoardHandler) SyncBoardCards(ctx context.Context, req *pb.SyncBoardCardsRequest) (*pb.SyncBoardCardsResponse, error) {
+    added, removed, err := h.svc.SyncBoardCards(ctx, req.BoardId)
+    if err != nil {
+        h.log.Sugar().Errorw("Failed to sync board cards", "error", err)
+        return nil, status.Errorf(codes.Internal, "failed to sync board cards: %v", err)
+    }
+    return &pb.SyncBoardCardsResponse{Added: int32(added), Removed: int32(removed)}, nil
+}
+
+// errorToStatus maps service errors to gRPC status codes
+func (h *BoardHandler) errorToStatus(err error, msg string) error {
+    switch {
+    case errors.Is(err, service.ErrValidation):
+        return status.Error(codes.InvalidArgument, err.Error())
+    case errors.Is(err, service.ErrNotFound):
+        return status.Error(codes.NotFound, err.Error())
+    default:
+        return status.Errorf(codes.Internal, "%s: %v", msg, err)
+    }
+}
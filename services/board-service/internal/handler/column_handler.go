@@ -0,0 +1,81 @@
+package handler
+
+import (
+    "context"
+    "time"
+
+    pb "github.com/nexusflow/nexusflow/pkg/proto/board/v1"
+    "github.com/nexusflow/nexusflow/services/board-service/internal/service"
+)
+
+func columnLoadToProto(l *service.ColumnLoad) *pb.BoardColumn {
+    if l == nil {
+        return nil
+    }
+    c := l.Column
+    return &pb.BoardColumn{
+        Id:           c.ID,
+        BoardId:      c.BoardID,
+        Name:         c.Name,
+        Position:     int32(c.Position),
+        StatusIds:    c.StatusIDs,
+        MinWip:       int32(c.MinWIP),
+        MaxWip:       int32(c.MaxWIP),
+        CardCount:    int32(l.CardCount),
+        WipViolation: l.Violation,
+        CreatedAt:    c.CreatedAt.Format(time.RFC3339),
+        UpdatedAt:    c.UpdatedAt.Format(time.RFC3339),
+    }
+}
+
+func columnLoadsToProto(loads []*service.ColumnLoad) []*pb.BoardColumn {
+    var columns []*pb.BoardColumn
+    for _, l := range loads {
+        columns = append(columns, columnLoadToProto(l))
+    }
+    return columns
+}
+
+func (h *BoardHandler) CreateBoardColumn(ctx context.Context, req *pb.CreateBoardColumnRequest) (*pb.CreateBoardColumnResponse, error) {
+    column, err := h.svc.CreateColumn(ctx, req)
+    if err != nil {
+        h.log.Sugar().Errorw("Failed to create board column", "error", err)
+        return nil, h.errorToStatus(err, "failed to create board column")
+    }
+    return &pb.CreateBoardColumnResponse{Column: columnLoadToProto(column)}, nil
+}
+
+func (h *BoardHandler) UpdateBoardColumn(ctx context.Context, req *pb.UpdateBoardColumnRequest) (*pb.UpdateBoardColumnResponse, error) {
+    column, err := h.svc.UpdateColumn(ctx, req)
+    if err != nil {
+        h.log.Sugar().Errorw("Failed to update board column", "error", err)
+        return nil, h.errorToStatus(err, "failed to update board column")
+    }
+    return &pb.UpdateBoardColumnResponse{Column: columnLoadToProto(column)}, nil
+}
+
+func (h *BoardHandler) DeleteBoardColumn(ctx context.Context, req *pb.DeleteBoardColumnRequest) (*pb.DeleteBoardColumnResponse, error) {
+    if err := h.svc.DeleteColumn(ctx, req.Id); err != nil {
+        h.log.Sugar().Errorw("Failed to delete board column", "error", err)
+        return nil, h.errorToStatus(err, "failed to delete board column")
+    }
+    return &pb.DeleteBoardColumnResponse{}, nil
+}
+
+func (h *BoardHandler) ListBoardColumns(ctx context.Context, req *pb.ListBoardColumnsRequest) (*pb.ListBoardColumnsResponse, error) {
+    columns, err := h.svc.ListColumns(ctx, req.BoardId)
+    if err != nil {
+        h.log.Sugar().Errorw("Failed to list board columns", "error", err)
+        return nil, h.errorToStatus(err, "failed to list board columns")
+    }
+    return &pb.ListBoardColumnsResponse{Columns: columnLoadsToProto(columns)}, nil
+}
+
+func (h *BoardHandler) ReorderBoardColumns(ctx context.Context, req *pb.ReorderBoardColumnsRequest) (*pb.ReorderBoardColumnsResponse, error) {
+    columns, err := h.svc.ReorderColumns(ctx, req.BoardId, req.ColumnIds)
+    if err != nil {
+        h.log.Sugar().Errorw("Failed to reorder board columns", "error", err)
+        return nil, h.errorToStatus(err, "failed to reorder board columns")
+    }
+    return &pb.ReorderBoardColumnsResponse{Columns: columnLoadsToProto(columns)}, nil
+}
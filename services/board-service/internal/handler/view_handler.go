@@ -0,0 +1,134 @@
+package handler
+
+import (
+    "context"
+    "time"
+
+    pb "github.com/nexusflow/nexusflow/pkg/proto/board/v1"
+    issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+    "github.com/nexusflow/nexusflow/services/board-service/internal/models"
+    "github.com/nexusflow/nexusflow/services/board-service/internal/service"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+)
+
+var swimlaneByToProto = map[models.SwimlaneBy]pb.SwimlaneBy{
+    models.SwimlaneByAssignee:    pb.SwimlaneBy_SWIMLANE_BY_ASSIGNEE,
+    models.SwimlaneByEpic:        pb.SwimlaneBy_SWIMLANE_BY_EPIC,
+    models.SwimlaneByPriority:    pb.SwimlaneBy_SWIMLANE_BY_PRIORITY,
+    models.SwimlaneByCustomField: pb.SwimlaneBy_SWIMLANE_BY_CUSTOM_FIELD,
+    models.SwimlaneByQuery:       pb.SwimlaneBy_SWIMLANE_BY_QUERY,
+}
+
+func swimlaneConfigToProto(c *models.SwimlaneConfig) *pb.SwimlaneConfig {
+    if c == nil {
+        return &pb.SwimlaneConfig{}
+    }
+    config := &pb.SwimlaneConfig{By: swimlaneByToProto[c.By], CustomFieldId: c.CustomFieldID}
+    for _, q := range c.Queries {
+        config.Queries = append(config.Queries, &pb.SwimlaneQuery{Name: q.Name, Query: q.Query})
+    }
+    return config
+}
+
+func quickFilterToProto(f *models.QuickFilter) *pb.QuickFilter {
+    if f == nil {
+        return nil
+    }
+    return &pb.QuickFilter{
+        Id:        f.ID,
+        BoardId:   f.BoardID,
+        Name:      f.Name,
+        Query:     f.Query,
+        Position:  int32(f.Position),
+        CreatedAt: f.CreatedAt.Format(time.RFC3339),
+        UpdatedAt: f.UpdatedAt.Format(time.RFC3339),
+    }
+}
+
+func issueSummaryToProto(i *issuepb.Issue) *pb.IssueSummary {
+    return &pb.IssueSummary{
+        Id:          i.Id,
+        Key:         i.Key,
+        Summary:     i.Summary,
+        Type:        service.IssueTypeName(i.Type),
+        Priority:    service.IssuePriorityName(i.Priority),
+        StatusId:    i.StatusId,
+        AssigneeId:  i.AssigneeId,
+        ParentId:    i.ParentId,
+        StoryPoints: i.StoryPoints,
+        LabelIds:    i.LabelIds,
+    }
+}
+
+func boardViewToProto(v *service.BoardView) *pb.GetBoardViewResponse {
+    resp := &pb.GetBoardViewResponse{
+        Board:   boardToProto(v.Board),
+        Columns: columnLoadsToProto(v.Columns),
+    }
+    for _, lane := range v.Lanes {
+        pbLane := &pb.BoardLane{Key: lane.Key, Name: lane.Name, CardCount: int32(lane.CardCount)}
+        for _, cell := range lane.Cells {
+            pbCell := &pb.BoardCell{ColumnId: cell.ColumnID}
+            for _, c := range cell.Cards {
+                pbCell.Cards = append(pbCell.Cards, &pb.BoardViewCard{
+                    Card:  cardToProto(c.Card),
+                    Issue: issueSummaryToProto(c.Issue),
+                })
+            }
+            pbLane.Cells = append(pbLane.Cells, pbCell)
+        }
+        resp.Lanes = append(resp.Lanes, pbLane)
+    }
+    return resp
+}
+
+// GetBoardView returns the cards of a board grouped into swimlanes and columns
+func (h *BoardHandler) GetBoardView(ctx context.Context, req *pb.GetBoardViewRequest) (*pb.GetBoardViewResponse, error) {
+    view, err := h.svc.GetBoardView(ctx, req.BoardId, req.QuickFilterIds, req.UserId)
+    if err != nil {
+        h.log.Sugar().Errorw("Failed to get board view", "error", err)
+        return nil, status.Errorf(codes.Internal, "failed to get board view: %v", err)
+    }
+    return boardViewToProto(view), nil
+}
+
+// Quick filters
+func (h *BoardHandler) CreateQuickFilter(ctx context.Context, req *pb.CreateQuickFilterRequest) (*pb.CreateQuickFilterResponse, error) {
+    filter, err := h.svc.CreateQuickFilter(ctx, req)
+    if err != nil {
+        h.log.Sugar().Errorw("Failed to create quick filter", "error", err)
+        return nil, status.Errorf(codes.Internal, "failed to create quick filter: %v", err)
+    }
+    return &pb.CreateQuickFilterResponse{QuickFilter: quickFilterToProto(filter)}, nil
+}
+
+func (h *BoardHandler) UpdateQuickFilter(ctx context.Context, req *pb.UpdateQuickFilterRequest) (*pb.UpdateQuickFilterResponse, error) {
+    filter, err := h.svc.UpdateQuickFilter(ctx, req)
+    if err != nil {
+        h.log.Sugar().Errorw("Failed to update quick filter", "error", err)
+        return nil, status.Errorf(codes.Internal, "failed to update quick filter: %v", err)
+    }
+    return &pb.UpdateQuickFilterResponse{QuickFilter: quickFilterToProto(filter)}, nil
+}
+
+func (h *BoardHandler) DeleteQuickFilter(ctx context.Context, req *pb.DeleteQuickFilterRequest) (*pb.DeleteQuickFilterResponse, error) {
+    if err := h.svc.DeleteQuickFilter(ctx, req.Id); err != nil {
+        h.log.Sugar().Errorw("Failed to delete quick filter", "error", err)
+        return nil, status.Errorf(codes.Internal, "failed to delete quick filter: %v", err)
+    }
+    return &pb.DeleteQuickFilterResponse{}, nil
+}
+
+func (h *BoardHandler) ListQuickFilters(ctx context.Context, req *pb.ListQuickFiltersRequest) (*pb.ListQuickFiltersResponse, error) {
+    filters, err := h.svc.ListQuickFilters(ctx, req.BoardId)
+    if err != nil {
+        h.log.Sugar().Errorw("Failed to list quick filters", "error", err)
+        return nil, status.Errorf(codes.Internal, "failed to list quick filters: %v", err)
+    }
+    var pbFilters []*pb.QuickFilter
+    for _, f := range filters {
+        pbFilters = append(pbFilters, quickFilterToProto(f))
+    }
+    return &pb.ListQuickFiltersResponse{QuickFilters: pbFilters}, nil
+}
@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+    "time"
+
+    "github.com/uptrace/bun"
+)
 
 type Board struct {
     ID          string    `bun:"type:uuid,default:uuid_generate_v4()"`
@@ -9,6 +13,60 @@ type Board struct {
     Description string    `bun:"type:text"`
     CreatedAt   time.Time `bun:"type:timestamp,default:now()"`
     UpdatedAt   time.Time `bun:"type:timestamp,default:now()"`
+    // Nil for a single lane
+    Swimlanes *SwimlaneConfig `bun:"type:jsonb"`
+    // Nil for boards whose cards are added by hand
+    Filter *BoardFilter `bun:"type:jsonb"`
+    // Done cards are archived after this many days, 0 to keep them
+    ArchiveDoneAfterDays int `bun:"type:int,notnull"`
+}
+
+// BoardFilter selects the issues of the board's project that have cards
+type BoardFilter struct {
+    // Issue type names such as "bug", any type when empty
+    IssueTypes []string `json:"issue_types,omitempty"`
+    // Issues with any of the labels, any when empty
+    LabelIDs []string `json:"label_ids,omitempty"`
+    // Quick filter query the issues must also match
+    Query string `json:"query,omitempty"`
+}
+
+// SwimlaneBy is what the lanes of a board group cards by
+type SwimlaneBy string
+
+const (
+    SwimlaneByNone        SwimlaneBy = ""
+    SwimlaneByAssignee    SwimlaneBy = "assignee"
+    SwimlaneByEpic        SwimlaneBy = "epic"
+    SwimlaneByPriority    SwimlaneBy = "priority"
+    SwimlaneByCustomField SwimlaneBy = "custom_field"
+    SwimlaneByQuery       SwimlaneBy = "query"
+)
+
+// SwimlaneConfig describes how the cards of a board are split into lanes
+type SwimlaneConfig struct {
+    By            SwimlaneBy      `json:"by"`
+    CustomFieldID string          `json:"custom_field_id,omitempty"`
+    Queries       []SwimlaneQuery `json:"queries,omitempty"`
+}
+
+// SwimlaneQuery is a lane holding the cards matching a query
+type SwimlaneQuery struct {
+    Name  string `json:"name"`
+    Query string `json:"query"`
+}
+
+// QuickFilter is a saved query a board view can be narrowed with
+type QuickFilter struct {
+    bun.BaseModel `bun:"table:board_quick_filters"`
+
+    ID        string    `bun:"type:uuid,default:uuid_generate_v4()"`
+    BoardID   string    `bun:"type:uuid,notnull"`
+    Name      string    `bun:"type:text,notnull"`
+    Query     string    `bun:"type:text,notnull"`
+    Position  int       `bun:"type:int,notnull"`
+    CreatedAt time.Time `bun:"type:timestamp,default:now()"`
+    UpdatedAt time.Time `bun:"type:timestamp,default:now()"`
 }
 
 type Card struct {
@@ -18,4 +76,28 @@ type Card struct {
     Position  int       `bun:"type:int,notnull"`
     CreatedAt time.Time `bun:"type:timestamp,default:now()"`
     UpdatedAt time.Time `bun:"type:timestamp,default:now()"`
+    // Set while the issue is in the trash
+    IssueDeletedAt time.Time `bun:"type:timestamp,nullzero"`
+    // Workflow status of the issue, empty until first synced
+    StatusID string `bun:"type:uuid,nullzero"`
+    // Set while the issue is in a done status
+    DoneAt time.Time `bun:"type:timestamp,nullzero"`
+    // Set once the card was done for the board's archive period
+    ArchivedAt time.Time `bun:"type:timestamp,nullzero"`
+    // Column holding the status, resolved from the board's columns
+    ColumnID string `bun:"-"`
+}
+
+// BoardColumn groups the cards of a board whose issues are in one of its statuses
+type BoardColumn struct {
+    ID        string   `bun:"type:uuid,default:uuid_generate_v4()"`
+    BoardID   string   `bun:"type:uuid,notnull"`
+    Name      string   `bun:"type:text,notnull"`
+    Position  int      `bun:"type:int,notnull"`
+    StatusIDs []string `bun:"status_ids,type:uuid[],array"`
+    // Work-in-progress limits, 0 for none
+    MinWIP    int       `bun:"min_wip,type:int,notnull"`
+    MaxWIP    int       `bun:"max_wip,type:int,notnull"`
+    CreatedAt time.Time `bun:"type:timestamp,default:now()"`
+    UpdatedAt time.Time `bun:"type:timestamp,default:now()"`
 }
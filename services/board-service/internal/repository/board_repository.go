@@ -8,6 +8,7 @@ import (
     "github.com/nexusflow/nexusflow/pkg/database"
     "github.com/nexusflow/nexusflow/pkg/logger"
     "github.com/nexusflow/nexusflow/services/board-service/internal/models"
+    "github.com/uptrace/bun"
 )
 
 // BoardRepository handles board and card persistence
@@ -90,7 +91,12 @@ func (r *BoardRepository) GetCard(ctx context.Context, id string) (*models.Card,
 
 func (r *BoardRepository) ListCardsByBoard(ctx context.Context, boardID string) ([]*models.Card, error) {
     var cards []*models.Card
-    err := r.db.NewSelect().Model(&cards).Where("board_id = ?", boardID).Order("position ASC").Scan(ctx)
+    err := r.db.NewSelect().Model(&cards).
+        Where("board_id = ?", boardID).
+        Where("issue_deleted_at IS NULL").
+        Where("archived_at IS NULL").
+        Order("position ASC").
+        Scan(ctx)
     if err != nil {
         return nil, fmt.Errorf("list cards: %w", err)
     }
@@ -112,3 +118,25 @@ func (r *BoardRepository) DeleteCard(ctx context.Context, id string) error {
     }
     return nil
 }
+
+// SetCardsIssueDeleted hides or, with a zero deletedAt, shows again the cards of the given issues
+func (r *BoardRepository) SetCardsIssueDeleted(ctx context.Context, issueIDs []string, deletedAt time.Time) error {
+    _, err := r.db.NewUpdate().Model((*models.Card)(nil)).
+        Set("issue_deleted_at = ?", bun.NullZero(deletedAt)).
+        Set("updated_at = ?", time.Now()).
+        Where("issue_id IN (?)", bun.In(issueIDs)).
+        Exec(ctx)
+    if err != nil {
+        return fmt.Errorf("set cards issue deleted: %w", err)
+    }
+    return nil
+}
+
+// DeleteCardsByIssues removes the cards of the given issues from every board
+func (r *BoardRepository) DeleteCardsByIssues(ctx context.Context, issueIDs []string) error {
+    _, err := r.db.NewDelete().Model((*models.Card)(nil)).Where("issue_id IN (?)", bun.In(issueIDs)).Exec(ctx)
+    if err != nil {
+        return fmt.Errorf("delete cards by issues: %w", err)
+    }
+    return nil
+}
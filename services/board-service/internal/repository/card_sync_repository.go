@@ -0,0 +1,138 @@
+package repository
+
+import (
+    "context"
+    "fmt"
+    "time"
+
+    "github.com/nexusflow/nexusflow/services/board-service/internal/models"
+    "github.com/uptrace/bun"
+)
+
+// ListFilteredBoards lists the boards of a project whose cards follow a filter
+func (r *BoardRepository) ListFilteredBoards(ctx context.Context, projectID string) ([]*models.Board, error) {
+    var boards []*models.Board
+    err := r.db.NewSelect().Model(&boards).
+        Where("project_id = ?", projectID).
+        Where("filter IS NOT NULL").
+        Scan(ctx)
+    if err != nil {
+        return nil, fmt.Errorf("list filtered boards: %w", err)
+    }
+    return boards, nil
+}
+
+// ListAllCards lists every card of a board, including archived cards and cards of trashed issues
+func (r *BoardRepository) ListAllCards(ctx context.Context, boardID string) ([]*models.Card, error) {
+    var cards []*models.Card
+    err := r.db.NewSelect().Model(&cards).
+        Where("board_id = ?", boardID).
+        Order("position ASC").
+        Scan(ctx)
+    if err != nil {
+        return nil, fmt.Errorf("list all cards: %w", err)
+    }
+    return cards, nil
+}
+
+// ListArchivedCards lists the archived cards of a board, most recently archived first
+func (r *BoardRepository) ListArchivedCards(ctx context.Context, boardID string) ([]*models.Card, error) {
+    var cards []*models.Card
+    err := r.db.NewSelect().Model(&cards).
+        Where("board_id = ?", boardID).
+        Where("issue_deleted_at IS NULL").
+        Where("archived_at IS NOT NULL").
+        Order("archived_at DESC").
+        Scan(ctx)
+    if err != nil {
+        return nil, fmt.Errorf("list archived cards: %w", err)
+    }
+    return cards, nil
+}
+
+// AddCards appends cards after the last card of their board, in order, skipping
+// issues that already have a card there. It returns the number of cards added.
+func (r *BoardRepository) AddCards(ctx context.Context, boardID string, cards []*models.Card) (int, error) {
+    if len(cards) == 0 {
+        return 0, nil
+    }
+    added := 0
+    err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+        var last int
+        err := tx.NewSelect().Model((*models.Card)(nil)).
+            ColumnExpr("COALESCE(MAX(position), -1)").
+            Where("board_id = ?", boardID).
+            Scan(ctx, &last)
+        if err != nil {
+            return fmt.Errorf("get last card position: %w", err)
+        }
+        now := time.Now()
+        for i, c := range cards {
+            c.ID = ""
+            c.BoardID = boardID
+            c.Position = last + 1 + i
+            c.CreatedAt = now
+            c.UpdatedAt = now
+        }
+        res, err := tx.NewInsert().Model(&cards).
+            On("CONFLICT (board_id, issue_id) DO NOTHING").
+            Exec(ctx)
+        if err != nil {
+            return fmt.Errorf("add cards: %w", err)
+        }
+        n, _ := res.RowsAffected()
+        added = int(n)
+        return nil
+    })
+    return added, err
+}
+
+// DeleteBoardCards removes the cards of the given issues from a board
+func (r *BoardRepository) DeleteBoardCards(ctx context.Context, boardID string, issueIDs []string) (int, error) {
+    if len(issueIDs) == 0 {
+        return 0, nil
+    }
+    res, err := r.db.NewDelete().Model((*models.Card)(nil)).
+        Where("board_id = ?", boardID).
+        Where("issue_id IN (?)", bun.In(issueIDs)).
+        Exec(ctx)
+    if err != nil {
+        return 0, fmt.Errorf("delete board cards: %w", err)
+    }
+    n, _ := res.RowsAffected()
+    return int(n), nil
+}
+
+// SetCardsIssueState records the status of an issue on its cards. Cards become
+// done when the issue reaches a done status, and leave the archive when it is reopened.
+func (r *BoardRepository) SetCardsIssueState(ctx context.Context, issueID, statusID string, done bool, now time.Time) error {
+    _, err := r.db.NewUpdate().Model((*models.Card)(nil)).
+        Set("status_id = ?", statusID).
+        Set("done_at = CASE WHEN ? THEN COALESCE(done_at, ?) ELSE NULL END", done, now).
+        Set("archived_at = CASE WHEN ? THEN archived_at ELSE NULL END", done).
+        Set("updated_at = ?", now).
+        Where("issue_id = ?", issueID).
+        Exec(ctx)
+    if err != nil {
+        return fmt.Errorf("set cards issue state: %w", err)
+    }
+    return nil
+}
+
+// ArchiveDoneCards archives the cards that have been done for longer than their
+// board's archive period and returns the number archived
+func (r *BoardRepository) ArchiveDoneCards(ctx context.Context, now time.Time) (int, error) {
+    res, err := r.db.NewUpdate().Model((*models.Card)(nil)).
+        TableExpr("boards AS b").
+        Set("archived_at = ?", now).
+        Where("b.id = card.board_id").
+        Where("b.archive_done_after_days > 0").
+        Where("card.archived_at IS NULL").
+        Where("card.done_at < ?::timestamp - b.archive_done_after_days * INTERVAL '1 day'", now).
+        Exec(ctx)
+    if err != nil {
+        return 0, fmt.Errorf("archive done cards: %w", err)
+    }
+    n, _ := res.RowsAffected()
+    return int(n), nil
+}
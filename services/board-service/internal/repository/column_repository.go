@@ -0,0 +1,117 @@
+package repository
+
+import (
+    "context"
+    "database/sql"
+    "fmt"
+    "time"
+
+    "github.com/nexusflow/nexusflow/services/board-service/internal/models"
+    "github.com/uptrace/bun"
+)
+
+// CreateColumn adds a column after the last column of its board
+func (r *BoardRepository) CreateColumn(ctx context.Context, column *models.BoardColumn) error {
+    return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+        count, err := tx.NewSelect().Model((*models.BoardColumn)(nil)).
+            Where("board_id = ?", column.BoardID).
+            Count(ctx)
+        if err != nil {
+            return fmt.Errorf("count columns: %w", err)
+        }
+        column.ID = ""
+        column.Position = count
+        column.CreatedAt = time.Now()
+        column.UpdatedAt = time.Now()
+        if _, err := tx.NewInsert().Model(column).Returning("*").Exec(ctx); err != nil {
+            return fmt.Errorf("create column: %w", err)
+        }
+        return nil
+    })
+}
+
+// GetColumn gets a column, or nil if it does not exist
+func (r *BoardRepository) GetColumn(ctx context.Context, id string) (*models.BoardColumn, error) {
+    column := new(models.BoardColumn)
+    err := r.db.NewSelect().Model(column).Where("id = ?", id).Scan(ctx)
+    if err != nil {
+        if err == sql.ErrNoRows {
+            return nil, nil
+        }
+        return nil, fmt.Errorf("get column: %w", err)
+    }
+    return column, nil
+}
+
+// ListColumns lists the columns of a board from left to right
+func (r *BoardRepository) ListColumns(ctx context.Context, boardID string) ([]*models.BoardColumn, error) {
+    var columns []*models.BoardColumn
+    err := r.db.NewSelect().Model(&columns).
+        Where("board_id = ?", boardID).
+        Order("position ASC").
+        Scan(ctx)
+    if err != nil {
+        return nil, fmt.Errorf("list columns: %w", err)
+    }
+    return columns, nil
+}
+
+// UpdateColumn saves the name, statuses and WIP limits of a column
+func (r *BoardRepository) UpdateColumn(ctx context.Context, column *models.BoardColumn) error {
+    column.UpdatedAt = time.Now()
+    _, err := r.db.NewUpdate().Model(column).
+        Column("name", "status_ids", "min_wip", "max_wip", "updated_at").
+        WherePK().
+        Exec(ctx)
+    if err != nil {
+        return fmt.Errorf("update column: %w", err)
+    }
+    return nil
+}
+
+// DeleteColumn removes a column and closes the gap it leaves
+func (r *BoardRepository) DeleteColumn(ctx context.Context, column *models.BoardColumn) error {
+    return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+        if _, err := tx.NewDelete().Model((*models.BoardColumn)(nil)).Where("id = ?", column.ID).Exec(ctx); err != nil {
+            return fmt.Errorf("delete column: %w", err)
+        }
+        _, err := tx.NewUpdate().Model((*models.BoardColumn)(nil)).
+            Set("position = position - 1").
+            Where("board_id = ? AND position > ?", column.BoardID, column.Position).
+            Exec(ctx)
+        if err != nil {
+            return fmt.Errorf("shift columns: %w", err)
+        }
+        return nil
+    })
+}
+
+// ReorderColumns sets the position of each column to its index in columnIDs
+func (r *BoardRepository) ReorderColumns(ctx context.Context, boardID string, columnIDs []string) error {
+    return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+        for i, id := range columnIDs {
+            _, err := tx.NewUpdate().Model((*models.BoardColumn)(nil)).
+                Set("position = ?", i).
+                Set("updated_at = ?", time.Now()).
+                Where("id = ? AND board_id = ?", id, boardID).
+                Exec(ctx)
+            if err != nil {
+                return fmt.Errorf("reorder columns: %w", err)
+            }
+        }
+        return nil
+    })
+}
+
+// SetCardStatus records the workflow status of a card's issue
+func (r *BoardRepository) SetCardStatus(ctx context.Context, cardID, statusID string) error {
+    _, err := r.db.NewUpdate().Model((*models.Card)(nil)).
+        Set("status_id = ?", statusID).
+        Set("updated_at = ?", time.Now()).
+        Where("id = ?", cardID).
+        Exec(ctx)
+    if err != nil {
+        return fmt.Errorf("set card status: %w", err)
+    }
+    return nil
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+    "context"
+    "database/sql"
+    "fmt"
+    "time"
+
+    "github.com/nexusflow/nexusflow/services/board-service/internal/models"
+    "github.com/uptrace/bun"
+)
+
+// CreateQuickFilter adds a quick filter after the last one of its board
+func (r *BoardRepository) CreateQuickFilter(ctx context.Context, filter *models.QuickFilter) error {
+    return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+        count, err := tx.NewSelect().Model((*models.QuickFilter)(nil)).
+            Where("board_id = ?", filter.BoardID).
+            Count(ctx)
+        if err != nil {
+            return fmt.Errorf("count quick filters: %w", err)
+        }
+        filter.ID = ""
+        filter.Position = count
+        filter.CreatedAt = time.Now()
+        filter.UpdatedAt = time.Now()
+        if _, err := tx.NewInsert().Model(filter).Returning("*").Exec(ctx); err != nil {
+            return fmt.Errorf("create quick filter: %w", err)
+        }
+        return nil
+    })
+}
+
+// GetQuickFilter gets a quick filter, or nil if it does not exist
+func (r *BoardRepository) GetQuickFilter(ctx context.Context, id string) (*models.QuickFilter, error) {
+    filter := new(models.QuickFilter)
+    err := r.db.NewSelect().Model(filter).Where("id = ?", id).Scan(ctx)
+    if err != nil {
+        if err == sql.ErrNoRows {
+            return nil, nil
+        }
+        return nil, fmt.Errorf("get quick filter: %w", err)
+    }
+    return filter, nil
+}
+
+// ListQuickFilters lists the quick filters of a board in display order
+func (r *BoardRepository) ListQuickFilters(ctx context.Context, boardID string) ([]*models.QuickFilter, error) {
+    var filters []*models.QuickFilter
+    err := r.db.NewSelect().Model(&filters).
+        Where("board_id = ?", boardID).
+        Order("position ASC").
+        Scan(ctx)
+    if err != nil {
+        return nil, fmt.Errorf("list quick filters: %w", err)
+    }
+    return filters, nil
+}
+
+// UpdateQuickFilter saves the name and query of a quick filter
+func (r *BoardRepository) UpdateQuickFilter(ctx context.Context, filter *models.QuickFilter) error {
+    filter.UpdatedAt = time.Now()
+    _, err := r.db.NewUpdate().Model(filter).
+        Column("name", "query", "updated_at").
+        WherePK().
+        Exec(ctx)
+    if err != nil {
+        return fmt.Errorf("update quick filter: %w", err)
+    }
+    return nil
+}
+
+// DeleteQuickFilter removes a quick filter and closes the gap it leaves
+func (r *BoardRepository) DeleteQuickFilter(ctx context.Context, filter *models.QuickFilter) error {
+    return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+        if _, err := tx.NewDelete().Model((*models.QuickFilter)(nil)).Where("id = ?", filter.ID).Exec(ctx); err != nil {
+            return fmt.Errorf("delete quick filter: %w", err)
+        }
+        _, err := tx.NewUpdate().Model((*models.QuickFilter)(nil)).
+            Set("position = position - 1").
+            Where("board_id = ? AND position > ?", filter.BoardID, filter.Position).
+            Exec(ctx)
+        if err != nil {
+            return fmt.Errorf("shift quick filters: %w", err)
+        }
+        return nil
+    })
+}
@@ -8,33 +8,66 @@ import (
     "github.com/nexusflow/nexusflow/pkg/kafka"
     "github.com/nexusflow/nexusflow/pkg/logger"
     pb "github.com/nexusflow/nexusflow/pkg/proto/board/v1"
+    issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+    workflowpb "github.com/nexusflow/nexusflow/pkg/proto/workflow/v1"
     "github.com/nexusflow/nexusflow/services/board-service/internal/models"
     "github.com/nexusflow/nexusflow/services/board-service/internal/repository"
+    "google.golang.org/grpc"
+    "google.golang.org/grpc/credentials/insecure"
 )
 
 // BoardService handles business logic for boards and cards
 type BoardService struct {
-    repo     *repository.BoardRepository
-    producer *kafka.Producer
-    log      *logger.Logger
+    repo           *repository.BoardRepository
+    producer       *kafka.Producer
+    log            *logger.Logger
+    issueClient    issuepb.IssueServiceClient
+    workflowClient workflowpb.WorkflowServiceClient
 }
 
-func NewBoardService(repo *repository.BoardRepository, producer *kafka.Producer, log *logger.Logger) *BoardService {
-    return &BoardService{repo: repo, producer: producer, log: log}
+func NewBoardService(repo *repository.BoardRepository, producer *kafka.Producer, log *logger.Logger, issueServiceAddr, workflowServiceAddr string) (*BoardService, error) {
+    issueConn, err := grpc.Dial(issueServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+    if err != nil {
+        return nil, fmt.Errorf("failed to connect to issue service: %w", err)
+    }
+    workflowConn, err := grpc.Dial(workflowServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+    if err != nil {
+        return nil, fmt.Errorf("failed to connect to workflow service: %w", err)
+    }
+
+    return &BoardService{
+        repo:           repo,
+        producer:       producer,
+        log:            log,
+        issueClient:    issuepb.NewIssueServiceClient(issueConn),
+        workflowClient: workflowpb.NewWorkflowServiceClient(workflowConn),
+    }, nil
 }
 
 // CreateBoard creates a new board
 func (s *BoardService) CreateBoard(ctx context.Context, input *pb.CreateBoardRequest) (*models.Board, error) {
     b := &models.Board{
-        ProjectID:   input.ProjectId,
-        Name:        input.Name,
-        Description: input.Description,
+        ProjectID:            input.ProjectId,
+        Name:                 input.Name,
+        Description:          input.Description,
+        ArchiveDoneAfterDays: int(input.ArchiveDoneAfterDays),
+    }
+    if b.ArchiveDoneAfterDays < 0 {
+        return nil, fmt.Errorf("archive_done_after_days cannot be negative")
+    }
+    if input.Filter != nil {
+        filter, err := boardFilterFromProto(input.Filter)
+        if err != nil {
+            return nil, err
+        }
+        b.Filter = filter
     }
     if err := s.repo.CreateBoard(ctx, b); err != nil {
         return nil, fmt.Errorf("create board: %w", err)
     }
     // publish event
     s.publishEvent("board.created", b.ProjectID, map[string]interface{}{"board_id": b.ID, "name": b.Name})
+    s.syncBoardAfterFilterChange(ctx, b)
     return b, nil
 }
 
@@ -57,10 +90,35 @@ func (s *BoardService) UpdateBoard(ctx context.Context, input *pb.UpdateBoardReq
     if input.Description != "" {
         b.Description = input.Description
     }
+    if input.Swimlanes != nil {
+        if b.Swimlanes, err = swimlaneConfigFromProto(input.Swimlanes); err != nil {
+            return nil, err
+        }
+    }
+    filterChanged := false
+    switch {
+    case input.ClearFilter:
+        // Existing cards stay and are managed by hand from now on
+        b.Filter = nil
+    case input.Filter != nil:
+        if b.Filter, err = boardFilterFromProto(input.Filter); err != nil {
+            return nil, err
+        }
+        filterChanged = true
+    }
+    if input.ArchiveDoneAfterDays != nil {
+        if *input.ArchiveDoneAfterDays < 0 {
+            return nil, fmt.Errorf("archive_done_after_days cannot be negative")
+        }
+        b.ArchiveDoneAfterDays = int(*input.ArchiveDoneAfterDays)
+    }
     if err := s.repo.UpdateBoard(ctx, b); err != nil {
         return nil, fmt.Errorf("update board: %w", err)
     }
     s.publishEvent("board.updated", b.ProjectID, map[string]interface{}{"board_id": b.ID})
+    if filterChanged {
+        s.syncBoardAfterFilterChange(ctx, b)
+    }
     return b, nil
 }
 
@@ -79,19 +137,40 @@ func (s *BoardService) DeleteBoard(ctx context.Context, id string) error {
 
 // Card operations
 func (s *BoardService) AddCard(ctx context.Context, input *pb.AddCardRequest) (*models.Card, error) {
+    if err := s.checkManualBoard(ctx, input.BoardId); err != nil {
+        return nil, err
+    }
     c := &models.Card{
         BoardID:  input.BoardId,
         IssueID:  input.IssueId,
         Position: int(input.Position),
     }
+    issueResp, err := s.issueClient.GetIssue(ctx, &issuepb.GetIssueRequest{Id: input.IssueId})
+    if err != nil {
+        return nil, fmt.Errorf("get issue: %w", err)
+    }
+    c.StatusID = issueResp.Issue.StatusId
     if err := s.repo.AddCard(ctx, c); err != nil {
         return nil, fmt.Errorf("add card: %w", err)
     }
+    if err := s.resolveCardColumns(ctx, c.BoardID, []*models.Card{c}); err != nil {
+        return nil, err
+    }
     s.publishEvent("card.added", "", map[string]interface{}{"card_id": c.ID, "board_id": c.BoardID, "issue_id": c.IssueID})
     return c, nil
 }
 
+// MoveCard moves a card to a new position and, when a column is given, transitions its issue into the column
 func (s *BoardService) MoveCard(ctx context.Context, input *pb.MoveCardRequest) (*models.Card, error) {
+    if input.ColumnId != "" {
+        c, err := s.repo.GetCard(ctx, input.CardId)
+        if err != nil {
+            return nil, err
+        }
+        if err := s.moveCardToColumn(ctx, c, input.ColumnId, input.UserId); err != nil {
+            return nil, err
+        }
+    }
     if err := s.repo.MoveCard(ctx, input.CardId, int(input.NewPosition)); err != nil {
         return nil, fmt.Errorf("move card: %w", err)
     }
@@ -99,20 +178,64 @@ func (s *BoardService) MoveCard(ctx context.Context, input *pb.MoveCardRequest)
     if err != nil {
         return nil, err
     }
-    s.publishEvent("card.moved", "", map[string]interface{}{"card_id": c.ID, "new_position": c.Position})
+    if err := s.resolveCardColumns(ctx, c.BoardID, []*models.Card{c}); err != nil {
+        return nil, err
+    }
+    s.publishEvent("card.moved", "", map[string]interface{}{
+        "card_id":      c.ID,
+        "board_id":     c.BoardID,
+        "issue_id":     c.IssueID,
+        "new_position": c.Position,
+        "column_id":    c.ColumnID,
+        "status_id":    c.StatusID,
+        "moved_by":     input.UserId,
+    })
     return c, nil
 }
 
 func (s *BoardService) DeleteCard(ctx context.Context, cardID string) error {
+    c, err := s.repo.GetCard(ctx, cardID)
+    if err != nil {
+        return err
+    }
+    if err := s.checkManualBoard(ctx, c.BoardID); err != nil {
+        return err
+    }
     if err := s.repo.DeleteCard(ctx, cardID); err != nil {
         return fmt.Errorf("delete card: %w", err)
     }
-    s.publishEvent("card.deleted", "", map[string]interface{}{"card_id": cardID})
+    s.publishEvent("card.deleted", "", map[string]interface{}{"card_id": cardID, "board_id": c.BoardID, "issue_id": c.IssueID})
     return nil
 }
 
-func (s *BoardService) ListCards(ctx context.Context, boardID string) ([]*models.Card, error) {
-    return s.repo.ListCardsByBoard(ctx, boardID)
+func (s *BoardService) ListCards(ctx context.Context, boardID string, includeArchived bool) ([]*models.Card, error) {
+    cards, err := s.repo.ListCardsByBoard(ctx, boardID)
+    if err != nil {
+        return nil, err
+    }
+    if includeArchived {
+        archived, err := s.repo.ListArchivedCards(ctx, boardID)
+        if err != nil {
+            return nil, err
+        }
+        cards = append(cards, archived...)
+    }
+    if err := s.resolveCardColumns(ctx, boardID, cards); err != nil {
+        return nil, err
+    }
+    return cards, nil
+}
+
+// checkManualBoard rejects hand edits to the cards of boards that follow a filter
+func (s *BoardService) checkManualBoard(ctx context.Context, boardID string) error {
+    b, err := s.repo.GetBoard(ctx, boardID)
+    if err != nil {
+        return err
+    }
+    if b.Filter != nil {
+        return fmt.Errorf("cards of board %s follow its filter and cannot be added or removed by hand", boardID)
+    }
+    return nil
 }
 
 func (s *BoardService) publishEvent(eventType, projectID string, payload map[string]interface{}) {
@@ -0,0 +1,256 @@
+package service
+
+import (
+    "context"
+    "fmt"
+    "strings"
+    "time"
+
+    commonpb "github.com/nexusflow/nexusflow/pkg/proto/common/v1"
+    pb "github.com/nexusflow/nexusflow/pkg/proto/board/v1"
+    issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+    workflowpb "github.com/nexusflow/nexusflow/pkg/proto/workflow/v1"
+    "github.com/nexusflow/nexusflow/pkg/workflow"
+    "github.com/nexusflow/nexusflow/services/board-service/internal/models"
+)
+
+// boardMatcher decides whether an issue has a card on a filtered board
+type boardMatcher struct {
+    board *models.Board
+    query issueQuery
+}
+
+func newBoardMatcher(board *models.Board) (*boardMatcher, error) {
+    m := &boardMatcher{board: board}
+    if board.Filter != nil && board.Filter.Query != "" {
+        q, err := parseQuery(board.Filter.Query)
+        if err != nil {
+            return nil, fmt.Errorf("board filter: %w", err)
+        }
+        m.query = q
+    }
+    return m, nil
+}
+
+func (m *boardMatcher) match(issue *issuepb.Issue) bool {
+    f := m.board.Filter
+    if f == nil || issue.ProjectId != m.board.ProjectID {
+        return false
+    }
+    if len(f.IssueTypes) > 0 && !containsString(f.IssueTypes, IssueTypeName(issue.Type)) {
+        return false
+    }
+    if len(f.LabelIDs) > 0 {
+        found := false
+        for _, id := range issue.LabelIds {
+            if containsString(f.LabelIDs, id) {
+                found = true
+                break
+            }
+        }
+        if !found {
+            return false
+        }
+    }
+    // Board filters have no viewer, so currentUser() matches nobody
+    return m.query == nil || m.query.match(issue, "")
+}
+
+// boardFilterFromProto validates a board filter
+func boardFilterFromProto(f *pb.BoardFilter) (*models.BoardFilter, error) {
+    filter := &models.BoardFilter{
+        IssueTypes: uniqueStrings(f.IssueTypes),
+        LabelIDs:   uniqueStrings(f.LabelIds),
+        Query:      f.Query,
+    }
+    for _, t := range filter.IssueTypes {
+        v, ok := issuepb.IssueType_value["ISSUE_TYPE_"+strings.ToUpper(t)]
+        if !ok || v == int32(issuepb.IssueType_ISSUE_TYPE_UNSPECIFIED) || t != strings.ToLower(t) {
+            return nil, fmt.Errorf("unknown issue type %q", t)
+        }
+    }
+    if filter.Query != "" {
+        if _, err := parseQuery(filter.Query); err != nil {
+            return nil, fmt.Errorf("board filter: %w", err)
+        }
+    }
+    return filter, nil
+}
+
+// SyncBoardCards brings the cards of a filtered board in line with the issues
+// its filter matches. New cards go after the existing ones in backlog order.
+func (s *BoardService) SyncBoardCards(ctx context.Context, boardID string) (added, removed int, err error) {
+    board, err := s.repo.GetBoard(ctx, boardID)
+    if err != nil {
+        return 0, 0, err
+    }
+    if board.Filter == nil {
+        return 0, 0, fmt.Errorf("board %s has no filter", boardID)
+    }
+    matcher, err := newBoardMatcher(board)
+    if err != nil {
+        return 0, 0, err
+    }
+    categories, err := workflow.StatusCategories(ctx, s.workflowClient, board.ProjectID)
+    if err != nil {
+        return 0, 0, err
+    }
+
+    req := &issuepb.ListIssuesRequest{
+        ProjectId:  board.ProjectID,
+        LabelIds:   board.Filter.LabelIDs,
+        Pagination: &commonpb.PaginationRequest{PageSize: 100, SortBy: "rank", SortOrder: "asc"},
+    }
+    var matching []*issuepb.Issue
+    for {
+        resp, err := s.issueClient.ListIssues(ctx, req)
+        if err != nil {
+            return 0, 0, fmt.Errorf("list issues: %w", err)
+        }
+        for _, issue := range resp.Issues {
+            if matcher.match(issue) {
+                matching = append(matching, issue)
+            }
+        }
+        if resp.Pagination == nil || !resp.Pagination.HasNext {
+            break
+        }
+        req.Pagination.Cursor = resp.Pagination.NextCursor
+    }
+
+    cards, err := s.repo.ListAllCards(ctx, board.ID)
+    if err != nil {
+        return 0, 0, err
+    }
+    matched := make(map[string]bool, len(matching))
+    for _, issue := range matching {
+        matched[issue.Id] = true
+    }
+    onBoard := make(map[string]bool, len(cards))
+    var stale []string
+    for _, c := range cards {
+        onBoard[c.IssueID] = true
+        // Trashed issues keep their hidden cards until they are restored or purged
+        if !matched[c.IssueID] && c.IssueDeletedAt.IsZero() {
+            stale = append(stale, c.IssueID)
+        }
+    }
+    var missing []*models.Card
+    for _, issue := range matching {
+        if !onBoard[issue.Id] {
+            missing = append(missing, newIssueCard(issue, categories))
+        }
+    }
+
+    if removed, err = s.repo.DeleteBoardCards(ctx, board.ID, stale); err != nil {
+        return 0, 0, err
+    }
+    if added, err = s.repo.AddCards(ctx, board.ID, missing); err != nil {
+        return 0, removed, err
+    }
+    s.publishEvent("board.cards_synced", board.ProjectID, map[string]interface{}{"board_id": board.ID, "added": added, "removed": removed})
+    return added, removed, nil
+}
+
+// syncBoardAfterFilterChange fills a board whose filter was just set. Failures are
+// logged rather than returned since the board itself was saved; SyncBoardCards retries.
+func (s *BoardService) syncBoardAfterFilterChange(ctx context.Context, board *models.Board) {
+    if board.Filter == nil {
+        return
+    }
+    if _, _, err := s.SyncBoardCards(ctx, board.ID); err != nil {
+        s.log.Sugar().Errorw("Failed to sync board cards", "error", err, "board_id", board.ID)
+    }
+}
+
+// syncIssueCards records the state of changed issues on their cards and adds or
+// removes their cards on the filtered boards of their projects
+func (s *BoardService) syncIssueCards(ctx context.Context, issueIDs []string) error {
+    issues, err := s.batchGetIssues(ctx, uniqueStrings(issueIDs))
+    if err != nil {
+        return err
+    }
+    byProject := make(map[string][]*issuepb.Issue)
+    for _, issue := range issues {
+        byProject[issue.ProjectId] = append(byProject[issue.ProjectId], issue)
+    }
+
+    now := time.Now()
+    for projectID, projectIssues := range byProject {
+        categories, err := workflow.StatusCategories(ctx, s.workflowClient, projectID)
+        if err != nil {
+            return err
+        }
+        for _, issue := range projectIssues {
+            done := categories[issue.StatusId] == workflowpb.StatusCategory_STATUS_CATEGORY_DONE
+            if err := s.repo.SetCardsIssueState(ctx, issue.Id, issue.StatusId, done, now); err != nil {
+                return err
+            }
+        }
+
+        boards, err := s.repo.ListFilteredBoards(ctx, projectID)
+        if err != nil {
+            return err
+        }
+        for _, board := range boards {
+            matcher, err := newBoardMatcher(board)
+            if err != nil {
+                s.log.Sugar().Errorw("Skipping board with invalid filter", "error", err, "board_id", board.ID)
+                continue
+            }
+            var add []*models.Card
+            var remove []string
+            for _, issue := range projectIssues {
+                if matcher.match(issue) {
+                    add = append(add, newIssueCard(issue, categories))
+                } else {
+                    remove = append(remove, issue.Id)
+                }
+            }
+            added, err := s.repo.AddCards(ctx, board.ID, add)
+            if err != nil {
+                return err
+            }
+            removed, err := s.repo.DeleteBoardCards(ctx, board.ID, remove)
+            if err != nil {
+                return err
+            }
+            if added > 0 || removed > 0 {
+                s.publishEvent("board.cards_synced", board.ProjectID, map[string]interface{}{"board_id": board.ID, "added": added, "removed": removed})
+            }
+        }
+    }
+    return nil
+}
+
+// newIssueCard builds the card of an issue joining a filtered board
+func newIssueCard(issue *issuepb.Issue, categories map[string]workflowpb.StatusCategory) *models.Card {
+    c := &models.Card{IssueID: issue.Id, StatusID: issue.StatusId}
+    if categories[issue.StatusId] == workflowpb.StatusCategory_STATUS_CATEGORY_DONE {
+        // The last update is the best guess at when an already done issue was finished
+        c.DoneAt = issue.UpdatedAt.AsTime()
+    }
+    return c
+}
+
+// RunCardArchiver periodically archives the cards that have been done for longer
+// than their board's archive period
+func (s *BoardService) RunCardArchiver(ctx context.Context, interval time.Duration) {
+    ticker := time.NewTicker(interval)
+    defer ticker.Stop()
+
+    for {
+        n, err := s.repo.ArchiveDoneCards(ctx, time.Now())
+        if err != nil {
+            s.log.Sugar().Errorw("Failed to archive done cards", "error", err)
+        } else if n > 0 {
+            s.log.Sugar().Infow("Archived done cards", "count", n)
+        }
+
+        select {
+        case <-ctx.Done():
+            return
+        case <-ticker.C:
+        }
+    }
+}
@@ -0,0 +1,366 @@
+package service
+
+import (
+    "context"
+    "database/sql"
+    "errors"
+    "fmt"
+
+    pb "github.com/nexusflow/nexusflow/pkg/proto/board/v1"
+    issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+    workflowpb "github.com/nexusflow/nexusflow/pkg/proto/workflow/v1"
+    "github.com/nexusflow/nexusflow/services/board-service/internal/models"
+)
+
+// ColumnLoad is a board column with the cards it holds measured against its WIP limits
+type ColumnLoad struct {
+    Column    *models.BoardColumn
+    CardCount int
+    Violation pb.WipViolation
+}
+
+// CreateColumn adds a column mapped to workflow statuses at the right end of a board
+func (s *BoardService) CreateColumn(ctx context.Context, input *pb.CreateBoardColumnRequest) (*ColumnLoad, error) {
+    board, err := s.getBoard(ctx, input.BoardId)
+    if err != nil {
+        return nil, err
+    }
+    column := &models.BoardColumn{
+        BoardID:   board.ID,
+        Name:      input.Name,
+        StatusIDs: uniqueStrings(input.StatusIds),
+        MinWIP:    int(input.MinWip),
+        MaxWIP:    int(input.MaxWip),
+    }
+    if err := s.validateColumn(ctx, board, column); err != nil {
+        return nil, err
+    }
+    if err := s.repo.CreateColumn(ctx, column); err != nil {
+        return nil, err
+    }
+    s.publishEvent("board.column_created", board.ProjectID, map[string]interface{}{"board_id": board.ID, "column_id": column.ID, "name": column.Name})
+    return s.columnLoad(ctx, column)
+}
+
+// UpdateColumn changes the name, statuses or WIP limits of a column
+func (s *BoardService) UpdateColumn(ctx context.Context, input *pb.UpdateBoardColumnRequest) (*ColumnLoad, error) {
+    column, err := s.getColumn(ctx, input.Id)
+    if err != nil {
+        return nil, err
+    }
+    board, err := s.getBoard(ctx, column.BoardID)
+    if err != nil {
+        return nil, err
+    }
+    if input.Name != nil {
+        column.Name = *input.Name
+    }
+    if len(input.StatusIds) > 0 {
+        column.StatusIDs = uniqueStrings(input.StatusIds)
+    }
+    if input.MinWip != nil {
+        column.MinWIP = int(*input.MinWip)
+    }
+    if input.MaxWip != nil {
+        column.MaxWIP = int(*input.MaxWip)
+    }
+    if err := s.validateColumn(ctx, board, column); err != nil {
+        return nil, err
+    }
+    if err := s.repo.UpdateColumn(ctx, column); err != nil {
+        return nil, err
+    }
+    s.publishEvent("board.column_updated", board.ProjectID, map[string]interface{}{"board_id": board.ID, "column_id": column.ID})
+    return s.columnLoad(ctx, column)
+}
+
+// DeleteColumn removes a column; its cards stay on the board without a column
+func (s *BoardService) DeleteColumn(ctx context.Context, id string) error {
+    column, err := s.getColumn(ctx, id)
+    if err != nil {
+        return err
+    }
+    board, err := s.getBoard(ctx, column.BoardID)
+    if err != nil {
+        return err
+    }
+    if err := s.repo.DeleteColumn(ctx, column); err != nil {
+        return err
+    }
+    s.publishEvent("board.column_deleted", board.ProjectID, map[string]interface{}{"board_id": board.ID, "column_id": column.ID})
+    return nil
+}
+
+// ListColumns lists the columns of a board with their card counts and WIP violations
+func (s *BoardService) ListColumns(ctx context.Context, boardID string) ([]*ColumnLoad, error) {
+    columns, err := s.repo.ListColumns(ctx, boardID)
+    if err != nil {
+        return nil, err
+    }
+    cards, err := s.repo.ListCardsByBoard(ctx, boardID)
+    if err != nil {
+        return nil, err
+    }
+    if err := s.refreshCardStatuses(ctx, cards); err != nil {
+        return nil, err
+    }
+    assignColumns(columns, cards)
+    return columnLoads(columns, cards), nil
+}
+
+// ReorderColumns puts the columns of a board in the given order
+func (s *BoardService) ReorderColumns(ctx context.Context, boardID string, columnIDs []string) ([]*ColumnLoad, error) {
+    columns, err := s.repo.ListColumns(ctx, boardID)
+    if err != nil {
+        return nil, err
+    }
+    columnIDs = uniqueStrings(columnIDs)
+    if len(columnIDs) != len(columns) {
+        return nil, fmt.Errorf("%w: column order must list all %d columns of the board", ErrValidation, len(columns))
+    }
+    existing := make(map[string]bool, len(columns))
+    for _, c := range columns {
+        existing[c.ID] = true
+    }
+    for _, id := range columnIDs {
+        if !existing[id] {
+            return nil, fmt.Errorf("%w: column %s is not on board %s", ErrValidation, id, boardID)
+        }
+    }
+    if err := s.repo.ReorderColumns(ctx, boardID, columnIDs); err != nil {
+        return nil, err
+    }
+    s.publishEvent("board.columns_reordered", "", map[string]interface{}{"board_id": boardID, "column_ids": columnIDs})
+    return s.ListColumns(ctx, boardID)
+}
+
+// getBoard gets a board, which must exist
+func (s *BoardService) getBoard(ctx context.Context, id string) (*models.Board, error) {
+    board, err := s.repo.GetBoard(ctx, id)
+    if errors.Is(err, sql.ErrNoRows) {
+        return nil, fmt.Errorf("%w: board %s", ErrNotFound, id)
+    }
+    return board, err
+}
+
+func (s *BoardService) getColumn(ctx context.Context, id string) (*models.BoardColumn, error) {
+    column, err := s.repo.GetColumn(ctx, id)
+    if err != nil {
+        return nil, err
+    }
+    if column == nil {
+        return nil, fmt.Errorf("%w: column %s", ErrNotFound, id)
+    }
+    return column, nil
+}
+
+// validateColumn checks a column's name, WIP limits and that its statuses belong to the
+// project's workflow and are not held by another column of the board
+func (s *BoardService) validateColumn(ctx context.Context, board *models.Board, column *models.BoardColumn) error {
+    if column.Name == "" {
+        return fmt.Errorf("%w: column name is required", ErrValidation)
+    }
+    if len(column.StatusIDs) == 0 {
+        return fmt.Errorf("%w: column must be mapped to at least one status", ErrValidation)
+    }
+    if column.MinWIP < 0 || column.MaxWIP < 0 {
+        return fmt.Errorf("%w: WIP limits cannot be negative", ErrValidation)
+    }
+    if column.MaxWIP > 0 && column.MinWIP > column.MaxWIP {
+        return fmt.Errorf("%w: minimum WIP %d is above maximum WIP %d", ErrValidation, column.MinWIP, column.MaxWIP)
+    }
+
+    resp, err := s.workflowClient.ListWorkflows(ctx, &workflowpb.ListWorkflowsRequest{ProjectId: board.ProjectID})
+    if err != nil {
+        return fmt.Errorf("list workflows: %w", err)
+    }
+    statuses := make(map[string]bool)
+    for _, w := range resp.Workflows {
+        for _, st := range w.Statuses {
+            statuses[st.Id] = true
+        }
+    }
+    for _, id := range column.StatusIDs {
+        if !statuses[id] {
+            return fmt.Errorf("%w: status %s is not in the project's workflow", ErrValidation, id)
+        }
+    }
+
+    columns, err := s.repo.ListColumns(ctx, board.ID)
+    if err != nil {
+        return err
+    }
+    for _, other := range columns {
+        if other.ID == column.ID {
+            continue
+        }
+        for _, id := range other.StatusIDs {
+            if containsString(column.StatusIDs, id) {
+                return fmt.Errorf("%w: status %s is already mapped to column %q", ErrValidation, id, other.Name)
+            }
+        }
+    }
+    return nil
+}
+
+// moveCardToColumn executes the workflow transition taking a card's issue into one of the column's statuses
+func (s *BoardService) moveCardToColumn(ctx context.Context, card *models.Card, columnID, userID string) error {
+    column, err := s.getColumn(ctx, columnID)
+    if err != nil {
+        return err
+    }
+    if column.BoardID != card.BoardID {
+        return fmt.Errorf("%w: column %s is not on the card's board", ErrValidation, columnID)
+    }
+
+    issueResp, err := s.issueClient.GetIssue(ctx, &issuepb.GetIssueRequest{Id: card.IssueID})
+    if err != nil {
+        return fmt.Errorf("get issue: %w", err)
+    }
+    if containsString(column.StatusIDs, issueResp.Issue.StatusId) {
+        // Already in the column, so only the position changes
+        if card.StatusID != issueResp.Issue.StatusId {
+            return s.repo.SetCardStatus(ctx, card.ID, issueResp.Issue.StatusId)
+        }
+        return nil
+    }
+
+    transResp, err := s.workflowClient.GetAvailableTransitions(ctx, &workflowpb.GetAvailableTransitionsRequest{
+        IssueId: card.IssueID,
+        UserId:  userID,
+    })
+    if err != nil {
+        return fmt.Errorf("get available transitions: %w", err)
+    }
+    var transition *workflowpb.Transition
+    for _, t := range transResp.Transitions {
+        if containsString(column.StatusIDs, t.ToStatusId) {
+            transition = t
+            break
+        }
+    }
+    if transition == nil {
+        return fmt.Errorf("%w: no transition leads from the issue's status into column %q", ErrValidation, column.Name)
+    }
+
+    _, err = s.workflowClient.ExecuteTransition(ctx, &workflowpb.ExecuteTransitionRequest{
+        IssueId:      card.IssueID,
+        TransitionId: transition.Id,
+        UserId:       userID,
+    })
+    if err != nil {
+        return fmt.Errorf("execute transition: %w", err)
+    }
+    return s.repo.SetCardStatus(ctx, card.ID, transition.ToStatusId)
+}
+
+// resolveCardColumns sets the column of each card from its issue's status
+func (s *BoardService) resolveCardColumns(ctx context.Context, boardID string, cards []*models.Card) error {
+    if err := s.refreshCardStatuses(ctx, cards); err != nil {
+        return err
+    }
+    columns, err := s.repo.ListColumns(ctx, boardID)
+    if err != nil {
+        return err
+    }
+    assignColumns(columns, cards)
+    return nil
+}
+
+// refreshCardStatuses fetches the status of cards added before statuses were tracked
+func (s *BoardService) refreshCardStatuses(ctx context.Context, cards []*models.Card) error {
+    var issueIDs []string
+    for _, c := range cards {
+        if c.StatusID == "" {
+            issueIDs = append(issueIDs, c.IssueID)
+        }
+    }
+    if len(issueIDs) == 0 {
+        return nil
+    }
+    issues, err := s.batchGetIssues(ctx, issueIDs)
+    if err != nil {
+        return err
+    }
+    for _, c := range cards {
+        issue, ok := issues[c.IssueID]
+        if c.StatusID != "" || !ok {
+            // Trashed issues are hidden by the issue events instead
+            continue
+        }
+        c.StatusID = issue.StatusId
+        if err := s.repo.SetCardStatus(ctx, c.ID, c.StatusID); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// columnLoad measures a single column against its WIP limits
+func (s *BoardService) columnLoad(ctx context.Context, column *models.BoardColumn) (*ColumnLoad, error) {
+    cards, err := s.repo.ListCardsByBoard(ctx, column.BoardID)
+    if err != nil {
+        return nil, err
+    }
+    if err := s.refreshCardStatuses(ctx, cards); err != nil {
+        return nil, err
+    }
+    assignColumns([]*models.BoardColumn{column}, cards)
+    return columnLoads([]*models.BoardColumn{column}, cards)[0], nil
+}
+
+// assignColumns sets the column of each card whose status a column holds
+func assignColumns(columns []*models.BoardColumn, cards []*models.Card) {
+    byStatus := make(map[string]string)
+    for _, col := range columns {
+        for _, id := range col.StatusIDs {
+            byStatus[id] = col.ID
+        }
+    }
+    for _, c := range cards {
+        c.ColumnID = byStatus[c.StatusID]
+    }
+}
+
+// columnLoads counts the cards in each column and flags the columns outside their WIP limits
+func columnLoads(columns []*models.BoardColumn, cards []*models.Card) []*ColumnLoad {
+    counts := make(map[string]int)
+    for _, c := range cards {
+        if c.ColumnID != "" {
+            counts[c.ColumnID]++
+        }
+    }
+    loads := make([]*ColumnLoad, 0, len(columns))
+    for _, col := range columns {
+        load := &ColumnLoad{Column: col, CardCount: counts[col.ID]}
+        switch {
+        case col.MaxWIP > 0 && load.CardCount > col.MaxWIP:
+            load.Violation = pb.WipViolation_WIP_VIOLATION_OVER_MAX
+        case col.MinWIP > 0 && load.CardCount < col.MinWIP:
+            load.Violation = pb.WipViolation_WIP_VIOLATION_UNDER_MIN
+        }
+        loads = append(loads, load)
+    }
+    return loads
+}
+
+func uniqueStrings(values []string) []string {
+    seen := make(map[string]bool, len(values))
+    result := make([]string, 0, len(values))
+    for _, v := range values {
+        if v != "" && !seen[v] {
+            seen[v] = true
+            result = append(result, v)
+        }
+    }
+    return result
+}
+
+func containsString(values []string, value string) bool {
+    for _, v := range values {
+        if v == value {
+            return true
+        }
+    }
+    return false
+}
@@ -0,0 +1,10 @@
+package service
+
+import "errors"
+
+var (
+    // ErrValidation is returned when input fails validation
+    ErrValidation = errors.New("validation failed")
+    // ErrNotFound is returned when a referenced entity does not exist
+    ErrNotFound = errors.New("not found")
+)
@@ -0,0 +1,62 @@
+package service
+
+import (
+    "context"
+    "time"
+
+    "github.com/nexusflow/nexusflow/pkg/kafka"
+)
+
+// HandleIssueEvent keeps cards in step with issue statuses, the filters of
+// filtered boards and issues moving in and out of the trash
+func (s *BoardService) HandleIssueEvent(ctx context.Context, event kafka.Event) error {
+    if changed := changedIssueIDs(event); len(changed) > 0 {
+        if err := s.syncIssueCards(ctx, changed); err != nil {
+            s.log.Sugar().Errorw("Failed to sync cards with issues", "error", err, "type", event.Type)
+            return err
+        }
+    }
+
+    var issueIDs []string
+    switch event.Type {
+    case "issue.deleted", "issue.restored", "issue.purged":
+        issueIDs = event.PayloadStrings("issue_ids")
+    case "issue.bulk_updated":
+        issueIDs = event.PayloadStrings("deleted_issue_ids")
+    }
+    if len(issueIDs) == 0 {
+        return nil
+    }
+
+    deletedAt := event.Timestamp
+    if deletedAt.IsZero() {
+        deletedAt = time.Now()
+    }
+
+    var err error
+    switch event.Type {
+    case "issue.deleted", "issue.bulk_updated":
+        err = s.repo.SetCardsIssueDeleted(ctx, issueIDs, deletedAt)
+    case "issue.restored":
+        err = s.repo.SetCardsIssueDeleted(ctx, issueIDs, time.Time{})
+    case "issue.purged":
+        err = s.repo.DeleteCardsByIssues(ctx, issueIDs)
+    }
+    if err != nil {
+        s.log.Sugar().Errorw("Failed to apply issue event to cards", "error", err, "type", event.Type)
+    }
+    return err
+}
+
+// changedIssueIDs lists the issues whose state or board membership an event may have changed
+func changedIssueIDs(event kafka.Event) []string {
+    switch event.Type {
+    case "issue.created", "issue.updated":
+        if id, _ := event.Payload["issue_id"].(string); id != "" {
+            return []string{id}
+        }
+    case "issue.restored", "issue.bulk_updated":
+        return event.PayloadStrings("issue_ids")
+    }
+    return nil
+}
@@ -0,0 +1,475 @@
+package service
+
+import (
+    "fmt"
+    "strconv"
+    "strings"
+    "time"
+
+    issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+    "google.golang.org/protobuf/types/known/anypb"
+    "google.golang.org/protobuf/types/known/structpb"
+    "google.golang.org/protobuf/types/known/timestamppb"
+    "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// issueQuery is a parsed quick filter or swimlane query. Queries are small
+// boolean expressions over issue fields, for example
+//
+//    assignee = currentUser() AND priority IN (high, highest)
+//    NOT (epic IS EMPTY OR summary ~ "spike")
+//    cf[<field id>] != "Backend"
+//
+// and are evaluated in memory against the issues of a board's cards.
+type issueQuery interface {
+    match(issue *issuepb.Issue, userID string) bool
+}
+
+// queryFields are the issue fields a query can refer to, besides cf[<id>]
+var queryFields = map[string]string{
+    "status":     "status",
+    "assignee":   "assignee",
+    "reporter":   "reporter",
+    "priority":   "priority",
+    "type":       "type",
+    "label":      "label",
+    "labels":     "label",
+    "component":  "component",
+    "components": "component",
+    "sprint":     "sprint",
+    "epic":       "parent",
+    "parent":     "parent",
+    "key":        "key",
+    "summary":    "summary",
+}
+
+type andQuery []issueQuery
+
+func (q andQuery) match(issue *issuepb.Issue, userID string) bool {
+    for _, sub := range q {
+        if !sub.match(issue, userID) {
+            return false
+        }
+    }
+    return true
+}
+
+type orQuery []issueQuery
+
+func (q orQuery) match(issue *issuepb.Issue, userID string) bool {
+    for _, sub := range q {
+        if sub.match(issue, userID) {
+            return true
+        }
+    }
+    return false
+}
+
+type notQuery struct{ sub issueQuery }
+
+func (q notQuery) match(issue *issuepb.Issue, userID string) bool {
+    return !q.sub.match(issue, userID)
+}
+
+// queryValue is a literal, or the user viewing the board for currentUser()
+type queryValue struct {
+    text        string
+    currentUser bool
+}
+
+func (v queryValue) resolve(userID string) (string, bool) {
+    if v.currentUser {
+        return userID, userID != ""
+    }
+    return v.text, true
+}
+
+// clauseQuery compares one field of an issue
+type clauseQuery struct {
+    field   string
+    fieldID string // Custom field ID for cf[<id>]
+    op      string // "=", "!=", "~", "!~", "in", "not in", "empty" or "not empty"
+    values  []queryValue
+}
+
+func (q *clauseQuery) match(issue *issuepb.Issue, userID string) bool {
+    actual := issueFieldValues(issue, q.field, q.fieldID)
+    switch q.op {
+    case "empty":
+        return len(actual) == 0
+    case "not empty":
+        return len(actual) > 0
+    case "!=", "not in":
+        return !q.matchValues(actual, userID, false)
+    case "!~":
+        return !q.matchValues(actual, userID, true)
+    case "~":
+        return q.matchValues(actual, userID, true)
+    default:
+        return q.matchValues(actual, userID, false)
+    }
+}
+
+// matchValues reports whether any actual value equals, or with contains
+// holds, any of the clause's values, ignoring case
+func (q *clauseQuery) matchValues(actual []string, userID string, contains bool) bool {
+    for _, v := range q.values {
+        want, ok := v.resolve(userID)
+        if !ok {
+            continue
+        }
+        for _, a := range actual {
+            if contains && strings.Contains(strings.ToLower(a), strings.ToLower(want)) {
+                return true
+            }
+            if !contains && strings.EqualFold(a, want) {
+                return true
+            }
+        }
+    }
+    return false
+}
+
+// issueFieldValues returns the non-empty values of an issue field
+func issueFieldValues(issue *issuepb.Issue, field, fieldID string) []string {
+    var values []string
+    switch field {
+    case "status":
+        values = []string{issue.StatusId}
+    case "assignee":
+        values = []string{issue.AssigneeId}
+    case "reporter":
+        values = []string{issue.ReporterId}
+    case "priority":
+        values = []string{IssuePriorityName(issue.Priority)}
+    case "type":
+        values = []string{IssueTypeName(issue.Type)}
+    case "label":
+        values = issue.LabelIds
+    case "component":
+        values = issue.ComponentIds
+    case "sprint":
+        values = []string{issue.SprintId}
+    case "parent":
+        values = []string{issue.ParentId}
+    case "key":
+        values = []string{issue.Key}
+    case "summary":
+        values = []string{issue.Summary}
+    case "cf":
+        for _, cf := range issue.CustomFields {
+            if cf.FieldId == fieldID {
+                values = customFieldStrings(cf.Value)
+            }
+        }
+    }
+    result := values[:0:0]
+    for _, v := range values {
+        if v != "" {
+            result = append(result, v)
+        }
+    }
+    return result
+}
+
+// IssuePriorityName is the lower-case name of a priority, such as "high"
+func IssuePriorityName(p issuepb.IssuePriority) string {
+    if p == issuepb.IssuePriority_ISSUE_PRIORITY_UNSPECIFIED {
+        return ""
+    }
+    return strings.ToLower(strings.TrimPrefix(p.String(), "ISSUE_PRIORITY_"))
+}
+
+// IssueTypeName is the lower-case name of an issue type, such as "sub_task"
+func IssueTypeName(t issuepb.IssueType) string {
+    if t == issuepb.IssueType_ISSUE_TYPE_UNSPECIFIED {
+        return ""
+    }
+    return strings.ToLower(strings.TrimPrefix(t.String(), "ISSUE_TYPE_"))
+}
+
+// customFieldStrings renders a packed custom field value as strings, one per
+// item of a multi-value field
+func customFieldStrings(value *anypb.Any) []string {
+    if value == nil {
+        return nil
+    }
+    msg, err := value.UnmarshalNew()
+    if err != nil {
+        return nil
+    }
+    switch v := msg.(type) {
+    case *wrapperspb.StringValue:
+        return []string{v.Value}
+    case *wrapperspb.BoolValue:
+        return []string{strconv.FormatBool(v.Value)}
+    case *wrapperspb.DoubleValue:
+        return []string{strconv.FormatFloat(v.Value, 'f', -1, 64)}
+    case *wrapperspb.Int64Value:
+        return []string{strconv.FormatInt(v.Value, 10)}
+    case *timestamppb.Timestamp:
+        return []string{v.AsTime().Format(time.RFC3339)}
+    case *structpb.ListValue:
+        var values []string
+        for _, item := range v.AsSlice() {
+            values = append(values, fmt.Sprint(item))
+        }
+        return values
+    case *structpb.Value:
+        if v.AsInterface() == nil {
+            return nil
+        }
+        return []string{fmt.Sprint(v.AsInterface())}
+    }
+    return nil
+}
+
+// parseQuery parses a quick filter or swimlane query
+func parseQuery(text string) (issueQuery, error) {
+    tokens, err := tokenizeQuery(text)
+    if err != nil {
+        return nil, err
+    }
+    if len(tokens) == 0 {
+        return nil, fmt.Errorf("query is empty")
+    }
+    p := &queryParser{tokens: tokens}
+    q, err := p.parseOr()
+    if err != nil {
+        return nil, err
+    }
+    if !p.done() {
+        return nil, fmt.Errorf("query: unexpected %q", p.peek().text)
+    }
+    return q, nil
+}
+
+type queryToken struct {
+    text   string
+    quoted bool
+}
+
+func tokenizeQuery(text string) ([]queryToken, error) {
+    var tokens []queryToken
+    for i := 0; i < len(text); {
+        c := text[i]
+        switch {
+        case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+            i++
+        case c == '(' || c == ')' || c == ',' || c == '=' || c == '~':
+            tokens = append(tokens, queryToken{text: string(c)})
+            i++
+        case c == '!':
+            if i+1 >= len(text) || (text[i+1] != '=' && text[i+1] != '~') {
+                return nil, fmt.Errorf("query: unexpected '!' at %d", i)
+            }
+            tokens = append(tokens, queryToken{text: text[i : i+2]})
+            i += 2
+        case c == '"' || c == '\'':
+            end := strings.IndexByte(text[i+1:], c)
+            if end < 0 {
+                return nil, fmt.Errorf("query: unterminated string at %d", i)
+            }
+            tokens = append(tokens, queryToken{text: text[i+1 : i+1+end], quoted: true})
+            i += end + 2
+        default:
+            start := i
+            for i < len(text) && !strings.ContainsRune(" \t\n\r(),=~!\"'", rune(text[i])) {
+                i++
+            }
+            tokens = append(tokens, queryToken{text: text[start:i]})
+        }
+    }
+    return tokens, nil
+}
+
+type queryParser struct {
+    tokens []queryToken
+    pos    int
+}
+
+func (p *queryParser) done() bool {
+    return p.pos >= len(p.tokens)
+}
+
+func (p *queryParser) peek() queryToken {
+    if p.done() {
+        return queryToken{}
+    }
+    return p.tokens[p.pos]
+}
+
+func (p *queryParser) next() queryToken {
+    t := p.peek()
+    p.pos++
+    return t
+}
+
+// keyword reports whether the next token is the given unquoted keyword and consumes it
+func (p *queryParser) keyword(word string) bool {
+    t := p.peek()
+    if !t.quoted && strings.EqualFold(t.text, word) {
+        p.pos++
+        return true
+    }
+    return false
+}
+
+func (p *queryParser) expect(text string) error {
+    if t := p.next(); t.quoted || t.text != text {
+        return fmt.Errorf("query: expected %q", text)
+    }
+    return nil
+}
+
+func (p *queryParser) parseOr() (issueQuery, error) {
+    q, err := p.parseAnd()
+    if err != nil {
+        return nil, err
+    }
+    or := orQuery{q}
+    for p.keyword("OR") {
+        q, err := p.parseAnd()
+        if err != nil {
+            return nil, err
+        }
+        or = append(or, q)
+    }
+    if len(or) == 1 {
+        return or[0], nil
+    }
+    return or, nil
+}
+
+func (p *queryParser) parseAnd() (issueQuery, error) {
+    q, err := p.parseNot()
+    if err != nil {
+        return nil, err
+    }
+    and := andQuery{q}
+    for p.keyword("AND") {
+        q, err := p.parseNot()
+        if err != nil {
+            return nil, err
+        }
+        and = append(and, q)
+    }
+    if len(and) == 1 {
+        return and[0], nil
+    }
+    return and, nil
+}
+
+func (p *queryParser) parseNot() (issueQuery, error) {
+    if p.keyword("NOT") {
+        q, err := p.parseNot()
+        if err != nil {
+            return nil, err
+        }
+        return notQuery{q}, nil
+    }
+    if t := p.peek(); !t.quoted && t.text == "(" {
+        p.pos++
+        q, err := p.parseOr()
+        if err != nil {
+            return nil, err
+        }
+        if err := p.expect(")"); err != nil {
+            return nil, err
+        }
+        return q, nil
+    }
+    return p.parseClause()
+}
+
+func (p *queryParser) parseClause() (issueQuery, error) {
+    t := p.next()
+    if t.text == "" || t.quoted {
+        return nil, fmt.Errorf("query: expected a field")
+    }
+    q := &clauseQuery{}
+    name := strings.ToLower(t.text)
+    if strings.HasPrefix(name, "cf[") && strings.HasSuffix(name, "]") && len(name) > 4 {
+        q.field = "cf"
+        q.fieldID = t.text[3 : len(t.text)-1]
+    } else if field, ok := queryFields[name]; ok {
+        q.field = field
+    } else {
+        return nil, fmt.Errorf("query: unknown field %q", t.text)
+    }
+
+    switch {
+    case p.keyword("IS"):
+        q.op = "empty"
+        if p.keyword("NOT") {
+            q.op = "not empty"
+        }
+        if !p.keyword("EMPTY") && !p.keyword("NULL") {
+            return nil, fmt.Errorf("query: expected EMPTY after IS")
+        }
+        return q, nil
+    case p.keyword("IN"):
+        q.op = "in"
+    case p.keyword("NOT"):
+        if !p.keyword("IN") {
+            return nil, fmt.Errorf("query: expected IN after NOT")
+        }
+        q.op = "not in"
+    default:
+        op := p.next()
+        switch op.text {
+        case "=", "!=", "~", "!~":
+            if op.quoted {
+                return nil, fmt.Errorf("query: expected an operator after %q", t.text)
+            }
+            q.op = op.text
+        default:
+            return nil, fmt.Errorf("query: expected an operator after %q", t.text)
+        }
+        v, err := p.parseValue()
+        if err != nil {
+            return nil, err
+        }
+        q.values = []queryValue{v}
+        return q, nil
+    }
+
+    if err := p.expect("("); err != nil {
+        return nil, err
+    }
+    for {
+        v, err := p.parseValue()
+        if err != nil {
+            return nil, err
+        }
+        q.values = append(q.values, v)
+        if p.peek().text == ")" && !p.peek().quoted {
+            p.pos++
+            return q, nil
+        }
+        if err := p.expect(","); err != nil {
+            return nil, err
+        }
+    }
+}
+
+func (p *queryParser) parseValue() (queryValue, error) {
+    t := p.next()
+    if t.quoted {
+        return queryValue{text: t.text}, nil
+    }
+    switch t.text {
+    case "", "(", ")", ",", "=", "!=", "~", "!~":
+        return queryValue{}, fmt.Errorf("query: expected a value")
+    }
+    if strings.EqualFold(t.text, "currentUser") {
+        if err := p.expect("("); err != nil {
+            return queryValue{}, err
+        }
+        if err := p.expect(")"); err != nil {
+            return queryValue{}, err
+        }
+        return queryValue{currentUser: true}, nil
+    }
+    return queryValue{text: t.text}, nil
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+    "testing"
+
+    issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+    "google.golang.org/protobuf/types/known/anypb"
+    "google.golang.org/protobuf/types/known/structpb"
+    "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func queryTestIssues(t *testing.T) (bug, task *issuepb.Issue) {
+    t.Helper()
+    team, err := anypb.New(wrapperspb.String("Backend"))
+    if err != nil {
+        t.Fatalf("anypb.New() error = %v", err)
+    }
+    list, err := structpb.NewList([]interface{}{"ios", "android"})
+    if err != nil {
+        t.Fatalf("structpb.NewList() error = %v", err)
+    }
+    platforms, err := anypb.New(list)
+    if err != nil {
+        t.Fatalf("anypb.New() error = %v", err)
+    }
+
+    bug = &issuepb.Issue{
+        Key:        "NX-1",
+        Summary:    "Spike: login flow",
+        Type:       issuepb.IssueType_ISSUE_TYPE_BUG,
+        Priority:   issuepb.IssuePriority_ISSUE_PRIORITY_HIGH,
+        StatusId:   "todo",
+        AssigneeId: "alice",
+        ReporterId: "bob",
+        LabelIds:   []string{"l-ui", "l-auth"},
+        CustomFields: []*issuepb.CustomFieldValue{
+            {FieldId: "team", Value: team},
+            {FieldId: "platforms", Value: platforms},
+        },
+    }
+    task = &issuepb.Issue{
+        Key:      "NX-2",
+        Summary:  "Write docs",
+        Type:     issuepb.IssueType_ISSUE_TYPE_TASK,
+        Priority: issuepb.IssuePriority_ISSUE_PRIORITY_LOW,
+        StatusId: "done",
+        ParentId: "epic-1",
+        SprintId: "sprint-1",
+    }
+    return bug, task
+}
+
+func TestParseQuery_Match(t *testing.T) {
+    bug, task := queryTestIssues(t)
+    tests := []struct {
+        name     string
+        query    string
+        wantBug  bool
+        wantTask bool
+    }{
+        {"Equals", "priority = high", true, false},
+        {"Equals ignores case", "TYPE = Bug", true, false},
+        {"Not equals", "priority != high", false, true},
+        {"Contains", "summary ~ spike", true, false},
+        {"Does not contain", "summary !~ spike", false, true},
+        {"In", "priority IN (high, highest)", true, false},
+        {"Not in", "priority NOT IN (high, highest)", false, true},
+        {"Is empty", "epic IS EMPTY", true, false},
+        {"Is not empty", "parent is not null", false, true},
+        {"Multi-value field", "labels = l-auth", true, false},
+        {"Field aliases", "component IS EMPTY AND components IS EMPTY", true, true},
+        {"Sprint", "sprint = sprint-1", false, true},
+        {"Key", "key = nx-2", false, true},
+        {"Current user", "assignee = currentUser()", true, false},
+        {"Current user in a list", "reporter IN (carol, currentUser())", false, false},
+        {"Custom field", "cf[team] = backend", true, false},
+        {"Custom field list", "cf[platforms] = android", true, false},
+        {"Custom field not set", "cf[team] IS EMPTY", false, true},
+        {"Unknown custom field", "cf[other] IS EMPTY", true, true},
+
+        // AND binds tighter than OR, and NOT tighter than both
+        {"AND before OR", "priority = high OR priority = low AND type = bug", true, false},
+        {"Parentheses", "(priority = high OR priority = low) AND type = bug", true, false},
+        {"Parentheses widen", "(priority = high OR priority = low) AND type != epic", true, true},
+        {"NOT before AND", "NOT priority = high AND type = task", false, true},
+        {"NOT of a group", "NOT (priority = high AND type = bug)", false, true},
+        {"Double NOT", "NOT NOT type = bug", true, false},
+        {"OR chain", "key = NX-3 OR key = NX-2 OR key = NX-1", true, true},
+        {"Keywords ignore case", "type = bug or type = task and priority = low", true, true},
+
+        // Quoting
+        {"Double quotes", `summary ~ "login flow"`, true, false},
+        {"Single quotes", `summary = 'Write docs'`, false, true},
+        {"Quoted keyword is a value", `summary ~ "AND"`, false, false},
+        {"Quoted operator is a value", `summary ~ "("`, false, false},
+        {"Quoted values in a list", `summary IN ("Write docs", 'Spike: login flow')`, true, true},
+        {"Quoted currentUser is a value", `assignee = "currentUser"`, false, false},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            q, err := parseQuery(tt.query)
+            if err != nil {
+                t.Fatalf("parseQuery(%q) error = %v", tt.query, err)
+            }
+            if got := q.match(bug, "alice"); got != tt.wantBug {
+                t.Errorf("match(bug) = %v, want %v", got, tt.wantBug)
+            }
+            if got := q.match(task, "alice"); got != tt.wantTask {
+                t.Errorf("match(task) = %v, want %v", got, tt.wantTask)
+            }
+        })
+    }
+}
+
+func TestParseQuery_CurrentUserWithoutUser(t *testing.T) {
+    bug, _ := queryTestIssues(t)
+    q, err := parseQuery("assignee = currentUser()")
+    if err != nil {
+        t.Fatalf("parseQuery() error = %v", err)
+    }
+    if q.match(bug, "") {
+        t.Error("match() = true without a user, want false")
+    }
+}
+
+func TestParseQuery_Errors(t *testing.T) {
+    tests := []struct {
+        name  string
+        query string
+    }{
+        {"Empty", ""},
+        {"Blank", "   "},
+        {"Unknown field", "colour = red"},
+        {"Quoted field", `"priority" = high`},
+        {"Empty custom field", "cf[] = x"},
+        {"Missing operator", "priority high"},
+        {"Quoted operator", `priority "=" high`},
+        {"Missing value", "priority ="},
+        {"Operator as value", "priority = ="},
+        {"Lone bang", "priority ! high"},
+        {"Unterminated string", `summary ~ "login`},
+        {"IS without EMPTY", "epic IS high"},
+        {"NOT without IN", "priority NOT high"},
+        {"IN without list", "priority IN high"},
+        {"Unclosed list", "priority IN (high, low"},
+        {"Missing comma", "priority IN (high low)"},
+        {"Empty list", "priority IN ()"},
+        {"Unclosed group", "(priority = high"},
+        {"Extra closing", "priority = high)"},
+        {"Trailing AND", "priority = high AND"},
+        {"Leading OR", "OR priority = high"},
+        {"Two clauses without AND", "priority = high type = bug"},
+        {"currentUser without call", "assignee = currentUser"},
+        {"Dangling NOT", "NOT"},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            if _, err := parseQuery(tt.query); err == nil {
+                t.Errorf("parseQuery(%q) error = nil, want an error", tt.query)
+            }
+        })
+    }
+}
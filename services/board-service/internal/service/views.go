@@ -0,0 +1,426 @@
+package service
+
+import (
+    "context"
+    "fmt"
+    "strings"
+
+    pb "github.com/nexusflow/nexusflow/pkg/proto/board/v1"
+    issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+    "github.com/nexusflow/nexusflow/services/board-service/internal/models"
+)
+
+// maxIssueBatch is the most issues the issue service returns in one call
+const maxIssueBatch = 500
+
+// BoardView is a board's cards grouped into lanes and columns
+type BoardView struct {
+    Board *models.Board
+    // Columns measure WIP over the whole board, whatever the quick filters
+    Columns []*ColumnLoad
+    Lanes   []*BoardLane
+}
+
+// BoardLane is a horizontal slice of a board view
+type BoardLane struct {
+    Key       string
+    Name      string
+    Cells     []*BoardCell
+    CardCount int
+}
+
+// BoardCell holds the cards of a lane in one column
+type BoardCell struct {
+    ColumnID string
+    Cards    []*ViewCard
+}
+
+// ViewCard is a card with its issue
+type ViewCard struct {
+    Card  *models.Card
+    Issue *issuepb.Issue
+}
+
+// GetBoardView groups the cards of a board into swimlanes and columns, keeping the
+// cards that match all of the given quick filters
+func (s *BoardService) GetBoardView(ctx context.Context, boardID string, quickFilterIDs []string, userID string) (*BoardView, error) {
+    board, err := s.repo.GetBoard(ctx, boardID)
+    if err != nil {
+        return nil, err
+    }
+    filters, err := s.boardQueries(ctx, board.ID, uniqueStrings(quickFilterIDs))
+    if err != nil {
+        return nil, err
+    }
+    columns, err := s.repo.ListColumns(ctx, board.ID)
+    if err != nil {
+        return nil, err
+    }
+    cards, err := s.repo.ListCardsByBoard(ctx, board.ID)
+    if err != nil {
+        return nil, err
+    }
+
+    issueIDs := make([]string, 0, len(cards))
+    for _, c := range cards {
+        issueIDs = append(issueIDs, c.IssueID)
+    }
+    issues, err := s.batchGetIssues(ctx, issueIDs)
+    if err != nil {
+        return nil, err
+    }
+    var viewCards []*ViewCard
+    var boardCards []*models.Card
+    for _, c := range cards {
+        issue, ok := issues[c.IssueID]
+        if !ok {
+            // Trashed issues are hidden by the issue events instead
+            continue
+        }
+        c.StatusID = issue.StatusId
+        boardCards = append(boardCards, c)
+        viewCards = append(viewCards, &ViewCard{Card: c, Issue: issue})
+    }
+    assignColumns(columns, boardCards)
+
+    view := &BoardView{Board: board, Columns: columnLoads(columns, boardCards)}
+    lanes, err := s.buildLanes(ctx, board, viewCards, filters, userID)
+    if err != nil {
+        return nil, err
+    }
+    for _, lane := range lanes {
+        lane.Cells = splitCells(columns, lane.cards)
+        for _, cell := range lane.Cells {
+            lane.CardCount += len(cell.Cards)
+        }
+        view.Lanes = append(view.Lanes, &lane.BoardLane)
+    }
+    return view, nil
+}
+
+// laneBuilder collects the cards of a lane before they are split into columns
+type laneBuilder struct {
+    BoardLane
+    cards []*ViewCard
+}
+
+// buildLanes splits the cards that pass the quick filters into the board's swimlanes
+func (s *BoardService) buildLanes(ctx context.Context, board *models.Board, cards []*ViewCard, filters []issueQuery, userID string) ([]*laneBuilder, error) {
+    config := board.Swimlanes
+    if config == nil {
+        config = &models.SwimlaneConfig{}
+    }
+
+    var lanes []*laneBuilder
+    byKey := make(map[string]*laneBuilder)
+    lane := func(key, name string) *laneBuilder {
+        if l, ok := byKey[key]; ok {
+            return l
+        }
+        l := &laneBuilder{BoardLane: BoardLane{Key: key, Name: name}}
+        byKey[key] = l
+        lanes = append(lanes, l)
+        return l
+    }
+
+    var queries []issueQuery
+    var epics map[string]*issuepb.Issue
+    switch config.By {
+    case models.SwimlaneByQuery:
+        for i, q := range config.Queries {
+            parsed, err := parseQuery(q.Query)
+            if err != nil {
+                return nil, fmt.Errorf("swimlane %q: %w", q.Name, err)
+            }
+            queries = append(queries, parsed)
+            lane(fmt.Sprintf("query:%d", i), q.Name)
+        }
+    case models.SwimlaneByPriority:
+        for p := issuepb.IssuePriority_ISSUE_PRIORITY_HIGHEST; p > issuepb.IssuePriority_ISSUE_PRIORITY_UNSPECIFIED; p-- {
+            lane(IssuePriorityName(p), laneTitle(IssuePriorityName(p)))
+        }
+    case models.SwimlaneByEpic:
+        var parentIDs []string
+        for _, c := range cards {
+            if c.Issue.ParentId != "" {
+                parentIDs = append(parentIDs, c.Issue.ParentId)
+            }
+        }
+        var err error
+        if epics, err = s.batchGetIssues(ctx, uniqueStrings(parentIDs)); err != nil {
+            return nil, err
+        }
+    }
+
+    var rest []*ViewCard
+cards:
+    for _, c := range cards {
+        for _, f := range filters {
+            if !f.match(c.Issue, userID) {
+                continue cards
+            }
+        }
+        issue := c.Issue
+        switch config.By {
+        case models.SwimlaneByAssignee:
+            if issue.AssigneeId == "" {
+                rest = append(rest, c)
+                continue
+            }
+            l := lane(issue.AssigneeId, issue.AssigneeId)
+            l.cards = append(l.cards, c)
+        case models.SwimlaneByEpic:
+            epic, ok := epics[issue.ParentId]
+            if !ok {
+                rest = append(rest, c)
+                continue
+            }
+            l := lane(epic.Id, epic.Key+": "+epic.Summary)
+            l.cards = append(l.cards, c)
+        case models.SwimlaneByPriority:
+            name := IssuePriorityName(issue.Priority)
+            if name == "" {
+                rest = append(rest, c)
+                continue
+            }
+            l := byKey[name]
+            l.cards = append(l.cards, c)
+        case models.SwimlaneByCustomField:
+            values := issueFieldValues(issue, "cf", config.CustomFieldID)
+            if len(values) == 0 {
+                rest = append(rest, c)
+                continue
+            }
+            key := strings.Join(values, ", ")
+            l := lane(key, key)
+            l.cards = append(l.cards, c)
+        case models.SwimlaneByQuery:
+            matched := false
+            for i, q := range queries {
+                if q.match(issue, userID) {
+                    l := lanes[i]
+                    l.cards = append(l.cards, c)
+                    matched = true
+                    break
+                }
+            }
+            if !matched {
+                rest = append(rest, c)
+            }
+        default:
+            rest = append(rest, c)
+        }
+    }
+
+    if config.By == models.SwimlaneByPriority {
+        // Priority lanes were laid out in order up front; drop the empty ones
+        var used []*laneBuilder
+        for _, l := range lanes {
+            if len(l.cards) > 0 {
+                used = append(used, l)
+            }
+        }
+        lanes = used
+    }
+
+    // Cards with no value for the lane field go in a last lane
+    var restName string
+    switch config.By {
+    case models.SwimlaneByAssignee:
+        restName = "Unassigned"
+    case models.SwimlaneByEpic:
+        restName = "Issues without epic"
+    case models.SwimlaneByPriority, models.SwimlaneByCustomField:
+        restName = "None"
+    case models.SwimlaneByQuery:
+        restName = "Everything else"
+    }
+    if config.By == models.SwimlaneByNone || len(rest) > 0 {
+        l := &laneBuilder{BoardLane: BoardLane{Name: restName}, cards: rest}
+        lanes = append(lanes, l)
+    }
+    return lanes, nil
+}
+
+// splitCells puts the cards of a lane into one cell per column. Cards whose
+// status no column holds are left out, unless the board has no columns at all.
+func splitCells(columns []*models.BoardColumn, cards []*ViewCard) []*BoardCell {
+    if len(columns) == 0 {
+        return []*BoardCell{{Cards: cards}}
+    }
+    cells := make([]*BoardCell, 0, len(columns))
+    byColumn := make(map[string]*BoardCell, len(columns))
+    for _, col := range columns {
+        cell := &BoardCell{ColumnID: col.ID}
+        byColumn[col.ID] = cell
+        cells = append(cells, cell)
+    }
+    for _, c := range cards {
+        if cell, ok := byColumn[c.Card.ColumnID]; ok {
+            cell.Cards = append(cell.Cards, c)
+        }
+    }
+    return cells
+}
+
+// boardQueries parses the quick filters with the given IDs, which must belong to the board
+func (s *BoardService) boardQueries(ctx context.Context, boardID string, quickFilterIDs []string) ([]issueQuery, error) {
+    var queries []issueQuery
+    for _, id := range quickFilterIDs {
+        filter, err := s.repo.GetQuickFilter(ctx, id)
+        if err != nil {
+            return nil, err
+        }
+        if filter == nil || filter.BoardID != boardID {
+            return nil, fmt.Errorf("quick filter %s not found on board %s", id, boardID)
+        }
+        q, err := parseQuery(filter.Query)
+        if err != nil {
+            return nil, fmt.Errorf("quick filter %q: %w", filter.Name, err)
+        }
+        queries = append(queries, q)
+    }
+    return queries, nil
+}
+
+// batchGetIssues fetches issues in batches, keyed by ID. Missing and trashed issues are left out.
+func (s *BoardService) batchGetIssues(ctx context.Context, issueIDs []string) (map[string]*issuepb.Issue, error) {
+    issues := make(map[string]*issuepb.Issue, len(issueIDs))
+    for start := 0; start < len(issueIDs); start += maxIssueBatch {
+        end := start + maxIssueBatch
+        if end > len(issueIDs) {
+            end = len(issueIDs)
+        }
+        resp, err := s.issueClient.BatchGetIssues(ctx, &issuepb.BatchGetIssuesRequest{Ids: issueIDs[start:end]})
+        if err != nil {
+            return nil, fmt.Errorf("batch get issues: %w", err)
+        }
+        for _, issue := range resp.Issues {
+            issues[issue.Id] = issue
+        }
+    }
+    return issues, nil
+}
+
+// CreateQuickFilter saves a query a board view can be narrowed with
+func (s *BoardService) CreateQuickFilter(ctx context.Context, input *pb.CreateQuickFilterRequest) (*models.QuickFilter, error) {
+    board, err := s.repo.GetBoard(ctx, input.BoardId)
+    if err != nil {
+        return nil, err
+    }
+    filter := &models.QuickFilter{BoardID: board.ID, Name: input.Name, Query: input.Query}
+    if err := validateQuickFilter(filter); err != nil {
+        return nil, err
+    }
+    if err := s.repo.CreateQuickFilter(ctx, filter); err != nil {
+        return nil, err
+    }
+    s.publishEvent("board.quick_filter_created", board.ProjectID, map[string]interface{}{"board_id": board.ID, "quick_filter_id": filter.ID})
+    return filter, nil
+}
+
+// UpdateQuickFilter changes the name or query of a quick filter
+func (s *BoardService) UpdateQuickFilter(ctx context.Context, input *pb.UpdateQuickFilterRequest) (*models.QuickFilter, error) {
+    filter, err := s.getQuickFilter(ctx, input.Id)
+    if err != nil {
+        return nil, err
+    }
+    if input.Name != nil {
+        filter.Name = *input.Name
+    }
+    if input.Query != nil {
+        filter.Query = *input.Query
+    }
+    if err := validateQuickFilter(filter); err != nil {
+        return nil, err
+    }
+    if err := s.repo.UpdateQuickFilter(ctx, filter); err != nil {
+        return nil, err
+    }
+    s.publishEvent("board.quick_filter_updated", "", map[string]interface{}{"board_id": filter.BoardID, "quick_filter_id": filter.ID})
+    return filter, nil
+}
+
+// DeleteQuickFilter removes a quick filter from its board
+func (s *BoardService) DeleteQuickFilter(ctx context.Context, id string) error {
+    filter, err := s.getQuickFilter(ctx, id)
+    if err != nil {
+        return err
+    }
+    if err := s.repo.DeleteQuickFilter(ctx, filter); err != nil {
+        return err
+    }
+    s.publishEvent("board.quick_filter_deleted", "", map[string]interface{}{"board_id": filter.BoardID, "quick_filter_id": filter.ID})
+    return nil
+}
+
+func (s *BoardService) ListQuickFilters(ctx context.Context, boardID string) ([]*models.QuickFilter, error) {
+    return s.repo.ListQuickFilters(ctx, boardID)
+}
+
+func (s *BoardService) getQuickFilter(ctx context.Context, id string) (*models.QuickFilter, error) {
+    filter, err := s.repo.GetQuickFilter(ctx, id)
+    if err != nil {
+        return nil, err
+    }
+    if filter == nil {
+        return nil, fmt.Errorf("quick filter %s not found", id)
+    }
+    return filter, nil
+}
+
+func validateQuickFilter(filter *models.QuickFilter) error {
+    if filter.Name == "" {
+        return fmt.Errorf("quick filter name is required")
+    }
+    if _, err := parseQuery(filter.Query); err != nil {
+        return fmt.Errorf("quick filter %q: %w", filter.Name, err)
+    }
+    return nil
+}
+
+// swimlaneConfigFromProto validates a swimlane configuration, returning nil for a single lane
+func swimlaneConfigFromProto(config *pb.SwimlaneConfig) (*models.SwimlaneConfig, error) {
+    result := &models.SwimlaneConfig{}
+    switch config.By {
+    case pb.SwimlaneBy_SWIMLANE_BY_NONE:
+        return nil, nil
+    case pb.SwimlaneBy_SWIMLANE_BY_ASSIGNEE:
+        result.By = models.SwimlaneByAssignee
+    case pb.SwimlaneBy_SWIMLANE_BY_EPIC:
+        result.By = models.SwimlaneByEpic
+    case pb.SwimlaneBy_SWIMLANE_BY_PRIORITY:
+        result.By = models.SwimlaneByPriority
+    case pb.SwimlaneBy_SWIMLANE_BY_CUSTOM_FIELD:
+        if config.CustomFieldId == "" {
+            return nil, fmt.Errorf("custom field swimlanes need a custom_field_id")
+        }
+        result.By = models.SwimlaneByCustomField
+        result.CustomFieldID = config.CustomFieldId
+    case pb.SwimlaneBy_SWIMLANE_BY_QUERY:
+        if len(config.Queries) == 0 {
+            return nil, fmt.Errorf("query swimlanes need at least one query")
+        }
+        for _, q := range config.Queries {
+            if q.Name == "" {
+                return nil, fmt.Errorf("swimlane name is required")
+            }
+            if _, err := parseQuery(q.Query); err != nil {
+                return nil, fmt.Errorf("swimlane %q: %w", q.Name, err)
+            }
+            result.Queries = append(result.Queries, models.SwimlaneQuery{Name: q.Name, Query: q.Query})
+        }
+        result.By = models.SwimlaneByQuery
+    default:
+        return nil, fmt.Errorf("unknown swimlane grouping %v", config.By)
+    }
+    return result, nil
+}
+
+// laneTitle capitalizes a lower-case lane name such as "high"
+func laneTitle(name string) string {
+    if name == "" {
+        return name
+    }
+    return strings.ToUpper(name[:1]) + name[1:]
+}
@@ -0,0 +1 @@
+ALTER TABLE cards DROP COLUMN IF EXISTS issue_deleted_at;
@@ -0,0 +1,2 @@
+-- Cards of issues in the trash are hidden until the issue is restored
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS issue_deleted_at TIMESTAMP;
@@ -0,0 +1,2 @@
+ALTER TABLE cards DROP COLUMN IF EXISTS status_id;
+DROP TABLE IF EXISTS board_columns;
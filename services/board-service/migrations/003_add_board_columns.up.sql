@@ -0,0 +1,21 @@
+-- Columns of a board, each holding the issues in one or more workflow statuses
+CREATE TABLE IF NOT EXISTS board_columns (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    position INT NOT NULL,
+    status_ids UUID[] NOT NULL DEFAULT '{}',
+    -- 0 means no limit
+    min_wip INT NOT NULL DEFAULT 0,
+    max_wip INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_board_columns_board_id ON board_columns(board_id, position);
+
+CREATE TRIGGER update_board_columns_updated_at BEFORE UPDATE ON board_columns
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Workflow status of the card's issue, kept in step with issue events
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS status_id UUID;
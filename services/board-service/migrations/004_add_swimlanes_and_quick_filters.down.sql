@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS board_quick_filters;
+ALTER TABLE boards DROP COLUMN IF EXISTS swimlanes;
@@ -0,0 +1,18 @@
+-- How the cards of a board are split into lanes, NULL for a single lane
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS swimlanes JSONB;
+
+-- Saved queries a board view can be narrowed with
+CREATE TABLE IF NOT EXISTS board_quick_filters (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    query TEXT NOT NULL,
+    position INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_board_quick_filters_board_id ON board_quick_filters(board_id, position);
+
+CREATE TRIGGER update_board_quick_filters_updated_at BEFORE UPDATE ON board_quick_filters
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
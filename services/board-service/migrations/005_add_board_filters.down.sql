@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_cards_done_at;
+DROP INDEX IF EXISTS idx_cards_board_issue;
+ALTER TABLE cards DROP COLUMN IF EXISTS archived_at;
+ALTER TABLE cards DROP COLUMN IF EXISTS done_at;
+ALTER TABLE boards DROP COLUMN IF EXISTS archive_done_after_days;
+ALTER TABLE boards DROP COLUMN IF EXISTS filter;
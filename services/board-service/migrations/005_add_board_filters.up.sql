@@ -0,0 +1,15 @@
+-- Issues a board shows, NULL for boards whose cards are added by hand
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS filter JSONB;
+-- Done cards are archived after this many days, 0 to keep them
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS archive_done_after_days INT NOT NULL DEFAULT 0;
+
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS done_at TIMESTAMP;
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP;
+
+-- An issue has at most one card per board; keep the oldest of any duplicates
+DELETE FROM cards a USING cards b
+WHERE a.board_id = b.board_id AND a.issue_id = b.issue_id
+  AND (a.created_at > b.created_at OR (a.created_at = b.created_at AND a.id > b.id));
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_cards_board_issue ON cards(board_id, issue_id);
+CREATE INDEX IF NOT EXISTS idx_cards_done_at ON cards(done_at) WHERE done_at IS NOT NULL AND archived_at IS NULL;
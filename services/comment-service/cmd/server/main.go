@@ -87,7 +87,13 @@ func main() {
 
 	// Initialize layers
 	repo := repository.NewCommentRepository(db, log)
-	svc := service.NewCommentService(repo, producer, log)
+	userServiceAddr := "127.0.0.1:50051"    // Default
+	issueServiceAddr := "127.0.0.1:50054"   // Default
+	projectServiceAddr := "127.0.0.1:50053" // Default
+	svc, err := service.NewCommentService(repo, producer, log, userServiceAddr, issueServiceAddr, projectServiceAddr)
+	if err != nil {
+		log.Sugar().Fatalw("Failed to create comment service", "error", err)
+	}
 	h := handler.NewCommentHandler(svc, log)
 
 	// Create gRPC server
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/nexusflow/nexusflow/pkg/database"
@@ -133,18 +134,36 @@ func (r *CommentRepository) ListMentions(ctx context.Context, commentID string)
 	return mentions, nil
 }
 
-// ParseMentions extracts @mentions from comment content
+// DeleteMention removes a user's mention from a comment
+func (r *CommentRepository) DeleteMention(ctx context.Context, commentID, userID string) error {
+	_, err := r.db.NewDelete().Model((*models.CommentMention)(nil)).
+		Where("comment_id = ? AND mentioned_user_id = ?", commentID, userID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete mention: %w", err)
+	}
+	return nil
+}
+
+// mentionPattern matches @handle and @email mentions. The @ must not follow a
+// character that could belong to an address, so plain emails in the text such
+// as jane@example.com are not taken for a mention of "example".
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w.%+-])@([\w.%+-]+@[\w-]+(?:\.[\w-]+)+|[\w.-]+)`)
+
+// ParseMentions extracts @mentions from comment content, each once
 func ParseMentions(content string) []string {
-	re := regexp.MustCompile(`@([a-zA-Z0-9_-]+)`)
-	matches := re.FindAllStringSubmatch(content, -1)
-	
+	matches := mentionPattern.FindAllStringSubmatch(content, -1)
+
 	mentions := make([]string, 0, len(matches))
 	seen := make(map[string]bool)
-	
+
 	for _, match := range matches {
-		if len(match) > 1 && !seen[match[1]] {
-			mentions = append(mentions, match[1])
-			seen[match[1]] = true
+		// A sentence may end right after a mention
+		mention := strings.TrimRight(match[1], ".")
+		key := strings.ToLower(mention)
+		if mention != "" && !seen[key] {
+			mentions = append(mentions, mention)
+			seen[key] = true
 		}
 	}
 	return mentions
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMentions(t *testing.T) {
+	for _, tc := range []struct {
+		content string
+		want    []string
+	}{
+		{"@jane can you look?", []string{"jane"}},
+		{"Thanks @jane.doe.", []string{"jane.doe"}},
+		{"cc @jane@example.com, @bob", []string{"jane@example.com", "bob"}},
+		{"@jane and @Jane again", []string{"jane"}},
+		{"mail jane@example.com instead", []string{}},
+		{"(@bob)", []string{"bob"}},
+	} {
+		if got := ParseMentions(tc.content); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("ParseMentions(%q) = %q, want %q", tc.content, got, tc.want)
+		}
+	}
+}
@@ -8,18 +8,70 @@ import (
 	"github.com/nexusflow/nexusflow/pkg/kafka"
 	"github.com/nexusflow/nexusflow/pkg/logger"
 	pb "github.com/nexusflow/nexusflow/pkg/proto/comment/v1"
+	issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+	projectpb "github.com/nexusflow/nexusflow/pkg/proto/project/v1"
+	userpb "github.com/nexusflow/nexusflow/pkg/proto/user/v1"
 	"github.com/nexusflow/nexusflow/services/comment-service/internal/models"
-	"github.com/nexusflow/nexusflow/services/comment-service/internal/repository"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 type CommentService struct {
-	repo     *repository.CommentRepository
-	producer *kafka.Producer
-	log      *logger.Logger
+	repo          Repository
+	producer      *kafka.Producer
+	log           *logger.Logger
+	userClient    userpb.UserServiceClient
+	issueClient   issuepb.IssueServiceClient
+	projectClient projectpb.ProjectServiceClient
 }
 
-func NewCommentService(repo *repository.CommentRepository, producer *kafka.Producer, log *logger.Logger) *CommentService {
-	return &CommentService{repo: repo, producer: producer, log: log}
+// Repository is the comment storage the service works with, implemented by
+// *repository.CommentRepository
+type Repository interface {
+	CreateComment(ctx context.Context, comment *models.Comment) error
+	GetComment(ctx context.Context, id string) (*models.Comment, error)
+	ListComments(ctx context.Context, issueID string) ([]*models.Comment, error)
+	UpdateComment(ctx context.Context, comment *models.Comment) error
+	DeleteComment(ctx context.Context, id string) error
+
+	AddReaction(ctx context.Context, commentID, userID, emoji string) error
+	RemoveReaction(ctx context.Context, commentID, userID, emoji string) error
+	ListReactions(ctx context.Context, commentID string) ([]*models.CommentReaction, error)
+
+	CreateMention(ctx context.Context, mention *models.CommentMention) error
+	ListMentions(ctx context.Context, commentID string) ([]*models.CommentMention, error)
+	DeleteMention(ctx context.Context, commentID, userID string) error
+}
+
+func NewCommentService(
+	repo Repository,
+	producer *kafka.Producer,
+	log *logger.Logger,
+	userServiceAddr string,
+	issueServiceAddr string,
+	projectServiceAddr string,
+) (*CommentService, error) {
+	userConn, err := grpc.Dial(userServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to user service: %w", err)
+	}
+	issueConn, err := grpc.Dial(issueServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to issue service: %w", err)
+	}
+	projectConn, err := grpc.Dial(projectServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to project service: %w", err)
+	}
+
+	return &CommentService{
+		repo:          repo,
+		producer:      producer,
+		log:           log,
+		userClient:    userpb.NewUserServiceClient(userConn),
+		issueClient:   issuepb.NewIssueServiceClient(issueConn),
+		projectClient: projectpb.NewProjectServiceClient(projectConn),
+	}, nil
 }
 
 // CreateComment creates a new comment and processes mentions
@@ -38,23 +90,11 @@ func (s *CommentService) CreateComment(ctx context.Context, req *pb.CreateCommen
 		return nil, fmt.Errorf("create comment: %w", err)
 	}
 
-	// Parse and create mentions
-	mentions := repository.ParseMentions(comment.Content)
-	for _, username := range mentions {
-		// In a real implementation, you would resolve username to user ID
-		// For now, we'll just store the username as the user ID
-		mention := &models.CommentMention{
-			CommentID:       comment.ID,
-			MentionedUserID: username,
-		}
-		if err := s.repo.CreateMention(ctx, mention); err != nil {
-			s.log.Sugar().Warnw("Failed to create mention", "error", err, "username", username)
-		} else {
-			s.publishEvent("comment.mention_created", comment.IssueID, map[string]interface{}{
-				"comment_id":        comment.ID,
-				"mentioned_user_id": username,
-			})
-		}
+	// A comment is still posted when its mentions cannot be resolved
+	if userIDs, err := s.resolveMentions(ctx, comment); err != nil {
+		s.log.Sugar().Warnw("Failed to resolve mentions", "error", err, "comment_id", comment.ID)
+	} else {
+		s.addMentions(ctx, comment, userIDs)
 	}
 
 	s.publishEvent("comment.created", comment.IssueID, map[string]interface{}{
@@ -85,6 +125,14 @@ func (s *CommentService) UpdateComment(ctx context.Context, req *pb.UpdateCommen
 		return nil, fmt.Errorf("update comment: %w", err)
 	}
 
+	// Keep the existing mentions when the new ones cannot be resolved, rather
+	// than dropping them or notifying everyone again
+	if userIDs, err := s.resolveMentions(ctx, comment); err != nil {
+		s.log.Sugar().Warnw("Failed to resolve mentions", "error", err, "comment_id", comment.ID)
+	} else if err := s.updateMentions(ctx, comment, userIDs); err != nil {
+		s.log.Sugar().Warnw("Failed to update mentions", "error", err, "comment_id", comment.ID)
+	}
+
 	s.publishEvent("comment.updated", comment.IssueID, map[string]interface{}{
 		"comment_id": comment.ID,
 	})
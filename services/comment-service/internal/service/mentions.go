@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	commonpb "github.com/nexusflow/nexusflow/pkg/proto/common/v1"
+	issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+	projectpb "github.com/nexusflow/nexusflow/pkg/proto/project/v1"
+	userpb "github.com/nexusflow/nexusflow/pkg/proto/user/v1"
+	"github.com/nexusflow/nexusflow/services/comment-service/internal/models"
+	"github.com/nexusflow/nexusflow/services/comment-service/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// resolveMentions finds the users a comment mentions, by email or by handle
+// within the organization of the issue's project. Users have no username, so
+// a handle is the part of their email before the @, e.g. @jane for
+// jane@example.com. Mentions that match nobody, the author, and users who
+// cannot see the project are left as plain text.
+func (s *CommentService) resolveMentions(ctx context.Context, comment *models.Comment) ([]string, error) {
+	mentions := repository.ParseMentions(comment.Content)
+	if len(mentions) == 0 {
+		return nil, nil
+	}
+
+	issueResp, err := s.issueClient.GetIssue(ctx, &issuepb.GetIssueRequest{Id: comment.IssueID})
+	if err != nil {
+		return nil, fmt.Errorf("get issue: %w", err)
+	}
+	projectResp, err := s.projectClient.GetProject(ctx, &projectpb.GetProjectRequest{Id: issueResp.Issue.ProjectId})
+	if err != nil {
+		return nil, fmt.Errorf("get project: %w", err)
+	}
+	project := projectResp.Project
+	members, err := s.projectMembers(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	var userIDs []string
+	seen := make(map[string]bool)
+	for _, mention := range mentions {
+		userID, err := s.resolveMention(ctx, project.OrganizationId, mention)
+		if err != nil {
+			s.log.Sugar().Warnw("Failed to resolve mention", "error", err, "mention", mention)
+			continue
+		}
+		switch {
+		case userID == "":
+			s.log.Sugar().Debugw("Mention matches no user, leaving it as text", "mention", mention, "comment_id", comment.ID)
+		case !members[userID]:
+			s.log.Sugar().Debugw("Mentioned user cannot see the project", "user_id", userID, "project_id", project.Id)
+		case userID != comment.AuthorID && !seen[userID]:
+			seen[userID] = true
+			userIDs = append(userIDs, userID)
+		}
+	}
+	return userIDs, nil
+}
+
+// resolveMention returns the ID of the organization's user an email or handle
+// names, or "" when there is no such user or a handle is ambiguous
+func (s *CommentService) resolveMention(ctx context.Context, orgID, mention string) (string, error) {
+	if strings.Contains(mention, "@") {
+		resp, err := s.userClient.GetUserByEmail(ctx, &userpb.GetUserByEmailRequest{Email: mention})
+		if status.Code(err) == codes.NotFound {
+			return "", nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("get user by email: %w", err)
+		}
+		for _, id := range resp.User.OrganizationIds {
+			if id == orgID {
+				return resp.User.Id, nil
+			}
+		}
+		return "", nil
+	}
+
+	var matches []string
+	req := &userpb.SearchUsersRequest{
+		Query:           mention,
+		OrganizationIds: []string{orgID},
+		Pagination:      &commonpb.PaginationRequest{Page: 1, PageSize: 100},
+	}
+	for {
+		resp, err := s.userClient.SearchUsers(ctx, req)
+		if err != nil {
+			return "", fmt.Errorf("search users: %w", err)
+		}
+		for _, u := range resp.Users {
+			if handle, _, _ := strings.Cut(u.Email, "@"); strings.EqualFold(handle, mention) {
+				matches = append(matches, u.Id)
+			}
+		}
+		if resp.Pagination == nil || req.Pagination.Page >= resp.Pagination.TotalPages {
+			break
+		}
+		req.Pagination.Page++
+	}
+	if len(matches) != 1 {
+		return "", nil
+	}
+	return matches[0], nil
+}
+
+// projectMembers returns the set of users who can see a project: its lead and
+// its members
+func (s *CommentService) projectMembers(ctx context.Context, project *projectpb.Project) (map[string]bool, error) {
+	members := map[string]bool{project.LeadId: true}
+	req := &projectpb.ListProjectMembersRequest{
+		ProjectId:  project.Id,
+		Pagination: &commonpb.PaginationRequest{Page: 1, PageSize: 100},
+	}
+	for {
+		resp, err := s.projectClient.ListProjectMembers(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("list project members: %w", err)
+		}
+		for _, m := range resp.Members {
+			members[m.UserId] = true
+		}
+		if resp.Pagination == nil || req.Pagination.Page >= resp.Pagination.TotalPages {
+			return members, nil
+		}
+		req.Pagination.Page++
+	}
+}
+
+// addMentions records that a comment mentions users and notifies them
+func (s *CommentService) addMentions(ctx context.Context, comment *models.Comment, userIDs []string) {
+	for _, userID := range userIDs {
+		mention := &models.CommentMention{
+			CommentID:       comment.ID,
+			MentionedUserID: userID,
+		}
+		if err := s.repo.CreateMention(ctx, mention); err != nil {
+			s.log.Sugar().Warnw("Failed to create mention", "error", err, "user_id", userID)
+			continue
+		}
+		s.publishEvent("comment.mention_created", comment.IssueID, map[string]interface{}{
+			"comment_id":        comment.ID,
+			"mentioned_user_id": userID,
+			"author_id":         comment.AuthorID,
+		})
+	}
+}
+
+// updateMentions brings an edited comment's mentions in line with its content,
+// notifying only the users it did not mention before
+func (s *CommentService) updateMentions(ctx context.Context, comment *models.Comment, userIDs []string) error {
+	existing, err := s.repo.ListMentions(ctx, comment.ID)
+	if err != nil {
+		return err
+	}
+	mentioned := make(map[string]bool, len(existing))
+	for _, m := range existing {
+		mentioned[m.MentionedUserID] = true
+	}
+
+	var added []string
+	still := make(map[string]bool, len(userIDs))
+	for _, userID := range userIDs {
+		still[userID] = true
+		if !mentioned[userID] {
+			added = append(added, userID)
+		}
+	}
+	for userID := range mentioned {
+		if !still[userID] {
+			if err := s.repo.DeleteMention(ctx, comment.ID, userID); err != nil {
+				return fmt.Errorf("delete mention of %s: %w", userID, err)
+			}
+		}
+	}
+
+	s.addMentions(ctx, comment, added)
+	return nil
+}
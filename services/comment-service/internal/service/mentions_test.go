@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/nexusflow/nexusflow/pkg/logger"
+	commonpb "github.com/nexusflow/nexusflow/pkg/proto/common/v1"
+	issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+	projectpb "github.com/nexusflow/nexusflow/pkg/proto/project/v1"
+	userpb "github.com/nexusflow/nexusflow/pkg/proto/user/v1"
+	"github.com/nexusflow/nexusflow/services/comment-service/internal/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testUsers are everyone the fake user service knows. sam and sam2 share a
+// handle, outsider is not on the project and stranger is in another
+// organization.
+var testUsers = []*userpb.User{
+	{Id: "jane", Email: "jane@example.com", OrganizationIds: []string{"org"}},
+	{Id: "bob", Email: "bob@example.com", OrganizationIds: []string{"org"}},
+	{Id: "lee", Email: "lee@example.com", OrganizationIds: []string{"org"}},
+	{Id: "sam", Email: "sam@example.com", OrganizationIds: []string{"org"}},
+	{Id: "sam2", Email: "Sam@example.org", OrganizationIds: []string{"org"}},
+	{Id: "outsider", Email: "outsider@example.com", OrganizationIds: []string{"org"}},
+	{Id: "stranger", Email: "stranger@elsewhere.com", OrganizationIds: []string{"other-org"}},
+}
+
+// fakeRepo keeps the users a comment mentions. Other repository methods are
+// not used.
+type fakeRepo struct {
+	Repository
+	mentioned map[string]bool
+	deleteErr error
+	created   []string
+	deleted   []string
+}
+
+func (r *fakeRepo) CreateMention(ctx context.Context, mention *models.CommentMention) error {
+	r.created = append(r.created, mention.MentionedUserID)
+	return nil
+}
+
+func (r *fakeRepo) ListMentions(ctx context.Context, commentID string) ([]*models.CommentMention, error) {
+	var mentions []*models.CommentMention
+	for userID := range r.mentioned {
+		mentions = append(mentions, &models.CommentMention{CommentID: commentID, MentionedUserID: userID})
+	}
+	return mentions, nil
+}
+
+func (r *fakeRepo) DeleteMention(ctx context.Context, commentID, userID string) error {
+	if r.deleteErr != nil {
+		return r.deleteErr
+	}
+	r.deleted = append(r.deleted, userID)
+	return nil
+}
+
+type fakeUserClient struct {
+	userpb.UserServiceClient
+}
+
+func (c *fakeUserClient) GetUserByEmail(ctx context.Context, in *userpb.GetUserByEmailRequest, opts ...grpc.CallOption) (*userpb.GetUserByEmailResponse, error) {
+	for _, u := range testUsers {
+		if strings.EqualFold(u.Email, in.Email) {
+			return &userpb.GetUserByEmailResponse{User: u}, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "user not found")
+}
+
+// SearchUsers returns one matching user per page
+func (c *fakeUserClient) SearchUsers(ctx context.Context, in *userpb.SearchUsersRequest, opts ...grpc.CallOption) (*userpb.SearchUsersResponse, error) {
+	var found []*userpb.User
+	for _, u := range testUsers {
+		if strings.Contains(strings.ToLower(u.Email), strings.ToLower(in.Query)) && u.OrganizationIds[0] == in.OrganizationIds[0] {
+			found = append(found, u)
+		}
+	}
+	resp := &userpb.SearchUsersResponse{Pagination: &commonpb.PaginationResponse{Page: in.Pagination.Page, TotalPages: int32(len(found))}}
+	if page := int(in.Pagination.Page); page <= len(found) {
+		resp.Users = found[page-1 : page]
+	}
+	return resp, nil
+}
+
+type fakeIssueClient struct {
+	issuepb.IssueServiceClient
+}
+
+func (c *fakeIssueClient) GetIssue(ctx context.Context, in *issuepb.GetIssueRequest, opts ...grpc.CallOption) (*issuepb.GetIssueResponse, error) {
+	return &issuepb.GetIssueResponse{Issue: &issuepb.Issue{Id: in.Id, ProjectId: "project"}}, nil
+}
+
+// fakeProjectClient serves a project led by lee, with jane, bob and both
+// sams as members
+type fakeProjectClient struct {
+	projectpb.ProjectServiceClient
+}
+
+func (c *fakeProjectClient) GetProject(ctx context.Context, in *projectpb.GetProjectRequest, opts ...grpc.CallOption) (*projectpb.GetProjectResponse, error) {
+	return &projectpb.GetProjectResponse{Project: &projectpb.Project{Id: in.Id, OrganizationId: "org", LeadId: "lee"}}, nil
+}
+
+func (c *fakeProjectClient) ListProjectMembers(ctx context.Context, in *projectpb.ListProjectMembersRequest, opts ...grpc.CallOption) (*projectpb.ListProjectMembersResponse, error) {
+	var members []*projectpb.ProjectMember
+	for _, id := range []string{"jane", "bob", "sam", "sam2"} {
+		members = append(members, &projectpb.ProjectMember{ProjectId: in.ProjectId, UserId: id})
+	}
+	return &projectpb.ListProjectMembersResponse{Members: members}, nil
+}
+
+func newTestService(t *testing.T, repo *fakeRepo) *CommentService {
+	t.Helper()
+	log, err := logger.NewDefault("comment-service-test")
+	if err != nil {
+		t.Fatalf("NewDefault() error = %v", err)
+	}
+	return &CommentService{
+		repo:          repo,
+		log:           log,
+		userClient:    &fakeUserClient{},
+		issueClient:   &fakeIssueClient{},
+		projectClient: &fakeProjectClient{},
+	}
+}
+
+func TestResolveMentions(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{"Handle", "@jane can you look?", []string{"jane"}},
+		{"Email", "cc @jane@example.com", []string{"jane"}},
+		{"Same user twice", "@jane, or @jane@example.com", []string{"jane"}},
+		{"Project lead", "@lee and @jane", []string{"lee", "jane"}},
+		{"Ambiguous handle", "@sam please", nil},
+		{"Email of an ambiguous handle", "@sam@example.com please", []string{"sam"}},
+		{"Not on the project", "@outsider", nil},
+		{"Another organization", "@stranger@elsewhere.com", nil},
+		{"Nobody", "@nobody and @nobody@example.com", nil},
+		{"Author", "as @bob said", nil},
+		{"No mentions", "Looks good", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(t, &fakeRepo{})
+			comment := &models.Comment{ID: "comment", IssueID: "issue", AuthorID: "bob", Content: tt.content}
+			got, err := s.resolveMentions(context.Background(), comment)
+			if err != nil {
+				t.Fatalf("resolveMentions() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resolveMentions() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateMentions(t *testing.T) {
+	tests := []struct {
+		name        string
+		mentioned   []string
+		userIDs     []string
+		wantCreated []string
+		wantDeleted []string
+	}{
+		{"Added", []string{"jane"}, []string{"jane", "lee"}, []string{"lee"}, nil},
+		{"Removed", []string{"jane", "lee"}, []string{"lee"}, nil, []string{"jane"}},
+		{"Kept", []string{"jane", "lee"}, []string{"lee", "jane"}, nil, nil},
+		{"Replaced", []string{"jane", "sam"}, []string{"lee"}, []string{"lee"}, []string{"jane", "sam"}},
+		{"First mentions", nil, []string{"jane"}, []string{"jane"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeRepo{mentioned: make(map[string]bool)}
+			for _, id := range tt.mentioned {
+				repo.mentioned[id] = true
+			}
+			s := newTestService(t, repo)
+			comment := &models.Comment{ID: "comment", IssueID: "issue", AuthorID: "bob"}
+			if err := s.updateMentions(context.Background(), comment, tt.userIDs); err != nil {
+				t.Fatalf("updateMentions() error = %v", err)
+			}
+			sort.Strings(repo.deleted)
+			if !reflect.DeepEqual(repo.created, tt.wantCreated) {
+				t.Errorf("created mentions of %q, want %q", repo.created, tt.wantCreated)
+			}
+			if !reflect.DeepEqual(repo.deleted, tt.wantDeleted) {
+				t.Errorf("deleted mentions of %q, want %q", repo.deleted, tt.wantDeleted)
+			}
+		})
+	}
+}
+
+func TestUpdateMentions_DeleteFails(t *testing.T) {
+	deleteErr := errors.New("connection reset")
+	repo := &fakeRepo{mentioned: map[string]bool{"jane": true}, deleteErr: deleteErr}
+	s := newTestService(t, repo)
+	comment := &models.Comment{ID: "comment", IssueID: "issue", AuthorID: "bob"}
+	if err := s.updateMentions(context.Background(), comment, []string{"lee"}); !errors.Is(err, deleteErr) {
+		t.Errorf("updateMentions() error = %v, want %v", err, deleteErr)
+	}
+}
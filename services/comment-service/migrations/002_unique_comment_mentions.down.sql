@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_comment_mentions_comment_user;
@@ -0,0 +1,2 @@
+-- A user is mentioned in a comment at most once
+CREATE UNIQUE INDEX IF NOT EXISTS idx_comment_mentions_comment_user ON comment_mentions(comment_id, mentioned_user_id);
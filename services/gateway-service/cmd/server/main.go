@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	automationv1 "github.com/nexusflow/nexusflow/pkg/proto/automation/v1"
 	projectv1 "github.com/nexusflow/nexusflow/pkg/proto/project/v1"
 	issuev1 "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
 	orgv1 "github.com/nexusflow/nexusflow/pkg/proto/org/v1"
@@ -21,7 +22,7 @@ func main() {
 
 	// Register gRPC server endpoint
 	// Note: Make sure the gRPC server is running on this address
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher))
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 
 	// Register Project Service
@@ -45,6 +46,13 @@ func main() {
 		log.Fatalf("Failed to register org service handler: %v", err)
 	}
 
+	// Register Automation Service
+	// Assuming automation-service runs on localhost:50063
+	err = automationv1.RegisterAutomationServiceHandlerFromEndpoint(ctx, mux, "localhost:50063", opts)
+	if err != nil {
+		log.Fatalf("Failed to register automation service handler: %v", err)
+	}
+
 	// Register other services here as we add annotations...
 
 	// CORS Middleware
@@ -56,11 +64,21 @@ func main() {
 	}
 }
 
+// outgoingHeaderMatcher forwards the etag set by the services as a plain ETag
+// header so clients can echo it back in If-Match.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == "etag" {
+		return "ETag", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
 func allowCORS(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Origin, Content-Type, Accept, Authorization")
+		w.Header().Set("Access-Control-Allow-Headers", "Origin, Content-Type, Accept, Authorization, If-Match")
+		w.Header().Set("Access-Control-Expose-Headers", "ETag")
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
@@ -50,6 +50,27 @@ type GitHubPushPayload struct {
 	} `json:"commits"`
 }
 
+// GitHubPullRequestPayload represents a subset of GitHub pull_request event
+type GitHubPullRequestPayload struct {
+	Action      string `json:"action"`
+	PullRequest struct {
+		ID      int64  `json:"id"`
+		Title   string `json:"title"`
+		HTMLURL string `json:"html_url"`
+		State   string `json:"state"`
+		Merged  bool   `json:"merged"`
+		User    struct {
+			Login string `json:"login"`
+		} `json:"user"`
+		Head struct {
+			Ref string `json:"ref"`
+		} `json:"head"`
+	} `json:"pull_request"`
+	Repository struct {
+		ID int64 `json:"id"`
+	} `json:"repository"`
+}
+
 func (h *WebhookHandler) HandleGitHub(w http.ResponseWriter, r *http.Request) {
 	eventType := r.Header.Get("X-GitHub-Event")
 
@@ -99,5 +120,39 @@ func (h *WebhookHandler) HandleGitHub(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if eventType == "pull_request" {
+		var payload GitHubPullRequestPayload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			h.log.Sugar().Errorw("Failed to unmarshal GitHub payload", "error", err)
+			http.Error(w, "Invalid JSON", http.StatusBadRequest)
+			return
+		}
+
+		repoID := fmt.Sprintf("%d", payload.Repository.ID)
+		repo, err := h.repo.GetRepositoryByExternalID(r.Context(), "github", repoID)
+		if err != nil {
+			h.log.Sugar().Warnw("Repository not found", "external_id", repoID)
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		status := "open"
+		if payload.PullRequest.Merged {
+			status = "merged"
+		} else if payload.PullRequest.State == "closed" {
+			status = "closed"
+		}
+		pr := &models.PullRequest{
+			ExternalID: fmt.Sprintf("%d", payload.PullRequest.ID),
+			Title:      payload.PullRequest.Title,
+			Status:     status,
+			URL:        payload.PullRequest.HTMLURL,
+			AuthorName: payload.PullRequest.User.Login,
+		}
+		if err := h.svc.ProcessPullRequest(r.Context(), repo, pr, payload.PullRequest.Head.Ref); err != nil {
+			h.log.Sugar().Errorw("Failed to process pull request", "error", err, "pull_request", pr.ExternalID)
+		}
+	}
+
 	w.WriteHeader(http.StatusOK)
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"time"
 
@@ -104,6 +105,20 @@ func (r *GitRepository) CreatePullRequest(ctx context.Context, pr *models.PullRe
 	return nil
 }
 
+func (r *GitRepository) GetPullRequestByExternalID(ctx context.Context, repositoryID, externalID string) (*models.PullRequest, error) {
+	pr := new(models.PullRequest)
+	err := r.db.NewSelect().Model(pr).
+		Where("repository_id = ? AND external_id = ?", repositoryID, externalID).
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get pr: %w", err)
+	}
+	return pr, nil
+}
+
 func (r *GitRepository) UpdatePullRequest(ctx context.Context, pr *models.PullRequest) error {
 	pr.UpdatedAt = time.Now()
 	_, err := r.db.NewUpdate().Model(pr).WherePK().Exec(ctx)
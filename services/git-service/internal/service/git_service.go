@@ -72,6 +72,53 @@ func (s *GitService) ProcessCommit(ctx context.Context, repo *models.Repository,
 	return nil
 }
 
+// ProcessPullRequest saves the state of a pull request from a webhook. When
+// the PR is opened, closed or merged it publishes an event for every issue key
+// in its title or source branch.
+func (s *GitService) ProcessPullRequest(ctx context.Context, repo *models.Repository, pr *models.PullRequest, branch string) error {
+	existing, err := s.repo.GetPullRequestByExternalID(ctx, repo.ID, pr.ExternalID)
+	if err != nil {
+		return fmt.Errorf("failed to get pull request: %w", err)
+	}
+
+	pr.RepositoryID = repo.ID
+	previousStatus := ""
+	if existing == nil {
+		if err := s.repo.CreatePullRequest(ctx, pr); err != nil {
+			return fmt.Errorf("failed to create pull request: %w", err)
+		}
+	} else {
+		previousStatus = existing.Status
+		pr.ID = existing.ID
+		pr.CreatedAt = existing.CreatedAt
+		if err := s.repo.UpdatePullRequest(ctx, pr); err != nil {
+			return fmt.Errorf("failed to update pull request: %w", err)
+		}
+	}
+
+	// Edits and new commits on an open PR do not change its status
+	if pr.Status == previousStatus {
+		return nil
+	}
+
+	for _, key := range s.findIssueKeys(pr.Title + " " + branch) {
+		s.log.Sugar().Infow("Found issue key in pull request", "key", key, "pull_request", pr.ExternalID, "status", pr.Status)
+
+		s.publishEvent("git.pull_request_"+pr.Status, repo.ProjectID, map[string]interface{}{
+			"pull_request_id": pr.ID,
+			"issue_key":       key,
+			"title":           pr.Title,
+			"branch":          branch,
+			"status":          pr.Status,
+			"repository":      repo.Name,
+			"author":          pr.AuthorName,
+			"url":             pr.URL,
+		})
+	}
+
+	return nil
+}
+
 // GetIssueCommits gets commits linked to an issue
 func (s *GitService) GetIssueCommits(ctx context.Context, issueID string) ([]*models.Commit, error) {
 	return s.repo.GetIssueCommits(ctx, issueID)
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"net"
@@ -8,6 +9,7 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	_ "time/tzdata" // Schedule timezones must resolve in minimal images
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
@@ -89,13 +91,50 @@ func main() {
 	repo := repository.NewIssueRepository(db, log)
 	
 	// Project Service Address (should be in config)
-	projectServiceAddr := "127.0.0.1:50053" // Default
+	projectServiceAddr := "127.0.0.1:50053"  // Default
+	workflowServiceAddr := "127.0.0.1:50055" // Default
+	sprintServiceAddr := "127.0.0.1:50057"   // Default
 	// TODO: Get from config
 	
-	svc, err := service.NewIssueService(repo, producer, log, projectServiceAddr)
+	svc, err := service.NewIssueService(repo, producer, log, projectServiceAddr, workflowServiceAddr, sprintServiceAddr)
 	if err != nil {
 		log.Sugar().Fatalw("Failed to create issue service", "error", err)
 	}
+
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+
+	// Fail bulk jobs whose instance stopped running them, e.g. in a restart
+	reapInterval := cfg.GetInt("bulk_jobs.reap_interval_seconds")
+	if reapInterval <= 0 {
+		reapInterval = 60
+	}
+	go svc.RunBulkJobReaper(backgroundCtx, time.Duration(reapInterval)*time.Second)
+
+	// Purge issues that have been in the trash longer than the retention period
+	retentionDays := cfg.GetInt("trash.retention_days")
+	if retentionDays <= 0 {
+		retentionDays = 30
+	}
+	purgeInterval := cfg.GetInt("trash.purge_interval_minutes")
+	if purgeInterval <= 0 {
+		purgeInterval = 60
+	}
+	go svc.RunTrashRetention(backgroundCtx, time.Duration(retentionDays)*24*time.Hour, time.Duration(purgeInterval)*time.Minute)
+
+	// Create recurring issues; the first run catches up after downtime
+	schedulerInterval := cfg.GetInt("schedules.poll_interval_seconds")
+	if schedulerInterval <= 0 {
+		schedulerInterval = 60
+	}
+	go svc.RunIssueScheduler(backgroundCtx, time.Duration(schedulerInterval)*time.Second)
+
+	// Respread backlog ranks that grew long from repeated moves
+	rebalanceInterval := cfg.GetInt("ranks.rebalance_interval_minutes")
+	if rebalanceInterval <= 0 {
+		rebalanceInterval = 60
+	}
+	go svc.RunRankRebalancer(backgroundCtx, time.Duration(rebalanceInterval)*time.Minute)
 	
 	h := handler.NewIssueHandler(svc, log)
 
@@ -7,6 +7,7 @@ require (
 	github.com/google/uuid v1.6.0
 	github.com/nexusflow/nexusflow/pkg/config v0.0.0-00010101000000-000000000000
 	github.com/nexusflow/nexusflow/pkg/database v0.0.0-00010101000000-000000000000
+	github.com/nexusflow/nexusflow/pkg/etag v0.0.0-00010101000000-000000000000
 	github.com/nexusflow/nexusflow/pkg/kafka v0.0.0-00010101000000-000000000000
 	github.com/nexusflow/nexusflow/pkg/logger v0.0.0-00010101000000-000000000000
 	github.com/nexusflow/nexusflow/pkg/proto v0.0.0-00010101000000-000000000000
@@ -75,6 +76,7 @@ require (
 replace (
 	github.com/nexusflow/nexusflow/pkg/config => ../../pkg/config
 	github.com/nexusflow/nexusflow/pkg/database => ../../pkg/database
+	github.com/nexusflow/nexusflow/pkg/etag => ../../pkg/etag
 	github.com/nexusflow/nexusflow/pkg/kafka => ../../pkg/kafka
 	github.com/nexusflow/nexusflow/pkg/logger => ../../pkg/logger
 	github.com/nexusflow/nexusflow/pkg/proto => ../../pkg/proto
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Bulk operations
+
+func (h *IssueHandler) BulkUpdateIssues(ctx context.Context, req *pb.BulkUpdateIssuesRequest) (*pb.BulkUpdateIssuesResponse, error) {
+	input := service.BulkUpdateIssuesInput{
+		IssueIDs: req.IssueIds,
+		UserID:   req.UserId,
+		Changes: models.BulkChanges{
+			AssigneeID: req.AssigneeId,
+			StatusID:   req.StatusId,
+			SprintID:   req.SprintId,
+			Delete:     req.Delete,
+		},
+	}
+	if req.Priority != nil {
+		priority := h.protoPriorityToModel(*req.Priority)
+		input.Changes.Priority = &priority
+	}
+	if req.Filter != nil {
+		filter := h.issueFilterFromProto(req.Filter)
+		input.Filter = &filter
+	}
+
+	job, err := h.service.BulkUpdateIssues(ctx, input)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to start bulk update", "error", err)
+		return nil, h.errorToStatus(err, "failed to start bulk update")
+	}
+
+	return &pb.BulkUpdateIssuesResponse{
+		Job: jobToProto(job),
+	}, nil
+}
+
+func (h *IssueHandler) GetJob(ctx context.Context, req *pb.GetJobRequest) (*pb.GetJobResponse, error) {
+	job, err := h.service.GetJob(ctx, req.Id)
+	if err != nil {
+		return nil, h.errorToStatus(err, "failed to get job")
+	}
+
+	return &pb.GetJobResponse{
+		Job: jobToProto(job),
+	}, nil
+}
+
+func jobToProto(j *models.BulkJob) *pb.Job {
+	job := &pb.Job{
+		Id:         j.ID,
+		Status:     jobStatusToProto(j.Status),
+		Total:      int32(j.Total),
+		Processed:  int32(j.Processed),
+		Succeeded:  int32(j.Succeeded),
+		Failed:     int32(j.Failed),
+		Error:      j.Error,
+		CreatedBy:  j.CreatedBy,
+		CreatedAt:  timestamppb.New(j.CreatedAt),
+		StartedAt:  optionalTimestamp(j.StartedAt),
+		FinishedAt: optionalTimestamp(j.FinishedAt),
+	}
+	for _, e := range j.Errors {
+		job.Errors = append(job.Errors, &pb.JobItemError{IssueId: e.IssueID, Message: e.Message})
+	}
+	return job
+}
+
+func jobStatusToProto(s models.BulkJobStatus) pb.JobStatus {
+	switch s {
+	case models.BulkJobStatusPending:
+		return pb.JobStatus_JOB_STATUS_PENDING
+	case models.BulkJobStatusRunning:
+		return pb.JobStatus_JOB_STATUS_RUNNING
+	case models.BulkJobStatusCompleted:
+		return pb.JobStatus_JOB_STATUS_COMPLETED
+	case models.BulkJobStatusCompletedWithErrors:
+		return pb.JobStatus_JOB_STATUS_COMPLETED_WITH_ERRORS
+	case models.BulkJobStatusFailed:
+		return pb.JobStatus_JOB_STATUS_FAILED
+	default:
+		return pb.JobStatus_JOB_STATUS_UNSPECIFIED
+	}
+}
+
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// SetIssuesSprint moves issues into a sprint or to the backlog
+func (h *IssueHandler) SetIssuesSprint(ctx context.Context, req *pb.SetIssuesSprintRequest) (*pb.SetIssuesSprintResponse, error) {
+	changed, err := h.service.SetIssuesSprint(ctx, req.IssueIds, req.SprintId)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to set issues sprint", "error", err)
+		return nil, h.errorToStatus(err, "failed to set issues sprint")
+	}
+	return &pb.SetIssuesSprintResponse{IssueIds: changed}, nil
+}
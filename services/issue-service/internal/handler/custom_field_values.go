@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/service"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// protoCustomFieldsToMap unpacks custom field values into plain Go values.
+// The service validates them against the field definitions.
+func (h *IssueHandler) protoCustomFieldsToMap(fields []*pb.CustomFieldValue) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		if f.FieldId == "" {
+			return nil, fmt.Errorf("custom field value is missing field_id")
+		}
+		value, err := anyToValue(f.Value)
+		if err != nil {
+			return nil, fmt.Errorf("custom field %s: %w", f.FieldId, err)
+		}
+		result[f.FieldId] = value
+	}
+	return result, nil
+}
+
+// customValuesToProto packs stored custom field values for the response
+func (h *IssueHandler) customValuesToProto(values []*models.IssueCustomValue) []*pb.CustomFieldValue {
+	var result []*pb.CustomFieldValue
+	for _, v := range values {
+		if v.Field == nil {
+			continue
+		}
+		packed, err := valueToAny(v.Field.Type, v.Value)
+		if err != nil {
+			h.log.Sugar().Warnw("Failed to encode custom field value", "error", err, "issue_id", v.IssueID, "field_id", v.FieldID)
+			continue
+		}
+		result = append(result, &pb.CustomFieldValue{
+			FieldId: v.FieldID,
+			Value:   packed,
+		})
+	}
+	return result
+}
+
+// customFieldMapToProto packs plain custom field values, such as those of an
+// issue schedule blueprint, as generic struct values
+func (h *IssueHandler) customFieldMapToProto(fields map[string]interface{}) []*pb.CustomFieldValue {
+	result := make([]*pb.CustomFieldValue, 0, len(fields))
+	for fieldID, value := range fields {
+		v, err := structpb.NewValue(value)
+		if err != nil {
+			h.log.Sugar().Warnw("Failed to encode custom field value", "error", err, "field_id", fieldID)
+			continue
+		}
+		packed, err := anypb.New(v)
+		if err != nil {
+			h.log.Sugar().Warnw("Failed to encode custom field value", "error", err, "field_id", fieldID)
+			continue
+		}
+		result = append(result, &pb.CustomFieldValue{FieldId: fieldID, Value: packed})
+	}
+	return result
+}
+
+// anyToValue converts a packed well-known type into a plain Go value
+func anyToValue(value *anypb.Any) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	msg, err := value.UnmarshalNew()
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack value: %w", err)
+	}
+
+	switch v := msg.(type) {
+	case *wrapperspb.StringValue:
+		return v.Value, nil
+	case *wrapperspb.BoolValue:
+		return v.Value, nil
+	case *wrapperspb.DoubleValue:
+		return v.Value, nil
+	case *wrapperspb.FloatValue:
+		return float64(v.Value), nil
+	case *wrapperspb.Int32Value:
+		return float64(v.Value), nil
+	case *wrapperspb.Int64Value:
+		return float64(v.Value), nil
+	case *wrapperspb.UInt32Value:
+		return float64(v.Value), nil
+	case *wrapperspb.UInt64Value:
+		return float64(v.Value), nil
+	case *timestamppb.Timestamp:
+		return v.AsTime(), nil
+	case *structpb.ListValue:
+		return v.AsSlice(), nil
+	case *structpb.Value:
+		return v.AsInterface(), nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %s", value.GetTypeUrl())
+	}
+}
+
+// valueToAny packs a stored value using the well-known type for the field type
+func valueToAny(fieldType models.CustomFieldType, value interface{}) (*anypb.Any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	var msg proto.Message
+	switch fieldType {
+	case models.CustomFieldTypeNumber:
+		n, ok := value.(float64)
+		if !ok {
+			return nil, fmt.Errorf("expected number, got %T", value)
+		}
+		msg = wrapperspb.Double(n)
+	case models.CustomFieldTypeCheckbox:
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected boolean, got %T", value)
+		}
+		msg = wrapperspb.Bool(b)
+	case models.CustomFieldTypeDate:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected date string, got %T", value)
+		}
+		d, err := time.Parse(service.CustomFieldDateLayout, s)
+		if err != nil {
+			return nil, err
+		}
+		msg = timestamppb.New(d)
+	case models.CustomFieldTypeMultiSelect:
+		var items []interface{}
+		switch v := value.(type) {
+		case []interface{}:
+			items = v
+		case []string:
+			for _, s := range v {
+				items = append(items, s)
+			}
+		default:
+			return nil, fmt.Errorf("expected list, got %T", value)
+		}
+		list, err := structpb.NewList(items)
+		if err != nil {
+			return nil, err
+		}
+		msg = list
+	default:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected string, got %T", value)
+		}
+		msg = wrapperspb.String(s)
+	}
+
+	return anypb.New(msg)
+}
@@ -2,14 +2,18 @@ package handler
 
 import (
 	"context"
+	"errors"
 
+	"github.com/nexusflow/nexusflow/pkg/etag"
 	"github.com/nexusflow/nexusflow/pkg/logger"
 	commonpb "github.com/nexusflow/nexusflow/pkg/proto/common/v1"
 	pb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
 	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/repository"
 	"github.com/nexusflow/nexusflow/services/issue-service/internal/service"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -33,33 +37,55 @@ func (h *IssueHandler) CreateIssue(ctx context.Context, req *pb.CreateIssueReque
 	// TODO: Extract user ID from context
 	userID := "00000000-0000-0000-0000-000000000000" // Placeholder
 
+	customFields, err := h.protoCustomFieldsToMap(req.CustomFields)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	input := service.CreateIssueInput{
-		ProjectID:   req.ProjectId,
-		Summary:     req.Summary,
-		Description: req.Description,
-		Type:        h.protoTypeToModel(req.Type),
-		Priority:    h.protoPriorityToModel(req.Priority),
-		AssigneeID:  req.AssigneeId,
-		ReporterID:  userID,
-		ParentID:    req.ParentId,
-		CustomFields: h.protoCustomFieldsToMap(req.CustomFields),
+		ProjectID:    req.ProjectId,
+		Summary:      req.Summary,
+		Description:  req.Description,
+		AssigneeID:   req.AssigneeId,
+		ReporterID:   userID,
+		ParentID:     req.ParentId,
+		LabelIDs:     req.LabelIds,
+		ComponentIDs: req.ComponentIds,
+		CustomFields: customFields,
+		TemplateID:   req.TemplateId,
+
+		OriginalEstimate: req.OriginalEstimateSeconds,
+	}
+	// Unset type and priority are left to the template or the service defaults
+	if req.Type != pb.IssueType_ISSUE_TYPE_UNSPECIFIED {
+		input.Type = h.protoTypeToModel(req.Type)
+	}
+	if req.Priority != pb.IssuePriority_ISSUE_PRIORITY_UNSPECIFIED {
+		input.Priority = h.protoPriorityToModel(req.Priority)
 	}
 
 	issue, err := h.service.CreateIssue(ctx, input)
 	if err != nil {
 		h.log.Sugar().Errorw("Failed to create issue", "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to create issue: %v", err)
+		return nil, h.errorToStatus(err, "failed to create issue")
 	}
 
+	etag.Set(ctx, issue.Version)
 	return &pb.CreateIssueResponse{
-		Issue: h.issueToProto(issue),
+		Issue: h.issueWithValuesToProto(ctx, issue),
 	}, nil
 }
 
 // UpdateIssue updates an issue
 func (h *IssueHandler) UpdateIssue(ctx context.Context, req *pb.UpdateIssueRequest) (*pb.UpdateIssueResponse, error) {
+	version, err := etag.ExpectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
 	input := service.UpdateIssueInput{
-		ID: req.Id,
+		ID:              req.Id,
+		UserID:          req.UserId,
+		ExpectedVersion: version,
 	}
 	if req.Summary != nil {
 		input.Summary = req.Summary
@@ -73,16 +99,42 @@ func (h *IssueHandler) UpdateIssue(ctx context.Context, req *pb.UpdateIssueReque
 	if req.AssigneeId != nil {
 		input.AssigneeID = req.AssigneeId
 	}
-	// Priority handling if needed
+	input.OriginalEstimate = req.OriginalEstimateSeconds
+	input.RemainingEstimate = req.RemainingEstimateSeconds
+	if req.Priority != nil && *req.Priority != pb.IssuePriority_ISSUE_PRIORITY_UNSPECIFIED {
+		priority := h.protoPriorityToModel(*req.Priority)
+		input.Priority = &priority
+	}
+	if len(req.LabelIds) > 0 || containsPath(req.UpdateMask, "label_ids") {
+		input.LabelIDs = req.LabelIds
+		input.SetLabelIDs = true
+	}
+	if len(req.ComponentIds) > 0 || containsPath(req.UpdateMask, "component_ids") {
+		input.ComponentIDs = req.ComponentIds
+		input.SetComponentIDs = true
+	}
+	if len(req.CustomFields) > 0 {
+		customFields, err := h.protoCustomFieldsToMap(req.CustomFields)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		input.CustomFields = customFields
+	}
 
 	issue, err := h.service.UpdateIssue(ctx, input)
 	if err != nil {
+		var conflict *service.VersionConflictError
+		if errors.As(err, &conflict) {
+			etag.Set(ctx, conflict.Current.Version)
+			return nil, etag.ConflictStatus(err.Error(), h.issueWithValuesToProto(ctx, conflict.Current))
+		}
 		h.log.Sugar().Errorw("Failed to update issue", "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to update issue: %v", err)
+		return nil, h.errorToStatus(err, "failed to update issue")
 	}
 
+	etag.Set(ctx, issue.Version)
 	return &pb.UpdateIssueResponse{
-		Issue: h.issueToProto(issue),
+		Issue: h.issueWithValuesToProto(ctx, issue),
 	}, nil
 }
 
@@ -97,8 +149,9 @@ func (h *IssueHandler) GetIssue(ctx context.Context, req *pb.GetIssueRequest) (*
 		return nil, status.Error(codes.NotFound, "issue not found")
 	}
 
+	etag.Set(ctx, issue.Version)
 	return &pb.GetIssueResponse{
-		Issue: h.issueToProto(issue),
+		Issue: h.issueWithValuesToProto(ctx, issue),
 	}, nil
 }
 
@@ -113,58 +166,113 @@ func (h *IssueHandler) GetIssueByKey(ctx context.Context, req *pb.GetIssueByKeyR
 		return nil, status.Error(codes.NotFound, "issue not found")
 	}
 
+	etag.Set(ctx, issue.Version)
 	return &pb.GetIssueByKeyResponse{
-		Issue: h.issueToProto(issue),
+		Issue: h.issueWithValuesToProto(ctx, issue),
 	}, nil
 }
 
+// BatchGetIssues gets several issues in one call
+func (h *IssueHandler) BatchGetIssues(ctx context.Context, req *pb.BatchGetIssuesRequest) (*pb.BatchGetIssuesResponse, error) {
+	issues, err := h.service.BatchGetIssues(ctx, req.Ids)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to batch get issues", "error", err)
+		return nil, h.errorToStatus(err, "failed to get issues")
+	}
+	return &pb.BatchGetIssuesResponse{Issues: h.issuesToProto(ctx, issues)}, nil
+}
+
 // ListIssues lists issues
 func (h *IssueHandler) ListIssues(ctx context.Context, req *pb.ListIssuesRequest) (*pb.ListIssuesResponse, error) {
-	page := 1
-	pageSize := 10
+	input := service.ListIssuesInput{Filter: h.issueFilterFromProto(req)}
 	if req.Pagination != nil {
-		page = int(req.Pagination.Page)
-		pageSize = int(req.Pagination.PageSize)
+		input.PageSize = int(req.Pagination.PageSize)
+		input.SortBy = req.Pagination.SortBy
+		input.SortOrder = req.Pagination.SortOrder
+		input.Cursor = req.Pagination.Cursor
 	}
 
-	issues, count, err := h.service.ListIssues(ctx, req.ProjectId, page, pageSize)
+	result, err := h.service.ListIssues(ctx, input)
 	if err != nil {
 		h.log.Sugar().Errorw("Failed to list issues", "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to list issues: %v", err)
-	}
-
-	var pbIssues []*pb.Issue
-	for _, i := range issues {
-		pbIssues = append(pbIssues, h.issueToProto(i))
+		return nil, h.errorToStatus(err, "failed to list issues")
 	}
 
 	return &pb.ListIssuesResponse{
-		Issues: pbIssues,
+		Issues: h.issuesToProto(ctx, result.Issues),
 		Pagination: &commonpb.PaginationResponse{
-			Page:       int32(page),
-			PageSize:   int32(pageSize),
-			TotalItems: int64(count),
-			TotalPages: int32((count + pageSize - 1) / pageSize),
+			PageSize:       int32(result.PageSize),
+			TotalItems:     int64(result.Total),
+			TotalPages:     int32((result.Total + result.PageSize - 1) / result.PageSize),
+			HasNext:        result.HasNext,
+			HasPrevious:    result.HasPrevious,
+			NextCursor:     result.NextCursor,
+			PreviousCursor: result.PreviousCursor,
 		},
 	}, nil
 }
 
+// issueFilterFromProto converts the filter fields of a list request
+func (h *IssueHandler) issueFilterFromProto(req *pb.ListIssuesRequest) repository.IssueFilter {
+	filter := repository.IssueFilter{
+		ProjectID:    req.ProjectId,
+		StatusIDs:    req.StatusIds,
+		AssigneeID:   req.AssigneeId,
+		ReporterID:   req.ReporterId,
+		SprintID:     req.SprintId,
+		ParentID:     req.ParentId,
+		LabelIDs:     req.LabelIds,
+		ComponentIDs: req.ComponentIds,
+		Backlog:      req.Backlog,
+	}
+	if req.Type != pb.IssueType_ISSUE_TYPE_UNSPECIFIED {
+		filter.Types = []models.IssueType{h.protoTypeToModel(req.Type)}
+	}
+	for _, p := range req.Priorities {
+		filter.Priorities = append(filter.Priorities, h.protoPriorityToModel(p))
+	}
+	if req.DueAfter != nil {
+		filter.DueAfter = req.DueAfter.AsTime()
+	}
+	if req.DueBefore != nil {
+		filter.DueBefore = req.DueBefore.AsTime()
+	}
+	if req.UpdatedSince != nil {
+		filter.UpdatedSince = req.UpdatedSince.AsTime()
+	}
+	if req.UpdatedBefore != nil {
+		filter.UpdatedBefore = req.UpdatedBefore.AsTime()
+	}
+	for _, f := range req.CustomFields {
+		filter.CustomFields = append(filter.CustomFields, repository.CustomFieldFilter{FieldID: f.FieldId, Value: f.Value})
+	}
+	return filter
+}
+
 // Custom Fields
 
 func (h *IssueHandler) CreateCustomField(ctx context.Context, req *pb.CreateCustomFieldRequest) (*pb.CreateCustomFieldResponse, error) {
+	defaultValue, err := anyToValue(req.DefaultValue)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid default value: %v", err)
+	}
+
 	field := &models.CustomField{
-		ProjectID:   req.ProjectId,
-		Name:        req.Name,
-		Description: req.Description,
-		Type:        h.protoCustomFieldTypeToModel(req.Type),
-		Required:    req.Required,
-		Options:     req.Options,
+		ProjectID:      req.ProjectId,
+		OrganizationID: req.OrganizationId,
+		Name:           req.Name,
+		Description:    req.Description,
+		Type:           h.protoCustomFieldTypeToModel(req.Type),
+		Required:       req.Required,
+		DefaultValue:   defaultValue,
+		Options:        req.Options,
+		Config:         req.Config,
 	}
 
 	created, err := h.service.CreateCustomField(ctx, field)
 	if err != nil {
 		h.log.Sugar().Errorw("Failed to create custom field", "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to create custom field: %v", err)
+		return nil, h.errorToStatus(err, "failed to create custom field")
 	}
 
 	return &pb.CreateCustomFieldResponse{
@@ -173,10 +281,15 @@ func (h *IssueHandler) CreateCustomField(ctx context.Context, req *pb.CreateCust
 }
 
 func (h *IssueHandler) ListCustomFields(ctx context.Context, req *pb.ListCustomFieldsRequest) (*pb.ListCustomFieldsResponse, error) {
-	fields, err := h.service.ListCustomFields(ctx, req.ProjectId)
+	var issueType models.IssueType
+	if req.IssueType != pb.IssueType_ISSUE_TYPE_UNSPECIFIED {
+		issueType = h.protoTypeToModel(req.IssueType)
+	}
+
+	fields, err := h.service.ListCustomFields(ctx, req.ProjectId, req.OrganizationId, issueType)
 	if err != nil {
 		h.log.Sugar().Errorw("Failed to list custom fields", "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to list custom fields: %v", err)
+		return nil, h.errorToStatus(err, "failed to list custom fields")
 	}
 
 	var pbFields []*pb.CustomField
@@ -189,32 +302,166 @@ func (h *IssueHandler) ListCustomFields(ctx context.Context, req *pb.ListCustomF
 	}, nil
 }
 
+// Custom Field Contexts
+
+func (h *IssueHandler) CreateCustomFieldContext(ctx context.Context, req *pb.CreateCustomFieldContextRequest) (*pb.CreateCustomFieldContextResponse, error) {
+	defaultValue, err := anyToValue(req.DefaultValue)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid default value: %v", err)
+	}
+
+	fieldCtx := &models.CustomFieldContext{
+		FieldID:      req.FieldId,
+		Name:         req.Name,
+		ProjectIDs:   req.ProjectIds,
+		IssueTypes:   h.protoTypesToModel(req.IssueTypes),
+		Options:      req.Options,
+		DefaultValue: defaultValue,
+	}
+
+	created, err := h.service.CreateCustomFieldContext(ctx, fieldCtx)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to create custom field context", "error", err)
+		return nil, h.errorToStatus(err, "failed to create custom field context")
+	}
+
+	return &pb.CreateCustomFieldContextResponse{
+		Context: h.customFieldContextToProto(created),
+	}, nil
+}
+
+func (h *IssueHandler) UpdateCustomFieldContext(ctx context.Context, req *pb.UpdateCustomFieldContextRequest) (*pb.UpdateCustomFieldContextResponse, error) {
+	input := service.UpdateCustomFieldContextInput{
+		ID:   req.Id,
+		Name: req.Name,
+	}
+	for _, path := range req.UpdateMask {
+		switch path {
+		case "project_ids":
+			input.SetProjectIDs = true
+			input.ProjectIDs = req.ProjectIds
+		case "issue_types":
+			input.SetIssueTypes = true
+			input.IssueTypes = h.protoTypesToModel(req.IssueTypes)
+		case "options":
+			input.SetOptions = true
+			input.Options = req.Options
+		case "default_value":
+			defaultValue, err := anyToValue(req.DefaultValue)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid default value: %v", err)
+			}
+			input.SetDefaultValue = true
+			input.DefaultValue = defaultValue
+		case "name":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown update_mask path %q", path)
+		}
+	}
+
+	updated, err := h.service.UpdateCustomFieldContext(ctx, input)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to update custom field context", "error", err)
+		return nil, h.errorToStatus(err, "failed to update custom field context")
+	}
+
+	return &pb.UpdateCustomFieldContextResponse{
+		Context: h.customFieldContextToProto(updated),
+	}, nil
+}
+
+func (h *IssueHandler) DeleteCustomFieldContext(ctx context.Context, req *pb.DeleteCustomFieldContextRequest) (*pb.DeleteCustomFieldContextResponse, error) {
+	if err := h.service.DeleteCustomFieldContext(ctx, req.Id); err != nil {
+		h.log.Sugar().Errorw("Failed to delete custom field context", "error", err)
+		return nil, h.errorToStatus(err, "failed to delete custom field context")
+	}
+
+	return &pb.DeleteCustomFieldContextResponse{
+		Response: &commonpb.SuccessResponse{Success: true},
+	}, nil
+}
+
+func (h *IssueHandler) ListCustomFieldContexts(ctx context.Context, req *pb.ListCustomFieldContextsRequest) (*pb.ListCustomFieldContextsResponse, error) {
+	contexts, err := h.service.ListCustomFieldContexts(ctx, req.FieldId)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to list custom field contexts", "error", err)
+		return nil, h.errorToStatus(err, "failed to list custom field contexts")
+	}
+
+	var pbContexts []*pb.CustomFieldContext
+	for _, c := range contexts {
+		pbContexts = append(pbContexts, h.customFieldContextToProto(c))
+	}
+
+	return &pb.ListCustomFieldContextsResponse{
+		Contexts: pbContexts,
+	}, nil
+}
+
 // Helpers
 
-func (h *IssueHandler) protoCustomFieldsToMap(fields []*pb.CustomFieldValue) map[string]interface{} {
-	result := make(map[string]interface{})
-	for _, f := range fields {
-		// TODO: Handle Any type properly. For now, assuming string or simple types?
-		// This is tricky with Any.
-		// Let's assume for now we skip complex value parsing or just store nil
-		// Real implementation needs Any unpacking.
-		result[f.FieldId] = f.Value // This won't work directly as it's *anypb.Any
+// errorToStatus maps service errors to gRPC status errors
+func (h *IssueHandler) errorToStatus(err error, msg string) error {
+	switch {
+	case errors.Is(err, service.ErrValidation):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrConflict):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, service.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
 	}
-	return result
 }
 
 func (h *IssueHandler) customFieldToProto(f *models.CustomField) *pb.CustomField {
 	if f == nil {
 		return nil
 	}
+	defaultValue, err := valueToAny(f.Type, f.DefaultValue)
+	if err != nil {
+		h.log.Sugar().Warnw("Failed to encode custom field default value", "error", err, "field_id", f.ID)
+	}
 	return &pb.CustomField{
-		Id:          f.ID,
-		ProjectId:   f.ProjectID,
-		Name:        f.Name,
-		Description: f.Description,
-		// Type:        ... // Need mapping
-		Required:    f.Required,
-		Options:     f.Options,
+		Id:             f.ID,
+		ProjectId:      f.ProjectID,
+		Name:           f.Name,
+		Description:    f.Description,
+		Type:           h.customFieldTypeToProto(f.Type),
+		Required:       f.Required,
+		DefaultValue:   defaultValue,
+		Options:        f.Options,
+		Config:         f.Config,
+		OrganizationId: f.OrganizationID,
+	}
+}
+
+func (h *IssueHandler) customFieldContextToProto(c *models.CustomFieldContext) *pb.CustomFieldContext {
+	if c == nil {
+		return nil
+	}
+	var defaultValue *anypb.Any
+	if c.Field != nil {
+		var err error
+		defaultValue, err = valueToAny(c.Field.Type, c.DefaultValue)
+		if err != nil {
+			h.log.Sugar().Warnw("Failed to encode custom field context default value", "error", err, "context_id", c.ID)
+		}
+	}
+	issueTypes := make([]pb.IssueType, 0, len(c.IssueTypes))
+	for _, t := range c.IssueTypes {
+		issueTypes = append(issueTypes, h.issueTypeToProto(t))
+	}
+	return &pb.CustomFieldContext{
+		Id:           c.ID,
+		FieldId:      c.FieldID,
+		Name:         c.Name,
+		ProjectIds:   c.ProjectIDs,
+		IssueTypes:   issueTypes,
+		Options:      c.Options,
+		DefaultValue: defaultValue,
 	}
 }
 
@@ -224,16 +471,87 @@ func (h *IssueHandler) protoCustomFieldTypeToModel(t pb.CustomFieldType) models.
 		return models.CustomFieldTypeText
 	case pb.CustomFieldType_CUSTOM_FIELD_TYPE_NUMBER:
 		return models.CustomFieldTypeNumber
+	case pb.CustomFieldType_CUSTOM_FIELD_TYPE_DATE:
+		return models.CustomFieldTypeDate
 	case pb.CustomFieldType_CUSTOM_FIELD_TYPE_SELECT:
 		return models.CustomFieldTypeSelect
+	case pb.CustomFieldType_CUSTOM_FIELD_TYPE_MULTI_SELECT:
+		return models.CustomFieldTypeMultiSelect
+	case pb.CustomFieldType_CUSTOM_FIELD_TYPE_USER:
+		return models.CustomFieldTypeUser
+	case pb.CustomFieldType_CUSTOM_FIELD_TYPE_CHECKBOX:
+		return models.CustomFieldTypeCheckbox
+	case pb.CustomFieldType_CUSTOM_FIELD_TYPE_URL:
+		return models.CustomFieldTypeURL
+	case pb.CustomFieldType_CUSTOM_FIELD_TYPE_EMAIL:
+		return models.CustomFieldTypeEmail
+	case pb.CustomFieldType_CUSTOM_FIELD_TYPE_TEXTAREA:
+		return models.CustomFieldTypeTextarea
 	default:
 		return models.CustomFieldTypeText
 	}
 }
 
+func (h *IssueHandler) customFieldTypeToProto(t models.CustomFieldType) pb.CustomFieldType {
+	switch t {
+	case models.CustomFieldTypeText:
+		return pb.CustomFieldType_CUSTOM_FIELD_TYPE_TEXT
+	case models.CustomFieldTypeNumber:
+		return pb.CustomFieldType_CUSTOM_FIELD_TYPE_NUMBER
+	case models.CustomFieldTypeDate:
+		return pb.CustomFieldType_CUSTOM_FIELD_TYPE_DATE
+	case models.CustomFieldTypeSelect:
+		return pb.CustomFieldType_CUSTOM_FIELD_TYPE_SELECT
+	case models.CustomFieldTypeMultiSelect:
+		return pb.CustomFieldType_CUSTOM_FIELD_TYPE_MULTI_SELECT
+	case models.CustomFieldTypeUser:
+		return pb.CustomFieldType_CUSTOM_FIELD_TYPE_USER
+	case models.CustomFieldTypeCheckbox:
+		return pb.CustomFieldType_CUSTOM_FIELD_TYPE_CHECKBOX
+	case models.CustomFieldTypeURL:
+		return pb.CustomFieldType_CUSTOM_FIELD_TYPE_URL
+	case models.CustomFieldTypeEmail:
+		return pb.CustomFieldType_CUSTOM_FIELD_TYPE_EMAIL
+	case models.CustomFieldTypeTextarea:
+		return pb.CustomFieldType_CUSTOM_FIELD_TYPE_TEXTAREA
+	default:
+		return pb.CustomFieldType_CUSTOM_FIELD_TYPE_UNSPECIFIED
+	}
+}
+
 // Helpers
 
-func (h *IssueHandler) issueToProto(i *models.Issue) *pb.Issue {
+// issueWithValuesToProto converts an issue and loads its custom field values
+func (h *IssueHandler) issueWithValuesToProto(ctx context.Context, i *models.Issue) *pb.Issue {
+	if i == nil {
+		return nil
+	}
+	values, err := h.service.GetIssueCustomValues(ctx, i.ID)
+	if err != nil {
+		h.log.Sugar().Warnw("Failed to load custom field values", "error", err, "issue_id", i.ID)
+	}
+	return h.issueToProto(i, values)
+}
+
+// issuesToProto converts issues and batch-loads their custom field values
+func (h *IssueHandler) issuesToProto(ctx context.Context, issues []*models.Issue) []*pb.Issue {
+	issueIDs := make([]string, 0, len(issues))
+	for _, i := range issues {
+		issueIDs = append(issueIDs, i.ID)
+	}
+	values, err := h.service.ListIssueCustomValues(ctx, issueIDs)
+	if err != nil {
+		h.log.Sugar().Warnw("Failed to load custom field values", "error", err)
+	}
+
+	var pbIssues []*pb.Issue
+	for _, i := range issues {
+		pbIssues = append(pbIssues, h.issueToProto(i, values[i.ID]))
+	}
+	return pbIssues
+}
+
+func (h *IssueHandler) issueToProto(i *models.Issue, values []*models.IssueCustomValue) *pb.Issue {
 	if i == nil {
 		return nil
 	}
@@ -245,15 +563,29 @@ func (h *IssueHandler) issueToProto(i *models.Issue) *pb.Issue {
 		Description: i.Description,
 		// Type:        pb.IssueType(pb.IssueType_value[string(i.Type)]), // Need mapping
 		// Priority:    pb.IssuePriority(pb.IssuePriority_value[string(i.Priority)]), // Need mapping
-		StatusId:    i.StatusID,
-		AssigneeId:  i.AssigneeID,
-		ReporterId:  i.ReporterID,
-		ParentId:    i.ParentID,
-		SprintId:    i.SprintID,
-		StoryPoints: i.StoryPoints,
-		CreatedAt:   timestamppb.New(i.CreatedAt),
-		UpdatedAt:   timestamppb.New(i.UpdatedAt),
-		DueDate:     timestamppb.New(i.DueDate),
+		StatusId:     i.StatusID,
+		AssigneeId:   i.AssigneeID,
+		ReporterId:   i.ReporterID,
+		ParentId:     i.ParentID,
+		SprintId:     i.SprintID,
+		StoryPoints:  i.StoryPoints,
+		Version:      i.Version,
+		LabelIds:     i.LabelIDs,
+		ComponentIds: i.ComponentIDs,
+		WatcherIds:   i.WatcherIDs,
+		CustomFields: h.customValuesToProto(values),
+		CreatedAt:    timestamppb.New(i.CreatedAt),
+		UpdatedAt:    timestamppb.New(i.UpdatedAt),
+		DueDate:      timestamppb.New(i.DueDate),
+		DeletedAt:    optionalTimestamp(i.DeletedAt),
+		DeletedBy:    i.DeletedBy,
+
+		OriginalEstimateSeconds:  i.OriginalEstimate,
+		RemainingEstimateSeconds: i.RemainingEstimate,
+		TimeSpentSeconds:         i.TimeSpent,
+		ScheduleId:               i.ScheduleID,
+		ScheduledFor:             optionalTimestamp(i.ScheduledFor),
+		Rank:                     i.Rank,
 	}
 }
 
@@ -276,6 +608,42 @@ func (h *IssueHandler) protoTypeToModel(t pb.IssueType) models.IssueType {
 	}
 }
 
+func containsPath(paths []string, path string) bool {
+	for _, p := range paths {
+		if p == path {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *IssueHandler) protoTypesToModel(types []pb.IssueType) []models.IssueType {
+	result := make([]models.IssueType, 0, len(types))
+	for _, t := range types {
+		result = append(result, h.protoTypeToModel(t))
+	}
+	return result
+}
+
+func (h *IssueHandler) issueTypeToProto(t models.IssueType) pb.IssueType {
+	switch t {
+	case models.IssueTypeEpic:
+		return pb.IssueType_ISSUE_TYPE_EPIC
+	case models.IssueTypeStory:
+		return pb.IssueType_ISSUE_TYPE_STORY
+	case models.IssueTypeTask:
+		return pb.IssueType_ISSUE_TYPE_TASK
+	case models.IssueTypeSubTask:
+		return pb.IssueType_ISSUE_TYPE_SUB_TASK
+	case models.IssueTypeBug:
+		return pb.IssueType_ISSUE_TYPE_BUG
+	case models.IssueTypeImprovement:
+		return pb.IssueType_ISSUE_TYPE_IMPROVEMENT
+	default:
+		return pb.IssueType_ISSUE_TYPE_UNSPECIFIED
+	}
+}
+
 func (h *IssueHandler) protoPriorityToModel(p pb.IssuePriority) models.IssuePriority {
 	switch p {
 	case pb.IssuePriority_ISSUE_PRIORITY_LOWEST:
@@ -292,3 +660,20 @@ func (h *IssueHandler) protoPriorityToModel(p pb.IssuePriority) models.IssuePrio
 		return models.IssuePriorityMedium
 	}
 }
+
+func (h *IssueHandler) priorityToProto(p models.IssuePriority) pb.IssuePriority {
+	switch p {
+	case models.IssuePriorityLowest:
+		return pb.IssuePriority_ISSUE_PRIORITY_LOWEST
+	case models.IssuePriorityLow:
+		return pb.IssuePriority_ISSUE_PRIORITY_LOW
+	case models.IssuePriorityMedium:
+		return pb.IssuePriority_ISSUE_PRIORITY_MEDIUM
+	case models.IssuePriorityHigh:
+		return pb.IssuePriority_ISSUE_PRIORITY_HIGH
+	case models.IssuePriorityHighest:
+		return pb.IssuePriority_ISSUE_PRIORITY_HIGHEST
+	default:
+		return pb.IssuePriority_ISSUE_PRIORITY_UNSPECIFIED
+	}
+}
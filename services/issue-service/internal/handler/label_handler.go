@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"context"
+
+	commonpb "github.com/nexusflow/nexusflow/pkg/proto/common/v1"
+	pb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/service"
+)
+
+// Labels
+
+func (h *IssueHandler) CreateLabel(ctx context.Context, req *pb.CreateLabelRequest) (*pb.CreateLabelResponse, error) {
+	label, err := h.service.CreateLabel(ctx, &models.Label{
+		ProjectID:   req.ProjectId,
+		Name:        req.Name,
+		Color:       req.Color,
+		Description: req.Description,
+	})
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to create label", "error", err)
+		return nil, h.errorToStatus(err, "failed to create label")
+	}
+
+	return &pb.CreateLabelResponse{
+		Label: labelToProto(label),
+	}, nil
+}
+
+func (h *IssueHandler) UpdateLabel(ctx context.Context, req *pb.UpdateLabelRequest) (*pb.UpdateLabelResponse, error) {
+	label, err := h.service.UpdateLabel(ctx, service.UpdateLabelInput{
+		ID:          req.Id,
+		Name:        req.Name,
+		Color:       req.Color,
+		Description: req.Description,
+	})
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to update label", "error", err)
+		return nil, h.errorToStatus(err, "failed to update label")
+	}
+
+	return &pb.UpdateLabelResponse{
+		Label: labelToProto(label),
+	}, nil
+}
+
+func (h *IssueHandler) DeleteLabel(ctx context.Context, req *pb.DeleteLabelRequest) (*pb.DeleteLabelResponse, error) {
+	if err := h.service.DeleteLabel(ctx, req.Id); err != nil {
+		h.log.Sugar().Errorw("Failed to delete label", "error", err)
+		return nil, h.errorToStatus(err, "failed to delete label")
+	}
+
+	return &pb.DeleteLabelResponse{
+		Response: &commonpb.SuccessResponse{Success: true},
+	}, nil
+}
+
+func (h *IssueHandler) ListLabels(ctx context.Context, req *pb.ListLabelsRequest) (*pb.ListLabelsResponse, error) {
+	labels, err := h.service.ListLabels(ctx, req.ProjectId)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to list labels", "error", err)
+		return nil, h.errorToStatus(err, "failed to list labels")
+	}
+
+	var pbLabels []*commonpb.Label
+	for _, l := range labels {
+		pbLabels = append(pbLabels, labelToProto(l))
+	}
+
+	return &pb.ListLabelsResponse{
+		Labels: pbLabels,
+	}, nil
+}
+
+func (h *IssueHandler) BulkUpdateIssueLabels(ctx context.Context, req *pb.BulkUpdateIssueLabelsRequest) (*pb.BulkUpdateIssueLabelsResponse, error) {
+	issues, err := h.service.BulkUpdateIssueLabels(ctx, req.IssueIds, req.AddLabelIds, req.RemoveLabelIds)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to bulk update issue labels", "error", err)
+		return nil, h.errorToStatus(err, "failed to update issue labels")
+	}
+
+	return &pb.BulkUpdateIssueLabelsResponse{
+		Issues: h.issuesToProto(ctx, issues),
+	}, nil
+}
+
+// Components
+
+func (h *IssueHandler) CreateComponent(ctx context.Context, req *pb.CreateComponentRequest) (*pb.CreateComponentResponse, error) {
+	component, err := h.service.CreateComponent(ctx, &models.Component{
+		ProjectID:         req.ProjectId,
+		Name:              req.Name,
+		Description:       req.Description,
+		LeadID:            req.LeadId,
+		DefaultAssigneeID: req.DefaultAssigneeId,
+	})
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to create component", "error", err)
+		return nil, h.errorToStatus(err, "failed to create component")
+	}
+
+	return &pb.CreateComponentResponse{
+		Component: componentToProto(component),
+	}, nil
+}
+
+func (h *IssueHandler) UpdateComponent(ctx context.Context, req *pb.UpdateComponentRequest) (*pb.UpdateComponentResponse, error) {
+	component, err := h.service.UpdateComponent(ctx, service.UpdateComponentInput{
+		ID:                req.Id,
+		Name:              req.Name,
+		Description:       req.Description,
+		LeadID:            req.LeadId,
+		DefaultAssigneeID: req.DefaultAssigneeId,
+	})
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to update component", "error", err)
+		return nil, h.errorToStatus(err, "failed to update component")
+	}
+
+	return &pb.UpdateComponentResponse{
+		Component: componentToProto(component),
+	}, nil
+}
+
+func (h *IssueHandler) DeleteComponent(ctx context.Context, req *pb.DeleteComponentRequest) (*pb.DeleteComponentResponse, error) {
+	if err := h.service.DeleteComponent(ctx, req.Id); err != nil {
+		h.log.Sugar().Errorw("Failed to delete component", "error", err)
+		return nil, h.errorToStatus(err, "failed to delete component")
+	}
+
+	return &pb.DeleteComponentResponse{
+		Response: &commonpb.SuccessResponse{Success: true},
+	}, nil
+}
+
+func (h *IssueHandler) ListComponents(ctx context.Context, req *pb.ListComponentsRequest) (*pb.ListComponentsResponse, error) {
+	components, err := h.service.ListComponents(ctx, req.ProjectId)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to list components", "error", err)
+		return nil, h.errorToStatus(err, "failed to list components")
+	}
+
+	var pbComponents []*pb.Component
+	for _, c := range components {
+		pbComponents = append(pbComponents, componentToProto(c))
+	}
+
+	return &pb.ListComponentsResponse{
+		Components: pbComponents,
+	}, nil
+}
+
+func (h *IssueHandler) BulkUpdateIssueComponents(ctx context.Context, req *pb.BulkUpdateIssueComponentsRequest) (*pb.BulkUpdateIssueComponentsResponse, error) {
+	issues, err := h.service.BulkUpdateIssueComponents(ctx, req.IssueIds, req.AddComponentIds, req.RemoveComponentIds)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to bulk update issue components", "error", err)
+		return nil, h.errorToStatus(err, "failed to update issue components")
+	}
+
+	return &pb.BulkUpdateIssueComponentsResponse{
+		Issues: h.issuesToProto(ctx, issues),
+	}, nil
+}
+
+// Helpers
+
+func labelToProto(l *models.Label) *commonpb.Label {
+	if l == nil {
+		return nil
+	}
+	return &commonpb.Label{
+		Id:          l.ID,
+		Name:        l.Name,
+		Color:       l.Color,
+		Description: l.Description,
+	}
+}
+
+func componentToProto(c *models.Component) *pb.Component {
+	if c == nil {
+		return nil
+	}
+	return &pb.Component{
+		Id:                c.ID,
+		ProjectId:         c.ProjectID,
+		Name:              c.Name,
+		Description:       c.Description,
+		LeadId:            c.LeadID,
+		DefaultAssigneeId: c.DefaultAssigneeID,
+	}
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/service"
+)
+
+// Backlog ranking
+
+func (h *IssueHandler) RankIssueBefore(ctx context.Context, req *pb.RankIssueBeforeRequest) (*pb.RankIssueBeforeResponse, error) {
+	issues, err := h.service.RankIssues(ctx, service.RankIssuesInput{
+		IssueIDs: []string{req.IssueId},
+		BeforeID: req.BeforeIssueId,
+		UserID:   req.UserId,
+	})
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to rank issue", "error", err)
+		return nil, h.errorToStatus(err, "failed to rank issue")
+	}
+	return &pb.RankIssueBeforeResponse{Issue: h.issuesToProto(ctx, issues)[0]}, nil
+}
+
+func (h *IssueHandler) RankIssueAfter(ctx context.Context, req *pb.RankIssueAfterRequest) (*pb.RankIssueAfterResponse, error) {
+	issues, err := h.service.RankIssues(ctx, service.RankIssuesInput{
+		IssueIDs: []string{req.IssueId},
+		AfterID:  req.AfterIssueId,
+		UserID:   req.UserId,
+	})
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to rank issue", "error", err)
+		return nil, h.errorToStatus(err, "failed to rank issue")
+	}
+	return &pb.RankIssueAfterResponse{Issue: h.issuesToProto(ctx, issues)[0]}, nil
+}
+
+func (h *IssueHandler) RankIssues(ctx context.Context, req *pb.RankIssuesRequest) (*pb.RankIssuesResponse, error) {
+	issues, err := h.service.RankIssues(ctx, service.RankIssuesInput{
+		IssueIDs: req.IssueIds,
+		BeforeID: req.BeforeIssueId,
+		AfterID:  req.AfterIssueId,
+		UserID:   req.UserId,
+	})
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to rank issues", "error", err)
+		return nil, h.errorToStatus(err, "failed to rank issues")
+	}
+	return &pb.RankIssuesResponse{Issues: h.issuesToProto(ctx, issues)}, nil
+}
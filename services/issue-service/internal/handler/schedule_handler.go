@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Recurring issues
+
+func (h *IssueHandler) CreateIssueSchedule(ctx context.Context, req *pb.CreateIssueScheduleRequest) (*pb.CreateIssueScheduleResponse, error) {
+	schedule, err := h.protoToIssueSchedule(req.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	schedule.CreatedBy = req.UserId
+
+	schedule, err = h.service.CreateIssueSchedule(ctx, schedule)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to create issue schedule", "error", err)
+		return nil, h.errorToStatus(err, "failed to create issue schedule")
+	}
+
+	return &pb.CreateIssueScheduleResponse{
+		Schedule: h.issueScheduleToProto(schedule),
+	}, nil
+}
+
+func (h *IssueHandler) GetIssueSchedule(ctx context.Context, req *pb.GetIssueScheduleRequest) (*pb.GetIssueScheduleResponse, error) {
+	schedule, err := h.service.GetIssueSchedule(ctx, req.Id)
+	if err != nil {
+		return nil, h.errorToStatus(err, "failed to get issue schedule")
+	}
+
+	return &pb.GetIssueScheduleResponse{
+		Schedule: h.issueScheduleToProto(schedule),
+	}, nil
+}
+
+func (h *IssueHandler) UpdateIssueSchedule(ctx context.Context, req *pb.UpdateIssueScheduleRequest) (*pb.UpdateIssueScheduleResponse, error) {
+	schedule, err := h.protoToIssueSchedule(req.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	schedule.ID = req.Id
+
+	schedule, err = h.service.UpdateIssueSchedule(ctx, schedule)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to update issue schedule", "error", err)
+		return nil, h.errorToStatus(err, "failed to update issue schedule")
+	}
+
+	return &pb.UpdateIssueScheduleResponse{
+		Schedule: h.issueScheduleToProto(schedule),
+	}, nil
+}
+
+func (h *IssueHandler) DeleteIssueSchedule(ctx context.Context, req *pb.DeleteIssueScheduleRequest) (*pb.DeleteIssueScheduleResponse, error) {
+	if err := h.service.DeleteIssueSchedule(ctx, req.Id); err != nil {
+		h.log.Sugar().Errorw("Failed to delete issue schedule", "error", err)
+		return nil, h.errorToStatus(err, "failed to delete issue schedule")
+	}
+
+	return &pb.DeleteIssueScheduleResponse{}, nil
+}
+
+func (h *IssueHandler) ListIssueSchedules(ctx context.Context, req *pb.ListIssueSchedulesRequest) (*pb.ListIssueSchedulesResponse, error) {
+	schedules, err := h.service.ListIssueSchedules(ctx, req.ProjectId)
+	if err != nil {
+		return nil, h.errorToStatus(err, "failed to list issue schedules")
+	}
+
+	result := make([]*pb.IssueSchedule, 0, len(schedules))
+	for _, s := range schedules {
+		result = append(result, h.issueScheduleToProto(s))
+	}
+	return &pb.ListIssueSchedulesResponse{
+		Schedules: result,
+	}, nil
+}
+
+// protoToIssueSchedule reads the writable fields of a schedule
+func (h *IssueHandler) protoToIssueSchedule(s *pb.IssueSchedule) (*models.IssueSchedule, error) {
+	if s == nil {
+		return nil, status.Error(codes.InvalidArgument, "schedule is required")
+	}
+	customFields, err := h.protoCustomFieldsToMap(s.CustomFields)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	schedule := &models.IssueSchedule{
+		ProjectID:    s.ProjectId,
+		Name:         s.Name,
+		Summary:      s.Summary,
+		Description:  s.Description,
+		Type:         h.protoTypeToModel(s.Type),
+		Priority:     h.protoPriorityToModel(s.Priority),
+		AssigneeID:   s.AssigneeId,
+		CustomFields: customFields,
+		RRule:        s.Rrule,
+		Timezone:     s.Timezone,
+		CatchUp:      protoCatchUpToModel(s.CatchUp),
+		Enabled:      s.Enabled,
+	}
+	if s.StartsAt != nil {
+		schedule.StartsAt = s.StartsAt.AsTime()
+	}
+	if s.EndsAt != nil {
+		schedule.EndsAt = s.EndsAt.AsTime()
+	}
+	return schedule, nil
+}
+
+func (h *IssueHandler) issueScheduleToProto(s *models.IssueSchedule) *pb.IssueSchedule {
+	return &pb.IssueSchedule{
+		Id:           s.ID,
+		ProjectId:    s.ProjectID,
+		Name:         s.Name,
+		Summary:      s.Summary,
+		Description:  s.Description,
+		Type:         h.issueTypeToProto(s.Type),
+		Priority:     h.priorityToProto(s.Priority),
+		AssigneeId:   s.AssigneeID,
+		CustomFields: h.customFieldMapToProto(s.CustomFields),
+		Rrule:        s.RRule,
+		Timezone:     s.Timezone,
+		StartsAt:     timestamppb.New(s.StartsAt),
+		EndsAt:       optionalTimestamp(s.EndsAt),
+		CatchUp:      catchUpToProto(s.CatchUp),
+		Enabled:      s.Enabled,
+		NextRunAt:    optionalTimestamp(s.NextRunAt),
+		LastRunAt:    optionalTimestamp(s.LastRunAt),
+		LastError:    s.LastError,
+		CreatedBy:    s.CreatedBy,
+		CreatedAt:    timestamppb.New(s.CreatedAt),
+		UpdatedAt:    timestamppb.New(s.UpdatedAt),
+	}
+}
+
+func protoCatchUpToModel(c pb.ScheduleCatchUp) models.ScheduleCatchUp {
+	switch c {
+	case pb.ScheduleCatchUp_SCHEDULE_CATCH_UP_NONE:
+		return models.ScheduleCatchUpNone
+	case pb.ScheduleCatchUp_SCHEDULE_CATCH_UP_ALL:
+		return models.ScheduleCatchUpAll
+	default:
+		return models.ScheduleCatchUpLatest
+	}
+}
+
+func catchUpToProto(c models.ScheduleCatchUp) pb.ScheduleCatchUp {
+	switch c {
+	case models.ScheduleCatchUpNone:
+		return pb.ScheduleCatchUp_SCHEDULE_CATCH_UP_NONE
+	case models.ScheduleCatchUpLatest:
+		return pb.ScheduleCatchUp_SCHEDULE_CATCH_UP_LATEST
+	case models.ScheduleCatchUpAll:
+		return pb.ScheduleCatchUp_SCHEDULE_CATCH_UP_ALL
+	default:
+		return pb.ScheduleCatchUp_SCHEDULE_CATCH_UP_UNSPECIFIED
+	}
+}
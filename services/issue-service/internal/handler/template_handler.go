@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Issue templates
+
+func (h *IssueHandler) CreateIssueTemplate(ctx context.Context, req *pb.CreateIssueTemplateRequest) (*pb.CreateIssueTemplateResponse, error) {
+	template, err := h.protoToIssueTemplate(req.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err = h.service.CreateIssueTemplate(ctx, template)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to create issue template", "error", err)
+		return nil, h.errorToStatus(err, "failed to create issue template")
+	}
+
+	return &pb.CreateIssueTemplateResponse{
+		Template: h.issueTemplateToProto(template),
+	}, nil
+}
+
+func (h *IssueHandler) GetIssueTemplate(ctx context.Context, req *pb.GetIssueTemplateRequest) (*pb.GetIssueTemplateResponse, error) {
+	template, err := h.service.GetIssueTemplate(ctx, req.Id)
+	if err != nil {
+		return nil, h.errorToStatus(err, "failed to get issue template")
+	}
+
+	return &pb.GetIssueTemplateResponse{
+		Template: h.issueTemplateToProto(template),
+	}, nil
+}
+
+func (h *IssueHandler) UpdateIssueTemplate(ctx context.Context, req *pb.UpdateIssueTemplateRequest) (*pb.UpdateIssueTemplateResponse, error) {
+	template, err := h.protoToIssueTemplate(req.Template)
+	if err != nil {
+		return nil, err
+	}
+	template.ID = req.Id
+
+	template, err = h.service.UpdateIssueTemplate(ctx, template)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to update issue template", "error", err)
+		return nil, h.errorToStatus(err, "failed to update issue template")
+	}
+
+	return &pb.UpdateIssueTemplateResponse{
+		Template: h.issueTemplateToProto(template),
+	}, nil
+}
+
+func (h *IssueHandler) DeleteIssueTemplate(ctx context.Context, req *pb.DeleteIssueTemplateRequest) (*pb.DeleteIssueTemplateResponse, error) {
+	if err := h.service.DeleteIssueTemplate(ctx, req.Id); err != nil {
+		h.log.Sugar().Errorw("Failed to delete issue template", "error", err)
+		return nil, h.errorToStatus(err, "failed to delete issue template")
+	}
+
+	return &pb.DeleteIssueTemplateResponse{}, nil
+}
+
+func (h *IssueHandler) ListIssueTemplates(ctx context.Context, req *pb.ListIssueTemplatesRequest) (*pb.ListIssueTemplatesResponse, error) {
+	var issueType models.IssueType
+	if req.IssueType != pb.IssueType_ISSUE_TYPE_UNSPECIFIED {
+		issueType = h.protoTypeToModel(req.IssueType)
+	}
+
+	templates, err := h.service.ListIssueTemplates(ctx, req.ProjectId, issueType)
+	if err != nil {
+		return nil, h.errorToStatus(err, "failed to list issue templates")
+	}
+
+	result := make([]*pb.IssueTemplate, 0, len(templates))
+	for _, t := range templates {
+		result = append(result, h.issueTemplateToProto(t))
+	}
+	return &pb.ListIssueTemplatesResponse{
+		Templates: result,
+	}, nil
+}
+
+// protoToIssueTemplate reads the writable fields of a template
+func (h *IssueHandler) protoToIssueTemplate(t *pb.IssueTemplate) (*models.IssueTemplate, error) {
+	if t == nil {
+		return nil, status.Error(codes.InvalidArgument, "template is required")
+	}
+	customFields, err := h.protoCustomFieldsToMap(t.CustomFields)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	template := &models.IssueTemplate{
+		ProjectID:    t.ProjectId,
+		Name:         t.Name,
+		IsDefault:    t.IsDefault,
+		Description:  t.Description,
+		LabelIDs:     t.LabelIds,
+		CustomFields: customFields,
+	}
+	if t.IssueType != pb.IssueType_ISSUE_TYPE_UNSPECIFIED {
+		template.IssueType = h.protoTypeToModel(t.IssueType)
+	}
+	if t.Priority != pb.IssuePriority_ISSUE_PRIORITY_UNSPECIFIED {
+		template.Priority = h.protoPriorityToModel(t.Priority)
+	}
+	for _, st := range t.SubTasks {
+		template.SubTasks = append(template.SubTasks, models.TemplateSubTask{
+			Summary:     st.Summary,
+			Description: st.Description,
+			AssigneeID:  st.AssigneeId,
+		})
+	}
+	return template, nil
+}
+
+func (h *IssueHandler) issueTemplateToProto(t *models.IssueTemplate) *pb.IssueTemplate {
+	template := &pb.IssueTemplate{
+		Id:           t.ID,
+		ProjectId:    t.ProjectID,
+		Name:         t.Name,
+		IssueType:    h.issueTypeToProto(t.IssueType),
+		IsDefault:    t.IsDefault,
+		Description:  t.Description,
+		Priority:     h.priorityToProto(t.Priority),
+		LabelIds:     t.LabelIDs,
+		CustomFields: h.customFieldMapToProto(t.CustomFields),
+		CreatedAt:    timestamppb.New(t.CreatedAt),
+		UpdatedAt:    timestamppb.New(t.UpdatedAt),
+	}
+	for _, st := range t.SubTasks {
+		template.SubTasks = append(template.SubTasks, &pb.TemplateSubTask{
+			Summary:     st.Summary,
+			Description: st.Description,
+			AssigneeId:  st.AssigneeID,
+		})
+	}
+	return template
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/nexusflow/nexusflow/pkg/etag"
+	commonpb "github.com/nexusflow/nexusflow/pkg/proto/common/v1"
+	pb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+)
+
+// Trash
+
+func (h *IssueHandler) DeleteIssue(ctx context.Context, req *pb.DeleteIssueRequest) (*pb.DeleteIssueResponse, error) {
+	if err := h.service.DeleteIssue(ctx, req.Id, req.UserId); err != nil {
+		h.log.Sugar().Errorw("Failed to delete issue", "error", err)
+		return nil, h.errorToStatus(err, "failed to delete issue")
+	}
+
+	return &pb.DeleteIssueResponse{
+		Response: &commonpb.SuccessResponse{Success: true},
+	}, nil
+}
+
+func (h *IssueHandler) ListDeletedIssues(ctx context.Context, req *pb.ListDeletedIssuesRequest) (*pb.ListDeletedIssuesResponse, error) {
+	var page, pageSize int
+	if req.Pagination != nil {
+		page = int(req.Pagination.Page)
+		pageSize = int(req.Pagination.PageSize)
+	}
+
+	result, err := h.service.ListDeletedIssues(ctx, req.ProjectId, page, pageSize)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to list deleted issues", "error", err)
+		return nil, h.errorToStatus(err, "failed to list deleted issues")
+	}
+
+	return &pb.ListDeletedIssuesResponse{
+		Issues: h.issuesToProto(ctx, result.Issues),
+		Pagination: &commonpb.PaginationResponse{
+			Page:        int32(result.Page),
+			PageSize:    int32(result.PageSize),
+			TotalItems:  int64(result.Total),
+			TotalPages:  int32((result.Total + result.PageSize - 1) / result.PageSize),
+			HasNext:     result.Page*result.PageSize < result.Total,
+			HasPrevious: result.Page > 1,
+		},
+	}, nil
+}
+
+func (h *IssueHandler) RestoreIssue(ctx context.Context, req *pb.RestoreIssueRequest) (*pb.RestoreIssueResponse, error) {
+	issue, err := h.service.RestoreIssue(ctx, req.Id, req.UserId)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to restore issue", "error", err)
+		return nil, h.errorToStatus(err, "failed to restore issue")
+	}
+
+	etag.Set(ctx, issue.Version)
+	return &pb.RestoreIssueResponse{
+		Issue: h.issueWithValuesToProto(ctx, issue),
+	}, nil
+}
@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+)
+
+// Watchers
+
+func (h *IssueHandler) AddWatcher(ctx context.Context, req *pb.AddWatcherRequest) (*pb.AddWatcherResponse, error) {
+	issue, err := h.service.AddWatcher(ctx, req.IssueId, req.UserId)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to add watcher", "error", err)
+		return nil, h.errorToStatus(err, "failed to add watcher")
+	}
+	return &pb.AddWatcherResponse{Issue: h.issueWithValuesToProto(ctx, issue)}, nil
+}
+
+func (h *IssueHandler) RemoveWatcher(ctx context.Context, req *pb.RemoveWatcherRequest) (*pb.RemoveWatcherResponse, error) {
+	issue, err := h.service.RemoveWatcher(ctx, req.IssueId, req.UserId)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to remove watcher", "error", err)
+		return nil, h.errorToStatus(err, "failed to remove watcher")
+	}
+	return &pb.RemoveWatcherResponse{Issue: h.issueWithValuesToProto(ctx, issue)}, nil
+}
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"context"
+	"sort"
+
+	pb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/repository"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Time tracking
+
+func (h *IssueHandler) AddWorklog(ctx context.Context, req *pb.AddWorklogRequest) (*pb.AddWorklogResponse, error) {
+	input := service.AddWorklogInput{
+		IssueID:  req.IssueId,
+		UserID:   req.UserId,
+		Duration: req.DurationSeconds,
+		Comment:  req.Comment,
+	}
+	if req.StartedAt != nil {
+		input.StartedAt = req.StartedAt.AsTime()
+	}
+
+	worklog, err := h.service.AddWorklog(ctx, input)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to add worklog", "error", err)
+		return nil, h.errorToStatus(err, "failed to add worklog")
+	}
+
+	return &pb.AddWorklogResponse{
+		Worklog: worklogToProto(worklog),
+	}, nil
+}
+
+func (h *IssueHandler) UpdateWorklog(ctx context.Context, req *pb.UpdateWorklogRequest) (*pb.UpdateWorklogResponse, error) {
+	input := service.UpdateWorklogInput{
+		ID:       req.Id,
+		UserID:   req.UserId,
+		Duration: req.DurationSeconds,
+		Comment:  req.Comment,
+	}
+	if req.StartedAt != nil {
+		startedAt := req.StartedAt.AsTime()
+		input.StartedAt = &startedAt
+	}
+
+	worklog, err := h.service.UpdateWorklog(ctx, input)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to update worklog", "error", err)
+		return nil, h.errorToStatus(err, "failed to update worklog")
+	}
+
+	return &pb.UpdateWorklogResponse{
+		Worklog: worklogToProto(worklog),
+	}, nil
+}
+
+func (h *IssueHandler) DeleteWorklog(ctx context.Context, req *pb.DeleteWorklogRequest) (*pb.DeleteWorklogResponse, error) {
+	if err := h.service.DeleteWorklog(ctx, req.Id, req.UserId); err != nil {
+		h.log.Sugar().Errorw("Failed to delete worklog", "error", err)
+		return nil, h.errorToStatus(err, "failed to delete worklog")
+	}
+
+	return &pb.DeleteWorklogResponse{}, nil
+}
+
+func (h *IssueHandler) ListWorklogs(ctx context.Context, req *pb.ListWorklogsRequest) (*pb.ListWorklogsResponse, error) {
+	worklogs, err := h.service.ListWorklogs(ctx, req.IssueId)
+	if err != nil {
+		return nil, h.errorToStatus(err, "failed to list worklogs")
+	}
+
+	return &pb.ListWorklogsResponse{
+		Worklogs: worklogsToProto(worklogs),
+	}, nil
+}
+
+func (h *IssueHandler) GetTimeTracking(ctx context.Context, req *pb.GetTimeTrackingRequest) (*pb.GetTimeTrackingResponse, error) {
+	summary, err := h.service.GetTimeTracking(ctx, req.IssueId)
+	if err != nil {
+		return nil, h.errorToStatus(err, "failed to get time tracking")
+	}
+
+	return &pb.GetTimeTrackingResponse{
+		Issue: &pb.TimeTracking{
+			OriginalEstimateSeconds:  summary.Issue.OriginalEstimate,
+			RemainingEstimateSeconds: summary.Issue.RemainingEstimate,
+			TimeSpentSeconds:         summary.Issue.TimeSpent,
+		},
+		Rollup: &pb.TimeTracking{
+			OriginalEstimateSeconds:  summary.Rollup.OriginalEstimate,
+			RemainingEstimateSeconds: summary.Rollup.RemainingEstimate,
+			TimeSpentSeconds:         summary.Rollup.TimeSpent,
+		},
+	}, nil
+}
+
+func (h *IssueHandler) GetTimesheet(ctx context.Context, req *pb.GetTimesheetRequest) (*pb.GetTimesheetResponse, error) {
+	filter := repository.TimesheetFilter{
+		UserID:    req.UserId,
+		ProjectID: req.ProjectId,
+	}
+	if req.From != nil {
+		filter.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		filter.To = req.To.AsTime()
+	}
+
+	timesheet, err := h.service.GetTimesheet(ctx, filter)
+	if err != nil {
+		return nil, h.errorToStatus(err, "failed to get timesheet")
+	}
+
+	return &pb.GetTimesheetResponse{
+		Worklogs:     worklogsToProto(timesheet.Worklogs),
+		TotalSeconds: timesheet.TotalSeconds,
+		ByUser:       timesheetTotalsToProto(timesheet.ByUser),
+		ByIssue:      timesheetTotalsToProto(timesheet.ByIssue),
+	}, nil
+}
+
+func worklogToProto(w *models.Worklog) *pb.Worklog {
+	return &pb.Worklog{
+		Id:              w.ID,
+		IssueId:         w.IssueID,
+		ProjectId:       w.ProjectID,
+		UserId:          w.UserID,
+		StartedAt:       timestamppb.New(w.StartedAt),
+		DurationSeconds: w.Duration,
+		Comment:         w.Comment,
+		CreatedAt:       timestamppb.New(w.CreatedAt),
+		UpdatedAt:       timestamppb.New(w.UpdatedAt),
+	}
+}
+
+func worklogsToProto(worklogs []*models.Worklog) []*pb.Worklog {
+	result := make([]*pb.Worklog, 0, len(worklogs))
+	for _, w := range worklogs {
+		result = append(result, worklogToProto(w))
+	}
+	return result
+}
+
+// timesheetTotalsToProto lists totals by descending time spent
+func timesheetTotalsToProto(totals map[string]int64) []*pb.TimesheetTotal {
+	result := make([]*pb.TimesheetTotal, 0, len(totals))
+	for id, seconds := range totals {
+		result = append(result, &pb.TimesheetTotal{Id: id, TimeSpentSeconds: seconds})
+	}
+	sort.Slice(result, func(a, b int) bool {
+		if result[a].TimeSpentSeconds != result[b].TimeSpentSeconds {
+			return result[a].TimeSpentSeconds > result[b].TimeSpentSeconds
+		}
+		return result[a].Id < result[b].Id
+	})
+	return result
+}
@@ -29,6 +29,24 @@ const (
 	IssuePriorityHighest IssuePriority = "highest"
 )
 
+// Rank orders priorities from lowest (1) to highest (5); unknown values rank 0
+func (p IssuePriority) Rank() int {
+	switch p {
+	case IssuePriorityLowest:
+		return 1
+	case IssuePriorityLow:
+		return 2
+	case IssuePriorityMedium:
+		return 3
+	case IssuePriorityHigh:
+		return 4
+	case IssuePriorityHighest:
+		return 5
+	default:
+		return 0
+	}
+}
+
 // Issue represents an issue
 type Issue struct {
 	bun.BaseModel `bun:"table:issues,alias:i"`
@@ -51,6 +69,24 @@ type Issue struct {
 	UpdatedAt   time.Time     `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
 	DeletedAt   time.Time     `bun:"deleted_at,soft_delete,nullzero"`
 	Version     int64         `bun:"version,notnull,default:1"`
+	// Time tracking in seconds; TimeSpent is the sum of the issue's worklogs
+	OriginalEstimate  int64 `bun:"original_estimate,notnull,default:0"`
+	RemainingEstimate int64 `bun:"remaining_estimate,notnull,default:0"`
+	TimeSpent         int64 `bun:"time_spent,notnull,default:0"`
+	// Set while the issue is in the trash; DeletedRootID is the issue whose
+	// deletion also deleted this one
+	DeletedBy     string `bun:"deleted_by,type:uuid,nullzero"`
+	DeletedRootID string `bun:"deleted_root_id,type:uuid,nullzero"`
+	// Set on issues created by a schedule, for the occurrence they were created for
+	ScheduleID   string    `bun:"schedule_id,type:uuid,nullzero"`
+	ScheduledFor time.Time `bun:"scheduled_for,nullzero"`
+	// Backlog position within the project, see RankBetween
+	Rank string `bun:"rank,notnull"`
+
+	// Loaded from issue_labels, issue_components and issue_watchers
+	LabelIDs     []string `bun:"-"`
+	ComponentIDs []string `bun:"-"`
+	WatcherIDs   []string `bun:"-"`
 }
 
 // ProjectCounter tracks the next issue number for a project
@@ -78,21 +114,85 @@ const (
 	CustomFieldTypeTextarea    CustomFieldType = "textarea"
 )
 
-// CustomField represents a custom field definition
+// CustomField represents a custom field definition. A field belongs either to a
+// single project or to an organization, in which case contexts decide which
+// projects and issue types it applies to.
 type CustomField struct {
 	bun.BaseModel `bun:"table:custom_fields,alias:cf"`
 
-	ID          string          `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
-	ProjectID   string          `bun:"project_id,notnull,type:uuid"`
-	Name        string          `bun:"name,notnull"`
-	Description string          `bun:"description"`
-	Type        CustomFieldType `bun:"type,notnull"`
-	Required    bool            `bun:"required,default:false"`
-	DefaultValue interface{}    `bun:"default_value,type:jsonb"`
-	Options     []string        `bun:"options,type:jsonb"`
-	Config      map[string]string `bun:"config,type:jsonb"`
-	CreatedAt   time.Time       `bun:"created_at,nullzero,notnull,default:current_timestamp"`
-	UpdatedAt   time.Time       `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+	ID             string            `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	ProjectID      string            `bun:"project_id,type:uuid,nullzero"`
+	OrganizationID string            `bun:"organization_id,type:uuid,nullzero"`
+	Name           string            `bun:"name,notnull"`
+	Description    string            `bun:"description"`
+	Type           CustomFieldType   `bun:"type,notnull"`
+	Required       bool              `bun:"required,default:false"`
+	DefaultValue   interface{}       `bun:"default_value,type:jsonb"`
+	Options        []string          `bun:"options,type:jsonb"`
+	Config         map[string]string `bun:"config,type:jsonb"`
+	CreatedAt      time.Time         `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt      time.Time         `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// IsShared reports whether the field is an organization-level field
+func (f *CustomField) IsShared() bool {
+	return f.ProjectID == ""
+}
+
+// CustomFieldContext applies an organization-level field to a set of projects
+// and issue types, with its own options and default value
+type CustomFieldContext struct {
+	bun.BaseModel `bun:"table:custom_field_contexts,alias:cfc"`
+
+	ID           string      `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	FieldID      string      `bun:"field_id,notnull,type:uuid"`
+	Name         string      `bun:"name,notnull"`
+	ProjectIDs   []string    `bun:"project_ids,array"`
+	IssueTypes   []IssueType `bun:"issue_types,array"`
+	Options      []string    `bun:"options,type:jsonb"`
+	DefaultValue interface{} `bun:"default_value,type:jsonb"`
+	CreatedAt    time.Time   `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt    time.Time   `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+
+	Field *CustomField `bun:"rel:belongs-to,join:field_id=id"`
+}
+
+// AppliesTo reports whether the context covers the given project and issue type
+func (c *CustomFieldContext) AppliesTo(projectID string, issueType IssueType) bool {
+	if len(c.ProjectIDs) > 0 && !containsString(c.ProjectIDs, projectID) {
+		return false
+	}
+	if len(c.IssueTypes) > 0 && issueType != "" {
+		for _, t := range c.IssueTypes {
+			if t == issueType {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// Specificity ranks contexts so that project and issue type scoped contexts
+// win over global ones
+func (c *CustomFieldContext) Specificity() int {
+	score := 0
+	if len(c.ProjectIDs) > 0 {
+		score += 2
+	}
+	if len(c.IssueTypes) > 0 {
+		score++
+	}
+	return score
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
 }
 
 // IssueCustomValue represents a value for a custom field on an issue
@@ -103,6 +203,8 @@ type IssueCustomValue struct {
 	FieldID   string      `bun:"field_id,pk,type:uuid"`
 	Value     interface{} `bun:"value,type:jsonb"`
 	UpdatedAt time.Time   `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+
+	Field *CustomField `bun:"rel:belongs-to,join:field_id=id"`
 }
 
 // IssueLinkType represents issue link type
@@ -137,3 +239,220 @@ type IssueWatcher struct {
 	UserID   string    `bun:"user_id,pk,type:uuid"`
 	JoinedAt time.Time `bun:"joined_at,nullzero,notnull,default:current_timestamp"`
 }
+
+// Label represents a project label
+type Label struct {
+	bun.BaseModel `bun:"table:labels,alias:l"`
+
+	ID          string    `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	ProjectID   string    `bun:"project_id,notnull,type:uuid"`
+	Name        string    `bun:"name,notnull"`
+	Color       string    `bun:"color"`
+	Description string    `bun:"description"`
+	CreatedAt   time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt   time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// Component represents a project component
+type Component struct {
+	bun.BaseModel `bun:"table:components,alias:co"`
+
+	ID                string    `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	ProjectID         string    `bun:"project_id,notnull,type:uuid"`
+	Name              string    `bun:"name,notnull"`
+	Description       string    `bun:"description"`
+	LeadID            string    `bun:"lead_id,type:uuid,nullzero"`
+	DefaultAssigneeID string    `bun:"default_assignee_id,type:uuid,nullzero"`
+	CreatedAt         time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt         time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// IssueLabel assigns a label to an issue
+type IssueLabel struct {
+	bun.BaseModel `bun:"table:issue_labels,alias:ilb"`
+
+	IssueID   string    `bun:"issue_id,pk,type:uuid"`
+	LabelID   string    `bun:"label_id,pk,type:uuid"`
+	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// IssueComponent assigns a component to an issue
+type IssueComponent struct {
+	bun.BaseModel `bun:"table:issue_components,alias:ic"`
+
+	IssueID     string    `bun:"issue_id,pk,type:uuid"`
+	ComponentID string    `bun:"component_id,pk,type:uuid"`
+	CreatedAt   time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// BulkJobStatus represents the state of a bulk job
+type BulkJobStatus string
+
+const (
+	BulkJobStatusPending             BulkJobStatus = "pending"
+	BulkJobStatusRunning             BulkJobStatus = "running"
+	BulkJobStatusCompleted           BulkJobStatus = "completed"
+	BulkJobStatusCompletedWithErrors BulkJobStatus = "completed_with_errors"
+	BulkJobStatusFailed              BulkJobStatus = "failed"
+)
+
+// IsFinished reports whether the job has stopped running
+func (s BulkJobStatus) IsFinished() bool {
+	return s == BulkJobStatusCompleted || s == BulkJobStatusCompletedWithErrors || s == BulkJobStatusFailed
+}
+
+// BulkChanges describes what a bulk job does to every issue; nil fields are
+// left untouched and an empty string clears the field
+type BulkChanges struct {
+	AssigneeID *string        `json:"assignee_id,omitempty"`
+	Priority   *IssuePriority `json:"priority,omitempty"`
+	StatusID   *string        `json:"status_id,omitempty"`
+	SprintID   *string        `json:"sprint_id,omitempty"`
+	Delete     bool           `json:"delete,omitempty"`
+}
+
+// BulkJobError records why a single issue could not be processed
+type BulkJobError struct {
+	IssueID string `json:"issue_id"`
+	Message string `json:"message"`
+}
+
+// BulkJob is an asynchronous operation over a set of issues
+type BulkJob struct {
+	bun.BaseModel `bun:"table:bulk_jobs,alias:bj"`
+
+	ID         string         `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	ProjectID  string         `bun:"project_id,type:uuid,nullzero"`
+	Status     BulkJobStatus  `bun:"status,notnull,default:'pending'"`
+	IssueIDs   []string       `bun:"issue_ids,array,type:uuid[]"`
+	Changes    BulkChanges    `bun:"changes,type:jsonb"`
+	Total      int            `bun:"total,notnull"`
+	Processed  int            `bun:"processed,notnull"`
+	Succeeded  int            `bun:"succeeded,notnull"`
+	Failed     int            `bun:"failed,notnull"`
+	Errors     []BulkJobError `bun:"errors,type:jsonb"`
+	Error      string         `bun:"error,nullzero"`
+	CreatedBy  string         `bun:"created_by,type:uuid,nullzero"`
+	CreatedAt  time.Time      `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt  time.Time      `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+	StartedAt  time.Time      `bun:"started_at,nullzero"`
+	FinishedAt time.Time      `bun:"finished_at,nullzero"`
+	// Owner is the instance running the job; it renews the lease while it does
+	Owner          string    `bun:"owner,nullzero"`
+	LeaseExpiresAt time.Time `bun:"lease_expires_at,nullzero"`
+}
+
+// IssueHistory records a single field change on an issue
+type IssueHistory struct {
+	bun.BaseModel `bun:"table:issue_history,alias:ih"`
+
+	ID        string    `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	IssueID   string    `bun:"issue_id,notnull,type:uuid"`
+	Field     string    `bun:"field,notnull"`
+	OldValue  string    `bun:"old_value"`
+	NewValue  string    `bun:"new_value"`
+	ChangedBy string    `bun:"changed_by,type:uuid,nullzero"`
+	JobID     string    `bun:"job_id,type:uuid,nullzero"`
+	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// Worklog records time a user spent on an issue
+type Worklog struct {
+	bun.BaseModel `bun:"table:worklogs,alias:w"`
+
+	ID        string    `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	IssueID   string    `bun:"issue_id,notnull,type:uuid"`
+	ProjectID string    `bun:"project_id,notnull,type:uuid"`
+	UserID    string    `bun:"user_id,notnull,type:uuid"`
+	StartedAt time.Time `bun:"started_at,notnull"`
+	Duration  int64     `bun:"duration,notnull"` // seconds
+	Comment   string    `bun:"comment"`
+	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+	// EstimateTaken is how much of the remaining estimate the worklog took off
+	EstimateTaken int64 `bun:"estimate_taken,notnull,default:0"`
+}
+
+// TimeTracking sums the estimates and time spent of an issue and everything below it
+type TimeTracking struct {
+	OriginalEstimate  int64 `bun:"original_estimate"`
+	RemainingEstimate int64 `bun:"remaining_estimate"`
+	TimeSpent         int64 `bun:"time_spent"`
+}
+
+// ScheduleCatchUp controls what a schedule does about occurrences missed while
+// the scheduler was not running
+type ScheduleCatchUp string
+
+const (
+	// ScheduleCatchUpNone skips missed occurrences
+	ScheduleCatchUpNone ScheduleCatchUp = "none"
+	// ScheduleCatchUpLatest creates an issue for the most recent missed occurrence only
+	ScheduleCatchUpLatest ScheduleCatchUp = "latest"
+	// ScheduleCatchUpAll creates an issue for every missed occurrence
+	ScheduleCatchUpAll ScheduleCatchUp = "all"
+)
+
+// IssueSchedule creates issues from a stored blueprint on a recurrence rule
+type IssueSchedule struct {
+	bun.BaseModel `bun:"table:issue_schedules,alias:isch"`
+
+	ID        string `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	ProjectID string `bun:"project_id,notnull,type:uuid"`
+	Name      string `bun:"name,notnull"`
+
+	// Blueprint of the issues to create
+	Summary      string                 `bun:"summary,notnull"`
+	Description  string                 `bun:"description"`
+	Type         IssueType              `bun:"type,notnull,default:'task'"`
+	Priority     IssuePriority          `bun:"priority,notnull,default:'medium'"`
+	AssigneeID   string                 `bun:"assignee_id,type:uuid,nullzero"`
+	CustomFields map[string]interface{} `bun:"custom_fields,type:jsonb"`
+
+	// RRule is an RFC 5545 recurrence rule evaluated in Timezone, starting at StartsAt
+	RRule    string          `bun:"rrule,notnull"`
+	Timezone string          `bun:"timezone,notnull,default:'UTC'"`
+	StartsAt time.Time       `bun:"starts_at,notnull"`
+	EndsAt   time.Time       `bun:"ends_at,nullzero"`
+	CatchUp  ScheduleCatchUp `bun:"catch_up,notnull,default:'latest'"`
+	Enabled  bool            `bun:"enabled,notnull,default:true"`
+	// NextRunAt is null once the schedule has no occurrences left
+	NextRunAt time.Time `bun:"next_run_at,nullzero"`
+	LastRunAt time.Time `bun:"last_run_at,nullzero"`
+	LastError string    `bun:"last_error"`
+
+	CreatedBy string    `bun:"created_by,type:uuid,nullzero"`
+	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// IssueTemplate pre-fills new issues of a type in a project. The default
+// template of a type is applied when an issue is created without one.
+type IssueTemplate struct {
+	bun.BaseModel `bun:"table:issue_templates,alias:it"`
+
+	ID        string    `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	ProjectID string    `bun:"project_id,notnull,type:uuid"`
+	Name      string    `bun:"name,notnull"`
+	IssueType IssueType `bun:"issue_type,notnull"`
+	IsDefault bool      `bun:"is_default,notnull,default:false"`
+
+	// Description is the skeleton used when an issue has no description
+	Description  string                 `bun:"description"`
+	Priority     IssuePriority          `bun:"priority,nullzero"`
+	LabelIDs     []string               `bun:"label_ids,array,type:uuid[]"`
+	CustomFields map[string]interface{} `bun:"custom_fields,type:jsonb"`
+	// SubTasks are created under every issue created from the template
+	SubTasks []TemplateSubTask `bun:"sub_tasks,type:jsonb"`
+
+	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// TemplateSubTask is a checklist item of an issue template
+type TemplateSubTask struct {
+	Summary     string `json:"summary"`
+	Description string `json:"description,omitempty"`
+	// AssigneeID defaults to the assignee of the parent issue
+	AssigneeID string `json:"assignee_id,omitempty"`
+}
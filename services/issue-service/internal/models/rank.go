@@ -0,0 +1,80 @@
+package models
+
+import "strings"
+
+// rankDigits are the digits of a rank, in sort order. A rank is read as the
+// fraction 0.<digits> in base 36, so there is always room for another rank
+// between two others. Ranks never end in '0', which keeps every fraction to
+// a single spelling.
+const rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// RankBetween returns a rank that sorts after lo and before hi. An empty lo
+// is the start of the backlog and an empty hi its end. lo must sort before hi.
+func RankBetween(lo, hi string) string {
+	// Skip the digits both share, reading missing digits of lo as zero
+	n := 0
+	for n < len(hi) && rankDigitAt(lo, n) == hi[n] {
+		n++
+	}
+	if n > 0 {
+		return hi[:n] + RankBetween(rankSuffix(lo, n), hi[n:])
+	}
+
+	a := 0
+	if lo != "" {
+		a = strings.IndexByte(rankDigits, lo[0])
+	}
+	b := len(rankDigits)
+	if hi != "" {
+		b = strings.IndexByte(rankDigits, hi[0])
+	}
+	if b-a > 1 {
+		return string(rankDigits[(a+b)/2])
+	}
+	// The first digits are adjacent: a prefix of hi fits when hi is longer,
+	// otherwise lo's digit is kept and the rest goes after lo
+	if len(hi) > 1 {
+		return hi[:1]
+	}
+	return string(rankDigits[a]) + RankBetween(rankSuffix(lo, 1), "")
+}
+
+// RankAfter returns a rank that sorts after lo, for appending to the end of
+// the backlog. Appending is the common case, so the first digit of lo that
+// can grow is stepped by one rather than halving the space left; ranks then
+// grow by a digit every 35 appends instead of every few.
+func RankAfter(lo string) string {
+	for i := 0; ; i++ {
+		d := strings.IndexByte(rankDigits, rankDigitAt(lo, i))
+		if d < len(rankDigits)-1 {
+			return lo[:i] + string(rankDigits[d+1])
+		}
+	}
+}
+
+// RanksBetween returns n ascending ranks between lo and hi, as short as they
+// can be
+func RanksBetween(lo, hi string, n int) []string {
+	if n <= 0 {
+		return nil
+	}
+	mid := RankBetween(lo, hi)
+	left := n / 2
+	ranks := RanksBetween(lo, mid, left)
+	ranks = append(ranks, mid)
+	return append(ranks, RanksBetween(mid, hi, n-left-1)...)
+}
+
+func rankDigitAt(rank string, i int) byte {
+	if i < len(rank) {
+		return rank[i]
+	}
+	return '0'
+}
+
+func rankSuffix(rank string, i int) string {
+	if i < len(rank) {
+		return rank[i:]
+	}
+	return ""
+}
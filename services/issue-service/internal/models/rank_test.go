@@ -0,0 +1,157 @@
+package models
+
+import (
+	"strings"
+	"testing"
+)
+
+// checkRank fails unless rank is a valid rank sorting after lo and before hi
+func checkRank(t *testing.T, rank, lo, hi string) {
+	t.Helper()
+	if rank == "" || strings.Trim(rank, rankDigits) != "" || strings.HasSuffix(rank, "0") {
+		t.Fatalf("rank %q is not a valid rank", rank)
+	}
+	if rank <= lo || (hi != "" && rank >= hi) {
+		t.Fatalf("rank %q does not sort between %q and %q", rank, lo, hi)
+	}
+}
+
+func TestRankBetween(t *testing.T) {
+	tests := []struct {
+		name   string
+		lo, hi string
+		want   string
+	}{
+		{"Empty backlog", "", "", "i"},
+		{"Start of the backlog", "", "i", "9"},
+		{"End of the backlog", "i", "", "r"},
+		{"Wide gap", "a", "k", "f"},
+		{"Adjacent digits", "a", "b", "ai"},
+		{"Before the first digit", "", "1", "0i"},
+		{"After the last digit", "z", "", "zi"},
+		{"Longer hi", "a", "b5", "b"},
+		{"Longer lo", "az", "b", "azi"},
+		{"Shared prefix", "ab", "ac", "abi"},
+		{"lo is a prefix of hi", "a", "a5", "a2"},
+		{"Deep prefix", "a0001", "a0002", "a0001i"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RankBetween(tt.lo, tt.hi)
+			if got != tt.want {
+				t.Errorf("RankBetween(%q, %q) = %q, want %q", tt.lo, tt.hi, got, tt.want)
+			}
+			checkRank(t, got, tt.lo, tt.hi)
+		})
+	}
+}
+
+func TestRankBetween_Repeated(t *testing.T) {
+	// Inserting at the same place again and again narrows the gap each time
+	for _, tt := range []struct {
+		name   string
+		insert func(lo, hi, rank string) (string, string)
+	}{
+		{"Always first", func(lo, hi, rank string) (string, string) { return lo, rank }},
+		{"Always last before hi", func(lo, hi, rank string) (string, string) { return rank, hi }},
+		{"Alternating", func(lo, hi, rank string) (string, string) {
+			if len(rank)%2 == 0 {
+				return lo, rank
+			}
+			return rank, hi
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			lo, hi := "", "i"
+			for i := 0; i < 500; i++ {
+				rank := RankBetween(lo, hi)
+				checkRank(t, rank, lo, hi)
+				lo, hi = tt.insert(lo, hi, rank)
+			}
+		})
+	}
+}
+
+func TestRankAfter(t *testing.T) {
+	tests := []struct {
+		lo   string
+		want string
+	}{
+		{"", "1"},
+		{"1", "2"},
+		{"a5", "b"},
+		{"y", "z"},
+		{"z", "z1"},
+		{"zy", "zz"},
+		{"zz", "zz1"},
+		{"zz5", "zz6"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lo, func(t *testing.T) {
+			got := RankAfter(tt.lo)
+			if got != tt.want {
+				t.Errorf("RankAfter(%q) = %q, want %q", tt.lo, got, tt.want)
+			}
+			checkRank(t, got, tt.lo, "")
+		})
+	}
+}
+
+func TestRankAfter_Appends(t *testing.T) {
+	rank := ""
+	for i := 0; i < 1000; i++ {
+		next := RankAfter(rank)
+		checkRank(t, next, rank, "")
+		rank = next
+	}
+	// One digit longer every 35 appends
+	if max := 1000/35 + 1; len(rank) > max {
+		t.Errorf("rank after 1000 appends is %d digits long, want at most %d", len(rank), max)
+	}
+}
+
+func TestRanksBetween(t *testing.T) {
+	tests := []struct {
+		name      string
+		lo, hi    string
+		n         int
+		maxLength int
+	}{
+		{"One", "a", "b", 1, 2},
+		{"Empty backlog", "", "", 10, 1},
+		{"Adjacent digits", "a", "b", 35, 3},
+		{"Rebalanced backlog", "", "", 1000, 3},
+		{"Many in a small gap", "a", "b", 1000, 4},
+		{"Before the first digit", "", "1", 100, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranks := RanksBetween(tt.lo, tt.hi, tt.n)
+			if len(ranks) != tt.n {
+				t.Fatalf("RanksBetween() returned %d ranks, want %d", len(ranks), tt.n)
+			}
+			lo := tt.lo
+			for _, rank := range ranks {
+				checkRank(t, rank, lo, tt.hi)
+				if len(rank) > tt.maxLength {
+					t.Errorf("rank %q is longer than %d digits", rank, tt.maxLength)
+				}
+				lo = rank
+			}
+		})
+	}
+}
+
+func TestRanksBetween_None(t *testing.T) {
+	for _, n := range []int{0, -1} {
+		if ranks := RanksBetween("a", "b", n); ranks != nil {
+			t.Errorf("RanksBetween(%d) = %q, want nil", n, ranks)
+		}
+	}
+	if got, want := RanksBetween("a", "k", 1), []string{RankBetween("a", "k")}; got[0] != want[0] {
+		t.Errorf("RanksBetween(1) = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// BulkBatch is one transaction's worth of bulk changes
+type BulkBatch struct {
+	Updated []BulkUpdate
+	// Deleted issues are moved to the trash along with their sub-tasks
+	Deleted []string
+	// History of changes made outside the batch, e.g. sprint moves, is saved as is
+	History   []models.IssueHistory
+	JobID     string
+	DeletedBy string
+}
+
+// BulkUpdate saves the columns a bulk job changed on one issue, provided the
+// issue is still at the version it was loaded at, and their history
+type BulkUpdate struct {
+	Issue   *models.Issue
+	Columns []string
+	History []models.IssueHistory
+}
+
+// CreateBulkJob creates a bulk job
+func (r *IssueRepository) CreateBulkJob(ctx context.Context, job *models.BulkJob) error {
+	if job.Errors == nil {
+		job.Errors = []models.BulkJobError{}
+	}
+	_, err := r.db.NewInsert().Model(job).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("create bulk job: %w", err)
+	}
+	return nil
+}
+
+// GetBulkJob gets a bulk job by ID
+func (r *IssueRepository) GetBulkJob(ctx context.Context, id string) (*models.BulkJob, error) {
+	job := new(models.BulkJob)
+	err := r.db.NewSelect().Model(job).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get bulk job: %w", err)
+	}
+	return job, nil
+}
+
+// activeBulkJobStatuses are the statuses of jobs that have not finished
+var activeBulkJobStatuses = []models.BulkJobStatus{models.BulkJobStatusPending, models.BulkJobStatusRunning}
+
+// UpdateBulkJobProgress saves the status, counters and errors of a bulk job.
+// Jobs that have already finished, e.g. failed after their lease expired,
+// are left alone.
+func (r *IssueRepository) UpdateBulkJobProgress(ctx context.Context, job *models.BulkJob) error {
+	if job.Errors == nil {
+		job.Errors = []models.BulkJobError{}
+	}
+	_, err := r.db.NewUpdate().
+		Model(job).
+		Column("status", "processed", "succeeded", "failed", "errors", "error", "started_at", "finished_at").
+		WherePK().
+		Where("status IN (?)", bun.In(activeBulkJobStatuses)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("update bulk job: %w", err)
+	}
+	return nil
+}
+
+// RenewBulkJobLease extends the lease an instance holds on an unfinished job.
+// It reports false when the job is no longer the instance's to run.
+func (r *IssueRepository) RenewBulkJobLease(ctx context.Context, id, owner string, expiresAt time.Time) (bool, error) {
+	res, err := r.db.NewUpdate().
+		Model((*models.BulkJob)(nil)).
+		Set("lease_expires_at = ?", expiresAt).
+		Where("id = ?", id).
+		Where("owner = ?", owner).
+		Where("status IN (?)", bun.In(activeBulkJobStatuses)).
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("renew bulk job lease: %w", err)
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// FailExpiredBulkJobs marks unfinished jobs whose lease expired before now,
+// i.e. whose instance stopped running them, as failed and returns how many
+// there were
+func (r *IssueRepository) FailExpiredBulkJobs(ctx context.Context, reason string, now time.Time) (int, error) {
+	res, err := r.db.NewUpdate().
+		Model((*models.BulkJob)(nil)).
+		Set("status = ?", models.BulkJobStatusFailed).
+		Set("error = ?", reason).
+		Set("finished_at = ?", now).
+		Where("status IN (?)", bun.In(activeBulkJobStatuses)).
+		Where("lease_expires_at IS NULL OR lease_expires_at < ?", now).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fail expired bulk jobs: %w", err)
+	}
+	n, _ := res.RowsAffected()
+	return int(n), nil
+}
+
+// ApplyBulkBatch saves a batch of bulk changes and their history in one
+// transaction. It returns the IDs of every issue deleted, sub-tasks included,
+// and of the issues left alone because someone else updated them first.
+func (r *IssueRepository) ApplyBulkBatch(ctx context.Context, batch BulkBatch) (deleted, conflicted []string, err error) {
+	err = r.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		deleted, conflicted = nil, nil
+		history := append([]models.IssueHistory(nil), batch.History...)
+		for _, u := range batch.Updated {
+			expected := u.Issue.Version
+			u.Issue.UpdatedAt = time.Now()
+			u.Issue.Version = expected + 1
+			columns := append([]string{"version", "updated_at"}, u.Columns...)
+			res, err := tx.NewUpdate().
+				Model(u.Issue).
+				Column(columns...).
+				WherePK().
+				Where("i.version = ?", expected).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("update issue %s: %w", u.Issue.ID, err)
+			}
+			if n, err := res.RowsAffected(); err == nil && n == 0 {
+				u.Issue.Version = expected
+				conflicted = append(conflicted, u.Issue.ID)
+				continue
+			}
+			history = append(history, u.History...)
+		}
+
+		var err error
+		if deleted, err = softDeleteTree(ctx, tx, batch.Deleted, batch.DeletedBy, batch.JobID); err != nil {
+			return err
+		}
+		return insertIssueHistory(ctx, tx, history)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return deleted, conflicted, nil
+}
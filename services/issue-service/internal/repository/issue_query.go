@@ -0,0 +1,293 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// IssueFilter narrows the issues returned by List. Zero values are ignored.
+type IssueFilter struct {
+	ProjectID  string
+	StatusIDs  []string
+	AssigneeID string
+	ReporterID string
+	Types      []models.IssueType
+	Priorities []models.IssuePriority
+	SprintID   string
+	ParentID   string
+	// LabelIDs matches issues with any of the labels
+	LabelIDs []string
+	// ComponentIDs matches issues in any of the components
+	ComponentIDs []string
+	DueAfter     time.Time
+	DueBefore    time.Time
+	UpdatedSince time.Time
+	// UpdatedBefore is exclusive, for finding issues that went stale
+	UpdatedBefore time.Time
+	// Backlog matches issues in no sprint
+	Backlog bool
+	// CustomFields matches issues with all of the custom field values
+	CustomFields []CustomFieldFilter
+}
+
+// CustomFieldFilter matches issues whose custom field value, as text, is
+// Value, or whose multi-value field includes it
+type CustomFieldFilter struct {
+	FieldID string
+	Value   string
+}
+
+// IssueSort orders the issues returned by List. Ties are broken by ID so the
+// order is total, which keyset pagination relies on.
+type IssueSort struct {
+	Field string
+	Desc  bool
+}
+
+// IssueCursor is the keyset position List continues from: the sort key and ID
+// of the last issue seen. Before pages backwards from the first issue seen.
+type IssueCursor struct {
+	Value  string
+	ID     string
+	Before bool
+}
+
+// IssuePage is a page of issues returned by List
+type IssuePage struct {
+	Issues      []*models.Issue
+	Total       int
+	HasNext     bool
+	HasPrevious bool
+}
+
+type issueSortColumn struct {
+	expr string
+	cast string
+	key  func(*models.Issue) string
+	// valid reports whether a cursor value can be cast to the column type
+	valid func(string) bool
+}
+
+const priorityRankExpr = `CASE i.priority WHEN 'lowest' THEN 1 WHEN 'low' THEN 2 WHEN 'medium' THEN 3 WHEN 'high' THEN 4 WHEN 'highest' THEN 5 ELSE 0 END`
+
+// issueSortColumns whitelists the fields issues can be sorted by. Nullable
+// columns are coalesced so every issue has a comparable key.
+var issueSortColumns = map[string]issueSortColumn{
+	"created_at": {
+		expr:  "i.created_at",
+		cast:  "timestamptz",
+		key:   func(i *models.Issue) string { return formatSortTime(i.CreatedAt) },
+		valid: validSortTime,
+	},
+	"updated_at": {
+		expr:  "i.updated_at",
+		cast:  "timestamptz",
+		key:   func(i *models.Issue) string { return formatSortTime(i.UpdatedAt) },
+		valid: validSortTime,
+	},
+	"due_date": {
+		expr: "COALESCE(i.due_date, 'infinity'::timestamptz)",
+		cast: "timestamptz",
+		key: func(i *models.Issue) string {
+			if i.DueDate.IsZero() {
+				return "infinity"
+			}
+			return formatSortTime(i.DueDate)
+		},
+		valid: validSortTime,
+	},
+	"priority": {
+		expr:  priorityRankExpr,
+		cast:  "integer",
+		key:   func(i *models.Issue) string { return strconv.Itoa(i.Priority.Rank()) },
+		valid: validSortInteger,
+	},
+	"rank": {
+		expr:  "i.rank",
+		cast:  "text",
+		key:   func(i *models.Issue) string { return i.Rank },
+		valid: validSortText,
+	},
+	"story_points": {
+		expr:  "COALESCE(i.story_points, 0)",
+		cast:  "integer",
+		key:   func(i *models.Issue) string { return strconv.Itoa(int(i.StoryPoints)) },
+		valid: validSortInteger,
+	},
+}
+
+// IsIssueSortField reports whether issues can be sorted by the field
+func IsIssueSortField(field string) bool {
+	_, ok := issueSortColumns[field]
+	return ok
+}
+
+// IsIssueSortValue reports whether a cursor value fits the type of the sort
+// field, so a tampered cursor is rejected before it reaches the query
+func IsIssueSortValue(field, value string) bool {
+	column, ok := issueSortColumns[field]
+	return ok && column.valid(value)
+}
+
+// IssueCursorFor returns the cursor positioned at an issue for the given sort
+func IssueCursorFor(issue *models.Issue, sort IssueSort, before bool) IssueCursor {
+	return IssueCursor{
+		Value:  issueSortColumns[sort.Field].key(issue),
+		ID:     issue.ID,
+		Before: before,
+	}
+}
+
+func formatSortTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func validSortTime(v string) bool {
+	if v == "infinity" {
+		return true
+	}
+	_, err := time.Parse(time.RFC3339Nano, v)
+	return err == nil
+}
+
+func validSortInteger(v string) bool {
+	_, err := strconv.ParseInt(v, 10, 32)
+	return err == nil
+}
+
+func validSortText(v string) bool {
+	return utf8.ValidString(v) && !strings.ContainsRune(v, 0)
+}
+
+// List lists issues matching the filter, one keyset page at a time
+func (r *IssueRepository) List(ctx context.Context, filter IssueFilter, sort IssueSort, cursor *IssueCursor, limit int) (*IssuePage, error) {
+	column, ok := issueSortColumns[sort.Field]
+	if !ok {
+		return nil, fmt.Errorf("unsupported sort field %q", sort.Field)
+	}
+
+	total, err := applyIssueFilter(r.db.NewSelect().Model((*models.Issue)(nil)), filter).Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("count issues: %w", err)
+	}
+
+	// Paging backwards walks the reversed order and flips the result afterwards
+	desc := sort.Desc
+	if cursor != nil && cursor.Before {
+		desc = !desc
+	}
+	direction, op := "ASC", ">"
+	if desc {
+		direction, op = "DESC", "<"
+	}
+
+	var issues []*models.Issue
+	q := applyIssueFilter(r.db.NewSelect().Model(&issues), filter)
+	if cursor != nil {
+		q = q.Where(fmt.Sprintf("(?, i.id) %s (CAST(? AS %s), CAST(? AS uuid))", op, column.cast),
+			bun.Safe(column.expr), cursor.Value, cursor.ID)
+	}
+	err = q.
+		OrderExpr(fmt.Sprintf("? %s", direction), bun.Safe(column.expr)).
+		OrderExpr(fmt.Sprintf("i.id %s", direction)).
+		Limit(limit + 1).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list issues: %w", err)
+	}
+
+	more := len(issues) > limit
+	if more {
+		issues = issues[:limit]
+	}
+	page := &IssuePage{Total: total}
+	if cursor != nil && cursor.Before {
+		for i, j := 0, len(issues)-1; i < j; i, j = i+1, j-1 {
+			issues[i], issues[j] = issues[j], issues[i]
+		}
+		page.HasPrevious = more
+		page.HasNext = true
+	} else {
+		page.HasNext = more
+		page.HasPrevious = cursor != nil
+	}
+
+	if err := r.loadAssociations(ctx, issues...); err != nil {
+		return nil, err
+	}
+	page.Issues = issues
+	return page, nil
+}
+
+// ListIDs lists the IDs of up to limit issues matching the filter, oldest first
+func (r *IssueRepository) ListIDs(ctx context.Context, filter IssueFilter, limit int) ([]string, error) {
+	var ids []string
+	err := applyIssueFilter(r.db.NewSelect().Model((*models.Issue)(nil)), filter).
+		Column("i.id").
+		OrderExpr("i.created_at ASC, i.id ASC").
+		Limit(limit).
+		Scan(ctx, &ids)
+	if err != nil {
+		return nil, fmt.Errorf("list issue ids: %w", err)
+	}
+	return ids, nil
+}
+
+func applyIssueFilter(q *bun.SelectQuery, filter IssueFilter) *bun.SelectQuery {
+	q = q.Where("i.project_id = ?", filter.ProjectID)
+	if len(filter.StatusIDs) > 0 {
+		q = q.Where("i.status_id IN (?)", bun.In(filter.StatusIDs))
+	}
+	if filter.AssigneeID != "" {
+		q = q.Where("i.assignee_id = ?", filter.AssigneeID)
+	}
+	if filter.ReporterID != "" {
+		q = q.Where("i.reporter_id = ?", filter.ReporterID)
+	}
+	if len(filter.Types) > 0 {
+		q = q.Where("i.type IN (?)", bun.In(filter.Types))
+	}
+	if len(filter.Priorities) > 0 {
+		q = q.Where("i.priority IN (?)", bun.In(filter.Priorities))
+	}
+	if filter.SprintID != "" {
+		q = q.Where("i.sprint_id = ?", filter.SprintID)
+	}
+	if filter.Backlog {
+		q = q.Where("i.sprint_id IS NULL")
+	}
+	if filter.ParentID != "" {
+		q = q.Where("i.parent_id = ?", filter.ParentID)
+	}
+	if len(filter.LabelIDs) > 0 {
+		q = q.Where("EXISTS (SELECT 1 FROM issue_labels AS ilb WHERE ilb.issue_id = i.id AND ilb.label_id IN (?))", bun.In(filter.LabelIDs))
+	}
+	if len(filter.ComponentIDs) > 0 {
+		q = q.Where("EXISTS (SELECT 1 FROM issue_components AS ic WHERE ic.issue_id = i.id AND ic.component_id IN (?))", bun.In(filter.ComponentIDs))
+	}
+	if !filter.DueAfter.IsZero() {
+		q = q.Where("i.due_date >= ?", filter.DueAfter)
+	}
+	if !filter.DueBefore.IsZero() {
+		q = q.Where("i.due_date < ?", filter.DueBefore)
+	}
+	if !filter.UpdatedSince.IsZero() {
+		q = q.Where("i.updated_at >= ?", filter.UpdatedSince)
+	}
+	if !filter.UpdatedBefore.IsZero() {
+		q = q.Where("i.updated_at < ?", filter.UpdatedBefore)
+	}
+	for _, f := range filter.CustomFields {
+		q = q.Where("EXISTS (SELECT 1 FROM issue_custom_values AS icv WHERE icv.issue_id = i.id AND icv.field_id = ? "+
+			"AND (icv.value #>> '{}' = ? OR (jsonb_typeof(icv.value) = 'array' AND icv.value @> jsonb_build_array(?::text))))",
+			f.FieldID, f.Value, f.Value)
+	}
+	return q
+}
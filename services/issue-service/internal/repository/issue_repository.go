@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -13,6 +14,9 @@ import (
 	"github.com/uptrace/bun"
 )
 
+// ErrVersionConflict is returned when a conditional update finds a newer version
+var ErrVersionConflict = errors.New("version conflict")
+
 // IssueRepository handles issue data access
 type IssueRepository struct {
 	db  *database.DB
@@ -27,12 +31,12 @@ func NewIssueRepository(db *database.DB, log *logger.Logger) *IssueRepository {
 	}
 }
 
-// Create creates a new issue with atomic key generation
-func (r *IssueRepository) Create(ctx context.Context, issue *models.Issue, projectKey string) error {
+// Create creates a new issue with atomic key generation, together with its custom field values
+func (r *IssueRepository) Create(ctx context.Context, issue *models.Issue, projectKey string, values []models.IssueCustomValue) error {
 	return r.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
 		// 1. Get and increment project counter
 		counter := &models.ProjectCounter{ProjectID: issue.ProjectID}
-		
+
 		// Upsert counter: increment if exists, insert 1 if not
 		_, err := tx.NewInsert().
 			Model(counter).
@@ -40,35 +44,35 @@ func (r *IssueRepository) Create(ctx context.Context, issue *models.Issue, proje
 			Set("next_issue_number = pc.next_issue_number + 1").
 			Returning("next_issue_number").
 			Exec(ctx)
-			
+
 		if err != nil {
 			return fmt.Errorf("failed to increment project counter: %w", err)
 		}
-		
+
 		// 2. Generate key
-		// Note: The returned counter value is the *next* number if we used RETURNING, 
+		// Note: The returned counter value is the *next* number if we used RETURNING,
 		// but Bun's behavior with ON CONFLICT UPDATE RETURNING can be tricky.
 		// Let's fetch the updated value to be safe, or trust the return.
-		// Actually, let's use a simpler approach: 
+		// Actually, let's use a simpler approach:
 		// INSERT ... ON CONFLICT DO UPDATE ... RETURNING next_issue_number
-		// But we need the value *before* increment if we want 1, 2, 3... 
+		// But we need the value *before* increment if we want 1, 2, 3...
 		// Wait, if default is 1, and we increment, we get 2. So first issue is 1?
 		// Let's adjust: Default 1. First insert uses 1. Next update increments to 2.
 		// Actually, let's just read-and-update with locking for simplicity and correctness.
-		
+
 		// Better approach with atomic update:
 		// UPDATE project_counters SET next_issue_number = next_issue_number + 1 WHERE project_id = ? RETURNING next_issue_number
 		// If no rows, INSERT.
-		
+
 		var issueNum int64
-		
+
 		// Try update first
 		err = tx.NewRaw(`
 			UPDATE project_counters 
 			SET next_issue_number = next_issue_number + 1, updated_at = now() 
 			WHERE project_id = ? 
 			RETURNING next_issue_number - 1`, issue.ProjectID).Scan(ctx, &issueNum)
-			
+
 		if err != nil {
 			if err == sql.ErrNoRows {
 				// Create counter if not exists
@@ -84,19 +88,45 @@ func (r *IssueRepository) Create(ctx context.Context, issue *models.Issue, proje
 				return fmt.Errorf("failed to update project counter: %w", err)
 			}
 		}
-		
+
 		// Set key
 		issue.Key = fmt.Sprintf("%s-%d", projectKey, issueNum)
-		
+
 		if issue.ID == "" {
 			issue.ID = uuid.New().String()
 		}
-		
+
+		// New issues go to the bottom of the backlog; the counter update
+		// above serializes creation within the project
+		lastRank, err := lastIssueRank(ctx, tx, issue.ProjectID)
+		if err != nil {
+			return err
+		}
+		issue.Rank = models.RankAfter(lastRank)
+
 		// 3. Create issue
 		if _, err := tx.NewInsert().Model(issue).Exec(ctx); err != nil {
 			return fmt.Errorf("failed to create issue: %w", err)
 		}
-		
+
+		// 4. Save custom field values
+		if len(values) > 0 {
+			for i := range values {
+				values[i].IssueID = issue.ID
+			}
+			if _, err := tx.NewInsert().Model(&values).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to save issue custom values: %w", err)
+			}
+		}
+
+		// 5. Assign labels and components
+		if err := insertIssueLabels(ctx, tx, []string{issue.ID}, issue.LabelIDs); err != nil {
+			return err
+		}
+		if err := insertIssueComponents(ctx, tx, []string{issue.ID}, issue.ComponentIDs); err != nil {
+			return err
+		}
+
 		return nil
 	})
 }
@@ -111,9 +141,54 @@ func (r *IssueRepository) GetByID(ctx context.Context, id string) (*models.Issue
 		}
 		return nil, fmt.Errorf("get issue: %w", err)
 	}
+	if err := r.loadAssociations(ctx, issue); err != nil {
+		return nil, err
+	}
 	return issue, nil
 }
 
+// GetByIDs gets the issues with the given IDs
+func (r *IssueRepository) GetByIDs(ctx context.Context, ids []string) ([]*models.Issue, error) {
+	var issues []*models.Issue
+	if len(ids) == 0 {
+		return issues, nil
+	}
+	err := r.db.NewSelect().Model(&issues).Where("i.id IN (?)", bun.In(ids)).Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get issues: %w", err)
+	}
+	if err := r.loadAssociations(ctx, issues...); err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
+
+// SetSprint moves issues into a sprint, or to the backlog for an empty
+// sprintID, and returns the IDs of the issues whose sprint changed
+func (r *IssueRepository) SetSprint(ctx context.Context, ids []string, sprintID string) ([]string, error) {
+	var changed []string
+	if len(ids) == 0 {
+		return changed, nil
+	}
+	var sprint interface{}
+	if sprintID != "" {
+		sprint = sprintID
+	}
+	err := r.db.NewUpdate().
+		Model((*models.Issue)(nil)).
+		Set("sprint_id = ?", sprint).
+		Set("version = i.version + 1").
+		Set("updated_at = ?", time.Now()).
+		Where("i.id IN (?)", bun.In(ids)).
+		Where("i.sprint_id IS DISTINCT FROM ?", sprint).
+		Returning("i.id").
+		Scan(ctx, &changed)
+	if err != nil {
+		return nil, fmt.Errorf("set issue sprint: %w", err)
+	}
+	return changed, nil
+}
+
 // GetByKey gets an issue by key
 func (r *IssueRepository) GetByKey(ctx context.Context, key string) (*models.Issue, error) {
 	issue := new(models.Issue)
@@ -124,42 +199,164 @@ func (r *IssueRepository) GetByKey(ctx context.Context, key string) (*models.Iss
 		}
 		return nil, fmt.Errorf("get issue by key: %w", err)
 	}
+	if err := r.loadAssociations(ctx, issue); err != nil {
+		return nil, err
+	}
 	return issue, nil
 }
 
-// Update updates an issue
-func (r *IssueRepository) Update(ctx context.Context, issue *models.Issue) error {
+// IssueChanges are what an update changes besides the issue's own columns
+type IssueChanges struct {
+	// CustomValues are saved, and the values of ClearedFields removed
+	CustomValues  []models.IssueCustomValue
+	ClearedFields []string
+	// SetLabels and SetComponents replace the issue's labels and components
+	// with its LabelIDs and ComponentIDs
+	SetLabels     bool
+	SetComponents bool
+}
+
+// Update updates an issue and its custom values, labels and components in one
+// transaction if it is still at the version it was read at, and bumps the
+// version. It returns ErrVersionConflict if someone else updated it first.
+func (r *IssueRepository) Update(ctx context.Context, issue *models.Issue, changes IssueChanges) error {
+	expected := issue.Version
 	issue.UpdatedAt = time.Now()
-	_, err := r.db.NewUpdate().Model(issue).WherePK().Exec(ctx)
+	issue.Version = expected + 1
+
+	err := r.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		res, err := tx.NewUpdate().
+			Model(issue).
+			// Ranks change on their own, see SetRanks
+			ExcludeColumn("rank").
+			WherePK().
+			Where("i.version = ?", expected).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("update issue: %w", err)
+		}
+		if n, err := res.RowsAffected(); err == nil && n == 0 {
+			return ErrVersionConflict
+		}
+
+		if err := saveIssueCustomValues(ctx, tx, issue.ID, changes.CustomValues); err != nil {
+			return err
+		}
+		if err := deleteIssueCustomValues(ctx, tx, issue.ID, changes.ClearedFields); err != nil {
+			return err
+		}
+		if changes.SetLabels {
+			if err := setIssueLabels(ctx, tx, issue.ID, issue.LabelIDs); err != nil {
+				return err
+			}
+		}
+		if changes.SetComponents {
+			if err := setIssueComponents(ctx, tx, issue.ID, issue.ComponentIDs); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("update issue: %w", err)
+		issue.Version = expected
+		return err
 	}
 	return nil
 }
 
-// Delete soft deletes an issue
-func (r *IssueRepository) Delete(ctx context.Context, id string) error {
-	_, err := r.db.NewDelete().Model((*models.Issue)(nil)).Where("id = ?", id).Exec(ctx)
+// Delete moves an issue and its sub-tasks to the trash and returns the IDs of
+// every issue deleted
+func (r *IssueRepository) Delete(ctx context.Context, id, deletedBy string) ([]string, error) {
+	var deleted []string
+	err := r.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		var err error
+		deleted, err = softDeleteTree(ctx, tx, []string{id}, deletedBy, "")
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
+
+// loadAssociations fills LabelIDs, ComponentIDs and WatcherIDs on the given issues
+func (r *IssueRepository) loadAssociations(ctx context.Context, issues ...*models.Issue) error {
+	if len(issues) == 0 {
+		return nil
+	}
+	byID := make(map[string]*models.Issue, len(issues))
+	issueIDs := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		byID[issue.ID] = issue
+		issueIDs = append(issueIDs, issue.ID)
+		issue.LabelIDs = nil
+		issue.ComponentIDs = nil
+		issue.WatcherIDs = nil
+	}
+
+	var labels []models.IssueLabel
+	err := r.db.NewSelect().
+		Model(&labels).
+		Where("issue_id IN (?)", bun.In(issueIDs)).
+		Order("created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return fmt.Errorf("load issue labels: %w", err)
+	}
+	for _, l := range labels {
+		byID[l.IssueID].LabelIDs = append(byID[l.IssueID].LabelIDs, l.LabelID)
+	}
+
+	var components []models.IssueComponent
+	err = r.db.NewSelect().
+		Model(&components).
+		Where("issue_id IN (?)", bun.In(issueIDs)).
+		Order("created_at ASC").
+		Scan(ctx)
 	if err != nil {
-		return fmt.Errorf("delete issue: %w", err)
+		return fmt.Errorf("load issue components: %w", err)
+	}
+	for _, c := range components {
+		byID[c.IssueID].ComponentIDs = append(byID[c.IssueID].ComponentIDs, c.ComponentID)
+	}
+
+	var watchers []models.IssueWatcher
+	err = r.db.NewSelect().
+		Model(&watchers).
+		Where("issue_id IN (?)", bun.In(issueIDs)).
+		Order("joined_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return fmt.Errorf("load issue watchers: %w", err)
+	}
+	for _, w := range watchers {
+		byID[w.IssueID].WatcherIDs = append(byID[w.IssueID].WatcherIDs, w.UserID)
 	}
 	return nil
 }
 
-// List lists issues
-func (r *IssueRepository) List(ctx context.Context, projectID string, limit, offset int) ([]*models.Issue, int, error) {
-	var issues []*models.Issue
-	count, err := r.db.NewSelect().
-		Model(&issues).
-		Where("project_id = ?", projectID).
-		Order("created_at DESC").
-		Limit(limit).
-		Offset(offset).
-		ScanAndCount(ctx)
+// AddWatcher makes a user watch an issue; watching twice is a no-op
+func (r *IssueRepository) AddWatcher(ctx context.Context, issueID, userID string) error {
+	_, err := r.db.NewInsert().
+		Model(&models.IssueWatcher{IssueID: issueID, UserID: userID}).
+		On("CONFLICT (issue_id, user_id) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("add watcher: %w", err)
+	}
+	return nil
+}
+
+// RemoveWatcher stops a user watching an issue
+func (r *IssueRepository) RemoveWatcher(ctx context.Context, issueID, userID string) error {
+	_, err := r.db.NewDelete().
+		Model((*models.IssueWatcher)(nil)).
+		Where("issue_id = ? AND user_id = ?", issueID, userID).
+		Exec(ctx)
 	if err != nil {
-		return nil, 0, fmt.Errorf("list issues: %w", err)
+		return fmt.Errorf("remove watcher: %w", err)
 	}
-	return issues, count, nil
+	return nil
 }
 
 // Custom Fields
@@ -198,11 +395,21 @@ func (r *IssueRepository) UpdateCustomField(ctx context.Context, field *models.C
 
 // DeleteCustomField deletes a custom field
 func (r *IssueRepository) DeleteCustomField(ctx context.Context, id string) error {
-	_, err := r.db.NewDelete().Model((*models.CustomField)(nil)).Where("id = ?", id).Exec(ctx)
-	if err != nil {
-		return fmt.Errorf("delete custom field: %w", err)
-	}
-	return nil
+	return r.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewDelete().Model((*models.CustomField)(nil)).Where("id = ?", id).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("delete custom field: %w", err)
+		}
+		_, err = tx.NewUpdate().
+			Model((*models.IssueTemplate)(nil)).
+			Set("custom_fields = custom_fields - ?", id).
+			Where("custom_fields \\? ?", id).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("remove custom field from templates: %w", err)
+		}
+		return nil
+	})
 }
 
 // ListCustomFields lists custom fields for a project
@@ -219,19 +426,382 @@ func (r *IssueRepository) ListCustomFields(ctx context.Context, projectID string
 	return fields, nil
 }
 
-// SaveIssueCustomValues saves values for custom fields on an issue
-func (r *IssueRepository) SaveIssueCustomValues(ctx context.Context, issueID string, values []models.IssueCustomValue) error {
+// ListOrganizationCustomFields lists organization-level custom fields
+func (r *IssueRepository) ListOrganizationCustomFields(ctx context.Context, orgID string) ([]*models.CustomField, error) {
+	var fields []*models.CustomField
+	err := r.db.NewSelect().
+		Model(&fields).
+		Where("organization_id = ?", orgID).
+		Where("project_id IS NULL").
+		Order("created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list organization custom fields: %w", err)
+	}
+	return fields, nil
+}
+
+// ListApplicableCustomFields lists the project's own fields plus organization
+// fields that have at least one context covering the project
+func (r *IssueRepository) ListApplicableCustomFields(ctx context.Context, projectID, orgID string) ([]*models.CustomField, error) {
+	var fields []*models.CustomField
+	q := r.db.NewSelect().
+		Model(&fields).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			q = q.Where("cf.project_id = ?", projectID)
+			if orgID != "" {
+				q = q.WhereOr(`cf.organization_id = ? AND cf.project_id IS NULL AND EXISTS (
+					SELECT 1 FROM custom_field_contexts AS cfc
+					WHERE cfc.field_id = cf.id
+					AND (cardinality(cfc.project_ids) = 0 OR ?::uuid = ANY(cfc.project_ids))
+				)`, orgID, projectID)
+			}
+			return q
+		}).
+		Order("cf.created_at ASC")
+	if err := q.Scan(ctx); err != nil {
+		return nil, fmt.Errorf("list applicable custom fields: %w", err)
+	}
+	return fields, nil
+}
+
+// Custom Field Contexts
+
+// CreateCustomFieldContext creates a new custom field context
+func (r *IssueRepository) CreateCustomFieldContext(ctx context.Context, fieldCtx *models.CustomFieldContext) error {
+	_, err := r.db.NewInsert().Model(fieldCtx).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("create custom field context: %w", err)
+	}
+	return nil
+}
+
+// GetCustomFieldContext gets a custom field context by ID
+func (r *IssueRepository) GetCustomFieldContext(ctx context.Context, id string) (*models.CustomFieldContext, error) {
+	fieldCtx := new(models.CustomFieldContext)
+	err := r.db.NewSelect().Model(fieldCtx).Relation("Field").Where("cfc.id = ?", id).Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get custom field context: %w", err)
+	}
+	return fieldCtx, nil
+}
+
+// UpdateCustomFieldContext updates a custom field context
+func (r *IssueRepository) UpdateCustomFieldContext(ctx context.Context, fieldCtx *models.CustomFieldContext) error {
+	fieldCtx.UpdatedAt = time.Now()
+	_, err := r.db.NewUpdate().Model(fieldCtx).WherePK().Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("update custom field context: %w", err)
+	}
+	return nil
+}
+
+// DeleteCustomFieldContext deletes a custom field context
+func (r *IssueRepository) DeleteCustomFieldContext(ctx context.Context, id string) error {
+	_, err := r.db.NewDelete().Model((*models.CustomFieldContext)(nil)).Where("id = ?", id).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete custom field context: %w", err)
+	}
+	return nil
+}
+
+// ListCustomFieldContexts lists the contexts of the given fields
+func (r *IssueRepository) ListCustomFieldContexts(ctx context.Context, fieldIDs []string) ([]*models.CustomFieldContext, error) {
+	var contexts []*models.CustomFieldContext
+	if len(fieldIDs) == 0 {
+		return contexts, nil
+	}
+
+	err := r.db.NewSelect().
+		Model(&contexts).
+		Relation("Field").
+		Where("cfc.field_id IN (?)", bun.In(fieldIDs)).
+		Order("cfc.created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list custom field contexts: %w", err)
+	}
+	return contexts, nil
+}
+
+// Labels
+
+// CreateLabel creates a new label
+func (r *IssueRepository) CreateLabel(ctx context.Context, label *models.Label) error {
+	_, err := r.db.NewInsert().Model(label).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("create label: %w", err)
+	}
+	return nil
+}
+
+// GetLabel gets a label by ID
+func (r *IssueRepository) GetLabel(ctx context.Context, id string) (*models.Label, error) {
+	label := new(models.Label)
+	err := r.db.NewSelect().Model(label).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get label: %w", err)
+	}
+	return label, nil
+}
+
+// UpdateLabel updates a label
+func (r *IssueRepository) UpdateLabel(ctx context.Context, label *models.Label) error {
+	label.UpdatedAt = time.Now()
+	_, err := r.db.NewUpdate().Model(label).WherePK().Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("update label: %w", err)
+	}
+	return nil
+}
+
+// DeleteLabel deletes a label and removes it from all issues and templates
+func (r *IssueRepository) DeleteLabel(ctx context.Context, id string) error {
+	return r.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewDelete().Model((*models.Label)(nil)).Where("id = ?", id).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("delete label: %w", err)
+		}
+		_, err = tx.NewUpdate().
+			Model((*models.IssueTemplate)(nil)).
+			Set("label_ids = array_remove(label_ids, ?)", id).
+			Where("? = ANY(label_ids)", id).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("remove label from templates: %w", err)
+		}
+		return nil
+	})
+}
+
+// ListLabels lists labels for a project
+func (r *IssueRepository) ListLabels(ctx context.Context, projectID string) ([]*models.Label, error) {
+	var labels []*models.Label
+	err := r.db.NewSelect().
+		Model(&labels).
+		Where("project_id = ?", projectID).
+		Order("name ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list labels: %w", err)
+	}
+	return labels, nil
+}
+
+// GetLabelsByIDs gets the labels with the given IDs
+func (r *IssueRepository) GetLabelsByIDs(ctx context.Context, ids []string) ([]*models.Label, error) {
+	var labels []*models.Label
+	if len(ids) == 0 {
+		return labels, nil
+	}
+	err := r.db.NewSelect().Model(&labels).Where("id IN (?)", bun.In(ids)).Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get labels: %w", err)
+	}
+	return labels, nil
+}
+
+// ListIssueIDsByLabel lists the issues a label is assigned to
+func (r *IssueRepository) ListIssueIDsByLabel(ctx context.Context, labelID string) ([]string, error) {
+	var issueIDs []string
+	err := r.db.NewSelect().
+		Model((*models.IssueLabel)(nil)).
+		Column("issue_id").
+		Where("label_id = ?", labelID).
+		Scan(ctx, &issueIDs)
+	if err != nil {
+		return nil, fmt.Errorf("list issues by label: %w", err)
+	}
+	return issueIDs, nil
+}
+
+// setIssueLabels replaces the labels of an issue
+func setIssueLabels(ctx context.Context, db bun.IDB, issueID string, labelIDs []string) error {
+	if _, err := db.NewDelete().Model((*models.IssueLabel)(nil)).Where("issue_id = ?", issueID).Exec(ctx); err != nil {
+		return fmt.Errorf("clear issue labels: %w", err)
+	}
+	return insertIssueLabels(ctx, db, []string{issueID}, labelIDs)
+}
+
+// UpdateIssueLabels removes labels from several issues and adds others, in
+// one transaction. A label both removed and added ends up assigned.
+func (r *IssueRepository) UpdateIssueLabels(ctx context.Context, issueIDs, add, remove []string) error {
+	return r.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		if len(issueIDs) > 0 && len(remove) > 0 {
+			_, err := tx.NewDelete().
+				Model((*models.IssueLabel)(nil)).
+				Where("issue_id IN (?)", bun.In(issueIDs)).
+				Where("label_id IN (?)", bun.In(remove)).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("remove issue labels: %w", err)
+			}
+		}
+		return insertIssueLabels(ctx, tx, issueIDs, add)
+	})
+}
+
+func insertIssueLabels(ctx context.Context, db bun.IDB, issueIDs, labelIDs []string) error {
+	if len(issueIDs) == 0 || len(labelIDs) == 0 {
+		return nil
+	}
+	rows := make([]models.IssueLabel, 0, len(issueIDs)*len(labelIDs))
+	for _, issueID := range issueIDs {
+		for _, labelID := range labelIDs {
+			rows = append(rows, models.IssueLabel{IssueID: issueID, LabelID: labelID})
+		}
+	}
+	if _, err := db.NewInsert().Model(&rows).On("CONFLICT DO NOTHING").Exec(ctx); err != nil {
+		return fmt.Errorf("assign issue labels: %w", err)
+	}
+	return nil
+}
+
+// Components
+
+// CreateComponent creates a new component
+func (r *IssueRepository) CreateComponent(ctx context.Context, component *models.Component) error {
+	_, err := r.db.NewInsert().Model(component).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("create component: %w", err)
+	}
+	return nil
+}
+
+// GetComponent gets a component by ID
+func (r *IssueRepository) GetComponent(ctx context.Context, id string) (*models.Component, error) {
+	component := new(models.Component)
+	err := r.db.NewSelect().Model(component).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get component: %w", err)
+	}
+	return component, nil
+}
+
+// UpdateComponent updates a component
+func (r *IssueRepository) UpdateComponent(ctx context.Context, component *models.Component) error {
+	component.UpdatedAt = time.Now()
+	_, err := r.db.NewUpdate().Model(component).WherePK().Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("update component: %w", err)
+	}
+	return nil
+}
+
+// DeleteComponent deletes a component and removes it from all issues
+func (r *IssueRepository) DeleteComponent(ctx context.Context, id string) error {
+	_, err := r.db.NewDelete().Model((*models.Component)(nil)).Where("id = ?", id).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete component: %w", err)
+	}
+	return nil
+}
+
+// ListComponents lists components for a project
+func (r *IssueRepository) ListComponents(ctx context.Context, projectID string) ([]*models.Component, error) {
+	var components []*models.Component
+	err := r.db.NewSelect().
+		Model(&components).
+		Where("project_id = ?", projectID).
+		Order("name ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list components: %w", err)
+	}
+	return components, nil
+}
+
+// GetComponentsByIDs gets the components with the given IDs
+func (r *IssueRepository) GetComponentsByIDs(ctx context.Context, ids []string) ([]*models.Component, error) {
+	var components []*models.Component
+	if len(ids) == 0 {
+		return components, nil
+	}
+	err := r.db.NewSelect().Model(&components).Where("id IN (?)", bun.In(ids)).Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get components: %w", err)
+	}
+	return components, nil
+}
+
+// ListIssueIDsByComponent lists the issues in a component
+func (r *IssueRepository) ListIssueIDsByComponent(ctx context.Context, componentID string) ([]string, error) {
+	var issueIDs []string
+	err := r.db.NewSelect().
+		Model((*models.IssueComponent)(nil)).
+		Column("issue_id").
+		Where("component_id = ?", componentID).
+		Scan(ctx, &issueIDs)
+	if err != nil {
+		return nil, fmt.Errorf("list issues by component: %w", err)
+	}
+	return issueIDs, nil
+}
+
+// setIssueComponents replaces the components of an issue
+func setIssueComponents(ctx context.Context, db bun.IDB, issueID string, componentIDs []string) error {
+	if _, err := db.NewDelete().Model((*models.IssueComponent)(nil)).Where("issue_id = ?", issueID).Exec(ctx); err != nil {
+		return fmt.Errorf("clear issue components: %w", err)
+	}
+	return insertIssueComponents(ctx, db, []string{issueID}, componentIDs)
+}
+
+// UpdateIssueComponents removes components from several issues and adds others, in
+// one transaction. A component both removed and added ends up assigned.
+func (r *IssueRepository) UpdateIssueComponents(ctx context.Context, issueIDs, add, remove []string) error {
+	return r.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		if len(issueIDs) > 0 && len(remove) > 0 {
+			_, err := tx.NewDelete().
+				Model((*models.IssueComponent)(nil)).
+				Where("issue_id IN (?)", bun.In(issueIDs)).
+				Where("component_id IN (?)", bun.In(remove)).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("remove issue components: %w", err)
+			}
+		}
+		return insertIssueComponents(ctx, tx, issueIDs, add)
+	})
+}
+
+func insertIssueComponents(ctx context.Context, db bun.IDB, issueIDs, componentIDs []string) error {
+	if len(issueIDs) == 0 || len(componentIDs) == 0 {
+		return nil
+	}
+	rows := make([]models.IssueComponent, 0, len(issueIDs)*len(componentIDs))
+	for _, issueID := range issueIDs {
+		for _, componentID := range componentIDs {
+			rows = append(rows, models.IssueComponent{IssueID: issueID, ComponentID: componentID})
+		}
+	}
+	if _, err := db.NewInsert().Model(&rows).On("CONFLICT DO NOTHING").Exec(ctx); err != nil {
+		return fmt.Errorf("assign issue components: %w", err)
+	}
+	return nil
+}
+
+// saveIssueCustomValues saves values for custom fields on an issue
+func saveIssueCustomValues(ctx context.Context, db bun.IDB, issueID string, values []models.IssueCustomValue) error {
 	if len(values) == 0 {
 		return nil
 	}
-	
+
 	// Ensure issue_id is set
 	for i := range values {
 		values[i].IssueID = issueID
 	}
 
 	// Upsert values
-	_, err := r.db.NewInsert().
+	_, err := db.NewInsert().
 		Model(&values).
 		On("CONFLICT (issue_id, field_id) DO UPDATE").
 		Set("value = EXCLUDED.value").
@@ -243,15 +813,51 @@ func (r *IssueRepository) SaveIssueCustomValues(ctx context.Context, issueID str
 	return nil
 }
 
-// GetIssueCustomValues gets custom values for an issue
+// deleteIssueCustomValues removes values for the given fields from an issue
+func deleteIssueCustomValues(ctx context.Context, db bun.IDB, issueID string, fieldIDs []string) error {
+	if len(fieldIDs) == 0 {
+		return nil
+	}
+
+	_, err := db.NewDelete().
+		Model((*models.IssueCustomValue)(nil)).
+		Where("issue_id = ?", issueID).
+		Where("field_id IN (?)", bun.In(fieldIDs)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete issue custom values: %w", err)
+	}
+	return nil
+}
+
+// GetIssueCustomValues gets custom values for an issue, with their field definitions
 func (r *IssueRepository) GetIssueCustomValues(ctx context.Context, issueID string) ([]*models.IssueCustomValue, error) {
 	var values []*models.IssueCustomValue
 	err := r.db.NewSelect().
 		Model(&values).
-		Where("issue_id = ?", issueID).
+		Relation("Field").
+		Where("icv.issue_id = ?", issueID).
 		Scan(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("get issue custom values: %w", err)
 	}
 	return values, nil
 }
+
+// ListIssueCustomValues gets custom values for several issues, with their field definitions
+func (r *IssueRepository) ListIssueCustomValues(ctx context.Context, issueIDs []string) ([]*models.IssueCustomValue, error) {
+	var values []*models.IssueCustomValue
+	if len(issueIDs) == 0 {
+		return values, nil
+	}
+
+	err := r.db.NewSelect().
+		Model(&values).
+		Relation("Field").
+		Where("icv.issue_id IN (?)", bun.In(issueIDs)).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list issue custom values: %w", err)
+	}
+	return values, nil
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// lastIssueRank returns the highest rank in a project, trashed issues
+// included so restoring one cannot collide with a newer rank
+func lastIssueRank(ctx context.Context, db bun.IDB, projectID string) (string, error) {
+	var rank string
+	err := db.NewSelect().
+		Model((*models.Issue)(nil)).
+		Column("rank").
+		WhereAllWithDeleted().
+		Where("i.project_id = ?", projectID).
+		OrderExpr("i.rank DESC").
+		Limit(1).
+		Scan(ctx, &rank)
+	if err != nil && err != sql.ErrNoRows {
+		return "", fmt.Errorf("get last rank: %w", err)
+	}
+	return rank, nil
+}
+
+// AdjacentRank returns the rank of the issue nearest to rank in a project,
+// the one before it or, with after, the one after it. The excluded issues are
+// skipped. It returns an empty rank at either end of the backlog.
+func (r *IssueRepository) AdjacentRank(ctx context.Context, projectID, rank string, after bool, excludeIDs []string) (string, error) {
+	op, direction := "<", "DESC"
+	if after {
+		op, direction = ">", "ASC"
+	}
+
+	var adjacent string
+	err := r.db.NewSelect().
+		Model((*models.Issue)(nil)).
+		Column("rank").
+		WhereAllWithDeleted().
+		Where("i.project_id = ?", projectID).
+		Where(fmt.Sprintf("i.rank %s ?", op), rank).
+		Where("i.id NOT IN (?)", bun.In(excludeIDs)).
+		OrderExpr(fmt.Sprintf("i.rank %s", direction)).
+		Limit(1).
+		Scan(ctx, &adjacent)
+	if err != nil && err != sql.ErrNoRows {
+		return "", fmt.Errorf("get adjacent rank: %w", err)
+	}
+	return adjacent, nil
+}
+
+// SetRanks saves the ranks of issues. Ranks are ordering rather than content,
+// so neither the version nor updated_at of the issues change.
+func (r *IssueRepository) SetRanks(ctx context.Context, issues []*models.Issue) error {
+	if len(issues) == 0 {
+		return nil
+	}
+	_, err := r.db.NewUpdate().
+		With("_data", r.db.NewValues(&issues).Column("id", "rank")).
+		Model((*models.Issue)(nil)).
+		TableExpr("_data").
+		Set("rank = _data.rank").
+		Where("i.id = _data.id").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("set ranks: %w", err)
+	}
+	return nil
+}
+
+// ListProjectsToRebalance lists the projects holding a rank longer than
+// maxLength or two issues with the same rank
+func (r *IssueRepository) ListProjectsToRebalance(ctx context.Context, maxLength, limit int) ([]string, error) {
+	var projectIDs []string
+	err := r.db.NewRaw(`
+		SELECT project_id FROM issues
+		GROUP BY project_id
+		HAVING max(length(rank)) > ? OR count(*) > count(DISTINCT rank)
+		LIMIT ?`, maxLength, limit).
+		Scan(ctx, &projectIDs)
+	if err != nil {
+		return nil, fmt.Errorf("list projects to rebalance: %w", err)
+	}
+	return projectIDs, nil
+}
+
+// rankChange moves an issue from one rank to another
+type rankChange struct {
+	ID      string `bun:"id,type:uuid"`
+	OldRank string `bun:"old_rank"`
+	Rank    string `bun:"rank"`
+}
+
+// RebalanceRanks spreads the ranks of a project's issues evenly, keeping
+// their order, so they are as short as they can be again. It returns how
+// many ranks changed.
+//
+// Ranks are saved batchSize issues at a time, so only a batch is locked at
+// once. Issues whose rank goes down are saved first to last and those whose
+// rank goes up last to first, which keeps the backlog in order after every
+// batch. Issues moved while the project is rebalanced keep their new rank.
+func (r *IssueRepository) RebalanceRanks(ctx context.Context, projectID string, batchSize int) (int, error) {
+	var issues []*models.Issue
+	err := r.db.NewSelect().
+		Model(&issues).
+		Column("id", "rank").
+		WhereAllWithDeleted().
+		Where("i.project_id = ?", projectID).
+		OrderExpr("i.rank ASC, i.created_at ASC, i.id ASC").
+		Scan(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list ranks: %w", err)
+	}
+
+	ranks := models.RanksBetween("", "", len(issues))
+	var down, up []rankChange
+	for i, issue := range issues {
+		change := rankChange{ID: issue.ID, OldRank: issue.Rank, Rank: ranks[i]}
+		switch {
+		case change.Rank < change.OldRank:
+			down = append(down, change)
+		case change.Rank > change.OldRank:
+			up = append(up, change)
+		}
+	}
+	for i, j := 0, len(up)-1; i < j; i, j = i+1, j-1 {
+		up[i], up[j] = up[j], up[i]
+	}
+
+	changes := append(down, up...)
+	changed := 0
+	for start := 0; start < len(changes); start += batchSize {
+		end := start + batchSize
+		if end > len(changes) {
+			end = len(changes)
+		}
+		batch := changes[start:end]
+		res, err := r.db.NewUpdate().
+			With("_data", r.db.NewValues(&batch)).
+			Model((*models.Issue)(nil)).
+			TableExpr("_data").
+			Set("rank = _data.rank").
+			Where("i.id = _data.id").
+			Where("i.rank = _data.old_rank").
+			Exec(ctx)
+		if err != nil {
+			return changed, fmt.Errorf("save ranks: %w", err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return changed, fmt.Errorf("save ranks: %w", err)
+		}
+		changed += int(n)
+	}
+	return changed, nil
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+)
+
+// CreateIssueSchedule creates an issue schedule
+func (r *IssueRepository) CreateIssueSchedule(ctx context.Context, schedule *models.IssueSchedule) error {
+	if _, err := r.db.NewInsert().Model(schedule).Exec(ctx); err != nil {
+		return fmt.Errorf("create issue schedule: %w", err)
+	}
+	return nil
+}
+
+// GetIssueSchedule gets an issue schedule by ID
+func (r *IssueRepository) GetIssueSchedule(ctx context.Context, id string) (*models.IssueSchedule, error) {
+	schedule := new(models.IssueSchedule)
+	err := r.db.NewSelect().Model(schedule).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get issue schedule: %w", err)
+	}
+	return schedule, nil
+}
+
+// UpdateIssueSchedule updates an issue schedule
+func (r *IssueRepository) UpdateIssueSchedule(ctx context.Context, schedule *models.IssueSchedule) error {
+	schedule.UpdatedAt = time.Now()
+	if _, err := r.db.NewUpdate().Model(schedule).WherePK().Exec(ctx); err != nil {
+		return fmt.Errorf("update issue schedule: %w", err)
+	}
+	return nil
+}
+
+// DeleteIssueSchedule deletes an issue schedule; issues it created keep existing
+func (r *IssueRepository) DeleteIssueSchedule(ctx context.Context, id string) error {
+	_, err := r.db.NewDelete().Model((*models.IssueSchedule)(nil)).Where("id = ?", id).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete issue schedule: %w", err)
+	}
+	return nil
+}
+
+// ListIssueSchedules lists the issue schedules of a project
+func (r *IssueRepository) ListIssueSchedules(ctx context.Context, projectID string) ([]*models.IssueSchedule, error) {
+	var schedules []*models.IssueSchedule
+	err := r.db.NewSelect().
+		Model(&schedules).
+		Where("project_id = ?", projectID).
+		Order("name ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list issue schedules: %w", err)
+	}
+	return schedules, nil
+}
+
+// ListDueIssueSchedules lists up to limit enabled schedules whose next run is
+// at or before now, oldest first
+func (r *IssueRepository) ListDueIssueSchedules(ctx context.Context, now time.Time, limit int) ([]*models.IssueSchedule, error) {
+	var schedules []*models.IssueSchedule
+	err := r.db.NewSelect().
+		Model(&schedules).
+		Where("enabled").
+		Where("next_run_at <= ?", now).
+		OrderExpr("next_run_at ASC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list due issue schedules: %w", err)
+	}
+	return schedules, nil
+}
+
+// ClaimIssueScheduleRun moves a schedule from its current next run to the
+// following one. It reports false if another scheduler already claimed the
+// run, so each occurrence is created by one instance only.
+func (r *IssueRepository) ClaimIssueScheduleRun(ctx context.Context, schedule *models.IssueSchedule, next, now time.Time) (bool, error) {
+	res, err := r.db.NewUpdate().
+		Model((*models.IssueSchedule)(nil)).
+		Set("next_run_at = ?", nullTime(next)).
+		Set("last_run_at = ?", now).
+		Where("id = ?", schedule.ID).
+		Where("next_run_at = ?", schedule.NextRunAt).
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("claim issue schedule run: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("claim issue schedule run: %w", err)
+	}
+	return n == 1, nil
+}
+
+// SetIssueScheduleError records the outcome of the last run; an empty
+// message clears a previous error
+func (r *IssueRepository) SetIssueScheduleError(ctx context.Context, id, message string) error {
+	_, err := r.db.NewUpdate().
+		Model((*models.IssueSchedule)(nil)).
+		Set("last_error = ?", message).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("set issue schedule error: %w", err)
+	}
+	return nil
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
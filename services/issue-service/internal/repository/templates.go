@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// CreateIssueTemplate creates an issue template. A default template replaces
+// the previous default of its issue type.
+func (r *IssueRepository) CreateIssueTemplate(ctx context.Context, template *models.IssueTemplate) error {
+	return r.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		if err := clearDefaultTemplate(ctx, tx, template); err != nil {
+			return err
+		}
+		if _, err := tx.NewInsert().Model(template).Exec(ctx); err != nil {
+			return fmt.Errorf("create issue template: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetIssueTemplate gets an issue template by ID
+func (r *IssueRepository) GetIssueTemplate(ctx context.Context, id string) (*models.IssueTemplate, error) {
+	template := new(models.IssueTemplate)
+	err := r.db.NewSelect().Model(template).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get issue template: %w", err)
+	}
+	return template, nil
+}
+
+// GetDefaultIssueTemplate gets the default template of an issue type in a project
+func (r *IssueRepository) GetDefaultIssueTemplate(ctx context.Context, projectID string, issueType models.IssueType) (*models.IssueTemplate, error) {
+	template := new(models.IssueTemplate)
+	err := r.db.NewSelect().
+		Model(template).
+		Where("project_id = ?", projectID).
+		Where("issue_type = ?", issueType).
+		Where("is_default").
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get default issue template: %w", err)
+	}
+	return template, nil
+}
+
+// UpdateIssueTemplate updates an issue template. A default template replaces
+// the previous default of its issue type.
+func (r *IssueRepository) UpdateIssueTemplate(ctx context.Context, template *models.IssueTemplate) error {
+	return r.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		if err := clearDefaultTemplate(ctx, tx, template); err != nil {
+			return err
+		}
+		template.UpdatedAt = time.Now()
+		if _, err := tx.NewUpdate().Model(template).WherePK().Exec(ctx); err != nil {
+			return fmt.Errorf("update issue template: %w", err)
+		}
+		return nil
+	})
+}
+
+// DeleteIssueTemplate deletes an issue template
+func (r *IssueRepository) DeleteIssueTemplate(ctx context.Context, id string) error {
+	_, err := r.db.NewDelete().Model((*models.IssueTemplate)(nil)).Where("id = ?", id).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete issue template: %w", err)
+	}
+	return nil
+}
+
+// ListIssueTemplates lists the templates of a project, optionally only those
+// of one issue type
+func (r *IssueRepository) ListIssueTemplates(ctx context.Context, projectID string, issueType models.IssueType) ([]*models.IssueTemplate, error) {
+	var templates []*models.IssueTemplate
+	q := r.db.NewSelect().
+		Model(&templates).
+		Where("project_id = ?", projectID)
+	if issueType != "" {
+		q = q.Where("issue_type = ?", issueType)
+	}
+	if err := q.Order("issue_type ASC", "name ASC").Scan(ctx); err != nil {
+		return nil, fmt.Errorf("list issue templates: %w", err)
+	}
+	return templates, nil
+}
+
+// clearDefaultTemplate unsets the current default of the template's issue
+// type when the template becomes the default
+func clearDefaultTemplate(ctx context.Context, db bun.IDB, template *models.IssueTemplate) error {
+	if !template.IsDefault {
+		return nil
+	}
+	q := db.NewUpdate().
+		Model((*models.IssueTemplate)(nil)).
+		Set("is_default = FALSE").
+		Where("project_id = ?", template.ProjectID).
+		Where("issue_type = ?", template.IssueType).
+		Where("is_default")
+	if template.ID != "" {
+		q = q.Where("id != ?", template.ID)
+	}
+	if _, err := q.Exec(ctx); err != nil {
+		return fmt.Errorf("clear default issue template: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// softDeleteTree soft deletes the given issues and all of their sub-tasks,
+// recording on each which of the given issues the delete started from. When
+// both an issue and one of its ancestors are given, the issue is deleted
+// along with the topmost ancestor, so restoring that ancestor restores it too.
+// It returns the IDs of every issue that was deleted.
+func softDeleteTree(ctx context.Context, db bun.IDB, ids []string, deletedBy, jobID string) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var deleted []string
+	err := db.NewRaw(`
+		WITH RECURSIVE tree AS (
+			SELECT id, id AS root_id, 0 AS depth FROM issues WHERE id IN (?) AND deleted_at IS NULL
+			UNION ALL
+			SELECT c.id, tree.root_id, tree.depth + 1
+			FROM issues AS c JOIN tree ON c.parent_id = tree.id
+			WHERE c.deleted_at IS NULL
+		), roots AS (
+			SELECT DISTINCT ON (id) id, root_id FROM tree ORDER BY id, depth DESC
+		)
+		UPDATE issues AS i
+		SET deleted_at = ?, deleted_by = ?, deleted_root_id = roots.root_id
+		FROM roots
+		WHERE i.id = roots.id
+		RETURNING i.id`,
+		bun.In(ids), time.Now(), sql.NullString{String: deletedBy, Valid: deletedBy != ""},
+	).Scan(ctx, &deleted)
+	if err != nil {
+		return nil, fmt.Errorf("delete issues: %w", err)
+	}
+
+	history := make([]models.IssueHistory, 0, len(deleted))
+	for _, id := range deleted {
+		history = append(history, models.IssueHistory{
+			IssueID:   id,
+			Field:     "deleted",
+			NewValue:  "true",
+			ChangedBy: deletedBy,
+			JobID:     jobID,
+		})
+	}
+	if err := insertIssueHistory(ctx, db, history); err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
+
+func insertIssueHistory(ctx context.Context, db bun.IDB, history []models.IssueHistory) error {
+	if len(history) == 0 {
+		return nil
+	}
+	if _, err := db.NewInsert().Model(&history).Exec(ctx); err != nil {
+		return fmt.Errorf("insert issue history: %w", err)
+	}
+	return nil
+}
+
+// GetDeletedByID gets an issue from the trash
+func (r *IssueRepository) GetDeletedByID(ctx context.Context, id string) (*models.Issue, error) {
+	issue := new(models.Issue)
+	err := r.db.NewSelect().Model(issue).WhereDeleted().Where("i.id = ?", id).Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get deleted issue: %w", err)
+	}
+	if err := r.loadAssociations(ctx, issue); err != nil {
+		return nil, err
+	}
+	return issue, nil
+}
+
+// ListDeleted lists the trash of a project, most recently deleted first. Only
+// issues that were deleted directly are listed, not the sub-tasks deleted
+// along with them.
+func (r *IssueRepository) ListDeleted(ctx context.Context, projectID string, limit, offset int) ([]*models.Issue, int, error) {
+	var issues []*models.Issue
+	total, err := r.db.NewSelect().
+		Model(&issues).
+		WhereDeleted().
+		Where("i.project_id = ?", projectID).
+		Where("i.deleted_root_id = i.id").
+		OrderExpr("i.deleted_at DESC, i.id DESC").
+		Limit(limit).
+		Offset(offset).
+		ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list deleted issues: %w", err)
+	}
+	if err := r.loadAssociations(ctx, issues...); err != nil {
+		return nil, 0, err
+	}
+	return issues, total, nil
+}
+
+// Restore takes an issue and the sub-tasks deleted with it out of the trash
+// and returns their IDs
+func (r *IssueRepository) Restore(ctx context.Context, rootID, restoredBy string) ([]string, error) {
+	var restored []string
+	err := r.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewRaw(`
+			UPDATE issues
+			SET deleted_at = NULL, deleted_by = NULL, deleted_root_id = NULL
+			WHERE deleted_root_id = ? AND deleted_at IS NOT NULL
+			RETURNING id`,
+			rootID,
+		).Scan(ctx, &restored)
+		if err != nil {
+			return fmt.Errorf("restore issues: %w", err)
+		}
+
+		history := make([]models.IssueHistory, 0, len(restored))
+		for _, id := range restored {
+			history = append(history, models.IssueHistory{
+				IssueID:   id,
+				Field:     "deleted",
+				OldValue:  "true",
+				ChangedBy: restoredBy,
+			})
+		}
+		return insertIssueHistory(ctx, tx, history)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return restored, nil
+}
+
+// PurgeDeleted permanently deletes up to limit issues that have been in the
+// trash since before the given time, together with their custom values,
+// links and watchers. It returns the purged issues.
+func (r *IssueRepository) PurgeDeleted(ctx context.Context, before time.Time, limit int) ([]*models.Issue, error) {
+	var issues []*models.Issue
+	err := r.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewSelect().
+			Model(&issues).
+			Column("i.id", "i.project_id", "i.key").
+			WhereDeleted().
+			Where("i.deleted_at < ?", before).
+			OrderExpr("i.deleted_at ASC").
+			Limit(limit).
+			For("UPDATE SKIP LOCKED").
+			Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("select expired issues: %w", err)
+		}
+		if len(issues) == 0 {
+			return nil
+		}
+		ids := make([]string, 0, len(issues))
+		for _, issue := range issues {
+			ids = append(ids, issue.ID)
+		}
+
+		// Sub-tasks that outlived their parent lose the link rather than blocking the purge
+		_, err = tx.NewUpdate().
+			Model((*models.Issue)(nil)).
+			WhereAllWithDeleted().
+			Set("parent_id = NULL").
+			Where("parent_id IN (?)", bun.In(ids)).
+			Where("id NOT IN (?)", bun.In(ids)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("detach sub-tasks: %w", err)
+		}
+
+		if _, err := tx.NewDelete().Model((*models.IssueCustomValue)(nil)).Where("issue_id IN (?)", bun.In(ids)).Exec(ctx); err != nil {
+			return fmt.Errorf("delete custom values: %w", err)
+		}
+		_, err = tx.NewDelete().
+			Model((*models.IssueLink)(nil)).
+			Where("source_issue_id IN (?) OR target_issue_id IN (?)", bun.In(ids), bun.In(ids)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("delete links: %w", err)
+		}
+		if _, err := tx.NewDelete().Model((*models.IssueWatcher)(nil)).Where("issue_id IN (?)", bun.In(ids)).Exec(ctx); err != nil {
+			return fmt.Errorf("delete watchers: %w", err)
+		}
+
+		_, err = tx.NewDelete().
+			Model((*models.Issue)(nil)).
+			WhereAllWithDeleted().
+			Where("id IN (?)", bun.In(ids)).
+			ForceDelete().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("purge issues: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// TimesheetFilter selects worklogs started in [From, To) by a user, in a
+// project, or both
+type TimesheetFilter struct {
+	UserID    string
+	ProjectID string
+	From      time.Time
+	To        time.Time
+}
+
+// CreateWorklog creates a worklog and adds its duration to the issue. It
+// returns the issue's updated time tracking.
+func (r *IssueRepository) CreateWorklog(ctx context.Context, worklog *models.Worklog) (*models.Issue, error) {
+	var issue *models.Issue
+	err := r.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		var err error
+		if issue, err = adjustTimeSpent(ctx, tx, worklog, worklog.Duration); err != nil {
+			return err
+		}
+		if _, err := tx.NewInsert().Model(worklog).Exec(ctx); err != nil {
+			return fmt.Errorf("create worklog: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issue, nil
+}
+
+// GetWorklog gets a worklog by ID
+func (r *IssueRepository) GetWorklog(ctx context.Context, id string) (*models.Worklog, error) {
+	worklog := new(models.Worklog)
+	err := r.db.NewSelect().Model(worklog).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get worklog: %w", err)
+	}
+	return worklog, nil
+}
+
+// UpdateWorklog updates a worklog and moves the change in duration onto the
+// issue. It returns the issue's updated time tracking, or nil if the duration
+// did not change.
+func (r *IssueRepository) UpdateWorklog(ctx context.Context, worklog *models.Worklog, previousDuration int64) (*models.Issue, error) {
+	var issue *models.Issue
+	err := r.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		if delta := worklog.Duration - previousDuration; delta != 0 {
+			var err error
+			if issue, err = adjustTimeSpent(ctx, tx, worklog, delta); err != nil {
+				return err
+			}
+		}
+		worklog.UpdatedAt = time.Now()
+		if _, err := tx.NewUpdate().Model(worklog).WherePK().Exec(ctx); err != nil {
+			return fmt.Errorf("update worklog: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issue, nil
+}
+
+// DeleteWorklog deletes a worklog and takes its duration off the issue. It
+// returns the issue's updated time tracking.
+func (r *IssueRepository) DeleteWorklog(ctx context.Context, worklog *models.Worklog) (*models.Issue, error) {
+	var issue *models.Issue
+	err := r.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		var err error
+		if issue, err = adjustTimeSpent(ctx, tx, worklog, -worklog.Duration); err != nil {
+			return err
+		}
+		if _, err := tx.NewDelete().Model((*models.Worklog)(nil)).Where("id = ?", worklog.ID).Exec(ctx); err != nil {
+			return fmt.Errorf("delete worklog: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issue, nil
+}
+
+// ListWorklogs lists the worklogs of an issue in the order the work started
+func (r *IssueRepository) ListWorklogs(ctx context.Context, issueID string) ([]*models.Worklog, error) {
+	var worklogs []*models.Worklog
+	err := r.db.NewSelect().
+		Model(&worklogs).
+		Where("issue_id = ?", issueID).
+		Order("started_at ASC", "id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list worklogs: %w", err)
+	}
+	return worklogs, nil
+}
+
+// ListTimesheet lists the worklogs matching a timesheet filter, leaving out
+// those on issues in the trash
+func (r *IssueRepository) ListTimesheet(ctx context.Context, filter TimesheetFilter) ([]*models.Worklog, error) {
+	var worklogs []*models.Worklog
+	q := r.db.NewSelect().
+		Model(&worklogs).
+		Where("w.started_at >= ?", filter.From).
+		Where("w.started_at < ?", filter.To).
+		Where("EXISTS (SELECT 1 FROM issues AS i WHERE i.id = w.issue_id AND i.deleted_at IS NULL)")
+	if filter.UserID != "" {
+		q = q.Where("w.user_id = ?", filter.UserID)
+	}
+	if filter.ProjectID != "" {
+		q = q.Where("w.project_id = ?", filter.ProjectID)
+	}
+	if err := q.Order("w.started_at ASC", "w.id ASC").Scan(ctx); err != nil {
+		return nil, fmt.Errorf("list timesheet: %w", err)
+	}
+	return worklogs, nil
+}
+
+// GetTimeTrackingRollup sums the time tracking of an issue and all of its
+// descendants, e.g. the stories and sub-tasks of an epic
+func (r *IssueRepository) GetTimeTrackingRollup(ctx context.Context, issueID string) (*models.TimeTracking, error) {
+	total := new(models.TimeTracking)
+	err := r.db.NewRaw(`
+		WITH RECURSIVE tree AS (
+			SELECT id, original_estimate, remaining_estimate, time_spent
+			FROM issues WHERE id = ? AND deleted_at IS NULL
+			UNION ALL
+			SELECT c.id, c.original_estimate, c.remaining_estimate, c.time_spent
+			FROM issues AS c JOIN tree ON c.parent_id = tree.id
+			WHERE c.deleted_at IS NULL
+		)
+		SELECT
+			COALESCE(SUM(original_estimate), 0) AS original_estimate,
+			COALESCE(SUM(remaining_estimate), 0) AS remaining_estimate,
+			COALESCE(SUM(time_spent), 0) AS time_spent
+		FROM tree`,
+		issueID,
+	).Scan(ctx, total)
+	if err != nil {
+		return nil, fmt.Errorf("get time tracking rollup: %w", err)
+	}
+	return total, nil
+}
+
+// adjustTimeSpent adds delta seconds of the worklog to the time spent on its
+// issue and moves the remaining estimate the other way. Logging more takes
+// time off the remaining estimate, never below zero, and records how much on
+// the worklog; logging less gives back no more than the worklog took. Issues
+// without an estimate keep a zero remaining estimate. The worklog is saved by
+// the caller.
+func adjustTimeSpent(ctx context.Context, tx bun.Tx, worklog *models.Worklog, delta int64) (*models.Issue, error) {
+	issue := new(models.Issue)
+	err := tx.NewSelect().
+		Model(issue).
+		Column("id", "key", "project_id", "version", "original_estimate", "remaining_estimate", "time_spent").
+		Where("i.id = ?", worklog.IssueID).
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("adjust time spent: issue %s not found", worklog.IssueID)
+		}
+		return nil, fmt.Errorf("adjust time spent: %w", err)
+	}
+
+	if delta > 0 {
+		if issue.OriginalEstimate > 0 || issue.RemainingEstimate > 0 {
+			taken := min(delta, issue.RemainingEstimate)
+			issue.RemainingEstimate -= taken
+			worklog.EstimateTaken += taken
+		}
+	} else {
+		given := min(-delta, worklog.EstimateTaken)
+		issue.RemainingEstimate += given
+		worklog.EstimateTaken -= given
+	}
+	issue.TimeSpent = max(issue.TimeSpent+delta, 0)
+	issue.Version++
+	issue.UpdatedAt = time.Now()
+
+	_, err = tx.NewUpdate().
+		Model(issue).
+		Column("time_spent", "remaining_estimate", "version", "updated_at").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("adjust time spent: %w", err)
+	}
+	return issue, nil
+}
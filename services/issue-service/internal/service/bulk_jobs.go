@@ -0,0 +1,476 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sprintpb "github.com/nexusflow/nexusflow/pkg/proto/sprint/v1"
+	workflowpb "github.com/nexusflow/nexusflow/pkg/proto/workflow/v1"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/repository"
+)
+
+const (
+	// bulkJobBatchSize is the number of issues saved per transaction
+	bulkJobBatchSize = 50
+	// maxBulkJobIssues caps how many issues a single job may touch
+	maxBulkJobIssues = 5000
+	// bulkJobLease is how long a job stays claimed by its instance without a
+	// renewal; the lease is renewed several times within it
+	bulkJobLease = 2 * time.Minute
+)
+
+// BulkUpdateIssuesInput represents input for a bulk update job
+type BulkUpdateIssuesInput struct {
+	// IssueIDs selects the issues; Filter is used when it is empty
+	IssueIDs []string
+	Filter   *repository.IssueFilter
+	Changes  models.BulkChanges
+	UserID   string
+}
+
+// BulkUpdateIssues starts an asynchronous job applying the changes to every
+// selected issue. The issues are resolved up front so the job is not affected
+// by issues that start or stop matching the filter while it runs.
+func (s *IssueService) BulkUpdateIssues(ctx context.Context, input BulkUpdateIssuesInput) (*models.BulkJob, error) {
+	if err := validateBulkChanges(input.Changes); err != nil {
+		return nil, err
+	}
+
+	job := &models.BulkJob{
+		Status:    models.BulkJobStatusPending,
+		Changes:   input.Changes,
+		CreatedBy: input.UserID,
+		// Taken now so the job is not failed as abandoned before it starts
+		Owner:          s.instanceID,
+		LeaseExpiresAt: time.Now().Add(bulkJobLease),
+	}
+	switch {
+	case len(input.IssueIDs) > 0:
+		job.IssueIDs = uniqueStrings(input.IssueIDs)
+		if len(job.IssueIDs) > maxBulkJobIssues {
+			return nil, fmt.Errorf("%w: at most %d issues can be updated at once", ErrValidation, maxBulkJobIssues)
+		}
+	case input.Filter != nil && input.Filter.ProjectID != "":
+		if err := validateIssueFilter(*input.Filter); err != nil {
+			return nil, err
+		}
+		ids, err := s.repo.ListIDs(ctx, *input.Filter, maxBulkJobIssues+1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve issues: %w", err)
+		}
+		if len(ids) > maxBulkJobIssues {
+			return nil, fmt.Errorf("%w: filter matches more than %d issues", ErrValidation, maxBulkJobIssues)
+		}
+		job.ProjectID = input.Filter.ProjectID
+		job.IssueIDs = ids
+	default:
+		return nil, fmt.Errorf("%w: issue_ids or a filter with project_id is required", ErrValidation)
+	}
+	if len(job.IssueIDs) == 0 {
+		return nil, fmt.Errorf("%w: no issues selected", ErrValidation)
+	}
+	job.Total = len(job.IssueIDs)
+
+	if err := s.repo.CreateBulkJob(ctx, job); err != nil {
+		return nil, fmt.Errorf("failed to create job: %w", err)
+	}
+
+	// The job outlives the request, so it must not inherit its cancellation
+	go s.runBulkJob(context.Background(), job)
+
+	return job, nil
+}
+
+// GetJob gets a bulk job with its progress
+func (s *IssueService) GetJob(ctx context.Context, id string) (*models.BulkJob, error) {
+	job, err := s.repo.GetBulkJob(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+	if job == nil {
+		return nil, fmt.Errorf("%w: job %s", ErrNotFound, id)
+	}
+	return job, nil
+}
+
+// FailInterruptedJobs marks unfinished jobs whose lease has expired as failed.
+// Jobs run in the process that created them, so a job whose instance stopped
+// renewing its lease will never finish; jobs other instances are still
+// running keep their lease and are left alone.
+func (s *IssueService) FailInterruptedJobs(ctx context.Context) error {
+	n, err := s.repo.FailExpiredBulkJobs(ctx, "interrupted by a service restart", time.Now())
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		s.log.Sugar().Warnw("Marked interrupted bulk jobs as failed", "count", n)
+	}
+	return nil
+}
+
+// RunBulkJobReaper fails interrupted bulk jobs until ctx is cancelled
+func (s *IssueService) RunBulkJobReaper(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.FailInterruptedJobs(ctx); err != nil {
+			s.log.Sugar().Errorw("Failed to clean up interrupted bulk jobs", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// holdBulkJobLease renews this instance's lease on a job until the returned
+// function is called. The returned context is cancelled if the lease is lost,
+// e.g. because renewals failed for long enough that the job was failed.
+func (s *IssueService) holdBulkJobLease(ctx context.Context, job *models.BulkJob) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		ticker := time.NewTicker(bulkJobLease / 4)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			held, err := s.repo.RenewBulkJobLease(ctx, job.ID, job.Owner, time.Now().Add(bulkJobLease))
+			if err != nil {
+				s.log.Sugar().Warnw("Failed to renew bulk job lease", "error", err, "job_id", job.ID)
+				continue
+			}
+			if !held {
+				s.log.Sugar().Errorw("Lost lease on bulk job, stopping it", "job_id", job.ID)
+				cancel()
+				return
+			}
+		}
+	}()
+	return ctx, cancel
+}
+
+// runBulkJob processes a job batch by batch, saving progress after each batch
+func (s *IssueService) runBulkJob(ctx context.Context, job *models.BulkJob) {
+	ctx, release := s.holdBulkJobLease(ctx, job)
+	defer release()
+
+	job.Status = models.BulkJobStatusRunning
+	job.StartedAt = time.Now()
+	s.saveBulkJob(ctx, job)
+
+	var succeeded, deleted []string
+	projects := make(map[string]bool)
+	for start := 0; start < len(job.IssueIDs); start += bulkJobBatchSize {
+		end := start + bulkJobBatchSize
+		if end > len(job.IssueIDs) {
+			end = len(job.IssueIDs)
+		}
+		ids := job.IssueIDs[start:end]
+		if ctx.Err() != nil {
+			// The job has been failed as interrupted; leave it that way
+			return
+		}
+
+		result, err := s.runBulkBatch(ctx, job, ids)
+		if err != nil {
+			s.log.Sugar().Errorw("Bulk job batch failed", "error", err, "job_id", job.ID)
+			result = &bulkBatchResult{}
+			for _, id := range ids {
+				result.skipped = append(result.skipped, models.BulkJobError{IssueID: id, Message: err.Error()})
+			}
+		}
+		job.Errors = append(job.Errors, result.skipped...)
+		for _, issue := range result.done {
+			succeeded = append(succeeded, issue.ID)
+			projects[issue.ProjectID] = true
+		}
+		deleted = append(deleted, result.deleted...)
+
+		job.Processed += len(ids)
+		job.Succeeded += len(result.done)
+		job.Failed = job.Processed - job.Succeeded
+		s.saveBulkJob(ctx, job)
+	}
+
+	switch {
+	case job.Failed == 0:
+		job.Status = models.BulkJobStatusCompleted
+	case job.Succeeded == 0:
+		job.Status = models.BulkJobStatusFailed
+		job.Error = "no issues could be updated"
+	default:
+		job.Status = models.BulkJobStatusCompletedWithErrors
+	}
+	job.FinishedAt = time.Now()
+	s.saveBulkJob(ctx, job)
+
+	projectID := job.ProjectID
+	if projectID == "" && len(projects) == 1 {
+		for id := range projects {
+			projectID = id
+		}
+	}
+	payload := map[string]interface{}{
+		"job_id":    job.ID,
+		"status":    string(job.Status),
+		"issue_ids": succeeded,
+		"changes":   bulkChangesPayload(job.Changes),
+		"succeeded": job.Succeeded,
+		"failed":    job.Failed,
+	}
+	if job.Changes.Delete {
+		// Includes the sub-tasks that went to the trash with the selected issues
+		payload["deleted_issue_ids"] = deleted
+	}
+	s.publishEvent("issue.bulk_updated", projectID, job.CreatedBy, payload)
+}
+
+// bulkBatchResult is the outcome of one bulk job batch
+type bulkBatchResult struct {
+	done []*models.Issue
+	// deleted includes the sub-tasks of the deleted issues
+	deleted []string
+	skipped []models.BulkJobError
+}
+
+// runBulkBatch applies the job changes to one batch of issues in a single
+// transaction. Issues that cannot be changed, or that someone else updated
+// after they were loaded, are skipped and returned with the reason; an error
+// means the whole batch was rolled back.
+func (s *IssueService) runBulkBatch(ctx context.Context, job *models.BulkJob, ids []string) (*bulkBatchResult, error) {
+	issues, err := s.repo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load issues: %w", err)
+	}
+	byID := make(map[string]*models.Issue, len(issues))
+	for _, issue := range issues {
+		byID[issue.ID] = issue
+	}
+
+	batch := repository.BulkBatch{JobID: job.ID, DeletedBy: job.CreatedBy}
+	result := &bulkBatchResult{}
+	var updated []*models.Issue
+	for _, id := range ids {
+		issue, ok := byID[id]
+		if !ok {
+			result.skipped = append(result.skipped, models.BulkJobError{IssueID: id, Message: "issue not found"})
+			continue
+		}
+		if job.ProjectID != "" && issue.ProjectID != job.ProjectID {
+			result.skipped = append(result.skipped, models.BulkJobError{IssueID: id, Message: "issue belongs to another project"})
+			continue
+		}
+		if target := job.Changes.StatusID; target != nil && issue.StatusID != *target {
+			if err := s.checkTransition(ctx, issue, *target, job.CreatedBy); err != nil {
+				result.skipped = append(result.skipped, models.BulkJobError{IssueID: id, Message: err.Error()})
+				continue
+			}
+		}
+		if job.Changes.Delete {
+			result.done = append(result.done, issue)
+			batch.Deleted = append(batch.Deleted, issue.ID)
+			continue
+		}
+		updated = append(updated, issue)
+	}
+
+	if job.Changes.SprintID != nil && len(updated) > 0 {
+		var skipped []models.BulkJobError
+		if updated, batch.History, skipped, err = s.moveBulkSprint(ctx, job, updated); err != nil {
+			return nil, err
+		}
+		result.skipped = append(result.skipped, skipped...)
+	}
+
+	for _, issue := range updated {
+		history := applyBulkChanges(job, issue)
+		if len(history) > 0 {
+			update := repository.BulkUpdate{Issue: issue, History: history}
+			for _, h := range history {
+				update.Columns = append(update.Columns, h.Field)
+			}
+			batch.Updated = append(batch.Updated, update)
+		}
+	}
+
+	deleted, conflicted, err := s.repo.ApplyBulkBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	result.deleted = deleted
+	stale := make(map[string]bool, len(conflicted))
+	for _, id := range conflicted {
+		stale[id] = true
+	}
+	for _, issue := range updated {
+		if stale[issue.ID] {
+			result.skipped = append(result.skipped, models.BulkJobError{IssueID: issue.ID, Message: "issue was updated by someone else while the job ran"})
+			continue
+		}
+		result.done = append(result.done, issue)
+	}
+	return result, nil
+}
+
+// moveBulkSprint moves issues into the job's sprint, or to the backlog, the
+// way the sprint service does: the sprint service takes them in, then
+// SetIssuesSprint saves and announces the move on the issues. It returns the
+// issues, reloaded if they moved, with the history of the moves, and skips
+// those the sprint service refused.
+func (s *IssueService) moveBulkSprint(ctx context.Context, job *models.BulkJob, issues []*models.Issue) ([]*models.Issue, []models.IssueHistory, []models.BulkJobError, error) {
+	target := *job.Changes.SprintID
+	var sprintProjectID string
+	if target != "" {
+		resp, err := s.sprintClient.GetSprint(ctx, &sprintpb.GetSprintRequest{Id: target})
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to get sprint: %w", err)
+		}
+		sprintProjectID = resp.Sprint.ProjectId
+	}
+
+	var kept, moved []*models.Issue
+	var skipped []models.BulkJobError
+	for _, issue := range issues {
+		if issue.SprintID == target {
+			kept = append(kept, issue)
+			continue
+		}
+		if target != "" && issue.ProjectID != sprintProjectID {
+			skipped = append(skipped, models.BulkJobError{IssueID: issue.ID, Message: "sprint belongs to another project"})
+			continue
+		}
+		var err error
+		if target != "" {
+			_, err = s.sprintClient.AddIssueToSprint(ctx, &sprintpb.AddIssueToSprintRequest{SprintId: target, IssueId: issue.ID})
+		} else {
+			_, err = s.sprintClient.RemoveIssueFromSprint(ctx, &sprintpb.RemoveIssueFromSprintRequest{SprintId: issue.SprintID, IssueId: issue.ID})
+		}
+		if err != nil {
+			skipped = append(skipped, models.BulkJobError{IssueID: issue.ID, Message: fmt.Sprintf("failed to move issue: %v", err)})
+			continue
+		}
+		moved = append(moved, issue)
+	}
+	if len(moved) == 0 {
+		return kept, nil, skipped, nil
+	}
+
+	ids := make([]string, len(moved))
+	history := make([]models.IssueHistory, len(moved))
+	for i, issue := range moved {
+		ids[i] = issue.ID
+		history[i] = bulkHistory(job, issue, "sprint_id", issue.SprintID, target)
+	}
+	if _, err := s.SetIssuesSprint(ctx, ids, target); err != nil {
+		// The sprint service has taken the issues and retries until they follow
+		s.log.Sugar().Warnw("Failed to set issues sprint", "error", err, "job_id", job.ID)
+	}
+	// Moving bumped their version
+	reloaded, err := s.repo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to reload issues: %w", err)
+	}
+	return append(kept, reloaded...), history, skipped, nil
+}
+
+// checkTransition checks that the issue's workflow allows moving it straight to
+// the status, as a single transition would
+func (s *IssueService) checkTransition(ctx context.Context, issue *models.Issue, statusID, userID string) error {
+	resp, err := s.workflowClient.GetAvailableTransitions(ctx, &workflowpb.GetAvailableTransitionsRequest{
+		IssueId: issue.ID,
+		UserId:  userID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get available transitions: %w", err)
+	}
+	for _, t := range resp.Transitions {
+		if t.ToStatusId == statusID {
+			return nil
+		}
+	}
+	return fmt.Errorf("workflow has no transition from status %s to %s", issue.StatusID, statusID)
+}
+
+// applyBulkChanges changes the issue in memory and returns the history of
+// the fields that actually changed, one entry per column
+func applyBulkChanges(job *models.BulkJob, issue *models.Issue) []models.IssueHistory {
+	var history []models.IssueHistory
+	set := func(field string, current *string, value *string) {
+		if value == nil || *current == *value {
+			return
+		}
+		history = append(history, bulkHistory(job, issue, field, *current, *value))
+		*current = *value
+	}
+
+	set("assignee_id", &issue.AssigneeID, job.Changes.AssigneeID)
+	set("status_id", &issue.StatusID, job.Changes.StatusID)
+	if p := job.Changes.Priority; p != nil && issue.Priority != *p {
+		history = append(history, bulkHistory(job, issue, "priority", string(issue.Priority), string(*p)))
+		issue.Priority = *p
+	}
+	return history
+}
+
+func bulkHistory(job *models.BulkJob, issue *models.Issue, field, oldValue, newValue string) models.IssueHistory {
+	return models.IssueHistory{
+		IssueID:   issue.ID,
+		Field:     field,
+		OldValue:  oldValue,
+		NewValue:  newValue,
+		ChangedBy: job.CreatedBy,
+		JobID:     job.ID,
+	}
+}
+
+// saveBulkJob persists job progress; failures are logged since the job keeps running
+func (s *IssueService) saveBulkJob(ctx context.Context, job *models.BulkJob) {
+	if err := s.repo.UpdateBulkJobProgress(ctx, job); err != nil {
+		s.log.Sugar().Errorw("Failed to save bulk job progress", "error", err, "job_id", job.ID)
+	}
+}
+
+func validateBulkChanges(changes models.BulkChanges) error {
+	hasUpdate := changes.AssigneeID != nil || changes.Priority != nil || changes.StatusID != nil || changes.SprintID != nil
+	if changes.Delete && hasUpdate {
+		return fmt.Errorf("%w: delete cannot be combined with other changes", ErrValidation)
+	}
+	if !changes.Delete && !hasUpdate {
+		return fmt.Errorf("%w: no changes requested", ErrValidation)
+	}
+	if changes.Priority != nil && changes.Priority.Rank() == 0 {
+		return fmt.Errorf("%w: invalid priority %q", ErrValidation, *changes.Priority)
+	}
+	if changes.StatusID != nil && *changes.StatusID == "" {
+		return fmt.Errorf("%w: status_id cannot be cleared", ErrValidation)
+	}
+	return nil
+}
+
+func bulkChangesPayload(changes models.BulkChanges) map[string]interface{} {
+	payload := make(map[string]interface{})
+	if changes.AssigneeID != nil {
+		payload["assignee_id"] = *changes.AssigneeID
+	}
+	if changes.Priority != nil {
+		payload["priority"] = string(*changes.Priority)
+	}
+	if changes.StatusID != nil {
+		payload["status_id"] = *changes.StatusID
+	}
+	if changes.SprintID != nil {
+		payload["sprint_id"] = *changes.SprintID
+	}
+	if changes.Delete {
+		payload["delete"] = true
+	}
+	return payload
+}
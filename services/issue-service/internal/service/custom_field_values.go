@@ -0,0 +1,403 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+)
+
+// CustomFieldDateLayout is the layout date values are stored in
+const CustomFieldDateLayout = "2006-01-02"
+
+// Supported keys in CustomField.Config
+const (
+	FieldConfigMinLength     = "min_length"     // text, textarea
+	FieldConfigMaxLength     = "max_length"     // text, textarea
+	FieldConfigPattern       = "pattern"        // text, textarea
+	FieldConfigMin           = "min"            // number, date
+	FieldConfigMax           = "max"            // number, date
+	FieldConfigInteger       = "integer"        // number
+	FieldConfigMaxSelections = "max_selections" // multi_select
+	FieldConfigSchemes       = "schemes"        // url, comma separated
+)
+
+// customFieldValues validates the input values of the fields that apply to an
+// issue and returns the values to save and the fields to clear. When creating,
+// fields left out fall back to their default and required fields must end up
+// with a value.
+func customFieldValues(fields []*models.CustomField, input map[string]interface{}, creating bool) ([]models.IssueCustomValue, []string, error) {
+	byID := make(map[string]*models.CustomField, len(fields))
+	for _, f := range fields {
+		byID[f.ID] = f
+	}
+
+	var values []models.IssueCustomValue
+	var cleared []string
+	for fieldID, raw := range input {
+		field, ok := byID[fieldID]
+		if !ok {
+			return nil, nil, fmt.Errorf("%w: unknown custom field %s", ErrValidation, fieldID)
+		}
+		value, err := normalizeCustomFieldValue(field, raw)
+		if err != nil {
+			return nil, nil, err
+		}
+		if value == nil {
+			if field.Required {
+				return nil, nil, fmt.Errorf("%w: custom field %q is required", ErrValidation, field.Name)
+			}
+			cleared = append(cleared, fieldID)
+			continue
+		}
+		values = append(values, models.IssueCustomValue{FieldID: fieldID, Value: value})
+	}
+
+	if creating {
+		// Fields left out fall back to their default value. A default that no
+		// longer validates, e.g. a removed option, is ignored.
+		for _, f := range fields {
+			if _, ok := input[f.ID]; ok {
+				continue
+			}
+			if value, err := normalizeCustomFieldValue(f, f.DefaultValue); err == nil && value != nil {
+				values = append(values, models.IssueCustomValue{FieldID: f.ID, Value: value})
+				continue
+			}
+			if f.Required {
+				return nil, nil, fmt.Errorf("%w: custom field %q is required", ErrValidation, f.Name)
+			}
+		}
+	}
+
+	return values, cleared, nil
+}
+
+// normalizeCustomFieldValue validates a value against its field definition and
+// returns the representation stored in issue_custom_values. Accepted inputs are
+// the plain Go values produced by the handler: string, float64, bool, time.Time
+// and []string. A nil result means the value is empty.
+func normalizeCustomFieldValue(field *models.CustomField, value interface{}) (interface{}, error) {
+	if isEmptyCustomFieldValue(value) {
+		return nil, nil
+	}
+
+	switch field.Type {
+	case models.CustomFieldTypeText, models.CustomFieldTypeTextarea:
+		s, err := customFieldString(field, value)
+		if err != nil {
+			return nil, err
+		}
+		return s, validateTextConfig(field, s)
+
+	case models.CustomFieldTypeNumber:
+		n, err := customFieldNumber(field, value)
+		if err != nil {
+			return nil, err
+		}
+		return n, validateNumberConfig(field, n)
+
+	case models.CustomFieldTypeDate:
+		d, err := customFieldDate(field, value)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateDateConfig(field, d); err != nil {
+			return nil, err
+		}
+		return d.Format(CustomFieldDateLayout), nil
+
+	case models.CustomFieldTypeSelect:
+		s, err := customFieldString(field, value)
+		if err != nil {
+			return nil, err
+		}
+		if !containsOption(field.Options, s) {
+			return nil, fieldError(field, "%q is not a valid option", s)
+		}
+		return s, nil
+
+	case models.CustomFieldTypeMultiSelect:
+		items, err := customFieldStrings(field, value)
+		if err != nil {
+			return nil, err
+		}
+		seen := make(map[string]bool, len(items))
+		result := make([]string, 0, len(items))
+		for _, item := range items {
+			if !containsOption(field.Options, item) {
+				return nil, fieldError(field, "%q is not a valid option", item)
+			}
+			if !seen[item] {
+				seen[item] = true
+				result = append(result, item)
+			}
+		}
+		if max, ok, err := configInt(field, FieldConfigMaxSelections); err != nil {
+			return nil, err
+		} else if ok && len(result) > max {
+			return nil, fieldError(field, "at most %d options can be selected", max)
+		}
+		return result, nil
+
+	case models.CustomFieldTypeUser:
+		s, err := customFieldString(field, value)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := uuid.Parse(s); err != nil {
+			return nil, fieldError(field, "%q is not a valid user id", s)
+		}
+		return s, nil
+
+	case models.CustomFieldTypeCheckbox:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, fieldError(field, "%q is not a boolean", v)
+			}
+			return b, nil
+		default:
+			return nil, fieldError(field, "expected a boolean, got %T", value)
+		}
+
+	case models.CustomFieldTypeURL:
+		s, err := customFieldString(field, value)
+		if err != nil {
+			return nil, err
+		}
+		u, err := url.Parse(s)
+		if err != nil || u.Host == "" {
+			return nil, fieldError(field, "%q is not a valid URL", s)
+		}
+		schemes := []string{"http", "https"}
+		if cfg := field.Config[FieldConfigSchemes]; cfg != "" {
+			schemes = strings.Split(cfg, ",")
+		}
+		if !containsOption(schemes, strings.ToLower(u.Scheme)) {
+			return nil, fieldError(field, "URL scheme %q is not allowed", u.Scheme)
+		}
+		return s, nil
+
+	case models.CustomFieldTypeEmail:
+		s, err := customFieldString(field, value)
+		if err != nil {
+			return nil, err
+		}
+		addr, err := mail.ParseAddress(s)
+		if err != nil || addr.Address != s {
+			return nil, fieldError(field, "%q is not a valid email address", s)
+		}
+		return s, nil
+
+	default:
+		return nil, fieldError(field, "unsupported field type %q", field.Type)
+	}
+}
+
+// isEmptyCustomFieldValue reports whether a value should be treated as unset
+func isEmptyCustomFieldValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(v) == ""
+	case []string:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+func customFieldString(field *models.CustomField, value interface{}) (string, error) {
+	s, ok := value.(string)
+	if !ok {
+		return "", fieldError(field, "expected a string, got %T", value)
+	}
+	return strings.TrimSpace(s), nil
+}
+
+func customFieldStrings(field *models.CustomField, value interface{}) ([]string, error) {
+	switch v := value.(type) {
+	case []string:
+		return v, nil
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fieldError(field, "expected a list of strings, got %T", item)
+			}
+			result = append(result, s)
+		}
+		return result, nil
+	case string:
+		return []string{v}, nil
+	default:
+		return nil, fieldError(field, "expected a list of strings, got %T", value)
+	}
+}
+
+func customFieldNumber(field *models.CustomField, value interface{}) (float64, error) {
+	var n float64
+	switch v := value.(type) {
+	case float64:
+		n = v
+	case float32:
+		n = float64(v)
+	case int:
+		n = float64(v)
+	case int32:
+		n = float64(v)
+	case int64:
+		n = float64(v)
+	case string:
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0, fieldError(field, "%q is not a number", v)
+		}
+		n = parsed
+	default:
+		return 0, fieldError(field, "expected a number, got %T", value)
+	}
+	if math.IsNaN(n) || math.IsInf(n, 0) {
+		return 0, fieldError(field, "value must be a finite number")
+	}
+	return n, nil
+}
+
+func customFieldDate(field *models.CustomField, value interface{}) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return truncateToDate(v), nil
+	case string:
+		v = strings.TrimSpace(v)
+		if d, err := time.Parse(CustomFieldDateLayout, v); err == nil {
+			return d, nil
+		}
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return truncateToDate(t), nil
+		}
+		return time.Time{}, fieldError(field, "%q is not a valid date", v)
+	default:
+		return time.Time{}, fieldError(field, "expected a date, got %T", value)
+	}
+}
+
+func truncateToDate(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func validateTextConfig(field *models.CustomField, s string) error {
+	length := utf8.RuneCountInString(s)
+	if min, ok, err := configInt(field, FieldConfigMinLength); err != nil {
+		return err
+	} else if ok && length < min {
+		return fieldError(field, "must be at least %d characters", min)
+	}
+	if max, ok, err := configInt(field, FieldConfigMaxLength); err != nil {
+		return err
+	} else if ok && length > max {
+		return fieldError(field, "must be at most %d characters", max)
+	}
+	if pattern := field.Config[FieldConfigPattern]; pattern != "" {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return fieldError(field, "invalid pattern in field config: %v", err)
+		}
+		if !re.MatchString(s) {
+			return fieldError(field, "does not match the required format")
+		}
+	}
+	return nil
+}
+
+func validateNumberConfig(field *models.CustomField, n float64) error {
+	if field.Config[FieldConfigInteger] == "true" && n != math.Trunc(n) {
+		return fieldError(field, "must be a whole number")
+	}
+	if min, ok, err := configFloat(field, FieldConfigMin); err != nil {
+		return err
+	} else if ok && n < min {
+		return fieldError(field, "must be at least %v", min)
+	}
+	if max, ok, err := configFloat(field, FieldConfigMax); err != nil {
+		return err
+	} else if ok && n > max {
+		return fieldError(field, "must be at most %v", max)
+	}
+	return nil
+}
+
+func validateDateConfig(field *models.CustomField, d time.Time) error {
+	if raw := field.Config[FieldConfigMin]; raw != "" {
+		min, err := time.Parse(CustomFieldDateLayout, raw)
+		if err != nil {
+			return fieldError(field, "invalid %s in field config", FieldConfigMin)
+		}
+		if d.Before(min) {
+			return fieldError(field, "must be on or after %s", raw)
+		}
+	}
+	if raw := field.Config[FieldConfigMax]; raw != "" {
+		max, err := time.Parse(CustomFieldDateLayout, raw)
+		if err != nil {
+			return fieldError(field, "invalid %s in field config", FieldConfigMax)
+		}
+		if d.After(max) {
+			return fieldError(field, "must be on or before %s", raw)
+		}
+	}
+	return nil
+}
+
+func configInt(field *models.CustomField, key string) (int, bool, error) {
+	raw := field.Config[key]
+	if raw == "" {
+		return 0, false, nil
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, false, fieldError(field, "invalid %s in field config", key)
+	}
+	return n, true, nil
+}
+
+func configFloat(field *models.CustomField, key string) (float64, bool, error) {
+	raw := field.Config[key]
+	if raw == "" {
+		return 0, false, nil
+	}
+	n, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, false, fieldError(field, "invalid %s in field config", key)
+	}
+	return n, true, nil
+}
+
+func containsOption(options []string, value string) bool {
+	for _, o := range options {
+		if strings.TrimSpace(o) == value {
+			return true
+		}
+	}
+	return false
+}
+
+func fieldError(field *models.CustomField, format string, args ...interface{}) error {
+	return fmt.Errorf("%w: custom field %q: %s", ErrValidation, field.Name, fmt.Sprintf(format, args...))
+}
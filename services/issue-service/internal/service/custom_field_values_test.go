@@ -0,0 +1,157 @@
+package service
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+)
+
+func TestNormalizeCustomFieldValue(t *testing.T) {
+	field := func(fieldType models.CustomFieldType, config map[string]string, options ...string) *models.CustomField {
+		return &models.CustomField{Name: "Field", Type: fieldType, Config: config, Options: options}
+	}
+	const userID = "3f1c2a9e-7b4d-4e8a-9c61-5d2b8f0a1e37"
+	tests := []struct {
+		name    string
+		field   *models.CustomField
+		value   interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{"Empty string", field(models.CustomFieldTypeText, nil), "  ", nil, false},
+		{"Empty list", field(models.CustomFieldTypeMultiSelect, nil, "a"), []string{}, nil, false},
+		{"Nil", field(models.CustomFieldTypeNumber, nil), nil, nil, false},
+
+		{"Text is trimmed", field(models.CustomFieldTypeText, nil), " Acme ", "Acme", false},
+		{"Text of the wrong type", field(models.CustomFieldTypeText, nil), 4.0, nil, true},
+		{"Text long enough", field(models.CustomFieldTypeText, map[string]string{FieldConfigMinLength: "3"}), "abc", "abc", false},
+		{"Text too short", field(models.CustomFieldTypeText, map[string]string{FieldConfigMinLength: "3"}), "ab", nil, true},
+		{"Text length counts characters", field(models.CustomFieldTypeTextarea, map[string]string{FieldConfigMaxLength: "4"}), "über", "über", false},
+		{"Text too long", field(models.CustomFieldTypeTextarea, map[string]string{FieldConfigMaxLength: "4"}), "abcde", nil, true},
+		{"Text matching the pattern", field(models.CustomFieldTypeText, map[string]string{FieldConfigPattern: `^[A-Z]+-\d+$`}), "CUS-12", "CUS-12", false},
+		{"Text not matching the pattern", field(models.CustomFieldTypeText, map[string]string{FieldConfigPattern: `^[A-Z]+-\d+$`}), "cus-12", nil, true},
+		{"Invalid pattern", field(models.CustomFieldTypeText, map[string]string{FieldConfigPattern: `(`}), "x", nil, true},
+		{"Invalid length config", field(models.CustomFieldTypeText, map[string]string{FieldConfigMaxLength: "many"}), "x", nil, true},
+
+		{"Number", field(models.CustomFieldTypeNumber, nil), 2.5, 2.5, false},
+		{"Number from an int", field(models.CustomFieldTypeNumber, nil), 3, 3.0, false},
+		{"Number from a string", field(models.CustomFieldTypeNumber, nil), " 7 ", 7.0, false},
+		{"Not a number", field(models.CustomFieldTypeNumber, nil), "seven", nil, true},
+		{"Whole number", field(models.CustomFieldTypeNumber, map[string]string{FieldConfigInteger: "true"}), 4.0, 4.0, false},
+		{"Fraction of a whole number field", field(models.CustomFieldTypeNumber, map[string]string{FieldConfigInteger: "true"}), 4.5, nil, true},
+		{"Number at the minimum", field(models.CustomFieldTypeNumber, map[string]string{FieldConfigMin: "1"}), 1.0, 1.0, false},
+		{"Number below the minimum", field(models.CustomFieldTypeNumber, map[string]string{FieldConfigMin: "1"}), 0.5, nil, true},
+		{"Number above the maximum", field(models.CustomFieldTypeNumber, map[string]string{FieldConfigMax: "10"}), 11.0, nil, true},
+		{"Invalid minimum config", field(models.CustomFieldTypeNumber, map[string]string{FieldConfigMin: "low"}), 1.0, nil, true},
+
+		{"Date", field(models.CustomFieldTypeDate, nil), "2026-10-19", "2026-10-19", false},
+		{"Date from a timestamp", field(models.CustomFieldTypeDate, nil), "2026-10-19T23:30:00-02:00", "2026-10-20", false},
+		{"Date from a time", field(models.CustomFieldTypeDate, nil), time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC), "2026-10-19", false},
+		{"Not a date", field(models.CustomFieldTypeDate, nil), "19/10/2026", nil, true},
+		{"Date before the minimum", field(models.CustomFieldTypeDate, map[string]string{FieldConfigMin: "2026-01-01"}), "2025-12-31", nil, true},
+		{"Date on the maximum", field(models.CustomFieldTypeDate, map[string]string{FieldConfigMax: "2026-12-31"}), "2026-12-31", "2026-12-31", false},
+		{"Date after the maximum", field(models.CustomFieldTypeDate, map[string]string{FieldConfigMax: "2026-12-31"}), "2027-01-01", nil, true},
+		{"Invalid date config", field(models.CustomFieldTypeDate, map[string]string{FieldConfigMin: "soon"}), "2026-10-19", nil, true},
+
+		{"Option", field(models.CustomFieldTypeSelect, nil, "Gold", "Silver"), "Gold", "Gold", false},
+		{"Unknown option", field(models.CustomFieldTypeSelect, nil, "Gold", "Silver"), "Bronze", nil, true},
+		{"Options are deduplicated", field(models.CustomFieldTypeMultiSelect, nil, "a", "b", "c"), []interface{}{"a", "b", "a"}, []string{"a", "b"}, false},
+		{"One option for a multi-select", field(models.CustomFieldTypeMultiSelect, nil, "a", "b"), "b", []string{"b"}, false},
+		{"Unknown option in a multi-select", field(models.CustomFieldTypeMultiSelect, nil, "a"), []string{"a", "z"}, nil, true},
+		{"Within max selections", field(models.CustomFieldTypeMultiSelect, map[string]string{FieldConfigMaxSelections: "2"}, "a", "b", "c"), []string{"a", "b", "a"}, []string{"a", "b"}, false},
+		{"Too many selections", field(models.CustomFieldTypeMultiSelect, map[string]string{FieldConfigMaxSelections: "2"}, "a", "b", "c"), []string{"a", "b", "c"}, nil, true},
+
+		{"User", field(models.CustomFieldTypeUser, nil), userID, userID, false},
+		{"Not a user id", field(models.CustomFieldTypeUser, nil), "jane", nil, true},
+
+		{"Checkbox", field(models.CustomFieldTypeCheckbox, nil), true, true, false},
+		{"Checkbox from a string", field(models.CustomFieldTypeCheckbox, nil), "false", false, false},
+		{"Not a boolean", field(models.CustomFieldTypeCheckbox, nil), "maybe", nil, true},
+
+		{"URL", field(models.CustomFieldTypeURL, nil), "https://example.com/a", "https://example.com/a", false},
+		{"URL without a host", field(models.CustomFieldTypeURL, nil), "https://", nil, true},
+		{"URL scheme not allowed", field(models.CustomFieldTypeURL, nil), "ftp://example.com", nil, true},
+		{"URL scheme from the config", field(models.CustomFieldTypeURL, map[string]string{FieldConfigSchemes: "ftp"}), "ftp://example.com", "ftp://example.com", false},
+
+		{"Email", field(models.CustomFieldTypeEmail, nil), "jane@example.com", "jane@example.com", false},
+		{"Email with a name", field(models.CustomFieldTypeEmail, nil), "Jane <jane@example.com>", nil, true},
+
+		{"Unsupported type", field("rating", nil), "5", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeCustomFieldValue(tt.field, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("normalizeCustomFieldValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if !errors.Is(err, ErrValidation) {
+					t.Errorf("normalizeCustomFieldValue() error = %v, want ErrValidation", err)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("normalizeCustomFieldValue() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCustomFieldValues(t *testing.T) {
+	fields := []*models.CustomField{
+		{ID: "customer", Name: "Customer", Type: models.CustomFieldTypeText, Required: true},
+		{ID: "tier", Name: "Tier", Type: models.CustomFieldTypeSelect, Options: []string{"Gold", "Silver"}, DefaultValue: "Silver"},
+		{ID: "region", Name: "Region", Type: models.CustomFieldTypeSelect, Options: []string{"EU"}, DefaultValue: "US"},
+		{ID: "seats", Name: "Seats", Type: models.CustomFieldTypeNumber},
+	}
+	tests := []struct {
+		name        string
+		input       map[string]interface{}
+		creating    bool
+		wantValues  map[string]interface{}
+		wantCleared []string
+		wantErr     bool
+	}{
+		{"Create with defaults", map[string]interface{}{"customer": "Acme"}, true,
+			map[string]interface{}{"customer": "Acme", "tier": "Silver"}, nil, false},
+		{"Create overriding a default", map[string]interface{}{"customer": "Acme", "tier": "Gold", "seats": 5.0}, true,
+			map[string]interface{}{"customer": "Acme", "tier": "Gold", "seats": 5.0}, nil, false},
+		{"Create without a required field", map[string]interface{}{"tier": "Gold"}, true, nil, nil, true},
+		{"Create with a required field empty", map[string]interface{}{"customer": " "}, true, nil, nil, true},
+		{"Update leaves out fields", map[string]interface{}{"seats": "12"}, false,
+			map[string]interface{}{"seats": 12.0}, nil, false},
+		{"Update clears a field", map[string]interface{}{"tier": ""}, false,
+			map[string]interface{}{}, []string{"tier"}, false},
+		{"Update clears a required field", map[string]interface{}{"customer": ""}, false, nil, nil, true},
+		{"Unknown field", map[string]interface{}{"customer": "Acme", "budget": 10.0}, false, nil, nil, true},
+		{"Invalid value", map[string]interface{}{"tier": "Bronze"}, false, nil, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, cleared, err := customFieldValues(fields, tt.input, tt.creating)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("customFieldValues() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			got := make(map[string]interface{}, len(values))
+			for _, v := range values {
+				got[v.FieldID] = v.Value
+			}
+			if !reflect.DeepEqual(got, tt.wantValues) {
+				t.Errorf("customFieldValues() values = %v, want %v", got, tt.wantValues)
+			}
+			sort.Strings(cleared)
+			if !reflect.DeepEqual(cleared, tt.wantCleared) {
+				t.Errorf("customFieldValues() cleared = %q, want %q", cleared, tt.wantCleared)
+			}
+		})
+	}
+}
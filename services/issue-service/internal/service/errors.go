@@ -0,0 +1,27 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/nexusflow/nexusflow/pkg/etag"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+)
+
+var (
+	// ErrValidation is returned when input fails validation
+	ErrValidation = errors.New("validation failed")
+	// ErrNotFound is returned when a referenced entity does not exist
+	ErrNotFound = errors.New("not found")
+	// ErrConflict is returned when an update is based on a stale version
+	ErrConflict = etag.ErrConflict
+	// ErrPermissionDenied is returned when a user may not change an entity
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+// VersionConflictError is returned when an issue changed since the
+// version the caller expected
+type VersionConflictError = etag.ConflictError[*models.Issue]
+
+func versionConflict(current *models.Issue) error {
+	return &VersionConflictError{Entity: "issue", ID: current.ID, Version: current.Version, Current: current}
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/repository"
+)
+
+const (
+	defaultIssuePageSize = 20
+	maxIssuePageSize     = 100
+	defaultIssueSort     = "created_at"
+)
+
+// ListIssuesInput represents input for listing issues
+type ListIssuesInput struct {
+	Filter    repository.IssueFilter
+	SortBy    string // created_at, updated_at, due_date, priority, story_points
+	SortOrder string // asc or desc, defaults to desc
+	// Cursor is an opaque token from a previous page; empty starts at the top
+	Cursor   string
+	PageSize int
+}
+
+// ListIssuesResult is a page of issues with cursors for the adjacent pages
+type ListIssuesResult struct {
+	Issues         []*models.Issue
+	Total          int
+	PageSize       int
+	HasNext        bool
+	HasPrevious    bool
+	NextCursor     string
+	PreviousCursor string
+}
+
+// issueCursorToken is the decoded form of a cursor. It records the sort it was
+// issued for so it can't be replayed against a different ordering.
+type issueCursorToken struct {
+	SortBy    string `json:"s"`
+	SortOrder string `json:"o"`
+	Value     string `json:"v"`
+	ID        string `json:"id"`
+	Before    bool   `json:"b,omitempty"`
+}
+
+// ListIssues lists issues using keyset pagination, so pages stay consistent
+// while issues are created or changed between requests
+func (s *IssueService) ListIssues(ctx context.Context, input ListIssuesInput) (*ListIssuesResult, error) {
+	if input.Filter.ProjectID == "" {
+		return nil, fmt.Errorf("%w: project_id is required", ErrValidation)
+	}
+	if err := validateIssueFilter(input.Filter); err != nil {
+		return nil, err
+	}
+
+	sortBy := input.SortBy
+	if sortBy == "" {
+		sortBy = defaultIssueSort
+	}
+	if !repository.IsIssueSortField(sortBy) {
+		return nil, fmt.Errorf("%w: unsupported sort field %q", ErrValidation, sortBy)
+	}
+	sortOrder := strings.ToLower(input.SortOrder)
+	switch sortOrder {
+	case "":
+		sortOrder = "desc"
+	case "asc", "desc":
+	default:
+		return nil, fmt.Errorf("%w: sort order must be asc or desc", ErrValidation)
+	}
+	sort := repository.IssueSort{Field: sortBy, Desc: sortOrder == "desc"}
+
+	pageSize := input.PageSize
+	if pageSize < 1 {
+		pageSize = defaultIssuePageSize
+	}
+	if pageSize > maxIssuePageSize {
+		pageSize = maxIssuePageSize
+	}
+
+	var cursor *repository.IssueCursor
+	if input.Cursor != "" {
+		var err error
+		if cursor, err = parseIssueCursor(input.Cursor, sortBy, sortOrder); err != nil {
+			return nil, err
+		}
+	}
+
+	page, err := s.repo.List(ctx, input.Filter, sort, cursor, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ListIssuesResult{
+		Issues:      page.Issues,
+		Total:       page.Total,
+		PageSize:    pageSize,
+		HasNext:     page.HasNext,
+		HasPrevious: page.HasPrevious,
+	}
+	if n := len(page.Issues); n > 0 {
+		if page.HasNext {
+			result.NextCursor = encodeIssueCursor(sortBy, sortOrder, repository.IssueCursorFor(page.Issues[n-1], sort, false))
+		}
+		if page.HasPrevious {
+			result.PreviousCursor = encodeIssueCursor(sortBy, sortOrder, repository.IssueCursorFor(page.Issues[0], sort, true))
+		}
+	}
+	return result, nil
+}
+
+// validateIssueFilter checks the filter values that are not free text
+func validateIssueFilter(filter repository.IssueFilter) error {
+	for _, f := range filter.CustomFields {
+		if _, err := uuid.Parse(f.FieldID); err != nil {
+			return fmt.Errorf("%w: invalid custom field id %q", ErrValidation, f.FieldID)
+		}
+	}
+	return nil
+}
+
+func encodeIssueCursor(sortBy, sortOrder string, cursor repository.IssueCursor) string {
+	data, _ := json.Marshal(issueCursorToken{
+		SortBy:    sortBy,
+		SortOrder: sortOrder,
+		Value:     cursor.Value,
+		ID:        cursor.ID,
+		Before:    cursor.Before,
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// parseIssueCursor decodes a cursor and checks it was issued for the sort and
+// holds a value of the sort field's type
+func parseIssueCursor(cursor, sortBy, sortOrder string) (*repository.IssueCursor, error) {
+	token, err := decodeIssueCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	if token.SortBy != sortBy || token.SortOrder != sortOrder {
+		return nil, fmt.Errorf("%w: cursor was issued for a different sort order", ErrValidation)
+	}
+	if !repository.IsIssueSortValue(sortBy, token.Value) {
+		return nil, fmt.Errorf("%w: invalid cursor", ErrValidation)
+	}
+	return &repository.IssueCursor{Value: token.Value, ID: token.ID, Before: token.Before}, nil
+}
+
+func decodeIssueCursor(cursor string) (*issueCursorToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid cursor", ErrValidation)
+	}
+	var token issueCursorToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, fmt.Errorf("%w: invalid cursor", ErrValidation)
+	}
+	if _, err := uuid.Parse(token.ID); err != nil {
+		return nil, fmt.Errorf("%w: invalid cursor", ErrValidation)
+	}
+	return &token, nil
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/repository"
+)
+
+const testCursorIssueID = "6b0e4f2a-1c3d-4e5f-8a7b-9c0d1e2f3a4b"
+
+func TestParseIssueCursor_RoundTrip(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+	issue := &models.Issue{
+		ID:          testCursorIssueID,
+		CreatedAt:   time.Date(2026, 10, 19, 9, 30, 15, 123456789, berlin),
+		UpdatedAt:   time.Date(2026, 10, 20, 17, 0, 0, 0, time.UTC),
+		Priority:    models.IssuePriorityHigh,
+		Rank:        "0|hzzzzz:",
+		StoryPoints: 8,
+	}
+	noDueDate := &models.Issue{ID: testCursorIssueID}
+	tests := []struct {
+		name      string
+		issue     *models.Issue
+		sortBy    string
+		before    bool
+		wantValue string
+	}{
+		{"Created", issue, "created_at", false, "2026-10-19T07:30:15.123456789Z"},
+		{"Updated", issue, "updated_at", true, "2026-10-20T17:00:00Z"},
+		{"No due date", noDueDate, "due_date", false, "infinity"},
+		{"Priority", issue, "priority", false, "4"},
+		{"Rank", issue, "rank", true, "0|hzzzzz:"},
+		{"Story points", issue, "story_points", false, "8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sort := repository.IssueSort{Field: tt.sortBy, Desc: true}
+			want := repository.IssueCursorFor(tt.issue, sort, tt.before)
+			if want.Value != tt.wantValue {
+				t.Errorf("IssueCursorFor() value = %q, want %q", want.Value, tt.wantValue)
+			}
+
+			got, err := parseIssueCursor(encodeIssueCursor(tt.sortBy, "desc", want), tt.sortBy, "desc")
+			if err != nil {
+				t.Fatalf("parseIssueCursor() error = %v", err)
+			}
+			if !reflect.DeepEqual(*got, want) {
+				t.Errorf("parseIssueCursor() = %+v, want %+v", *got, want)
+			}
+		})
+	}
+}
+
+func TestParseIssueCursor_Invalid(t *testing.T) {
+	token := func(sortBy, sortOrder, value, id string) string {
+		data, _ := json.Marshal(issueCursorToken{SortBy: sortBy, SortOrder: sortOrder, Value: value, ID: id})
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	tests := []struct {
+		name   string
+		cursor string
+		sortBy string
+	}{
+		{"Not base64", "not a cursor!", "created_at"},
+		{"Not JSON", base64.RawURLEncoding.EncodeToString([]byte("{")), "created_at"},
+		{"Invalid ID", token("created_at", "desc", "2026-10-19T07:30:00Z", "42"), "created_at"},
+		{"Other sort field", token("updated_at", "desc", "2026-10-19T07:30:00Z", testCursorIssueID), "created_at"},
+		{"Other sort order", token("created_at", "asc", "2026-10-19T07:30:00Z", testCursorIssueID), "created_at"},
+		{"Time that is not a time", token("created_at", "desc", "yesterday", testCursorIssueID), "created_at"},
+		{"Time without a zone", token("due_date", "desc", "2026-10-19 07:30:00", testCursorIssueID), "due_date"},
+		{"Priority that is not a number", token("priority", "desc", "high", testCursorIssueID), "priority"},
+		{"Fractional story points", token("story_points", "desc", "1.5", testCursorIssueID), "story_points"},
+		{"Story points out of range", token("story_points", "desc", "99999999999", testCursorIssueID), "story_points"},
+		{"Rank with a NUL byte", token("rank", "desc", "0|h\x00", testCursorIssueID), "rank"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseIssueCursor(tt.cursor, tt.sortBy, "desc")
+			if !errors.Is(err, ErrValidation) {
+				t.Errorf("parseIssueCursor() error = %v, want ErrValidation", err)
+			}
+		})
+	}
+}
@@ -2,12 +2,16 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/nexusflow/nexusflow/pkg/kafka"
 	"github.com/nexusflow/nexusflow/pkg/logger"
 	pb "github.com/nexusflow/nexusflow/pkg/proto/project/v1"
+	sprintpb "github.com/nexusflow/nexusflow/pkg/proto/sprint/v1"
+	workflowpb "github.com/nexusflow/nexusflow/pkg/proto/workflow/v1"
 	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
 	"github.com/nexusflow/nexusflow/services/issue-service/internal/repository"
 	"google.golang.org/grpc"
@@ -16,10 +20,14 @@ import (
 
 // IssueService handles issue business logic
 type IssueService struct {
-	repo          *repository.IssueRepository
-	producer      *kafka.Producer
-	log           *logger.Logger
-	projectClient pb.ProjectServiceClient
+	repo           *repository.IssueRepository
+	producer       *kafka.Producer
+	log            *logger.Logger
+	projectClient  pb.ProjectServiceClient
+	workflowClient workflowpb.WorkflowServiceClient
+	sprintClient   sprintpb.SprintServiceClient
+	// instanceID identifies this process as the owner of the bulk jobs it runs
+	instanceID string
 }
 
 // NewIssueService creates a new issue service
@@ -28,6 +36,8 @@ func NewIssueService(
 	producer *kafka.Producer,
 	log *logger.Logger,
 	projectServiceAddr string,
+	workflowServiceAddr string,
+	sprintServiceAddr string,
 ) (*IssueService, error) {
 	// Connect to project service
 	conn, err := grpc.Dial(projectServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -36,25 +46,48 @@ func NewIssueService(
 	}
 	projectClient := pb.NewProjectServiceClient(conn)
 
+	workflowConn, err := grpc.Dial(workflowServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to workflow service: %w", err)
+	}
+
+	sprintConn, err := grpc.Dial(sprintServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to sprint service: %w", err)
+	}
+
 	return &IssueService{
-		repo:          repo,
-		producer:      producer,
-		log:           log,
-		projectClient: projectClient,
+		repo:           repo,
+		producer:       producer,
+		log:            log,
+		projectClient:  projectClient,
+		workflowClient: workflowpb.NewWorkflowServiceClient(workflowConn),
+		sprintClient:   sprintpb.NewSprintServiceClient(sprintConn),
+		instanceID:     uuid.NewString(),
 	}, nil
 }
 
 // CreateIssueInput represents input for creating an issue
 type CreateIssueInput struct {
-	ProjectID   string
-	Summary     string
-	Description string
-	Type        models.IssueType
-	Priority    models.IssuePriority
-	AssigneeID  string
-	ReporterID  string
-	ParentID    string
+	ProjectID    string
+	Summary      string
+	Description  string
+	Type         models.IssueType
+	Priority     models.IssuePriority
+	AssigneeID   string
+	ReporterID   string
+	ParentID     string
+	LabelIDs     []string
+	ComponentIDs []string
 	CustomFields map[string]interface{}
+	// OriginalEstimate is in seconds; the remaining estimate starts out equal to it
+	OriginalEstimate int64
+	// ScheduleID and ScheduledFor link an issue to the schedule occurrence that created it
+	ScheduleID   string
+	ScheduledFor time.Time
+	// TemplateID picks the template to start from; without it the project
+	// default template for the issue type is used, if any
+	TemplateID string
 }
 
 // CreateIssue creates a new issue
@@ -66,7 +99,13 @@ func (s *IssueService) CreateIssue(ctx context.Context, input CreateIssueInput)
 	}
 	projectKey := projectResp.Project.Key
 
-	// 2. Create Issue
+	// 2. Fill blank fields from the issue template
+	template, err := s.resolveIssueTemplate(ctx, &input)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Create Issue
 	issue := &models.Issue{
 		ProjectID:   input.ProjectID,
 		Summary:     input.Summary,
@@ -76,6 +115,9 @@ func (s *IssueService) CreateIssue(ctx context.Context, input CreateIssueInput)
 		AssigneeID:  input.AssigneeID,
 		ReporterID:  input.ReporterID,
 		ParentID:    input.ParentID,
+
+		ScheduleID:   input.ScheduleID,
+		ScheduledFor: input.ScheduledFor,
 	}
 	if issue.Type == "" {
 		issue.Type = models.IssueTypeTask
@@ -83,48 +125,86 @@ func (s *IssueService) CreateIssue(ctx context.Context, input CreateIssueInput)
 	if issue.Priority == "" {
 		issue.Priority = models.IssuePriorityMedium
 	}
+	if err := validateEstimate("original_estimate", input.OriginalEstimate); err != nil {
+		return nil, err
+	}
+	issue.OriginalEstimate = input.OriginalEstimate
+	issue.RemainingEstimate = input.OriginalEstimate
 
-	if err := s.repo.Create(ctx, issue, projectKey); err != nil {
+	// 4. Validate labels and components; unassigned issues go to the
+	// default assignee of their first component that has one
+	if issue.LabelIDs, err = s.validateLabelIDs(ctx, issue.ProjectID, input.LabelIDs); err != nil {
+		return nil, err
+	}
+	components, err := s.validateComponentIDs(ctx, issue.ProjectID, input.ComponentIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range components {
+		issue.ComponentIDs = append(issue.ComponentIDs, c.ID)
+		if issue.AssigneeID == "" && c.DefaultAssigneeID != "" {
+			issue.AssigneeID = c.DefaultAssigneeID
+		}
+	}
+
+	// 5. Validate custom field values, applying field defaults
+	values, _, err := s.resolveCustomFieldValues(ctx, issue, projectResp.Project.OrganizationId, input.CustomFields, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, issue, projectKey, values); err != nil {
 		return nil, fmt.Errorf("failed to create issue: %w", err)
 	}
 
-	// 3. Save Custom Fields
-	if len(input.CustomFields) > 0 {
-		var values []models.IssueCustomValue
-		for fieldID, value := range input.CustomFields {
-			values = append(values, models.IssueCustomValue{
-				IssueID: issue.ID,
-				FieldID: fieldID,
-				Value:   value,
-			})
-		}
-		if err := s.repo.SaveIssueCustomValues(ctx, issue.ID, values); err != nil {
-			// Log error but don't fail creation? Or fail?
-			// For now, log and continue, or fail. Let's fail to ensure consistency.
-			// Ideally we should do this in a transaction.
-			// TODO: Transactional support
-			s.log.Sugar().Errorw("Failed to save custom fields", "error", err)
-		}
+	// 6. Publish Event
+	payload := map[string]interface{}{
+		"issue_id":      issue.ID,
+		"key":           issue.Key,
+		"summary":       issue.Summary,
+		"label_ids":     issue.LabelIDs,
+		"component_ids": issue.ComponentIDs,
+		"custom_fields": customValuesPayload(values, nil),
+		"schedule_id":   issue.ScheduleID,
 	}
+	if template != nil {
+		payload["template_id"] = template.ID
+	}
+	s.publishEvent("issue.created", input.ProjectID, input.ReporterID, payload)
 
-	// 4. Publish Event
-	s.publishEvent("issue.created", input.ProjectID, input.ReporterID, map[string]interface{}{
-		"issue_id": issue.ID,
-		"key":      issue.Key,
-		"summary":  issue.Summary,
-	})
+	// 7. Create the template sub-task checklist
+	if template != nil {
+		s.createTemplateSubTasks(ctx, issue, template)
+	}
 
 	return issue, nil
 }
 
 // UpdateIssueInput represents input for updating an issue
 type UpdateIssueInput struct {
-	ID          string
+	ID string
+	// UserID is the user making the change; it defaults to "system"
+	UserID string
+	// ExpectedVersion rejects the update if the issue has changed since
+	ExpectedVersion *int64
+
 	Summary     *string
 	Description *string
 	StatusID    *string
 	AssigneeID  *string
 	Priority    *models.IssuePriority
+	// CustomFields holds only the fields being changed; an empty value clears the field
+	CustomFields map[string]interface{}
+	// LabelIDs and ComponentIDs replace the current assignments when the
+	// matching Set* flag is true
+	LabelIDs        []string
+	ComponentIDs    []string
+	SetLabelIDs     bool
+	SetComponentIDs bool
+	// OriginalEstimate and RemainingEstimate are in seconds. Changing only
+	// the original estimate before any work is logged resets the remaining one.
+	OriginalEstimate  *int64
+	RemainingEstimate *int64
 }
 
 // UpdateIssue updates an issue
@@ -134,7 +214,18 @@ func (s *IssueService) UpdateIssue(ctx context.Context, input UpdateIssueInput)
 		return nil, fmt.Errorf("failed to get issue: %w", err)
 	}
 	if issue == nil {
-		return nil, fmt.Errorf("issue not found")
+		return nil, fmt.Errorf("%w: issue %s", ErrNotFound, input.ID)
+	}
+	if input.ExpectedVersion != nil && *input.ExpectedVersion != issue.Version {
+		return nil, versionConflict(issue)
+	}
+
+	// changes records the old and new value of the core fields that changed
+	changes := make(map[string]interface{})
+	trackChange := func(field, from, to string) {
+		if from != to {
+			changes[field] = map[string]interface{}{"from": from, "to": to}
+		}
 	}
 
 	if input.Summary != nil {
@@ -144,50 +235,132 @@ func (s *IssueService) UpdateIssue(ctx context.Context, input UpdateIssueInput)
 		issue.Description = *input.Description
 	}
 	if input.StatusID != nil {
+		trackChange("status_id", issue.StatusID, *input.StatusID)
 		issue.StatusID = *input.StatusID
 	}
 	if input.AssigneeID != nil {
+		trackChange("assignee_id", issue.AssigneeID, *input.AssigneeID)
 		issue.AssigneeID = *input.AssigneeID
 	}
 	if input.Priority != nil {
+		if input.Priority.Rank() == 0 {
+			return nil, fmt.Errorf("%w: invalid priority %q", ErrValidation, *input.Priority)
+		}
+		trackChange("priority", string(issue.Priority), string(*input.Priority))
 		issue.Priority = *input.Priority
 	}
+	if input.OriginalEstimate != nil {
+		if err := validateEstimate("original_estimate", *input.OriginalEstimate); err != nil {
+			return nil, err
+		}
+		issue.OriginalEstimate = *input.OriginalEstimate
+		if input.RemainingEstimate == nil && issue.TimeSpent == 0 {
+			issue.RemainingEstimate = issue.OriginalEstimate
+		}
+	}
+	if input.RemainingEstimate != nil {
+		if err := validateEstimate("remaining_estimate", *input.RemainingEstimate); err != nil {
+			return nil, err
+		}
+		issue.RemainingEstimate = *input.RemainingEstimate
+	}
 
-	if err := s.repo.Update(ctx, issue); err != nil {
+	if input.SetLabelIDs {
+		if issue.LabelIDs, err = s.validateLabelIDs(ctx, issue.ProjectID, input.LabelIDs); err != nil {
+			return nil, err
+		}
+	}
+	if input.SetComponentIDs {
+		components, err := s.validateComponentIDs(ctx, issue.ProjectID, input.ComponentIDs)
+		if err != nil {
+			return nil, err
+		}
+		issue.ComponentIDs = nil
+		for _, c := range components {
+			issue.ComponentIDs = append(issue.ComponentIDs, c.ID)
+		}
+	}
+
+	values, cleared, err := s.resolveCustomFieldValues(ctx, issue, "", input.CustomFields, false)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.Update(ctx, issue, repository.IssueChanges{
+		CustomValues:  values,
+		ClearedFields: cleared,
+		SetLabels:     input.SetLabelIDs,
+		SetComponents: input.SetComponentIDs,
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrVersionConflict) {
+			return nil, s.versionConflict(ctx, issue.ID)
+		}
 		return nil, fmt.Errorf("failed to update issue: %w", err)
 	}
 
 	// Publish event
-	s.publishEvent("issue.updated", issue.ProjectID, "system", map[string]interface{}{
+	payload := map[string]interface{}{
 		"issue_id": issue.ID,
 		"key":      issue.Key,
-	})
+		"version":  issue.Version,
+	}
+	if len(input.CustomFields) > 0 {
+		payload["custom_fields"] = customValuesPayload(values, cleared)
+	}
+	if input.SetLabelIDs {
+		payload["label_ids"] = issue.LabelIDs
+	}
+	if input.SetComponentIDs {
+		payload["component_ids"] = issue.ComponentIDs
+	}
+	if input.OriginalEstimate != nil || input.RemainingEstimate != nil {
+		payload["original_estimate"] = issue.OriginalEstimate
+		payload["remaining_estimate"] = issue.RemainingEstimate
+	}
+	if len(changes) > 0 {
+		payload["changes"] = changes
+	}
+	userID := input.UserID
+	if userID == "" {
+		userID = "system"
+	}
+	s.publishEvent("issue.updated", issue.ProjectID, userID, payload)
 
 	return issue, nil
 }
 
+// versionConflict reloads an issue that changed underneath an update
+func (s *IssueService) versionConflict(ctx context.Context, id string) error {
+	current, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to reload issue: %w", err)
+	}
+	if current == nil {
+		return fmt.Errorf("%w: issue %s", ErrNotFound, id)
+	}
+	return versionConflict(current)
+}
+
 // GetIssue gets an issue by ID
 func (s *IssueService) GetIssue(ctx context.Context, id string) (*models.Issue, error) {
 	return s.repo.GetByID(ctx, id)
 }
 
+// BatchGetIssues gets the issues with the given IDs, leaving out missing ones
+func (s *IssueService) BatchGetIssues(ctx context.Context, ids []string) ([]*models.Issue, error) {
+	ids = uniqueStrings(ids)
+	if len(ids) > maxBulkIssues {
+		return nil, fmt.Errorf("%w: at most %d issues can be fetched at once", ErrValidation, maxBulkIssues)
+	}
+	return s.repo.GetByIDs(ctx, ids)
+}
+
 // GetIssueByKey gets an issue by key
 func (s *IssueService) GetIssueByKey(ctx context.Context, key string) (*models.Issue, error) {
 	return s.repo.GetByKey(ctx, key)
 }
 
-// ListIssues lists issues
-func (s *IssueService) ListIssues(ctx context.Context, projectID string, page, pageSize int) ([]*models.Issue, int, error) {
-	if page < 1 {
-		page = 1
-	}
-	if pageSize < 1 {
-		pageSize = 10
-	}
-	offset := (page - 1) * pageSize
-	return s.repo.List(ctx, projectID, pageSize, offset)
-}
-
 // publishEvent publishes a Kafka event
 func (s *IssueService) publishEvent(eventType, projectID, userID string, payload map[string]interface{}) {
 	if s.producer == nil {
@@ -211,20 +384,260 @@ func (s *IssueService) publishEvent(eventType, projectID, userID string, payload
 
 // Custom Fields
 
-// CreateCustomField creates a new custom field
+// CreateCustomField creates a new custom field. Fields without a project are
+// shared across the organization and need a context before they apply anywhere.
 func (s *IssueService) CreateCustomField(ctx context.Context, field *models.CustomField) (*models.CustomField, error) {
+	if field.Name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrValidation)
+	}
+	if field.ProjectID == "" && field.OrganizationID == "" {
+		return nil, fmt.Errorf("%w: project_id or organization_id is required", ErrValidation)
+	}
+	if field.ProjectID != "" {
+		// Project fields are never shared, the organization is implied by the project
+		field.OrganizationID = ""
+	}
+	if (field.Type == models.CustomFieldTypeSelect || field.Type == models.CustomFieldTypeMultiSelect) && len(field.Options) == 0 && !field.IsShared() {
+		return nil, fmt.Errorf("%w: %s fields require options", ErrValidation, field.Type)
+	}
+	if field.DefaultValue != nil {
+		value, err := normalizeCustomFieldValue(field, field.DefaultValue)
+		if err != nil {
+			return nil, err
+		}
+		field.DefaultValue = value
+	}
+
 	if err := s.repo.CreateCustomField(ctx, field); err != nil {
 		return nil, err
 	}
 	return field, nil
 }
 
-// ListCustomFields lists custom fields for a project
-func (s *IssueService) ListCustomFields(ctx context.Context, projectID string) ([]*models.CustomField, error) {
-	return s.repo.ListCustomFields(ctx, projectID)
+// ListCustomFields lists the custom fields that apply to a project, resolved
+// for the given issue type. Shared fields carry the options and default of
+// their most specific context. Without a project, the organization's shared
+// field definitions are returned as-is.
+func (s *IssueService) ListCustomFields(ctx context.Context, projectID, orgID string, issueType models.IssueType) ([]*models.CustomField, error) {
+	if projectID == "" {
+		if orgID == "" {
+			return nil, fmt.Errorf("%w: project_id or organization_id is required", ErrValidation)
+		}
+		return s.repo.ListOrganizationCustomFields(ctx, orgID)
+	}
+	return s.applicableCustomFields(ctx, projectID, "", issueType)
+}
+
+// CreateCustomFieldContext applies a shared custom field to projects and issue types
+func (s *IssueService) CreateCustomFieldContext(ctx context.Context, fieldCtx *models.CustomFieldContext) (*models.CustomFieldContext, error) {
+	field, err := s.repo.GetCustomField(ctx, fieldCtx.FieldID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get custom field: %w", err)
+	}
+	if field == nil {
+		return nil, fmt.Errorf("%w: custom field %s", ErrNotFound, fieldCtx.FieldID)
+	}
+	if err := validateFieldContext(field, fieldCtx); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreateCustomFieldContext(ctx, fieldCtx); err != nil {
+		return nil, err
+	}
+	fieldCtx.Field = field
+	return fieldCtx, nil
+}
+
+// UpdateCustomFieldContextInput represents input for updating a field context
+type UpdateCustomFieldContextInput struct {
+	ID           string
+	Name         *string
+	ProjectIDs   []string
+	IssueTypes   []models.IssueType
+	Options      []string
+	DefaultValue interface{}
+	// Set* flags distinguish an empty list or nil default from "unchanged"
+	SetProjectIDs   bool
+	SetIssueTypes   bool
+	SetOptions      bool
+	SetDefaultValue bool
+}
+
+// UpdateCustomFieldContext updates a custom field context
+func (s *IssueService) UpdateCustomFieldContext(ctx context.Context, input UpdateCustomFieldContextInput) (*models.CustomFieldContext, error) {
+	fieldCtx, err := s.repo.GetCustomFieldContext(ctx, input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get custom field context: %w", err)
+	}
+	if fieldCtx == nil {
+		return nil, fmt.Errorf("%w: custom field context %s", ErrNotFound, input.ID)
+	}
+	field := fieldCtx.Field
+
+	if input.Name != nil {
+		fieldCtx.Name = *input.Name
+	}
+	if input.SetProjectIDs {
+		fieldCtx.ProjectIDs = input.ProjectIDs
+	}
+	if input.SetIssueTypes {
+		fieldCtx.IssueTypes = input.IssueTypes
+	}
+	if input.SetOptions {
+		fieldCtx.Options = input.Options
+	}
+	if input.SetDefaultValue {
+		fieldCtx.DefaultValue = input.DefaultValue
+	}
+	if err := validateFieldContext(field, fieldCtx); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateCustomFieldContext(ctx, fieldCtx); err != nil {
+		return nil, err
+	}
+	return fieldCtx, nil
+}
+
+// DeleteCustomFieldContext deletes a custom field context. Stored values are
+// kept so they stay searchable and reappear if the field is applied again.
+func (s *IssueService) DeleteCustomFieldContext(ctx context.Context, id string) error {
+	return s.repo.DeleteCustomFieldContext(ctx, id)
+}
+
+// ListCustomFieldContexts lists the contexts of a custom field
+func (s *IssueService) ListCustomFieldContexts(ctx context.Context, fieldID string) ([]*models.CustomFieldContext, error) {
+	return s.repo.ListCustomFieldContexts(ctx, []string{fieldID})
+}
+
+// validateFieldContext checks a context against its field and normalizes its default value
+func validateFieldContext(field *models.CustomField, fieldCtx *models.CustomFieldContext) error {
+	if !field.IsShared() {
+		return fmt.Errorf("%w: contexts can only be added to organization fields", ErrValidation)
+	}
+	if fieldCtx.Name == "" {
+		return fmt.Errorf("%w: context name is required", ErrValidation)
+	}
+	effective := fieldWithContext(field, fieldCtx)
+	if (effective.Type == models.CustomFieldTypeSelect || effective.Type == models.CustomFieldTypeMultiSelect) && len(effective.Options) == 0 {
+		return fmt.Errorf("%w: %s fields require options", ErrValidation, field.Type)
+	}
+	if fieldCtx.DefaultValue != nil {
+		value, err := normalizeCustomFieldValue(effective, fieldCtx.DefaultValue)
+		if err != nil {
+			return err
+		}
+		fieldCtx.DefaultValue = value
+	}
+	return nil
+}
+
+// fieldWithContext returns a copy of the field with the context's overrides applied
+func fieldWithContext(field *models.CustomField, fieldCtx *models.CustomFieldContext) *models.CustomField {
+	effective := *field
+	if len(fieldCtx.Options) > 0 {
+		effective.Options = fieldCtx.Options
+	}
+	if fieldCtx.DefaultValue != nil {
+		effective.DefaultValue = fieldCtx.DefaultValue
+	}
+	return &effective
+}
+
+// applicableCustomFields resolves the fields that apply to an issue of the
+// given type in a project
+func (s *IssueService) applicableCustomFields(ctx context.Context, projectID, orgID string, issueType models.IssueType) ([]*models.CustomField, error) {
+	if orgID == "" {
+		projectResp, err := s.projectClient.GetProject(ctx, &pb.GetProjectRequest{Id: projectID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project: %w", err)
+		}
+		orgID = projectResp.Project.OrganizationId
+	}
+
+	fields, err := s.repo.ListApplicableCustomFields(ctx, projectID, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	var sharedIDs []string
+	for _, f := range fields {
+		if f.IsShared() {
+			sharedIDs = append(sharedIDs, f.ID)
+		}
+	}
+	if len(sharedIDs) == 0 {
+		return fields, nil
+	}
+
+	contexts, err := s.repo.ListCustomFieldContexts(ctx, sharedIDs)
+	if err != nil {
+		return nil, err
+	}
+	best := make(map[string]*models.CustomFieldContext)
+	for _, c := range contexts {
+		if !c.AppliesTo(projectID, issueType) {
+			continue
+		}
+		if current, ok := best[c.FieldID]; !ok || c.Specificity() > current.Specificity() {
+			best[c.FieldID] = c
+		}
+	}
+
+	result := make([]*models.CustomField, 0, len(fields))
+	for _, f := range fields {
+		if !f.IsShared() {
+			result = append(result, f)
+			continue
+		}
+		if c, ok := best[f.ID]; ok {
+			result = append(result, fieldWithContext(f, c))
+		}
+	}
+	return result, nil
 }
 
 // GetIssueCustomValues gets custom values for an issue
 func (s *IssueService) GetIssueCustomValues(ctx context.Context, issueID string) ([]*models.IssueCustomValue, error) {
 	return s.repo.GetIssueCustomValues(ctx, issueID)
 }
+
+// ListIssueCustomValues gets custom values for several issues, keyed by issue ID
+func (s *IssueService) ListIssueCustomValues(ctx context.Context, issueIDs []string) (map[string][]*models.IssueCustomValue, error) {
+	values, err := s.repo.ListIssueCustomValues(ctx, issueIDs)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string][]*models.IssueCustomValue, len(issueIDs))
+	for _, v := range values {
+		result[v.IssueID] = append(result[v.IssueID], v)
+	}
+	return result, nil
+}
+
+// resolveCustomFieldValues validates input values against the fields that
+// apply to the issue. It returns the values to upsert and the field IDs to
+// clear. When creating, every required field must be present.
+func (s *IssueService) resolveCustomFieldValues(ctx context.Context, issue *models.Issue, orgID string, input map[string]interface{}, creating bool) ([]models.IssueCustomValue, []string, error) {
+	if len(input) == 0 && !creating {
+		return nil, nil, nil
+	}
+
+	fields, err := s.applicableCustomFields(ctx, issue.ProjectID, orgID, issue.Type)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve custom fields: %w", err)
+	}
+	return customFieldValues(fields, input, creating)
+}
+
+// customValuesPayload builds the event payload for changed custom field values
+func customValuesPayload(values []models.IssueCustomValue, cleared []string) map[string]interface{} {
+	payload := make(map[string]interface{}, len(values)+len(cleared))
+	for _, v := range values {
+		payload[v.FieldID] = v.Value
+	}
+	for _, fieldID := range cleared {
+		payload[fieldID] = nil
+	}
+	return payload
+}
@@ -0,0 +1,368 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+)
+
+// maxBulkIssues caps the number of issues touched by a single bulk update
+const maxBulkIssues = 500
+
+var labelColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// Labels
+
+// CreateLabel creates a new project label
+func (s *IssueService) CreateLabel(ctx context.Context, label *models.Label) (*models.Label, error) {
+	label.Name = strings.TrimSpace(label.Name)
+	if err := validateLabel(label); err != nil {
+		return nil, err
+	}
+	if label.ProjectID == "" {
+		return nil, fmt.Errorf("%w: project_id is required", ErrValidation)
+	}
+
+	if err := s.repo.CreateLabel(ctx, label); err != nil {
+		return nil, err
+	}
+
+	s.publishEvent("label.created", label.ProjectID, "system", labelPayload(label))
+	return label, nil
+}
+
+// UpdateLabelInput represents input for updating a label
+type UpdateLabelInput struct {
+	ID          string
+	Name        *string
+	Color       *string
+	Description *string
+}
+
+// UpdateLabel updates a label
+func (s *IssueService) UpdateLabel(ctx context.Context, input UpdateLabelInput) (*models.Label, error) {
+	label, err := s.repo.GetLabel(ctx, input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get label: %w", err)
+	}
+	if label == nil {
+		return nil, fmt.Errorf("%w: label %s", ErrNotFound, input.ID)
+	}
+
+	if input.Name != nil {
+		label.Name = strings.TrimSpace(*input.Name)
+	}
+	if input.Color != nil {
+		label.Color = *input.Color
+	}
+	if input.Description != nil {
+		label.Description = *input.Description
+	}
+	if err := validateLabel(label); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateLabel(ctx, label); err != nil {
+		return nil, err
+	}
+
+	s.publishEvent("label.updated", label.ProjectID, "system", labelPayload(label))
+	return label, nil
+}
+
+// DeleteLabel deletes a label and removes it from every issue. The event lists
+// the affected issues so they can be reindexed.
+func (s *IssueService) DeleteLabel(ctx context.Context, id string) error {
+	label, err := s.repo.GetLabel(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get label: %w", err)
+	}
+	if label == nil {
+		return fmt.Errorf("%w: label %s", ErrNotFound, id)
+	}
+	issueIDs, err := s.repo.ListIssueIDsByLabel(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteLabel(ctx, id); err != nil {
+		return err
+	}
+
+	payload := labelPayload(label)
+	payload["issue_ids"] = issueIDs
+	s.publishEvent("label.deleted", label.ProjectID, "system", payload)
+	return nil
+}
+
+// ListLabels lists the labels of a project
+func (s *IssueService) ListLabels(ctx context.Context, projectID string) ([]*models.Label, error) {
+	return s.repo.ListLabels(ctx, projectID)
+}
+
+// BulkUpdateIssueLabels adds and removes labels on several issues of one project
+func (s *IssueService) BulkUpdateIssueLabels(ctx context.Context, issueIDs, add, remove []string) ([]*models.Issue, error) {
+	issues, err := s.getBulkIssues(ctx, issueIDs)
+	if err != nil {
+		return nil, err
+	}
+	projectID := issues[0].ProjectID
+	if add, err = s.validateLabelIDs(ctx, projectID, add); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateIssueLabels(ctx, issueIDs, add, remove); err != nil {
+		return nil, fmt.Errorf("failed to update labels: %w", err)
+	}
+
+	return s.publishBulkUpdate(ctx, issueIDs)
+}
+
+func (s *IssueService) validateLabelIDs(ctx context.Context, projectID string, ids []string) ([]string, error) {
+	ids = uniqueStrings(ids)
+	labels, err := s.repo.GetLabelsByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get labels: %w", err)
+	}
+	found := make(map[string]bool, len(labels))
+	for _, l := range labels {
+		if l.ProjectID == projectID {
+			found[l.ID] = true
+		}
+	}
+	for _, id := range ids {
+		if !found[id] {
+			return nil, fmt.Errorf("%w: label %s does not belong to the project", ErrValidation, id)
+		}
+	}
+	return ids, nil
+}
+
+func validateLabel(label *models.Label) error {
+	if label.Name == "" {
+		return fmt.Errorf("%w: label name is required", ErrValidation)
+	}
+	if strings.ContainsAny(label.Name, " \t\n") {
+		return fmt.Errorf("%w: label name cannot contain whitespace", ErrValidation)
+	}
+	if len(label.Name) > 100 {
+		return fmt.Errorf("%w: label name must be at most 100 characters", ErrValidation)
+	}
+	if label.Color != "" && !labelColorPattern.MatchString(label.Color) {
+		return fmt.Errorf("%w: color must be a hex code like #0052CC", ErrValidation)
+	}
+	return nil
+}
+
+func labelPayload(label *models.Label) map[string]interface{} {
+	return map[string]interface{}{
+		"label_id": label.ID,
+		"name":     label.Name,
+		"color":    label.Color,
+	}
+}
+
+// Components
+
+// CreateComponent creates a new project component
+func (s *IssueService) CreateComponent(ctx context.Context, component *models.Component) (*models.Component, error) {
+	component.Name = strings.TrimSpace(component.Name)
+	if component.Name == "" {
+		return nil, fmt.Errorf("%w: component name is required", ErrValidation)
+	}
+	if component.ProjectID == "" {
+		return nil, fmt.Errorf("%w: project_id is required", ErrValidation)
+	}
+
+	if err := s.repo.CreateComponent(ctx, component); err != nil {
+		return nil, err
+	}
+
+	s.publishEvent("component.created", component.ProjectID, "system", componentPayload(component))
+	return component, nil
+}
+
+// UpdateComponentInput represents input for updating a component
+type UpdateComponentInput struct {
+	ID                string
+	Name              *string
+	Description       *string
+	LeadID            *string
+	DefaultAssigneeID *string
+}
+
+// UpdateComponent updates a component
+func (s *IssueService) UpdateComponent(ctx context.Context, input UpdateComponentInput) (*models.Component, error) {
+	component, err := s.repo.GetComponent(ctx, input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get component: %w", err)
+	}
+	if component == nil {
+		return nil, fmt.Errorf("%w: component %s", ErrNotFound, input.ID)
+	}
+
+	if input.Name != nil {
+		component.Name = strings.TrimSpace(*input.Name)
+		if component.Name == "" {
+			return nil, fmt.Errorf("%w: component name is required", ErrValidation)
+		}
+	}
+	if input.Description != nil {
+		component.Description = *input.Description
+	}
+	if input.LeadID != nil {
+		component.LeadID = *input.LeadID
+	}
+	if input.DefaultAssigneeID != nil {
+		component.DefaultAssigneeID = *input.DefaultAssigneeID
+	}
+
+	if err := s.repo.UpdateComponent(ctx, component); err != nil {
+		return nil, err
+	}
+
+	s.publishEvent("component.updated", component.ProjectID, "system", componentPayload(component))
+	return component, nil
+}
+
+// DeleteComponent deletes a component and removes it from every issue. The
+// event lists the affected issues so they can be reindexed.
+func (s *IssueService) DeleteComponent(ctx context.Context, id string) error {
+	component, err := s.repo.GetComponent(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get component: %w", err)
+	}
+	if component == nil {
+		return fmt.Errorf("%w: component %s", ErrNotFound, id)
+	}
+	issueIDs, err := s.repo.ListIssueIDsByComponent(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteComponent(ctx, id); err != nil {
+		return err
+	}
+
+	payload := componentPayload(component)
+	payload["issue_ids"] = issueIDs
+	s.publishEvent("component.deleted", component.ProjectID, "system", payload)
+	return nil
+}
+
+// ListComponents lists the components of a project
+func (s *IssueService) ListComponents(ctx context.Context, projectID string) ([]*models.Component, error) {
+	return s.repo.ListComponents(ctx, projectID)
+}
+
+// BulkUpdateIssueComponents adds and removes components on several issues of one project
+func (s *IssueService) BulkUpdateIssueComponents(ctx context.Context, issueIDs, add, remove []string) ([]*models.Issue, error) {
+	issues, err := s.getBulkIssues(ctx, issueIDs)
+	if err != nil {
+		return nil, err
+	}
+	projectID := issues[0].ProjectID
+	if _, err := s.validateComponentIDs(ctx, projectID, add); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateIssueComponents(ctx, issueIDs, uniqueStrings(add), remove); err != nil {
+		return nil, fmt.Errorf("failed to update components: %w", err)
+	}
+
+	return s.publishBulkUpdate(ctx, issueIDs)
+}
+
+// validateComponentIDs checks that the components belong to the project and
+// returns them in the given order
+func (s *IssueService) validateComponentIDs(ctx context.Context, projectID string, ids []string) ([]*models.Component, error) {
+	ids = uniqueStrings(ids)
+	components, err := s.repo.GetComponentsByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get components: %w", err)
+	}
+	byID := make(map[string]*models.Component, len(components))
+	for _, c := range components {
+		if c.ProjectID == projectID {
+			byID[c.ID] = c
+		}
+	}
+	result := make([]*models.Component, 0, len(ids))
+	for _, id := range ids {
+		c, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: component %s does not belong to the project", ErrValidation, id)
+		}
+		result = append(result, c)
+	}
+	return result, nil
+}
+
+func componentPayload(component *models.Component) map[string]interface{} {
+	return map[string]interface{}{
+		"component_id":        component.ID,
+		"name":                component.Name,
+		"lead_id":             component.LeadID,
+		"default_assignee_id": component.DefaultAssigneeID,
+	}
+}
+
+// Bulk helpers
+
+// getBulkIssues loads the issues of a bulk update, which must all exist and
+// belong to the same project
+func (s *IssueService) getBulkIssues(ctx context.Context, issueIDs []string) ([]*models.Issue, error) {
+	if len(issueIDs) == 0 {
+		return nil, fmt.Errorf("%w: issue_ids is required", ErrValidation)
+	}
+	if len(issueIDs) > maxBulkIssues {
+		return nil, fmt.Errorf("%w: at most %d issues can be updated at once", ErrValidation, maxBulkIssues)
+	}
+
+	issues, err := s.repo.GetByIDs(ctx, uniqueStrings(issueIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issues: %w", err)
+	}
+	if len(issues) != len(uniqueStrings(issueIDs)) {
+		return nil, fmt.Errorf("%w: one or more issues", ErrNotFound)
+	}
+	for _, issue := range issues[1:] {
+		if issue.ProjectID != issues[0].ProjectID {
+			return nil, fmt.Errorf("%w: issues must belong to the same project", ErrValidation)
+		}
+	}
+	return issues, nil
+}
+
+// publishBulkUpdate reloads the issues of a bulk update and publishes an
+// issue.updated event for each of them
+func (s *IssueService) publishBulkUpdate(ctx context.Context, issueIDs []string) ([]*models.Issue, error) {
+	issues, err := s.repo.GetByIDs(ctx, uniqueStrings(issueIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issues: %w", err)
+	}
+	for _, issue := range issues {
+		s.publishEvent("issue.updated", issue.ProjectID, "system", map[string]interface{}{
+			"issue_id":      issue.ID,
+			"key":           issue.Key,
+			"label_ids":     issue.LabelIDs,
+			"component_ids": issue.ComponentIDs,
+		})
+	}
+	return issues, nil
+}
+
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != "" && !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	return result
+}
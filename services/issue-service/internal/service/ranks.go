@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+)
+
+const (
+	// maxRankLength is the rank length past which a project is rebalanced
+	maxRankLength = 24
+	// rebalanceBatchSize is the number of projects rebalanced per tick
+	rebalanceBatchSize = 50
+	// rebalanceRankBatchSize is the number of ranks saved, and locked, at a
+	// time while a project is rebalanced
+	rebalanceRankBatchSize = 500
+)
+
+// RankIssuesInput moves issues, in the given order, right before or right
+// after an anchor issue of the same project
+type RankIssuesInput struct {
+	IssueIDs []string
+	BeforeID string
+	AfterID  string
+	UserID   string
+}
+
+// RankIssues moves issues next to an anchor in the project backlog. Moving a
+// single issue updates only its own rank.
+func (s *IssueService) RankIssues(ctx context.Context, input RankIssuesInput) ([]*models.Issue, error) {
+	if (input.BeforeID == "") == (input.AfterID == "") {
+		return nil, fmt.Errorf("%w: exactly one of before and after issue is required", ErrValidation)
+	}
+	issueIDs := uniqueStrings(input.IssueIDs)
+	issues, err := s.getBulkIssues(ctx, issueIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	anchorID, after := input.BeforeID, false
+	if input.AfterID != "" {
+		anchorID, after = input.AfterID, true
+	}
+	for _, id := range issueIDs {
+		if id == anchorID {
+			return nil, fmt.Errorf("%w: an issue cannot be ranked next to itself", ErrValidation)
+		}
+	}
+	anchor, err := s.repo.GetByID(ctx, anchorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue: %w", err)
+	}
+	if anchor == nil {
+		return nil, fmt.Errorf("%w: issue %s", ErrNotFound, anchorID)
+	}
+	if anchor.ProjectID != issues[0].ProjectID {
+		return nil, fmt.Errorf("%w: issues must belong to the anchor issue's project", ErrValidation)
+	}
+
+	ranks, err := s.ranksNextTo(ctx, anchor, after, issueIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*models.Issue, len(issues))
+	for _, issue := range issues {
+		byID[issue.ID] = issue
+	}
+	ranked := make([]*models.Issue, len(issueIDs))
+	for i, id := range issueIDs {
+		ranked[i] = byID[id]
+		ranked[i].Rank = ranks[i]
+	}
+	if err := s.repo.SetRanks(ctx, ranked); err != nil {
+		return nil, fmt.Errorf("failed to rank issues: %w", err)
+	}
+
+	userID := input.UserID
+	if userID == "" {
+		userID = "system"
+	}
+	s.publishEvent("issue.ranked", anchor.ProjectID, userID, map[string]interface{}{
+		"issue_ids":       issueIDs,
+		"before_issue_id": input.BeforeID,
+		"after_issue_id":  input.AfterID,
+	})
+	return ranked, nil
+}
+
+// ranksNextTo returns ranks for the moved issues right before or after an
+// anchor issue. The nearest other rank is strictly before or after the
+// anchor's, so there is always room, even next to issues sharing a rank.
+func (s *IssueService) ranksNextTo(ctx context.Context, anchor *models.Issue, after bool, movedIDs []string) ([]string, error) {
+	adjacent, err := s.repo.AdjacentRank(ctx, anchor.ProjectID, anchor.Rank, after, movedIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacent rank: %w", err)
+	}
+	if after {
+		return models.RanksBetween(anchor.Rank, adjacent, len(movedIDs)), nil
+	}
+	return models.RanksBetween(adjacent, anchor.Rank, len(movedIDs)), nil
+}
+
+// RunRankRebalancer rebalances projects with long or colliding ranks every
+// interval until ctx is done
+func (s *IssueService) RunRankRebalancer(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := s.RebalanceRanks(ctx)
+		if err != nil {
+			s.log.Sugar().Errorw("Failed to rebalance ranks", "error", err)
+		} else if n > 0 {
+			s.log.Sugar().Infow("Rebalanced project ranks", "projects", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RebalanceRanks rebalances the ranks of every project whose ranks grew too
+// long or collide and returns the number of projects rebalanced
+func (s *IssueService) RebalanceRanks(ctx context.Context) (int, error) {
+	projectIDs, err := s.repo.ListProjectsToRebalance(ctx, maxRankLength, rebalanceBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	for i, projectID := range projectIDs {
+		if _, err := s.repo.RebalanceRanks(ctx, projectID, rebalanceRankBatchSize); err != nil {
+			return i, err
+		}
+		s.publishEvent("issue.ranks_rebalanced", projectID, "system", map[string]interface{}{})
+	}
+	return len(projectIDs), nil
+}
@@ -0,0 +1,267 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxRecurrenceSearchDays bounds the search for the next occurrence; it
+// covers rules such as every 12 months on the 31st
+const maxRecurrenceSearchDays = 5 * 366
+
+var weekdayCodes = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// recurrence is the subset of RFC 5545 recurrence rules that schedules
+// support: FREQ=DAILY, WEEKLY or MONTHLY with INTERVAL, BYDAY (daily and
+// weekly), BYMONTHDAY (monthly, negative days count from the end of the
+// month), BYHOUR, BYMINUTE, and either UNTIL or COUNT. Occurrences are
+// computed on the wall clock of the schedule timezone, so they keep their
+// local time across DST.
+type recurrence struct {
+	freq       string
+	interval   int
+	byDay      map[time.Weekday]bool
+	byMonthDay []int
+	hour       int
+	minute     int
+	// until is the last time an occurrence can be at, if set
+	until time.Time
+	// count is how many occurrences there are, if set
+	count int
+
+	loc *time.Location
+	// start is the first day the rule can match, as midnight UTC
+	start time.Time
+	// startAt is the earliest time an occurrence can be at
+	startAt time.Time
+}
+
+// parseRecurrence parses a recurrence rule evaluated in loc from startsAt.
+// The time of day defaults to that of startsAt, as does the weekday of
+// weekly rules and the day of monthly rules.
+func parseRecurrence(rule string, startsAt time.Time, loc *time.Location) (*recurrence, error) {
+	localStart := startsAt.In(loc)
+	r := &recurrence{
+		interval: 1,
+		byDay:    make(map[time.Weekday]bool),
+		hour:     localStart.Hour(),
+		minute:   localStart.Minute(),
+		loc:      loc,
+		start:    time.Date(localStart.Year(), localStart.Month(), localStart.Day(), 0, 0, 0, 0, time.UTC),
+		startAt:  startsAt,
+	}
+
+	rule = strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:")
+	for _, part := range strings.Split(rule, ";") {
+		if part == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rule part %q", part)
+		}
+		var err error
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			r.freq = strings.ToUpper(value)
+		case "INTERVAL":
+			r.interval, err = parseRuleInt(name, value, 1, 1000)
+		case "BYDAY":
+			for _, code := range strings.Split(value, ",") {
+				day, ok := weekdayCodes[strings.ToUpper(code)]
+				if !ok {
+					return nil, fmt.Errorf("invalid BYDAY value %q", code)
+				}
+				r.byDay[day] = true
+			}
+		case "BYMONTHDAY":
+			for _, v := range strings.Split(value, ",") {
+				day, err := parseRuleInt(name, v, -31, 31)
+				if err != nil {
+					return nil, err
+				}
+				if day == 0 {
+					return nil, fmt.Errorf("BYMONTHDAY cannot be 0")
+				}
+				r.byMonthDay = append(r.byMonthDay, day)
+			}
+		case "BYHOUR":
+			r.hour, err = parseRuleInt(name, value, 0, 23)
+		case "BYMINUTE":
+			r.minute, err = parseRuleInt(name, value, 0, 59)
+		case "UNTIL":
+			r.until, err = parseUntil(value, loc)
+		case "COUNT":
+			r.count, err = parseRuleInt(name, value, 1, 10000)
+		default:
+			return nil, fmt.Errorf("unsupported rule part %s", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !r.until.IsZero() && r.count > 0 {
+		return nil, fmt.Errorf("UNTIL and COUNT cannot both be set")
+	}
+
+	switch r.freq {
+	case "DAILY":
+		if len(r.byMonthDay) > 0 {
+			return nil, fmt.Errorf("BYMONTHDAY requires FREQ=MONTHLY")
+		}
+	case "WEEKLY":
+		if len(r.byMonthDay) > 0 {
+			return nil, fmt.Errorf("BYMONTHDAY requires FREQ=MONTHLY")
+		}
+		if len(r.byDay) == 0 {
+			r.byDay[localStart.Weekday()] = true
+		}
+	case "MONTHLY":
+		if len(r.byDay) > 0 {
+			return nil, fmt.Errorf("BYDAY is not supported with FREQ=MONTHLY")
+		}
+		if len(r.byMonthDay) == 0 {
+			r.byMonthDay = []int{localStart.Day()}
+		}
+	case "":
+		return nil, fmt.Errorf("FREQ is required")
+	default:
+		return nil, fmt.Errorf("unsupported FREQ %s", r.freq)
+	}
+	return r, nil
+}
+
+func parseRuleInt(name, value string, min, max int) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < min || n > max {
+		return 0, fmt.Errorf("%s must be between %d and %d", strings.ToUpper(name), min, max)
+	}
+	return n, nil
+}
+
+// parseUntil parses an UNTIL value: a UTC date-time such as
+// "20261231T170000Z", a date-time on the wall clock of loc, or a date, which
+// includes the whole day
+func parseUntil(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102T150405", value, loc); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102", value, loc); err == nil {
+		return t.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid UNTIL value %q", value)
+}
+
+// next returns the first occurrence after the given time, or the zero time
+// if there is none within the search window or the rule has ended
+func (r *recurrence) next(after time.Time) time.Time {
+	if after.Before(r.startAt) {
+		after = r.startAt.Add(-time.Nanosecond)
+	}
+
+	var t time.Time
+	if r.count > 0 {
+		t = r.counted(after)
+	} else {
+		t = r.search(after)
+	}
+	if !r.until.IsZero() && t.After(r.until) {
+		return time.Time{}
+	}
+	return t
+}
+
+// search returns the first occurrence after the given time within the
+// search window
+func (r *recurrence) search(after time.Time) time.Time {
+	local := after.In(r.loc)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	for i := 0; i < maxRecurrenceSearchDays; i++ {
+		d := day.AddDate(0, 0, i)
+		if !r.matches(d) {
+			continue
+		}
+		if t := r.occurrence(d); t.After(after) {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// counted returns the first occurrence after the given time among the first
+// count occurrences of the rule, counting them from its start
+func (r *recurrence) counted(after time.Time) time.Time {
+	// idle counts the days since the last occurrence, bounding the search
+	n, idle := 0, 0
+	for d := r.start; n < r.count && idle < maxRecurrenceSearchDays; d = d.AddDate(0, 0, 1) {
+		idle++
+		if !r.matches(d) {
+			continue
+		}
+		t := r.occurrence(d)
+		if t.Before(r.startAt) {
+			continue
+		}
+		n++
+		idle = 0
+		if t.After(after) {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// occurrence returns the time the rule fires on a day, given as midnight UTC
+func (r *recurrence) occurrence(d time.Time) time.Time {
+	return time.Date(d.Year(), d.Month(), d.Day(), r.hour, r.minute, 0, 0, r.loc)
+}
+
+// matches reports whether the rule fires on a day, given as midnight UTC
+func (r *recurrence) matches(d time.Time) bool {
+	if d.Before(r.start) {
+		return false
+	}
+
+	switch r.freq {
+	case "DAILY":
+		days := int(d.Sub(r.start).Hours() / 24)
+		return days%r.interval == 0 && (len(r.byDay) == 0 || r.byDay[d.Weekday()])
+	case "WEEKLY":
+		weeks := int(weekStart(d).Sub(weekStart(r.start)).Hours() / (24 * 7))
+		return weeks%r.interval == 0 && r.byDay[d.Weekday()]
+	case "MONTHLY":
+		months := (d.Year()-r.start.Year())*12 + int(d.Month()) - int(r.start.Month())
+		if months%r.interval != 0 {
+			return false
+		}
+		daysInMonth := time.Date(d.Year(), d.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		for _, day := range r.byMonthDay {
+			if day < 0 {
+				day = daysInMonth + day + 1
+			}
+			if d.Day() == day {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// weekStart returns the Monday of the week of a day, as RFC 5545 weeks start on Monday by default
+func weekStart(d time.Time) time.Time {
+	return d.AddDate(0, 0, -((int(d.Weekday()) + 6) % 7))
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+const occurrenceLayout = "2006-01-02 15:04 -0700"
+
+func TestRecurrence_Next(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     string
+		timezone string
+		startsAt string
+		want     []string
+	}{
+		{"Daily at the start time", "FREQ=DAILY", "UTC", "2026-10-19 09:30",
+			[]string{"2026-10-19 09:30 +0000", "2026-10-20 09:30 +0000", "2026-10-21 09:30 +0000"}},
+		{"Every other day", "FREQ=DAILY;INTERVAL=2", "UTC", "2026-10-19 09:30",
+			[]string{"2026-10-19 09:30 +0000", "2026-10-21 09:30 +0000", "2026-10-23 09:30 +0000"}},
+		{"Weekdays", "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR", "UTC", "2026-10-23 09:00",
+			[]string{"2026-10-23 09:00 +0000", "2026-10-26 09:00 +0000", "2026-10-27 09:00 +0000"}},
+		{"Later hour than the start", "FREQ=DAILY;BYHOUR=8;BYMINUTE=15", "UTC", "2026-10-19 09:00",
+			[]string{"2026-10-20 08:15 +0000", "2026-10-21 08:15 +0000"}},
+		{"Weekly on some days", "FREQ=WEEKLY;BYDAY=MO,WE,FR;BYHOUR=9;BYMINUTE=0", "UTC", "2026-10-20 12:00",
+			[]string{"2026-10-21 09:00 +0000", "2026-10-23 09:00 +0000", "2026-10-26 09:00 +0000", "2026-10-28 09:00 +0000"}},
+		{"Weekly on the start day", "FREQ=WEEKLY;INTERVAL=2", "UTC", "2026-10-19 10:00",
+			[]string{"2026-10-19 10:00 +0000", "2026-11-02 10:00 +0000", "2026-11-16 10:00 +0000"}},
+		{"Every other week from midweek", "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR", "UTC", "2026-10-21 09:00",
+			[]string{"2026-10-23 09:00 +0000", "2026-11-02 09:00 +0000", "2026-11-06 09:00 +0000"}},
+		{"Monthly on the 31st", "FREQ=MONTHLY;BYMONTHDAY=31", "UTC", "2026-01-31 09:00",
+			[]string{"2026-01-31 09:00 +0000", "2026-03-31 09:00 +0000", "2026-05-31 09:00 +0000", "2026-07-31 09:00 +0000"}},
+		{"Monthly on the last day", "FREQ=MONTHLY;BYMONTHDAY=-1", "UTC", "2026-01-15 09:00",
+			[]string{"2026-01-31 09:00 +0000", "2026-02-28 09:00 +0000", "2026-03-31 09:00 +0000"}},
+		{"Quarterly on the start day", "FREQ=MONTHLY;INTERVAL=3", "UTC", "2026-01-15 09:00",
+			[]string{"2026-01-15 09:00 +0000", "2026-04-15 09:00 +0000", "2026-07-15 09:00 +0000"}},
+		{"Count", "FREQ=DAILY;COUNT=3", "UTC", "2026-10-19 09:00",
+			[]string{"2026-10-19 09:00 +0000", "2026-10-20 09:00 +0000", "2026-10-21 09:00 +0000"}},
+		{"Count skips times before the start", "FREQ=WEEKLY;BYDAY=MO,TU;BYHOUR=8;COUNT=2", "UTC", "2026-10-19 09:00",
+			[]string{"2026-10-20 08:00 +0000", "2026-10-26 08:00 +0000"}},
+		{"Until a time", "FREQ=DAILY;UNTIL=20261021T090000Z", "UTC", "2026-10-19 09:00",
+			[]string{"2026-10-19 09:00 +0000", "2026-10-20 09:00 +0000", "2026-10-21 09:00 +0000"}},
+		{"Until a date", "FREQ=DAILY;UNTIL=20261020", "Europe/Berlin", "2026-10-19 23:30",
+			[]string{"2026-10-19 23:30 +0200", "2026-10-20 23:30 +0200"}},
+		{"Clocks go forward", "FREQ=DAILY", "Europe/Berlin", "2026-03-28 09:00",
+			[]string{"2026-03-28 09:00 +0100", "2026-03-29 09:00 +0200", "2026-03-30 09:00 +0200"}},
+		{"Clocks go back", "FREQ=DAILY", "America/New_York", "2026-10-31 09:00",
+			[]string{"2026-10-31 09:00 -0400", "2026-11-01 09:00 -0500", "2026-11-02 09:00 -0500"}},
+		{"Time skipped by DST", "FREQ=DAILY;BYHOUR=2;BYMINUTE=30", "Europe/Berlin", "2026-03-28 00:00",
+			[]string{"2026-03-28 02:30 +0100", "2026-03-29 03:30 +0200", "2026-03-30 02:30 +0200"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := time.LoadLocation(tt.timezone)
+			if err != nil {
+				t.Fatalf("LoadLocation() error = %v", err)
+			}
+			startsAt, err := time.ParseInLocation("2006-01-02 15:04", tt.startsAt, loc)
+			if err != nil {
+				t.Fatalf("ParseInLocation() error = %v", err)
+			}
+			r, err := parseRecurrence(tt.rule, startsAt, loc)
+			if err != nil {
+				t.Fatalf("parseRecurrence() error = %v", err)
+			}
+
+			// One more than expected, to check where rules with an end stop
+			var got []string
+			after := startsAt.Add(-time.Hour)
+			for i := 0; i <= len(tt.want); i++ {
+				next := r.next(after)
+				if next.IsZero() {
+					break
+				}
+				got = append(got, next.In(loc).Format(occurrenceLayout))
+				after = next
+			}
+			if r.count == 0 && r.until.IsZero() && len(got) > len(tt.want) {
+				got = got[:len(tt.want)]
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("occurrences = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurrence_NextBeforeStart(t *testing.T) {
+	startsAt := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	r, err := parseRecurrence("FREQ=DAILY", startsAt, time.UTC)
+	if err != nil {
+		t.Fatalf("parseRecurrence() error = %v", err)
+	}
+	if got := r.next(startsAt.AddDate(-1, 0, 0)); !got.Equal(startsAt) {
+		t.Errorf("next() = %v, want %v", got, startsAt)
+	}
+}
+
+func TestParseRecurrence_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		rule string
+	}{
+		{"Empty", ""},
+		{"No FREQ", "INTERVAL=2"},
+		{"Unsupported FREQ", "FREQ=YEARLY"},
+		{"Part without value", "FREQ"},
+		{"Unsupported part", "FREQ=DAILY;BYSETPOS=1"},
+		{"Zero interval", "FREQ=DAILY;INTERVAL=0"},
+		{"Unknown weekday", "FREQ=WEEKLY;BYDAY=XX"},
+		{"BYMONTHDAY with DAILY", "FREQ=DAILY;BYMONTHDAY=1"},
+		{"BYDAY with MONTHLY", "FREQ=MONTHLY;BYDAY=MO"},
+		{"BYMONTHDAY zero", "FREQ=MONTHLY;BYMONTHDAY=0"},
+		{"BYMONTHDAY past 31", "FREQ=MONTHLY;BYMONTHDAY=32"},
+		{"BYHOUR past 23", "FREQ=DAILY;BYHOUR=24"},
+		{"Invalid UNTIL", "FREQ=DAILY;UNTIL=tomorrow"},
+		{"Zero COUNT", "FREQ=DAILY;COUNT=0"},
+		{"UNTIL and COUNT", "FREQ=DAILY;COUNT=2;UNTIL=20261231"},
+	}
+
+	startsAt := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := parseRecurrence(tt.rule, startsAt, time.UTC); err == nil {
+				t.Errorf("parseRecurrence(%q) error = nil, want an error", tt.rule)
+			}
+		})
+	}
+}
+
+func TestParseRecurrence_Prefix(t *testing.T) {
+	startsAt := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	r, err := parseRecurrence(" RRULE:freq=weekly;byday=mo ", startsAt, time.UTC)
+	if err != nil {
+		t.Fatalf("parseRecurrence() error = %v", err)
+	}
+	if r.freq != "WEEKLY" || !r.byDay[time.Monday] {
+		t.Errorf("parseRecurrence() = %+v, want weekly on Mondays", r)
+	}
+}
@@ -0,0 +1,354 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/nexusflow/nexusflow/pkg/proto/project/v1"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+)
+
+const (
+	// scheduleBatchSize is the number of due schedules handled per scheduler tick
+	scheduleBatchSize = 100
+	// maxScheduleCatchUp caps the issues created for the missed occurrences
+	// of one schedule; only the most recent ones are created
+	maxScheduleCatchUp = 50
+	// scheduleGracePeriod is how late an occurrence can be created before it
+	// counts as missed
+	scheduleGracePeriod = 15 * time.Minute
+)
+
+// CreateIssueSchedule creates a schedule that files an issue from the
+// schedule's blueprint at every occurrence of its recurrence rule
+func (s *IssueService) CreateIssueSchedule(ctx context.Context, schedule *models.IssueSchedule) (*models.IssueSchedule, error) {
+	if schedule.ProjectID == "" {
+		return nil, fmt.Errorf("%w: project_id is required", ErrValidation)
+	}
+	if schedule.StartsAt.IsZero() {
+		schedule.StartsAt = time.Now()
+	}
+	if err := s.prepareIssueSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreateIssueSchedule(ctx, schedule); err != nil {
+		return nil, fmt.Errorf("failed to create issue schedule: %w", err)
+	}
+
+	s.publishEvent("issue_schedule.created", schedule.ProjectID, schedule.CreatedBy, issueSchedulePayload(schedule))
+	return schedule, nil
+}
+
+// GetIssueSchedule gets an issue schedule
+func (s *IssueService) GetIssueSchedule(ctx context.Context, id string) (*models.IssueSchedule, error) {
+	schedule, err := s.repo.GetIssueSchedule(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue schedule: %w", err)
+	}
+	if schedule == nil {
+		return nil, fmt.Errorf("%w: issue schedule %s", ErrNotFound, id)
+	}
+	return schedule, nil
+}
+
+// UpdateIssueSchedule replaces the blueprint and recurrence of a schedule.
+// The next run is recomputed from now, so changing a rule never backfills.
+func (s *IssueService) UpdateIssueSchedule(ctx context.Context, update *models.IssueSchedule) (*models.IssueSchedule, error) {
+	schedule, err := s.GetIssueSchedule(ctx, update.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule.Name = update.Name
+	schedule.Summary = update.Summary
+	schedule.Description = update.Description
+	schedule.Type = update.Type
+	schedule.Priority = update.Priority
+	schedule.AssigneeID = update.AssigneeID
+	schedule.CustomFields = update.CustomFields
+	schedule.RRule = update.RRule
+	schedule.Timezone = update.Timezone
+	schedule.EndsAt = update.EndsAt
+	schedule.CatchUp = update.CatchUp
+	schedule.Enabled = update.Enabled
+	if !update.StartsAt.IsZero() {
+		schedule.StartsAt = update.StartsAt
+	}
+	if err := s.prepareIssueSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateIssueSchedule(ctx, schedule); err != nil {
+		return nil, fmt.Errorf("failed to update issue schedule: %w", err)
+	}
+
+	s.publishEvent("issue_schedule.updated", schedule.ProjectID, "system", issueSchedulePayload(schedule))
+	return schedule, nil
+}
+
+// DeleteIssueSchedule deletes a schedule; issues it already created stay but
+// lose the link to it
+func (s *IssueService) DeleteIssueSchedule(ctx context.Context, id string) error {
+	schedule, err := s.GetIssueSchedule(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.DeleteIssueSchedule(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete issue schedule: %w", err)
+	}
+
+	s.publishEvent("issue_schedule.deleted", schedule.ProjectID, "system", map[string]interface{}{
+		"schedule_id": schedule.ID,
+	})
+	return nil
+}
+
+// ListIssueSchedules lists the issue schedules of a project
+func (s *IssueService) ListIssueSchedules(ctx context.Context, projectID string) ([]*models.IssueSchedule, error) {
+	if projectID == "" {
+		return nil, fmt.Errorf("%w: project_id is required", ErrValidation)
+	}
+	return s.repo.ListIssueSchedules(ctx, projectID)
+}
+
+// prepareIssueSchedule validates a schedule, normalizes its blueprint and
+// computes its next run
+func (s *IssueService) prepareIssueSchedule(ctx context.Context, schedule *models.IssueSchedule) error {
+	schedule.Name = strings.TrimSpace(schedule.Name)
+	if schedule.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrValidation)
+	}
+	if strings.TrimSpace(schedule.Summary) == "" {
+		return fmt.Errorf("%w: summary is required", ErrValidation)
+	}
+	if schedule.Type == "" {
+		schedule.Type = models.IssueTypeTask
+	}
+	if schedule.Priority == "" {
+		schedule.Priority = models.IssuePriorityMedium
+	}
+	switch schedule.CatchUp {
+	case "":
+		schedule.CatchUp = models.ScheduleCatchUpLatest
+	case models.ScheduleCatchUpNone, models.ScheduleCatchUpLatest, models.ScheduleCatchUpAll:
+	default:
+		return fmt.Errorf("%w: invalid catch_up %q", ErrValidation, schedule.CatchUp)
+	}
+	if !schedule.EndsAt.IsZero() && !schedule.EndsAt.After(schedule.StartsAt) {
+		return fmt.Errorf("%w: ends_at must be after starts_at", ErrValidation)
+	}
+
+	rule, err := scheduleRecurrence(schedule)
+	if err != nil {
+		return err
+	}
+
+	// Blueprint custom values are checked now so that runs only fail on
+	// fields changed after the schedule was saved
+	projectResp, err := s.projectClient.GetProject(ctx, &pb.GetProjectRequest{Id: schedule.ProjectID})
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	blueprint := &models.Issue{ProjectID: schedule.ProjectID, Type: schedule.Type}
+	values, _, err := s.resolveCustomFieldValues(ctx, blueprint, projectResp.Project.OrganizationId, schedule.CustomFields, true)
+	if err != nil {
+		return err
+	}
+	// Defaults are left out so that issues pick up the defaults current when they are created
+	blueprintValues := make(map[string]interface{}, len(values))
+	for _, v := range values {
+		if _, ok := schedule.CustomFields[v.FieldID]; ok {
+			blueprintValues[v.FieldID] = v.Value
+		}
+	}
+	schedule.CustomFields = blueprintValues
+
+	schedule.NextRunAt = scheduleNextRun(schedule, rule, time.Now())
+	if schedule.NextRunAt.IsZero() && schedule.Enabled {
+		return fmt.Errorf("%w: recurrence has no upcoming occurrences", ErrValidation)
+	}
+	schedule.LastError = ""
+	return nil
+}
+
+// scheduleRecurrence parses the recurrence rule of a schedule in its timezone
+func scheduleRecurrence(schedule *models.IssueSchedule) (*recurrence, error) {
+	if schedule.Timezone == "" {
+		schedule.Timezone = "UTC"
+	}
+	loc, err := time.LoadLocation(schedule.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unknown timezone %q", ErrValidation, schedule.Timezone)
+	}
+	rule, err := parseRecurrence(schedule.RRule, schedule.StartsAt, loc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid rrule: %v", ErrValidation, err)
+	}
+	return rule, nil
+}
+
+// scheduleNextRun returns the first occurrence after the given time, or the
+// zero time once the schedule has ended
+func scheduleNextRun(schedule *models.IssueSchedule, rule *recurrence, after time.Time) time.Time {
+	next := rule.next(after)
+	if !schedule.EndsAt.IsZero() && next.After(schedule.EndsAt) {
+		return time.Time{}
+	}
+	return next
+}
+
+// RunIssueScheduler creates the issues of due schedules every interval
+// until ctx is done. The first run catches up on anything missed while the
+// service was down.
+func (s *IssueService) RunIssueScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := s.RunDueIssueSchedules(ctx, time.Now())
+		if err != nil {
+			s.log.Sugar().Errorw("Failed to run issue schedules", "error", err)
+		} else if n > 0 {
+			s.log.Sugar().Infow("Created scheduled issues", "count", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunDueIssueSchedules runs every schedule that is due at now and returns
+// the number of issues created
+func (s *IssueService) RunDueIssueSchedules(ctx context.Context, now time.Time) (int, error) {
+	created := 0
+	for {
+		schedules, err := s.repo.ListDueIssueSchedules(ctx, now, scheduleBatchSize)
+		if err != nil {
+			return created, fmt.Errorf("failed to list due schedules: %w", err)
+		}
+		for _, schedule := range schedules {
+			created += s.runIssueSchedule(ctx, schedule, now)
+		}
+		if len(schedules) < scheduleBatchSize {
+			return created, nil
+		}
+	}
+}
+
+// runIssueSchedule creates the issues for the due occurrences of a schedule
+// and moves it to its next occurrence. The run is claimed before any issue
+// is created, so a crash can lose an occurrence but never duplicate one.
+func (s *IssueService) runIssueSchedule(ctx context.Context, schedule *models.IssueSchedule, now time.Time) int {
+	log := s.log.Sugar().With("schedule_id", schedule.ID)
+
+	var due []time.Time
+	var next time.Time
+	rule, err := scheduleRecurrence(schedule)
+	if err == nil {
+		due, next = dueOccurrences(schedule, rule, now)
+	}
+	claimed, claimErr := s.repo.ClaimIssueScheduleRun(ctx, schedule, next, now)
+	if claimErr != nil {
+		log.Errorw("Failed to claim schedule run", "error", claimErr)
+		return 0
+	}
+	if !claimed {
+		return 0
+	}
+	if err != nil {
+		// Leaves the schedule without a next run until its rule is fixed
+		log.Errorw("Schedule has an invalid recurrence", "error", err)
+		s.recordScheduleError(ctx, schedule.ID, err)
+		return 0
+	}
+
+	created := 0
+	var errs []error
+	for _, occurrence := range due {
+		if _, err := s.createScheduledIssue(ctx, schedule, occurrence); err != nil {
+			log.Errorw("Failed to create scheduled issue", "error", err, "scheduled_for", occurrence)
+			errs = append(errs, fmt.Errorf("%s: %w", occurrence.Format(time.RFC3339), err))
+			continue
+		}
+		created++
+	}
+	if len(errs) > 0 || schedule.LastError != "" {
+		s.recordScheduleError(ctx, schedule.ID, errors.Join(errs...))
+	}
+	return created
+}
+
+// dueOccurrences lists the occurrences of a schedule to create at now,
+// applying its catch-up policy, and returns the run after them
+func dueOccurrences(schedule *models.IssueSchedule, rule *recurrence, now time.Time) ([]time.Time, time.Time) {
+	var due []time.Time
+	t := schedule.NextRunAt
+	for !t.IsZero() && !t.After(now) {
+		due = append(due, t)
+		if len(due) > maxScheduleCatchUp {
+			due = due[1:]
+		}
+		t = scheduleNextRun(schedule, rule, t)
+	}
+
+	switch schedule.CatchUp {
+	case models.ScheduleCatchUpNone:
+		onTime := due[:0]
+		for _, occurrence := range due {
+			if now.Sub(occurrence) <= scheduleGracePeriod {
+				onTime = append(onTime, occurrence)
+			}
+		}
+		due = onTime
+	case models.ScheduleCatchUpLatest:
+		if len(due) > 1 {
+			due = due[len(due)-1:]
+		}
+	}
+	return due, t
+}
+
+// createScheduledIssue files the blueprint of a schedule for one occurrence
+func (s *IssueService) createScheduledIssue(ctx context.Context, schedule *models.IssueSchedule, occurrence time.Time) (*models.Issue, error) {
+	return s.CreateIssue(ctx, CreateIssueInput{
+		ProjectID:    schedule.ProjectID,
+		Summary:      schedule.Summary,
+		Description:  schedule.Description,
+		Type:         schedule.Type,
+		Priority:     schedule.Priority,
+		AssigneeID:   schedule.AssigneeID,
+		ReporterID:   schedule.CreatedBy,
+		CustomFields: schedule.CustomFields,
+		ScheduleID:   schedule.ID,
+		ScheduledFor: occurrence,
+	})
+}
+
+// recordScheduleError saves the outcome of a run; a nil error clears it
+func (s *IssueService) recordScheduleError(ctx context.Context, id string, runErr error) {
+	message := ""
+	if runErr != nil {
+		message = runErr.Error()
+	}
+	if err := s.repo.SetIssueScheduleError(ctx, id, message); err != nil {
+		s.log.Sugar().Errorw("Failed to save schedule error", "error", err, "schedule_id", id)
+	}
+}
+
+func issueSchedulePayload(schedule *models.IssueSchedule) map[string]interface{} {
+	return map[string]interface{}{
+		"schedule_id": schedule.ID,
+		"name":        schedule.Name,
+		"rrule":       schedule.RRule,
+		"timezone":    schedule.Timezone,
+		"enabled":     schedule.Enabled,
+		"next_run_at": schedule.NextRunAt,
+	}
+}
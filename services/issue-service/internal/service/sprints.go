@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"fmt"
+)
+
+// SetIssuesSprint moves issues into a sprint, or to the backlog for an empty
+// sprintID. The sprint service owns sprint membership and calls this to keep
+// Issue.SprintID in step; an issue.updated event is published for every
+// issue whose sprint changed.
+func (s *IssueService) SetIssuesSprint(ctx context.Context, issueIDs []string, sprintID string) ([]string, error) {
+	issueIDs = uniqueStrings(issueIDs)
+	if len(issueIDs) == 0 {
+		return nil, fmt.Errorf("%w: issue_ids is required", ErrValidation)
+	}
+	if len(issueIDs) > maxBulkIssues {
+		return nil, fmt.Errorf("%w: at most %d issues can be updated at once", ErrValidation, maxBulkIssues)
+	}
+
+	before, err := s.repo.GetByIDs(ctx, issueIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issues: %w", err)
+	}
+	changed, err := s.repo.SetSprint(ctx, issueIDs, sprintID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set sprint: %w", err)
+	}
+	if len(changed) == 0 {
+		return changed, nil
+	}
+
+	from := make(map[string]string, len(before))
+	for _, issue := range before {
+		from[issue.ID] = issue.SprintID
+	}
+	issues, err := s.repo.GetByIDs(ctx, changed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issues: %w", err)
+	}
+	for _, issue := range issues {
+		s.publishEvent("issue.updated", issue.ProjectID, "system", map[string]interface{}{
+			"issue_id": issue.ID,
+			"key":      issue.Key,
+			"version":  issue.Version,
+			"changes": map[string]interface{}{
+				"sprint_id": map[string]interface{}{"from": from[issue.ID], "to": issue.SprintID},
+			},
+		})
+	}
+	return changed, nil
+}
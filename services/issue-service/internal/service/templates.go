@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+)
+
+// maxTemplateSubTasks caps the checklist of a template
+const maxTemplateSubTasks = 50
+
+// CreateIssueTemplate creates an issue template
+func (s *IssueService) CreateIssueTemplate(ctx context.Context, template *models.IssueTemplate) (*models.IssueTemplate, error) {
+	if template.ProjectID == "" {
+		return nil, fmt.Errorf("%w: project_id is required", ErrValidation)
+	}
+	if err := s.prepareIssueTemplate(ctx, template); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreateIssueTemplate(ctx, template); err != nil {
+		return nil, fmt.Errorf("failed to create issue template: %w", err)
+	}
+
+	s.publishEvent("issue_template.created", template.ProjectID, "system", issueTemplatePayload(template))
+	return template, nil
+}
+
+// GetIssueTemplate gets an issue template
+func (s *IssueService) GetIssueTemplate(ctx context.Context, id string) (*models.IssueTemplate, error) {
+	template, err := s.repo.GetIssueTemplate(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue template: %w", err)
+	}
+	if template == nil {
+		return nil, fmt.Errorf("%w: issue template %s", ErrNotFound, id)
+	}
+	return template, nil
+}
+
+// UpdateIssueTemplate replaces the contents of a template; its project
+// cannot change
+func (s *IssueService) UpdateIssueTemplate(ctx context.Context, update *models.IssueTemplate) (*models.IssueTemplate, error) {
+	template, err := s.GetIssueTemplate(ctx, update.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	template.Name = update.Name
+	template.IssueType = update.IssueType
+	template.IsDefault = update.IsDefault
+	template.Description = update.Description
+	template.Priority = update.Priority
+	template.LabelIDs = update.LabelIDs
+	template.CustomFields = update.CustomFields
+	template.SubTasks = update.SubTasks
+	if err := s.prepareIssueTemplate(ctx, template); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateIssueTemplate(ctx, template); err != nil {
+		return nil, fmt.Errorf("failed to update issue template: %w", err)
+	}
+
+	s.publishEvent("issue_template.updated", template.ProjectID, "system", issueTemplatePayload(template))
+	return template, nil
+}
+
+// DeleteIssueTemplate deletes an issue template
+func (s *IssueService) DeleteIssueTemplate(ctx context.Context, id string) error {
+	template, err := s.GetIssueTemplate(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.DeleteIssueTemplate(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete issue template: %w", err)
+	}
+
+	s.publishEvent("issue_template.deleted", template.ProjectID, "system", map[string]interface{}{
+		"template_id": template.ID,
+	})
+	return nil
+}
+
+// ListIssueTemplates lists the templates of a project, optionally only those
+// of one issue type
+func (s *IssueService) ListIssueTemplates(ctx context.Context, projectID string, issueType models.IssueType) ([]*models.IssueTemplate, error) {
+	if projectID == "" {
+		return nil, fmt.Errorf("%w: project_id is required", ErrValidation)
+	}
+	return s.repo.ListIssueTemplates(ctx, projectID, issueType)
+}
+
+// prepareIssueTemplate validates a template and normalizes its labels and
+// custom field values
+func (s *IssueService) prepareIssueTemplate(ctx context.Context, template *models.IssueTemplate) error {
+	template.Name = strings.TrimSpace(template.Name)
+	if template.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrValidation)
+	}
+	if template.IssueType == "" {
+		return fmt.Errorf("%w: issue_type is required", ErrValidation)
+	}
+	if template.Priority != "" && template.Priority.Rank() == 0 {
+		return fmt.Errorf("%w: invalid priority %q", ErrValidation, template.Priority)
+	}
+
+	if len(template.SubTasks) > maxTemplateSubTasks {
+		return fmt.Errorf("%w: a template can have at most %d sub-tasks", ErrValidation, maxTemplateSubTasks)
+	}
+	if len(template.SubTasks) > 0 && template.IssueType == models.IssueTypeSubTask {
+		return fmt.Errorf("%w: sub-task templates cannot have sub-tasks", ErrValidation)
+	}
+	for i := range template.SubTasks {
+		template.SubTasks[i].Summary = strings.TrimSpace(template.SubTasks[i].Summary)
+		if template.SubTasks[i].Summary == "" {
+			return fmt.Errorf("%w: sub-task %d has no summary", ErrValidation, i+1)
+		}
+	}
+
+	var err error
+	if template.LabelIDs, err = s.validateLabelIDs(ctx, template.ProjectID, template.LabelIDs); err != nil {
+		return err
+	}
+
+	// Values are checked like an issue update so that required fields can be
+	// left to the issue or the field default
+	blueprint := &models.Issue{ProjectID: template.ProjectID, Type: template.IssueType}
+	values, _, err := s.resolveCustomFieldValues(ctx, blueprint, "", template.CustomFields, false)
+	if err != nil {
+		return err
+	}
+	template.CustomFields = make(map[string]interface{}, len(values))
+	for _, v := range values {
+		template.CustomFields[v.FieldID] = v.Value
+	}
+	return nil
+}
+
+// resolveIssueTemplate finds the template for a new issue: the requested one,
+// or else the project default for the issue type. It fills the fields the
+// input leaves blank from the template and returns it, or nil if there is none.
+func (s *IssueService) resolveIssueTemplate(ctx context.Context, input *CreateIssueInput) (*models.IssueTemplate, error) {
+	var template *models.IssueTemplate
+	var err error
+	if input.TemplateID != "" {
+		template, err = s.GetIssueTemplate(ctx, input.TemplateID)
+		if err != nil {
+			return nil, err
+		}
+		if template.ProjectID != input.ProjectID {
+			return nil, fmt.Errorf("%w: template %s belongs to another project", ErrValidation, template.ID)
+		}
+		if input.Type == "" {
+			input.Type = template.IssueType
+		} else if input.Type != template.IssueType {
+			return nil, fmt.Errorf("%w: template %s is for %s issues", ErrValidation, template.ID, template.IssueType)
+		}
+	} else {
+		if input.Type == "" {
+			input.Type = models.IssueTypeTask
+		}
+		template, err = s.repo.GetDefaultIssueTemplate(ctx, input.ProjectID, input.Type)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get default template: %w", err)
+		}
+		if template == nil {
+			return nil, nil
+		}
+	}
+
+	if input.Description == "" {
+		input.Description = template.Description
+	}
+	if input.Priority == "" {
+		input.Priority = template.Priority
+	}
+	input.LabelIDs = append(append([]string(nil), template.LabelIDs...), input.LabelIDs...)
+	if len(template.CustomFields) > 0 {
+		customFields := make(map[string]interface{}, len(template.CustomFields)+len(input.CustomFields))
+		for fieldID, value := range template.CustomFields {
+			customFields[fieldID] = value
+		}
+		for fieldID, value := range input.CustomFields {
+			customFields[fieldID] = value
+		}
+		input.CustomFields = customFields
+	}
+	return template, nil
+}
+
+// createTemplateSubTasks creates the sub-task checklist of a template under a
+// new issue. The issue is already saved, so failures are logged rather than
+// returned.
+func (s *IssueService) createTemplateSubTasks(ctx context.Context, parent *models.Issue, template *models.IssueTemplate) {
+	for _, st := range template.SubTasks {
+		assigneeID := st.AssigneeID
+		if assigneeID == "" {
+			assigneeID = parent.AssigneeID
+		}
+		_, err := s.CreateIssue(ctx, CreateIssueInput{
+			ProjectID:   parent.ProjectID,
+			Summary:     st.Summary,
+			Description: st.Description,
+			Type:        models.IssueTypeSubTask,
+			AssigneeID:  assigneeID,
+			ReporterID:  parent.ReporterID,
+			ParentID:    parent.ID,
+		})
+		if err != nil {
+			s.log.Sugar().Errorw("Failed to create template sub-task", "error", err, "issue_id", parent.ID, "template_id", template.ID)
+		}
+	}
+}
+
+func issueTemplatePayload(template *models.IssueTemplate) map[string]interface{} {
+	return map[string]interface{}{
+		"template_id": template.ID,
+		"name":        template.Name,
+		"issue_type":  string(template.IssueType),
+		"is_default":  template.IsDefault,
+	}
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+)
+
+// purgeBatchSize is the number of issues hard deleted per transaction
+const purgeBatchSize = 200
+
+// DeleteIssue moves an issue and its sub-tasks to the trash. Trashed issues
+// disappear from lists, boards and sprints until restored or purged.
+func (s *IssueService) DeleteIssue(ctx context.Context, id, userID string) error {
+	issue, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get issue: %w", err)
+	}
+	if issue == nil {
+		return fmt.Errorf("%w: issue %s", ErrNotFound, id)
+	}
+
+	deleted, err := s.repo.Delete(ctx, id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete issue: %w", err)
+	}
+
+	s.publishEvent("issue.deleted", issue.ProjectID, userID, map[string]interface{}{
+		"issue_id":  issue.ID,
+		"key":       issue.Key,
+		"issue_ids": deleted,
+	})
+	return nil
+}
+
+// DeletedIssuesPage is one page of a project trash
+type DeletedIssuesPage struct {
+	Issues   []*models.Issue
+	Total    int
+	Page     int
+	PageSize int
+}
+
+// ListDeletedIssues lists the trash of a project
+func (s *IssueService) ListDeletedIssues(ctx context.Context, projectID string, page, pageSize int) (*DeletedIssuesPage, error) {
+	if projectID == "" {
+		return nil, fmt.Errorf("%w: project_id is required", ErrValidation)
+	}
+	if pageSize <= 0 {
+		pageSize = defaultIssuePageSize
+	}
+	if pageSize > maxIssuePageSize {
+		pageSize = maxIssuePageSize
+	}
+	if page <= 0 {
+		page = 1
+	}
+
+	issues, total, err := s.repo.ListDeleted(ctx, projectID, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deleted issues: %w", err)
+	}
+	return &DeletedIssuesPage{Issues: issues, Total: total, Page: page, PageSize: pageSize}, nil
+}
+
+// RestoreIssue takes an issue out of the trash together with the sub-tasks
+// that were deleted with it. Sub-tasks deleted along with their parent can
+// only come back with it.
+func (s *IssueService) RestoreIssue(ctx context.Context, id, userID string) (*models.Issue, error) {
+	issue, err := s.repo.GetDeletedByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue: %w", err)
+	}
+	if issue == nil {
+		return nil, fmt.Errorf("%w: issue %s is not in the trash", ErrNotFound, id)
+	}
+	if issue.DeletedRootID != issue.ID {
+		return nil, fmt.Errorf("%w: issue was deleted with %s, restore that issue instead", ErrValidation, issue.DeletedRootID)
+	}
+	if issue.ParentID != "" {
+		parent, err := s.repo.GetByID(ctx, issue.ParentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get parent issue: %w", err)
+		}
+		if parent == nil {
+			return nil, fmt.Errorf("%w: parent issue %s is deleted, restore it first", ErrValidation, issue.ParentID)
+		}
+	}
+
+	restored, err := s.repo.Restore(ctx, issue.ID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore issue: %w", err)
+	}
+
+	issue, err = s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue: %w", err)
+	}
+	if issue == nil {
+		return nil, fmt.Errorf("%w: issue %s", ErrNotFound, id)
+	}
+
+	s.publishEvent("issue.restored", issue.ProjectID, userID, map[string]interface{}{
+		"issue_id":  issue.ID,
+		"key":       issue.Key,
+		"issue_ids": restored,
+	})
+	return issue, nil
+}
+
+// PurgeExpiredIssues permanently deletes issues that have been in the trash
+// for longer than the retention period and returns how many were removed
+func (s *IssueService) PurgeExpiredIssues(ctx context.Context, retention time.Duration) (int, error) {
+	before := time.Now().Add(-retention)
+	purged := 0
+	for {
+		issues, err := s.repo.PurgeDeleted(ctx, before, purgeBatchSize)
+		if err != nil {
+			return purged, fmt.Errorf("failed to purge issues: %w", err)
+		}
+		purged += len(issues)
+
+		byProject := make(map[string][]string)
+		for _, issue := range issues {
+			byProject[issue.ProjectID] = append(byProject[issue.ProjectID], issue.ID)
+		}
+		for projectID, ids := range byProject {
+			s.publishEvent("issue.purged", projectID, "system", map[string]interface{}{
+				"issue_ids": ids,
+			})
+		}
+
+		if len(issues) < purgeBatchSize {
+			return purged, nil
+		}
+	}
+}
+
+// RunTrashRetention purges expired issues every interval until ctx is done
+func (s *IssueService) RunTrashRetention(ctx context.Context, retention, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := s.PurgeExpiredIssues(ctx, retention)
+		if err != nil {
+			s.log.Sugar().Errorw("Failed to purge trash", "error", err)
+		} else if n > 0 {
+			s.log.Sugar().Infow("Purged issues from trash", "count", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+)
+
+// AddWatcher makes a user watch an issue so they are notified of its changes
+func (s *IssueService) AddWatcher(ctx context.Context, issueID, userID string) (*models.Issue, error) {
+	if _, err := s.watchedIssue(ctx, issueID, userID); err != nil {
+		return nil, err
+	}
+	if err := s.repo.AddWatcher(ctx, issueID, userID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetByID(ctx, issueID)
+}
+
+// RemoveWatcher stops a user watching an issue
+func (s *IssueService) RemoveWatcher(ctx context.Context, issueID, userID string) (*models.Issue, error) {
+	if _, err := s.watchedIssue(ctx, issueID, userID); err != nil {
+		return nil, err
+	}
+	if err := s.repo.RemoveWatcher(ctx, issueID, userID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetByID(ctx, issueID)
+}
+
+func (s *IssueService) watchedIssue(ctx context.Context, issueID, userID string) (*models.Issue, error) {
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil, fmt.Errorf("%w: user_id must be a UUID", ErrValidation)
+	}
+	if _, err := uuid.Parse(issueID); err != nil {
+		return nil, fmt.Errorf("%w: issue %s", ErrNotFound, issueID)
+	}
+	issue, err := s.repo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+	if issue == nil {
+		return nil, fmt.Errorf("%w: issue %s", ErrNotFound, issueID)
+	}
+	return issue, nil
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/models"
+	"github.com/nexusflow/nexusflow/services/issue-service/internal/repository"
+)
+
+// maxTimesheetRange bounds timesheet queries so a single request stays cheap
+const maxTimesheetRange = 366 * 24 * time.Hour
+
+// AddWorklogInput represents input for logging work on an issue
+type AddWorklogInput struct {
+	IssueID   string
+	UserID    string
+	StartedAt time.Time
+	Duration  int64 // seconds
+	Comment   string
+}
+
+// AddWorklog logs work on an issue and takes it off the remaining estimate
+func (s *IssueService) AddWorklog(ctx context.Context, input AddWorklogInput) (*models.Worklog, error) {
+	if input.UserID == "" {
+		return nil, fmt.Errorf("%w: user_id is required", ErrValidation)
+	}
+	if input.Duration <= 0 {
+		return nil, fmt.Errorf("%w: duration must be positive", ErrValidation)
+	}
+	issue, err := s.repo.GetByID(ctx, input.IssueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue: %w", err)
+	}
+	if issue == nil {
+		return nil, fmt.Errorf("%w: issue %s", ErrNotFound, input.IssueID)
+	}
+
+	worklog := &models.Worklog{
+		IssueID:   issue.ID,
+		ProjectID: issue.ProjectID,
+		UserID:    input.UserID,
+		StartedAt: input.StartedAt,
+		Duration:  input.Duration,
+		Comment:   input.Comment,
+	}
+	if worklog.StartedAt.IsZero() {
+		worklog.StartedAt = time.Now()
+	}
+	updated, err := s.repo.CreateWorklog(ctx, worklog)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add worklog: %w", err)
+	}
+
+	s.publishEvent("worklog.added", issue.ProjectID, input.UserID, worklogPayload(worklog))
+	s.publishTimeTracking(updated, input.UserID)
+	return worklog, nil
+}
+
+// UpdateWorklogInput represents input for editing a worklog; nil fields are left unchanged
+type UpdateWorklogInput struct {
+	ID string
+	// UserID must be the author of the worklog
+	UserID    string
+	StartedAt *time.Time
+	Duration  *int64
+	Comment   *string
+}
+
+// UpdateWorklog edits a worklog; a changed duration is moved onto the
+// remaining estimate as well
+func (s *IssueService) UpdateWorklog(ctx context.Context, input UpdateWorklogInput) (*models.Worklog, error) {
+	worklog, err := s.getOwnWorklog(ctx, input.ID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	previousDuration := worklog.Duration
+	if input.StartedAt != nil {
+		worklog.StartedAt = *input.StartedAt
+	}
+	if input.Duration != nil {
+		if *input.Duration <= 0 {
+			return nil, fmt.Errorf("%w: duration must be positive", ErrValidation)
+		}
+		worklog.Duration = *input.Duration
+	}
+	if input.Comment != nil {
+		worklog.Comment = *input.Comment
+	}
+
+	updated, err := s.repo.UpdateWorklog(ctx, worklog, previousDuration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update worklog: %w", err)
+	}
+
+	s.publishEvent("worklog.updated", worklog.ProjectID, input.UserID, worklogPayload(worklog))
+	if updated != nil {
+		s.publishTimeTracking(updated, input.UserID)
+	}
+	return worklog, nil
+}
+
+// DeleteWorklog deletes a worklog and gives the time it took off the remaining
+// estimate back
+func (s *IssueService) DeleteWorklog(ctx context.Context, id, userID string) error {
+	worklog, err := s.getOwnWorklog(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	updated, err := s.repo.DeleteWorklog(ctx, worklog)
+	if err != nil {
+		return fmt.Errorf("failed to delete worklog: %w", err)
+	}
+
+	s.publishEvent("worklog.deleted", worklog.ProjectID, userID, worklogPayload(worklog))
+	s.publishTimeTracking(updated, userID)
+	return nil
+}
+
+// ListWorklogs lists the worklogs of an issue
+func (s *IssueService) ListWorklogs(ctx context.Context, issueID string) ([]*models.Worklog, error) {
+	return s.repo.ListWorklogs(ctx, issueID)
+}
+
+// TimeTrackingSummary is the time tracking of an issue on its own and rolled
+// up over its sub-tasks, or over the stories and sub-tasks of an epic
+type TimeTrackingSummary struct {
+	Issue  *models.Issue
+	Rollup *models.TimeTracking
+}
+
+// GetTimeTracking gets the time tracking of an issue including its descendants
+func (s *IssueService) GetTimeTracking(ctx context.Context, issueID string) (*TimeTrackingSummary, error) {
+	issue, err := s.repo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue: %w", err)
+	}
+	if issue == nil {
+		return nil, fmt.Errorf("%w: issue %s", ErrNotFound, issueID)
+	}
+	rollup, err := s.repo.GetTimeTrackingRollup(ctx, issueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to roll up time tracking: %w", err)
+	}
+	return &TimeTrackingSummary{Issue: issue, Rollup: rollup}, nil
+}
+
+// Timesheet is the work logged in a date range, with totals per user and issue
+type Timesheet struct {
+	Worklogs     []*models.Worklog
+	TotalSeconds int64
+	ByUser       map[string]int64
+	ByIssue      map[string]int64
+}
+
+// GetTimesheet lists the work logged by a user and/or in a project between
+// from (inclusive) and to (exclusive)
+func (s *IssueService) GetTimesheet(ctx context.Context, filter repository.TimesheetFilter) (*Timesheet, error) {
+	if filter.UserID == "" && filter.ProjectID == "" {
+		return nil, fmt.Errorf("%w: user_id or project_id is required", ErrValidation)
+	}
+	if filter.From.IsZero() || filter.To.IsZero() {
+		return nil, fmt.Errorf("%w: from and to are required", ErrValidation)
+	}
+	if !filter.From.Before(filter.To) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrValidation)
+	}
+	if filter.To.Sub(filter.From) > maxTimesheetRange {
+		return nil, fmt.Errorf("%w: range cannot exceed a year", ErrValidation)
+	}
+
+	worklogs, err := s.repo.ListTimesheet(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list worklogs: %w", err)
+	}
+
+	timesheet := &Timesheet{
+		Worklogs: worklogs,
+		ByUser:   make(map[string]int64),
+		ByIssue:  make(map[string]int64),
+	}
+	for _, w := range worklogs {
+		timesheet.TotalSeconds += w.Duration
+		timesheet.ByUser[w.UserID] += w.Duration
+		timesheet.ByIssue[w.IssueID] += w.Duration
+	}
+	return timesheet, nil
+}
+
+// getOwnWorklog loads a worklog that the user is allowed to change
+func (s *IssueService) getOwnWorklog(ctx context.Context, id, userID string) (*models.Worklog, error) {
+	worklog, err := s.repo.GetWorklog(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get worklog: %w", err)
+	}
+	if worklog == nil {
+		return nil, fmt.Errorf("%w: worklog %s", ErrNotFound, id)
+	}
+	if userID != worklog.UserID {
+		return nil, fmt.Errorf("%w: only the author can change a worklog", ErrPermissionDenied)
+	}
+	return worklog, nil
+}
+
+// publishTimeTracking announces the new version and time tracking of an issue
+// that a worklog changed
+func (s *IssueService) publishTimeTracking(issue *models.Issue, userID string) {
+	s.publishEvent("issue.updated", issue.ProjectID, userID, map[string]interface{}{
+		"issue_id":           issue.ID,
+		"key":                issue.Key,
+		"version":            issue.Version,
+		"time_spent":         issue.TimeSpent,
+		"remaining_estimate": issue.RemainingEstimate,
+	})
+}
+
+func worklogPayload(w *models.Worklog) map[string]interface{} {
+	return map[string]interface{}{
+		"worklog_id": w.ID,
+		"issue_id":   w.IssueID,
+		"user_id":    w.UserID,
+		"started_at": w.StartedAt,
+		"duration":   w.Duration,
+	}
+}
+
+// validateEstimate checks an estimate in seconds
+func validateEstimate(name string, seconds int64) error {
+	if seconds < 0 {
+		return fmt.Errorf("%w: %s cannot be negative", ErrValidation, name)
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+DROP TRIGGER IF EXISTS update_custom_field_contexts_updated_at ON custom_field_contexts;
+DROP TABLE IF EXISTS custom_field_contexts;
+
+DROP INDEX IF EXISTS idx_custom_fields_org_name;
+DROP INDEX IF EXISTS idx_custom_fields_organization_id;
+
+DELETE FROM custom_fields WHERE project_id IS NULL;
+ALTER TABLE custom_fields DROP CONSTRAINT IF EXISTS custom_fields_scope_check;
+ALTER TABLE custom_fields ALTER COLUMN project_id SET NOT NULL;
+ALTER TABLE custom_fields DROP COLUMN IF EXISTS organization_id;
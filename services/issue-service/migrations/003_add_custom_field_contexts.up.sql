@@ -0,0 +1,31 @@
+-- Organization-level custom fields, shared across projects through contexts
+ALTER TABLE custom_fields ADD COLUMN IF NOT EXISTS organization_id UUID;
+ALTER TABLE custom_fields ALTER COLUMN project_id DROP NOT NULL;
+ALTER TABLE custom_fields ADD CONSTRAINT custom_fields_scope_check
+    CHECK (project_id IS NOT NULL OR organization_id IS NOT NULL);
+
+CREATE INDEX IF NOT EXISTS idx_custom_fields_organization_id ON custom_fields(organization_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_custom_fields_org_name
+    ON custom_fields(organization_id, name) WHERE project_id IS NULL;
+
+-- Custom Field Contexts table
+CREATE TABLE IF NOT EXISTS custom_field_contexts (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    field_id UUID NOT NULL REFERENCES custom_fields(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    project_ids UUID[] NOT NULL DEFAULT '{}', -- empty applies to every project in the organization
+    issue_types VARCHAR(50)[] NOT NULL DEFAULT '{}', -- empty applies to every issue type
+    options JSONB, -- overrides the field's options
+    default_value JSONB, -- overrides the field's default
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(field_id, name)
+);
+
+CREATE INDEX idx_custom_field_contexts_field_id ON custom_field_contexts(field_id);
+CREATE INDEX idx_custom_field_contexts_project_ids ON custom_field_contexts USING GIN (project_ids);
+
+CREATE TRIGGER update_custom_field_contexts_updated_at
+    BEFORE UPDATE ON custom_field_contexts
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS update_components_updated_at ON components;
+DROP TRIGGER IF EXISTS update_labels_updated_at ON labels;
+
+DROP TABLE IF EXISTS issue_components;
+DROP TABLE IF EXISTS issue_labels;
+DROP TABLE IF EXISTS components;
+DROP TABLE IF EXISTS labels;
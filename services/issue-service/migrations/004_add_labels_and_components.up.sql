@@ -0,0 +1,58 @@
+-- Labels table
+CREATE TABLE IF NOT EXISTS labels (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    color VARCHAR(7), -- hex color code, e.g. #0052CC
+    description TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(project_id, name)
+);
+
+CREATE INDEX idx_labels_project_id ON labels(project_id);
+
+-- Components table
+CREATE TABLE IF NOT EXISTS components (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    lead_id UUID, -- Reference to users table
+    default_assignee_id UUID, -- Assigned to new unassigned issues in this component
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(project_id, name)
+);
+
+CREATE INDEX idx_components_project_id ON components(project_id);
+
+-- Issue Labels table
+CREATE TABLE IF NOT EXISTS issue_labels (
+    issue_id UUID NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    label_id UUID NOT NULL REFERENCES labels(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (issue_id, label_id)
+);
+
+CREATE INDEX idx_issue_labels_label_id ON issue_labels(label_id);
+
+-- Issue Components table
+CREATE TABLE IF NOT EXISTS issue_components (
+    issue_id UUID NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    component_id UUID NOT NULL REFERENCES components(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (issue_id, component_id)
+);
+
+CREATE INDEX idx_issue_components_component_id ON issue_components(component_id);
+
+CREATE TRIGGER update_labels_updated_at
+    BEFORE UPDATE ON labels
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_components_updated_at
+    BEFORE UPDATE ON components
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
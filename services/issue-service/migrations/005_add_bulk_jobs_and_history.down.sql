@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS update_bulk_jobs_updated_at ON bulk_jobs;
+
+DROP TABLE IF EXISTS issue_history;
+DROP TABLE IF EXISTS bulk_jobs;
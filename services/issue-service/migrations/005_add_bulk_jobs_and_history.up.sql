@@ -0,0 +1,40 @@
+-- Bulk jobs table
+CREATE TABLE IF NOT EXISTS bulk_jobs (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID,
+    status VARCHAR(50) NOT NULL DEFAULT 'pending', -- pending, running, completed, completed_with_errors, failed
+    issue_ids UUID[] NOT NULL DEFAULT '{}', -- resolved when the job is created
+    changes JSONB NOT NULL DEFAULT '{}',
+    total INTEGER NOT NULL DEFAULT 0,
+    processed INTEGER NOT NULL DEFAULT 0,
+    succeeded INTEGER NOT NULL DEFAULT 0,
+    failed INTEGER NOT NULL DEFAULT 0,
+    errors JSONB NOT NULL DEFAULT '[]', -- [{issue_id, message}]
+    error TEXT, -- set when the whole job failed
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    started_at TIMESTAMP WITH TIME ZONE,
+    finished_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_bulk_jobs_status ON bulk_jobs(status);
+
+-- Issue history table
+CREATE TABLE IF NOT EXISTS issue_history (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    issue_id UUID NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    field VARCHAR(100) NOT NULL,
+    old_value TEXT,
+    new_value TEXT,
+    changed_by UUID,
+    job_id UUID REFERENCES bulk_jobs(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_issue_history_issue_id ON issue_history(issue_id, created_at);
+
+CREATE TRIGGER update_bulk_jobs_updated_at
+    BEFORE UPDATE ON bulk_jobs
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_issues_deleted_root_id;
+DROP INDEX IF EXISTS idx_issues_trash;
+
+ALTER TABLE issues DROP COLUMN IF EXISTS deleted_root_id;
+ALTER TABLE issues DROP COLUMN IF EXISTS deleted_by;
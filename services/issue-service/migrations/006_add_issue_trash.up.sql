@@ -0,0 +1,7 @@
+-- Deleting an issue also deletes its sub-tasks; deleted_root_id points at the
+-- issue the delete started from so they can be restored together
+ALTER TABLE issues ADD COLUMN IF NOT EXISTS deleted_by UUID;
+ALTER TABLE issues ADD COLUMN IF NOT EXISTS deleted_root_id UUID;
+
+CREATE INDEX idx_issues_trash ON issues(project_id, deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_issues_deleted_root_id ON issues(deleted_root_id) WHERE deleted_root_id IS NOT NULL;
@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS update_worklogs_updated_at ON worklogs;
+
+DROP TABLE IF EXISTS worklogs;
+
+ALTER TABLE issues DROP COLUMN IF EXISTS time_spent;
+ALTER TABLE issues DROP COLUMN IF EXISTS remaining_estimate;
+ALTER TABLE issues DROP COLUMN IF EXISTS original_estimate;
@@ -0,0 +1,26 @@
+-- Time tracking, all durations in seconds
+ALTER TABLE issues ADD COLUMN IF NOT EXISTS original_estimate BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE issues ADD COLUMN IF NOT EXISTS remaining_estimate BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE issues ADD COLUMN IF NOT EXISTS time_spent BIGINT NOT NULL DEFAULT 0;
+
+-- Worklogs table
+CREATE TABLE IF NOT EXISTS worklogs (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    issue_id UUID NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    project_id UUID NOT NULL, -- copied from the issue for timesheet queries
+    user_id UUID NOT NULL,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    duration BIGINT NOT NULL CHECK (duration > 0),
+    comment TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_worklogs_issue_id ON worklogs(issue_id);
+CREATE INDEX idx_worklogs_user_started_at ON worklogs(user_id, started_at);
+CREATE INDEX idx_worklogs_project_started_at ON worklogs(project_id, started_at);
+
+CREATE TRIGGER update_worklogs_updated_at
+    BEFORE UPDATE ON worklogs
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
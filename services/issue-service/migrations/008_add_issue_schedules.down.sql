@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_issues_schedule_occurrence;
+ALTER TABLE issues DROP COLUMN IF EXISTS scheduled_for;
+ALTER TABLE issues DROP COLUMN IF EXISTS schedule_id;
+
+DROP TRIGGER IF EXISTS update_issue_schedules_updated_at ON issue_schedules;
+
+DROP TABLE IF EXISTS issue_schedules;
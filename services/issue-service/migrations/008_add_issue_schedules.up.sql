@@ -0,0 +1,38 @@
+-- Issue schedules: blueprints that create issues on a recurrence rule
+CREATE TABLE IF NOT EXISTS issue_schedules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    summary VARCHAR(500) NOT NULL,
+    description TEXT,
+    type VARCHAR(50) NOT NULL DEFAULT 'task',
+    priority VARCHAR(50) NOT NULL DEFAULT 'medium',
+    assignee_id UUID,
+    custom_fields JSONB,
+    rrule TEXT NOT NULL,
+    timezone VARCHAR(100) NOT NULL DEFAULT 'UTC',
+    starts_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ends_at TIMESTAMP WITH TIME ZONE,
+    catch_up VARCHAR(20) NOT NULL DEFAULT 'latest',
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    next_run_at TIMESTAMP WITH TIME ZONE,
+    last_run_at TIMESTAMP WITH TIME ZONE,
+    last_error TEXT,
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_issue_schedules_project_id ON issue_schedules(project_id);
+CREATE INDEX idx_issue_schedules_next_run_at ON issue_schedules(next_run_at) WHERE enabled AND next_run_at IS NOT NULL;
+
+CREATE TRIGGER update_issue_schedules_updated_at
+    BEFORE UPDATE ON issue_schedules
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Issues created by a schedule link back to it; one issue per occurrence
+ALTER TABLE issues ADD COLUMN IF NOT EXISTS schedule_id UUID REFERENCES issue_schedules(id) ON DELETE SET NULL;
+ALTER TABLE issues ADD COLUMN IF NOT EXISTS scheduled_for TIMESTAMP WITH TIME ZONE;
+
+CREATE UNIQUE INDEX idx_issues_schedule_occurrence ON issues(schedule_id, scheduled_for) WHERE schedule_id IS NOT NULL;
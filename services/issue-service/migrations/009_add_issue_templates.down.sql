@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_issue_templates_updated_at ON issue_templates;
+
+DROP TABLE IF EXISTS issue_templates;
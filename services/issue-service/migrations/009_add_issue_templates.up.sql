@@ -0,0 +1,25 @@
+-- Issue templates pre-fill new issues of a type in a project
+CREATE TABLE IF NOT EXISTS issue_templates (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    issue_type VARCHAR(50) NOT NULL,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    description TEXT,
+    priority VARCHAR(50),
+    label_ids UUID[],
+    custom_fields JSONB,
+    sub_tasks JSONB,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(project_id, name)
+);
+
+CREATE INDEX idx_issue_templates_project_id ON issue_templates(project_id);
+-- At most one default template per issue type in a project
+CREATE UNIQUE INDEX idx_issue_templates_default ON issue_templates(project_id, issue_type) WHERE is_default;
+
+CREATE TRIGGER update_issue_templates_updated_at
+    BEFORE UPDATE ON issue_templates
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
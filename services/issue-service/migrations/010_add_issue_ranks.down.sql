@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_issues_rank;
+ALTER TABLE issues DROP COLUMN IF EXISTS rank;
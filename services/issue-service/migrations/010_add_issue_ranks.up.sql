@@ -0,0 +1,13 @@
+-- Backlog rank: issues of a project sort by rank, compared byte-wise
+ALTER TABLE issues ADD COLUMN IF NOT EXISTS rank TEXT COLLATE "C" NOT NULL DEFAULT '';
+
+-- Existing issues are ranked by creation. The trailing digit keeps ranks from
+-- ending in zero, which ranks placed between them rely on.
+UPDATE issues AS i SET rank = r.rank
+FROM (
+    SELECT id, lpad(row_number() OVER (PARTITION BY project_id ORDER BY created_at, id)::text, 10, '0') || 'i' AS rank
+    FROM issues
+) AS r
+WHERE i.id = r.id;
+
+CREATE INDEX idx_issues_rank ON issues(project_id, rank, id);
@@ -0,0 +1,2 @@
+ALTER TABLE bulk_jobs DROP COLUMN IF EXISTS lease_expires_at;
+ALTER TABLE bulk_jobs DROP COLUMN IF EXISTS owner;
@@ -0,0 +1,4 @@
+-- Bulk jobs run in the process that created them, which holds a lease on the
+-- job while it runs. Jobs whose lease expires were interrupted.
+ALTER TABLE bulk_jobs ADD COLUMN IF NOT EXISTS owner TEXT;
+ALTER TABLE bulk_jobs ADD COLUMN IF NOT EXISTS lease_expires_at TIMESTAMP WITH TIME ZONE;
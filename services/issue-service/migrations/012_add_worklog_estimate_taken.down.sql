@@ -0,0 +1 @@
+ALTER TABLE worklogs DROP COLUMN IF EXISTS estimate_taken;
@@ -0,0 +1,3 @@
+-- How much of the issue's remaining estimate each worklog took off, in
+-- seconds, so removing the worklog gives back no more than that
+ALTER TABLE worklogs ADD COLUMN IF NOT EXISTS estimate_taken BIGINT NOT NULL DEFAULT 0;
@@ -1,19 +1,22 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
-	"github.com/gorilla/websocket"
+	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
@@ -21,22 +24,18 @@ import (
 
 	"github.com/nexusflow/nexusflow/pkg/config"
 	"github.com/nexusflow/nexusflow/pkg/database"
+	"github.com/nexusflow/nexusflow/pkg/kafka"
 	"github.com/nexusflow/nexusflow/pkg/logger"
 	pb "github.com/nexusflow/nexusflow/pkg/proto/notification/v1"
 	"github.com/nexusflow/nexusflow/services/notification-service/internal/handler"
 	"github.com/nexusflow/nexusflow/services/notification-service/internal/repository"
+	"github.com/nexusflow/nexusflow/services/notification-service/internal/secret"
 	"github.com/nexusflow/nexusflow/services/notification-service/internal/service"
 	ws "github.com/nexusflow/nexusflow/services/notification-service/internal/websocket"
 )
 
 const serviceName = "notification-service"
 
-var upgrader = websocket.Upgrader{
-	CheckOrigin: func(r *http.Request) bool {
-		return true // Allow all origins for development
-	},
-}
-
 func main() {
 	// Initialize logger
 	log, err := logger.NewDefault(serviceName)
@@ -79,15 +78,96 @@ func main() {
 		log.Sugar().Fatal("Failed to run migrations")
 	}
 
-	// Initialize WebSocket hub
-	hub := ws.NewHub(log)
-	go hub.Run()
+	// Initialize WebSocket hub; the backplane fans messages out to every instance
+	hostname, _ := os.Hostname()
+	instanceID := hostname + "-" + uuid.New().String()[:8]
+	backplane, err := newBackplane(cfg, instanceID)
+	if err != nil {
+		log.Sugar().Fatalw("Failed to create WebSocket backplane", "error", err)
+	}
+	defer backplane.Close()
+	hub := ws.NewHub(log, backplane, instanceID)
+	hubCtx, stopHub := context.WithCancel(context.Background())
+	defer stopHub()
+	go hub.Run(hubCtx)
+	log.Sugar().Infow("WebSocket hub started", "instance_id", instanceID)
 
 	// Initialize layers
 	repo := repository.NewNotificationRepository(db, log)
-	svc := service.NewNotificationService(repo, hub, log)
+	projectServiceAddr := "127.0.0.1:50053"  // Default
+	issueServiceAddr := "127.0.0.1:50054"    // Default
+	boardServiceAddr := "127.0.0.1:50056"    // Default
+	workflowServiceAddr := "127.0.0.1:50055" // Default
+	orgServiceAddr := "127.0.0.1:50052"      // Default
+	// Links in Slack and Teams messages point at the web app
+	appURL := cfg.GetString("notifications.app_url")
+	if appURL == "" {
+		appURL = "http://localhost:3000"
+	}
+	// Slack and Teams webhook URLs and tokens are encrypted with this key
+	var secrets *secret.Box
+	if key := cfg.GetString("notifications.secret_key"); key != "" {
+		if secrets, err = secret.NewBox(key); err != nil {
+			log.Sugar().Fatalw("Invalid notifications.secret_key", "error", err)
+		}
+	} else {
+		log.Sugar().Warnw("No notifications.secret_key configured, chat channels are disabled")
+	}
+	svc, err := service.NewNotificationService(repo, hub, log, projectServiceAddr, issueServiceAddr, boardServiceAddr, workflowServiceAddr, orgServiceAddr, appURL, secrets)
+	if err != nil {
+		log.Sugar().Fatalw("Failed to create notification service", "error", err)
+	}
+	if secrets != nil {
+		if err := svc.SealStoredSecrets(context.Background()); err != nil {
+			log.Sugar().Errorw("Failed to encrypt stored chat channel secrets", "error", err)
+		}
+	}
+	// Topic subscriptions are allowed for members of the topic's project
+	hub.SetAuthorizer(svc)
+	// Reconnecting clients get the notifications they missed
+	hub.SetReplayer(svc)
 	h := handler.NewNotificationHandler(svc, log)
 
+	// Snoozed notifications return to the inbox when their snooze ends, and read
+	// notifications are purged after the retention period
+	snoozePoll := cfg.GetInt("notifications.snooze_poll_seconds")
+	if snoozePoll <= 0 {
+		snoozePoll = 60
+	}
+	retentionDays := cfg.GetInt("notifications.retention_days")
+	if retentionDays <= 0 {
+		retentionDays = 90
+	}
+	purgeInterval := cfg.GetInt("notifications.purge_interval_minutes")
+	if purgeInterval <= 0 {
+		purgeInterval = 60
+	}
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	go svc.RunSnoozeWaker(jobsCtx, time.Duration(snoozePoll)*time.Second)
+	go svc.RunRetentionPurge(jobsCtx, time.Duration(retentionDays)*24*time.Hour, time.Duration(purgeInterval)*time.Minute)
+
+	// Consume the events that notify users and feed the WebSocket topics
+	kafkaCfg := cfg.GetKafka()
+	consumer, err := kafka.NewEventConsumer(kafka.ConsumerConfig{
+		Brokers:       kafkaCfg.Brokers,
+		ConsumerGroup: kafkaCfg.ConsumerGroup,
+		Topics:        []string{"issue-events", "comment-events", "sprint-events", "automation-events", "board-events"},
+	}, svc.HandleEvent)
+	if err != nil {
+		log.Sugar().Warnw("Failed to create Kafka consumer, continuing without events", "error", err)
+	} else {
+		defer consumer.Close()
+		consumerCtx, stopConsumer := context.WithCancel(context.Background())
+		defer stopConsumer()
+		go func() {
+			if err := consumer.Start(consumerCtx); err != nil && !errors.Is(err, context.Canceled) {
+				log.Sugar().Errorw("Kafka consumer stopped", "error", err)
+			}
+		}()
+		log.Sugar().Infow("Kafka consumer initialized")
+	}
+
 	// Create gRPC server
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
@@ -134,36 +214,18 @@ func main() {
 	}
 	httpAddr := fmt.Sprintf("%s:%d", serverCfg.Host, httpPort)
 
-	http.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
-		userID := r.URL.Query().Get("user_id")
-		if userID == "" {
-			http.Error(w, "user_id required", http.StatusBadRequest)
-			return
-		}
-
-		conn, err := upgrader.Upgrade(w, r, nil)
-		if err != nil {
-			log.Sugar().Errorw("Failed to upgrade connection", "error", err)
-			return
-		}
-
-		client := &ws.Client{
-			UserID: userID,
-			Conn:   conn,
-		}
-
-		hub.Register(client)
-
-		// Keep connection alive
-		defer hub.Unregister(client)
-
-		for {
-			_, _, err := conn.ReadMessage()
-			if err != nil {
-				break
-			}
+	// Sockets authenticate with an access token checked against Hydra
+	hydraAdminURL := cfg.GetOry().HydraAdminURL
+	if hydraAdminURL == "" {
+		hydraAdminURL = "http://localhost:4445"
+	}
+	var allowedOrigins []string
+	for _, origin := range strings.Split(cfg.GetString("websocket.allowed_origins"), ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			allowedOrigins = append(allowedOrigins, origin)
 		}
-	})
+	}
+	http.Handle("/ws", ws.NewHandler(hub, ws.NewHydraAuthenticator(hydraAdminURL), allowedOrigins, log))
 
 	// Start HTTP server in goroutine
 	go func() {
@@ -186,6 +248,26 @@ func main() {
 	log.Sugar().Infow("Server stopped")
 }
 
+// newBackplane creates the backplane named by websocket.backplane: "memory" for a
+// single instance, "redis" for Redis pub/sub or "kafka" for a fan-out topic
+func newBackplane(cfg *config.Config, instanceID string) (ws.Backplane, error) {
+	channel := cfg.GetString("websocket.channel")
+	if channel == "" {
+		channel = "notification-fanout"
+	}
+	switch kind := cfg.GetString("websocket.backplane"); kind {
+	case "", "memory":
+		return ws.NewMemoryBackplane(), nil
+	case "redis":
+		return ws.NewRedisBackplane(cfg.GetRedis(), channel), nil
+	case "kafka":
+		kafkaCfg := cfg.GetKafka()
+		return ws.NewKafkaBackplane(kafkaCfg.Brokers, channel, kafkaCfg.ConsumerGroup+"-fanout", instanceID)
+	default:
+		return nil, fmt.Errorf("unknown websocket backplane %q", kind)
+	}
+}
+
 // runMigrations runs database migrations
 func runMigrations(db *sql.DB, log *logger.Logger) error {
 	driver, err := postgres.WithInstance(db, &postgres.Config{
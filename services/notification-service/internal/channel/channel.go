@@ -0,0 +1,205 @@
+// Package channel delivers notifications to chat tools such as Slack and
+// Microsoft Teams.
+package channel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// Channel kinds
+const (
+	KindSlackWebhook = "slack_webhook"
+	KindSlackBot     = "slack_bot"
+	KindTeamsWebhook = "teams_webhook"
+)
+
+// sendTimeout bounds a single delivery to a chat tool
+const sendTimeout = 10 * time.Second
+
+// ErrInvalidConfig is returned for channel settings that cannot be used
+var ErrInvalidConfig = errors.New("invalid channel config")
+
+// ErrBlockedAddress is returned when a webhook resolves to an address inside
+// the network, such as a loopback, private or link-local one
+var ErrBlockedAddress = errors.New("webhook address is not allowed")
+
+// StatusError is returned when a chat tool answers with an error status. Body
+// holds the start of the answer for logs; it is left out of Error so that a
+// webhook cannot be used to read other services' responses through the API.
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d", e.StatusCode)
+}
+
+// Message is a chat message about an event, formatted by each channel for its tool
+type Message struct {
+	EventType  string
+	Title      string // e.g. "Issue updated"
+	IssueKey   string // e.g. "WEB-12"
+	Summary    string
+	StatusFrom string // Status names when the event changed the status
+	StatusTo   string
+	Text       string // Extra detail, e.g. the notification message
+	URL        string // Absolute link to what the message is about
+}
+
+// Heading is the message's first line, e.g. "WEB-12 Fix login"
+func (m *Message) Heading() string {
+	switch {
+	case m.IssueKey != "" && m.Summary != "":
+		return m.IssueKey + " " + m.Summary
+	case m.IssueKey != "":
+		return m.IssueKey
+	default:
+		return m.Summary
+	}
+}
+
+// StatusChange describes a status change, e.g. "To Do → In Progress"
+func (m *Message) StatusChange() string {
+	if m.StatusTo == "" {
+		return ""
+	}
+	from := m.StatusFrom
+	if from == "" {
+		from = "None"
+	}
+	return from + " → " + m.StatusTo
+}
+
+// Channel sends messages to one destination in a chat tool
+type Channel interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// Config holds the settings of a channel. Webhook kinds need WebhookURL; a
+// Slack bot needs BotToken and the Slack channel to post to.
+type Config struct {
+	Kind         string
+	WebhookURL   string
+	BotToken     string
+	SlackChannel string
+}
+
+// Validate checks that the settings needed by the kind are present
+func (c Config) Validate() error {
+	switch c.Kind {
+	case KindSlackWebhook, KindTeamsWebhook:
+		u, err := url.Parse(c.WebhookURL)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("%w: webhook_url must be an https URL", ErrInvalidConfig)
+		}
+	case KindSlackBot:
+		if c.BotToken == "" || c.SlackChannel == "" {
+			return fmt.Errorf("%w: bot_token and slack_channel are required", ErrInvalidConfig)
+		}
+	default:
+		return fmt.Errorf("%w: unknown kind %q", ErrInvalidConfig, c.Kind)
+	}
+	return nil
+}
+
+// NewClient returns the HTTP client channels send with. It only connects to
+// public addresses, checked when dialling so that DNS cannot be used to point
+// a webhook back into the network, and does not follow redirects.
+func NewClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: sendTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !isPublic(ip) {
+				return fmt.Errorf("%w: %s", ErrBlockedAddress, host)
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: sendTimeout,
+		Transport: &http.Transport{
+			// No proxy, the address check must apply to the chat tool itself
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: sendTimeout,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// sharedAddressSpace is the carrier-grade NAT range, not covered by IsPrivate
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// isPublic reports whether ip is an address on the internet
+func isPublic(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() || sharedAddressSpace.Contains(ip))
+}
+
+// New creates the channel described by cfg; a nil client uses NewClient
+func New(cfg Config, client *http.Client) (Channel, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if client == nil {
+		client = NewClient()
+	}
+	switch cfg.Kind {
+	case KindSlackWebhook:
+		return &SlackWebhook{url: cfg.WebhookURL, client: client}, nil
+	case KindSlackBot:
+		return &SlackBot{apiURL: slackAPIURL, token: cfg.BotToken, channel: cfg.SlackChannel, client: client}, nil
+	default:
+		return &TeamsWebhook{url: cfg.WebhookURL, client: client}, nil
+	}
+}
+
+// postJSON posts body as JSON and returns the response body, failing on
+// non-2xx statuses
+func postJSON(ctx context.Context, client *http.Client, target string, header http.Header, body interface{}) ([]byte, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("encode message: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("post message: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body := bytes.TrimSpace(respBody)
+		if len(body) > 512 {
+			body = body[:512]
+		}
+		return nil, fmt.Errorf("post message: %w", &StatusError{StatusCode: resp.StatusCode, Body: string(body)})
+	}
+	return respBody, nil
+}
@@ -0,0 +1,203 @@
+package channel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// stub records the requests a chat tool would receive and answers them
+type stub struct {
+	status int
+	reply  string
+	header http.Header
+	body   map[string]interface{}
+}
+
+func startStub(t *testing.T, status int, reply string) (*stub, *httptest.Server) {
+	t.Helper()
+	s := &stub{status: status, reply: reply}
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.header = r.Header.Clone()
+		data, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(data, &s.body); err != nil {
+			t.Errorf("request body is not JSON: %v", err)
+		}
+		w.WriteHeader(s.status)
+		io.WriteString(w, s.reply)
+	}))
+	t.Cleanup(srv.Close)
+	return s, srv
+}
+
+// encode re-encodes part of a request without escaping HTML, for matching
+func encode(v interface{}) string {
+	var buf strings.Builder
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(v)
+	return buf.String()
+}
+
+func statusChange() *Message {
+	return &Message{
+		EventType:  "issue.updated",
+		Title:      "Issue updated",
+		IssueKey:   "WEB-12",
+		Summary:    "Fix <login> & signup",
+		StatusFrom: "To Do",
+		StatusTo:   "In Progress",
+		URL:        "https://app.example.com/issues/i1",
+	}
+}
+
+func TestSlackWebhookSendsBlocks(t *testing.T) {
+	s, srv := startStub(t, http.StatusOK, "ok")
+	ch, err := New(Config{Kind: KindSlackWebhook, WebhookURL: srv.URL}, srv.Client())
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	if err := ch.Send(context.Background(), statusChange()); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+
+	if got := s.body["text"]; got != "Issue updated: WEB-12 Fix <login> & signup" {
+		t.Fatalf("unexpected fallback text %q", got)
+	}
+	blocks := encode(s.body["blocks"])
+	for _, want := range []string{
+		"<https://app.example.com/issues/i1|WEB-12 Fix &lt;login&gt; &amp; signup>",
+		`*Status*\nTo Do → In Progress`,
+	} {
+		if !strings.Contains(blocks, want) {
+			t.Errorf("blocks %s do not contain %q", blocks, want)
+		}
+	}
+}
+
+func TestSlackBotUsesTokenAndReportsAPIErrors(t *testing.T) {
+	s, srv := startStub(t, http.StatusOK, `{"ok":true}`)
+	ch, err := New(Config{Kind: KindSlackBot, BotToken: "xoxb-1", SlackChannel: "#web"}, srv.Client())
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	ch.(*SlackBot).apiURL = srv.URL
+
+	if err := ch.Send(context.Background(), statusChange()); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	if got := s.header.Get("Authorization"); got != "Bearer xoxb-1" {
+		t.Fatalf("unexpected authorization %q", got)
+	}
+	if s.body["channel"] != "#web" {
+		t.Fatalf("posted to %v, want #web", s.body["channel"])
+	}
+
+	// Slack answers 200 with ok=false when the bot cannot post
+	s.reply = `{"ok":false,"error":"not_in_channel"}`
+	if err := ch.Send(context.Background(), statusChange()); err == nil || !strings.Contains(err.Error(), "not_in_channel") {
+		t.Fatalf("expected not_in_channel error, got %v", err)
+	}
+}
+
+func TestTeamsWebhookSendsAdaptiveCard(t *testing.T) {
+	s, srv := startStub(t, http.StatusAccepted, "")
+	ch, err := New(Config{Kind: KindTeamsWebhook, WebhookURL: srv.URL}, srv.Client())
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	if err := ch.Send(context.Background(), statusChange()); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+
+	attachments, _ := s.body["attachments"].([]interface{})
+	if len(attachments) != 1 {
+		t.Fatalf("expected one attachment, got %v", s.body)
+	}
+	attachment := attachments[0].(map[string]interface{})
+	if attachment["contentType"] != "application/vnd.microsoft.card.adaptive" {
+		t.Fatalf("unexpected content type %v", attachment["contentType"])
+	}
+	card := encode(attachment["content"])
+	for _, want := range []string{
+		`"text":"WEB-12 Fix <login> & signup"`,
+		`{"title":"Status","value":"To Do → In Progress"}`,
+		`"url":"https://app.example.com/issues/i1"`,
+	} {
+		if !strings.Contains(card, want) {
+			t.Errorf("card %s does not contain %s", card, want)
+		}
+	}
+}
+
+func TestSendFailsOnErrorStatus(t *testing.T) {
+	_, srv := startStub(t, http.StatusNotFound, "no_service")
+	ch, err := New(Config{Kind: KindSlackWebhook, WebhookURL: srv.URL}, srv.Client())
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	err = ch.Send(context.Background(), statusChange())
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusNotFound || statusErr.Body != "no_service" {
+		t.Fatalf("expected 404 error, got %v", err)
+	}
+	// What the webhook answered is for logs only
+	if strings.Contains(err.Error(), "no_service") {
+		t.Fatalf("error %q echoes the response body", err)
+	}
+}
+
+func TestDefaultClientRefusesInternalAddresses(t *testing.T) {
+	s, srv := startStub(t, http.StatusOK, "ok")
+	ch, err := New(Config{Kind: KindSlackWebhook, WebhookURL: srv.URL}, nil)
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	if err := ch.Send(context.Background(), statusChange()); !errors.Is(err, ErrBlockedAddress) {
+		t.Fatalf("expected blocked address, got %v", err)
+	}
+	if s.body != nil {
+		t.Fatalf("request reached %s", srv.URL)
+	}
+}
+
+func TestIsPublic(t *testing.T) {
+	for addr, want := range map[string]bool{
+		"1.1.1.1":         true,
+		"2606:4700::1111": true,
+		"127.0.0.1":       false,
+		"::1":             false,
+		"10.1.2.3":        false,
+		"172.16.0.1":      false,
+		"192.168.1.1":     false,
+		"169.254.169.254": false,
+		"fe80::1":         false,
+		"100.64.0.1":      false,
+		"0.0.0.0":         false,
+		"::ffff:10.0.0.1": false,
+	} {
+		if got := isPublic(net.ParseIP(addr)); got != want {
+			t.Errorf("isPublic(%s) = %v, want %v", addr, got, want)
+		}
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	for _, cfg := range []Config{
+		{Kind: "email"},
+		{Kind: KindSlackWebhook},
+		{Kind: KindTeamsWebhook, WebhookURL: "ftp://example.com/hook"},
+		{Kind: KindTeamsWebhook, WebhookURL: "http://example.com/hook"},
+		{Kind: KindSlackBot, BotToken: "xoxb-1"},
+	} {
+		if err := cfg.Validate(); !errors.Is(err, ErrInvalidConfig) {
+			t.Errorf("%+v: expected invalid config, got %v", cfg, err)
+		}
+	}
+}
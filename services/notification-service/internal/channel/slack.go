@@ -0,0 +1,101 @@
+package channel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// slackAPIURL is Slack's Web API method for posting messages
+const slackAPIURL = "https://slack.com/api/chat.postMessage"
+
+// ErrSlackAPI is returned with the error code when Slack refuses a bot's post,
+// e.g. "slack: not_in_channel"
+var ErrSlackAPI = errors.New("slack")
+
+// SlackWebhook posts to the Slack channel an incoming webhook was created for
+type SlackWebhook struct {
+	url    string
+	client *http.Client
+}
+
+func (c *SlackWebhook) Send(ctx context.Context, msg *Message) error {
+	_, err := postJSON(ctx, c.client, c.url, nil, slackPayload(msg))
+	return err
+}
+
+// SlackBot posts with a bot token to a channel the bot has been added to
+type SlackBot struct {
+	apiURL  string
+	token   string
+	channel string
+	client  *http.Client
+}
+
+func (c *SlackBot) Send(ctx context.Context, msg *Message) error {
+	payload := slackPayload(msg)
+	payload["channel"] = c.channel
+	header := http.Header{"Authorization": {"Bearer " + c.token}}
+	body, err := postJSON(ctx, c.client, c.apiURL, header, payload)
+	if err != nil {
+		return err
+	}
+
+	// The Web API answers 200 with ok=false for failures such as a bad token
+	var result struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("decode slack response: %w", err)
+	}
+	if !result.OK {
+		return fmt.Errorf("%w: %s", ErrSlackAPI, result.Error)
+	}
+	return nil
+}
+
+// slackPayload renders a message as Block Kit blocks. The text is the fallback
+// shown in notifications and by clients without block support.
+func slackPayload(msg *Message) map[string]interface{} {
+	heading := slackEscape(msg.Heading())
+	if msg.URL != "" && heading != "" {
+		heading = fmt.Sprintf("<%s|%s>", msg.URL, heading)
+	}
+
+	lines := []string{"*" + slackEscape(msg.Title) + "*"}
+	if heading != "" {
+		lines = append(lines, heading)
+	}
+	if msg.Text != "" {
+		lines = append(lines, slackEscape(msg.Text))
+	}
+	blocks := []interface{}{
+		map[string]interface{}{
+			"type": "section",
+			"text": map[string]interface{}{"type": "mrkdwn", "text": strings.Join(lines, "\n")},
+		},
+	}
+
+	var fields []interface{}
+	if change := msg.StatusChange(); change != "" {
+		fields = append(fields, map[string]interface{}{"type": "mrkdwn", "text": "*Status*\n" + slackEscape(change)})
+	}
+	if len(fields) > 0 {
+		blocks = append(blocks, map[string]interface{}{"type": "section", "fields": fields})
+	}
+
+	text := msg.Title
+	if h := msg.Heading(); h != "" {
+		text += ": " + h
+	}
+	return map[string]interface{}{"text": text, "blocks": blocks}
+}
+
+// slackEscape escapes the characters Slack treats as markup
+func slackEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
@@ -0,0 +1,56 @@
+package channel
+
+import (
+	"context"
+	"net/http"
+)
+
+// TeamsWebhook posts Adaptive Cards to a Microsoft Teams channel through an
+// incoming webhook or a Workflows webhook
+type TeamsWebhook struct {
+	url    string
+	client *http.Client
+}
+
+func (c *TeamsWebhook) Send(ctx context.Context, msg *Message) error {
+	_, err := postJSON(ctx, c.client, c.url, nil, teamsPayload(msg))
+	return err
+}
+
+func teamsPayload(msg *Message) map[string]interface{} {
+	body := []interface{}{
+		map[string]interface{}{"type": "TextBlock", "text": msg.Title, "weight": "Bolder", "size": "Medium", "wrap": true},
+	}
+	if heading := msg.Heading(); heading != "" {
+		body = append(body, map[string]interface{}{"type": "TextBlock", "text": heading, "wrap": true})
+	}
+	if msg.Text != "" {
+		body = append(body, map[string]interface{}{"type": "TextBlock", "text": msg.Text, "wrap": true, "isSubtle": true})
+	}
+
+	var facts []interface{}
+	if change := msg.StatusChange(); change != "" {
+		facts = append(facts, map[string]interface{}{"title": "Status", "value": change})
+	}
+	if len(facts) > 0 {
+		body = append(body, map[string]interface{}{"type": "FactSet", "facts": facts})
+	}
+
+	card := map[string]interface{}{
+		"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+		"type":    "AdaptiveCard",
+		"version": "1.4",
+		"body":    body,
+	}
+	if msg.URL != "" {
+		card["actions"] = []interface{}{
+			map[string]interface{}{"type": "Action.OpenUrl", "title": "Open", "url": msg.URL},
+		}
+	}
+	return map[string]interface{}{
+		"type": "message",
+		"attachments": []interface{}{
+			map[string]interface{}{"contentType": "application/vnd.microsoft.card.adaptive", "content": card},
+		},
+	}
+}
@@ -2,11 +2,15 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/nexusflow/nexusflow/pkg/logger"
 	pb "github.com/nexusflow/nexusflow/pkg/proto/notification/v1"
+	"github.com/nexusflow/nexusflow/services/notification-service/internal/channel"
 	"github.com/nexusflow/nexusflow/services/notification-service/internal/models"
+	"github.com/nexusflow/nexusflow/services/notification-service/internal/repository"
 	"github.com/nexusflow/nexusflow/services/notification-service/internal/service"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -28,15 +32,65 @@ func notificationToProto(n *models.Notification) *pb.Notification {
 		return nil
 	}
 	return &pb.Notification{
-		Id:        n.ID,
-		UserId:    n.UserID,
-		Type:      n.Type,
-		Title:     n.Title,
-		Message:   n.Message,
-		Link:      n.Link,
-		Metadata:  string(n.Metadata),
-		Read:      n.Read,
-		CreatedAt: n.CreatedAt.Format(time.RFC3339),
+		Id:           n.ID,
+		UserId:       n.UserID,
+		Type:         n.Type,
+		Title:        n.Title,
+		Message:      n.Message,
+		Link:         n.Link,
+		Metadata:     string(n.Metadata),
+		Read:         n.Read,
+		CreatedAt:    n.CreatedAt.Format(time.RFC3339),
+		GroupKey:     n.GroupKey,
+		Subject:      n.Subject,
+		ProjectId:    n.ProjectID,
+		ActorId:      n.ActorID,
+		SnoozedUntil: formatTime(n.SnoozedUntil),
+		ArchivedAt:   formatTime(n.ArchivedAt),
+	}
+}
+
+func threadToProto(t *models.NotificationThread) *pb.NotificationThread {
+	return &pb.NotificationThread{
+		Latest:      notificationToProto(&t.Notification),
+		Count:       int32(t.Count),
+		UnreadCount: int32(t.UnreadCount),
+		Summary:     service.ThreadSummary(t),
+	}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func filterFromProto(req *pb.ListNotificationsRequest) repository.NotificationFilter {
+	filter := repository.NotificationFilter{
+		Types:      req.Types,
+		ProjectID:  req.ProjectId,
+		UnreadOnly: req.UnreadOnly,
+	}
+	switch req.View {
+	case pb.NotificationView_NOTIFICATION_VIEW_SNOOZED:
+		filter.View = repository.ViewSnoozed
+	case pb.NotificationView_NOTIFICATION_VIEW_ARCHIVED:
+		filter.View = repository.ViewArchived
+	default:
+		filter.View = repository.ViewInbox
+	}
+	return filter
+}
+
+func errorToStatus(err error, msg string) error {
+	switch {
+	case errors.Is(err, service.ErrValidation):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
 	}
 }
 
@@ -60,8 +114,22 @@ func (h *NotificationHandler) ListNotifications(ctx context.Context, req *pb.Lis
 		limit = 20
 	}
 	offset := int(req.Offset)
+	filter := filterFromProto(req)
 
-	notifications, total, err := h.svc.ListNotifications(ctx, req.UserId, limit, offset)
+	if req.Grouped {
+		threads, total, err := h.svc.ListThreads(ctx, req.UserId, filter, limit, offset)
+		if err != nil {
+			h.log.Sugar().Errorw("Failed to list notification threads", "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to list notifications: %v", err)
+		}
+		var pbThreads []*pb.NotificationThread
+		for _, t := range threads {
+			pbThreads = append(pbThreads, threadToProto(t))
+		}
+		return &pb.ListNotificationsResponse{Threads: pbThreads, Total: int32(total)}, nil
+	}
+
+	notifications, total, err := h.svc.ListNotifications(ctx, req.UserId, filter, limit, offset)
 	if err != nil {
 		h.log.Sugar().Errorw("Failed to list notifications", "error", err)
 		return nil, status.Errorf(codes.Internal, "failed to list notifications: %v", err)
@@ -126,3 +194,201 @@ func (h *NotificationHandler) UpdatePreference(ctx context.Context, req *pb.Upda
 	}
 	return &pb.UpdatePreferenceResponse{Preference: preferenceToProto(pref)}, nil
 }
+
+func (h *NotificationHandler) SnoozeNotification(ctx context.Context, req *pb.SnoozeNotificationRequest) (*pb.SnoozeNotificationResponse, error) {
+	var until time.Time
+	if req.SnoozeUntil != "" {
+		var err error
+		until, err = time.Parse(time.RFC3339, req.SnoozeUntil)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid snooze_until: %v", err)
+		}
+	}
+	count, err := h.svc.SnoozeNotification(ctx, req.UserId, req.NotificationId, until)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to snooze notification", "error", err)
+		return nil, errorToStatus(err, "failed to snooze notification")
+	}
+	return &pb.SnoozeNotificationResponse{Count: int32(count)}, nil
+}
+
+func (h *NotificationHandler) ArchiveNotification(ctx context.Context, req *pb.ArchiveNotificationRequest) (*pb.ArchiveNotificationResponse, error) {
+	count, err := h.svc.ArchiveNotification(ctx, req.UserId, req.NotificationId)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to archive notification", "error", err)
+		return nil, errorToStatus(err, "failed to archive notification")
+	}
+	return &pb.ArchiveNotificationResponse{Count: int32(count)}, nil
+}
+
+func (h *NotificationHandler) UnarchiveNotification(ctx context.Context, req *pb.UnarchiveNotificationRequest) (*pb.UnarchiveNotificationResponse, error) {
+	count, err := h.svc.UnarchiveNotification(ctx, req.UserId, req.NotificationId)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to unarchive notification", "error", err)
+		return nil, errorToStatus(err, "failed to unarchive notification")
+	}
+	return &pb.UnarchiveNotificationResponse{Count: int32(count)}, nil
+}
+
+// Chat channels
+
+func channelToProto(ch *models.ChatChannel) *pb.ChatChannel {
+	return &pb.ChatChannel{
+		Id:           ch.ID,
+		ProjectId:    ch.ProjectID,
+		Name:         ch.Name,
+		Kind:         ch.Kind,
+		SlackChannel: ch.SlackChannel,
+		CreatedAt:    ch.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func routeToProto(r *models.ChannelRoute) *pb.ChannelRoute {
+	return &pb.ChannelRoute{
+		Id:         r.ID,
+		ProjectId:  r.ProjectID,
+		ChannelId:  r.ChannelID,
+		EventTypes: r.EventTypes,
+		CreatedAt:  r.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func (h *NotificationHandler) CreateChannel(ctx context.Context, req *pb.CreateChannelRequest) (*pb.CreateChannelResponse, error) {
+	ch, err := h.svc.CreateChannel(ctx, &models.ChatChannel{
+		ProjectID:    req.ProjectId,
+		Name:         req.Name,
+		Kind:         req.Kind,
+		WebhookURL:   req.WebhookUrl,
+		BotToken:     req.BotToken,
+		SlackChannel: req.SlackChannel,
+	})
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to create channel", "error", err)
+		return nil, errorToStatus(err, "failed to create channel")
+	}
+	return &pb.CreateChannelResponse{Channel: channelToProto(ch)}, nil
+}
+
+func (h *NotificationHandler) ListChannels(ctx context.Context, req *pb.ListChannelsRequest) (*pb.ListChannelsResponse, error) {
+	channels, err := h.svc.ListChannels(ctx, req.ProjectId)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to list channels", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list channels: %v", err)
+	}
+	var pbChannels []*pb.ChatChannel
+	for _, ch := range channels {
+		pbChannels = append(pbChannels, channelToProto(ch))
+	}
+	return &pb.ListChannelsResponse{Channels: pbChannels}, nil
+}
+
+func (h *NotificationHandler) DeleteChannel(ctx context.Context, req *pb.DeleteChannelRequest) (*pb.DeleteChannelResponse, error) {
+	if err := h.svc.DeleteChannel(ctx, req.ChannelId); err != nil {
+		h.log.Sugar().Errorw("Failed to delete channel", "error", err)
+		return nil, errorToStatus(err, "failed to delete channel")
+	}
+	return &pb.DeleteChannelResponse{}, nil
+}
+
+func (h *NotificationHandler) TestChannel(ctx context.Context, req *pb.TestChannelRequest) (*pb.TestChannelResponse, error) {
+	if err := h.svc.TestChannel(ctx, req.ChannelId); err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		// The chat tool rejected the message, usually because of the channel's
+		// settings. Only the outcome is returned, never what the webhook answered.
+		h.log.Sugar().Warnw("Failed to send test message", "error", err, "channel_id", req.ChannelId)
+		return nil, status.Error(codes.FailedPrecondition, testChannelFailure(err))
+	}
+	return &pb.TestChannelResponse{}, nil
+}
+
+// testChannelFailure describes why a test message was not delivered
+func testChannelFailure(err error) string {
+	var statusErr *channel.StatusError
+	switch {
+	case errors.As(err, &statusErr):
+		return fmt.Sprintf("the chat tool answered with status %d", statusErr.StatusCode)
+	case errors.Is(err, channel.ErrBlockedAddress):
+		return channel.ErrBlockedAddress.Error()
+	case errors.Is(err, channel.ErrSlackAPI):
+		return err.Error()
+	default:
+		return "the chat tool could not be reached"
+	}
+}
+
+func (h *NotificationHandler) CreateChannelRoute(ctx context.Context, req *pb.CreateChannelRouteRequest) (*pb.CreateChannelRouteResponse, error) {
+	route, err := h.svc.CreateRoute(ctx, &models.ChannelRoute{
+		ProjectID:  req.ProjectId,
+		ChannelID:  req.ChannelId,
+		EventTypes: req.EventTypes,
+	})
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to create channel route", "error", err)
+		return nil, errorToStatus(err, "failed to create channel route")
+	}
+	return &pb.CreateChannelRouteResponse{Route: routeToProto(route)}, nil
+}
+
+func (h *NotificationHandler) ListChannelRoutes(ctx context.Context, req *pb.ListChannelRoutesRequest) (*pb.ListChannelRoutesResponse, error) {
+	routes, err := h.svc.ListRoutes(ctx, req.ProjectId)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to list channel routes", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list channel routes: %v", err)
+	}
+	var pbRoutes []*pb.ChannelRoute
+	for _, r := range routes {
+		pbRoutes = append(pbRoutes, routeToProto(r))
+	}
+	return &pb.ListChannelRoutesResponse{Routes: pbRoutes}, nil
+}
+
+func (h *NotificationHandler) DeleteChannelRoute(ctx context.Context, req *pb.DeleteChannelRouteRequest) (*pb.DeleteChannelRouteResponse, error) {
+	if err := h.svc.DeleteRoute(ctx, req.RouteId); err != nil {
+		h.log.Sugar().Errorw("Failed to delete channel route", "error", err)
+		return nil, errorToStatus(err, "failed to delete channel route")
+	}
+	return &pb.DeleteChannelRouteResponse{}, nil
+}
+
+// Notification schemes
+
+func schemeEntriesToProto(entries []*models.SchemeEntry) []*pb.SchemeEntry {
+	var pbEntries []*pb.SchemeEntry
+	for _, e := range entries {
+		pbEntries = append(pbEntries, &pb.SchemeEntry{EventType: e.EventType, Recipient: e.Recipient, Value: e.Value})
+	}
+	return pbEntries
+}
+
+func (h *NotificationHandler) GetNotificationScheme(ctx context.Context, req *pb.GetNotificationSchemeRequest) (*pb.GetNotificationSchemeResponse, error) {
+	entries, isDefault, err := h.svc.GetNotificationScheme(ctx, req.ProjectId)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to get notification scheme", "error", err)
+		return nil, errorToStatus(err, "failed to get notification scheme")
+	}
+	return &pb.GetNotificationSchemeResponse{Entries: schemeEntriesToProto(entries), IsDefault: isDefault}, nil
+}
+
+func (h *NotificationHandler) UpdateNotificationScheme(ctx context.Context, req *pb.UpdateNotificationSchemeRequest) (*pb.UpdateNotificationSchemeResponse, error) {
+	var entries []*models.SchemeEntry
+	for _, e := range req.Entries {
+		entries = append(entries, &models.SchemeEntry{EventType: e.EventType, Recipient: e.Recipient, Value: e.Value})
+	}
+	entries, err := h.svc.UpdateNotificationScheme(ctx, req.ProjectId, entries)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to update notification scheme", "error", err)
+		return nil, errorToStatus(err, "failed to update notification scheme")
+	}
+	return &pb.UpdateNotificationSchemeResponse{Entries: schemeEntriesToProto(entries)}, nil
+}
+
+func (h *NotificationHandler) ResetNotificationScheme(ctx context.Context, req *pb.ResetNotificationSchemeRequest) (*pb.ResetNotificationSchemeResponse, error) {
+	entries, err := h.svc.ResetNotificationScheme(ctx, req.ProjectId)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to reset notification scheme", "error", err)
+		return nil, errorToStatus(err, "failed to reset notification scheme")
+	}
+	return &pb.ResetNotificationSchemeResponse{Entries: schemeEntriesToProto(entries)}, nil
+}
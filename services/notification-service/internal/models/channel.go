@@ -0,0 +1,49 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// ChatChannel is a Slack or Microsoft Teams destination of a project. The
+// webhook URL and bot token are secrets: they are stored sealed by the secret
+// package and are never returned by the API.
+type ChatChannel struct {
+	bun.BaseModel `bun:"table:notification_channels,alias:ch"`
+
+	ID           string    `bun:"type:uuid,pk,default:uuid_generate_v4()"`
+	ProjectID    string    `bun:"type:uuid,notnull"`
+	Name         string    `bun:"type:text,notnull"`
+	Kind         string    `bun:"type:text,notnull"` // One of the channel package's kinds
+	WebhookURL   string    `bun:"type:text,nullzero"`
+	BotToken     string    `bun:"type:text,nullzero"`
+	SlackChannel string    `bun:"type:text,nullzero"`
+	CreatedAt    time.Time `bun:"type:timestamp,notnull,default:now()"`
+}
+
+// ChannelRoute sends a project's events of the given types to a channel
+type ChannelRoute struct {
+	bun.BaseModel `bun:"table:notification_channel_routes,alias:rt"`
+
+	ID         string       `bun:"type:uuid,pk,default:uuid_generate_v4()"`
+	ProjectID  string       `bun:"type:uuid,notnull"`
+	ChannelID  string       `bun:"type:uuid,notnull"`
+	EventTypes []string     `bun:"event_types,array"` // e.g. "issue.created" or "issue.*"; empty matches every event
+	CreatedAt  time.Time    `bun:"type:timestamp,notnull,default:now()"`
+	Channel    *ChatChannel `bun:"rel:belongs-to,join:channel_id=id"`
+}
+
+// Matches reports whether the route applies to an event type
+func (r *ChannelRoute) Matches(eventType string) bool {
+	if len(r.EventTypes) == 0 {
+		return true
+	}
+	for _, t := range r.EventTypes {
+		if t == eventType || (strings.HasSuffix(t, ".*") && strings.HasPrefix(eventType, strings.TrimSuffix(t, "*"))) {
+			return true
+		}
+	}
+	return false
+}
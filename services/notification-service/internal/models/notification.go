@@ -7,12 +7,14 @@ import (
 
 // NotificationType constants
 const (
+	NotificationTypeIssueCreated    = "issue.created"
 	NotificationTypeIssueAssigned   = "issue.assigned"
 	NotificationTypeIssueUpdated    = "issue.updated"
 	NotificationTypeCommentCreated  = "comment.created"
 	NotificationTypeCommentMention  = "comment.mention"
 	NotificationTypeSprintStarted   = "sprint.started"
 	NotificationTypeSprintCompleted = "sprint.completed"
+	NotificationTypeAutomation      = "automation"
 )
 
 type Notification struct {
@@ -25,6 +27,20 @@ type Notification struct {
 	Metadata  json.RawMessage `bun:"type:jsonb"`
 	Read      bool            `bun:"type:boolean,notnull,default:false"`
 	CreatedAt time.Time       `bun:"type:timestamp,notnull,default:now()"`
+
+	GroupKey     string    `bun:"type:text,nullzero"` // Notifications sharing a key form one thread, e.g. "issue:<id>"
+	Subject      string    `bun:"type:text,nullzero"` // What the thread is about, e.g. "WEB-12"
+	ProjectID    string    `bun:"type:uuid,nullzero"`
+	ActorID      string    `bun:"type:text,nullzero"` // Who caused the notification
+	SnoozedUntil time.Time `bun:"type:timestamp,nullzero"`
+	ArchivedAt   time.Time `bun:"type:timestamp,nullzero"`
+}
+
+// NotificationThread is the latest notification of a group with the size of the group
+type NotificationThread struct {
+	Notification `bun:",extend"`
+	Count        int `bun:"thread_count"`
+	UnreadCount  int `bun:"thread_unread"`
 }
 
 type NotificationPreference struct {
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// Recipients of a notification scheme entry
+const (
+	RecipientAssignee    = "assignee"
+	RecipientReporter    = "reporter"
+	RecipientWatchers    = "watchers"
+	RecipientProjectRole = "project_role" // Value is admin, member, viewer or lead
+	RecipientTeam        = "team"         // Value is an organization team ID
+	RecipientUser        = "user"         // Value is a user ID
+)
+
+// Project roles a scheme entry can notify
+const (
+	ProjectRoleAdmin  = "admin"
+	ProjectRoleMember = "member"
+	ProjectRoleViewer = "viewer"
+	ProjectRoleLead   = "lead"
+)
+
+// SchemeEntry says who is notified of one type of event in a project. A
+// project's entries form its notification scheme; projects without entries
+// use the default scheme.
+type SchemeEntry struct {
+	bun.BaseModel `bun:"table:notification_scheme_entries,alias:se"`
+
+	ID        string    `bun:"type:uuid,pk,default:uuid_generate_v4()"`
+	ProjectID string    `bun:"type:uuid,notnull"`
+	EventType string    `bun:"type:text,notnull"` // A NotificationType
+	Recipient string    `bun:"type:text,notnull"`
+	Value     string    `bun:"type:text,notnull,default:''"`
+	CreatedAt time.Time `bun:"type:timestamp,notnull,default:now()"`
+}
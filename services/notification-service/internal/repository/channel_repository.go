@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nexusflow/nexusflow/services/notification-service/internal/models"
+)
+
+// Chat channels
+
+func (r *NotificationRepository) CreateChannel(ctx context.Context, ch *models.ChatChannel) error {
+	ch.ID = ""
+	ch.CreatedAt = time.Now()
+	if _, err := r.db.NewInsert().Model(ch).Exec(ctx); err != nil {
+		return fmt.Errorf("create channel: %w", err)
+	}
+	return nil
+}
+
+func (r *NotificationRepository) GetChannel(ctx context.Context, id string) (*models.ChatChannel, error) {
+	ch := new(models.ChatChannel)
+	if err := r.db.NewSelect().Model(ch).Where("id = ?", id).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("get channel: %w", err)
+	}
+	return ch, nil
+}
+
+func (r *NotificationRepository) ListChannels(ctx context.Context, projectID string) ([]*models.ChatChannel, error) {
+	var channels []*models.ChatChannel
+	err := r.db.NewSelect().Model(&channels).
+		Where("project_id = ?", projectID).
+		Order("name ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list channels: %w", err)
+	}
+	return channels, nil
+}
+
+// ListChannelsWithPlainSecrets lists channels whose webhook URL or bot token
+// is stored without the sealed prefix
+func (r *NotificationRepository) ListChannelsWithPlainSecrets(ctx context.Context, sealedPrefix string) ([]*models.ChatChannel, error) {
+	var channels []*models.ChatChannel
+	err := r.db.NewSelect().Model(&channels).
+		Where("(webhook_url <> '' AND webhook_url NOT LIKE ? || '%')", sealedPrefix).
+		WhereOr("(bot_token <> '' AND bot_token NOT LIKE ? || '%')", sealedPrefix).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list channels with plain secrets: %w", err)
+	}
+	return channels, nil
+}
+
+// UpdateChannelSecrets saves a channel's webhook URL and bot token
+func (r *NotificationRepository) UpdateChannelSecrets(ctx context.Context, ch *models.ChatChannel) error {
+	_, err := r.db.NewUpdate().Model(ch).Column("webhook_url", "bot_token").WherePK().Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("update channel secrets: %w", err)
+	}
+	return nil
+}
+
+// DeleteChannel deletes a channel with its routes and reports whether it existed
+func (r *NotificationRepository) DeleteChannel(ctx context.Context, id string) (bool, error) {
+	res, err := r.db.NewDelete().Model((*models.ChatChannel)(nil)).Where("id = ?", id).Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("delete channel: %w", err)
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// Channel routes
+
+func (r *NotificationRepository) CreateRoute(ctx context.Context, route *models.ChannelRoute) error {
+	route.ID = ""
+	route.CreatedAt = time.Now()
+	if route.EventTypes == nil {
+		route.EventTypes = []string{}
+	}
+	if _, err := r.db.NewInsert().Model(route).Exec(ctx); err != nil {
+		return fmt.Errorf("create route: %w", err)
+	}
+	return nil
+}
+
+// ListRoutes lists a project's routes with their channels
+func (r *NotificationRepository) ListRoutes(ctx context.Context, projectID string) ([]*models.ChannelRoute, error) {
+	var routes []*models.ChannelRoute
+	err := r.db.NewSelect().Model(&routes).
+		Relation("Channel").
+		Where("rt.project_id = ?", projectID).
+		Order("rt.created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list routes: %w", err)
+	}
+	return routes, nil
+}
+
+// DeleteRoute deletes a route and reports whether it existed
+func (r *NotificationRepository) DeleteRoute(ctx context.Context, id string) (bool, error) {
+	res, err := r.db.NewDelete().Model((*models.ChannelRoute)(nil)).Where("id = ?", id).Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("delete route: %w", err)
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
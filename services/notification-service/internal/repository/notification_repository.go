@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"time"
 
@@ -39,12 +40,14 @@ func (r *NotificationRepository) GetNotification(ctx context.Context, id string)
 	return n, nil
 }
 
-func (r *NotificationRepository) ListNotifications(ctx context.Context, userID string, limit, offset int) ([]*models.Notification, int, error) {
+// ListNotifications lists a user's notifications matching a filter, newest first
+func (r *NotificationRepository) ListNotifications(ctx context.Context, userID string, filter NotificationFilter, limit, offset int) ([]*models.Notification, int, error) {
 	var notifications []*models.Notification
 	
 	query := r.db.NewSelect().Model(&notifications).
 		Where("user_id = ?", userID).
 		Order("created_at DESC")
+	query = filter.apply(query)
 	
 	if limit > 0 {
 		query = query.Limit(limit)
@@ -53,20 +56,42 @@ func (r *NotificationRepository) ListNotifications(ctx context.Context, userID s
 		query = query.Offset(offset)
 	}
 	
-	err := query.Scan(ctx)
+	total, err := query.ScanAndCount(ctx)
 	if err != nil {
 		return nil, 0, fmt.Errorf("list notifications: %w", err)
 	}
 	
-	// Get total count
-	total, err := r.db.NewSelect().Model((*models.Notification)(nil)).
+	return notifications, total, nil
+}
+
+// ListNotificationsSince lists up to limit of the notifications in a user's inbox
+// created after the notification lastID, oldest first; archived notifications and
+// those snoozed past now are left out. found is false when lastID is not one of
+// the user's notifications.
+func (r *NotificationRepository) ListNotificationsSince(ctx context.Context, userID, lastID string, now time.Time, limit int) (notifications []*models.Notification, found bool, err error) {
+	last := new(models.Notification)
+	err = r.db.NewSelect().Model(last).
+		Column("id", "created_at").
+		Where("id = ? AND user_id = ?", lastID, userID).
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("get last seen notification: %w", err)
+	}
+
+	query := r.db.NewSelect().Model(&notifications).
 		Where("user_id = ?", userID).
-		Count(ctx)
+		Where("(created_at, id) > (?, ?)", last.CreatedAt, last.ID)
+	err = NotificationFilter{Now: now}.apply(query).
+		Order("created_at ASC", "id ASC").
+		Limit(limit).
+		Scan(ctx)
 	if err != nil {
-		return notifications, 0, fmt.Errorf("count notifications: %w", err)
+		return nil, true, fmt.Errorf("list notifications since: %w", err)
 	}
-	
-	return notifications, total, nil
+	return notifications, true, nil
 }
 
 func (r *NotificationRepository) MarkAsRead(ctx context.Context, id string) error {
@@ -91,10 +116,11 @@ func (r *NotificationRepository) MarkAllAsRead(ctx context.Context, userID strin
 	return nil
 }
 
+// GetUnreadCount counts the unread notifications in a user's inbox
 func (r *NotificationRepository) GetUnreadCount(ctx context.Context, userID string) (int, error) {
-	count, err := r.db.NewSelect().Model((*models.Notification)(nil)).
-		Where("user_id = ? AND read = ?", userID, false).
-		Count(ctx)
+	query := r.db.NewSelect().Model((*models.Notification)(nil)).
+		Where("user_id = ? AND read = ?", userID, false)
+	count, err := NotificationFilter{Now: time.Now()}.apply(query).Count(ctx)
 	if err != nil {
 		return 0, fmt.Errorf("get unread count: %w", err)
 	}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nexusflow/nexusflow/services/notification-service/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// ListSchemeEntries lists a project's notification scheme; it is empty for
+// projects using the default scheme
+func (r *NotificationRepository) ListSchemeEntries(ctx context.Context, projectID string) ([]*models.SchemeEntry, error) {
+	var entries []*models.SchemeEntry
+	err := r.db.NewSelect().Model(&entries).
+		Where("project_id = ?", projectID).
+		Order("event_type ASC", "created_at ASC", "id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list scheme entries: %w", err)
+	}
+	return entries, nil
+}
+
+// ReplaceSchemeEntries replaces a project's notification scheme
+func (r *NotificationRepository) ReplaceSchemeEntries(ctx context.Context, projectID string, entries []*models.SchemeEntry) error {
+	now := time.Now()
+	for _, e := range entries {
+		e.ID = ""
+		e.ProjectID = projectID
+		e.CreatedAt = now
+	}
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewDelete().Model((*models.SchemeEntry)(nil)).Where("project_id = ?", projectID).Exec(ctx); err != nil {
+			return fmt.Errorf("delete scheme entries: %w", err)
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+		if _, err := tx.NewInsert().Model(&entries).Exec(ctx); err != nil {
+			return fmt.Errorf("insert scheme entries: %w", err)
+		}
+		return nil
+	})
+}
+
+// DisabledInApp returns the users among userIDs who turned off in-app
+// notifications of a type. Users without a preference get notifications.
+func (r *NotificationRepository) DisabledInApp(ctx context.Context, userIDs []string, notificationType string) (map[string]bool, error) {
+	disabled := make(map[string]bool)
+	// Preferences are keyed by UUID; other IDs cannot have any
+	var ids []string
+	for _, id := range userIDs {
+		if _, err := uuid.Parse(id); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return disabled, nil
+	}
+	var prefs []*models.NotificationPreference
+	err := r.db.NewSelect().Model(&prefs).
+		Column("user_id").
+		Where("user_id IN (?)", bun.In(ids)).
+		Where("notification_type = ?", notificationType).
+		Where("in_app_enabled = ?", false).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get disabled preferences: %w", err)
+	}
+	for _, p := range prefs {
+		disabled[p.UserID] = true
+	}
+	return disabled, nil
+}
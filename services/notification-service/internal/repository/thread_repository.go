@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nexusflow/nexusflow/services/notification-service/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// NotificationView selects which of a user's notifications are listed
+type NotificationView int
+
+const (
+	// ViewInbox lists notifications that are neither archived nor snoozed
+	ViewInbox NotificationView = iota
+	// ViewSnoozed lists notifications snoozed until a later time
+	ViewSnoozed
+	// ViewArchived lists archived notifications
+	ViewArchived
+)
+
+// NotificationFilter narrows a listing of a user's notifications
+type NotificationFilter struct {
+	View       NotificationView
+	Types      []string
+	ProjectID  string
+	UnreadOnly bool
+	Now        time.Time // Snoozes ending before Now are over
+}
+
+func (f NotificationFilter) apply(q *bun.SelectQuery) *bun.SelectQuery {
+	switch f.View {
+	case ViewSnoozed:
+		q = q.Where("archived_at IS NULL AND snoozed_until > ?", f.Now)
+	case ViewArchived:
+		q = q.Where("archived_at IS NOT NULL")
+	default:
+		q = q.Where("archived_at IS NULL AND (snoozed_until IS NULL OR snoozed_until <= ?)", f.Now)
+	}
+	if len(f.Types) > 0 {
+		q = q.Where("type IN (?)", bun.In(f.Types))
+	}
+	if f.ProjectID != "" {
+		q = q.Where("project_id = ?", f.ProjectID)
+	}
+	if f.UnreadOnly {
+		q = q.Where("read = ?", false)
+	}
+	return q
+}
+
+// threadKey groups notifications without a group key on their own
+const threadKey = "COALESCE(group_key, id::text)"
+
+// ListThreads lists a user's notification threads matching a filter, most recently
+// active first. Each thread is its latest notification with the number of
+// notifications in it, counting only those matching the filter.
+func (r *NotificationRepository) ListThreads(ctx context.Context, userID string, filter NotificationFilter, limit, offset int) ([]*models.NotificationThread, int, error) {
+	inner := r.db.NewSelect().Model((*models.Notification)(nil)).
+		ColumnExpr("notification.*").
+		ColumnExpr("COUNT(*) OVER (PARTITION BY "+threadKey+") AS thread_count").
+		ColumnExpr("COUNT(*) FILTER (WHERE NOT read) OVER (PARTITION BY "+threadKey+") AS thread_unread").
+		ColumnExpr("ROW_NUMBER() OVER (PARTITION BY "+threadKey+" ORDER BY created_at DESC, id DESC) AS thread_rank").
+		Where("user_id = ?", userID)
+	inner = filter.apply(inner)
+
+	var threads []*models.NotificationThread
+	query := r.db.NewSelect().Model(&threads).
+		ModelTableExpr("(?) AS notification", inner).
+		Where("thread_rank = 1").
+		Order("created_at DESC", "id DESC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+
+	total, err := query.ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list notification threads: %w", err)
+	}
+	return threads, total, nil
+}
+
+// threadOf selects the notifications of a user in the same thread as notificationID
+func threadOf(q *bun.UpdateQuery, userID, notificationID string) *bun.UpdateQuery {
+	return q.Where("user_id = ?", userID).
+		Where(threadKey+" = (SELECT "+threadKey+" FROM notifications WHERE id = ? AND user_id = ?)", notificationID, userID)
+}
+
+// SnoozeThread hides the notifications of a thread until a time; a zero time
+// ends the snooze. It returns the number of notifications changed.
+func (r *NotificationRepository) SnoozeThread(ctx context.Context, userID, notificationID string, until time.Time) (int, error) {
+	q := r.db.NewUpdate().Model((*models.Notification)(nil)).
+		Where("archived_at IS NULL")
+	if until.IsZero() {
+		q = q.Set("snoozed_until = NULL")
+	} else {
+		q = q.Set("snoozed_until = ?", until)
+	}
+	res, err := threadOf(q, userID, notificationID).Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("snooze thread: %w", err)
+	}
+	n, _ := res.RowsAffected()
+	return int(n), nil
+}
+
+// SetThreadArchived archives or restores the notifications of a thread and
+// returns the number changed. Archiving ends any snooze.
+func (r *NotificationRepository) SetThreadArchived(ctx context.Context, userID, notificationID string, archived bool, now time.Time) (int, error) {
+	q := r.db.NewUpdate().Model((*models.Notification)(nil))
+	if archived {
+		q = q.Set("archived_at = ?", now).
+			Set("snoozed_until = NULL").
+			Where("archived_at IS NULL")
+	} else {
+		q = q.Set("archived_at = NULL").
+			Where("archived_at IS NOT NULL")
+	}
+	res, err := threadOf(q, userID, notificationID).Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("archive thread: %w", err)
+	}
+	n, _ := res.RowsAffected()
+	return int(n), nil
+}
+
+// WakeSnoozed ends the snoozes that are over and returns the notifications that
+// are back in their users' inboxes
+func (r *NotificationRepository) WakeSnoozed(ctx context.Context, now time.Time) ([]*models.Notification, error) {
+	var notifications []*models.Notification
+	_, err := r.db.NewUpdate().Model((*models.Notification)(nil)).
+		Set("snoozed_until = NULL").
+		Where("snoozed_until <= ?", now).
+		Where("archived_at IS NULL").
+		Returning("*").
+		Exec(ctx, &notifications)
+	if err != nil {
+		return nil, fmt.Errorf("wake snoozed notifications: %w", err)
+	}
+	return notifications, nil
+}
+
+// PurgeReadNotifications deletes read notifications created before a cutoff and
+// returns the number deleted
+func (r *NotificationRepository) PurgeReadNotifications(ctx context.Context, before time.Time) (int, error) {
+	res, err := r.db.NewDelete().Model((*models.Notification)(nil)).
+		Where("read = ?", true).
+		Where("created_at < ?", before).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("purge read notifications: %w", err)
+	}
+	n, _ := res.RowsAffected()
+	return int(n), nil
+}
@@ -0,0 +1,80 @@
+// Package secret encrypts values such as webhook URLs and bot tokens before
+// they are stored, with AES-256-GCM under a configured key.
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Prefix marks a sealed value, and the format it was sealed in
+const Prefix = "enc:v1:"
+
+// ErrInvalidKey is returned for a key that is not 32 base64-encoded bytes
+var ErrInvalidKey = errors.New("secret key must be 32 bytes, base64-encoded")
+
+// Box seals and opens values with one key
+type Box struct {
+	aead cipher.AEAD
+}
+
+// NewBox creates a box from a base64-encoded 32-byte key, as made by
+// `openssl rand -base64 32`
+func NewBox(key string) (*Box, error) {
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(key))
+	if err != nil || len(raw) != 32 {
+		return nil, ErrInvalidKey
+	}
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Box{aead: aead}, nil
+}
+
+// Seal encrypts a value; the empty value stays empty
+func (b *Box) Seal(plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	nonce := make([]byte, b.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("generate nonce: %w", err)
+	}
+	sealed := b.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return Prefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Open decrypts a value sealed by Seal
+func (b *Box) Open(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	if !IsSealed(value) {
+		return "", errors.New("value is not sealed")
+	}
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, Prefix))
+	if err != nil || len(data) < b.aead.NonceSize() {
+		return "", errors.New("malformed sealed value")
+	}
+	nonce, ciphertext := data[:b.aead.NonceSize()], data[b.aead.NonceSize():]
+	plaintext, err := b.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", errors.New("sealed value cannot be opened with this key")
+	}
+	return string(plaintext), nil
+}
+
+// IsSealed reports whether a stored value was sealed by a Box
+func IsSealed(value string) bool {
+	return strings.HasPrefix(value, Prefix)
+}
@@ -0,0 +1,65 @@
+package secret
+
+import (
+	"strings"
+	"testing"
+)
+
+const testKey = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+
+func TestSealAndOpen(t *testing.T) {
+	box, err := NewBox(testKey)
+	if err != nil {
+		t.Fatalf("new box: %v", err)
+	}
+	const url = "https://hooks.slack.com/services/T000/B000/XXXX"
+
+	sealed, err := box.Seal(url)
+	if err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+	if !IsSealed(sealed) || strings.Contains(sealed, "hooks.slack.com") {
+		t.Fatalf("value not sealed: %q", sealed)
+	}
+	if again, _ := box.Seal(url); again == sealed {
+		t.Fatalf("sealing twice gave the same value")
+	}
+	opened, err := box.Open(sealed)
+	if err != nil || opened != url {
+		t.Fatalf("open = %q, %v", opened, err)
+	}
+
+	if sealed, _ := box.Seal(""); sealed != "" {
+		t.Fatalf("empty value sealed to %q", sealed)
+	}
+}
+
+func TestOpenRejectsOtherKeysAndTampering(t *testing.T) {
+	box, _ := NewBox(testKey)
+	other, _ := NewBox("ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA=")
+	sealed, _ := box.Seal("xoxb-1")
+
+	if _, err := other.Open(sealed); err == nil {
+		t.Errorf("opened with another key")
+	}
+	i := len(Prefix) + 20
+	flipped := byte('A')
+	if sealed[i] == 'A' {
+		flipped = 'B'
+	}
+	tampered := sealed[:i] + string(flipped) + sealed[i+1:]
+	if _, err := box.Open(tampered); err == nil {
+		t.Errorf("opened a tampered value")
+	}
+	if _, err := box.Open("xoxb-1"); err == nil {
+		t.Errorf("opened a plaintext value")
+	}
+}
+
+func TestNewBoxRejectsBadKeys(t *testing.T) {
+	for _, key := range []string{"", "not base64!", "c2hvcnQ="} {
+		if _, err := NewBox(key); err != ErrInvalidKey {
+			t.Errorf("NewBox(%q) = %v, want ErrInvalidKey", key, err)
+		}
+	}
+}
@@ -0,0 +1,329 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/nexusflow/nexusflow/pkg/kafka"
+	issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+	workflowpb "github.com/nexusflow/nexusflow/pkg/proto/workflow/v1"
+	"github.com/nexusflow/nexusflow/services/notification-service/internal/channel"
+	"github.com/nexusflow/nexusflow/services/notification-service/internal/models"
+	"github.com/nexusflow/nexusflow/services/notification-service/internal/secret"
+)
+
+// CreateChannel adds a Slack or Teams channel to a project
+func (s *NotificationService) CreateChannel(ctx context.Context, ch *models.ChatChannel) (*models.ChatChannel, error) {
+	ch.Name = strings.TrimSpace(ch.Name)
+	if _, err := uuid.Parse(ch.ProjectID); err != nil {
+		return nil, fmt.Errorf("%w: project_id must be a UUID", ErrValidation)
+	}
+	if ch.Name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrValidation)
+	}
+	if s.secrets == nil {
+		return nil, fmt.Errorf("%w: chat channels need notifications.secret_key to be configured", ErrValidation)
+	}
+	cfg := channel.Config{Kind: ch.Kind, WebhookURL: ch.WebhookURL, BotToken: ch.BotToken, SlackChannel: ch.SlackChannel}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrValidation, err)
+	}
+	// Only keep the settings the kind uses
+	if ch.Kind == channel.KindSlackBot {
+		ch.WebhookURL = ""
+	} else {
+		ch.BotToken, ch.SlackChannel = "", ""
+	}
+	if err := s.sealSecrets(ch); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreateChannel(ctx, ch); err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+func (s *NotificationService) ListChannels(ctx context.Context, projectID string) ([]*models.ChatChannel, error) {
+	return s.repo.ListChannels(ctx, projectID)
+}
+
+// DeleteChannel deletes a channel and the routes to it
+func (s *NotificationService) DeleteChannel(ctx context.Context, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("%w: channel", ErrNotFound)
+	}
+	deleted, err := s.repo.DeleteChannel(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return fmt.Errorf("%w: channel", ErrNotFound)
+	}
+	return nil
+}
+
+// TestChannel posts a sample message to a channel, returning the chat tool's error
+func (s *NotificationService) TestChannel(ctx context.Context, id string) error {
+	ch, err := s.getChannel(ctx, id)
+	if err != nil {
+		return err
+	}
+	msg := &channel.Message{
+		EventType: "channel.test",
+		Title:     "NexusFlow notifications connected",
+		Text:      fmt.Sprintf("Events routed to %q will be posted here.", ch.Name),
+		URL:       s.link("/projects/" + ch.ProjectID),
+	}
+	if err := s.deliver(ctx, ch, msg); err != nil {
+		return fmt.Errorf("send test message: %w", err)
+	}
+	return nil
+}
+
+func (s *NotificationService) getChannel(ctx context.Context, id string) (*models.ChatChannel, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: channel", ErrNotFound)
+	}
+	ch, err := s.repo.GetChannel(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: channel", ErrNotFound)
+		}
+		return nil, err
+	}
+	return ch, nil
+}
+
+// CreateRoute routes a project's events of the given types to one of its channels
+func (s *NotificationService) CreateRoute(ctx context.Context, route *models.ChannelRoute) (*models.ChannelRoute, error) {
+	ch, err := s.getChannel(ctx, route.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	if ch.ProjectID != route.ProjectID {
+		return nil, fmt.Errorf("%w: channel belongs to another project", ErrValidation)
+	}
+
+	eventTypes := make([]string, 0, len(route.EventTypes))
+	for _, t := range route.EventTypes {
+		if t = strings.TrimSpace(t); t != "" {
+			eventTypes = append(eventTypes, t)
+		}
+	}
+	route.EventTypes = eventTypes
+
+	if err := s.repo.CreateRoute(ctx, route); err != nil {
+		return nil, err
+	}
+	return route, nil
+}
+
+func (s *NotificationService) ListRoutes(ctx context.Context, projectID string) ([]*models.ChannelRoute, error) {
+	return s.repo.ListRoutes(ctx, projectID)
+}
+
+func (s *NotificationService) DeleteRoute(ctx context.Context, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("%w: route", ErrNotFound)
+	}
+	deleted, err := s.repo.DeleteRoute(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return fmt.Errorf("%w: route", ErrNotFound)
+	}
+	return nil
+}
+
+// sendToChannels posts an event to every channel of its project it is routed to
+func (s *NotificationService) sendToChannels(ctx context.Context, event kafka.Event) {
+	projectID := event.ProjectID
+	if projectID == "" {
+		projectID, _ = event.Payload["project_id"].(string)
+	}
+	if _, err := uuid.Parse(projectID); err != nil {
+		return
+	}
+
+	routes, err := s.repo.ListRoutes(ctx, projectID)
+	if err != nil {
+		s.log.Sugar().Errorw("Failed to list channel routes", "error", err, "project_id", projectID)
+		return
+	}
+	var channels []*models.ChatChannel
+	seen := make(map[string]bool)
+	for _, route := range routes {
+		if route.Channel != nil && !seen[route.ChannelID] && route.Matches(event.Type) {
+			seen[route.ChannelID] = true
+			channels = append(channels, route.Channel)
+		}
+	}
+	if len(channels) == 0 {
+		return
+	}
+
+	msg := s.chatMessage(ctx, event, projectID)
+	for _, ch := range channels {
+		if err := s.deliver(ctx, ch, msg); err != nil {
+			s.log.Sugar().Warnw("Failed to send event to chat channel", "error", err, "channel_id", ch.ID, "type", event.Type)
+		}
+	}
+}
+
+func (s *NotificationService) deliver(ctx context.Context, ch *models.ChatChannel, msg *channel.Message) error {
+	cfg, err := s.channelConfig(ch)
+	if err != nil {
+		return err
+	}
+	c, err := channel.New(cfg, s.chatClient)
+	if err != nil {
+		return err
+	}
+	err = c.Send(ctx, msg)
+	var statusErr *channel.StatusError
+	if errors.As(err, &statusErr) {
+		// The answer is only logged, it may come from anywhere the webhook points
+		s.log.Sugar().Warnw("Chat tool rejected message", "channel_id", ch.ID, "status", statusErr.StatusCode, "body", statusErr.Body)
+	}
+	return err
+}
+
+// channelConfig opens a stored channel's secrets into the config to send with
+func (s *NotificationService) channelConfig(ch *models.ChatChannel) (channel.Config, error) {
+	if s.secrets == nil {
+		return channel.Config{}, errors.New("no secret key configured for chat channels")
+	}
+	webhookURL, err := s.secrets.Open(ch.WebhookURL)
+	if err != nil {
+		return channel.Config{}, fmt.Errorf("open webhook url: %w", err)
+	}
+	botToken, err := s.secrets.Open(ch.BotToken)
+	if err != nil {
+		return channel.Config{}, fmt.Errorf("open bot token: %w", err)
+	}
+	return channel.Config{
+		Kind:         ch.Kind,
+		WebhookURL:   webhookURL,
+		BotToken:     botToken,
+		SlackChannel: ch.SlackChannel,
+	}, nil
+}
+
+// sealSecrets encrypts a channel's webhook URL and bot token for storage
+func (s *NotificationService) sealSecrets(ch *models.ChatChannel) error {
+	var err error
+	if ch.WebhookURL, err = s.secrets.Seal(ch.WebhookURL); err != nil {
+		return fmt.Errorf("seal webhook url: %w", err)
+	}
+	if ch.BotToken, err = s.secrets.Seal(ch.BotToken); err != nil {
+		return fmt.Errorf("seal bot token: %w", err)
+	}
+	return nil
+}
+
+// SealStoredSecrets encrypts the secrets of channels saved before they were
+// encrypted at rest
+func (s *NotificationService) SealStoredSecrets(ctx context.Context) error {
+	channels, err := s.repo.ListChannelsWithPlainSecrets(ctx, secret.Prefix)
+	if err != nil {
+		return err
+	}
+	for _, ch := range channels {
+		for _, field := range []*string{&ch.WebhookURL, &ch.BotToken} {
+			if secret.IsSealed(*field) {
+				continue
+			}
+			if *field, err = s.secrets.Seal(*field); err != nil {
+				return fmt.Errorf("seal channel %s: %w", ch.ID, err)
+			}
+		}
+		if err := s.repo.UpdateChannelSecrets(ctx, ch); err != nil {
+			return err
+		}
+	}
+	if len(channels) > 0 {
+		s.log.Sugar().Infow("Encrypted stored chat channel secrets", "count", len(channels))
+	}
+	return nil
+}
+
+// chatMessage describes an event for chat, looking up the issue it concerns
+// and the names of the statuses it moved between
+func (s *NotificationService) chatMessage(ctx context.Context, event kafka.Event, projectID string) *channel.Message {
+	payload := event.Payload
+	msg := &channel.Message{
+		EventType: event.Type,
+		Title:     eventTitle(event.Type),
+		URL:       s.link("/projects/" + projectID),
+	}
+	msg.Text, _ = payload["message"].(string)
+
+	if issueID, _ := payload["issue_id"].(string); issueID != "" {
+		msg.URL = s.link("/issues/" + issueID)
+		msg.IssueKey, _ = payload["key"].(string)
+		if msg.IssueKey == "" {
+			msg.IssueKey, _ = payload["issue_key"].(string)
+		}
+		msg.Summary, _ = payload["summary"].(string)
+
+		resp, err := s.issueClient.GetIssue(ctx, &issuepb.GetIssueRequest{Id: issueID})
+		if err != nil {
+			s.log.Sugar().Warnw("Failed to get issue for chat message", "error", err, "issue_id", issueID)
+		} else if resp.Issue != nil {
+			msg.IssueKey = resp.Issue.Key
+			msg.Summary = resp.Issue.Summary
+		}
+	}
+
+	changes, _ := payload["changes"].(map[string]interface{})
+	if change, ok := changes["status_id"].(map[string]interface{}); ok {
+		from, _ := change["from"].(string)
+		to, _ := change["to"].(string)
+		names := s.statusNames(ctx, projectID)
+		msg.StatusFrom, msg.StatusTo = names[from], names[to]
+		if msg.StatusTo == "" {
+			msg.StatusTo = to
+		}
+		if msg.StatusFrom == "" {
+			msg.StatusFrom = from
+		}
+	}
+	return msg
+}
+
+// statusNames maps the statuses of a project's workflows to their names
+func (s *NotificationService) statusNames(ctx context.Context, projectID string) map[string]string {
+	names := make(map[string]string)
+	resp, err := s.workflowClient.ListWorkflows(ctx, &workflowpb.ListWorkflowsRequest{ProjectId: projectID})
+	if err != nil {
+		s.log.Sugar().Warnw("Failed to list workflows for chat message", "error", err, "project_id", projectID)
+		return names
+	}
+	for _, w := range resp.Workflows {
+		for _, st := range w.Statuses {
+			names[st.Id] = st.Name
+		}
+	}
+	return names
+}
+
+// link makes an app path absolute
+func (s *NotificationService) link(path string) string {
+	return s.appURL + path
+}
+
+// eventTitle turns an event type into a title, e.g. "issue.updated" into
+// "Issue updated"
+func eventTitle(eventType string) string {
+	title := strings.NewReplacer(".", " ", "_", " ").Replace(eventType)
+	if title == "" {
+		return title
+	}
+	return strings.ToUpper(title[:1]) + title[1:]
+}
@@ -0,0 +1,10 @@
+package service
+
+import "errors"
+
+var (
+	// ErrValidation is returned for invalid input
+	ErrValidation = errors.New("validation failed")
+	// ErrNotFound is returned when a notification, channel or route does not exist
+	ErrNotFound = errors.New("not found")
+)
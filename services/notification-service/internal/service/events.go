@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	"github.com/nexusflow/nexusflow/pkg/kafka"
+)
+
+// HandleEvent turns an event consumed from Kafka into notifications, feeds it to
+// the WebSocket topics it concerns and sends it to the chat channels it is
+// routed to. Chat delivery does not depend on the notifications, so a lookup
+// failing while resolving recipients does not lose the chat posts too.
+func (s *NotificationService) HandleEvent(ctx context.Context, event kafka.Event) error {
+	s.publishToTopics(event)
+	// Delivery failures are logged, not retried, so a redelivered event is not
+	// posted twice to the channels that did get it
+	s.sendToChannels(ctx, event)
+	if err := s.ProcessEvent(ctx, event); err != nil {
+		s.log.Sugar().Errorw("Failed to process event", "error", err, "type", event.Type)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/nexusflow/nexusflow/pkg/kafka"
+	boardpb "github.com/nexusflow/nexusflow/pkg/proto/board/v1"
+	commonpb "github.com/nexusflow/nexusflow/pkg/proto/common/v1"
+	issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+	projectpb "github.com/nexusflow/nexusflow/pkg/proto/project/v1"
+	"github.com/nexusflow/nexusflow/services/notification-service/internal/websocket"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// feedEventPrefixes are the event types forwarded to topic subscribers
+var feedEventPrefixes = []string{"issue.", "comment.", "card.", "board.", "sprint."}
+
+// AuthorizeTopic lets project members subscribe to a project and to the boards
+// and issues in it
+func (s *NotificationService) AuthorizeTopic(ctx context.Context, userID, kind, id string) error {
+	var projectID string
+	switch kind {
+	case websocket.TopicProject:
+		projectID = id
+	case websocket.TopicBoard:
+		resp, err := s.boardClient.GetBoard(ctx, &boardpb.GetBoardRequest{Id: id})
+		if err != nil {
+			return lookupError("get board", err)
+		}
+		projectID = resp.Board.ProjectId
+	case websocket.TopicIssue:
+		resp, err := s.issueClient.GetIssue(ctx, &issuepb.GetIssueRequest{Id: id})
+		if err != nil {
+			return lookupError("get issue", err)
+		}
+		projectID = resp.Issue.ProjectId
+	default:
+		return websocket.ErrForbidden
+	}
+
+	member, err := s.isProjectMember(ctx, projectID, userID)
+	if err != nil {
+		return err
+	}
+	if !member {
+		return websocket.ErrForbidden
+	}
+	return nil
+}
+
+// isProjectMember reports whether a user leads or belongs to a project
+func (s *NotificationService) isProjectMember(ctx context.Context, projectID, userID string) (bool, error) {
+	projectResp, err := s.projectClient.GetProject(ctx, &projectpb.GetProjectRequest{Id: projectID})
+	if err != nil {
+		return false, lookupError("get project", err)
+	}
+	if projectResp.Project.LeadId == userID {
+		return true, nil
+	}
+
+	req := &projectpb.ListProjectMembersRequest{
+		ProjectId:  projectID,
+		Pagination: &commonpb.PaginationRequest{Page: 1, PageSize: 100},
+	}
+	for {
+		resp, err := s.projectClient.ListProjectMembers(ctx, req)
+		if err != nil {
+			return false, fmt.Errorf("list project members: %w", err)
+		}
+		for _, m := range resp.Members {
+			if m.UserId == userID {
+				return true, nil
+			}
+		}
+		if resp.Pagination == nil || req.Pagination.Page >= resp.Pagination.TotalPages {
+			return false, nil
+		}
+		req.Pagination.Page++
+	}
+}
+
+// lookupError hides topics that do not exist behind ErrForbidden so clients
+// cannot probe for IDs
+func lookupError(what string, err error) error {
+	if status.Code(err) == codes.NotFound {
+		return websocket.ErrForbidden
+	}
+	return fmt.Errorf("%s: %w", what, err)
+}
+
+// publishToTopics forwards an event to the board, issue and project topics it concerns
+func (s *NotificationService) publishToTopics(event kafka.Event) {
+	if s.hub == nil || !isFeedEvent(event.Type) {
+		return
+	}
+	for _, topic := range eventTopics(event) {
+		s.hub.Publish(&websocket.TopicMessage{
+			Topic:     topic,
+			Event:     event.Type,
+			UserID:    event.UserID,
+			Timestamp: event.Timestamp,
+			Payload:   event.Payload,
+		})
+	}
+}
+
+func isFeedEvent(eventType string) bool {
+	for _, prefix := range feedEventPrefixes {
+		if strings.HasPrefix(eventType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// eventTopics lists the topics of the board, issues and project named in an event
+func eventTopics(event kafka.Event) []string {
+	var topics []string
+	if id, _ := event.Payload["board_id"].(string); id != "" {
+		topics = append(topics, websocket.Topic(websocket.TopicBoard, id))
+	}
+	issueIDs := event.PayloadStrings("issue_ids")
+	if id, _ := event.Payload["issue_id"].(string); id != "" {
+		issueIDs = append(issueIDs, id)
+	}
+	for _, id := range issueIDs {
+		topics = append(topics, websocket.Topic(websocket.TopicIssue, id))
+	}
+	projectID := event.ProjectID
+	if projectID == "" {
+		projectID, _ = event.Payload["project_id"].(string)
+	}
+	if projectID != "" {
+		topics = append(topics, websocket.Topic(websocket.TopicProject, projectID))
+	}
+	return topics
+}
@@ -4,22 +4,126 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/nexusflow/nexusflow/pkg/kafka"
 	"github.com/nexusflow/nexusflow/pkg/logger"
+	boardpb "github.com/nexusflow/nexusflow/pkg/proto/board/v1"
+	issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
 	pb "github.com/nexusflow/nexusflow/pkg/proto/notification/v1"
+	orgpb "github.com/nexusflow/nexusflow/pkg/proto/org/v1"
+	projectpb "github.com/nexusflow/nexusflow/pkg/proto/project/v1"
+	workflowpb "github.com/nexusflow/nexusflow/pkg/proto/workflow/v1"
+	"github.com/nexusflow/nexusflow/services/notification-service/internal/channel"
 	"github.com/nexusflow/nexusflow/services/notification-service/internal/models"
 	"github.com/nexusflow/nexusflow/services/notification-service/internal/repository"
+	"github.com/nexusflow/nexusflow/services/notification-service/internal/secret"
 	"github.com/nexusflow/nexusflow/services/notification-service/internal/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 type NotificationService struct {
-	repo *repository.NotificationRepository
-	hub  *websocket.Hub
-	log  *logger.Logger
+	repo           Repository
+	hub            *websocket.Hub
+	log            *logger.Logger
+	projectClient  projectpb.ProjectServiceClient
+	issueClient    issuepb.IssueServiceClient
+	boardClient    boardpb.BoardServiceClient
+	workflowClient workflowpb.WorkflowServiceClient
+	orgClient      orgpb.OrgServiceClient
+	// appURL is the web app's base URL, used for links in chat messages
+	appURL     string
+	chatClient *http.Client
+	// secrets seals chat channel webhook URLs and tokens; nil when no key is
+	// configured, which disables chat channels
+	secrets *secret.Box
 }
 
-func NewNotificationService(repo *repository.NotificationRepository, hub *websocket.Hub, log *logger.Logger) *NotificationService {
-	return &NotificationService{repo: repo, hub: hub, log: log}
+// Repository is the notification storage the service works with, implemented
+// by *repository.NotificationRepository
+type Repository interface {
+	CreateNotification(ctx context.Context, notification *models.Notification) error
+	ListNotifications(ctx context.Context, userID string, filter repository.NotificationFilter, limit, offset int) ([]*models.Notification, int, error)
+	ListNotificationsSince(ctx context.Context, userID, lastID string, now time.Time, limit int) ([]*models.Notification, bool, error)
+	MarkAsRead(ctx context.Context, id string) error
+	MarkAllAsRead(ctx context.Context, userID string) error
+	GetUnreadCount(ctx context.Context, userID string) (int, error)
+	GetPreferences(ctx context.Context, userID string) ([]*models.NotificationPreference, error)
+	UpdatePreference(ctx context.Context, pref *models.NotificationPreference) error
+	DisabledInApp(ctx context.Context, userIDs []string, notificationType string) (map[string]bool, error)
+
+	ListThreads(ctx context.Context, userID string, filter repository.NotificationFilter, limit, offset int) ([]*models.NotificationThread, int, error)
+	SnoozeThread(ctx context.Context, userID, notificationID string, until time.Time) (int, error)
+	SetThreadArchived(ctx context.Context, userID, notificationID string, archived bool, now time.Time) (int, error)
+	WakeSnoozed(ctx context.Context, now time.Time) ([]*models.Notification, error)
+	PurgeReadNotifications(ctx context.Context, before time.Time) (int, error)
+
+	ListSchemeEntries(ctx context.Context, projectID string) ([]*models.SchemeEntry, error)
+	ReplaceSchemeEntries(ctx context.Context, projectID string, entries []*models.SchemeEntry) error
+
+	CreateChannel(ctx context.Context, ch *models.ChatChannel) error
+	GetChannel(ctx context.Context, id string) (*models.ChatChannel, error)
+	ListChannels(ctx context.Context, projectID string) ([]*models.ChatChannel, error)
+	ListChannelsWithPlainSecrets(ctx context.Context, sealedPrefix string) ([]*models.ChatChannel, error)
+	UpdateChannelSecrets(ctx context.Context, ch *models.ChatChannel) error
+	DeleteChannel(ctx context.Context, id string) (bool, error)
+	CreateRoute(ctx context.Context, route *models.ChannelRoute) error
+	ListRoutes(ctx context.Context, projectID string) ([]*models.ChannelRoute, error)
+	DeleteRoute(ctx context.Context, id string) (bool, error)
+}
+
+func NewNotificationService(
+	repo Repository,
+	hub *websocket.Hub,
+	log *logger.Logger,
+	projectServiceAddr string,
+	issueServiceAddr string,
+	boardServiceAddr string,
+	workflowServiceAddr string,
+	orgServiceAddr string,
+	appURL string,
+	secrets *secret.Box,
+) (*NotificationService, error) {
+	projectConn, err := grpc.Dial(projectServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to project service: %w", err)
+	}
+	issueConn, err := grpc.Dial(issueServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to issue service: %w", err)
+	}
+	boardConn, err := grpc.Dial(boardServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to board service: %w", err)
+	}
+	workflowConn, err := grpc.Dial(workflowServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to workflow service: %w", err)
+	}
+	orgConn, err := grpc.Dial(orgServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to org service: %w", err)
+	}
+
+	return &NotificationService{
+		repo:           repo,
+		hub:            hub,
+		log:            log,
+		projectClient:  projectpb.NewProjectServiceClient(projectConn),
+		issueClient:    issuepb.NewIssueServiceClient(issueConn),
+		boardClient:    boardpb.NewBoardServiceClient(boardConn),
+		workflowClient: workflowpb.NewWorkflowServiceClient(workflowConn),
+		orgClient:      orgpb.NewOrgServiceClient(orgConn),
+		appURL:         strings.TrimSuffix(appURL, "/"),
+		chatClient:     channel.NewClient(),
+		secrets:        secrets,
+	}, nil
 }
 
 // CreateNotification creates a notification and broadcasts it via WebSocket
@@ -36,8 +140,24 @@ func (s *NotificationService) CreateNotification(ctx context.Context, notificati
 	return nil
 }
 
-func (s *NotificationService) ListNotifications(ctx context.Context, userID string, limit, offset int) ([]*models.Notification, int, error) {
-	return s.repo.ListNotifications(ctx, userID, limit, offset)
+func (s *NotificationService) ListNotifications(ctx context.Context, userID string, filter repository.NotificationFilter, limit, offset int) ([]*models.Notification, int, error) {
+	filter.Now = time.Now()
+	return s.repo.ListNotifications(ctx, userID, filter, limit, offset)
+}
+
+// NotificationsSince lists the notifications a reconnecting WebSocket client missed
+func (s *NotificationService) NotificationsSince(ctx context.Context, userID, lastID string, limit int) ([]*models.Notification, error) {
+	if _, err := uuid.Parse(lastID); err != nil {
+		return nil, websocket.ErrUnknownNotification
+	}
+	notifications, found, err := s.repo.ListNotificationsSince(ctx, userID, lastID, time.Now(), limit)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, websocket.ErrUnknownNotification
+	}
+	return notifications, nil
 }
 
 func (s *NotificationService) MarkAsRead(ctx context.Context, id string) error {
@@ -72,71 +192,300 @@ func (s *NotificationService) UpdatePreference(ctx context.Context, req *pb.Upda
 }
 
 // ProcessEvent processes Kafka events and creates notifications
-func (s *NotificationService) ProcessEvent(ctx context.Context, eventType string, payload map[string]interface{}) error {
-	var notification *models.Notification
-
-	switch eventType {
+func (s *NotificationService) ProcessEvent(ctx context.Context, event kafka.Event) error {
+	switch event.Type {
+	case "automation.notify":
+		return s.createAutomationNotifications(ctx, event)
 	case "comment.mention_created":
-		notification = s.createMentionNotification(payload)
+		return s.createMentionNotification(ctx, event)
+	case "issue.created":
+		return s.notifyIssueEvent(ctx, event, models.NotificationTypeIssueCreated)
 	case "issue.assigned":
-		notification = s.createIssueAssignedNotification(payload)
+		return s.notifyIssueEvent(ctx, event, models.NotificationTypeIssueAssigned)
+	case "issue.updated":
+		return s.notifyIssueEvent(ctx, event, models.NotificationTypeIssueUpdated)
+	case "comment.created":
+		return s.notifyIssueEvent(ctx, event, models.NotificationTypeCommentCreated)
 	case "sprint.started":
-		notification = s.createSprintStartedNotification(payload)
+		return s.notifySprintEvent(ctx, event, models.NotificationTypeSprintStarted)
 	case "sprint.completed":
-		notification = s.createSprintCompletedNotification(payload)
+		return s.notifySprintEvent(ctx, event, models.NotificationTypeSprintCompleted)
 	default:
 		// Ignore unknown event types
 		return nil
 	}
+}
 
-	if notification != nil {
-		return s.CreateNotification(ctx, notification)
+// notifyUsers creates a notification of a type for each user who has not
+// turned that type off. build fills in the notification for one user. A user
+// who cannot be notified does not stop the others being notified; the error
+// is only returned when nobody could be, so that retrying the event cannot
+// notify anyone twice.
+func (s *NotificationService) notifyUsers(ctx context.Context, event kafka.Event, notificationType string, userIDs []string, build func(userID string) *models.Notification) error {
+	disabled, err := s.repo.DisabledInApp(ctx, userIDs, notificationType)
+	if err != nil {
+		return err
+	}
+	describe := s.notificationDescriber(ctx, event)
+	var firstErr error
+	created := 0
+	for _, userID := range userIDs {
+		if userID == "" || disabled[userID] {
+			continue
+		}
+		notification := build(userID)
+		notification.UserID = userID
+		notification.Type = notificationType
+		describe(notification)
+		if err := s.CreateNotification(ctx, notification); err != nil {
+			s.log.Sugar().Errorw("Failed to notify user", "error", err, "user_id", userID, "type", notificationType)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		created++
+	}
+	if created == 0 {
+		return firstErr
 	}
-
 	return nil
 }
 
-func (s *NotificationService) createMentionNotification(payload map[string]interface{}) *models.Notification {
+// eventActor is the user who caused an event
+func eventActor(event kafka.Event) string {
+	if event.UserID != "" {
+		return event.UserID
+	}
+	actor, _ := event.Payload["author_id"].(string)
+	return actor
+}
+
+// withoutUsers drops the excluded users from a list of recipients
+func withoutUsers(userIDs []string, excluded map[string]bool) []string {
+	var kept []string
+	for _, id := range userIDs {
+		if !excluded[id] {
+			kept = append(kept, id)
+		}
+	}
+	return kept
+}
+
+// notificationDescriber returns a function that records who caused a
+// notification of an event, its project and the thread it belongs to,
+// unless already set; notifications about the same issue or sprint share a
+// thread. Events that carry no issue key or project have them looked up
+// from the issue service, once per event.
+func (s *NotificationService) notificationDescriber(ctx context.Context, event kafka.Event) func(n *models.Notification) {
+	issueID, _ := event.Payload["issue_id"].(string)
+	key, _ := event.Payload["key"].(string)
+	if key == "" {
+		key, _ = event.Payload["issue_key"].(string)
+	}
+	projectID := event.ProjectID
+	if projectID == "" {
+		projectID, _ = event.Payload["project_id"].(string)
+	}
+	looked := false
+
+	return func(n *models.Notification) {
+		if n.ActorID == "" {
+			n.ActorID = eventActor(event)
+		}
+		needsIssue := (n.ProjectID == "" && projectID == "") || (n.GroupKey == "" && n.Subject == "" && key == "")
+		if issueID != "" && needsIssue && !looked {
+			looked = true
+			resp, err := s.issueClient.GetIssue(ctx, &issuepb.GetIssueRequest{Id: issueID})
+			if err != nil {
+				s.log.Sugar().Warnw("Failed to get issue of notification", "error", err, "issue_id", issueID)
+			} else if resp.Issue != nil {
+				if key == "" {
+					key = resp.Issue.Key
+				}
+				if projectID == "" {
+					projectID = resp.Issue.ProjectId
+				}
+			}
+		}
+
+		if n.ProjectID == "" {
+			n.ProjectID = projectID
+		}
+		if n.GroupKey != "" || issueID == "" {
+			return
+		}
+		n.GroupKey = "issue:" + issueID
+		if n.Subject == "" {
+			n.Subject = key
+		}
+	}
+}
+
+// notifyIssueEvent notifies the users a project's scheme names for an event
+// about an issue, except the user who caused it. An update that assigns the
+// issue also sends the assignment notification, and those notified of the
+// assignment are not notified of the update as well.
+func (s *NotificationService) notifyIssueEvent(ctx context.Context, event kafka.Event, notificationType string) error {
+	issueID, _ := event.Payload["issue_id"].(string)
+	if issueID == "" {
+		return nil
+	}
+	resp, err := s.issueClient.GetIssue(ctx, &issuepb.GetIssueRequest{Id: issueID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			// Deleted since; nobody needs to hear about it
+			return nil
+		}
+		return fmt.Errorf("get issue: %w", err)
+	}
+	issue := resp.Issue
+
+	excluded := map[string]bool{eventActor(event): true}
+	if notificationType == models.NotificationTypeIssueUpdated && assigneeChanged(event) {
+		recipients, err := s.schemeRecipients(ctx, issue.ProjectId, models.NotificationTypeIssueAssigned, issue)
+		if err != nil {
+			return err
+		}
+		recipients = withoutUsers(recipients, excluded)
+		if err := s.notifyUsers(ctx, event, models.NotificationTypeIssueAssigned, recipients, s.issueNotification(event, models.NotificationTypeIssueAssigned, issue)); err != nil {
+			return err
+		}
+		for _, id := range recipients {
+			excluded[id] = true
+		}
+	}
+
+	recipients, err := s.schemeRecipients(ctx, issue.ProjectId, notificationType, issue)
+	if err != nil {
+		return err
+	}
+	return s.notifyUsers(ctx, event, notificationType, withoutUsers(recipients, excluded), s.issueNotification(event, notificationType, issue))
+}
+
+// assigneeChanged reports whether an issue.updated event assigned the issue to someone
+func assigneeChanged(event kafka.Event) bool {
+	changes, _ := event.Payload["changes"].(map[string]interface{})
+	change, _ := changes["assignee_id"].(map[string]interface{})
+	to, _ := change["to"].(string)
+	return to != ""
+}
+
+func (s *NotificationService) issueNotification(event kafka.Event, notificationType string, issue *issuepb.Issue) func(userID string) *models.Notification {
+	metadata, _ := json.Marshal(event.Payload)
+	name := strings.TrimSpace(issue.Key + " " + issue.Summary)
+	link := fmt.Sprintf("/issues/%s", issue.Id)
+
+	return func(userID string) *models.Notification {
+		n := &models.Notification{
+			Link:      link,
+			Metadata:  metadata,
+			ProjectID: issue.ProjectId,
+			GroupKey:  "issue:" + issue.Id,
+			Subject:   issue.Key,
+		}
+		switch notificationType {
+		case models.NotificationTypeIssueCreated:
+			n.Title = "New issue"
+			n.Message = fmt.Sprintf("%s was created", name)
+		case models.NotificationTypeIssueAssigned:
+			if userID == issue.AssigneeId {
+				n.Title = "Issue assigned to you"
+				n.Message = fmt.Sprintf("You have been assigned to %s", issue.Key)
+			} else {
+				n.Title = "Issue assigned"
+				n.Message = fmt.Sprintf("%s was assigned", name)
+			}
+		case models.NotificationTypeCommentCreated:
+			commentID, _ := event.Payload["comment_id"].(string)
+			n.Title = "New comment"
+			n.Message = fmt.Sprintf("New comment on %s", name)
+			n.Link = fmt.Sprintf("/issues/%s#comment-%s", issue.Id, commentID)
+		default:
+			n.Title = "Issue updated"
+			n.Message = fmt.Sprintf("%s was updated", name)
+		}
+		return n
+	}
+}
+
+// notifySprintEvent notifies the users a project's scheme names for a sprint
+// starting or completing
+func (s *NotificationService) notifySprintEvent(ctx context.Context, event kafka.Event, notificationType string) error {
+	projectID, _ := event.Payload["project_id"].(string)
+	sprintID, _ := event.Payload["sprint_id"].(string)
+	if projectID == "" || sprintID == "" {
+		return nil
+	}
+	name, _ := event.Payload["name"].(string)
+	if name == "" {
+		name = "A sprint"
+	}
+
+	recipients, err := s.schemeRecipients(ctx, projectID, notificationType, nil)
+	if err != nil {
+		return err
+	}
+	recipients = withoutUsers(recipients, map[string]bool{eventActor(event): true})
+
+	metadata, _ := json.Marshal(event.Payload)
+	return s.notifyUsers(ctx, event, notificationType, recipients, func(string) *models.Notification {
+		n := &models.Notification{
+			Link:      fmt.Sprintf("/sprints/%s", sprintID),
+			Metadata:  metadata,
+			ProjectID: projectID,
+			GroupKey:  "sprint:" + sprintID,
+			Subject:   name,
+		}
+		if notificationType == models.NotificationTypeSprintStarted {
+			n.Title = "Sprint started"
+			n.Message = fmt.Sprintf("%s has started", name)
+		} else {
+			n.Title = "Sprint completed"
+			n.Message = fmt.Sprintf("%s was completed", name)
+		}
+		return n
+	})
+}
+
+func (s *NotificationService) createMentionNotification(ctx context.Context, event kafka.Event) error {
+	payload := event.Payload
 	userID, _ := payload["mentioned_user_id"].(string)
 	commentID, _ := payload["comment_id"].(string)
 	issueID, _ := payload["issue_id"].(string)
 
 	metadata, _ := json.Marshal(payload)
 
-	return &models.Notification{
-		UserID:   userID,
-		Type:     models.NotificationTypeCommentMention,
-		Title:    "You were mentioned",
-		Message:  "Someone mentioned you in a comment",
-		Link:     fmt.Sprintf("/issues/%s#comment-%s", issueID, commentID),
-		Metadata: metadata,
-	}
+	return s.notifyUsers(ctx, event, models.NotificationTypeCommentMention, []string{userID}, func(string) *models.Notification {
+		return &models.Notification{
+			Title:    "You were mentioned",
+			Message:  "Someone mentioned you in a comment",
+			Link:     fmt.Sprintf("/issues/%s#comment-%s", issueID, commentID),
+			Metadata: metadata,
+		}
+	})
 }
 
-func (s *NotificationService) createIssueAssignedNotification(payload map[string]interface{}) *models.Notification {
-	userID, _ := payload["assignee_id"].(string)
+// createAutomationNotifications notifies every recipient of an automation
+// rule's notify action
+func (s *NotificationService) createAutomationNotifications(ctx context.Context, event kafka.Event) error {
+	payload := event.Payload
+	title, _ := payload["title"].(string)
+	message, _ := payload["message"].(string)
 	issueID, _ := payload["issue_id"].(string)
-	issueKey, _ := payload["issue_key"].(string)
 
 	metadata, _ := json.Marshal(payload)
 
-	return &models.Notification{
-		UserID:   userID,
-		Type:     models.NotificationTypeIssueAssigned,
-		Title:    "Issue assigned to you",
-		Message:  fmt.Sprintf("You have been assigned to %s", issueKey),
-		Link:     fmt.Sprintf("/issues/%s", issueID),
-		Metadata: metadata,
+	link := ""
+	if issueID != "" {
+		link = fmt.Sprintf("/issues/%s", issueID)
 	}
-}
-
-func (s *NotificationService) createSprintStartedNotification(payload map[string]interface{}) *models.Notification {
-	// This would need to notify all team members
-	// For now, we'll skip this as we don't have team membership info
-	return nil
-}
-
-func (s *NotificationService) createSprintCompletedNotification(payload map[string]interface{}) *models.Notification {
-	// Similar to sprint started
-	return nil
+	return s.notifyUsers(ctx, event, models.NotificationTypeAutomation, event.PayloadStrings("user_ids"), func(string) *models.Notification {
+		return &models.Notification{
+			Title:    title,
+			Message:  message,
+			Link:     link,
+			Metadata: metadata,
+		}
+	})
 }
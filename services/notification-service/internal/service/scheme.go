@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	commonpb "github.com/nexusflow/nexusflow/pkg/proto/common/v1"
+	issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+	orgpb "github.com/nexusflow/nexusflow/pkg/proto/org/v1"
+	projectpb "github.com/nexusflow/nexusflow/pkg/proto/project/v1"
+	"github.com/nexusflow/nexusflow/services/notification-service/internal/models"
+)
+
+// SchemeEventTypes are the notification types a scheme decides the recipients
+// of. Mentions and automation notifications name their recipients themselves.
+var SchemeEventTypes = []string{
+	models.NotificationTypeIssueCreated,
+	models.NotificationTypeIssueAssigned,
+	models.NotificationTypeIssueUpdated,
+	models.NotificationTypeCommentCreated,
+	models.NotificationTypeSprintStarted,
+	models.NotificationTypeSprintCompleted,
+}
+
+// defaultScheme applies to projects that have not defined their own
+var defaultScheme = map[string][]*models.SchemeEntry{
+	models.NotificationTypeIssueCreated: {
+		{Recipient: models.RecipientAssignee},
+		{Recipient: models.RecipientWatchers},
+	},
+	models.NotificationTypeIssueAssigned: {
+		{Recipient: models.RecipientAssignee},
+	},
+	models.NotificationTypeIssueUpdated: {
+		{Recipient: models.RecipientAssignee},
+		{Recipient: models.RecipientReporter},
+		{Recipient: models.RecipientWatchers},
+	},
+	models.NotificationTypeCommentCreated: {
+		{Recipient: models.RecipientAssignee},
+		{Recipient: models.RecipientReporter},
+		{Recipient: models.RecipientWatchers},
+	},
+	models.NotificationTypeSprintStarted: {
+		{Recipient: models.RecipientProjectRole, Value: models.ProjectRoleLead},
+		{Recipient: models.RecipientProjectRole, Value: models.ProjectRoleAdmin},
+		{Recipient: models.RecipientProjectRole, Value: models.ProjectRoleMember},
+	},
+	models.NotificationTypeSprintCompleted: {
+		{Recipient: models.RecipientProjectRole, Value: models.ProjectRoleLead},
+		{Recipient: models.RecipientProjectRole, Value: models.ProjectRoleAdmin},
+		{Recipient: models.RecipientProjectRole, Value: models.ProjectRoleMember},
+	},
+}
+
+// GetNotificationScheme returns a project's notification scheme, or the
+// default scheme when the project has none
+func (s *NotificationService) GetNotificationScheme(ctx context.Context, projectID string) (entries []*models.SchemeEntry, isDefault bool, err error) {
+	if _, err := uuid.Parse(projectID); err != nil {
+		return nil, false, fmt.Errorf("%w: project_id must be a UUID", ErrValidation)
+	}
+	entries, err = s.repo.ListSchemeEntries(ctx, projectID)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(entries) > 0 {
+		return entries, false, nil
+	}
+	for _, eventType := range SchemeEventTypes {
+		for _, e := range defaultScheme[eventType] {
+			entries = append(entries, &models.SchemeEntry{ProjectID: projectID, EventType: eventType, Recipient: e.Recipient, Value: e.Value})
+		}
+	}
+	return entries, true, nil
+}
+
+// UpdateNotificationScheme replaces a project's notification scheme. Event
+// types left out of it notify nobody.
+func (s *NotificationService) UpdateNotificationScheme(ctx context.Context, projectID string, entries []*models.SchemeEntry) ([]*models.SchemeEntry, error) {
+	if _, err := uuid.Parse(projectID); err != nil {
+		return nil, fmt.Errorf("%w: project_id must be a UUID", ErrValidation)
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("%w: a scheme needs at least one entry; reset the scheme to use the default", ErrValidation)
+	}
+
+	seen := make(map[models.SchemeEntry]bool)
+	var unique []*models.SchemeEntry
+	for _, e := range entries {
+		if err := validateSchemeEntry(e); err != nil {
+			return nil, err
+		}
+		key := models.SchemeEntry{EventType: e.EventType, Recipient: e.Recipient, Value: e.Value}
+		if !seen[key] {
+			seen[key] = true
+			unique = append(unique, e)
+		}
+	}
+
+	if err := s.repo.ReplaceSchemeEntries(ctx, projectID, unique); err != nil {
+		return nil, err
+	}
+	return unique, nil
+}
+
+// ResetNotificationScheme makes a project use the default scheme again
+func (s *NotificationService) ResetNotificationScheme(ctx context.Context, projectID string) ([]*models.SchemeEntry, error) {
+	if _, err := uuid.Parse(projectID); err != nil {
+		return nil, fmt.Errorf("%w: project_id must be a UUID", ErrValidation)
+	}
+	if err := s.repo.ReplaceSchemeEntries(ctx, projectID, nil); err != nil {
+		return nil, err
+	}
+	entries, _, err := s.GetNotificationScheme(ctx, projectID)
+	return entries, err
+}
+
+func validateSchemeEntry(e *models.SchemeEntry) error {
+	known := false
+	for _, t := range SchemeEventTypes {
+		known = known || t == e.EventType
+	}
+	if !known {
+		return fmt.Errorf("%w: unsupported event type %q", ErrValidation, e.EventType)
+	}
+
+	switch e.Recipient {
+	case models.RecipientAssignee, models.RecipientReporter, models.RecipientWatchers:
+		if e.Value != "" {
+			return fmt.Errorf("%w: %s takes no value", ErrValidation, e.Recipient)
+		}
+	case models.RecipientProjectRole:
+		switch e.Value {
+		case models.ProjectRoleAdmin, models.ProjectRoleMember, models.ProjectRoleViewer, models.ProjectRoleLead:
+		default:
+			return fmt.Errorf("%w: unknown project role %q", ErrValidation, e.Value)
+		}
+	case models.RecipientTeam, models.RecipientUser:
+		if _, err := uuid.Parse(e.Value); err != nil {
+			return fmt.Errorf("%w: %s value must be a UUID", ErrValidation, e.Recipient)
+		}
+	default:
+		return fmt.Errorf("%w: unknown recipient %q", ErrValidation, e.Recipient)
+	}
+	return nil
+}
+
+// schemeRecipients resolves who a project's scheme notifies of an event type.
+// issue is nil for events that are not about an issue, such as sprint events;
+// issue recipients then match nobody.
+func (s *NotificationService) schemeRecipients(ctx context.Context, projectID, eventType string, issue *issuepb.Issue) ([]string, error) {
+	entries, _, err := s.GetNotificationScheme(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var recipients []string
+	seen := make(map[string]bool)
+	add := func(ids ...string) {
+		for _, id := range ids {
+			if id != "" && !seen[id] {
+				seen[id] = true
+				recipients = append(recipients, id)
+			}
+		}
+	}
+
+	var roles map[string][]string
+	for _, e := range entries {
+		if e.EventType != eventType {
+			continue
+		}
+		switch e.Recipient {
+		case models.RecipientAssignee:
+			if issue != nil {
+				add(issue.AssigneeId)
+			}
+		case models.RecipientReporter:
+			if issue != nil {
+				add(issue.ReporterId)
+			}
+		case models.RecipientWatchers:
+			if issue != nil {
+				add(issue.WatcherIds...)
+			}
+		case models.RecipientProjectRole:
+			if roles == nil {
+				if roles, err = s.projectRoles(ctx, projectID); err != nil {
+					return nil, err
+				}
+			}
+			add(roles[e.Value]...)
+		case models.RecipientTeam:
+			resp, err := s.orgClient.GetTeam(ctx, &orgpb.GetTeamRequest{Id: e.Value})
+			if err != nil {
+				// A deleted team should not stop everyone else being notified
+				s.log.Sugar().Warnw("Failed to get team of notification scheme", "error", err, "team_id", e.Value)
+				continue
+			}
+			add(resp.Team.MemberIds...)
+		case models.RecipientUser:
+			add(e.Value)
+		}
+	}
+	return recipients, nil
+}
+
+// projectRoles lists a project's users by role, the lead under "lead"
+func (s *NotificationService) projectRoles(ctx context.Context, projectID string) (map[string][]string, error) {
+	roles := make(map[string][]string)
+	projectResp, err := s.projectClient.GetProject(ctx, &projectpb.GetProjectRequest{Id: projectID})
+	if err != nil {
+		return nil, fmt.Errorf("get project: %w", err)
+	}
+	if projectResp.Project.LeadId != "" {
+		roles[models.ProjectRoleLead] = []string{projectResp.Project.LeadId}
+	}
+
+	req := &projectpb.ListProjectMembersRequest{
+		ProjectId:  projectID,
+		Pagination: &commonpb.PaginationRequest{Page: 1, PageSize: 100},
+	}
+	for {
+		resp, err := s.projectClient.ListProjectMembers(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("list project members: %w", err)
+		}
+		for _, m := range resp.Members {
+			switch m.Role {
+			case projectpb.ProjectRole_PROJECT_ROLE_ADMIN:
+				roles[models.ProjectRoleAdmin] = append(roles[models.ProjectRoleAdmin], m.UserId)
+			case projectpb.ProjectRole_PROJECT_ROLE_MEMBER:
+				roles[models.ProjectRoleMember] = append(roles[models.ProjectRoleMember], m.UserId)
+			case projectpb.ProjectRole_PROJECT_ROLE_VIEWER:
+				roles[models.ProjectRoleViewer] = append(roles[models.ProjectRoleViewer], m.UserId)
+			}
+		}
+		if resp.Pagination == nil || req.Pagination.Page >= resp.Pagination.TotalPages {
+			return roles, nil
+		}
+		req.Pagination.Page++
+	}
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/nexusflow/nexusflow/pkg/kafka"
+	"github.com/nexusflow/nexusflow/pkg/logger"
+	commonpb "github.com/nexusflow/nexusflow/pkg/proto/common/v1"
+	issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+	orgpb "github.com/nexusflow/nexusflow/pkg/proto/org/v1"
+	projectpb "github.com/nexusflow/nexusflow/pkg/proto/project/v1"
+	"github.com/nexusflow/nexusflow/services/notification-service/internal/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testProjectID = "8d3c7f0e-5b1a-4f7e-9a43-0c6f2d1e7b55"
+
+// fakeRepo keeps a project's scheme, the users who turned notifications off
+// and the notifications created. Other repository methods are not used.
+type fakeRepo struct {
+	Repository
+	entries  []*models.SchemeEntry
+	disabled map[string]bool
+	failFor  map[string]bool
+	created  []*models.Notification
+}
+
+func (r *fakeRepo) ListSchemeEntries(ctx context.Context, projectID string) ([]*models.SchemeEntry, error) {
+	return r.entries, nil
+}
+
+func (r *fakeRepo) DisabledInApp(ctx context.Context, userIDs []string, notificationType string) (map[string]bool, error) {
+	return r.disabled, nil
+}
+
+func (r *fakeRepo) CreateNotification(ctx context.Context, notification *models.Notification) error {
+	if r.failFor[notification.UserID] {
+		return errors.New("connection reset")
+	}
+	r.created = append(r.created, notification)
+	return nil
+}
+
+// notified lists the users notified of a type
+func (r *fakeRepo) notified(notificationType string) []string {
+	var userIDs []string
+	for _, n := range r.created {
+		if n.Type == notificationType {
+			userIDs = append(userIDs, n.UserID)
+		}
+	}
+	sort.Strings(userIDs)
+	return userIDs
+}
+
+// fakeProjectClient serves a project led by "lead" with an admin, a member
+// and a viewer, one per page
+type fakeProjectClient struct {
+	projectpb.ProjectServiceClient
+}
+
+func (c *fakeProjectClient) GetProject(ctx context.Context, in *projectpb.GetProjectRequest, opts ...grpc.CallOption) (*projectpb.GetProjectResponse, error) {
+	return &projectpb.GetProjectResponse{Project: &projectpb.Project{Id: in.Id, LeadId: "lead"}}, nil
+}
+
+func (c *fakeProjectClient) ListProjectMembers(ctx context.Context, in *projectpb.ListProjectMembersRequest, opts ...grpc.CallOption) (*projectpb.ListProjectMembersResponse, error) {
+	members := []*projectpb.ProjectMember{
+		{UserId: "admin", Role: projectpb.ProjectRole_PROJECT_ROLE_ADMIN},
+		{UserId: "member", Role: projectpb.ProjectRole_PROJECT_ROLE_MEMBER},
+		{UserId: "viewer", Role: projectpb.ProjectRole_PROJECT_ROLE_VIEWER},
+	}
+	page := in.Pagination.Page
+	return &projectpb.ListProjectMembersResponse{
+		Members:    members[page-1 : page],
+		Pagination: &commonpb.PaginationResponse{Page: page, TotalPages: int32(len(members))},
+	}, nil
+}
+
+// fakeOrgClient serves the "qa" team; other teams are not found
+type fakeOrgClient struct {
+	orgpb.OrgServiceClient
+}
+
+func (c *fakeOrgClient) GetTeam(ctx context.Context, in *orgpb.GetTeamRequest, opts ...grpc.CallOption) (*orgpb.GetTeamResponse, error) {
+	if in.Id != "qa" {
+		return nil, status.Error(codes.NotFound, "team not found")
+	}
+	return &orgpb.GetTeamResponse{Team: &orgpb.Team{Id: in.Id, MemberIds: []string{"tester", "reporter"}}}, nil
+}
+
+type fakeIssueClient struct {
+	issuepb.IssueServiceClient
+	issue *issuepb.Issue
+}
+
+func (c *fakeIssueClient) GetIssue(ctx context.Context, in *issuepb.GetIssueRequest, opts ...grpc.CallOption) (*issuepb.GetIssueResponse, error) {
+	if c.issue == nil || in.Id != c.issue.Id {
+		return nil, status.Error(codes.NotFound, "issue not found")
+	}
+	return &issuepb.GetIssueResponse{Issue: c.issue}, nil
+}
+
+func testIssue() *issuepb.Issue {
+	return &issuepb.Issue{
+		Id:         "issue-1",
+		Key:        "NF-1",
+		Summary:    "Fix login",
+		ProjectId:  testProjectID,
+		AssigneeId: "assignee",
+		ReporterId: "reporter",
+		WatcherIds: []string{"watcher", "assignee"},
+	}
+}
+
+func newTestService(t *testing.T, repo *fakeRepo) *NotificationService {
+	t.Helper()
+	log, err := logger.NewDefault("notification-service-test")
+	if err != nil {
+		t.Fatalf("NewDefault() error = %v", err)
+	}
+	return &NotificationService{
+		repo:          repo,
+		log:           log,
+		projectClient: &fakeProjectClient{},
+		issueClient:   &fakeIssueClient{issue: testIssue()},
+		orgClient:     &fakeOrgClient{},
+	}
+}
+
+func TestSchemeRecipients(t *testing.T) {
+	entry := func(eventType, recipient, value string) *models.SchemeEntry {
+		return &models.SchemeEntry{ProjectID: testProjectID, EventType: eventType, Recipient: recipient, Value: value}
+	}
+	tests := []struct {
+		name      string
+		entries   []*models.SchemeEntry
+		eventType string
+		issue     *issuepb.Issue
+		want      []string
+	}{
+		{"Default scheme for a new issue", nil, models.NotificationTypeIssueCreated, testIssue(),
+			[]string{"assignee", "watcher"}},
+		{"Default scheme for an update", nil, models.NotificationTypeIssueUpdated, testIssue(),
+			[]string{"assignee", "reporter", "watcher"}},
+		{"Default scheme for a sprint", nil, models.NotificationTypeSprintStarted, nil,
+			[]string{"lead", "admin", "member"}},
+		{"Custom scheme", []*models.SchemeEntry{
+			entry(models.NotificationTypeIssueCreated, models.RecipientReporter, ""),
+			entry(models.NotificationTypeIssueCreated, models.RecipientProjectRole, models.ProjectRoleViewer),
+			entry(models.NotificationTypeIssueCreated, models.RecipientTeam, "qa"),
+			entry(models.NotificationTypeIssueCreated, models.RecipientUser, "manager"),
+			entry(models.NotificationTypeIssueUpdated, models.RecipientUser, "someone else"),
+		}, models.NotificationTypeIssueCreated, testIssue(),
+			[]string{"reporter", "viewer", "tester", "manager"}},
+		{"Custom scheme leaving out the event", []*models.SchemeEntry{
+			entry(models.NotificationTypeIssueUpdated, models.RecipientAssignee, ""),
+		}, models.NotificationTypeIssueCreated, testIssue(), nil},
+		{"Deleted team", []*models.SchemeEntry{
+			entry(models.NotificationTypeIssueCreated, models.RecipientTeam, "gone"),
+			entry(models.NotificationTypeIssueCreated, models.RecipientAssignee, ""),
+		}, models.NotificationTypeIssueCreated, testIssue(), []string{"assignee"}},
+		{"Issue recipients of a sprint", []*models.SchemeEntry{
+			entry(models.NotificationTypeSprintCompleted, models.RecipientAssignee, ""),
+			entry(models.NotificationTypeSprintCompleted, models.RecipientProjectRole, models.ProjectRoleLead),
+		}, models.NotificationTypeSprintCompleted, nil, []string{"lead"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(t, &fakeRepo{entries: tt.entries})
+			got, err := s.schemeRecipients(context.Background(), testProjectID, tt.eventType, tt.issue)
+			if err != nil {
+				t.Fatalf("schemeRecipients() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("schemeRecipients() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNotifyIssueEvent(t *testing.T) {
+	assigned := map[string]interface{}{
+		"issue_id": "issue-1",
+		"changes": map[string]interface{}{
+			"assignee_id": map[string]interface{}{"from": "", "to": "assignee"},
+		},
+	}
+	tests := []struct {
+		name         string
+		eventType    string
+		actor        string
+		payload      map[string]interface{}
+		disabled     map[string]bool
+		failFor      map[string]bool
+		wantAssigned []string
+		wantUpdated  []string
+		wantErr      bool
+	}{
+		{"Update", "issue.updated", "someone", map[string]interface{}{"issue_id": "issue-1"}, nil, nil,
+			nil, []string{"assignee", "reporter", "watcher"}, false},
+		{"Actor is left out", "issue.updated", "reporter", map[string]interface{}{"issue_id": "issue-1"}, nil, nil,
+			nil, []string{"assignee", "watcher"}, false},
+		{"Assignment is not an update too", "issue.updated", "someone", assigned, nil, nil,
+			[]string{"assignee"}, []string{"reporter", "watcher"}, false},
+		{"Assigning yourself", "issue.updated", "assignee", assigned, nil, nil,
+			nil, []string{"reporter", "watcher"}, false},
+		{"Turned off", "issue.updated", "someone", assigned, map[string]bool{"assignee": true, "watcher": true}, nil,
+			nil, []string{"reporter"}, false},
+		{"One user failing", "issue.updated", "someone", map[string]interface{}{"issue_id": "issue-1"}, nil, map[string]bool{"assignee": true},
+			nil, []string{"reporter", "watcher"}, false},
+		{"Every user failing", "issue.updated", "someone", map[string]interface{}{"issue_id": "issue-1"}, nil, map[string]bool{"assignee": true, "reporter": true, "watcher": true},
+			nil, nil, true},
+		{"Deleted issue", "issue.updated", "someone", map[string]interface{}{"issue_id": "issue-2"}, nil, nil,
+			nil, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeRepo{disabled: tt.disabled, failFor: tt.failFor}
+			s := newTestService(t, repo)
+			event := kafka.Event{Type: tt.eventType, UserID: tt.actor, Payload: tt.payload}
+			err := s.ProcessEvent(context.Background(), event)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ProcessEvent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := repo.notified(models.NotificationTypeIssueAssigned); !reflect.DeepEqual(got, tt.wantAssigned) {
+				t.Errorf("notified of the assignment = %q, want %q", got, tt.wantAssigned)
+			}
+			if got := repo.notified(models.NotificationTypeIssueUpdated); !reflect.DeepEqual(got, tt.wantUpdated) {
+				t.Errorf("notified of the update = %q, want %q", got, tt.wantUpdated)
+			}
+			for _, n := range repo.created {
+				if n.ActorID != tt.actor || n.Subject != "NF-1" || n.GroupKey != "issue:issue-1" {
+					t.Errorf("notification = %+v, want actor %q, subject NF-1 and group issue:issue-1", n, tt.actor)
+				}
+			}
+		})
+	}
+}
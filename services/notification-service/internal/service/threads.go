@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nexusflow/nexusflow/services/notification-service/internal/models"
+	"github.com/nexusflow/nexusflow/services/notification-service/internal/repository"
+)
+
+// ListThreads lists a user's notifications collapsed into threads
+func (s *NotificationService) ListThreads(ctx context.Context, userID string, filter repository.NotificationFilter, limit, offset int) ([]*models.NotificationThread, int, error) {
+	filter.Now = time.Now()
+	return s.repo.ListThreads(ctx, userID, filter, limit, offset)
+}
+
+// ThreadSummary describes a thread in one line, e.g. "5 updates on WEB-12"
+func ThreadSummary(t *models.NotificationThread) string {
+	switch {
+	case t.Count <= 1:
+		return t.Title
+	case t.Subject != "":
+		return fmt.Sprintf("%d updates on %s", t.Count, t.Subject)
+	default:
+		return fmt.Sprintf("%d updates", t.Count)
+	}
+}
+
+// SnoozeNotification hides a notification's thread from the inbox until a time;
+// a zero time ends the snooze. It returns the number of notifications snoozed.
+func (s *NotificationService) SnoozeNotification(ctx context.Context, userID, notificationID string, until time.Time) (int, error) {
+	if _, err := uuid.Parse(notificationID); err != nil {
+		return 0, fmt.Errorf("%w: notification", ErrNotFound)
+	}
+	if !until.IsZero() && !until.After(time.Now()) {
+		return 0, fmt.Errorf("%w: snooze must end in the future", ErrValidation)
+	}
+	n, err := s.repo.SnoozeThread(ctx, userID, notificationID, until)
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, fmt.Errorf("%w: notification", ErrNotFound)
+	}
+	return n, nil
+}
+
+// ArchiveNotification moves a notification's thread out of the inbox
+func (s *NotificationService) ArchiveNotification(ctx context.Context, userID, notificationID string) (int, error) {
+	return s.setArchived(ctx, userID, notificationID, true)
+}
+
+// UnarchiveNotification moves a notification's thread back to the inbox
+func (s *NotificationService) UnarchiveNotification(ctx context.Context, userID, notificationID string) (int, error) {
+	return s.setArchived(ctx, userID, notificationID, false)
+}
+
+func (s *NotificationService) setArchived(ctx context.Context, userID, notificationID string, archived bool) (int, error) {
+	if _, err := uuid.Parse(notificationID); err != nil {
+		return 0, fmt.Errorf("%w: notification", ErrNotFound)
+	}
+	n, err := s.repo.SetThreadArchived(ctx, userID, notificationID, archived, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, fmt.Errorf("%w: notification", ErrNotFound)
+	}
+	return n, nil
+}
+
+// RunSnoozeWaker returns notifications whose snooze is over to their users'
+// inboxes, pushing them to connected clients again, until ctx is done
+func (s *NotificationService) RunSnoozeWaker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		notifications, err := s.repo.WakeSnoozed(ctx, time.Now())
+		if err != nil {
+			s.log.Sugar().Errorw("Failed to wake snoozed notifications", "error", err)
+		}
+		if s.hub != nil {
+			for _, n := range notifications {
+				s.hub.Broadcast(n.UserID, n)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunRetentionPurge deletes read notifications older than retention every
+// interval until ctx is done. Unread notifications are kept.
+func (s *NotificationService) RunRetentionPurge(ctx context.Context, retention, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		n, err := s.repo.PurgeReadNotifications(ctx, time.Now().Add(-retention))
+		if err != nil {
+			s.log.Sugar().Errorw("Failed to purge read notifications", "error", err)
+		} else if n > 0 {
+			s.log.Sugar().Infow("Purged read notifications", "count", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
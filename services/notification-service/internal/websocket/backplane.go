@@ -0,0 +1,98 @@
+package websocket
+
+import (
+	"context"
+	"sync"
+
+	"github.com/nexusflow/nexusflow/services/notification-service/internal/models"
+)
+
+// Backplane fans hub messages out to every notification-service instance. Each
+// instance receives everything published, including its own messages, and
+// delivers it to the sockets connected to it.
+type Backplane interface {
+	// Publish sends a message to every subscribed instance
+	Publish(ctx context.Context, data []byte) error
+	// Subscribe calls handler for each message until ctx is done
+	Subscribe(ctx context.Context, handler func(data []byte)) error
+	Close() error
+}
+
+// envelope is the message carried by the backplane
+type envelope struct {
+	Origin       string               `json:"origin"` // Instance that published it
+	UserID       string               `json:"user_id,omitempty"`
+	Notification *models.Notification `json:"notification,omitempty"`
+	Topic        *TopicMessage        `json:"topic,omitempty"`
+	Presence     *presenceMessage     `json:"presence,omitempty"`
+}
+
+// presenceMessage lists the users viewing a topic on one instance. Sync asks the
+// other instances to announce their own viewers, so a newly joined instance
+// learns about users connected elsewhere.
+type presenceMessage struct {
+	Topic   string   `json:"topic"`
+	UserIDs []string `json:"user_ids"`
+	Sync    bool     `json:"sync,omitempty"`
+}
+
+// MemoryBackplane fans out within the process. It serves a single instance, and
+// several hubs sharing one stand in for a cluster in tests.
+type MemoryBackplane struct {
+	mu          sync.RWMutex
+	subscribers map[int]chan []byte
+	next        int
+	closed      bool
+}
+
+func NewMemoryBackplane() *MemoryBackplane {
+	return &MemoryBackplane{subscribers: make(map[int]chan []byte)}
+}
+
+func (b *MemoryBackplane) Publish(ctx context.Context, data []byte) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, ch := range b.subscribers {
+		select {
+		case ch <- data:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (b *MemoryBackplane) Subscribe(ctx context.Context, handler func(data []byte)) error {
+	ch := make(chan []byte, 256)
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil
+	}
+	id := b.next
+	b.next++
+	b.subscribers[id] = ch
+	b.mu.Unlock()
+
+	defer func() {
+		b.mu.Lock()
+		delete(b.subscribers, id)
+		b.mu.Unlock()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case data := <-ch:
+			handler(data)
+		}
+	}
+}
+
+func (b *MemoryBackplane) Close() error {
+	b.mu.Lock()
+	b.closed = true
+	b.mu.Unlock()
+	return nil
+}
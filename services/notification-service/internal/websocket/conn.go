@@ -0,0 +1,166 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/nexusflow/nexusflow/services/notification-service/internal/models"
+)
+
+const (
+	// writeWait bounds a single write to a socket
+	writeWait = 10 * time.Second
+	// pongWait is how long a socket may stay silent before it is dropped
+	pongWait = 60 * time.Second
+	// pingPeriod must be shorter than pongWait so pongs arrive in time
+	pingPeriod = pongWait * 9 / 10
+	// maxFrameSize bounds the frames clients send
+	maxFrameSize = 4096
+	// sendBufferSize is how many frames may wait for a slow client before it is disconnected
+	sendBufferSize = 256
+	// maxReplay bounds the notifications replayed to a resuming client
+	maxReplay = 500
+)
+
+// ErrUnknownNotification is returned by a Replayer when the last seen
+// notification no longer exists, so there is nothing to resume from
+var ErrUnknownNotification = errors.New("unknown notification")
+
+// Replayer finds the notifications a resuming client missed
+type Replayer interface {
+	// NotificationsSince lists up to limit of a user's notifications created
+	// after lastID, oldest first
+	NotificationsSince(ctx context.Context, userID, lastID string, limit int) ([]*models.Notification, error)
+}
+
+type replayResult struct {
+	client        *Client
+	notifications []*models.Notification
+	truncated     bool
+}
+
+// Serve runs an authenticated socket until it closes. A client resuming after
+// lastSeenID first gets the notifications it missed, then the live ones.
+func (h *Hub) Serve(ctx context.Context, userID string, conn *websocket.Conn, lastSeenID string) {
+	client := &Client{
+		UserID:    userID,
+		Conn:      conn,
+		send:      make(chan []byte, sendBufferSize),
+		replaying: lastSeenID != "",
+	}
+	h.Register(client)
+	go h.writePump(client)
+
+	if lastSeenID != "" {
+		// Registering first holds back live notifications, so none fall between
+		// the replay and the live feed
+		h.replays <- h.loadReplay(ctx, client, lastSeenID)
+	}
+	h.readPump(ctx, client)
+}
+
+func (h *Hub) loadReplay(ctx context.Context, client *Client, lastSeenID string) *replayResult {
+	result := &replayResult{client: client, truncated: true}
+	if h.replayer == nil {
+		return result
+	}
+	notifications, err := h.replayer.NotificationsSince(ctx, client.UserID, lastSeenID, maxReplay+1)
+	if err != nil {
+		if !errors.Is(err, ErrUnknownNotification) {
+			h.log.Sugar().Errorw("Failed to load missed notifications", "error", err, "user_id", client.UserID)
+		}
+		return result
+	}
+	result.truncated = len(notifications) > maxReplay
+	if result.truncated {
+		// Send the most recent ones; the client reloads the rest from the API
+		notifications = notifications[len(notifications)-maxReplay:]
+	}
+	result.notifications = notifications
+	return result
+}
+
+// finishReplay runs on Run's goroutine. It sends the replay, then the live
+// notifications held back meanwhile that were not part of it.
+func (h *Hub) finishReplay(result *replayResult) {
+	client := result.client
+	if client.closed {
+		return
+	}
+	replayed := make(map[string]bool, len(result.notifications))
+	for _, n := range result.notifications {
+		replayed[n.ID] = true
+		h.writeNotification(client, n)
+	}
+	h.send(client, &ServerFrame{Type: FrameReplayed, Count: len(result.notifications), Truncated: result.truncated})
+
+	client.replaying = false
+	for _, n := range client.pending {
+		if !replayed[n.ID] {
+			h.writeNotification(client, n)
+		}
+	}
+	client.pending = nil
+}
+
+func (h *Hub) writeNotification(client *Client, n *models.Notification) {
+	data, err := json.Marshal(n)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to marshal notification", "error", err)
+		return
+	}
+	h.write(client, data)
+}
+
+// readPump reads the client's frames until the socket fails or goes quiet
+func (h *Hub) readPump(ctx context.Context, client *Client) {
+	defer h.Unregister(client)
+
+	conn := client.Conn
+	conn.SetReadLimit(maxFrameSize)
+	_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		h.HandleFrame(ctx, client, data)
+	}
+}
+
+// writePump is the only writer to the socket. It drains the client's queue and
+// pings the client so dead connections are noticed.
+func (h *Hub) writePump(client *Client) {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		client.Conn.Close()
+	}()
+
+	conn := client.Conn
+	for {
+		select {
+		case data, ok := <-client.send:
+			_ = conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if !ok {
+				_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+				return
+			}
+			if err := conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				h.log.Sugar().Warnw("Failed to send message", "error", err, "user_id", client.UserID)
+				return
+			}
+		case <-ticker.C:
+			_ = conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
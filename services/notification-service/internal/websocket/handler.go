@@ -0,0 +1,135 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/nexusflow/nexusflow/pkg/logger"
+)
+
+// authenticateTimeout bounds the token check done when a client connects
+const authenticateTimeout = 5 * time.Second
+
+// ErrUnauthenticated is returned by an Authenticator for invalid or expired tokens
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// Authenticator resolves the access token of a connecting client to its user
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (userID string, err error)
+}
+
+// Handler upgrades authenticated requests to sockets served by a hub. Browsers
+// cannot set headers on WebSocket requests, so the token may also be passed in
+// the access_token query parameter. A reconnecting client passes the ID of the
+// last notification it saw as last_notification_id.
+type Handler struct {
+	hub           *Hub
+	authenticator Authenticator
+	upgrader      websocket.Upgrader
+	log           *logger.Logger
+}
+
+// NewHandler creates the /ws handler. Without allowed origins only same-origin
+// browser requests are accepted.
+func NewHandler(hub *Hub, authenticator Authenticator, allowedOrigins []string, log *logger.Logger) *Handler {
+	h := &Handler{hub: hub, authenticator: authenticator, log: log}
+	if len(allowedOrigins) > 0 {
+		allowed := make(map[string]bool, len(allowedOrigins))
+		for _, o := range allowedOrigins {
+			allowed[strings.TrimSuffix(o, "/")] = true
+		}
+		h.upgrader.CheckOrigin = func(r *http.Request) bool {
+			origin := r.Header.Get("Origin")
+			return origin == "" || allowed[origin]
+		}
+	}
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	token := bearerToken(r)
+	if token == "" {
+		http.Error(w, "access token required", http.StatusUnauthorized)
+		return
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), authenticateTimeout)
+	userID, err := h.authenticator.Authenticate(ctx, token)
+	cancel()
+	if err != nil {
+		if errors.Is(err, ErrUnauthenticated) {
+			http.Error(w, "invalid access token", http.StatusUnauthorized)
+			return
+		}
+		h.log.Sugar().Errorw("Failed to authenticate WebSocket client", "error", err)
+		http.Error(w, "authentication unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	// Upgrade writes the error response itself when the origin is refused
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		h.log.Sugar().Warnw("Failed to upgrade connection", "error", err)
+		return
+	}
+	h.hub.Serve(r.Context(), userID, conn, r.URL.Query().Get("last_notification_id"))
+}
+
+func bearerToken(r *http.Request) string {
+	if header := r.Header.Get("Authorization"); strings.HasPrefix(header, "Bearer ") {
+		return strings.TrimPrefix(header, "Bearer ")
+	}
+	return r.URL.Query().Get("access_token")
+}
+
+// HydraAuthenticator checks OAuth2 access tokens with Ory Hydra's token
+// introspection endpoint; the token's subject is the user ID
+type HydraAuthenticator struct {
+	introspectURL string
+	client        *http.Client
+}
+
+func NewHydraAuthenticator(adminURL string) *HydraAuthenticator {
+	return &HydraAuthenticator{
+		introspectURL: strings.TrimSuffix(adminURL, "/") + "/admin/oauth2/introspect",
+		client:        &http.Client{Timeout: authenticateTimeout},
+	}
+}
+
+func (a *HydraAuthenticator) Authenticate(ctx context.Context, token string) (string, error) {
+	form := url.Values{"token": {token}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.introspectURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("introspect token: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("introspect token: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("introspect token: unexpected status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Active   bool   `json:"active"`
+		Subject  string `json:"sub"`
+		TokenUse string `json:"token_use"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("decode introspection: %w", err)
+	}
+	if !result.Active || result.Subject == "" || (result.TokenUse != "" && result.TokenUse != "access_token") {
+		return "", ErrUnauthenticated
+	}
+	return result.Subject, nil
+}
@@ -1,26 +1,86 @@
 package websocket
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/nexusflow/nexusflow/pkg/logger"
 	"github.com/nexusflow/nexusflow/services/notification-service/internal/models"
 )
 
+// Topic kinds a client can subscribe to, as "<kind>:<id>"
+const (
+	TopicBoard   = "board"
+	TopicIssue   = "issue"
+	TopicProject = "project"
+)
+
+// Frame types sent to clients for topic subscriptions. Notifications are still
+// sent as bare notification objects.
+const (
+	FrameSubscribed   = "subscribed"
+	FrameUnsubscribed = "unsubscribed"
+	FrameEvent        = "event"
+	FramePresence     = "presence"
+	FrameReplayed     = "replayed"
+	FrameError        = "error"
+)
+
+// maxTopicsPerClient bounds the subscriptions of a single connection
+const maxTopicsPerClient = 100
+
+// authorizeTimeout bounds the lookups done when a client subscribes
+const authorizeTimeout = 5 * time.Second
+
+// publishTimeout bounds a publish to the backplane
+const publishTimeout = 5 * time.Second
+
+// ErrForbidden is returned by an Authorizer when the user may not see a topic
+var ErrForbidden = errors.New("forbidden")
+
+// Authorizer decides whether a user may subscribe to a topic
+type Authorizer interface {
+	AuthorizeTopic(ctx context.Context, userID, kind, id string) error
+}
+
+// Hub delivers notifications and topic events to the sockets connected to this
+// instance. Messages go out through the backplane and come back to every
+// instance, so a user gets them whichever instance they are connected to.
 type Hub struct {
-	clients    map[string]map[*websocket.Conn]bool // userID -> connections
+	clients    map[string]map[*websocket.Conn]*Client // userID -> connections
+	topics     map[string]map[*Client]bool            // topic -> subscribers
+	remote     map[string]map[string][]string         // topic -> instance -> viewers on other instances
 	broadcast  chan *BroadcastMessage
+	publish    chan *TopicMessage
+	presence   chan *envelope
+	subscribe  chan *subscription
+	replays    chan *replayResult
 	register   chan *Client
 	unregister chan *Client
+	outbound   chan []byte
+	backplane  Backplane
+	instanceID string
+	authorizer Authorizer
+	replayer   Replayer
 	mu         sync.RWMutex
 	log        *logger.Logger
 }
 
+// Client is one socket. Everything but UserID and Conn is owned by Run.
 type Client struct {
-	UserID string
-	Conn   *websocket.Conn
+	UserID    string
+	Conn      *websocket.Conn
+	topics    map[string]bool
+	send      chan []byte // Drained by the client's writer goroutine
+	closed    bool
+	replaying bool                   // Live notifications wait in pending until the replay is sent
+	pending   []*models.Notification // Notifications received while replaying
 }
 
 type BroadcastMessage struct {
@@ -28,62 +88,148 @@ type BroadcastMessage struct {
 	Notification *models.Notification
 }
 
-func NewHub(log *logger.Logger) *Hub {
+// TopicMessage is an event delivered to the subscribers of a topic
+type TopicMessage struct {
+	Topic     string                 `json:"topic"`
+	Event     string                 `json:"event"`
+	UserID    string                 `json:"user_id,omitempty"` // Who caused the event, so clients can skip their own changes
+	Timestamp time.Time              `json:"timestamp"`
+	Payload   map[string]interface{} `json:"payload,omitempty"`
+}
+
+// ClientFrame is a request sent by a client over the socket
+type ClientFrame struct {
+	Action string `json:"action"` // "subscribe" or "unsubscribe"
+	Topic  string `json:"topic"`
+}
+
+// ServerFrame is a topic frame sent to a client
+type ServerFrame struct {
+	Type      string                 `json:"type"`
+	Topic     string                 `json:"topic,omitempty"`
+	Event     string                 `json:"event,omitempty"`
+	UserID    string                 `json:"user_id,omitempty"`
+	Timestamp *time.Time             `json:"timestamp,omitempty"`
+	Payload   map[string]interface{} `json:"payload,omitempty"`
+	UserIDs   []string               `json:"user_ids,omitempty"`  // Users viewing the topic, for presence frames
+	Count     int                    `json:"count,omitempty"`     // Notifications replayed, for replayed frames
+	Truncated bool                   `json:"truncated,omitempty"` // Not everything missed could be replayed
+	Error     string                 `json:"error,omitempty"`
+}
+
+// subscription asks Run to change the topics of a client, or to send it an error
+type subscription struct {
+	client *Client
+	topic  string
+	add    bool
+	err    string
+}
+
+// NewHub creates a hub for one instance. instanceID must be unique among the
+// instances sharing the backplane.
+func NewHub(log *logger.Logger, backplane Backplane, instanceID string) *Hub {
 	return &Hub{
-		clients:    make(map[string]map[*websocket.Conn]bool),
+		clients:    make(map[string]map[*websocket.Conn]*Client),
+		topics:     make(map[string]map[*Client]bool),
+		remote:     make(map[string]map[string][]string),
 		broadcast:  make(chan *BroadcastMessage, 256),
+		publish:    make(chan *TopicMessage, 256),
+		presence:   make(chan *envelope, 64),
+		subscribe:  make(chan *subscription, 64),
+		replays:    make(chan *replayResult, 16),
 		register:   make(chan *Client),
 		unregister: make(chan *Client),
+		outbound:   make(chan []byte, 256),
+		backplane:  backplane,
+		instanceID: instanceID,
 		log:        log,
 	}
 }
 
-func (h *Hub) Run() {
+// SetAuthorizer sets the check run when a client subscribes to a topic. Without
+// one every subscription is refused.
+func (h *Hub) SetAuthorizer(a Authorizer) {
+	h.authorizer = a
+}
+
+// SetReplayer sets the source of the notifications replayed to resuming clients.
+// Without one resuming clients are told their replay was truncated.
+func (h *Hub) SetReplayer(r Replayer) {
+	h.replayer = r
+}
+
+// Run owns the connection and topic maps and queues every frame sent to the
+// sockets. It also consumes the backplane until ctx is done.
+func (h *Hub) Run(ctx context.Context) {
+	go func() {
+		err := h.backplane.Subscribe(ctx, func(data []byte) { h.receive(ctx, data) })
+		if err != nil && !errors.Is(err, context.Canceled) {
+			h.log.Sugar().Errorw("Backplane subscription stopped", "error", err)
+		}
+	}()
+	go h.runOutbound(ctx)
+
 	for {
 		select {
+		case <-ctx.Done():
+			return
+
 		case client := <-h.register:
 			h.mu.Lock()
 			if h.clients[client.UserID] == nil {
-				h.clients[client.UserID] = make(map[*websocket.Conn]bool)
+				h.clients[client.UserID] = make(map[*websocket.Conn]*Client)
 			}
-			h.clients[client.UserID][client.Conn] = true
+			client.topics = make(map[string]bool)
+			h.clients[client.UserID][client.Conn] = client
 			h.mu.Unlock()
 			h.log.Sugar().Infow("Client registered", "user_id", client.UserID)
 
 		case client := <-h.unregister:
-			h.mu.Lock()
-			if connections, ok := h.clients[client.UserID]; ok {
-				if _, exists := connections[client.Conn]; exists {
-					delete(connections, client.Conn)
-					client.Conn.Close()
-					if len(connections) == 0 {
-						delete(h.clients, client.UserID)
-					}
-				}
-			}
-			h.mu.Unlock()
-			h.log.Sugar().Infow("Client unregistered", "user_id", client.UserID)
+			h.removeClient(client.UserID, client.Conn)
 
 		case message := <-h.broadcast:
 			h.mu.RLock()
-			connections := h.clients[message.UserID]
+			var clients []*Client
+			for _, client := range h.clients[message.UserID] {
+				clients = append(clients, client)
+			}
 			h.mu.RUnlock()
 
-			if connections != nil {
+			if len(clients) > 0 {
 				data, err := json.Marshal(message.Notification)
 				if err != nil {
 					h.log.Sugar().Errorw("Failed to marshal notification", "error", err)
 					continue
 				}
 
-				for conn := range connections {
-					err := conn.WriteMessage(websocket.TextMessage, data)
-					if err != nil {
-						h.log.Sugar().Warnw("Failed to send message", "error", err)
-						h.unregister <- &Client{UserID: message.UserID, Conn: conn}
+				for _, client := range clients {
+					if client.replaying {
+						client.pending = append(client.pending, message.Notification)
+						continue
 					}
+					h.write(client, data)
 				}
 			}
+
+		case result := <-h.replays:
+			h.finishReplay(result)
+
+		case message := <-h.publish:
+			ts := message.Timestamp
+			h.sendTopic(message.Topic, &ServerFrame{
+				Type:      FrameEvent,
+				Topic:     message.Topic,
+				Event:     message.Event,
+				UserID:    message.UserID,
+				Timestamp: &ts,
+				Payload:   message.Payload,
+			})
+
+		case env := <-h.presence:
+			h.applyRemotePresence(env)
+
+		case sub := <-h.subscribe:
+			h.applySubscription(sub)
 		}
 	}
 }
@@ -96,9 +242,341 @@ func (h *Hub) Unregister(client *Client) {
 	h.unregister <- client
 }
 
+// Broadcast sends a notification to a user's sockets on every instance
 func (h *Hub) Broadcast(userID string, notification *models.Notification) {
-	h.broadcast <- &BroadcastMessage{
-		UserID:       userID,
-		Notification: notification,
+	env := &envelope{Origin: h.instanceID, UserID: userID, Notification: notification}
+	if err := h.fanOut(env); err != nil {
+		h.log.Sugar().Errorw("Failed to fan out notification, delivering locally", "error", err, "user_id", userID)
+		h.broadcast <- &BroadcastMessage{UserID: userID, Notification: notification}
+	}
+}
+
+// Publish sends an event to the subscribers of a topic on every instance
+func (h *Hub) Publish(message *TopicMessage) {
+	if err := h.fanOut(&envelope{Origin: h.instanceID, Topic: message}); err != nil {
+		h.log.Sugar().Errorw("Failed to fan out topic event, delivering locally", "error", err, "topic", message.Topic)
+		h.publish <- message
+	}
+}
+
+func (h *Hub) fanOut(env *envelope) error {
+	data, err := json.Marshal(env)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+	defer cancel()
+	return h.backplane.Publish(ctx, data)
+}
+
+// receive queues a backplane message for delivery to the local sockets
+func (h *Hub) receive(ctx context.Context, data []byte) {
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		h.log.Sugar().Warnw("Dropping malformed backplane message", "error", err)
+		return
+	}
+	switch {
+	case env.Notification != nil:
+		select {
+		case h.broadcast <- &BroadcastMessage{UserID: env.UserID, Notification: env.Notification}:
+		case <-ctx.Done():
+		}
+	case env.Topic != nil:
+		select {
+		case h.publish <- env.Topic:
+		case <-ctx.Done():
+		}
+	case env.Presence != nil && env.Origin != h.instanceID:
+		select {
+		case h.presence <- &env:
+		case <-ctx.Done():
+		}
+	}
+}
+
+// runOutbound publishes the presence changes queued by Run, so Run never waits
+// on the backplane
+func (h *Hub) runOutbound(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case data := <-h.outbound:
+			pctx, cancel := context.WithTimeout(ctx, publishTimeout)
+			if err := h.backplane.Publish(pctx, data); err != nil {
+				h.log.Sugar().Warnw("Failed to publish presence", "error", err)
+			}
+			cancel()
+		}
+	}
+}
+
+// HandleFrame handles a frame read from a client's socket. Subscriptions are
+// authorized here, on the reading goroutine, so lookups never stall Run.
+func (h *Hub) HandleFrame(ctx context.Context, client *Client, data []byte) {
+	var frame ClientFrame
+	if err := json.Unmarshal(data, &frame); err != nil {
+		h.subscribe <- &subscription{client: client, err: "invalid frame"}
+		return
+	}
+	kind, id, ok := ParseTopic(frame.Topic)
+	if !ok {
+		h.subscribe <- &subscription{client: client, topic: frame.Topic, err: "invalid topic"}
+		return
+	}
+
+	switch frame.Action {
+	case "subscribe":
+		if h.authorizer == nil {
+			h.subscribe <- &subscription{client: client, topic: frame.Topic, err: ErrForbidden.Error()}
+			return
+		}
+		ctx, cancel := context.WithTimeout(ctx, authorizeTimeout)
+		err := h.authorizer.AuthorizeTopic(ctx, client.UserID, kind, id)
+		cancel()
+		if err != nil {
+			if !errors.Is(err, ErrForbidden) {
+				h.log.Sugar().Warnw("Failed to authorize topic", "error", err, "user_id", client.UserID, "topic", frame.Topic)
+			}
+			// Lookup failures deny the subscription like a missing permission would
+			h.subscribe <- &subscription{client: client, topic: frame.Topic, err: ErrForbidden.Error()}
+			return
+		}
+		h.subscribe <- &subscription{client: client, topic: frame.Topic, add: true}
+	case "unsubscribe":
+		h.subscribe <- &subscription{client: client, topic: frame.Topic}
+	default:
+		h.subscribe <- &subscription{client: client, topic: frame.Topic, err: "unknown action"}
+	}
+}
+
+// ParseTopic splits a topic into its kind and ID
+func ParseTopic(topic string) (kind, id string, ok bool) {
+	kind, id, ok = strings.Cut(topic, ":")
+	if !ok || id == "" {
+		return "", "", false
+	}
+	switch kind {
+	case TopicBoard, TopicIssue, TopicProject:
+		return kind, id, true
+	}
+	return "", "", false
+}
+
+// Topic builds the topic of a board, issue or project
+func Topic(kind, id string) string {
+	return kind + ":" + id
+}
+
+// applySubscription runs on Run's goroutine
+func (h *Hub) applySubscription(sub *subscription) {
+	client := sub.client
+	h.mu.Lock()
+	current := h.clients[client.UserID][client.Conn]
+	h.mu.Unlock()
+	if current != client {
+		return // Disconnected while the subscription was being authorized
+	}
+
+	if sub.err != "" {
+		h.send(client, &ServerFrame{Type: FrameError, Topic: sub.topic, Error: sub.err})
+		return
+	}
+
+	if sub.add {
+		if client.topics[sub.topic] {
+			h.send(client, &ServerFrame{Type: FrameSubscribed, Topic: sub.topic})
+			return
+		}
+		if len(client.topics) >= maxTopicsPerClient {
+			h.send(client, &ServerFrame{Type: FrameError, Topic: sub.topic, Error: "too many subscriptions"})
+			return
+		}
+		h.mu.Lock()
+		first := h.topics[sub.topic] == nil
+		client.topics[sub.topic] = true
+		if first {
+			h.topics[sub.topic] = make(map[*Client]bool)
+		}
+		h.topics[sub.topic][client] = true
+		h.mu.Unlock()
+		h.send(client, &ServerFrame{Type: FrameSubscribed, Topic: sub.topic})
+		// The first local viewer asks the other instances who is viewing there
+		h.announcePresence(sub.topic, first)
+		return
+	}
+
+	wasSubscribed := client.topics[sub.topic]
+	if wasSubscribed {
+		h.mu.Lock()
+		h.leaveTopic(client, sub.topic)
+		h.mu.Unlock()
+	}
+	h.send(client, &ServerFrame{Type: FrameUnsubscribed, Topic: sub.topic})
+	if wasSubscribed {
+		h.announcePresence(sub.topic, false)
+	}
+}
+
+// removeClient drops a connection and its subscriptions
+func (h *Hub) removeClient(userID string, conn *websocket.Conn) {
+	h.mu.Lock()
+	client, ok := h.clients[userID][conn]
+	if !ok {
+		h.mu.Unlock()
+		return
+	}
+	delete(h.clients[userID], conn)
+	if len(h.clients[userID]) == 0 {
+		delete(h.clients, userID)
+	}
+	var left []string
+	for topic := range client.topics {
+		h.leaveTopic(client, topic)
+		left = append(left, topic)
+	}
+	h.mu.Unlock()
+
+	// The writer sends a close frame and closes the socket, which ends the reader
+	client.closed = true
+	close(client.send)
+	h.log.Sugar().Infow("Client unregistered", "user_id", userID)
+	for _, topic := range left {
+		h.announcePresence(topic, false)
+	}
+}
+
+// leaveTopic must be called with mu held
+func (h *Hub) leaveTopic(client *Client, topic string) {
+	delete(client.topics, topic)
+	delete(h.topics[topic], client)
+	if len(h.topics[topic]) == 0 {
+		delete(h.topics, topic)
+	}
+}
+
+// announcePresence tells local and remote viewers of a topic that the local
+// viewers changed
+func (h *Hub) announcePresence(topic string, sync bool) {
+	h.queuePresence(topic, h.localViewers(topic), sync)
+	h.sendPresence(topic)
+}
+
+// queuePresence hands the local viewers of a topic to runOutbound. Presence is
+// dropped rather than blocking Run when the backplane falls behind.
+func (h *Hub) queuePresence(topic string, users []string, sync bool) {
+	data, err := json.Marshal(&envelope{
+		Origin:   h.instanceID,
+		Presence: &presenceMessage{Topic: topic, UserIDs: users, Sync: sync},
+	})
+	if err != nil {
+		return
+	}
+	select {
+	case h.outbound <- data:
+	default:
+		h.log.Sugar().Warnw("Dropping presence update, backplane queue full", "topic", topic)
+	}
+}
+
+// applyRemotePresence records the viewers of a topic on another instance. Viewers
+// of an instance that stops without announcing linger until it comes back.
+func (h *Hub) applyRemotePresence(env *envelope) {
+	p := env.Presence
+	if len(p.UserIDs) == 0 {
+		delete(h.remote[p.Topic], env.Origin)
+		if len(h.remote[p.Topic]) == 0 {
+			delete(h.remote, p.Topic)
+		}
+	} else {
+		if h.remote[p.Topic] == nil {
+			h.remote[p.Topic] = make(map[string][]string)
+		}
+		h.remote[p.Topic][env.Origin] = p.UserIDs
+	}
+
+	if local := h.localViewers(p.Topic); p.Sync && len(local) > 0 {
+		h.queuePresence(p.Topic, local, false)
+	}
+	h.sendPresence(p.Topic)
+}
+
+func (h *Hub) localViewers(topic string) []string {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	seen := make(map[string]bool)
+	users := []string{}
+	for client := range h.topics[topic] {
+		if !seen[client.UserID] {
+			seen[client.UserID] = true
+			users = append(users, client.UserID)
+		}
+	}
+	sort.Strings(users)
+	return users
+}
+
+// sendPresence tells the local subscribers of a topic who is viewing it on any instance
+func (h *Hub) sendPresence(topic string) {
+	seen := make(map[string]bool)
+	var users []string
+	for _, id := range h.localViewers(topic) {
+		seen[id] = true
+		users = append(users, id)
+	}
+	for _, ids := range h.remote[topic] {
+		for _, id := range ids {
+			if !seen[id] {
+				seen[id] = true
+				users = append(users, id)
+			}
+		}
+	}
+	sort.Strings(users)
+	h.sendTopic(topic, &ServerFrame{Type: FramePresence, Topic: topic, UserIDs: users})
+}
+
+func (h *Hub) sendTopic(topic string, frame *ServerFrame) {
+	h.mu.RLock()
+	var subscribers []*Client
+	for client := range h.topics[topic] {
+		subscribers = append(subscribers, client)
+	}
+	h.mu.RUnlock()
+	if len(subscribers) == 0 {
+		return
+	}
+
+	data, err := json.Marshal(frame)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to marshal topic frame", "error", err, "topic", topic)
+		return
+	}
+	for _, client := range subscribers {
+		h.write(client, data)
+	}
+}
+
+func (h *Hub) send(client *Client, frame *ServerFrame) {
+	data, err := json.Marshal(frame)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to marshal frame", "error", err)
+		return
+	}
+	h.write(client, data)
+}
+
+// write queues data for a client's writer. A client whose queue is full is too
+// slow to keep up and is disconnected; it can resume with a replay.
+func (h *Hub) write(client *Client, data []byte) {
+	if client.closed {
+		return
+	}
+	select {
+	case client.send <- data:
+	default:
+		h.log.Sugar().Warnw("Disconnecting slow client", "user_id", client.UserID)
+		h.removeClient(client.UserID, client.Conn)
 	}
 }
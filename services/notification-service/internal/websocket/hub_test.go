@@ -0,0 +1,269 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/nexusflow/nexusflow/pkg/logger"
+	"github.com/nexusflow/nexusflow/services/notification-service/internal/models"
+)
+
+type allowAll struct{}
+
+func (allowAll) AuthorizeTopic(ctx context.Context, userID, kind, id string) error {
+	return nil
+}
+
+type denyAll struct{}
+
+func (denyAll) AuthorizeTopic(ctx context.Context, userID, kind, id string) error {
+	return ErrForbidden
+}
+
+// tokenIsUser accepts any token starting with "token-" as the rest of it
+type tokenIsUser struct{}
+
+func (tokenIsUser) Authenticate(ctx context.Context, token string) (string, error) {
+	userID, ok := strings.CutPrefix(token, "token-")
+	if !ok {
+		return "", ErrUnauthenticated
+	}
+	return userID, nil
+}
+
+// missedNotifications replays fixed notifications after "n0"
+type missedNotifications []*models.Notification
+
+func (m missedNotifications) NotificationsSince(ctx context.Context, userID, lastID string, limit int) ([]*models.Notification, error) {
+	if lastID != "n0" {
+		return nil, ErrUnknownNotification
+	}
+	return m, nil
+}
+
+// startInstance runs a hub behind a test server, like one notification-service replica
+func startInstance(t *testing.T, backplane Backplane, instanceID string, authorizer Authorizer) (*Hub, string) {
+	t.Helper()
+	log, err := logger.New(logger.Config{Level: "error", ServiceName: "notification-service-test"})
+	if err != nil {
+		t.Fatalf("logger: %v", err)
+	}
+	hub := NewHub(log, backplane, instanceID)
+	hub.SetAuthorizer(authorizer)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go hub.Run(ctx)
+
+	srv := httptest.NewServer(NewHandler(hub, tokenIsUser{}, nil, log))
+	t.Cleanup(srv.Close)
+	return hub, "ws" + strings.TrimPrefix(srv.URL, "http") + "/ws"
+}
+
+func connect(t *testing.T, url, userID string) *websocket.Conn {
+	t.Helper()
+	header := http.Header{"Authorization": {"Bearer token-" + userID}}
+	conn, _, err := websocket.DefaultDialer.Dial(url, header)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func subscribe(t *testing.T, conn *websocket.Conn, topic string) {
+	t.Helper()
+	if err := conn.WriteJSON(ClientFrame{Action: "subscribe", Topic: topic}); err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	readFrame(t, conn, func(f ServerFrame) bool { return f.Type == FrameSubscribed && f.Topic == topic })
+}
+
+// readFrame reads until a frame matches, failing the test after a timeout
+func readFrame(t *testing.T, conn *websocket.Conn, match func(ServerFrame) bool) ServerFrame {
+	t.Helper()
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatalf("no matching frame: %v", err)
+		}
+		var f ServerFrame
+		if err := json.Unmarshal(data, &f); err != nil {
+			t.Fatalf("decode frame: %v", err)
+		}
+		if match(f) {
+			return f
+		}
+	}
+}
+
+func TestNotificationReachesUserOnAnotherInstance(t *testing.T) {
+	backplane := NewMemoryBackplane()
+	_, urlA := startInstance(t, backplane, "a", allowAll{})
+	hubB, urlB := startInstance(t, backplane, "b", allowAll{})
+
+	alice := connect(t, urlA, "alice")
+	bob := connect(t, urlB, "bob")
+	// Subscribing makes sure both connections are registered
+	subscribe(t, alice, "project:p1")
+	subscribe(t, bob, "project:p1")
+
+	hubB.Broadcast("alice", &models.Notification{ID: "n1", UserID: "alice", Title: "Assigned"})
+
+	alice.SetReadDeadline(time.Now().Add(2 * time.Second))
+	for {
+		_, data, err := alice.ReadMessage()
+		if err != nil {
+			t.Fatalf("alice did not get the notification: %v", err)
+		}
+		var n models.Notification
+		if err := json.Unmarshal(data, &n); err == nil && n.ID == "n1" {
+			break
+		}
+	}
+
+	bob.SetReadDeadline(time.Now().Add(200 * time.Millisecond))
+	for {
+		_, data, err := bob.ReadMessage()
+		if err != nil {
+			break // Timed out without seeing alice's notification
+		}
+		var n models.Notification
+		if err := json.Unmarshal(data, &n); err == nil && n.ID == "n1" {
+			t.Fatal("bob got alice's notification")
+		}
+	}
+}
+
+func TestTopicEventsAndPresenceSpanInstances(t *testing.T) {
+	backplane := NewMemoryBackplane()
+	_, urlA := startInstance(t, backplane, "a", allowAll{})
+	hubB, urlB := startInstance(t, backplane, "b", allowAll{})
+
+	alice := connect(t, urlA, "alice")
+	subscribe(t, alice, "board:b1")
+	bob := connect(t, urlB, "bob")
+	subscribe(t, bob, "board:b1")
+
+	// Both see each other although they are connected to different instances
+	for _, conn := range []*websocket.Conn{alice, bob} {
+		readFrame(t, conn, func(f ServerFrame) bool {
+			return f.Type == FramePresence && reflect.DeepEqual(f.UserIDs, []string{"alice", "bob"})
+		})
+	}
+
+	hubB.Publish(&TopicMessage{Topic: "board:b1", Event: "card.moved", UserID: "bob", Payload: map[string]interface{}{"card_id": "c1"}})
+	f := readFrame(t, alice, func(f ServerFrame) bool { return f.Type == FrameEvent })
+	if f.Topic != "board:b1" || f.Event != "card.moved" || f.UserID != "bob" || f.Payload["card_id"] != "c1" {
+		t.Fatalf("unexpected event frame %+v", f)
+	}
+
+	bob.Close()
+	readFrame(t, alice, func(f ServerFrame) bool {
+		return f.Type == FramePresence && reflect.DeepEqual(f.UserIDs, []string{"alice"})
+	})
+}
+
+func TestSubscribeIsAuthorized(t *testing.T) {
+	_, url := startInstance(t, NewMemoryBackplane(), "a", denyAll{})
+	conn := connect(t, url, "alice")
+
+	if err := conn.WriteJSON(ClientFrame{Action: "subscribe", Topic: "board:b1"}); err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	f := readFrame(t, conn, func(f ServerFrame) bool { return f.Type != FramePresence })
+	if f.Type != FrameError || f.Error != ErrForbidden.Error() {
+		t.Fatalf("expected forbidden error, got %+v", f)
+	}
+
+	if err := conn.WriteJSON(ClientFrame{Action: "subscribe", Topic: "sprint:s1"}); err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	f = readFrame(t, conn, func(f ServerFrame) bool { return true })
+	if f.Type != FrameError || f.Error != "invalid topic" {
+		t.Fatalf("expected invalid topic error, got %+v", f)
+	}
+}
+
+func TestConnectRequiresValidToken(t *testing.T) {
+	_, url := startInstance(t, NewMemoryBackplane(), "a", allowAll{})
+
+	for _, target := range []string{url, url + "?access_token=forged"} {
+		_, resp, err := websocket.DefaultDialer.Dial(target, nil)
+		if err == nil {
+			t.Fatalf("%s: connected without a valid token", target)
+		}
+		if resp == nil || resp.StatusCode != http.StatusUnauthorized {
+			t.Fatalf("%s: expected 401, got %v", target, resp)
+		}
+	}
+
+	conn, _, err := websocket.DefaultDialer.Dial(url+"?access_token=token-alice", nil)
+	if err != nil {
+		t.Fatalf("query token: %v", err)
+	}
+	conn.Close()
+}
+
+func TestResumeReplaysMissedNotifications(t *testing.T) {
+	log, err := logger.New(logger.Config{Level: "error", ServiceName: "notification-service-test"})
+	if err != nil {
+		t.Fatalf("logger: %v", err)
+	}
+	hub := NewHub(log, NewMemoryBackplane(), "a")
+	hub.SetReplayer(missedNotifications{{ID: "n1", UserID: "alice"}, {ID: "n2", UserID: "alice"}})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go hub.Run(ctx)
+	srv := httptest.NewServer(NewHandler(hub, tokenIsUser{}, nil, log))
+	defer srv.Close()
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/ws?access_token=token-alice"
+
+	conn, _, err := websocket.DefaultDialer.Dial(url+"&last_notification_id=n0", nil)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+
+	var ids []string
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatalf("read: %v", err)
+		}
+		var f ServerFrame
+		if err := json.Unmarshal(data, &f); err == nil && f.Type == FrameReplayed {
+			if f.Count != 2 || f.Truncated {
+				t.Fatalf("unexpected replayed frame %+v", f)
+			}
+			break
+		}
+		var n models.Notification
+		if err := json.Unmarshal(data, &n); err != nil {
+			t.Fatalf("decode notification: %v", err)
+		}
+		ids = append(ids, n.ID)
+	}
+	if !reflect.DeepEqual(ids, []string{"n1", "n2"}) {
+		t.Fatalf("replayed %v, want [n1 n2]", ids)
+	}
+
+	// A last seen notification that cannot be found is reported as truncated
+	stale, _, err := websocket.DefaultDialer.Dial(url+"&last_notification_id=gone", nil)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer stale.Close()
+	f := readFrame(t, stale, func(f ServerFrame) bool { return f.Type == FrameReplayed })
+	if f.Count != 0 || !f.Truncated {
+		t.Fatalf("unexpected replayed frame %+v", f)
+	}
+}
@@ -0,0 +1,67 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM/sarama"
+	"github.com/nexusflow/nexusflow/pkg/kafka"
+)
+
+// KafkaBackplane fans out over a Kafka topic shared by an instance group. Every
+// instance consumes the topic in a consumer group of its own, so each one sees
+// every message; new groups start from the newest offset.
+type KafkaBackplane struct {
+	producer *kafka.Producer
+	consumer *kafka.Consumer
+	topic    string
+	handler  func(data []byte)
+}
+
+// NewKafkaBackplane creates a backplane on topic. groupPrefix and instanceID name
+// this instance's consumer group.
+func NewKafkaBackplane(brokers []string, topic, groupPrefix, instanceID string) (*KafkaBackplane, error) {
+	producer, err := kafka.NewProducer(kafka.ProducerConfig{Brokers: brokers})
+	if err != nil {
+		return nil, err
+	}
+	b := &KafkaBackplane{producer: producer, topic: topic}
+	consumer, err := kafka.NewConsumer(kafka.ConsumerConfig{
+		Brokers:       brokers,
+		ConsumerGroup: groupPrefix + "-" + instanceID,
+		Topics:        []string{topic},
+	}, b.consume)
+	if err != nil {
+		_ = producer.Close()
+		return nil, err
+	}
+	b.consumer = consumer
+	return b, nil
+}
+
+func (b *KafkaBackplane) Publish(ctx context.Context, data []byte) error {
+	if err := b.producer.PublishJSON(b.topic, "", json.RawMessage(data)); err != nil {
+		return fmt.Errorf("kafka publish: %w", err)
+	}
+	return nil
+}
+
+// Subscribe must be called at most once
+func (b *KafkaBackplane) Subscribe(ctx context.Context, handler func(data []byte)) error {
+	b.handler = handler
+	return b.consumer.Start(ctx)
+}
+
+func (b *KafkaBackplane) consume(ctx context.Context, message *sarama.ConsumerMessage) error {
+	b.handler(message.Value)
+	return nil
+}
+
+func (b *KafkaBackplane) Close() error {
+	if err := b.consumer.Close(); err != nil {
+		_ = b.producer.Close()
+		return err
+	}
+	return b.producer.Close()
+}
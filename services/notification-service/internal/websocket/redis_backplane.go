@@ -0,0 +1,60 @@
+package websocket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nexusflow/nexusflow/pkg/config"
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisBackplane fans out over a Redis pub/sub channel. Messages published while
+// an instance is disconnected are not replayed to it.
+type RedisBackplane struct {
+	client  *redis.Client
+	channel string
+}
+
+func NewRedisBackplane(cfg config.RedisConfig, channel string) *RedisBackplane {
+	return &RedisBackplane{
+		client: redis.NewClient(&redis.Options{
+			Addr:     fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
+			Password: cfg.Password,
+			DB:       cfg.DB,
+		}),
+		channel: channel,
+	}
+}
+
+func (b *RedisBackplane) Publish(ctx context.Context, data []byte) error {
+	if err := b.client.Publish(ctx, b.channel, data).Err(); err != nil {
+		return fmt.Errorf("redis publish: %w", err)
+	}
+	return nil
+}
+
+func (b *RedisBackplane) Subscribe(ctx context.Context, handler func(data []byte)) error {
+	sub := b.client.Subscribe(ctx, b.channel)
+	defer sub.Close()
+
+	// Wait for the subscription so messages are not missed at startup
+	if _, err := sub.Receive(ctx); err != nil {
+		return fmt.Errorf("redis subscribe: %w", err)
+	}
+	ch := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-ch:
+			if !ok {
+				return fmt.Errorf("redis subscription closed")
+			}
+			handler([]byte(msg.Payload))
+		}
+	}
+}
+
+func (b *RedisBackplane) Close() error {
+	return b.client.Close()
+}
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_notifications_snoozed_until;
+DROP INDEX IF EXISTS idx_notifications_user_project;
+DROP INDEX IF EXISTS idx_notifications_user_group;
+
+ALTER TABLE notifications
+    DROP COLUMN IF EXISTS archived_at,
+    DROP COLUMN IF EXISTS snoozed_until,
+    DROP COLUMN IF EXISTS actor_id,
+    DROP COLUMN IF EXISTS project_id,
+    DROP COLUMN IF EXISTS subject,
+    DROP COLUMN IF EXISTS group_key;
@@ -0,0 +1,12 @@
+-- Grouping, snoozing and archiving of notifications
+ALTER TABLE notifications
+    ADD COLUMN IF NOT EXISTS group_key TEXT,
+    ADD COLUMN IF NOT EXISTS subject TEXT,
+    ADD COLUMN IF NOT EXISTS project_id UUID,
+    ADD COLUMN IF NOT EXISTS actor_id TEXT,
+    ADD COLUMN IF NOT EXISTS snoozed_until TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_group ON notifications(user_id, group_key);
+CREATE INDEX IF NOT EXISTS idx_notifications_user_project ON notifications(user_id, project_id);
+CREATE INDEX IF NOT EXISTS idx_notifications_snoozed_until ON notifications(snoozed_until) WHERE snoozed_until IS NOT NULL;
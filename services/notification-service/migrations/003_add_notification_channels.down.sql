@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS notification_channel_routes;
+DROP TABLE IF EXISTS notification_channels;
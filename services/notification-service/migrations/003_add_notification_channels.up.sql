@@ -0,0 +1,24 @@
+-- Slack and Microsoft Teams channels of a project
+CREATE TABLE IF NOT EXISTS notification_channels (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    kind TEXT NOT NULL,
+    webhook_url TEXT,
+    bot_token TEXT,
+    slack_channel TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_channels_project_id ON notification_channels(project_id);
+
+-- Which events of a project go to which channel
+CREATE TABLE IF NOT EXISTS notification_channel_routes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    channel_id UUID NOT NULL REFERENCES notification_channels(id) ON DELETE CASCADE,
+    event_types TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_channel_routes_project_id ON notification_channel_routes(project_id);
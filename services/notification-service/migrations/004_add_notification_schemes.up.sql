@@ -0,0 +1,12 @@
+-- Who is notified of each type of event in a project
+CREATE TABLE IF NOT EXISTS notification_scheme_entries (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    event_type TEXT NOT NULL,
+    recipient TEXT NOT NULL,
+    value TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    UNIQUE(project_id, event_type, recipient, value)
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_scheme_entries_project_id ON notification_scheme_entries(project_id);
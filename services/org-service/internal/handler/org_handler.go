@@ -2,9 +2,11 @@ package handler
 
 import (
 	"context"
+	"errors"
 
 	"github.com/nexusflow/nexusflow/pkg/logger"
 	commonpb "github.com/nexusflow/nexusflow/pkg/proto/common/v1"
+	"github.com/nexusflow/nexusflow/pkg/etag"
 	pb "github.com/nexusflow/nexusflow/pkg/proto/org/v1"
 	"github.com/nexusflow/nexusflow/services/org-service/internal/models"
 	"github.com/nexusflow/nexusflow/services/org-service/internal/service"
@@ -46,6 +48,7 @@ func (h *OrgHandler) CreateOrganization(ctx context.Context, req *pb.CreateOrgan
 		return nil, status.Errorf(codes.Internal, "failed to create organization: %v", err)
 	}
 
+	etag.Set(ctx, org.Version)
 	return &pb.CreateOrganizationResponse{
 		Organization: h.orgToProto(org),
 	}, nil
@@ -62,6 +65,7 @@ func (h *OrgHandler) GetOrganization(ctx context.Context, req *pb.GetOrganizatio
 		return nil, status.Error(codes.NotFound, "organization not found")
 	}
 
+	etag.Set(ctx, org.Version)
 	return &pb.GetOrganizationResponse{
 		Organization: h.orgToProto(org),
 	}, nil
@@ -69,9 +73,14 @@ func (h *OrgHandler) GetOrganization(ctx context.Context, req *pb.GetOrganizatio
 
 // UpdateOrganization updates an organization
 func (h *OrgHandler) UpdateOrganization(ctx context.Context, req *pb.UpdateOrganizationRequest) (*pb.UpdateOrganizationResponse, error) {
+	version, err := etag.ExpectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
 	input := service.UpdateOrgInput{
-		ID:       req.Id,
-		Settings: req.Settings,
+		ID:              req.Id,
+		Settings:        req.Settings,
+		ExpectedVersion: version,
 	}
 	if req.Name != nil {
 		input.Name = req.Name
@@ -85,10 +94,16 @@ func (h *OrgHandler) UpdateOrganization(ctx context.Context, req *pb.UpdateOrgan
 
 	org, err := h.service.UpdateOrganization(ctx, input)
 	if err != nil {
+		var conflict *service.VersionConflictError
+		if errors.As(err, &conflict) {
+			etag.Set(ctx, conflict.Current.Version)
+			return nil, etag.ConflictStatus(err.Error(), h.orgToProto(conflict.Current))
+		}
 		h.log.Sugar().Errorw("Failed to update organization", "error", err)
 		return nil, status.Errorf(codes.Internal, "failed to update organization: %v", err)
 	}
 
+	etag.Set(ctx, org.Version)
 	return &pb.UpdateOrganizationResponse{
 		Organization: h.orgToProto(org),
 	}, nil
@@ -453,6 +468,7 @@ func (h *OrgHandler) orgToProto(org *models.Organization) *pb.Organization {
 		Settings:    org.Settings,
 		CreatedAt:   timestamppb.New(org.CreatedAt),
 		UpdatedAt:   timestamppb.New(org.UpdatedAt),
+		Version:     org.Version,
 	}
 }
 
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -13,6 +14,9 @@ import (
 	"github.com/uptrace/bun"
 )
 
+// ErrVersionConflict is returned when a conditional update finds a newer version
+var ErrVersionConflict = errors.New("version conflict")
+
 // OrgRepository handles organization data access
 type OrgRepository struct {
 	db  *database.DB
@@ -72,17 +76,24 @@ func (r *OrgRepository) GetBySlug(ctx context.Context, slug string) (*models.Org
 
 // Update updates an organization
 func (r *OrgRepository) Update(ctx context.Context, org *models.Organization) error {
+	expected := org.Version
 	org.UpdatedAt = time.Now()
-	
-	_, err := r.db.NewUpdate().
+	org.Version = expected + 1
+
+	res, err := r.db.NewUpdate().
 		Model(org).
 		WherePK().
+		Where("o.version = ?", expected).
 		Exec(ctx)
-		
 	if err != nil {
+		org.Version = expected
 		r.log.Sugar().Errorw("Failed to update organization", "error", err, "id", org.ID)
 		return fmt.Errorf("update organization: %w", err)
 	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		org.Version = expected
+		return ErrVersionConflict
+	}
 	
 	return nil
 }
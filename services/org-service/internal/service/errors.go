@@ -0,0 +1,17 @@
+package service
+
+import (
+	"github.com/nexusflow/nexusflow/pkg/etag"
+	"github.com/nexusflow/nexusflow/services/org-service/internal/models"
+)
+
+// ErrConflict is returned when an update is based on a stale version
+var ErrConflict = etag.ErrConflict
+
+// VersionConflictError is returned when an organization changed since the
+// version the caller expected
+type VersionConflictError = etag.ConflictError[*models.Organization]
+
+func versionConflict(current *models.Organization) error {
+	return &VersionConflictError{Entity: "organization", ID: current.ID, Version: current.Version, Current: current}
+}
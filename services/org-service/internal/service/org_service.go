@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -95,6 +96,8 @@ func (s *OrgService) GetOrganization(ctx context.Context, id string) (*models.Or
 // UpdateOrgInput represents input for updating an organization
 type UpdateOrgInput struct {
 	ID          string
+	// ExpectedVersion rejects the update if the organization has changed since
+	ExpectedVersion *int64
 	Name        *string
 	Description *string
 	LogoURL     *string
@@ -110,6 +113,9 @@ func (s *OrgService) UpdateOrganization(ctx context.Context, input UpdateOrgInpu
 	if org == nil {
 		return nil, fmt.Errorf("organization not found")
 	}
+	if input.ExpectedVersion != nil && *input.ExpectedVersion != org.Version {
+		return nil, versionConflict(org)
+	}
 
 	if input.Name != nil {
 		org.Name = *input.Name
@@ -130,17 +136,33 @@ func (s *OrgService) UpdateOrganization(ctx context.Context, input UpdateOrgInpu
 	}
 
 	if err := s.orgRepo.Update(ctx, org); err != nil {
+		if errors.Is(err, repository.ErrVersionConflict) {
+			return nil, s.versionConflict(ctx, org.ID)
+		}
 		return nil, fmt.Errorf("failed to update organization: %w", err)
 	}
 
 	// Publish event
 	s.publishEvent(kafka.EventTypeOrgUpdated, org.ID, "", map[string]interface{}{
-		"name": org.Name,
+		"name":    org.Name,
+		"version": org.Version,
 	})
 
 	return org, nil
 }
 
+// versionConflict reloads an organization that changed underneath an update
+func (s *OrgService) versionConflict(ctx context.Context, id string) error {
+	current, err := s.orgRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to reload organization: %w", err)
+	}
+	if current == nil {
+		return fmt.Errorf("organization not found")
+	}
+	return versionConflict(current)
+}
+
 // DeleteOrganization deletes an organization
 func (s *OrgService) DeleteOrganization(ctx context.Context, id string) error {
 	if err := s.orgRepo.Delete(ctx, id); err != nil {
@@ -2,9 +2,11 @@ package handler
 
 import (
 	"context"
+	"errors"
 
 	"github.com/nexusflow/nexusflow/pkg/logger"
 	commonpb "github.com/nexusflow/nexusflow/pkg/proto/common/v1"
+	"github.com/nexusflow/nexusflow/pkg/etag"
 	pb "github.com/nexusflow/nexusflow/pkg/proto/project/v1"
 	"github.com/nexusflow/nexusflow/services/project-service/internal/models"
 	"github.com/nexusflow/nexusflow/services/project-service/internal/service"
@@ -51,6 +53,7 @@ func (h *ProjectHandler) CreateProject(ctx context.Context, req *pb.CreateProjec
 		return nil, status.Errorf(codes.Internal, "failed to create project: %v", err)
 	}
 
+	etag.Set(ctx, project.Version)
 	return &pb.CreateProjectResponse{
 		Project: h.projectToProto(project),
 	}, nil
@@ -67,6 +70,7 @@ func (h *ProjectHandler) GetProject(ctx context.Context, req *pb.GetProjectReque
 		return nil, status.Error(codes.NotFound, "project not found")
 	}
 
+	etag.Set(ctx, project.Version)
 	return &pb.GetProjectResponse{
 		Project: h.projectToProto(project),
 	}, nil
@@ -83,6 +87,7 @@ func (h *ProjectHandler) GetProjectByKey(ctx context.Context, req *pb.GetProject
 		return nil, status.Error(codes.NotFound, "project not found")
 	}
 
+	etag.Set(ctx, project.Version)
 	return &pb.GetProjectByKeyResponse{
 		Project: h.projectToProto(project),
 	}, nil
@@ -90,9 +95,14 @@ func (h *ProjectHandler) GetProjectByKey(ctx context.Context, req *pb.GetProject
 
 // UpdateProject updates a project
 func (h *ProjectHandler) UpdateProject(ctx context.Context, req *pb.UpdateProjectRequest) (*pb.UpdateProjectResponse, error) {
+	version, err := etag.ExpectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
 	input := service.UpdateProjectInput{
-		ID:       req.Id,
-		Settings: req.Settings,
+		ID:              req.Id,
+		Settings:        req.Settings,
+		ExpectedVersion: version,
 	}
 	if req.Name != nil {
 		input.Name = req.Name
@@ -109,10 +119,16 @@ func (h *ProjectHandler) UpdateProject(ctx context.Context, req *pb.UpdateProjec
 
 	project, err := h.service.UpdateProject(ctx, input)
 	if err != nil {
+		var conflict *service.VersionConflictError
+		if errors.As(err, &conflict) {
+			etag.Set(ctx, conflict.Current.Version)
+			return nil, etag.ConflictStatus(err.Error(), h.projectToProto(conflict.Current))
+		}
 		h.log.Sugar().Errorw("Failed to update project", "error", err)
 		return nil, status.Errorf(codes.Internal, "failed to update project: %v", err)
 	}
 
+	etag.Set(ctx, project.Version)
 	return &pb.UpdateProjectResponse{
 		Project: h.projectToProto(project),
 	}, nil
@@ -276,6 +292,7 @@ func (h *ProjectHandler) projectToProto(p *models.Project) *pb.Project {
 		Settings:       p.Settings,
 		CreatedAt:      timestamppb.New(p.CreatedAt),
 		UpdatedAt:      timestamppb.New(p.UpdatedAt),
+		Version:        p.Version,
 	}
 }
 
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -13,6 +14,9 @@ import (
 	"github.com/uptrace/bun"
 )
 
+// ErrVersionConflict is returned when a conditional update finds a newer version
+var ErrVersionConflict = errors.New("version conflict")
+
 // ProjectRepository handles project data access
 type ProjectRepository struct {
 	db  *database.DB
@@ -74,20 +78,28 @@ func (r *ProjectRepository) GetByKey(ctx context.Context, orgID, key string) (*m
 	return project, nil
 }
 
-// Update updates a project
+// Update updates a project if it is still at the version it was read at, and
+// bumps the version. It returns ErrVersionConflict if someone else updated it first.
 func (r *ProjectRepository) Update(ctx context.Context, project *models.Project) error {
+	expected := project.Version
 	project.UpdatedAt = time.Now()
-	
-	_, err := r.db.NewUpdate().
+	project.Version = expected + 1
+
+	res, err := r.db.NewUpdate().
 		Model(project).
 		WherePK().
+		Where("p.version = ?", expected).
 		Exec(ctx)
-		
 	if err != nil {
+		project.Version = expected
 		r.log.Sugar().Errorw("Failed to update project", "error", err, "id", project.ID)
 		return fmt.Errorf("update project: %w", err)
 	}
-	
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		project.Version = expected
+		return ErrVersionConflict
+	}
+
 	return nil
 }
 
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"github.com/nexusflow/nexusflow/pkg/etag"
+	"github.com/nexusflow/nexusflow/services/project-service/internal/models"
+)
+
+// ErrConflict is returned when an update is based on a stale version
+var ErrConflict = etag.ErrConflict
+
+// VersionConflictError is returned when a project changed since the
+// version the caller expected
+type VersionConflictError = etag.ConflictError[*models.Project]
+
+func versionConflict(current *models.Project) error {
+	return &VersionConflictError{Entity: "project", ID: current.ID, Version: current.Version, Current: current}
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -120,6 +121,8 @@ func (s *ProjectService) GetProjectByKey(ctx context.Context, orgID, key string)
 // UpdateProjectInput represents input for updating a project
 type UpdateProjectInput struct {
 	ID          string
+	// ExpectedVersion rejects the update if the project has changed since
+	ExpectedVersion *int64
 	Name        *string
 	Description *string
 	AvatarURL   *string
@@ -136,6 +139,9 @@ func (s *ProjectService) UpdateProject(ctx context.Context, input UpdateProjectI
 	if project == nil {
 		return nil, fmt.Errorf("project not found")
 	}
+	if input.ExpectedVersion != nil && *input.ExpectedVersion != project.Version {
+		return nil, versionConflict(project)
+	}
 
 	if input.Name != nil {
 		project.Name = *input.Name
@@ -159,17 +165,33 @@ func (s *ProjectService) UpdateProject(ctx context.Context, input UpdateProjectI
 	}
 
 	if err := s.repo.Update(ctx, project); err != nil {
+		if errors.Is(err, repository.ErrVersionConflict) {
+			return nil, s.versionConflict(ctx, project.ID)
+		}
 		return nil, fmt.Errorf("failed to update project: %w", err)
 	}
 
 	// Publish event
 	s.publishEvent("project.updated", project.OrganizationID, "", map[string]interface{}{
 		"project_id": project.ID,
+		"version":    project.Version,
 	})
 
 	return project, nil
 }
 
+// versionConflict reloads a project that changed underneath an update
+func (s *ProjectService) versionConflict(ctx context.Context, id string) error {
+	current, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to reload project: %w", err)
+	}
+	if current == nil {
+		return fmt.Errorf("project not found")
+	}
+	return versionConflict(current)
+}
+
 // DeleteProject deletes a project
 func (s *ProjectService) DeleteProject(ctx context.Context, id string) error {
 	project, err := s.repo.GetByID(ctx, id)
@@ -235,6 +257,9 @@ func (s *ProjectService) ArchiveProject(ctx context.Context, id string) (*models
 
 	project.Status = models.ProjectStatusArchived
 	if err := s.repo.Update(ctx, project); err != nil {
+		if errors.Is(err, repository.ErrVersionConflict) {
+			return nil, s.versionConflict(ctx, project.ID)
+		}
 		return nil, fmt.Errorf("failed to archive project: %w", err)
 	}
 
@@ -125,6 +125,7 @@ func initializeIndices(es *elasticsearch.Client, log *logger.Logger) error {
 				"assignee_id": map[string]string{"type": "keyword"},
 				"reporter_id": map[string]string{"type": "keyword"},
 				"labels":      map[string]string{"type": "keyword"},
+				"components":  map[string]string{"type": "keyword"},
 				"created_at":  map[string]string{"type": "date"},
 				"updated_at":  map[string]string{"type": "date"},
 			},
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"errors"
 
 	"github.com/nexusflow/nexusflow/pkg/logger"
 	pb "github.com/nexusflow/nexusflow/pkg/proto/search/v1"
@@ -63,6 +64,9 @@ func (h *SearchHandler) SearchIssues(ctx context.Context, req *pb.SearchIssuesRe
 	}
 
 	result, err := h.svc.SearchIssues(ctx, req)
+	if errors.Is(err, service.ErrValidation) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		h.log.Sugar().Errorw("Failed to search issues", "error", err)
 		return nil, status.Errorf(codes.Internal, "failed to search issues: %v", err)
@@ -38,6 +38,7 @@ type IssueDocument struct {
 	AssigneeID  string   `json:"assignee_id"`
 	ReporterID  string   `json:"reporter_id"`
 	Labels      []string `json:"labels"`
+	Components  []string `json:"components"`
 	CreatedAt   string   `json:"created_at"`
 	UpdatedAt   string   `json:"updated_at"`
 }
@@ -0,0 +1,6 @@
+package service
+
+import "errors"
+
+// ErrValidation is returned when input fails validation
+var ErrValidation = errors.New("validation failed")
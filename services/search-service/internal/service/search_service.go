@@ -10,6 +10,19 @@ import (
 	"github.com/nexusflow/nexusflow/services/search-service/internal/models"
 )
 
+// issueFilterFields whitelists the issue keyword fields SearchIssues filters on
+var issueFilterFields = map[string]bool{
+	"key":         true,
+	"status":      true,
+	"priority":    true,
+	"type":        true,
+	"project_id":  true,
+	"assignee_id": true,
+	"reporter_id": true,
+	"labels":      true,
+	"components":  true,
+}
+
 type SearchService struct {
 	es  *elasticsearch.Client
 	log *logger.Logger
@@ -43,12 +56,35 @@ func (s *SearchService) Search(ctx context.Context, req *pb.SearchRequest) (*mod
 
 // SearchIssues searches only issues
 func (s *SearchService) SearchIssues(ctx context.Context, req *pb.SearchIssuesRequest) (*models.SearchResponse, error) {
-	query := map[string]interface{}{
-		"query": map[string]interface{}{
+	var match map[string]interface{}
+	if req.Query != "" {
+		match = map[string]interface{}{
 			"multi_match": map[string]interface{}{
 				"query":  req.Query,
 				"fields": []string{"title^2", "description", "key"},
 			},
+		}
+	} else {
+		match = map[string]interface{}{"match_all": map[string]interface{}{}}
+	}
+
+	// Filters are exact matches on keyword fields
+	var filters []map[string]interface{}
+	for field, value := range req.Filters {
+		if !issueFilterFields[field] {
+			return nil, fmt.Errorf("%w: cannot filter issues by %q", ErrValidation, field)
+		}
+		filters = append(filters, map[string]interface{}{
+			"term": map[string]interface{}{field: value},
+		})
+	}
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must":   match,
+				"filter": filters,
+			},
 		},
 		"from": req.Offset,
 		"size": req.Limit,
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"net"
 	"os"
@@ -87,9 +89,43 @@ func main() {
 
 	// Initialize layers
 	repo := repository.NewSprintRepository(db, log)
-	svc := service.NewSprintService(repo, producer, log)
+	issueServiceAddr := "127.0.0.1:50054"    // Default
+	workflowServiceAddr := "127.0.0.1:50055" // Default
+	svc, err := service.NewSprintService(repo, producer, log, issueServiceAddr, workflowServiceAddr)
+	if err != nil {
+		log.Sugar().Fatalw("Failed to create sprint service", "error", err)
+	}
 	h := handler.NewSprintHandler(svc, log)
 
+	// Retry telling the issue service about sprint membership changes
+	syncInterval := cfg.GetInt("issue_sync.interval_seconds")
+	if syncInterval <= 0 {
+		syncInterval = 30
+	}
+	syncCtx, stopSync := context.WithCancel(context.Background())
+	defer stopSync()
+	go svc.RunIssueSprintSync(syncCtx, time.Duration(syncInterval)*time.Second)
+
+	// Consume issue events to hide deleted issues from sprints and track progress
+	consumer, err := kafka.NewEventConsumer(kafka.ConsumerConfig{
+		Brokers:       kafkaCfg.Brokers,
+		ConsumerGroup: kafkaCfg.ConsumerGroup,
+		Topics:        []string{"issue-events"},
+	}, svc.HandleIssueEvent)
+	if err != nil {
+		log.Sugar().Warnw("Failed to create Kafka consumer, continuing without issue events", "error", err)
+	} else {
+		defer consumer.Close()
+		consumerCtx, stopConsumer := context.WithCancel(context.Background())
+		defer stopConsumer()
+		go func() {
+			if err := consumer.Start(consumerCtx); err != nil && !errors.Is(err, context.Canceled) {
+				log.Sugar().Errorw("Kafka consumer stopped", "error", err)
+			}
+		}()
+		log.Sugar().Infow("Kafka consumer initialized")
+	}
+
 	// Create gRPC server
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
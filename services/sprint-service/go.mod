@@ -11,6 +11,7 @@ require (
 	github.com/nexusflow/nexusflow/pkg/kafka v0.0.0
 	github.com/nexusflow/nexusflow/pkg/logger v0.0.0
 	github.com/nexusflow/nexusflow/pkg/proto v0.0.0
+	github.com/nexusflow/nexusflow/pkg/workflow v0.0.0
 	google.golang.org/grpc v1.77.0
 )
 
@@ -53,7 +54,7 @@ require (
 	github.com/spf13/viper v1.18.2 // indirect
 	github.com/subosito/gotenv v1.6.0 // indirect
 	github.com/tmthrgd/go-hex v0.0.0-20190904060850-447a3041c3bc // indirect
-	github.com/uptrace/bun v1.1.17 // indirect
+	github.com/uptrace/bun v1.1.17
 	github.com/uptrace/bun/dialect/pgdialect v1.1.17 // indirect
 	github.com/vmihailenco/msgpack/v5 v5.4.1 // indirect
 	github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
@@ -79,4 +80,5 @@ replace (
 	github.com/nexusflow/nexusflow/pkg/kafka => ../../pkg/kafka
 	github.com/nexusflow/nexusflow/pkg/logger => ../../pkg/logger
 	github.com/nexusflow/nexusflow/pkg/proto => ../../pkg/proto
+	github.com/nexusflow/nexusflow/pkg/workflow => ../../pkg/workflow
 )
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/nexusflow/nexusflow/pkg/proto/sprint/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *SprintHandler) GetSprintReport(ctx context.Context, req *pb.GetSprintReportRequest) (*pb.GetSprintReportResponse, error) {
+	report, err := h.svc.GetSprintReport(ctx, req.SprintId)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to get sprint report", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get sprint report: %v", err)
+	}
+
+	resp := &pb.GetSprintReportResponse{
+		Sprint:                   sprintToProto(report.Sprint),
+		CommittedCompletedIssues: int32(report.CommittedCompletedIssues),
+		CommittedCompletedPoints: int32(report.CommittedCompletedPoints),
+		AddedIssues:              int32(report.AddedIssues),
+		AddedPoints:              int32(report.AddedPoints),
+		Completion:               completionReportToProto(report.Completion),
+	}
+	for _, d := range report.Days {
+		resp.Days = append(resp.Days, &pb.SprintReportDay{
+			Date:            d.Date.Format("2006-01-02"),
+			ScopeIssues:     int32(d.ScopeIssues),
+			ScopePoints:     int32(d.ScopePoints),
+			CompletedIssues: int32(d.CompletedIssues),
+			CompletedPoints: int32(d.CompletedPoints),
+			RemainingIssues: int32(d.RemainingIssues),
+			RemainingPoints: int32(d.RemainingPoints),
+			IdealPoints:     d.IdealPoints,
+		})
+	}
+	return resp, nil
+}
+
+func (h *SprintHandler) GetVelocity(ctx context.Context, req *pb.GetVelocityRequest) (*pb.GetVelocityResponse, error) {
+	velocity, err := h.svc.GetVelocity(ctx, req.ProjectId, int(req.SprintCount))
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to get velocity", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get velocity: %v", err)
+	}
+
+	resp := &pb.GetVelocityResponse{
+		AverageCommittedPoints: velocity.AverageCommittedPoints,
+		AverageCompletedPoints: velocity.AverageCompletedPoints,
+	}
+	for _, s := range velocity.Sprints {
+		resp.Sprints = append(resp.Sprints, sprintToProto(s))
+	}
+	return resp, nil
+}
+
+func (h *SprintHandler) SetSprintMemberCapacity(ctx context.Context, req *pb.SetSprintMemberCapacityRequest) (*pb.SetSprintMemberCapacityResponse, error) {
+	capacity, err := h.svc.SetMemberCapacity(ctx, req.SprintId, req.UserId, int(req.CapacityPoints))
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to set member capacity", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to set member capacity: %v", err)
+	}
+	return &pb.SetSprintMemberCapacityResponse{Capacity: &pb.SprintMemberCapacity{
+		SprintId:       capacity.SprintID,
+		UserId:         capacity.UserID,
+		CapacityPoints: int32(capacity.CapacityPoints),
+	}}, nil
+}
+
+func (h *SprintHandler) RemoveSprintMemberCapacity(ctx context.Context, req *pb.RemoveSprintMemberCapacityRequest) (*pb.RemoveSprintMemberCapacityResponse, error) {
+	if err := h.svc.RemoveMemberCapacity(ctx, req.SprintId, req.UserId); err != nil {
+		h.log.Sugar().Errorw("Failed to remove member capacity", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to remove member capacity: %v", err)
+	}
+	return &pb.RemoveSprintMemberCapacityResponse{}, nil
+}
+
+func (h *SprintHandler) GetSprintPlanning(ctx context.Context, req *pb.GetSprintPlanningRequest) (*pb.GetSprintPlanningResponse, error) {
+	planning, err := h.svc.GetSprintPlanning(ctx, req.SprintId)
+	if err != nil {
+		h.log.Sugar().Errorw("Failed to get sprint planning", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get sprint planning: %v", err)
+	}
+
+	resp := &pb.GetSprintPlanningResponse{
+		TotalCapacity:    int32(planning.TotalCapacity),
+		ScopePoints:      int32(planning.ScopePoints),
+		UnassignedPoints: int32(planning.UnassignedPoints),
+		Velocity:         planning.Velocity,
+		Overcommitted:    planning.Overcommitted,
+		Warnings:         planning.Warnings,
+	}
+	for _, m := range planning.Members {
+		resp.Members = append(resp.Members, &pb.SprintMemberLoad{
+			UserId:         m.UserID,
+			CapacityPoints: int32(m.CapacityPoints),
+			AssignedPoints: int32(m.AssignedPoints),
+		})
+	}
+	return resp, nil
+}
@@ -27,16 +27,47 @@ func sprintToProto(s *models.Sprint) *pb.Sprint {
 	if s == nil {
 		return nil
 	}
-	return &pb.Sprint{
-		Id:        s.ID,
-		ProjectId: s.ProjectID,
-		Name:      s.Name,
-		Goal:      s.Goal,
-		StartDate: s.StartDate.Format(time.RFC3339),
-		EndDate:   s.EndDate.Format(time.RFC3339),
-		Status:    statusToProto(s.Status),
-		CreatedAt: s.CreatedAt.Format(time.RFC3339),
-		UpdatedAt: s.UpdatedAt.Format(time.RFC3339),
+	sprint := &pb.Sprint{
+		Id:              s.ID,
+		ProjectId:       s.ProjectID,
+		Name:            s.Name,
+		Goal:            s.Goal,
+		StartDate:       s.StartDate.Format(time.RFC3339),
+		EndDate:         s.EndDate.Format(time.RFC3339),
+		Status:          statusToProto(s.Status),
+		CreatedAt:       s.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:       s.UpdatedAt.Format(time.RFC3339),
+		CommittedIssues: int32(s.CommittedIssues),
+		CommittedPoints: int32(s.CommittedPoints),
+		CompletedIssues: int32(s.CompletedIssues),
+		CompletedPoints: int32(s.CompletedPoints),
+	}
+	if !s.StartedAt.IsZero() {
+		sprint.StartedAt = s.StartedAt.Format(time.RFC3339)
+	}
+	if !s.CompletedAt.IsZero() {
+		sprint.CompletedAt = s.CompletedAt.Format(time.RFC3339)
+	}
+	return sprint
+}
+
+func completionReportToProto(r *models.SprintCompletionReport) *pb.SprintCompletionReport {
+	if r == nil {
+		return nil
+	}
+	return &pb.SprintCompletionReport{
+		SprintId:            r.SprintID,
+		NextSprintId:        r.NextSprintID,
+		CompletedIssues:     int32(r.CompletedIssues),
+		CompletedPoints:     int32(r.CompletedPoints),
+		CarriedOverIssues:   int32(r.CarriedOverIssues),
+		CarriedOverPoints:   int32(r.CarriedOverPoints),
+		AddedIssues:         int32(r.AddedIssues),
+		AddedPoints:         int32(r.AddedPoints),
+		RemovedIssues:       int32(r.RemovedIssues),
+		RemovedPoints:       int32(r.RemovedPoints),
+		CarriedOverIssueIds: r.CarriedOverIssueIDs,
+		CreatedAt:           r.CreatedAt.Format(time.RFC3339),
 	}
 }
 
@@ -141,16 +172,25 @@ func (h *SprintHandler) StartSprint(ctx context.Context, req *pb.StartSprintRequ
 		h.log.Sugar().Errorw("Failed to start sprint", "error", err)
 		return nil, status.Errorf(codes.Internal, "failed to start sprint: %v", err)
 	}
-	return &pb.StartSprintResponse{Sprint: sprintToProto(sprint)}, nil
+
+	resp := &pb.StartSprintResponse{Sprint: sprintToProto(sprint)}
+	planning, err := h.svc.GetSprintPlanning(ctx, sprint.ID)
+	if err != nil {
+		// The sprint has started, so only the warnings are lost
+		h.log.Sugar().Warnw("Failed to get sprint planning", "error", err, "sprint_id", sprint.ID)
+	} else {
+		resp.Warnings = planning.Warnings
+	}
+	return resp, nil
 }
 
 func (h *SprintHandler) CompleteSprint(ctx context.Context, req *pb.CompleteSprintRequest) (*pb.CompleteSprintResponse, error) {
-	sprint, err := h.svc.CompleteSprint(ctx, req.SprintId)
+	sprint, report, err := h.svc.CompleteSprint(ctx, req.SprintId, req.NextSprintId)
 	if err != nil {
 		h.log.Sugar().Errorw("Failed to complete sprint", "error", err)
 		return nil, status.Errorf(codes.Internal, "failed to complete sprint: %v", err)
 	}
-	return &pb.CompleteSprintResponse{Sprint: sprintToProto(sprint)}, nil
+	return &pb.CompleteSprintResponse{Sprint: sprintToProto(sprint), Report: completionReportToProto(report)}, nil
 }
 
 func (h *SprintHandler) GetSprintIssues(ctx context.Context, req *pb.GetSprintIssuesRequest) (*pb.GetSprintIssuesResponse, error) {
@@ -20,6 +20,17 @@ type Sprint struct {
 	Status    SprintStatus `bun:"type:text,notnull,default:'planned'"`
 	CreatedAt time.Time    `bun:"type:timestamp,notnull,default:now()"`
 	UpdatedAt time.Time    `bun:"type:timestamp,notnull,default:now()"`
+
+	StartedAt   time.Time `bun:"type:timestamp,nullzero"`
+	CompletedAt time.Time `bun:"type:timestamp,nullzero"`
+	// Scope when the sprint started and what was done when it completed
+	CommittedIssues int `bun:"type:integer,notnull,default:0"`
+	CommittedPoints int `bun:"type:integer,notnull,default:0"`
+	CompletedIssues int `bun:"type:integer,notnull,default:0"`
+	CompletedPoints int `bun:"type:integer,notnull,default:0"`
+	// Issues taken out while the sprint was active
+	RemovedIssues int `bun:"type:integer,notnull,default:0"`
+	RemovedPoints int `bun:"type:integer,notnull,default:0"`
 }
 
 type SprintIssue struct {
@@ -27,4 +38,65 @@ type SprintIssue struct {
 	SprintID string    `bun:"type:uuid,notnull"`
 	IssueID  string    `bun:"type:uuid,notnull"`
 	AddedAt  time.Time `bun:"type:timestamp,notnull,default:now()"`
+	// Set while the issue is in the trash
+	IssueDeletedAt time.Time `bun:"type:timestamp,nullzero"`
+
+	// Issue state as last seen by the sprint service
+	StoryPoints int       `bun:"type:integer,notnull,default:0"`
+	AssigneeID  string    `bun:"type:uuid,nullzero"`
+	Done        bool      `bun:"type:boolean,notnull,default:false"`
+	CompletedAt time.Time `bun:"type:timestamp,nullzero"`
+	// Committed issues were in the sprint when it started
+	Committed bool `bun:"type:boolean,notnull,default:false"`
+}
+
+// SprintDailyStat is the scope and progress of a sprint at the end of a day
+type SprintDailyStat struct {
+	ID              string    `bun:"type:uuid,pk,default:uuid_generate_v4()"`
+	SprintID        string    `bun:"type:uuid,notnull"`
+	Day             time.Time `bun:"type:date,notnull"`
+	ScopeIssues     int       `bun:"type:integer,notnull"`
+	ScopePoints     int       `bun:"type:integer,notnull"`
+	CompletedIssues int       `bun:"type:integer,notnull"`
+	CompletedPoints int       `bun:"type:integer,notnull"`
+	RecordedAt      time.Time `bun:"type:timestamp,notnull,default:now()"`
+}
+
+// SprintMemberCapacity is how many story points a member can take on in a sprint
+type SprintMemberCapacity struct {
+	ID             string    `bun:"type:uuid,pk,default:uuid_generate_v4()"`
+	SprintID       string    `bun:"type:uuid,notnull"`
+	UserID         string    `bun:"type:uuid,notnull"`
+	CapacityPoints int       `bun:"type:integer,notnull"`
+	CreatedAt      time.Time `bun:"type:timestamp,notnull,default:now()"`
+	UpdatedAt      time.Time `bun:"type:timestamp,notnull,default:now()"`
+}
+
+// SprintCompletionReport is the outcome of a completed sprint
+type SprintCompletionReport struct {
+	ID       string `bun:"type:uuid,pk,default:uuid_generate_v4()"`
+	SprintID string `bun:"type:uuid,notnull,unique"`
+	// Empty when unfinished issues went back to the backlog
+	NextSprintID        string    `bun:"type:uuid,nullzero"`
+	CompletedIssues     int       `bun:"type:integer,notnull"`
+	CompletedPoints     int       `bun:"type:integer,notnull"`
+	CarriedOverIssues   int       `bun:"type:integer,notnull"`
+	CarriedOverPoints   int       `bun:"type:integer,notnull"`
+	AddedIssues         int       `bun:"type:integer,notnull"`
+	AddedPoints         int       `bun:"type:integer,notnull"`
+	RemovedIssues       int       `bun:"type:integer,notnull"`
+	RemovedPoints       int       `bun:"type:integer,notnull"`
+	CarriedOverIssueIDs []string  `bun:"type:uuid[],array"`
+	CreatedAt           time.Time `bun:"type:timestamp,notnull,default:now()"`
+}
+
+// IssueSprintSync queues an issue whose sprint the issue service has not yet
+// been told about. The sprint sent is looked up when the sync runs, so only
+// the latest membership is ever applied.
+type IssueSprintSync struct {
+	IssueID       string    `bun:"type:uuid,pk"`
+	QueuedAt      time.Time `bun:"type:timestamp,notnull,default:now()"`
+	Attempts      int       `bun:"type:integer,notnull,default:0"`
+	NextAttemptAt time.Time `bun:"type:timestamp,notnull,default:now()"`
+	LastError     string    `bun:"type:text,nullzero"`
 }
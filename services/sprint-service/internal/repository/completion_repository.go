@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nexusflow/nexusflow/services/sprint-service/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// CompleteSprint saves a completed sprint and its report in one transaction,
+// moving the carried over issues to the next sprint, or out of any sprint
+// when the report has none
+func (r *SprintRepository) CompleteSprint(ctx context.Context, sprint *models.Sprint, report *models.SprintCompletionReport, carriedOver []*models.SprintIssue) error {
+	return r.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		sprint.UpdatedAt = time.Now()
+		if _, err := tx.NewUpdate().Model(sprint).WherePK().Exec(ctx); err != nil {
+			return fmt.Errorf("update sprint: %w", err)
+		}
+
+		report.CreatedAt = time.Now()
+		if _, err := tx.NewInsert().Model(report).Exec(ctx); err != nil {
+			return fmt.Errorf("create completion report: %w", err)
+		}
+
+		if len(carriedOver) == 0 {
+			return nil
+		}
+		ids := make([]string, len(carriedOver))
+		issueIDs := make([]string, len(carriedOver))
+		for i, si := range carriedOver {
+			ids[i] = si.ID
+			issueIDs[i] = si.IssueID
+		}
+		if _, err := tx.NewDelete().Model((*models.SprintIssue)(nil)).Where("id IN (?)", bun.In(ids)).Exec(ctx); err != nil {
+			return fmt.Errorf("remove carried over issues: %w", err)
+		}
+		// Done issues keep the completed sprint, carried over ones move on
+		if err := queueIssueSprintSyncs(ctx, tx, issueIDs); err != nil {
+			return err
+		}
+		if report.NextSprintID == "" {
+			return nil
+		}
+
+		moved := make([]*models.SprintIssue, len(carriedOver))
+		for i, si := range carriedOver {
+			moved[i] = &models.SprintIssue{
+				SprintID:    report.NextSprintID,
+				IssueID:     si.IssueID,
+				AddedAt:     time.Now(),
+				StoryPoints: si.StoryPoints,
+				AssigneeID:  si.AssigneeID,
+			}
+		}
+		_, err := tx.NewInsert().Model(&moved).
+			On("CONFLICT (sprint_id, issue_id) DO NOTHING").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("carry over issues: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetCompletionReport gets the completion report of a sprint, nil if it has none
+func (r *SprintRepository) GetCompletionReport(ctx context.Context, sprintID string) (*models.SprintCompletionReport, error) {
+	report := new(models.SprintCompletionReport)
+	err := r.db.NewSelect().Model(report).Where("sprint_id = ?", sprintID).Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get completion report: %w", err)
+	}
+	return report, nil
+}
+
+// AddRemovedScope counts an issue taken out of an active sprint
+func (r *SprintRepository) AddRemovedScope(ctx context.Context, sprintID string, points int) error {
+	_, err := r.db.NewUpdate().Model((*models.Sprint)(nil)).
+		Set("removed_issues = removed_issues + 1").
+		Set("removed_points = removed_points + ?", points).
+		Where("id = ?", sprintID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("add removed scope: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/nexusflow/nexusflow/services/sprint-service/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// GetSprintIssue gets the membership of an issue in a sprint
+func (r *SprintRepository) GetSprintIssue(ctx context.Context, sprintID, issueID string) (*models.SprintIssue, error) {
+	si := new(models.SprintIssue)
+	err := r.db.NewSelect().Model(si).
+		Where("sprint_id = ? AND issue_id = ?", sprintID, issueID).
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get sprint issue: %w", err)
+	}
+	return si, nil
+}
+
+// ListSprintIssueStates lists the issues of a sprint that are not in the trash
+func (r *SprintRepository) ListSprintIssueStates(ctx context.Context, sprintID string) ([]*models.SprintIssue, error) {
+	var issues []*models.SprintIssue
+	err := r.db.NewSelect().Model(&issues).
+		Where("sprint_id = ?", sprintID).
+		Where("issue_deleted_at IS NULL").
+		Order("added_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list sprint issue states: %w", err)
+	}
+	return issues, nil
+}
+
+// ListOpenSprintIDsByIssues lists the planned and active sprints holding any of the given issues
+func (r *SprintRepository) ListOpenSprintIDsByIssues(ctx context.Context, issueIDs []string) ([]string, error) {
+	var sprintIDs []string
+	err := r.db.NewSelect().Model((*models.SprintIssue)(nil)).
+		ColumnExpr("DISTINCT sprint_issue.sprint_id").
+		Join("JOIN sprints AS s ON s.id = sprint_issue.sprint_id").
+		Where("sprint_issue.issue_id IN (?)", bun.In(issueIDs)).
+		Where("s.status <> ?", models.SprintStatusCompleted).
+		Scan(ctx, &sprintIDs)
+	if err != nil {
+		return nil, fmt.Errorf("list open sprints by issues: %w", err)
+	}
+	return sprintIDs, nil
+}
+
+// UpdateSprintIssueState saves the issue state held for a sprint issue
+func (r *SprintRepository) UpdateSprintIssueState(ctx context.Context, si *models.SprintIssue) error {
+	_, err := r.db.NewUpdate().Model(si).
+		Column("story_points", "assignee_id", "done", "completed_at").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("update sprint issue state: %w", err)
+	}
+	return nil
+}
+
+// MarkSprintIssuesCommitted marks the issues in a sprint as committed when it starts
+func (r *SprintRepository) MarkSprintIssuesCommitted(ctx context.Context, sprintID string) error {
+	_, err := r.db.NewUpdate().Model((*models.SprintIssue)(nil)).
+		Set("committed = TRUE").
+		Where("sprint_id = ?", sprintID).
+		Where("issue_deleted_at IS NULL").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("mark sprint issues committed: %w", err)
+	}
+	return nil
+}
+
+// UpsertDailyStat records the scope and progress of a sprint for a day
+func (r *SprintRepository) UpsertDailyStat(ctx context.Context, stat *models.SprintDailyStat) error {
+	stat.RecordedAt = time.Now()
+	_, err := r.db.NewInsert().Model(stat).
+		On("CONFLICT (sprint_id, day) DO UPDATE").
+		Set("scope_issues = EXCLUDED.scope_issues").
+		Set("scope_points = EXCLUDED.scope_points").
+		Set("completed_issues = EXCLUDED.completed_issues").
+		Set("completed_points = EXCLUDED.completed_points").
+		Set("recorded_at = EXCLUDED.recorded_at").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("upsert sprint daily stat: %w", err)
+	}
+	return nil
+}
+
+// ListDailyStats lists the daily stats of a sprint, oldest first
+func (r *SprintRepository) ListDailyStats(ctx context.Context, sprintID string) ([]*models.SprintDailyStat, error) {
+	var stats []*models.SprintDailyStat
+	err := r.db.NewSelect().Model(&stats).
+		Where("sprint_id = ?", sprintID).
+		Order("day ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list sprint daily stats: %w", err)
+	}
+	return stats, nil
+}
+
+// ListCompletedSprints lists the latest completed sprints of a project, newest first
+func (r *SprintRepository) ListCompletedSprints(ctx context.Context, projectID string, limit int) ([]*models.Sprint, error) {
+	var sprints []*models.Sprint
+	err := r.db.NewSelect().Model(&sprints).
+		Where("project_id = ? AND status = ?", projectID, models.SprintStatusCompleted).
+		Order("completed_at DESC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list completed sprints: %w", err)
+	}
+	return sprints, nil
+}
+
+// SetMemberCapacity sets the capacity of a member in a sprint
+func (r *SprintRepository) SetMemberCapacity(ctx context.Context, capacity *models.SprintMemberCapacity) error {
+	capacity.UpdatedAt = time.Now()
+	_, err := r.db.NewInsert().Model(capacity).
+		On("CONFLICT (sprint_id, user_id) DO UPDATE").
+		Set("capacity_points = EXCLUDED.capacity_points").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("set member capacity: %w", err)
+	}
+	return nil
+}
+
+// RemoveMemberCapacity removes the capacity of a member from a sprint
+func (r *SprintRepository) RemoveMemberCapacity(ctx context.Context, sprintID, userID string) error {
+	_, err := r.db.NewDelete().Model((*models.SprintMemberCapacity)(nil)).
+		Where("sprint_id = ? AND user_id = ?", sprintID, userID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("remove member capacity: %w", err)
+	}
+	return nil
+}
+
+// ListMemberCapacities lists the member capacities of a sprint
+func (r *SprintRepository) ListMemberCapacities(ctx context.Context, sprintID string) ([]*models.SprintMemberCapacity, error) {
+	var capacities []*models.SprintMemberCapacity
+	err := r.db.NewSelect().Model(&capacities).
+		Where("sprint_id = ?", sprintID).
+		Order("created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list member capacities: %w", err)
+	}
+	return capacities, nil
+}
@@ -2,12 +2,14 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"time"
 
 	"github.com/nexusflow/nexusflow/pkg/database"
 	"github.com/nexusflow/nexusflow/pkg/logger"
 	"github.com/nexusflow/nexusflow/services/sprint-service/internal/models"
+	"github.com/uptrace/bun"
 )
 
 type SprintRepository struct {
@@ -62,41 +64,62 @@ func (r *SprintRepository) UpdateSprint(ctx context.Context, sprint *models.Spri
 	return nil
 }
 
-func (r *SprintRepository) DeleteSprint(ctx context.Context, id string) error {
-	_, err := r.db.NewDelete().Model((*models.Sprint)(nil)).Where("id = ?", id).Exec(ctx)
+// DeleteSprint deletes a sprint, queueing its issues to leave it in the issue
+// service, and returns them
+func (r *SprintRepository) DeleteSprint(ctx context.Context, id string) ([]string, error) {
+	var issueIDs []string
+	err := r.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewSelect().Model((*models.SprintIssue)(nil)).
+			Column("issue_id").
+			Where("sprint_id = ?", id).
+			Scan(ctx, &issueIDs)
+		if err != nil {
+			return fmt.Errorf("list sprint issues: %w", err)
+		}
+		if _, err := tx.NewDelete().Model((*models.Sprint)(nil)).Where("id = ?", id).Exec(ctx); err != nil {
+			return fmt.Errorf("delete sprint: %w", err)
+		}
+		return queueIssueSprintSyncs(ctx, tx, issueIDs)
+	})
 	if err != nil {
-		return fmt.Errorf("delete sprint: %w", err)
+		return nil, err
 	}
-	return nil
+	return issueIDs, nil
 }
 
 // Sprint Issues
 func (r *SprintRepository) AddIssueToSprint(ctx context.Context, sprintID, issueID string) error {
-	si := &models.SprintIssue{
-		SprintID: sprintID,
-		IssueID:  issueID,
-		AddedAt:  time.Now(),
-	}
-	_, err := r.db.NewInsert().Model(si).Exec(ctx)
-	if err != nil {
-		return fmt.Errorf("add issue to sprint: %w", err)
-	}
-	return nil
+	return r.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		si := &models.SprintIssue{
+			SprintID: sprintID,
+			IssueID:  issueID,
+			AddedAt:  time.Now(),
+		}
+		if _, err := tx.NewInsert().Model(si).Exec(ctx); err != nil {
+			return fmt.Errorf("add issue to sprint: %w", err)
+		}
+		return queueIssueSprintSyncs(ctx, tx, []string{issueID})
+	})
 }
 
 func (r *SprintRepository) RemoveIssueFromSprint(ctx context.Context, sprintID, issueID string) error {
-	_, err := r.db.NewDelete().Model((*models.SprintIssue)(nil)).
-		Where("sprint_id = ? AND issue_id = ?", sprintID, issueID).
-		Exec(ctx)
-	if err != nil {
-		return fmt.Errorf("remove issue from sprint: %w", err)
-	}
-	return nil
+	return r.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewDelete().Model((*models.SprintIssue)(nil)).
+			Where("sprint_id = ? AND issue_id = ?", sprintID, issueID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("remove issue from sprint: %w", err)
+		}
+		return queueIssueSprintSyncs(ctx, tx, []string{issueID})
+	})
 }
 
 func (r *SprintRepository) ListSprintIssues(ctx context.Context, sprintID string) ([]string, error) {
 	var issues []models.SprintIssue
-	err := r.db.NewSelect().Model(&issues).Where("sprint_id = ?", sprintID).Scan(ctx)
+	err := r.db.NewSelect().Model(&issues).
+		Where("sprint_id = ?", sprintID).
+		Where("issue_deleted_at IS NULL").
+		Scan(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("list sprint issues: %w", err)
 	}
@@ -108,6 +131,27 @@ func (r *SprintRepository) ListSprintIssues(ctx context.Context, sprintID string
 	return issueIDs, nil
 }
 
+// SetSprintIssuesDeleted hides or, with a zero deletedAt, shows again the given issues in every sprint
+func (r *SprintRepository) SetSprintIssuesDeleted(ctx context.Context, issueIDs []string, deletedAt time.Time) error {
+	_, err := r.db.NewUpdate().Model((*models.SprintIssue)(nil)).
+		Set("issue_deleted_at = ?", bun.NullZero(deletedAt)).
+		Where("issue_id IN (?)", bun.In(issueIDs)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("set sprint issues deleted: %w", err)
+	}
+	return nil
+}
+
+// RemoveIssuesFromSprints removes the given issues from every sprint
+func (r *SprintRepository) RemoveIssuesFromSprints(ctx context.Context, issueIDs []string) error {
+	_, err := r.db.NewDelete().Model((*models.SprintIssue)(nil)).Where("issue_id IN (?)", bun.In(issueIDs)).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("remove issues from sprints: %w", err)
+	}
+	return nil
+}
+
 // Check if project has active sprint
 func (r *SprintRepository) HasActiveSprint(ctx context.Context, projectID string) (bool, error) {
 	count, err := r.db.NewSelect().Model((*models.Sprint)(nil)).
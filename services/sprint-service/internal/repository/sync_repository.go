@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nexusflow/nexusflow/services/sprint-service/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// queueIssueSprintSyncs queues issues whose sprint changed so the issue
+// service is told about it. It runs in the transaction making the change.
+func queueIssueSprintSyncs(ctx context.Context, db bun.IDB, issueIDs []string) error {
+	if len(issueIDs) == 0 {
+		return nil
+	}
+	now := time.Now()
+	syncs := make([]*models.IssueSprintSync, len(issueIDs))
+	for i, id := range issueIDs {
+		syncs[i] = &models.IssueSprintSync{IssueID: id, QueuedAt: now, NextAttemptAt: now}
+	}
+	_, err := db.NewInsert().Model(&syncs).
+		On("CONFLICT (issue_id) DO UPDATE").
+		Set("queued_at = EXCLUDED.queued_at").
+		Set("attempts = 0").
+		Set("next_attempt_at = EXCLUDED.next_attempt_at").
+		Set("last_error = NULL").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("queue issue sprint syncs: %w", err)
+	}
+	return nil
+}
+
+// ListDueIssueSprintSyncs lists queued issue syncs due by now, oldest first.
+// When issueIDs is not empty only those issues are listed.
+func (r *SprintRepository) ListDueIssueSprintSyncs(ctx context.Context, issueIDs []string, now time.Time, limit int) ([]*models.IssueSprintSync, error) {
+	var syncs []*models.IssueSprintSync
+	q := r.db.NewSelect().Model(&syncs).
+		Where("next_attempt_at <= ?", now).
+		Order("queued_at ASC").
+		Limit(limit)
+	if len(issueIDs) > 0 {
+		q = q.Where("issue_id IN (?)", bun.In(issueIDs))
+	}
+	if err := q.Scan(ctx); err != nil {
+		return nil, fmt.Errorf("list issue sprint syncs: %w", err)
+	}
+	return syncs, nil
+}
+
+// OpenSprintsByIssues maps each of the given issues held by a planned or
+// active sprint to that sprint; issues in no open sprint are left out
+func (r *SprintRepository) OpenSprintsByIssues(ctx context.Context, issueIDs []string) (map[string]string, error) {
+	var rows []struct {
+		IssueID  string
+		SprintID string
+	}
+	err := r.db.NewSelect().Model((*models.SprintIssue)(nil)).
+		Column("sprint_issue.issue_id", "sprint_issue.sprint_id").
+		Join("JOIN sprints AS s ON s.id = sprint_issue.sprint_id").
+		Where("sprint_issue.issue_id IN (?)", bun.In(issueIDs)).
+		Where("s.status <> ?", models.SprintStatusCompleted).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("list open sprints of issues: %w", err)
+	}
+	sprints := make(map[string]string, len(rows))
+	for _, row := range rows {
+		sprints[row.IssueID] = row.SprintID
+	}
+	return sprints, nil
+}
+
+// DeleteIssueSprintSyncs removes synced issues from the queue, keeping those
+// queued again since listedAt
+func (r *SprintRepository) DeleteIssueSprintSyncs(ctx context.Context, issueIDs []string, listedAt time.Time) error {
+	_, err := r.db.NewDelete().Model((*models.IssueSprintSync)(nil)).
+		Where("issue_id IN (?)", bun.In(issueIDs)).
+		Where("queued_at <= ?", listedAt).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete issue sprint syncs: %w", err)
+	}
+	return nil
+}
+
+// DeferIssueSprintSyncs records a failed sync and backs off the next attempt,
+// doubling the wait up to an hour
+func (r *SprintRepository) DeferIssueSprintSyncs(ctx context.Context, issueIDs []string, lastError string, now time.Time) error {
+	_, err := r.db.NewUpdate().Model((*models.IssueSprintSync)(nil)).
+		Set("next_attempt_at = ? + LEAST(POWER(2, attempts), 60) * INTERVAL '1 minute'", now).
+		Set("attempts = attempts + 1").
+		Set("last_error = ?", lastError).
+		Where("issue_id IN (?)", bun.In(issueIDs)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("defer issue sprint syncs: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/nexusflow/nexusflow/pkg/kafka"
+)
+
+// HandleIssueEvent keeps sprints in step with issues moving in and out of the
+// trash, and with the points and status of their issues
+func (s *SprintService) HandleIssueEvent(ctx context.Context, event kafka.Event) error {
+	var issueIDs, changedIDs []string
+	switch event.Type {
+	case "issue.updated":
+		if id, _ := event.Payload["issue_id"].(string); id != "" {
+			changedIDs = []string{id}
+		}
+	case "issue.deleted", "issue.restored", "issue.purged":
+		issueIDs = event.PayloadStrings("issue_ids")
+		changedIDs = issueIDs
+	case "issue.bulk_updated":
+		issueIDs = event.PayloadStrings("deleted_issue_ids")
+		changedIDs = append(event.PayloadStrings("issue_ids"), issueIDs...)
+	}
+	if len(changedIDs) == 0 {
+		return nil
+	}
+
+	// Looked up first, purging takes the issues out of their sprints
+	sprintIDs, err := s.repo.ListOpenSprintIDsByIssues(ctx, changedIDs)
+	if err != nil {
+		return err
+	}
+	defer s.syncOpenSprints(ctx, sprintIDs, changedIDs)
+
+	if len(issueIDs) == 0 {
+		return nil
+	}
+
+	deletedAt := event.Timestamp
+	if deletedAt.IsZero() {
+		deletedAt = time.Now()
+	}
+
+	switch event.Type {
+	case "issue.deleted", "issue.bulk_updated":
+		err = s.repo.SetSprintIssuesDeleted(ctx, issueIDs, deletedAt)
+	case "issue.restored":
+		err = s.repo.SetSprintIssuesDeleted(ctx, issueIDs, time.Time{})
+	case "issue.purged":
+		err = s.repo.RemoveIssuesFromSprints(ctx, issueIDs)
+	}
+	if err != nil {
+		s.log.Sugar().Errorw("Failed to apply issue event to sprints", "error", err, "type", event.Type)
+	}
+	return err
+}
+
+// syncOpenSprints refreshes changed issues in the open sprints holding them
+func (s *SprintService) syncOpenSprints(ctx context.Context, sprintIDs, issueIDs []string) {
+	for _, id := range sprintIDs {
+		sprint, err := s.repo.GetSprint(ctx, id)
+		if err != nil {
+			s.log.Sugar().Errorw("Failed to get sprint", "error", err, "sprint_id", id)
+			continue
+		}
+		s.syncSprintIssues(ctx, sprint, issueIDs)
+	}
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+)
+
+// issueSyncBatch is the most queued issue syncs run at once
+const issueSyncBatch = 500
+
+// Sprint membership is owned by this service. Issue.SprintID in the issue
+// service mirrors it: every membership change queues its issues in the same
+// transaction, and the queue is drained right away and then retried in the
+// background until the issue service has caught up.
+
+// RunIssueSprintSync retries queued issue syncs until ctx is cancelled
+func (s *SprintService) RunIssueSprintSync(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.syncIssueSprints(ctx, nil)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// syncIssueSprints sets Issue.SprintID of the queued issues that are due, or
+// of the given ones only, to the open sprint holding them, or the backlog.
+// Issues the issue service could not update stay queued for a later retry.
+func (s *SprintService) syncIssueSprints(ctx context.Context, issueIDs []string) {
+	listedAt := time.Now()
+	syncs, err := s.repo.ListDueIssueSprintSyncs(ctx, issueIDs, listedAt, issueSyncBatch)
+	if err != nil {
+		s.log.Sugar().Errorw("Failed to list issue sprint syncs", "error", err)
+		return
+	}
+	if len(syncs) == 0 {
+		return
+	}
+
+	ids := make([]string, len(syncs))
+	for i, sync := range syncs {
+		ids[i] = sync.IssueID
+	}
+	sprints, err := s.repo.OpenSprintsByIssues(ctx, ids)
+	if err != nil {
+		s.log.Sugar().Errorw("Failed to look up sprints of issues", "error", err)
+		return
+	}
+	bySprint := make(map[string][]string)
+	for _, id := range ids {
+		bySprint[sprints[id]] = append(bySprint[sprints[id]], id)
+	}
+
+	for sprintID, issueIDs := range bySprint {
+		s.pushIssueSprint(ctx, sprintID, issueIDs, listedAt)
+	}
+}
+
+// pushIssueSprint moves issues into a sprint, or to the backlog for an empty
+// sprintID, in the issue service and dequeues them
+func (s *SprintService) pushIssueSprint(ctx context.Context, sprintID string, issueIDs []string, listedAt time.Time) {
+	for start := 0; start < len(issueIDs); start += maxIssueBatch {
+		end := start + maxIssueBatch
+		if end > len(issueIDs) {
+			end = len(issueIDs)
+		}
+		batch := issueIDs[start:end]
+
+		_, err := s.issueClient.SetIssuesSprint(ctx, &issuepb.SetIssuesSprintRequest{
+			IssueIds: batch,
+			SprintId: sprintID,
+		})
+		if err != nil {
+			s.log.Sugar().Warnw("Failed to set issues sprint, will retry", "error", err, "sprint_id", sprintID)
+			if err := s.repo.DeferIssueSprintSyncs(ctx, batch, err.Error(), time.Now()); err != nil {
+				s.log.Sugar().Errorw("Failed to defer issue sprint syncs", "error", err)
+			}
+			continue
+		}
+		if err := s.repo.DeleteIssueSprintSyncs(ctx, batch, listedAt); err != nil {
+			s.log.Sugar().Errorw("Failed to dequeue issue sprint syncs", "error", err)
+		}
+	}
+}
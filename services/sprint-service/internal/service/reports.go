@@ -0,0 +1,425 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
+	workflowpb "github.com/nexusflow/nexusflow/pkg/proto/workflow/v1"
+	"github.com/nexusflow/nexusflow/pkg/workflow"
+	"github.com/nexusflow/nexusflow/services/sprint-service/internal/models"
+)
+
+const (
+	defaultVelocitySprints = 3
+	maxVelocitySprints     = 20
+)
+
+// SprintReport is the committed vs completed scope of a sprint and its daily
+// progress, from which burndown and burnup charts are drawn
+type SprintReport struct {
+	Sprint *models.Sprint
+	// Committed issues that were done by the end of the sprint, or so far
+	CommittedCompletedIssues int
+	CommittedCompletedPoints int
+	AddedIssues              int
+	AddedPoints              int
+	Days                     []*ReportDay
+	// Set once the sprint is completed
+	Completion *models.SprintCompletionReport
+}
+
+// ReportDay is the state of a sprint at the end of a day
+type ReportDay struct {
+	Date            time.Time
+	ScopeIssues     int
+	ScopePoints     int
+	CompletedIssues int
+	CompletedPoints int
+	RemainingIssues int
+	RemainingPoints int
+	// Points left on the ideal burndown line, running from the committed
+	// points on the start date to zero on the end date
+	IdealPoints float64
+}
+
+// Velocity is the committed vs completed points of recent sprints
+type Velocity struct {
+	Sprints                []*models.Sprint
+	AverageCommittedPoints float64
+	AverageCompletedPoints float64
+}
+
+// SprintPlanning compares a sprint's scope with the team's capacity and velocity
+type SprintPlanning struct {
+	Members          []*MemberLoad
+	TotalCapacity    int
+	ScopePoints      int
+	UnassignedPoints int
+	Velocity         float64
+	Overcommitted    bool
+	Warnings         []string
+}
+
+// MemberLoad is the capacity of a sprint member against the open points
+// assigned to them
+type MemberLoad struct {
+	UserID         string
+	CapacityPoints int
+	AssignedPoints int
+}
+
+// GetSprintReport builds the report of a sprint from its daily stats
+func (s *SprintService) GetSprintReport(ctx context.Context, sprintID string) (*SprintReport, error) {
+	sprint, err := s.repo.GetSprint(ctx, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	issues, err := s.repo.ListSprintIssueStates(ctx, sprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &SprintReport{Sprint: sprint}
+	for _, si := range issues {
+		switch {
+		case si.Committed && si.Done:
+			report.CommittedCompletedIssues++
+			report.CommittedCompletedPoints += si.StoryPoints
+		case !si.Committed && sprint.Status != models.SprintStatusPlanned:
+			report.AddedIssues++
+			report.AddedPoints += si.StoryPoints
+		}
+	}
+	if sprint.Status == models.SprintStatusCompleted {
+		// Unfinished issues have left the sprint, the completion report
+		// still counts them
+		if report.Completion, err = s.repo.GetCompletionReport(ctx, sprintID); err != nil {
+			return nil, err
+		}
+		if report.Completion != nil {
+			report.AddedIssues, report.AddedPoints = report.Completion.AddedIssues, report.Completion.AddedPoints
+		}
+	}
+	if sprint.StartedAt.IsZero() {
+		return report, nil
+	}
+
+	stats, err := s.repo.ListDailyStats(ctx, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	byDay := make(map[string]*models.SprintDailyStat, len(stats))
+	for _, stat := range stats {
+		byDay[stat.Day.Format("2006-01-02")] = stat
+	}
+
+	first := utcDay(sprint.StartedAt)
+	last := utcDay(time.Now())
+	if !sprint.CompletedAt.IsZero() {
+		last = utcDay(sprint.CompletedAt)
+	}
+	if !sprint.EndDate.IsZero() && utcDay(sprint.EndDate).After(last) && sprint.CompletedAt.IsZero() {
+		// Future days of an active sprint only carry the ideal line
+		last = utcDay(sprint.EndDate)
+	}
+
+	today := utcDay(time.Now())
+	var current *models.SprintDailyStat
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		rd := &ReportDay{Date: day, IdealPoints: idealPoints(sprint, day)}
+		if !day.After(today) {
+			// Days without events carry the previous day forward
+			if stat, ok := byDay[day.Format("2006-01-02")]; ok {
+				current = stat
+			}
+			if current != nil {
+				rd.ScopeIssues, rd.ScopePoints = current.ScopeIssues, current.ScopePoints
+				rd.CompletedIssues, rd.CompletedPoints = current.CompletedIssues, current.CompletedPoints
+				rd.RemainingIssues = current.ScopeIssues - current.CompletedIssues
+				rd.RemainingPoints = current.ScopePoints - current.CompletedPoints
+			}
+		}
+		report.Days = append(report.Days, rd)
+	}
+	return report, nil
+}
+
+// idealPoints returns the committed points left on a day if the sprint
+// burned down evenly from its start date to its end date
+func idealPoints(sprint *models.Sprint, day time.Time) float64 {
+	if sprint.EndDate.IsZero() {
+		return 0
+	}
+	start := utcDay(sprint.StartedAt)
+	if !sprint.StartDate.IsZero() {
+		start = utcDay(sprint.StartDate)
+	}
+	end := utcDay(sprint.EndDate)
+	total := end.Sub(start).Hours() / 24
+	if total <= 0 || !day.Before(end) {
+		return 0
+	}
+	if day.Before(start) {
+		return float64(sprint.CommittedPoints)
+	}
+	elapsed := day.Sub(start).Hours() / 24
+	return float64(sprint.CommittedPoints) * (1 - elapsed/total)
+}
+
+// GetVelocity returns the committed vs completed points of the last count
+// completed sprints of a project
+func (s *SprintService) GetVelocity(ctx context.Context, projectID string, count int) (*Velocity, error) {
+	if count <= 0 {
+		count = defaultVelocitySprints
+	}
+	if count > maxVelocitySprints {
+		count = maxVelocitySprints
+	}
+
+	sprints, err := s.repo.ListCompletedSprints(ctx, projectID, count)
+	if err != nil {
+		return nil, err
+	}
+	v := &Velocity{Sprints: sprints}
+	if len(sprints) == 0 {
+		return v, nil
+	}
+	var committed, completed int
+	for _, sprint := range sprints {
+		committed += sprint.CommittedPoints
+		completed += sprint.CompletedPoints
+	}
+	v.AverageCommittedPoints = float64(committed) / float64(len(sprints))
+	v.AverageCompletedPoints = float64(completed) / float64(len(sprints))
+	return v, nil
+}
+
+// SetMemberCapacity sets how many points a member can take on in a sprint
+func (s *SprintService) SetMemberCapacity(ctx context.Context, sprintID, userID string, points int) (*models.SprintMemberCapacity, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user id is required")
+	}
+	if points < 0 {
+		return nil, fmt.Errorf("capacity points must not be negative")
+	}
+	sprint, err := s.repo.GetSprint(ctx, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	if sprint.Status == models.SprintStatusCompleted {
+		return nil, fmt.Errorf("cannot change capacity of completed sprint")
+	}
+
+	capacity := &models.SprintMemberCapacity{SprintID: sprintID, UserID: userID, CapacityPoints: points}
+	if err := s.repo.SetMemberCapacity(ctx, capacity); err != nil {
+		return nil, err
+	}
+	return capacity, nil
+}
+
+// RemoveMemberCapacity removes a member's capacity from a sprint
+func (s *SprintService) RemoveMemberCapacity(ctx context.Context, sprintID, userID string) error {
+	return s.repo.RemoveMemberCapacity(ctx, sprintID, userID)
+}
+
+// GetSprintPlanning compares the scope of a sprint with the capacity of its
+// members and the project's velocity, warning when it is overcommitted
+func (s *SprintService) GetSprintPlanning(ctx context.Context, sprintID string) (*SprintPlanning, error) {
+	sprint, err := s.repo.GetSprint(ctx, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	issues, err := s.repo.ListSprintIssueStates(ctx, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	capacities, err := s.repo.ListMemberCapacities(ctx, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	velocity, err := s.GetVelocity(ctx, sprint.ProjectID, defaultVelocitySprints)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &SprintPlanning{Velocity: velocity.AverageCompletedPoints}
+	members := make(map[string]*MemberLoad)
+	for _, c := range capacities {
+		m := &MemberLoad{UserID: c.UserID, CapacityPoints: c.CapacityPoints}
+		members[c.UserID] = m
+		p.Members = append(p.Members, m)
+		p.TotalCapacity += c.CapacityPoints
+	}
+	for _, si := range issues {
+		if si.Done {
+			continue
+		}
+		p.ScopePoints += si.StoryPoints
+		if si.AssigneeID == "" {
+			p.UnassignedPoints += si.StoryPoints
+			continue
+		}
+		m, ok := members[si.AssigneeID]
+		if !ok {
+			// Assignees without a capacity still show their load, after
+			// the members with one
+			m = &MemberLoad{UserID: si.AssigneeID}
+			members[si.AssigneeID] = m
+			p.Members = append(p.Members, m)
+		}
+		m.AssignedPoints += si.StoryPoints
+	}
+
+	if len(capacities) > 0 && p.ScopePoints > p.TotalCapacity {
+		p.Warnings = append(p.Warnings, fmt.Sprintf("sprint scope of %d points exceeds team capacity of %d points", p.ScopePoints, p.TotalCapacity))
+	}
+	if len(velocity.Sprints) > 0 && float64(p.ScopePoints) > p.Velocity {
+		p.Warnings = append(p.Warnings, fmt.Sprintf("sprint scope of %d points exceeds velocity of %.1f points", p.ScopePoints, p.Velocity))
+	}
+	for _, m := range p.Members[:len(capacities)] {
+		if m.AssignedPoints > m.CapacityPoints {
+			p.Warnings = append(p.Warnings, fmt.Sprintf("member %s has %d points assigned for a capacity of %d points", m.UserID, m.AssignedPoints, m.CapacityPoints))
+		}
+	}
+	p.Overcommitted = len(p.Warnings) > 0
+	return p, nil
+}
+
+// syncSprintIssues refreshes the given issues of a sprint from the issue
+// service and records the sprint's stats for today if it is active. Failures
+// are logged, the membership change that led here has already happened.
+func (s *SprintService) syncSprintIssues(ctx context.Context, sprint *models.Sprint, issueIDs []string) {
+	issues, err := s.repo.ListSprintIssueStates(ctx, sprint.ID)
+	if err != nil {
+		s.log.Sugar().Errorw("Failed to list sprint issues", "error", err, "sprint_id", sprint.ID)
+		return
+	}
+
+	wanted := make(map[string]bool, len(issueIDs))
+	for _, id := range issueIDs {
+		wanted[id] = true
+	}
+	var changed []*models.SprintIssue
+	for _, si := range issues {
+		if wanted[si.IssueID] {
+			changed = append(changed, si)
+		}
+	}
+	if err := s.refreshSprintIssueStates(ctx, sprint, changed); err != nil {
+		s.log.Sugar().Errorw("Failed to refresh sprint issues", "error", err, "sprint_id", sprint.ID)
+		return
+	}
+
+	if sprint.Status == models.SprintStatusActive {
+		s.recordDailyStat(ctx, sprint, issues)
+	}
+}
+
+// refreshSprintIssues refreshes every issue of a sprint from the issue
+// service and returns them
+func (s *SprintService) refreshSprintIssues(ctx context.Context, sprint *models.Sprint) ([]*models.SprintIssue, error) {
+	issues, err := s.repo.ListSprintIssueStates(ctx, sprint.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.refreshSprintIssueStates(ctx, sprint, issues); err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
+
+// refreshSprintIssueStates copies the points, assignee and done state of
+// issues onto their sprint rows. Trashed issues are left alone; the issue
+// events hide them instead.
+func (s *SprintService) refreshSprintIssueStates(ctx context.Context, sprint *models.Sprint, issues []*models.SprintIssue) error {
+	if len(issues) == 0 {
+		return nil
+	}
+	categories, err := workflow.StatusCategories(ctx, s.workflowClient, sprint.ProjectID)
+	if err != nil {
+		return err
+	}
+	ids := make([]string, len(issues))
+	for i, si := range issues {
+		ids[i] = si.IssueID
+	}
+	current, err := s.batchGetIssues(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, si := range issues {
+		issue, ok := current[si.IssueID]
+		if !ok {
+			continue
+		}
+		done := categories[issue.StatusId] == workflowpb.StatusCategory_STATUS_CATEGORY_DONE
+		switch {
+		case done && !si.Done:
+			si.CompletedAt = time.Now()
+		case !done:
+			si.CompletedAt = time.Time{}
+		}
+		si.Done = done
+		si.StoryPoints = int(issue.StoryPoints)
+		si.AssigneeID = issue.AssigneeId
+		if err := s.repo.UpdateSprintIssueState(ctx, si); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// batchGetIssues fetches issues in batches, keyed by ID. Missing and trashed
+// issues are left out.
+func (s *SprintService) batchGetIssues(ctx context.Context, issueIDs []string) (map[string]*issuepb.Issue, error) {
+	issues := make(map[string]*issuepb.Issue, len(issueIDs))
+	for start := 0; start < len(issueIDs); start += maxIssueBatch {
+		end := start + maxIssueBatch
+		if end > len(issueIDs) {
+			end = len(issueIDs)
+		}
+		resp, err := s.issueClient.BatchGetIssues(ctx, &issuepb.BatchGetIssuesRequest{Ids: issueIDs[start:end]})
+		if err != nil {
+			return nil, fmt.Errorf("batch get issues: %w", err)
+		}
+		for _, issue := range resp.Issues {
+			issues[issue.Id] = issue
+		}
+	}
+	return issues, nil
+}
+
+// recordDailyStat records the scope and progress of a sprint for today
+func (s *SprintService) recordDailyStat(ctx context.Context, sprint *models.Sprint, issues []*models.SprintIssue) {
+	stat := &models.SprintDailyStat{
+		SprintID:    sprint.ID,
+		Day:         utcDay(time.Now()),
+		ScopeIssues: len(issues),
+		ScopePoints: sumPoints(issues),
+	}
+	for _, si := range issues {
+		if si.Done {
+			stat.CompletedIssues++
+			stat.CompletedPoints += si.StoryPoints
+		}
+	}
+	if err := s.repo.UpsertDailyStat(ctx, stat); err != nil {
+		s.log.Sugar().Errorw("Failed to record sprint stats", "error", err, "sprint_id", sprint.ID)
+	}
+}
+
+func sumPoints(issues []*models.SprintIssue) int {
+	total := 0
+	for _, si := range issues {
+		total += si.StoryPoints
+	}
+	return total
+}
+
+func utcDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
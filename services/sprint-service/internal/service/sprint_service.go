@@ -7,19 +7,43 @@ import (
 
 	"github.com/nexusflow/nexusflow/pkg/kafka"
 	"github.com/nexusflow/nexusflow/pkg/logger"
+	issuepb "github.com/nexusflow/nexusflow/pkg/proto/issue/v1"
 	pb "github.com/nexusflow/nexusflow/pkg/proto/sprint/v1"
+	workflowpb "github.com/nexusflow/nexusflow/pkg/proto/workflow/v1"
 	"github.com/nexusflow/nexusflow/services/sprint-service/internal/models"
 	"github.com/nexusflow/nexusflow/services/sprint-service/internal/repository"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
+// maxIssueBatch is the most issues the issue service gets or updates in one call
+const maxIssueBatch = 500
+
 type SprintService struct {
-	repo     *repository.SprintRepository
-	producer *kafka.Producer
-	log      *logger.Logger
+	repo           *repository.SprintRepository
+	producer       *kafka.Producer
+	log            *logger.Logger
+	issueClient    issuepb.IssueServiceClient
+	workflowClient workflowpb.WorkflowServiceClient
 }
 
-func NewSprintService(repo *repository.SprintRepository, producer *kafka.Producer, log *logger.Logger) *SprintService {
-	return &SprintService{repo: repo, producer: producer, log: log}
+func NewSprintService(repo *repository.SprintRepository, producer *kafka.Producer, log *logger.Logger, issueServiceAddr, workflowServiceAddr string) (*SprintService, error) {
+	issueConn, err := grpc.Dial(issueServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to issue service: %w", err)
+	}
+	workflowConn, err := grpc.Dial(workflowServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to workflow service: %w", err)
+	}
+
+	return &SprintService{
+		repo:           repo,
+		producer:       producer,
+		log:            log,
+		issueClient:    issuepb.NewIssueServiceClient(issueConn),
+		workflowClient: workflowpb.NewWorkflowServiceClient(workflowConn),
+	}, nil
 }
 
 // CreateSprint creates a new sprint
@@ -113,9 +137,11 @@ func (s *SprintService) DeleteSprint(ctx context.Context, id string) error {
 		return err
 	}
 
-	if err := s.repo.DeleteSprint(ctx, id); err != nil {
+	issueIDs, err := s.repo.DeleteSprint(ctx, id)
+	if err != nil {
 		return fmt.Errorf("delete sprint: %w", err)
 	}
+	s.syncIssueSprints(ctx, issueIDs)
 
 	s.publishEvent("sprint.deleted", sprint.ProjectID, map[string]interface{}{"sprint_id": id})
 	return nil
@@ -141,32 +167,102 @@ func (s *SprintService) StartSprint(ctx context.Context, sprintID string) (*mode
 		return nil, fmt.Errorf("project already has an active sprint")
 	}
 
+	// Snapshot the scope the team commits to
+	issues, err := s.refreshSprintIssues(ctx, sprint)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.MarkSprintIssuesCommitted(ctx, sprint.ID); err != nil {
+		return nil, err
+	}
+	sprint.CommittedIssues, sprint.CommittedPoints = len(issues), sumPoints(issues)
+
 	sprint.Status = models.SprintStatusActive
+	sprint.StartedAt = time.Now()
 	if err := s.repo.UpdateSprint(ctx, sprint); err != nil {
 		return nil, fmt.Errorf("start sprint: %w", err)
 	}
+	s.recordDailyStat(ctx, sprint, issues)
 
-	s.publishEvent("sprint.started", sprint.ProjectID, map[string]interface{}{"sprint_id": sprint.ID})
+	s.publishEvent("sprint.started", sprint.ProjectID, map[string]interface{}{"sprint_id": sprint.ID, "name": sprint.Name})
 	return sprint, nil
 }
 
-func (s *SprintService) CompleteSprint(ctx context.Context, sprintID string) (*models.Sprint, error) {
+// CompleteSprint completes an active sprint. Issues whose status is not in
+// the done category carry over to nextSprintID, a planned sprint of the same
+// project, or go back to the backlog when it is empty.
+func (s *SprintService) CompleteSprint(ctx context.Context, sprintID, nextSprintID string) (*models.Sprint, *models.SprintCompletionReport, error) {
 	sprint, err := s.repo.GetSprint(ctx, sprintID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if sprint.Status != models.SprintStatusActive {
-		return nil, fmt.Errorf("sprint must be active to complete")
+		return nil, nil, fmt.Errorf("sprint must be active to complete")
+	}
+	if nextSprintID != "" {
+		next, err := s.repo.GetSprint(ctx, nextSprintID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if next.ProjectID != sprint.ProjectID {
+			return nil, nil, fmt.Errorf("next sprint must belong to the same project")
+		}
+		if next.Status != models.SprintStatusPlanned {
+			return nil, nil, fmt.Errorf("next sprint must be in planned status")
+		}
 	}
 
+	issues, err := s.refreshSprintIssues(ctx, sprint)
+	if err != nil {
+		return nil, nil, err
+	}
+	report := &models.SprintCompletionReport{
+		SprintID:            sprint.ID,
+		NextSprintID:        nextSprintID,
+		RemovedIssues:       sprint.RemovedIssues,
+		RemovedPoints:       sprint.RemovedPoints,
+		CarriedOverIssueIDs: []string{},
+	}
+	var carriedOver []*models.SprintIssue
+	for _, si := range issues {
+		if !si.Committed {
+			report.AddedIssues++
+			report.AddedPoints += si.StoryPoints
+		}
+		if si.Done {
+			report.CompletedIssues++
+			report.CompletedPoints += si.StoryPoints
+			continue
+		}
+		carriedOver = append(carriedOver, si)
+		report.CarriedOverIssues++
+		report.CarriedOverPoints += si.StoryPoints
+		report.CarriedOverIssueIDs = append(report.CarriedOverIssueIDs, si.IssueID)
+	}
+	// The last day of the burndown still holds the unfinished issues
+	s.recordDailyStat(ctx, sprint, issues)
+
 	sprint.Status = models.SprintStatusCompleted
-	if err := s.repo.UpdateSprint(ctx, sprint); err != nil {
-		return nil, fmt.Errorf("complete sprint: %w", err)
+	sprint.CompletedAt = time.Now()
+	sprint.CompletedIssues, sprint.CompletedPoints = report.CompletedIssues, report.CompletedPoints
+	if err := s.repo.CompleteSprint(ctx, sprint, report, carriedOver); err != nil {
+		return nil, nil, fmt.Errorf("complete sprint: %w", err)
 	}
+	s.syncIssueSprints(ctx, report.CarriedOverIssueIDs)
+
+	s.publishEvent("sprint.completed", sprint.ProjectID, map[string]interface{}{
+		"sprint_id":              sprint.ID,
+		"name":                   sprint.Name,
+		"next_sprint_id":         nextSprintID,
+		"completed_issues":       report.CompletedIssues,
+		"carried_over_issue_ids": report.CarriedOverIssueIDs,
+	})
+	return sprint, report, nil
+}
 
-	s.publishEvent("sprint.completed", sprint.ProjectID, map[string]interface{}{"sprint_id": sprint.ID})
-	return sprint, nil
+func (s *SprintService) GetCompletionReport(ctx context.Context, sprintID string) (*models.SprintCompletionReport, error) {
+	return s.repo.GetCompletionReport(ctx, sprintID)
 }
 
 // Sprint issues
@@ -180,9 +276,31 @@ func (s *SprintService) AddIssueToSprint(ctx context.Context, sprintID, issueID
 		return fmt.Errorf("cannot add issues to completed sprint")
 	}
 
+	// Issue.SprintID holds one sprint, so the issue leaves any other open sprint
+	openSprintIDs, err := s.repo.ListOpenSprintIDsByIssues(ctx, []string{issueID})
+	if err != nil {
+		return err
+	}
+	for _, id := range openSprintIDs {
+		if id == sprintID {
+			return fmt.Errorf("issue is already in the sprint")
+		}
+	}
+	for _, id := range openSprintIDs {
+		other, err := s.repo.GetSprint(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := s.removeIssue(ctx, other, issueID); err != nil {
+			return err
+		}
+	}
+
 	if err := s.repo.AddIssueToSprint(ctx, sprintID, issueID); err != nil {
 		return fmt.Errorf("add issue to sprint: %w", err)
 	}
+	s.syncSprintIssues(ctx, sprint, []string{issueID})
+	s.syncIssueSprints(ctx, []string{issueID})
 
 	s.publishEvent("sprint.issue_added", sprint.ProjectID, map[string]interface{}{
 		"sprint_id": sprintID,
@@ -197,12 +315,33 @@ func (s *SprintService) RemoveIssueFromSprint(ctx context.Context, sprintID, iss
 		return err
 	}
 
-	if err := s.repo.RemoveIssueFromSprint(ctx, sprintID, issueID); err != nil {
+	if err := s.removeIssue(ctx, sprint, issueID); err != nil {
+		return err
+	}
+	s.syncIssueSprints(ctx, []string{issueID})
+	return nil
+}
+
+// removeIssue takes an issue out of a sprint, counting it as removed scope
+// while the sprint is active
+func (s *SprintService) removeIssue(ctx context.Context, sprint *models.Sprint, issueID string) error {
+	si, err := s.repo.GetSprintIssue(ctx, sprint.ID, issueID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.RemoveIssueFromSprint(ctx, sprint.ID, issueID); err != nil {
 		return fmt.Errorf("remove issue from sprint: %w", err)
 	}
+	if si != nil && si.IssueDeletedAt.IsZero() && sprint.Status == models.SprintStatusActive {
+		if err := s.repo.AddRemovedScope(ctx, sprint.ID, si.StoryPoints); err != nil {
+			s.log.Sugar().Errorw("Failed to count removed scope", "error", err, "sprint_id", sprint.ID)
+		}
+	}
+	s.syncSprintIssues(ctx, sprint, nil)
 
 	s.publishEvent("sprint.issue_removed", sprint.ProjectID, map[string]interface{}{
-		"sprint_id": sprintID,
+		"sprint_id": sprint.ID,
 		"issue_id":  issueID,
 	})
 	return nil
@@ -0,0 +1 @@
+ALTER TABLE sprint_issues DROP COLUMN IF EXISTS issue_deleted_at;
@@ -0,0 +1,2 @@
+-- Issues in the trash are hidden from sprints until they are restored
+ALTER TABLE sprint_issues ADD COLUMN IF NOT EXISTS issue_deleted_at TIMESTAMP;
@@ -0,0 +1,18 @@
+DROP TRIGGER IF EXISTS update_sprint_member_capacities_updated_at ON sprint_member_capacities;
+
+DROP TABLE IF EXISTS sprint_member_capacities;
+DROP TABLE IF EXISTS sprint_daily_stats;
+
+ALTER TABLE sprint_issues DROP COLUMN IF EXISTS committed;
+ALTER TABLE sprint_issues DROP COLUMN IF EXISTS completed_at;
+ALTER TABLE sprint_issues DROP COLUMN IF EXISTS done;
+ALTER TABLE sprint_issues DROP COLUMN IF EXISTS assignee_id;
+ALTER TABLE sprint_issues DROP COLUMN IF EXISTS story_points;
+
+DROP INDEX IF EXISTS idx_sprints_completed_at;
+ALTER TABLE sprints DROP COLUMN IF EXISTS completed_points;
+ALTER TABLE sprints DROP COLUMN IF EXISTS completed_issues;
+ALTER TABLE sprints DROP COLUMN IF EXISTS committed_points;
+ALTER TABLE sprints DROP COLUMN IF EXISTS committed_issues;
+ALTER TABLE sprints DROP COLUMN IF EXISTS completed_at;
+ALTER TABLE sprints DROP COLUMN IF EXISTS started_at;
@@ -0,0 +1,44 @@
+-- Scope committed when a sprint starts and delivered when it completes
+ALTER TABLE sprints ADD COLUMN IF NOT EXISTS started_at TIMESTAMP;
+ALTER TABLE sprints ADD COLUMN IF NOT EXISTS completed_at TIMESTAMP;
+ALTER TABLE sprints ADD COLUMN IF NOT EXISTS committed_issues INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE sprints ADD COLUMN IF NOT EXISTS committed_points INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE sprints ADD COLUMN IF NOT EXISTS completed_issues INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE sprints ADD COLUMN IF NOT EXISTS completed_points INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_sprints_completed_at ON sprints(project_id, completed_at DESC) WHERE status = 'completed';
+
+-- Issue state as last seen in issue events
+ALTER TABLE sprint_issues ADD COLUMN IF NOT EXISTS story_points INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE sprint_issues ADD COLUMN IF NOT EXISTS assignee_id UUID;
+ALTER TABLE sprint_issues ADD COLUMN IF NOT EXISTS done BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE sprint_issues ADD COLUMN IF NOT EXISTS completed_at TIMESTAMP;
+-- In the sprint when it started
+ALTER TABLE sprint_issues ADD COLUMN IF NOT EXISTS committed BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Scope and progress of active sprints, one row per day (UTC)
+CREATE TABLE IF NOT EXISTS sprint_daily_stats (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    sprint_id UUID NOT NULL REFERENCES sprints(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    scope_issues INTEGER NOT NULL DEFAULT 0,
+    scope_points INTEGER NOT NULL DEFAULT 0,
+    completed_issues INTEGER NOT NULL DEFAULT 0,
+    completed_points INTEGER NOT NULL DEFAULT 0,
+    recorded_at TIMESTAMP NOT NULL DEFAULT now(),
+    UNIQUE(sprint_id, day)
+);
+
+-- Story points each member can take on in a sprint
+CREATE TABLE IF NOT EXISTS sprint_member_capacities (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    sprint_id UUID NOT NULL REFERENCES sprints(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    capacity_points INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    UNIQUE(sprint_id, user_id)
+);
+
+CREATE TRIGGER update_sprint_member_capacities_updated_at BEFORE UPDATE ON sprint_member_capacities
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS sprint_completion_reports;
+
+ALTER TABLE sprints DROP COLUMN IF EXISTS removed_points;
+ALTER TABLE sprints DROP COLUMN IF EXISTS removed_issues;
@@ -0,0 +1,21 @@
+-- Issues taken out of a sprint while it was active
+ALTER TABLE sprints ADD COLUMN IF NOT EXISTS removed_issues INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE sprints ADD COLUMN IF NOT EXISTS removed_points INTEGER NOT NULL DEFAULT 0;
+
+-- Outcome of a completed sprint, one row per sprint
+CREATE TABLE IF NOT EXISTS sprint_completion_reports (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    sprint_id UUID NOT NULL UNIQUE REFERENCES sprints(id) ON DELETE CASCADE,
+    -- NULL when unfinished issues went back to the backlog
+    next_sprint_id UUID REFERENCES sprints(id) ON DELETE SET NULL,
+    completed_issues INTEGER NOT NULL DEFAULT 0,
+    completed_points INTEGER NOT NULL DEFAULT 0,
+    carried_over_issues INTEGER NOT NULL DEFAULT 0,
+    carried_over_points INTEGER NOT NULL DEFAULT 0,
+    added_issues INTEGER NOT NULL DEFAULT 0,
+    added_points INTEGER NOT NULL DEFAULT 0,
+    removed_issues INTEGER NOT NULL DEFAULT 0,
+    removed_points INTEGER NOT NULL DEFAULT 0,
+    carried_over_issue_ids UUID[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
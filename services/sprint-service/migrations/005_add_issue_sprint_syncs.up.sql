@@ -0,0 +1,13 @@
+-- Issues whose sprint the issue service has yet to be told about. Sprint
+-- membership is owned by sprint_issues; a row is queued in the same
+-- transaction as a membership change and removed once Issue.sprint_id in the
+-- issue service has been set to match it.
+CREATE TABLE IF NOT EXISTS issue_sprint_syncs (
+    issue_id UUID PRIMARY KEY,
+    queued_at TIMESTAMP NOT NULL DEFAULT now(),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT now(),
+    last_error TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_issue_sprint_syncs_next_attempt_at ON issue_sprint_syncs(next_attempt_at);
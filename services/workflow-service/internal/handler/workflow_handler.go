@@ -139,13 +139,17 @@ func (h *WorkflowHandler) workflowToProto(w *models.Workflow) *pb.Workflow {
 	if w == nil {
 		return nil
 	}
-	return &pb.Workflow{
+	pbWorkflow := &pb.Workflow{
 		Id:          w.ID,
 		ProjectId:   w.ProjectID,
 		Name:        w.Name,
 		Description: w.Description,
 		IsDefault:   w.IsDefault,
 	}
+	for _, st := range w.Statuses {
+		pbWorkflow.Statuses = append(pbWorkflow.Statuses, h.statusToProto(st))
+	}
+	return pbWorkflow
 }
 
 func (h *WorkflowHandler) statusToProto(s *models.WorkflowStatus) *pb.Status {
@@ -156,9 +160,9 @@ func (h *WorkflowHandler) statusToProto(s *models.WorkflowStatus) *pb.Status {
 		Id:          s.ID,
 		Name:        s.Name,
 		Description: s.Description,
-		// Category:    ... // Need mapping
-		Color:    s.Color,
-		Position: s.Position,
+		Category:    h.categoryToProto(s.Category),
+		Color:       s.Color,
+		Position:    s.Position,
 	}
 }
 
@@ -186,3 +190,16 @@ func (h *WorkflowHandler) protoCategoryToModel(c pb.StatusCategory) models.Statu
 		return models.StatusCategoryTodo
 	}
 }
+
+func (h *WorkflowHandler) categoryToProto(c models.StatusCategory) pb.StatusCategory {
+	switch c {
+	case models.StatusCategoryTodo:
+		return pb.StatusCategory_STATUS_CATEGORY_TODO
+	case models.StatusCategoryInProgress:
+		return pb.StatusCategory_STATUS_CATEGORY_IN_PROGRESS
+	case models.StatusCategoryDone:
+		return pb.StatusCategory_STATUS_CATEGORY_DONE
+	default:
+		return pb.StatusCategory_STATUS_CATEGORY_UNSPECIFIED
+	}
+}
@@ -26,6 +26,9 @@ type Workflow struct {
 	IsDefault   bool      `bun:"is_default,default:false"`
 	CreatedAt   time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
 	UpdatedAt   time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+
+	// Statuses are loaded with the workflow, ordered by position
+	Statuses []*WorkflowStatus `bun:"rel:has-many,join:id=workflow_id"`
 }
 
 // WorkflowStatus represents a status in a workflow
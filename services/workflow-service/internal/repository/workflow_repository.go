@@ -8,6 +8,7 @@ import (
 	"github.com/nexusflow/nexusflow/pkg/database"
 	"github.com/nexusflow/nexusflow/pkg/logger"
 	"github.com/nexusflow/nexusflow/services/workflow-service/internal/models"
+	"github.com/uptrace/bun"
 )
 
 // WorkflowRepository handles workflow data access
@@ -36,7 +37,11 @@ func (r *WorkflowRepository) CreateWorkflow(ctx context.Context, workflow *model
 // GetWorkflow gets a workflow by ID
 func (r *WorkflowRepository) GetWorkflow(ctx context.Context, id string) (*models.Workflow, error) {
 	workflow := new(models.Workflow)
-	err := r.db.NewSelect().Model(workflow).Where("id = ?", id).Scan(ctx)
+	err := r.db.NewSelect().
+		Model(workflow).
+		Relation("Statuses", orderStatuses).
+		Where("w.id = ?", id).
+		Scan(ctx)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -51,8 +56,9 @@ func (r *WorkflowRepository) ListWorkflows(ctx context.Context, projectID string
 	var workflows []*models.Workflow
 	err := r.db.NewSelect().
 		Model(&workflows).
-		Where("project_id = ?", projectID).
-		Order("created_at DESC").
+		Relation("Statuses", orderStatuses).
+		Where("w.project_id = ?", projectID).
+		Order("w.created_at DESC").
 		Scan(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("list workflows: %w", err)
@@ -60,6 +66,11 @@ func (r *WorkflowRepository) ListWorkflows(ctx context.Context, projectID string
 	return workflows, nil
 }
 
+// orderStatuses orders the statuses loaded with a workflow
+func orderStatuses(q *bun.SelectQuery) *bun.SelectQuery {
+	return q.Order("ws.position ASC")
+}
+
 // CreateStatus creates a new status
 func (r *WorkflowRepository) CreateStatus(ctx context.Context, status *models.WorkflowStatus) error {
 	_, err := r.db.NewInsert().Model(status).Exec(ctx)
@@ -71,18 +71,24 @@ func (SprintStatus) EnumDescriptor() ([]byte, []int) {
 }
 
 type Sprint struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Goal          string                 `protobuf:"bytes,4,opt,name=goal,proto3" json:"goal,omitempty"`
-	StartDate     string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Status        SprintStatus           `protobuf:"varint,7,opt,name=status,proto3,enum=sprint.v1.SprintStatus" json:"status,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId       string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Goal            string                 `protobuf:"bytes,4,opt,name=goal,proto3" json:"goal,omitempty"`
+	StartDate       string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate         string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Status          SprintStatus           `protobuf:"varint,7,opt,name=status,proto3,enum=sprint.v1.SprintStatus" json:"status,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	StartedAt       string                 `protobuf:"bytes,10,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt     string                 `protobuf:"bytes,11,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	CommittedIssues int32                  `protobuf:"varint,12,opt,name=committed_issues,json=committedIssues,proto3" json:"committed_issues,omitempty"` // Scope when the sprint started
+	CommittedPoints int32                  `protobuf:"varint,13,opt,name=committed_points,json=committedPoints,proto3" json:"committed_points,omitempty"`
+	CompletedIssues int32                  `protobuf:"varint,14,opt,name=completed_issues,json=completedIssues,proto3" json:"completed_issues,omitempty"` // Done when the sprint completed
+	CompletedPoints int32                  `protobuf:"varint,15,opt,name=completed_points,json=completedPoints,proto3" json:"completed_points,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Sprint) Reset() {
@@ -178,6 +184,48 @@ func (x *Sprint) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *Sprint) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *Sprint) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+func (x *Sprint) GetCommittedIssues() int32 {
+	if x != nil {
+		return x.CommittedIssues
+	}
+	return 0
+}
+
+func (x *Sprint) GetCommittedPoints() int32 {
+	if x != nil {
+		return x.CommittedPoints
+	}
+	return 0
+}
+
+func (x *Sprint) GetCompletedIssues() int32 {
+	if x != nil {
+		return x.CompletedIssues
+	}
+	return 0
+}
+
+func (x *Sprint) GetCompletedPoints() int32 {
+	if x != nil {
+		return x.CompletedPoints
+	}
+	return 0
+}
+
 type CreateSprintRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
@@ -905,6 +953,7 @@ func (x *StartSprintRequest) GetSprintId() string {
 type StartSprintResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Sprint        *Sprint                `protobuf:"bytes,1,opt,name=sprint,proto3" json:"sprint,omitempty"`
+	Warnings      []string               `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"` // Planning warnings, e.g. when the sprint is overcommitted
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -946,9 +995,17 @@ func (x *StartSprintResponse) GetSprint() *Sprint {
 	return nil
 }
 
+func (x *StartSprintResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
 type CompleteSprintRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SprintId      string                 `protobuf:"bytes,1,opt,name=sprint_id,json=sprintId,proto3" json:"sprint_id,omitempty"`
+	NextSprintId  string                 `protobuf:"bytes,2,opt,name=next_sprint_id,json=nextSprintId,proto3" json:"next_sprint_id,omitempty"` // Planned sprint for unfinished issues, empty for the backlog
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -990,9 +1047,17 @@ func (x *CompleteSprintRequest) GetSprintId() string {
 	return ""
 }
 
+func (x *CompleteSprintRequest) GetNextSprintId() string {
+	if x != nil {
+		return x.NextSprintId
+	}
+	return ""
+}
+
 type CompleteSprintResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sprint        *Sprint                `protobuf:"bytes,1,opt,name=sprint,proto3" json:"sprint,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Sprint        *Sprint                 `protobuf:"bytes,1,opt,name=sprint,proto3" json:"sprint,omitempty"`
+	Report        *SprintCompletionReport `protobuf:"bytes,2,opt,name=report,proto3" json:"report,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1034,6 +1099,145 @@ func (x *CompleteSprintResponse) GetSprint() *Sprint {
 	return nil
 }
 
+func (x *CompleteSprintResponse) GetReport() *SprintCompletionReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type SprintCompletionReport struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	SprintId            string                 `protobuf:"bytes,1,opt,name=sprint_id,json=sprintId,proto3" json:"sprint_id,omitempty"`
+	NextSprintId        string                 `protobuf:"bytes,2,opt,name=next_sprint_id,json=nextSprintId,proto3" json:"next_sprint_id,omitempty"`
+	CompletedIssues     int32                  `protobuf:"varint,3,opt,name=completed_issues,json=completedIssues,proto3" json:"completed_issues,omitempty"`
+	CompletedPoints     int32                  `protobuf:"varint,4,opt,name=completed_points,json=completedPoints,proto3" json:"completed_points,omitempty"`
+	CarriedOverIssues   int32                  `protobuf:"varint,5,opt,name=carried_over_issues,json=carriedOverIssues,proto3" json:"carried_over_issues,omitempty"` // Unfinished issues moved on
+	CarriedOverPoints   int32                  `protobuf:"varint,6,opt,name=carried_over_points,json=carriedOverPoints,proto3" json:"carried_over_points,omitempty"`
+	AddedIssues         int32                  `protobuf:"varint,7,opt,name=added_issues,json=addedIssues,proto3" json:"added_issues,omitempty"` // Added after the sprint started
+	AddedPoints         int32                  `protobuf:"varint,8,opt,name=added_points,json=addedPoints,proto3" json:"added_points,omitempty"`
+	RemovedIssues       int32                  `protobuf:"varint,9,opt,name=removed_issues,json=removedIssues,proto3" json:"removed_issues,omitempty"` // Removed while the sprint was active
+	RemovedPoints       int32                  `protobuf:"varint,10,opt,name=removed_points,json=removedPoints,proto3" json:"removed_points,omitempty"`
+	CarriedOverIssueIds []string               `protobuf:"bytes,11,rep,name=carried_over_issue_ids,json=carriedOverIssueIds,proto3" json:"carried_over_issue_ids,omitempty"`
+	CreatedAt           string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SprintCompletionReport) Reset() {
+	*x = SprintCompletionReport{}
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SprintCompletionReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SprintCompletionReport) ProtoMessage() {}
+
+func (x *SprintCompletionReport) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SprintCompletionReport.ProtoReflect.Descriptor instead.
+func (*SprintCompletionReport) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_sprint_v1_sprint_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SprintCompletionReport) GetSprintId() string {
+	if x != nil {
+		return x.SprintId
+	}
+	return ""
+}
+
+func (x *SprintCompletionReport) GetNextSprintId() string {
+	if x != nil {
+		return x.NextSprintId
+	}
+	return ""
+}
+
+func (x *SprintCompletionReport) GetCompletedIssues() int32 {
+	if x != nil {
+		return x.CompletedIssues
+	}
+	return 0
+}
+
+func (x *SprintCompletionReport) GetCompletedPoints() int32 {
+	if x != nil {
+		return x.CompletedPoints
+	}
+	return 0
+}
+
+func (x *SprintCompletionReport) GetCarriedOverIssues() int32 {
+	if x != nil {
+		return x.CarriedOverIssues
+	}
+	return 0
+}
+
+func (x *SprintCompletionReport) GetCarriedOverPoints() int32 {
+	if x != nil {
+		return x.CarriedOverPoints
+	}
+	return 0
+}
+
+func (x *SprintCompletionReport) GetAddedIssues() int32 {
+	if x != nil {
+		return x.AddedIssues
+	}
+	return 0
+}
+
+func (x *SprintCompletionReport) GetAddedPoints() int32 {
+	if x != nil {
+		return x.AddedPoints
+	}
+	return 0
+}
+
+func (x *SprintCompletionReport) GetRemovedIssues() int32 {
+	if x != nil {
+		return x.RemovedIssues
+	}
+	return 0
+}
+
+func (x *SprintCompletionReport) GetRemovedPoints() int32 {
+	if x != nil {
+		return x.RemovedPoints
+	}
+	return 0
+}
+
+func (x *SprintCompletionReport) GetCarriedOverIssueIds() []string {
+	if x != nil {
+		return x.CarriedOverIssueIds
+	}
+	return nil
+}
+
+func (x *SprintCompletionReport) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
 type GetSprintIssuesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SprintId      string                 `protobuf:"bytes,1,opt,name=sprint_id,json=sprintId,proto3" json:"sprint_id,omitempty"`
@@ -1043,7 +1247,7 @@ type GetSprintIssuesRequest struct {
 
 func (x *GetSprintIssuesRequest) Reset() {
 	*x = GetSprintIssuesRequest{}
-	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[19]
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1055,7 +1259,7 @@ func (x *GetSprintIssuesRequest) String() string {
 func (*GetSprintIssuesRequest) ProtoMessage() {}
 
 func (x *GetSprintIssuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[19]
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,7 +1272,7 @@ func (x *GetSprintIssuesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSprintIssuesRequest.ProtoReflect.Descriptor instead.
 func (*GetSprintIssuesRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_sprint_v1_sprint_proto_rawDescGZIP(), []int{19}
+	return file_pkg_proto_sprint_v1_sprint_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetSprintIssuesRequest) GetSprintId() string {
@@ -1087,7 +1291,7 @@ type GetSprintIssuesResponse struct {
 
 func (x *GetSprintIssuesResponse) Reset() {
 	*x = GetSprintIssuesResponse{}
-	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[20]
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1099,7 +1303,7 @@ func (x *GetSprintIssuesResponse) String() string {
 func (*GetSprintIssuesResponse) ProtoMessage() {}
 
 func (x *GetSprintIssuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[20]
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1112,7 +1316,7 @@ func (x *GetSprintIssuesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSprintIssuesResponse.ProtoReflect.Descriptor instead.
 func (*GetSprintIssuesResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_sprint_v1_sprint_proto_rawDescGZIP(), []int{20}
+	return file_pkg_proto_sprint_v1_sprint_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetSprintIssuesResponse) GetIssueIds() []string {
@@ -1122,81 +1326,963 @@ func (x *GetSprintIssuesResponse) GetIssueIds() []string {
 	return nil
 }
 
-var File_pkg_proto_sprint_v1_sprint_proto protoreflect.FileDescriptor
+// Reports
+type SprintReportDay struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Date            string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	ScopeIssues     int32                  `protobuf:"varint,2,opt,name=scope_issues,json=scopeIssues,proto3" json:"scope_issues,omitempty"`
+	ScopePoints     int32                  `protobuf:"varint,3,opt,name=scope_points,json=scopePoints,proto3" json:"scope_points,omitempty"`
+	CompletedIssues int32                  `protobuf:"varint,4,opt,name=completed_issues,json=completedIssues,proto3" json:"completed_issues,omitempty"`
+	CompletedPoints int32                  `protobuf:"varint,5,opt,name=completed_points,json=completedPoints,proto3" json:"completed_points,omitempty"`
+	RemainingIssues int32                  `protobuf:"varint,6,opt,name=remaining_issues,json=remainingIssues,proto3" json:"remaining_issues,omitempty"`
+	RemainingPoints int32                  `protobuf:"varint,7,opt,name=remaining_points,json=remainingPoints,proto3" json:"remaining_points,omitempty"`
+	IdealPoints     float64                `protobuf:"fixed64,8,opt,name=ideal_points,json=idealPoints,proto3" json:"ideal_points,omitempty"` // Ideal burndown from the committed points to zero
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SprintReportDay) Reset() {
+	*x = SprintReportDay{}
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-const file_pkg_proto_sprint_v1_sprint_proto_rawDesc = "" +
-	"\n" +
-	" pkg/proto/sprint/v1/sprint.proto\x12\tsprint.v1\"\x88\x02\n" +
-	"\x06Sprint\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
-	"\n" +
-	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
-	"\x04goal\x18\x04 \x01(\tR\x04goal\x12\x1d\n" +
-	"\n" +
-	"start_date\x18\x05 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x06 \x01(\tR\aendDate\x12/\n" +
-	"\x06status\x18\a \x01(\x0e2\x17.sprint.v1.SprintStatusR\x06status\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\t \x01(\tR\tupdatedAt\"\x96\x01\n" +
-	"\x13CreateSprintRequest\x12\x1d\n" +
-	"\n" +
-	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04goal\x18\x03 \x01(\tR\x04goal\x12\x1d\n" +
-	"\n" +
-	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x05 \x01(\tR\aendDate\"A\n" +
-	"\x14CreateSprintResponse\x12)\n" +
-	"\x06sprint\x18\x01 \x01(\v2\x11.sprint.v1.SprintR\x06sprint\"\"\n" +
-	"\x10GetSprintRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\">\n" +
-	"\x11GetSprintResponse\x12)\n" +
-	"\x06sprint\x18\x01 \x01(\v2\x11.sprint.v1.SprintR\x06sprint\"d\n" +
-	"\x12ListSprintsRequest\x12\x1d\n" +
-	"\n" +
-	"project_id\x18\x01 \x01(\tR\tprojectId\x12/\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x17.sprint.v1.SprintStatusR\x06status\"B\n" +
-	"\x13ListSprintsResponse\x12+\n" +
-	"\asprints\x18\x01 \x03(\v2\x11.sprint.v1.SprintR\asprints\"\x87\x01\n" +
-	"\x13UpdateSprintRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04goal\x18\x03 \x01(\tR\x04goal\x12\x1d\n" +
-	"\n" +
-	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x05 \x01(\tR\aendDate\"A\n" +
-	"\x14UpdateSprintResponse\x12)\n" +
-	"\x06sprint\x18\x01 \x01(\v2\x11.sprint.v1.SprintR\x06sprint\"%\n" +
-	"\x13DeleteSprintRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x16\n" +
-	"\x14DeleteSprintResponse\"Q\n" +
-	"\x17AddIssueToSprintRequest\x12\x1b\n" +
-	"\tsprint_id\x18\x01 \x01(\tR\bsprintId\x12\x19\n" +
-	"\bissue_id\x18\x02 \x01(\tR\aissueId\"\x1a\n" +
-	"\x18AddIssueToSprintResponse\"V\n" +
-	"\x1cRemoveIssueFromSprintRequest\x12\x1b\n" +
-	"\tsprint_id\x18\x01 \x01(\tR\bsprintId\x12\x19\n" +
-	"\bissue_id\x18\x02 \x01(\tR\aissueId\"\x1f\n" +
-	"\x1dRemoveIssueFromSprintResponse\"1\n" +
-	"\x12StartSprintRequest\x12\x1b\n" +
-	"\tsprint_id\x18\x01 \x01(\tR\bsprintId\"@\n" +
-	"\x13StartSprintResponse\x12)\n" +
-	"\x06sprint\x18\x01 \x01(\v2\x11.sprint.v1.SprintR\x06sprint\"4\n" +
-	"\x15CompleteSprintRequest\x12\x1b\n" +
-	"\tsprint_id\x18\x01 \x01(\tR\bsprintId\"C\n" +
-	"\x16CompleteSprintResponse\x12)\n" +
-	"\x06sprint\x18\x01 \x01(\v2\x11.sprint.v1.SprintR\x06sprint\"5\n" +
-	"\x16GetSprintIssuesRequest\x12\x1b\n" +
-	"\tsprint_id\x18\x01 \x01(\tR\bsprintId\"6\n" +
-	"\x17GetSprintIssuesResponse\x12\x1b\n" +
-	"\tissue_ids\x18\x01 \x03(\tR\bissueIds*`\n" +
-	"\fSprintStatus\x12\x19\n" +
-	"\x15SPRINT_STATUS_PLANNED\x10\x00\x12\x18\n" +
-	"\x14SPRINT_STATUS_ACTIVE\x10\x01\x12\x1b\n" +
-	"\x17SPRINT_STATUS_COMPLETED\x10\x022\xe0\x06\n" +
+func (x *SprintReportDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SprintReportDay) ProtoMessage() {}
+
+func (x *SprintReportDay) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SprintReportDay.ProtoReflect.Descriptor instead.
+func (*SprintReportDay) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_sprint_v1_sprint_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SprintReportDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SprintReportDay) GetScopeIssues() int32 {
+	if x != nil {
+		return x.ScopeIssues
+	}
+	return 0
+}
+
+func (x *SprintReportDay) GetScopePoints() int32 {
+	if x != nil {
+		return x.ScopePoints
+	}
+	return 0
+}
+
+func (x *SprintReportDay) GetCompletedIssues() int32 {
+	if x != nil {
+		return x.CompletedIssues
+	}
+	return 0
+}
+
+func (x *SprintReportDay) GetCompletedPoints() int32 {
+	if x != nil {
+		return x.CompletedPoints
+	}
+	return 0
+}
+
+func (x *SprintReportDay) GetRemainingIssues() int32 {
+	if x != nil {
+		return x.RemainingIssues
+	}
+	return 0
+}
+
+func (x *SprintReportDay) GetRemainingPoints() int32 {
+	if x != nil {
+		return x.RemainingPoints
+	}
+	return 0
+}
+
+func (x *SprintReportDay) GetIdealPoints() float64 {
+	if x != nil {
+		return x.IdealPoints
+	}
+	return 0
+}
+
+type GetSprintReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SprintId      string                 `protobuf:"bytes,1,opt,name=sprint_id,json=sprintId,proto3" json:"sprint_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSprintReportRequest) Reset() {
+	*x = GetSprintReportRequest{}
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSprintReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSprintReportRequest) ProtoMessage() {}
+
+func (x *GetSprintReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSprintReportRequest.ProtoReflect.Descriptor instead.
+func (*GetSprintReportRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_sprint_v1_sprint_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetSprintReportRequest) GetSprintId() string {
+	if x != nil {
+		return x.SprintId
+	}
+	return ""
+}
+
+type GetSprintReportResponse struct {
+	state                    protoimpl.MessageState  `protogen:"open.v1"`
+	Sprint                   *Sprint                 `protobuf:"bytes,1,opt,name=sprint,proto3" json:"sprint,omitempty"`
+	CommittedCompletedIssues int32                   `protobuf:"varint,2,opt,name=committed_completed_issues,json=committedCompletedIssues,proto3" json:"committed_completed_issues,omitempty"` // Committed issues that are done
+	CommittedCompletedPoints int32                   `protobuf:"varint,3,opt,name=committed_completed_points,json=committedCompletedPoints,proto3" json:"committed_completed_points,omitempty"`
+	AddedIssues              int32                   `protobuf:"varint,4,opt,name=added_issues,json=addedIssues,proto3" json:"added_issues,omitempty"` // Added after the sprint started
+	AddedPoints              int32                   `protobuf:"varint,5,opt,name=added_points,json=addedPoints,proto3" json:"added_points,omitempty"`
+	Days                     []*SprintReportDay      `protobuf:"bytes,6,rep,name=days,proto3" json:"days,omitempty"`             // Burndown and burnup series
+	Completion               *SprintCompletionReport `protobuf:"bytes,7,opt,name=completion,proto3" json:"completion,omitempty"` // Set once the sprint is completed
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetSprintReportResponse) Reset() {
+	*x = GetSprintReportResponse{}
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSprintReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSprintReportResponse) ProtoMessage() {}
+
+func (x *GetSprintReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSprintReportResponse.ProtoReflect.Descriptor instead.
+func (*GetSprintReportResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_sprint_v1_sprint_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetSprintReportResponse) GetSprint() *Sprint {
+	if x != nil {
+		return x.Sprint
+	}
+	return nil
+}
+
+func (x *GetSprintReportResponse) GetCommittedCompletedIssues() int32 {
+	if x != nil {
+		return x.CommittedCompletedIssues
+	}
+	return 0
+}
+
+func (x *GetSprintReportResponse) GetCommittedCompletedPoints() int32 {
+	if x != nil {
+		return x.CommittedCompletedPoints
+	}
+	return 0
+}
+
+func (x *GetSprintReportResponse) GetAddedIssues() int32 {
+	if x != nil {
+		return x.AddedIssues
+	}
+	return 0
+}
+
+func (x *GetSprintReportResponse) GetAddedPoints() int32 {
+	if x != nil {
+		return x.AddedPoints
+	}
+	return 0
+}
+
+func (x *GetSprintReportResponse) GetDays() []*SprintReportDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetSprintReportResponse) GetCompletion() *SprintCompletionReport {
+	if x != nil {
+		return x.Completion
+	}
+	return nil
+}
+
+type GetVelocityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	SprintCount   int32                  `protobuf:"varint,2,opt,name=sprint_count,json=sprintCount,proto3" json:"sprint_count,omitempty"` // Defaults to 3, at most 20
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVelocityRequest) Reset() {
+	*x = GetVelocityRequest{}
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVelocityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVelocityRequest) ProtoMessage() {}
+
+func (x *GetVelocityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVelocityRequest.ProtoReflect.Descriptor instead.
+func (*GetVelocityRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_sprint_v1_sprint_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetVelocityRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GetVelocityRequest) GetSprintCount() int32 {
+	if x != nil {
+		return x.SprintCount
+	}
+	return 0
+}
+
+type GetVelocityResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Sprints                []*Sprint              `protobuf:"bytes,1,rep,name=sprints,proto3" json:"sprints,omitempty"` // Newest first
+	AverageCommittedPoints float64                `protobuf:"fixed64,2,opt,name=average_committed_points,json=averageCommittedPoints,proto3" json:"average_committed_points,omitempty"`
+	AverageCompletedPoints float64                `protobuf:"fixed64,3,opt,name=average_completed_points,json=averageCompletedPoints,proto3" json:"average_completed_points,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetVelocityResponse) Reset() {
+	*x = GetVelocityResponse{}
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVelocityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVelocityResponse) ProtoMessage() {}
+
+func (x *GetVelocityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVelocityResponse.ProtoReflect.Descriptor instead.
+func (*GetVelocityResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_sprint_v1_sprint_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetVelocityResponse) GetSprints() []*Sprint {
+	if x != nil {
+		return x.Sprints
+	}
+	return nil
+}
+
+func (x *GetVelocityResponse) GetAverageCommittedPoints() float64 {
+	if x != nil {
+		return x.AverageCommittedPoints
+	}
+	return 0
+}
+
+func (x *GetVelocityResponse) GetAverageCompletedPoints() float64 {
+	if x != nil {
+		return x.AverageCompletedPoints
+	}
+	return 0
+}
+
+// Capacity
+type SprintMemberCapacity struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SprintId       string                 `protobuf:"bytes,1,opt,name=sprint_id,json=sprintId,proto3" json:"sprint_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CapacityPoints int32                  `protobuf:"varint,3,opt,name=capacity_points,json=capacityPoints,proto3" json:"capacity_points,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SprintMemberCapacity) Reset() {
+	*x = SprintMemberCapacity{}
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SprintMemberCapacity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SprintMemberCapacity) ProtoMessage() {}
+
+func (x *SprintMemberCapacity) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SprintMemberCapacity.ProtoReflect.Descriptor instead.
+func (*SprintMemberCapacity) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_sprint_v1_sprint_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SprintMemberCapacity) GetSprintId() string {
+	if x != nil {
+		return x.SprintId
+	}
+	return ""
+}
+
+func (x *SprintMemberCapacity) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SprintMemberCapacity) GetCapacityPoints() int32 {
+	if x != nil {
+		return x.CapacityPoints
+	}
+	return 0
+}
+
+type SetSprintMemberCapacityRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SprintId       string                 `protobuf:"bytes,1,opt,name=sprint_id,json=sprintId,proto3" json:"sprint_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CapacityPoints int32                  `protobuf:"varint,3,opt,name=capacity_points,json=capacityPoints,proto3" json:"capacity_points,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetSprintMemberCapacityRequest) Reset() {
+	*x = SetSprintMemberCapacityRequest{}
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSprintMemberCapacityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSprintMemberCapacityRequest) ProtoMessage() {}
+
+func (x *SetSprintMemberCapacityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSprintMemberCapacityRequest.ProtoReflect.Descriptor instead.
+func (*SetSprintMemberCapacityRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_sprint_v1_sprint_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetSprintMemberCapacityRequest) GetSprintId() string {
+	if x != nil {
+		return x.SprintId
+	}
+	return ""
+}
+
+func (x *SetSprintMemberCapacityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetSprintMemberCapacityRequest) GetCapacityPoints() int32 {
+	if x != nil {
+		return x.CapacityPoints
+	}
+	return 0
+}
+
+type SetSprintMemberCapacityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Capacity      *SprintMemberCapacity  `protobuf:"bytes,1,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSprintMemberCapacityResponse) Reset() {
+	*x = SetSprintMemberCapacityResponse{}
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSprintMemberCapacityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSprintMemberCapacityResponse) ProtoMessage() {}
+
+func (x *SetSprintMemberCapacityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSprintMemberCapacityResponse.ProtoReflect.Descriptor instead.
+func (*SetSprintMemberCapacityResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_sprint_v1_sprint_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetSprintMemberCapacityResponse) GetCapacity() *SprintMemberCapacity {
+	if x != nil {
+		return x.Capacity
+	}
+	return nil
+}
+
+type RemoveSprintMemberCapacityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SprintId      string                 `protobuf:"bytes,1,opt,name=sprint_id,json=sprintId,proto3" json:"sprint_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSprintMemberCapacityRequest) Reset() {
+	*x = RemoveSprintMemberCapacityRequest{}
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSprintMemberCapacityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSprintMemberCapacityRequest) ProtoMessage() {}
+
+func (x *RemoveSprintMemberCapacityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSprintMemberCapacityRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSprintMemberCapacityRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_sprint_v1_sprint_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RemoveSprintMemberCapacityRequest) GetSprintId() string {
+	if x != nil {
+		return x.SprintId
+	}
+	return ""
+}
+
+func (x *RemoveSprintMemberCapacityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveSprintMemberCapacityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSprintMemberCapacityResponse) Reset() {
+	*x = RemoveSprintMemberCapacityResponse{}
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSprintMemberCapacityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSprintMemberCapacityResponse) ProtoMessage() {}
+
+func (x *RemoveSprintMemberCapacityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSprintMemberCapacityResponse.ProtoReflect.Descriptor instead.
+func (*RemoveSprintMemberCapacityResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_sprint_v1_sprint_proto_rawDescGZIP(), []int{31}
+}
+
+type SprintMemberLoad struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CapacityPoints int32                  `protobuf:"varint,2,opt,name=capacity_points,json=capacityPoints,proto3" json:"capacity_points,omitempty"`
+	AssignedPoints int32                  `protobuf:"varint,3,opt,name=assigned_points,json=assignedPoints,proto3" json:"assigned_points,omitempty"` // Open points assigned in the sprint
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SprintMemberLoad) Reset() {
+	*x = SprintMemberLoad{}
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SprintMemberLoad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SprintMemberLoad) ProtoMessage() {}
+
+func (x *SprintMemberLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SprintMemberLoad.ProtoReflect.Descriptor instead.
+func (*SprintMemberLoad) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_sprint_v1_sprint_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SprintMemberLoad) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SprintMemberLoad) GetCapacityPoints() int32 {
+	if x != nil {
+		return x.CapacityPoints
+	}
+	return 0
+}
+
+func (x *SprintMemberLoad) GetAssignedPoints() int32 {
+	if x != nil {
+		return x.AssignedPoints
+	}
+	return 0
+}
+
+type GetSprintPlanningRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SprintId      string                 `protobuf:"bytes,1,opt,name=sprint_id,json=sprintId,proto3" json:"sprint_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSprintPlanningRequest) Reset() {
+	*x = GetSprintPlanningRequest{}
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSprintPlanningRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSprintPlanningRequest) ProtoMessage() {}
+
+func (x *GetSprintPlanningRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSprintPlanningRequest.ProtoReflect.Descriptor instead.
+func (*GetSprintPlanningRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_sprint_v1_sprint_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetSprintPlanningRequest) GetSprintId() string {
+	if x != nil {
+		return x.SprintId
+	}
+	return ""
+}
+
+type GetSprintPlanningResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Members          []*SprintMemberLoad    `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	TotalCapacity    int32                  `protobuf:"varint,2,opt,name=total_capacity,json=totalCapacity,proto3" json:"total_capacity,omitempty"`
+	ScopePoints      int32                  `protobuf:"varint,3,opt,name=scope_points,json=scopePoints,proto3" json:"scope_points,omitempty"` // Open points in the sprint
+	UnassignedPoints int32                  `protobuf:"varint,4,opt,name=unassigned_points,json=unassignedPoints,proto3" json:"unassigned_points,omitempty"`
+	Velocity         float64                `protobuf:"fixed64,5,opt,name=velocity,proto3" json:"velocity,omitempty"` // Average completed points of recent sprints
+	Overcommitted    bool                   `protobuf:"varint,6,opt,name=overcommitted,proto3" json:"overcommitted,omitempty"`
+	Warnings         []string               `protobuf:"bytes,7,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetSprintPlanningResponse) Reset() {
+	*x = GetSprintPlanningResponse{}
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSprintPlanningResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSprintPlanningResponse) ProtoMessage() {}
+
+func (x *GetSprintPlanningResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_sprint_v1_sprint_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSprintPlanningResponse.ProtoReflect.Descriptor instead.
+func (*GetSprintPlanningResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_sprint_v1_sprint_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetSprintPlanningResponse) GetMembers() []*SprintMemberLoad {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *GetSprintPlanningResponse) GetTotalCapacity() int32 {
+	if x != nil {
+		return x.TotalCapacity
+	}
+	return 0
+}
+
+func (x *GetSprintPlanningResponse) GetScopePoints() int32 {
+	if x != nil {
+		return x.ScopePoints
+	}
+	return 0
+}
+
+func (x *GetSprintPlanningResponse) GetUnassignedPoints() int32 {
+	if x != nil {
+		return x.UnassignedPoints
+	}
+	return 0
+}
+
+func (x *GetSprintPlanningResponse) GetVelocity() float64 {
+	if x != nil {
+		return x.Velocity
+	}
+	return 0
+}
+
+func (x *GetSprintPlanningResponse) GetOvercommitted() bool {
+	if x != nil {
+		return x.Overcommitted
+	}
+	return false
+}
+
+func (x *GetSprintPlanningResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+var File_pkg_proto_sprint_v1_sprint_proto protoreflect.FileDescriptor
+
+const file_pkg_proto_sprint_v1_sprint_proto_rawDesc = "" +
+	"\n" +
+	" pkg/proto/sprint/v1/sprint.proto\x12\tsprint.v1\"\xf6\x03\n" +
+	"\x06Sprint\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04goal\x18\x04 \x01(\tR\x04goal\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x06 \x01(\tR\aendDate\x12/\n" +
+	"\x06status\x18\a \x01(\x0e2\x17.sprint.v1.SprintStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\n" +
+	" \x01(\tR\tstartedAt\x12!\n" +
+	"\fcompleted_at\x18\v \x01(\tR\vcompletedAt\x12)\n" +
+	"\x10committed_issues\x18\f \x01(\x05R\x0fcommittedIssues\x12)\n" +
+	"\x10committed_points\x18\r \x01(\x05R\x0fcommittedPoints\x12)\n" +
+	"\x10completed_issues\x18\x0e \x01(\x05R\x0fcompletedIssues\x12)\n" +
+	"\x10completed_points\x18\x0f \x01(\x05R\x0fcompletedPoints\"\x96\x01\n" +
+	"\x13CreateSprintRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04goal\x18\x03 \x01(\tR\x04goal\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x05 \x01(\tR\aendDate\"A\n" +
+	"\x14CreateSprintResponse\x12)\n" +
+	"\x06sprint\x18\x01 \x01(\v2\x11.sprint.v1.SprintR\x06sprint\"\"\n" +
+	"\x10GetSprintRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\">\n" +
+	"\x11GetSprintResponse\x12)\n" +
+	"\x06sprint\x18\x01 \x01(\v2\x11.sprint.v1.SprintR\x06sprint\"d\n" +
+	"\x12ListSprintsRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12/\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x17.sprint.v1.SprintStatusR\x06status\"B\n" +
+	"\x13ListSprintsResponse\x12+\n" +
+	"\asprints\x18\x01 \x03(\v2\x11.sprint.v1.SprintR\asprints\"\x87\x01\n" +
+	"\x13UpdateSprintRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04goal\x18\x03 \x01(\tR\x04goal\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x05 \x01(\tR\aendDate\"A\n" +
+	"\x14UpdateSprintResponse\x12)\n" +
+	"\x06sprint\x18\x01 \x01(\v2\x11.sprint.v1.SprintR\x06sprint\"%\n" +
+	"\x13DeleteSprintRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x16\n" +
+	"\x14DeleteSprintResponse\"Q\n" +
+	"\x17AddIssueToSprintRequest\x12\x1b\n" +
+	"\tsprint_id\x18\x01 \x01(\tR\bsprintId\x12\x19\n" +
+	"\bissue_id\x18\x02 \x01(\tR\aissueId\"\x1a\n" +
+	"\x18AddIssueToSprintResponse\"V\n" +
+	"\x1cRemoveIssueFromSprintRequest\x12\x1b\n" +
+	"\tsprint_id\x18\x01 \x01(\tR\bsprintId\x12\x19\n" +
+	"\bissue_id\x18\x02 \x01(\tR\aissueId\"\x1f\n" +
+	"\x1dRemoveIssueFromSprintResponse\"1\n" +
+	"\x12StartSprintRequest\x12\x1b\n" +
+	"\tsprint_id\x18\x01 \x01(\tR\bsprintId\"\\\n" +
+	"\x13StartSprintResponse\x12)\n" +
+	"\x06sprint\x18\x01 \x01(\v2\x11.sprint.v1.SprintR\x06sprint\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings\"Z\n" +
+	"\x15CompleteSprintRequest\x12\x1b\n" +
+	"\tsprint_id\x18\x01 \x01(\tR\bsprintId\x12$\n" +
+	"\x0enext_sprint_id\x18\x02 \x01(\tR\fnextSprintId\"~\n" +
+	"\x16CompleteSprintResponse\x12)\n" +
+	"\x06sprint\x18\x01 \x01(\v2\x11.sprint.v1.SprintR\x06sprint\x129\n" +
+	"\x06report\x18\x02 \x01(\v2!.sprint.v1.SprintCompletionReportR\x06report\"\xf9\x03\n" +
+	"\x16SprintCompletionReport\x12\x1b\n" +
+	"\tsprint_id\x18\x01 \x01(\tR\bsprintId\x12$\n" +
+	"\x0enext_sprint_id\x18\x02 \x01(\tR\fnextSprintId\x12)\n" +
+	"\x10completed_issues\x18\x03 \x01(\x05R\x0fcompletedIssues\x12)\n" +
+	"\x10completed_points\x18\x04 \x01(\x05R\x0fcompletedPoints\x12.\n" +
+	"\x13carried_over_issues\x18\x05 \x01(\x05R\x11carriedOverIssues\x12.\n" +
+	"\x13carried_over_points\x18\x06 \x01(\x05R\x11carriedOverPoints\x12!\n" +
+	"\fadded_issues\x18\a \x01(\x05R\vaddedIssues\x12!\n" +
+	"\fadded_points\x18\b \x01(\x05R\vaddedPoints\x12%\n" +
+	"\x0eremoved_issues\x18\t \x01(\x05R\rremovedIssues\x12%\n" +
+	"\x0eremoved_points\x18\n" +
+	" \x01(\x05R\rremovedPoints\x123\n" +
+	"\x16carried_over_issue_ids\x18\v \x03(\tR\x13carriedOverIssueIds\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\tR\tcreatedAt\"5\n" +
+	"\x16GetSprintIssuesRequest\x12\x1b\n" +
+	"\tsprint_id\x18\x01 \x01(\tR\bsprintId\"6\n" +
+	"\x17GetSprintIssuesResponse\x12\x1b\n" +
+	"\tissue_ids\x18\x01 \x03(\tR\bissueIds\"\xba\x02\n" +
+	"\x0fSprintReportDay\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12!\n" +
+	"\fscope_issues\x18\x02 \x01(\x05R\vscopeIssues\x12!\n" +
+	"\fscope_points\x18\x03 \x01(\x05R\vscopePoints\x12)\n" +
+	"\x10completed_issues\x18\x04 \x01(\x05R\x0fcompletedIssues\x12)\n" +
+	"\x10completed_points\x18\x05 \x01(\x05R\x0fcompletedPoints\x12)\n" +
+	"\x10remaining_issues\x18\x06 \x01(\x05R\x0fremainingIssues\x12)\n" +
+	"\x10remaining_points\x18\a \x01(\x05R\x0fremainingPoints\x12!\n" +
+	"\fideal_points\x18\b \x01(\x01R\videalPoints\"5\n" +
+	"\x16GetSprintReportRequest\x12\x1b\n" +
+	"\tsprint_id\x18\x01 \x01(\tR\bsprintId\"\xf9\x02\n" +
+	"\x17GetSprintReportResponse\x12)\n" +
+	"\x06sprint\x18\x01 \x01(\v2\x11.sprint.v1.SprintR\x06sprint\x12<\n" +
+	"\x1acommitted_completed_issues\x18\x02 \x01(\x05R\x18committedCompletedIssues\x12<\n" +
+	"\x1acommitted_completed_points\x18\x03 \x01(\x05R\x18committedCompletedPoints\x12!\n" +
+	"\fadded_issues\x18\x04 \x01(\x05R\vaddedIssues\x12!\n" +
+	"\fadded_points\x18\x05 \x01(\x05R\vaddedPoints\x12.\n" +
+	"\x04days\x18\x06 \x03(\v2\x1a.sprint.v1.SprintReportDayR\x04days\x12A\n" +
+	"\n" +
+	"completion\x18\a \x01(\v2!.sprint.v1.SprintCompletionReportR\n" +
+	"completion\"V\n" +
+	"\x12GetVelocityRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12!\n" +
+	"\fsprint_count\x18\x02 \x01(\x05R\vsprintCount\"\xb6\x01\n" +
+	"\x13GetVelocityResponse\x12+\n" +
+	"\asprints\x18\x01 \x03(\v2\x11.sprint.v1.SprintR\asprints\x128\n" +
+	"\x18average_committed_points\x18\x02 \x01(\x01R\x16averageCommittedPoints\x128\n" +
+	"\x18average_completed_points\x18\x03 \x01(\x01R\x16averageCompletedPoints\"u\n" +
+	"\x14SprintMemberCapacity\x12\x1b\n" +
+	"\tsprint_id\x18\x01 \x01(\tR\bsprintId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12'\n" +
+	"\x0fcapacity_points\x18\x03 \x01(\x05R\x0ecapacityPoints\"\x7f\n" +
+	"\x1eSetSprintMemberCapacityRequest\x12\x1b\n" +
+	"\tsprint_id\x18\x01 \x01(\tR\bsprintId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12'\n" +
+	"\x0fcapacity_points\x18\x03 \x01(\x05R\x0ecapacityPoints\"^\n" +
+	"\x1fSetSprintMemberCapacityResponse\x12;\n" +
+	"\bcapacity\x18\x01 \x01(\v2\x1f.sprint.v1.SprintMemberCapacityR\bcapacity\"Y\n" +
+	"!RemoveSprintMemberCapacityRequest\x12\x1b\n" +
+	"\tsprint_id\x18\x01 \x01(\tR\bsprintId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"$\n" +
+	"\"RemoveSprintMemberCapacityResponse\"}\n" +
+	"\x10SprintMemberLoad\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
+	"\x0fcapacity_points\x18\x02 \x01(\x05R\x0ecapacityPoints\x12'\n" +
+	"\x0fassigned_points\x18\x03 \x01(\x05R\x0eassignedPoints\"7\n" +
+	"\x18GetSprintPlanningRequest\x12\x1b\n" +
+	"\tsprint_id\x18\x01 \x01(\tR\bsprintId\"\xa7\x02\n" +
+	"\x19GetSprintPlanningResponse\x125\n" +
+	"\amembers\x18\x01 \x03(\v2\x1b.sprint.v1.SprintMemberLoadR\amembers\x12%\n" +
+	"\x0etotal_capacity\x18\x02 \x01(\x05R\rtotalCapacity\x12!\n" +
+	"\fscope_points\x18\x03 \x01(\x05R\vscopePoints\x12+\n" +
+	"\x11unassigned_points\x18\x04 \x01(\x05R\x10unassignedPoints\x12\x1a\n" +
+	"\bvelocity\x18\x05 \x01(\x01R\bvelocity\x12$\n" +
+	"\rovercommitted\x18\x06 \x01(\bR\rovercommitted\x12\x1a\n" +
+	"\bwarnings\x18\a \x03(\tR\bwarnings*`\n" +
+	"\fSprintStatus\x12\x19\n" +
+	"\x15SPRINT_STATUS_PLANNED\x10\x00\x12\x18\n" +
+	"\x14SPRINT_STATUS_ACTIVE\x10\x01\x12\x1b\n" +
+	"\x17SPRINT_STATUS_COMPLETED\x10\x022\xd5\n" +
+	"\n" +
 	"\rSprintService\x12O\n" +
 	"\fCreateSprint\x12\x1e.sprint.v1.CreateSprintRequest\x1a\x1f.sprint.v1.CreateSprintResponse\x12F\n" +
 	"\tGetSprint\x12\x1b.sprint.v1.GetSprintRequest\x1a\x1c.sprint.v1.GetSprintResponse\x12L\n" +
@@ -1207,7 +2293,12 @@ const file_pkg_proto_sprint_v1_sprint_proto_rawDesc = "" +
 	"\x15RemoveIssueFromSprint\x12'.sprint.v1.RemoveIssueFromSprintRequest\x1a(.sprint.v1.RemoveIssueFromSprintResponse\x12L\n" +
 	"\vStartSprint\x12\x1d.sprint.v1.StartSprintRequest\x1a\x1e.sprint.v1.StartSprintResponse\x12U\n" +
 	"\x0eCompleteSprint\x12 .sprint.v1.CompleteSprintRequest\x1a!.sprint.v1.CompleteSprintResponse\x12X\n" +
-	"\x0fGetSprintIssues\x12!.sprint.v1.GetSprintIssuesRequest\x1a\".sprint.v1.GetSprintIssuesResponseB5Z3github.com/nexusflow/nexusflow/pkg/proto/sprint/v1;b\x06proto3"
+	"\x0fGetSprintIssues\x12!.sprint.v1.GetSprintIssuesRequest\x1a\".sprint.v1.GetSprintIssuesResponse\x12X\n" +
+	"\x0fGetSprintReport\x12!.sprint.v1.GetSprintReportRequest\x1a\".sprint.v1.GetSprintReportResponse\x12L\n" +
+	"\vGetVelocity\x12\x1d.sprint.v1.GetVelocityRequest\x1a\x1e.sprint.v1.GetVelocityResponse\x12p\n" +
+	"\x17SetSprintMemberCapacity\x12).sprint.v1.SetSprintMemberCapacityRequest\x1a*.sprint.v1.SetSprintMemberCapacityResponse\x12y\n" +
+	"\x1aRemoveSprintMemberCapacity\x12,.sprint.v1.RemoveSprintMemberCapacityRequest\x1a-.sprint.v1.RemoveSprintMemberCapacityResponse\x12^\n" +
+	"\x11GetSprintPlanning\x12#.sprint.v1.GetSprintPlanningRequest\x1a$.sprint.v1.GetSprintPlanningResponseB5Z3github.com/nexusflow/nexusflow/pkg/proto/sprint/v1;b\x06proto3"
 
 var (
 	file_pkg_proto_sprint_v1_sprint_proto_rawDescOnce sync.Once
@@ -1222,30 +2313,44 @@ func file_pkg_proto_sprint_v1_sprint_proto_rawDescGZIP() []byte {
 }
 
 var file_pkg_proto_sprint_v1_sprint_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pkg_proto_sprint_v1_sprint_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_pkg_proto_sprint_v1_sprint_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_pkg_proto_sprint_v1_sprint_proto_goTypes = []any{
-	(SprintStatus)(0),                     // 0: sprint.v1.SprintStatus
-	(*Sprint)(nil),                        // 1: sprint.v1.Sprint
-	(*CreateSprintRequest)(nil),           // 2: sprint.v1.CreateSprintRequest
-	(*CreateSprintResponse)(nil),          // 3: sprint.v1.CreateSprintResponse
-	(*GetSprintRequest)(nil),              // 4: sprint.v1.GetSprintRequest
-	(*GetSprintResponse)(nil),             // 5: sprint.v1.GetSprintResponse
-	(*ListSprintsRequest)(nil),            // 6: sprint.v1.ListSprintsRequest
-	(*ListSprintsResponse)(nil),           // 7: sprint.v1.ListSprintsResponse
-	(*UpdateSprintRequest)(nil),           // 8: sprint.v1.UpdateSprintRequest
-	(*UpdateSprintResponse)(nil),          // 9: sprint.v1.UpdateSprintResponse
-	(*DeleteSprintRequest)(nil),           // 10: sprint.v1.DeleteSprintRequest
-	(*DeleteSprintResponse)(nil),          // 11: sprint.v1.DeleteSprintResponse
-	(*AddIssueToSprintRequest)(nil),       // 12: sprint.v1.AddIssueToSprintRequest
-	(*AddIssueToSprintResponse)(nil),      // 13: sprint.v1.AddIssueToSprintResponse
-	(*RemoveIssueFromSprintRequest)(nil),  // 14: sprint.v1.RemoveIssueFromSprintRequest
-	(*RemoveIssueFromSprintResponse)(nil), // 15: sprint.v1.RemoveIssueFromSprintResponse
-	(*StartSprintRequest)(nil),            // 16: sprint.v1.StartSprintRequest
-	(*StartSprintResponse)(nil),           // 17: sprint.v1.StartSprintResponse
-	(*CompleteSprintRequest)(nil),         // 18: sprint.v1.CompleteSprintRequest
-	(*CompleteSprintResponse)(nil),        // 19: sprint.v1.CompleteSprintResponse
-	(*GetSprintIssuesRequest)(nil),        // 20: sprint.v1.GetSprintIssuesRequest
-	(*GetSprintIssuesResponse)(nil),       // 21: sprint.v1.GetSprintIssuesResponse
+	(SprintStatus)(0),                          // 0: sprint.v1.SprintStatus
+	(*Sprint)(nil),                             // 1: sprint.v1.Sprint
+	(*CreateSprintRequest)(nil),                // 2: sprint.v1.CreateSprintRequest
+	(*CreateSprintResponse)(nil),               // 3: sprint.v1.CreateSprintResponse
+	(*GetSprintRequest)(nil),                   // 4: sprint.v1.GetSprintRequest
+	(*GetSprintResponse)(nil),                  // 5: sprint.v1.GetSprintResponse
+	(*ListSprintsRequest)(nil),                 // 6: sprint.v1.ListSprintsRequest
+	(*ListSprintsResponse)(nil),                // 7: sprint.v1.ListSprintsResponse
+	(*UpdateSprintRequest)(nil),                // 8: sprint.v1.UpdateSprintRequest
+	(*UpdateSprintResponse)(nil),               // 9: sprint.v1.UpdateSprintResponse
+	(*DeleteSprintRequest)(nil),                // 10: sprint.v1.DeleteSprintRequest
+	(*DeleteSprintResponse)(nil),               // 11: sprint.v1.DeleteSprintResponse
+	(*AddIssueToSprintRequest)(nil),            // 12: sprint.v1.AddIssueToSprintRequest
+	(*AddIssueToSprintResponse)(nil),           // 13: sprint.v1.AddIssueToSprintResponse
+	(*RemoveIssueFromSprintRequest)(nil),       // 14: sprint.v1.RemoveIssueFromSprintRequest
+	(*RemoveIssueFromSprintResponse)(nil),      // 15: sprint.v1.RemoveIssueFromSprintResponse
+	(*StartSprintRequest)(nil),                 // 16: sprint.v1.StartSprintRequest
+	(*StartSprintResponse)(nil),                // 17: sprint.v1.StartSprintResponse
+	(*CompleteSprintRequest)(nil),              // 18: sprint.v1.CompleteSprintRequest
+	(*CompleteSprintResponse)(nil),             // 19: sprint.v1.CompleteSprintResponse
+	(*SprintCompletionReport)(nil),             // 20: sprint.v1.SprintCompletionReport
+	(*GetSprintIssuesRequest)(nil),             // 21: sprint.v1.GetSprintIssuesRequest
+	(*GetSprintIssuesResponse)(nil),            // 22: sprint.v1.GetSprintIssuesResponse
+	(*SprintReportDay)(nil),                    // 23: sprint.v1.SprintReportDay
+	(*GetSprintReportRequest)(nil),             // 24: sprint.v1.GetSprintReportRequest
+	(*GetSprintReportResponse)(nil),            // 25: sprint.v1.GetSprintReportResponse
+	(*GetVelocityRequest)(nil),                 // 26: sprint.v1.GetVelocityRequest
+	(*GetVelocityResponse)(nil),                // 27: sprint.v1.GetVelocityResponse
+	(*SprintMemberCapacity)(nil),               // 28: sprint.v1.SprintMemberCapacity
+	(*SetSprintMemberCapacityRequest)(nil),     // 29: sprint.v1.SetSprintMemberCapacityRequest
+	(*SetSprintMemberCapacityResponse)(nil),    // 30: sprint.v1.SetSprintMemberCapacityResponse
+	(*RemoveSprintMemberCapacityRequest)(nil),  // 31: sprint.v1.RemoveSprintMemberCapacityRequest
+	(*RemoveSprintMemberCapacityResponse)(nil), // 32: sprint.v1.RemoveSprintMemberCapacityResponse
+	(*SprintMemberLoad)(nil),                   // 33: sprint.v1.SprintMemberLoad
+	(*GetSprintPlanningRequest)(nil),           // 34: sprint.v1.GetSprintPlanningRequest
+	(*GetSprintPlanningResponse)(nil),          // 35: sprint.v1.GetSprintPlanningResponse
 }
 var file_pkg_proto_sprint_v1_sprint_proto_depIdxs = []int32{
 	0,  // 0: sprint.v1.Sprint.status:type_name -> sprint.v1.SprintStatus
@@ -1256,31 +2361,48 @@ var file_pkg_proto_sprint_v1_sprint_proto_depIdxs = []int32{
 	1,  // 5: sprint.v1.UpdateSprintResponse.sprint:type_name -> sprint.v1.Sprint
 	1,  // 6: sprint.v1.StartSprintResponse.sprint:type_name -> sprint.v1.Sprint
 	1,  // 7: sprint.v1.CompleteSprintResponse.sprint:type_name -> sprint.v1.Sprint
-	2,  // 8: sprint.v1.SprintService.CreateSprint:input_type -> sprint.v1.CreateSprintRequest
-	4,  // 9: sprint.v1.SprintService.GetSprint:input_type -> sprint.v1.GetSprintRequest
-	6,  // 10: sprint.v1.SprintService.ListSprints:input_type -> sprint.v1.ListSprintsRequest
-	8,  // 11: sprint.v1.SprintService.UpdateSprint:input_type -> sprint.v1.UpdateSprintRequest
-	10, // 12: sprint.v1.SprintService.DeleteSprint:input_type -> sprint.v1.DeleteSprintRequest
-	12, // 13: sprint.v1.SprintService.AddIssueToSprint:input_type -> sprint.v1.AddIssueToSprintRequest
-	14, // 14: sprint.v1.SprintService.RemoveIssueFromSprint:input_type -> sprint.v1.RemoveIssueFromSprintRequest
-	16, // 15: sprint.v1.SprintService.StartSprint:input_type -> sprint.v1.StartSprintRequest
-	18, // 16: sprint.v1.SprintService.CompleteSprint:input_type -> sprint.v1.CompleteSprintRequest
-	20, // 17: sprint.v1.SprintService.GetSprintIssues:input_type -> sprint.v1.GetSprintIssuesRequest
-	3,  // 18: sprint.v1.SprintService.CreateSprint:output_type -> sprint.v1.CreateSprintResponse
-	5,  // 19: sprint.v1.SprintService.GetSprint:output_type -> sprint.v1.GetSprintResponse
-	7,  // 20: sprint.v1.SprintService.ListSprints:output_type -> sprint.v1.ListSprintsResponse
-	9,  // 21: sprint.v1.SprintService.UpdateSprint:output_type -> sprint.v1.UpdateSprintResponse
-	11, // 22: sprint.v1.SprintService.DeleteSprint:output_type -> sprint.v1.DeleteSprintResponse
-	13, // 23: sprint.v1.SprintService.AddIssueToSprint:output_type -> sprint.v1.AddIssueToSprintResponse
-	15, // 24: sprint.v1.SprintService.RemoveIssueFromSprint:output_type -> sprint.v1.RemoveIssueFromSprintResponse
-	17, // 25: sprint.v1.SprintService.StartSprint:output_type -> sprint.v1.StartSprintResponse
-	19, // 26: sprint.v1.SprintService.CompleteSprint:output_type -> sprint.v1.CompleteSprintResponse
-	21, // 27: sprint.v1.SprintService.GetSprintIssues:output_type -> sprint.v1.GetSprintIssuesResponse
-	18, // [18:28] is the sub-list for method output_type
-	8,  // [8:18] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	20, // 8: sprint.v1.CompleteSprintResponse.report:type_name -> sprint.v1.SprintCompletionReport
+	1,  // 9: sprint.v1.GetSprintReportResponse.sprint:type_name -> sprint.v1.Sprint
+	23, // 10: sprint.v1.GetSprintReportResponse.days:type_name -> sprint.v1.SprintReportDay
+	20, // 11: sprint.v1.GetSprintReportResponse.completion:type_name -> sprint.v1.SprintCompletionReport
+	1,  // 12: sprint.v1.GetVelocityResponse.sprints:type_name -> sprint.v1.Sprint
+	28, // 13: sprint.v1.SetSprintMemberCapacityResponse.capacity:type_name -> sprint.v1.SprintMemberCapacity
+	33, // 14: sprint.v1.GetSprintPlanningResponse.members:type_name -> sprint.v1.SprintMemberLoad
+	2,  // 15: sprint.v1.SprintService.CreateSprint:input_type -> sprint.v1.CreateSprintRequest
+	4,  // 16: sprint.v1.SprintService.GetSprint:input_type -> sprint.v1.GetSprintRequest
+	6,  // 17: sprint.v1.SprintService.ListSprints:input_type -> sprint.v1.ListSprintsRequest
+	8,  // 18: sprint.v1.SprintService.UpdateSprint:input_type -> sprint.v1.UpdateSprintRequest
+	10, // 19: sprint.v1.SprintService.DeleteSprint:input_type -> sprint.v1.DeleteSprintRequest
+	12, // 20: sprint.v1.SprintService.AddIssueToSprint:input_type -> sprint.v1.AddIssueToSprintRequest
+	14, // 21: sprint.v1.SprintService.RemoveIssueFromSprint:input_type -> sprint.v1.RemoveIssueFromSprintRequest
+	16, // 22: sprint.v1.SprintService.StartSprint:input_type -> sprint.v1.StartSprintRequest
+	18, // 23: sprint.v1.SprintService.CompleteSprint:input_type -> sprint.v1.CompleteSprintRequest
+	21, // 24: sprint.v1.SprintService.GetSprintIssues:input_type -> sprint.v1.GetSprintIssuesRequest
+	24, // 25: sprint.v1.SprintService.GetSprintReport:input_type -> sprint.v1.GetSprintReportRequest
+	26, // 26: sprint.v1.SprintService.GetVelocity:input_type -> sprint.v1.GetVelocityRequest
+	29, // 27: sprint.v1.SprintService.SetSprintMemberCapacity:input_type -> sprint.v1.SetSprintMemberCapacityRequest
+	31, // 28: sprint.v1.SprintService.RemoveSprintMemberCapacity:input_type -> sprint.v1.RemoveSprintMemberCapacityRequest
+	34, // 29: sprint.v1.SprintService.GetSprintPlanning:input_type -> sprint.v1.GetSprintPlanningRequest
+	3,  // 30: sprint.v1.SprintService.CreateSprint:output_type -> sprint.v1.CreateSprintResponse
+	5,  // 31: sprint.v1.SprintService.GetSprint:output_type -> sprint.v1.GetSprintResponse
+	7,  // 32: sprint.v1.SprintService.ListSprints:output_type -> sprint.v1.ListSprintsResponse
+	9,  // 33: sprint.v1.SprintService.UpdateSprint:output_type -> sprint.v1.UpdateSprintResponse
+	11, // 34: sprint.v1.SprintService.DeleteSprint:output_type -> sprint.v1.DeleteSprintResponse
+	13, // 35: sprint.v1.SprintService.AddIssueToSprint:output_type -> sprint.v1.AddIssueToSprintResponse
+	15, // 36: sprint.v1.SprintService.RemoveIssueFromSprint:output_type -> sprint.v1.RemoveIssueFromSprintResponse
+	17, // 37: sprint.v1.SprintService.StartSprint:output_type -> sprint.v1.StartSprintResponse
+	19, // 38: sprint.v1.SprintService.CompleteSprint:output_type -> sprint.v1.CompleteSprintResponse
+	22, // 39: sprint.v1.SprintService.GetSprintIssues:output_type -> sprint.v1.GetSprintIssuesResponse
+	25, // 40: sprint.v1.SprintService.GetSprintReport:output_type -> sprint.v1.GetSprintReportResponse
+	27, // 41: sprint.v1.SprintService.GetVelocity:output_type -> sprint.v1.GetVelocityResponse
+	30, // 42: sprint.v1.SprintService.SetSprintMemberCapacity:output_type -> sprint.v1.SetSprintMemberCapacityResponse
+	32, // 43: sprint.v1.SprintService.RemoveSprintMemberCapacity:output_type -> sprint.v1.RemoveSprintMemberCapacityResponse
+	35, // 44: sprint.v1.SprintService.GetSprintPlanning:output_type -> sprint.v1.GetSprintPlanningResponse
+	30, // [30:45] is the sub-list for method output_type
+	15, // [15:30] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_sprint_v1_sprint_proto_init() }
@@ -1294,7 +2416,7 @@ func file_pkg_proto_sprint_v1_sprint_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_proto_sprint_v1_sprint_proto_rawDesc), len(file_pkg_proto_sprint_v1_sprint_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   21,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,16 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SprintService_CreateSprint_FullMethodName          = "/sprint.v1.SprintService/CreateSprint"
-	SprintService_GetSprint_FullMethodName             = "/sprint.v1.SprintService/GetSprint"
-	SprintService_ListSprints_FullMethodName           = "/sprint.v1.SprintService/ListSprints"
-	SprintService_UpdateSprint_FullMethodName          = "/sprint.v1.SprintService/UpdateSprint"
-	SprintService_DeleteSprint_FullMethodName          = "/sprint.v1.SprintService/DeleteSprint"
-	SprintService_AddIssueToSprint_FullMethodName      = "/sprint.v1.SprintService/AddIssueToSprint"
-	SprintService_RemoveIssueFromSprint_FullMethodName = "/sprint.v1.SprintService/RemoveIssueFromSprint"
-	SprintService_StartSprint_FullMethodName           = "/sprint.v1.SprintService/StartSprint"
-	SprintService_CompleteSprint_FullMethodName        = "/sprint.v1.SprintService/CompleteSprint"
-	SprintService_GetSprintIssues_FullMethodName       = "/sprint.v1.SprintService/GetSprintIssues"
+	SprintService_CreateSprint_FullMethodName               = "/sprint.v1.SprintService/CreateSprint"
+	SprintService_GetSprint_FullMethodName                  = "/sprint.v1.SprintService/GetSprint"
+	SprintService_ListSprints_FullMethodName                = "/sprint.v1.SprintService/ListSprints"
+	SprintService_UpdateSprint_FullMethodName               = "/sprint.v1.SprintService/UpdateSprint"
+	SprintService_DeleteSprint_FullMethodName               = "/sprint.v1.SprintService/DeleteSprint"
+	SprintService_AddIssueToSprint_FullMethodName           = "/sprint.v1.SprintService/AddIssueToSprint"
+	SprintService_RemoveIssueFromSprint_FullMethodName      = "/sprint.v1.SprintService/RemoveIssueFromSprint"
+	SprintService_StartSprint_FullMethodName                = "/sprint.v1.SprintService/StartSprint"
+	SprintService_CompleteSprint_FullMethodName             = "/sprint.v1.SprintService/CompleteSprint"
+	SprintService_GetSprintIssues_FullMethodName            = "/sprint.v1.SprintService/GetSprintIssues"
+	SprintService_GetSprintReport_FullMethodName            = "/sprint.v1.SprintService/GetSprintReport"
+	SprintService_GetVelocity_FullMethodName                = "/sprint.v1.SprintService/GetVelocity"
+	SprintService_SetSprintMemberCapacity_FullMethodName    = "/sprint.v1.SprintService/SetSprintMemberCapacity"
+	SprintService_RemoveSprintMemberCapacity_FullMethodName = "/sprint.v1.SprintService/RemoveSprintMemberCapacity"
+	SprintService_GetSprintPlanning_FullMethodName          = "/sprint.v1.SprintService/GetSprintPlanning"
 )
 
 // SprintServiceClient is the client API for SprintService service.
@@ -45,6 +50,11 @@ type SprintServiceClient interface {
 	StartSprint(ctx context.Context, in *StartSprintRequest, opts ...grpc.CallOption) (*StartSprintResponse, error)
 	CompleteSprint(ctx context.Context, in *CompleteSprintRequest, opts ...grpc.CallOption) (*CompleteSprintResponse, error)
 	GetSprintIssues(ctx context.Context, in *GetSprintIssuesRequest, opts ...grpc.CallOption) (*GetSprintIssuesResponse, error)
+	GetSprintReport(ctx context.Context, in *GetSprintReportRequest, opts ...grpc.CallOption) (*GetSprintReportResponse, error)
+	GetVelocity(ctx context.Context, in *GetVelocityRequest, opts ...grpc.CallOption) (*GetVelocityResponse, error)
+	SetSprintMemberCapacity(ctx context.Context, in *SetSprintMemberCapacityRequest, opts ...grpc.CallOption) (*SetSprintMemberCapacityResponse, error)
+	RemoveSprintMemberCapacity(ctx context.Context, in *RemoveSprintMemberCapacityRequest, opts ...grpc.CallOption) (*RemoveSprintMemberCapacityResponse, error)
+	GetSprintPlanning(ctx context.Context, in *GetSprintPlanningRequest, opts ...grpc.CallOption) (*GetSprintPlanningResponse, error)
 }
 
 type sprintServiceClient struct {
@@ -155,6 +165,56 @@ func (c *sprintServiceClient) GetSprintIssues(ctx context.Context, in *GetSprint
 	return out, nil
 }
 
+func (c *sprintServiceClient) GetSprintReport(ctx context.Context, in *GetSprintReportRequest, opts ...grpc.CallOption) (*GetSprintReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSprintReportResponse)
+	err := c.cc.Invoke(ctx, SprintService_GetSprintReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sprintServiceClient) GetVelocity(ctx context.Context, in *GetVelocityRequest, opts ...grpc.CallOption) (*GetVelocityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVelocityResponse)
+	err := c.cc.Invoke(ctx, SprintService_GetVelocity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sprintServiceClient) SetSprintMemberCapacity(ctx context.Context, in *SetSprintMemberCapacityRequest, opts ...grpc.CallOption) (*SetSprintMemberCapacityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSprintMemberCapacityResponse)
+	err := c.cc.Invoke(ctx, SprintService_SetSprintMemberCapacity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sprintServiceClient) RemoveSprintMemberCapacity(ctx context.Context, in *RemoveSprintMemberCapacityRequest, opts ...grpc.CallOption) (*RemoveSprintMemberCapacityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveSprintMemberCapacityResponse)
+	err := c.cc.Invoke(ctx, SprintService_RemoveSprintMemberCapacity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sprintServiceClient) GetSprintPlanning(ctx context.Context, in *GetSprintPlanningRequest, opts ...grpc.CallOption) (*GetSprintPlanningResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSprintPlanningResponse)
+	err := c.cc.Invoke(ctx, SprintService_GetSprintPlanning_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SprintServiceServer is the server API for SprintService service.
 // All implementations must embed UnimplementedSprintServiceServer
 // for forward compatibility.
@@ -169,6 +229,11 @@ type SprintServiceServer interface {
 	StartSprint(context.Context, *StartSprintRequest) (*StartSprintResponse, error)
 	CompleteSprint(context.Context, *CompleteSprintRequest) (*CompleteSprintResponse, error)
 	GetSprintIssues(context.Context, *GetSprintIssuesRequest) (*GetSprintIssuesResponse, error)
+	GetSprintReport(context.Context, *GetSprintReportRequest) (*GetSprintReportResponse, error)
+	GetVelocity(context.Context, *GetVelocityRequest) (*GetVelocityResponse, error)
+	SetSprintMemberCapacity(context.Context, *SetSprintMemberCapacityRequest) (*SetSprintMemberCapacityResponse, error)
+	RemoveSprintMemberCapacity(context.Context, *RemoveSprintMemberCapacityRequest) (*RemoveSprintMemberCapacityResponse, error)
+	GetSprintPlanning(context.Context, *GetSprintPlanningRequest) (*GetSprintPlanningResponse, error)
 	mustEmbedUnimplementedSprintServiceServer()
 }
 
@@ -209,6 +274,21 @@ func (UnimplementedSprintServiceServer) CompleteSprint(context.Context, *Complet
 func (UnimplementedSprintServiceServer) GetSprintIssues(context.Context, *GetSprintIssuesRequest) (*GetSprintIssuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSprintIssues not implemented")
 }
+func (UnimplementedSprintServiceServer) GetSprintReport(context.Context, *GetSprintReportRequest) (*GetSprintReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSprintReport not implemented")
+}
+func (UnimplementedSprintServiceServer) GetVelocity(context.Context, *GetVelocityRequest) (*GetVelocityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVelocity not implemented")
+}
+func (UnimplementedSprintServiceServer) SetSprintMemberCapacity(context.Context, *SetSprintMemberCapacityRequest) (*SetSprintMemberCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSprintMemberCapacity not implemented")
+}
+func (UnimplementedSprintServiceServer) RemoveSprintMemberCapacity(context.Context, *RemoveSprintMemberCapacityRequest) (*RemoveSprintMemberCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSprintMemberCapacity not implemented")
+}
+func (UnimplementedSprintServiceServer) GetSprintPlanning(context.Context, *GetSprintPlanningRequest) (*GetSprintPlanningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSprintPlanning not implemented")
+}
 func (UnimplementedSprintServiceServer) mustEmbedUnimplementedSprintServiceServer() {}
 func (UnimplementedSprintServiceServer) testEmbeddedByValue()                       {}
 
@@ -410,6 +490,96 @@ func _SprintService_GetSprintIssues_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SprintService_GetSprintReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSprintReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SprintServiceServer).GetSprintReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SprintService_GetSprintReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SprintServiceServer).GetSprintReport(ctx, req.(*GetSprintReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SprintService_GetVelocity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVelocityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SprintServiceServer).GetVelocity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SprintService_GetVelocity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SprintServiceServer).GetVelocity(ctx, req.(*GetVelocityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SprintService_SetSprintMemberCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSprintMemberCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SprintServiceServer).SetSprintMemberCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SprintService_SetSprintMemberCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SprintServiceServer).SetSprintMemberCapacity(ctx, req.(*SetSprintMemberCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SprintService_RemoveSprintMemberCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSprintMemberCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SprintServiceServer).RemoveSprintMemberCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SprintService_RemoveSprintMemberCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SprintServiceServer).RemoveSprintMemberCapacity(ctx, req.(*RemoveSprintMemberCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SprintService_GetSprintPlanning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSprintPlanningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SprintServiceServer).GetSprintPlanning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SprintService_GetSprintPlanning_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SprintServiceServer).GetSprintPlanning(ctx, req.(*GetSprintPlanningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SprintService_ServiceDesc is the grpc.ServiceDesc for SprintService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -457,6 +627,26 @@ var SprintService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSprintIssues",
 			Handler:    _SprintService_GetSprintIssues_Handler,
 		},
+		{
+			MethodName: "GetSprintReport",
+			Handler:    _SprintService_GetSprintReport_Handler,
+		},
+		{
+			MethodName: "GetVelocity",
+			Handler:    _SprintService_GetVelocity_Handler,
+		},
+		{
+			MethodName: "SetSprintMemberCapacity",
+			Handler:    _SprintService_SetSprintMemberCapacity_Handler,
+		},
+		{
+			MethodName: "RemoveSprintMemberCapacity",
+			Handler:    _SprintService_RemoveSprintMemberCapacity_Handler,
+		},
+		{
+			MethodName: "GetSprintPlanning",
+			Handler:    _SprintService_GetSprintPlanning_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/proto/sprint/v1/sprint.proto",